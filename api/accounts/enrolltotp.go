@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accounts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// EnrollTotpResult contains the TOTP secret and recovery codes returned when
+// an account is enrolled in TOTP. They are only returned once.
+type EnrollTotpResult struct {
+	Secret        string   `json:"secret,omitempty"`
+	Url           string   `json:"url,omitempty"`
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
+
+	response *api.Response
+}
+
+func (n EnrollTotpResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// EnrollTotp creates a pending TOTP enrollment for the account. The
+// enrollment must be completed with VerifyTotp.
+func (c *Client) EnrollTotp(ctx context.Context, accountId string, opt ...Option) (*EnrollTotpResult, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("empty accountId value passed into EnrollTotp request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in EnrollTotp request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("accounts/%s:enroll-totp", accountId), map[string]any{}, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating EnrollTotp request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during EnrollTotp call: %w", err)
+	}
+
+	target := new(EnrollTotpResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding EnrollTotp response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
@@ -138,6 +138,30 @@ func DefaultOidcAccountIssuer() Option {
 	}
 }
 
+func WithSamlAccountIssuer(inIssuer string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["issuer"] = inIssuer
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSamlAccountIssuer() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["issuer"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithLdapAccountLoginName(inLoginName string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -222,6 +246,30 @@ func DefaultPasswordAccountPassword() Option {
 	}
 }
 
+func WithCertAccountSubject(inSubject string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["subject"] = inSubject
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultCertAccountSubject() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["subject"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAccountSubject(inSubject string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -245,3 +293,27 @@ func DefaultOidcAccountSubject() Option {
 		o.postMap["attributes"] = val
 	}
 }
+
+func WithSamlAccountSubject(inSubject string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["subject"] = inSubject
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSamlAccountSubject() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["subject"] = nil
+		o.postMap["attributes"] = val
+	}
+}
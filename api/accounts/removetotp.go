@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accounts
+
+import (
+	"context"
+	"fmt"
+)
+
+// RemoveTotp removes the TOTP enrollment and recovery codes of the account.
+func (c *Client) RemoveTotp(ctx context.Context, accountId string, opt ...Option) (*AccountUpdateResult, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("empty accountId value passed into RemoveTotp request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in RemoveTotp request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("accounts/%s:remove-totp", accountId), map[string]any{}, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RemoveTotp request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during RemoveTotp call: %w", err)
+	}
+
+	target := new(AccountUpdateResult)
+	target.Item = new(Account)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding RemoveTotp response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accounts
+
+import (
+	"context"
+	"fmt"
+)
+
+// VerifyTotp completes the pending TOTP enrollment of the account using a
+// code generated from the secret returned by EnrollTotp.
+func (c *Client) VerifyTotp(ctx context.Context, accountId, code string, opt ...Option) (*AccountUpdateResult, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("empty accountId value passed into VerifyTotp request")
+	}
+	if code == "" {
+		return nil, fmt.Errorf("empty code value passed into VerifyTotp request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in VerifyTotp request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	reqBody := map[string]any{
+		"code": code,
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("accounts/%s:verify-totp", accountId), reqBody, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating VerifyTotp request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during VerifyTotp call: %w", err)
+	}
+
+	target := new(AccountUpdateResult)
+	target.Item = new(Account)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding VerifyTotp response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
@@ -29,6 +29,9 @@ type AuthMethod struct {
 	Type                        string                 `json:"type,omitempty"`
 	Attributes                  map[string]interface{} `json:"attributes,omitempty"`
 	IsPrimary                   bool                   `json:"is_primary,omitempty"`
+	AuthTokenTimeToLiveSeconds  uint32                 `json:"auth_token_time_to_live_seconds,omitempty"`
+	AuthTokenTimeToStaleSeconds uint32                 `json:"auth_token_time_to_stale_seconds,omitempty"`
+	MaxAuthTokensPerUser        uint32                 `json:"max_auth_tokens_per_user,omitempty"`
 	AuthorizedActions           []string               `json:"authorized_actions,omitempty"`
 	AuthorizedCollectionActions map[string][]string    `json:"authorized_collection_actions,omitempty"`
 
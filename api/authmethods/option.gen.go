@@ -127,6 +127,30 @@ func DefaultLdapAuthMethodAccountAttributeMaps() Option {
 	}
 }
 
+func WithSamlAuthMethodAccountAttributeMaps(inAccountAttributeMaps []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["account_attribute_maps"] = inAccountAttributeMaps
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSamlAuthMethodAccountAttributeMaps() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["account_attribute_maps"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodAccountClaimMaps(inAccountClaimMaps []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -151,6 +175,30 @@ func DefaultOidcAuthMethodAccountClaimMaps() Option {
 	}
 }
 
+func WithCertAuthMethodAccountField(inAccountField string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["account_field"] = inAccountField
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultCertAuthMethodAccountField() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["account_field"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodAllowedAudiences(inAllowedAudiences []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -223,6 +271,30 @@ func DefaultOidcAuthMethodApiUrlPrefix() Option {
 	}
 }
 
+func WithSamlAuthMethodApiUrlPrefix(inApiUrlPrefix string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["api_url_prefix"] = inApiUrlPrefix
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSamlAuthMethodApiUrlPrefix() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["api_url_prefix"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithAttributes(inAttributes map[string]interface{}) Option {
 	return func(o *options) {
 		o.postMap["attributes"] = inAttributes
@@ -235,6 +307,30 @@ func DefaultAttributes() Option {
 	}
 }
 
+func WithAuthTokenTimeToLiveSeconds(inAuthTokenTimeToLiveSeconds uint32) Option {
+	return func(o *options) {
+		o.postMap["auth_token_time_to_live_seconds"] = inAuthTokenTimeToLiveSeconds
+	}
+}
+
+func DefaultAuthTokenTimeToLiveSeconds() Option {
+	return func(o *options) {
+		o.postMap["auth_token_time_to_live_seconds"] = nil
+	}
+}
+
+func WithAuthTokenTimeToStaleSeconds(inAuthTokenTimeToStaleSeconds uint32) Option {
+	return func(o *options) {
+		o.postMap["auth_token_time_to_stale_seconds"] = inAuthTokenTimeToStaleSeconds
+	}
+}
+
+func DefaultAuthTokenTimeToStaleSeconds() Option {
+	return func(o *options) {
+		o.postMap["auth_token_time_to_stale_seconds"] = nil
+	}
+}
+
 func WithLdapAuthMethodBindDn(inBindDn string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -283,6 +379,30 @@ func DefaultLdapAuthMethodBindPassword() Option {
 	}
 }
 
+func WithCertAuthMethodCaCertificates(inCaCertificates []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ca_certificates"] = inCaCertificates
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultCertAuthMethodCaCertificates() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ca_certificates"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithLdapAuthMethodCertificates(inCertificates []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -655,6 +775,78 @@ func DefaultOidcAuthMethodIdpCaCerts() Option {
 	}
 }
 
+func WithSamlAuthMethodIdpCertificates(inIdpCertificates []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["idp_certificates"] = inIdpCertificates
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSamlAuthMethodIdpCertificates() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["idp_certificates"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithSamlAuthMethodIdpEntityId(inIdpEntityId string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["idp_entity_id"] = inIdpEntityId
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSamlAuthMethodIdpEntityId() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["idp_entity_id"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithSamlAuthMethodIdpSsoUrl(inIdpSsoUrl string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["idp_sso_url"] = inIdpSsoUrl
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSamlAuthMethodIdpSsoUrl() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["idp_sso_url"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithLdapAuthMethodInsecureTls(inInsecureTls bool) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -703,6 +895,30 @@ func DefaultOidcAuthMethodIssuer() Option {
 	}
 }
 
+func WithPasswordAuthMethodLockoutDurationSeconds(inLockoutDurationSeconds uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["lockout_duration_seconds"] = inLockoutDurationSeconds
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodLockoutDurationSeconds() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["lockout_duration_seconds"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodMaxAge(inMaxAge uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -727,6 +943,66 @@ func DefaultOidcAuthMethodMaxAge() Option {
 	}
 }
 
+func WithMaxAuthTokensPerUser(inMaxAuthTokensPerUser uint32) Option {
+	return func(o *options) {
+		o.postMap["max_auth_tokens_per_user"] = inMaxAuthTokensPerUser
+	}
+}
+
+func DefaultMaxAuthTokensPerUser() Option {
+	return func(o *options) {
+		o.postMap["max_auth_tokens_per_user"] = nil
+	}
+}
+
+func WithPasswordAuthMethodMaxFailedAttempts(inMaxFailedAttempts uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["max_failed_attempts"] = inMaxFailedAttempts
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodMaxFailedAttempts() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["max_failed_attempts"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodMaxPasswordAgeDays(inMaxPasswordAgeDays uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["max_password_age_days"] = inMaxPasswordAgeDays
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodMaxPasswordAgeDays() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["max_password_age_days"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithLdapAuthMethodMaximumPageSize(inMaximumPageSize uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -751,6 +1027,30 @@ func DefaultLdapAuthMethodMaximumPageSize() Option {
 	}
 }
 
+func WithPasswordAuthMethodMinDigits(inMinDigits uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["min_digits"] = inMinDigits
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodMinDigits() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["min_digits"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithPasswordAuthMethodMinLoginNameLength(inMinLoginNameLength uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -775,6 +1075,30 @@ func DefaultPasswordAuthMethodMinLoginNameLength() Option {
 	}
 }
 
+func WithPasswordAuthMethodMinLowercase(inMinLowercase uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["min_lowercase"] = inMinLowercase
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodMinLowercase() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["min_lowercase"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithPasswordAuthMethodMinPasswordLength(inMinPasswordLength uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -799,6 +1123,54 @@ func DefaultPasswordAuthMethodMinPasswordLength() Option {
 	}
 }
 
+func WithPasswordAuthMethodMinSymbols(inMinSymbols uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["min_symbols"] = inMinSymbols
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodMinSymbols() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["min_symbols"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodMinUppercase(inMinUppercase uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["min_uppercase"] = inMinUppercase
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodMinUppercase() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["min_uppercase"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -811,6 +1183,54 @@ func DefaultName() Option {
 	}
 }
 
+func WithSamlAuthMethodNameIdFormat(inNameIdFormat string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["name_id_format"] = inNameIdFormat
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSamlAuthMethodNameIdFormat() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["name_id_format"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodPasswordHistoryCount(inPasswordHistoryCount uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["password_history_count"] = inPasswordHistoryCount
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodPasswordHistoryCount() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["password_history_count"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodPrompts(inPrompts []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -835,6 +1255,30 @@ func DefaultOidcAuthMethodPrompts() Option {
 	}
 }
 
+func WithSamlAuthMethodSignAuthnRequests(inSignAuthnRequests bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["sign_authn_requests"] = inSignAuthnRequests
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSamlAuthMethodSignAuthnRequests() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["sign_authn_requests"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodSigningAlgorithms(inSigningAlgorithms []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -859,6 +1303,30 @@ func DefaultOidcAuthMethodSigningAlgorithms() Option {
 	}
 }
 
+func WithSamlAuthMethodSpEntityId(inSpEntityId string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["sp_entity_id"] = inSpEntityId
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSamlAuthMethodSpEntityId() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["sp_entity_id"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithLdapAuthMethodStartTls(inStartTls bool) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -907,6 +1375,30 @@ func DefaultLdapAuthMethodState() Option {
 	}
 }
 
+func WithPasswordAuthMethodTotpRequired(inTotpRequired bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["totp_required"] = inTotpRequired
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodTotpRequired() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["totp_required"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithLdapAuthMethodUpnDomain(inUpnDomain string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -11,8 +11,17 @@ import (
 )
 
 type PasswordAuthMethodAttributes struct {
-	MinLoginNameLength uint32 `json:"min_login_name_length,omitempty"`
-	MinPasswordLength  uint32 `json:"min_password_length,omitempty"`
+	MinLoginNameLength     uint32 `json:"min_login_name_length,omitempty"`
+	MinPasswordLength      uint32 `json:"min_password_length,omitempty"`
+	MinUppercase           uint32 `json:"min_uppercase,omitempty"`
+	MinLowercase           uint32 `json:"min_lowercase,omitempty"`
+	MinDigits              uint32 `json:"min_digits,omitempty"`
+	MinSymbols             uint32 `json:"min_symbols,omitempty"`
+	PasswordHistoryCount   uint32 `json:"password_history_count,omitempty"`
+	MaxPasswordAgeDays     uint32 `json:"max_password_age_days,omitempty"`
+	MaxFailedAttempts      uint32 `json:"max_failed_attempts,omitempty"`
+	LockoutDurationSeconds uint32 `json:"lockout_duration_seconds,omitempty"`
+	TotpRequired           bool   `json:"totp_required,omitempty"`
 }
 
 func AttributesMapToPasswordAuthMethodAttributes(in map[string]interface{}) (*PasswordAuthMethodAttributes, error) {
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentiallibraries
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api/credentials"
+)
+
+// ListIssuances returns the credentials issued from the credential library
+// to sessions, most recently issued first. All pages are fetched unless a
+// list token is provided with WithListToken.
+func (c *Client) ListIssuances(ctx context.Context, id string, opt ...Option) (*credentials.CredentialIssuanceListResult, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty id value passed into ListIssuances request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	return credentials.ListIssuancePages(ctx, c.client, fmt.Sprintf("credential-libraries/%s:list-issuances", url.PathEscape(id)), opts.queryMap, apiOpts...)
+}
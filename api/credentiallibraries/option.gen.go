@@ -330,6 +330,78 @@ func DefaultVaultSSHCertificateCredentialLibraryKeyType() Option {
 	}
 }
 
+func WithVaultCredentialLibraryKvV2(inKvV2 bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["kv_v2"] = inKvV2
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialLibraryKvV2() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["kv_v2"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithVaultCredentialLibraryKvV2Version(inKvV2Version uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["kv_v2_version"] = inKvV2Version
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialLibraryKvV2Version() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["kv_v2_version"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithVaultCredentialLibraryLeaseTtlFromSession(inLeaseTtlFromSession bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["lease_ttl_from_session"] = inLeaseTtlFromSession
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialLibraryLeaseTtlFromSession() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["lease_ttl_from_session"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -342,6 +414,18 @@ func DefaultName() Option {
 	}
 }
 
+func WithFileCredentialLibraryPath(inPath string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["path"] = inPath
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultCredentialLibraryPath(inPath string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
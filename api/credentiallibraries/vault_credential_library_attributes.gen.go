@@ -11,9 +11,12 @@ import (
 )
 
 type VaultCredentialLibraryAttributes struct {
-	Path            string `json:"path,omitempty"`
-	HttpMethod      string `json:"http_method,omitempty"`
-	HttpRequestBody string `json:"http_request_body,omitempty"`
+	Path                string `json:"path,omitempty"`
+	HttpMethod          string `json:"http_method,omitempty"`
+	HttpRequestBody     string `json:"http_request_body,omitempty"`
+	KvV2                bool   `json:"kv_v2,omitempty"`
+	KvV2Version         uint32 `json:"kv_v2_version,omitempty"`
+	LeaseTtlFromSession bool   `json:"lease_ttl_from_session,omitempty"`
 }
 
 func AttributesMapToVaultCredentialLibraryAttributes(in map[string]interface{}) (*VaultCredentialLibraryAttributes, error) {
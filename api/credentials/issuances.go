@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// CredentialIssuance records a credential issued to a session, either from a
+// credential library or a static credential. Issuances are kept after their
+// session is deleted.
+type CredentialIssuance struct {
+	Id                  string    `json:"id,omitempty"`
+	SessionId           string    `json:"session_id,omitempty"`
+	UserId              string    `json:"user_id,omitempty"`
+	TargetId            string    `json:"target_id,omitempty"`
+	ScopeId             string    `json:"scope_id,omitempty"`
+	CredentialLibraryId string    `json:"credential_library_id,omitempty"`
+	CredentialId        string    `json:"credential_id,omitempty"`
+	CredentialVersion   uint32    `json:"credential_version,omitempty"`
+	Purpose             string    `json:"purpose,omitempty"`
+	CreatedTime         time.Time `json:"created_time,omitempty"`
+}
+
+type CredentialIssuanceListResult struct {
+	Items        []*CredentialIssuance `json:"items,omitempty"`
+	EstItemCount uint                  `json:"est_item_count,omitempty"`
+	RemovedIds   []string              `json:"removed_ids,omitempty"`
+	ListToken    string                `json:"list_token,omitempty"`
+	ResponseType string                `json:"response_type,omitempty"`
+	response     *api.Response
+}
+
+func (n CredentialIssuanceListResult) GetItems() []*CredentialIssuance {
+	return n.Items
+}
+
+func (n CredentialIssuanceListResult) GetEstItemCount() uint {
+	return n.EstItemCount
+}
+
+func (n CredentialIssuanceListResult) GetRemovedIds() []string {
+	return n.RemovedIds
+}
+
+func (n CredentialIssuanceListResult) GetListToken() string {
+	return n.ListToken
+}
+
+func (n CredentialIssuanceListResult) GetResponseType() string {
+	return n.ResponseType
+}
+
+func (n CredentialIssuanceListResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// ListIssuances returns the issuances of the static credential to sessions,
+// most recently issued first. All pages are fetched unless a list token is
+// provided with WithListToken.
+func (c *Client) ListIssuances(ctx context.Context, id string, opt ...Option) (*CredentialIssuanceListResult, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty id value passed into ListIssuances request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	return ListIssuancePages(ctx, c.client, fmt.Sprintf("credentials/%s:list-issuances", url.PathEscape(id)), opts.queryMap, apiOpts...)
+}
+
+// ListIssuancePages lists the credential issuances at path, fetching all
+// pages unless the query contains a list token. It is shared with the
+// credential libraries client.
+func ListIssuancePages(ctx context.Context, client *api.Client, path string, query map[string]string, apiOpts ...api.Option) (*CredentialIssuanceListResult, error) {
+	page := func() (*CredentialIssuanceListResult, error) {
+		req, err := client.NewRequest(ctx, "GET", path, nil, apiOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("error creating ListIssuances request: %w", err)
+		}
+		if len(query) > 0 {
+			q := url.Values{}
+			for k, v := range query {
+				q.Add(k, v)
+			}
+			req.URL.RawQuery = q.Encode()
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("error performing client request during ListIssuances call: %w", err)
+		}
+
+		target := new(CredentialIssuanceListResult)
+		apiErr, err := resp.Decode(target)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding ListIssuances response: %w", err)
+		}
+		if apiErr != nil {
+			return nil, apiErr
+		}
+		target.response = resp
+		return target, nil
+	}
+
+	target, err := page()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := query["list_token"]; ok || target.ResponseType == "complete" || target.ResponseType == "" {
+		return target, nil
+	}
+	// Issuances are never updated, so the remaining pages only contain
+	// issuances which have not been seen yet.
+	for {
+		query["list_token"] = target.ListToken
+		next, err := page()
+		if err != nil {
+			return nil, err
+		}
+		target.Items = append(target.Items, next.Items...)
+		target.ListToken = next.ListToken
+		target.ResponseType = next.ResponseType
+		target.response = next.response
+		if target.ResponseType == "complete" {
+			break
+		}
+	}
+	target.EstItemCount = uint(len(target.Items))
+	slices.SortFunc(target.Items, func(i, j *CredentialIssuance) int {
+		return j.CreatedTime.Compare(i.CreatedTime)
+	})
+	// Since we made at least 2 requests to the server to fulfill this
+	// function call, resp.Body and resp.Map will only contain the most recent
+	// response. Overwrite them with the true response.
+	target.response.Body.Reset()
+	if err := json.NewEncoder(target.response.Body).Encode(target); err != nil {
+		return nil, fmt.Errorf("error encoding final JSON list response: %w", err)
+	}
+	if err := json.Unmarshal(target.response.Body.Bytes(), &target.response.Map); err != nil {
+		return nil, fmt.Errorf("error encoding final map list response: %w", err)
+	}
+	return target, nil
+}
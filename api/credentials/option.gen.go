@@ -102,6 +102,42 @@ func DefaultAttributes() Option {
 	}
 }
 
+func WithTlsClientCertificateCredentialCaCertificate(inCaCertificate string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ca_certificate"] = inCaCertificate
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultTlsClientCertificateCredentialCaCertificate() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ca_certificate"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithTlsClientCertificateCredentialCertificate(inCertificate string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["certificate"] = inCertificate
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithDescription(inDescription string) Option {
 	return func(o *options) {
 		o.postMap["description"] = inDescription
@@ -114,6 +150,18 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithKubeconfigCredentialKubeconfig(inKubeconfig string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["kubeconfig"] = inKubeconfig
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -162,6 +210,18 @@ func WithSshPrivateKeyCredentialPrivateKey(inPrivateKey string) Option {
 	}
 }
 
+func WithTlsClientCertificateCredentialPrivateKey(inPrivateKey string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["private_key"] = inPrivateKey
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithSshPrivateKeyCredentialPrivateKeyPassphrase(inPrivateKeyPassphrase string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
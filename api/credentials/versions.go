@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// CredentialVersion describes one version of a static credential. A new
+// version is recorded each time the credential is created or updated.
+type CredentialVersion struct {
+	Version     uint32    `json:"version,omitempty"`
+	AuthorId    string    `json:"author_id,omitempty"`
+	CreatedTime time.Time `json:"created_time,omitempty"`
+}
+
+type CredentialVersionListResult struct {
+	Items    []*CredentialVersion
+	response *api.Response
+}
+
+func (n CredentialVersionListResult) GetItems() []*CredentialVersion {
+	return n.Items
+}
+
+func (n CredentialVersionListResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// ListVersions returns the versions of the static credential, newest first.
+func (c *Client) ListVersions(ctx context.Context, id string, opt ...Option) (*CredentialVersionListResult, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty id value passed into ListVersions request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("credentials/%s:list-versions", url.PathEscape(id)), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ListVersions request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListVersions call: %w", err)
+	}
+
+	target := new(CredentialVersionListResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListVersions response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// Rollback sets the attributes of the static credential back to those
+// recorded in toVersion. The rollback is recorded as a new version of the
+// credential.
+func (c *Client) Rollback(ctx context.Context, id string, toVersion, version uint32, opt ...Option) (*CredentialUpdateResult, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty id value passed into Rollback request")
+	}
+	if toVersion == 0 {
+		return nil, fmt.Errorf("zero toVersion value passed into Rollback request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	if version == 0 {
+		if !opts.withAutomaticVersioning {
+			return nil, errors.New("zero version number passed into Rollback request and automatic versioning not specified")
+		}
+		existingTarget, existingErr := c.Read(ctx, id, append([]Option{WithSkipCurlOutput(true)}, opt...)...)
+		if existingErr != nil {
+			if api.AsServerError(existingErr) != nil {
+				return nil, fmt.Errorf("error from controller when performing initial check-and-set read: %w", existingErr)
+			}
+			return nil, fmt.Errorf("error performing initial check-and-set read: %w", existingErr)
+		}
+		if existingTarget == nil {
+			return nil, errors.New("nil resource response found when performing initial check-and-set read")
+		}
+		if existingTarget.Item == nil {
+			return nil, errors.New("nil resource found when performing initial check-and-set read")
+		}
+		version = existingTarget.Item.Version
+	}
+
+	opts.postMap["version"] = version
+	opts.postMap["to_version"] = toVersion
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("credentials/%s:rollback", url.PathEscape(id)), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Rollback request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Rollback call: %w", err)
+	}
+
+	target := new(CredentialUpdateResult)
+	target.Item = new(Credential)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Rollback response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
@@ -115,6 +115,30 @@ func WithVaultCredentialStoreAddress(inAddress string) Option {
 	}
 }
 
+func WithFileCredentialStoreAgeIdentityPath(inAgeIdentityPath string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["age_identity_path"] = inAgeIdentityPath
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultFileCredentialStoreAgeIdentityPath() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["age_identity_path"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithAttributes(inAttributes map[string]interface{}) Option {
 	return func(o *options) {
 		o.postMap["attributes"] = inAttributes
@@ -127,6 +151,102 @@ func DefaultAttributes() Option {
 	}
 }
 
+func WithVaultCredentialStoreAuthMethod(inAuthMethod string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["auth_method"] = inAuthMethod
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialStoreAuthMethod() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["auth_method"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithVaultCredentialStoreAuthMountPath(inAuthMountPath string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["auth_mount_path"] = inAuthMountPath
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialStoreAuthMountPath() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["auth_mount_path"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithVaultCredentialStoreAuthRole(inAuthRole string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["auth_role"] = inAuthRole
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialStoreAuthRole() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["auth_role"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithVaultCredentialStoreAuthSecret(inAuthSecret string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["auth_secret"] = inAuthSecret
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialStoreAuthSecret() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["auth_secret"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultCredentialStoreCaCert(inCaCert string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -211,6 +331,30 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithStaticCredentialStoreExclusiveCheckout(inExclusiveCheckout bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["exclusive_checkout"] = inExclusiveCheckout
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultStaticCredentialStoreExclusiveCheckout() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["exclusive_checkout"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -247,6 +391,114 @@ func DefaultVaultCredentialStoreNamespace() Option {
 	}
 }
 
+func WithFileCredentialStorePath(inPath string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["path"] = inPath
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithStaticCredentialStoreRotateOnCheckin(inRotateOnCheckin bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["rotate_on_checkin"] = inRotateOnCheckin
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultStaticCredentialStoreRotateOnCheckin() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["rotate_on_checkin"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithStaticCredentialStoreRotationGracePeriod(inRotationGracePeriod uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["rotation_grace_period"] = inRotationGracePeriod
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultStaticCredentialStoreRotationGracePeriod() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["rotation_grace_period"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithStaticCredentialStoreRotationPeriod(inRotationPeriod uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["rotation_period"] = inRotationPeriod
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultStaticCredentialStoreRotationPeriod() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["rotation_period"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithStaticCredentialStoreRotator(inRotator string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["rotator"] = inRotator
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultStaticCredentialStoreRotator() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["rotator"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultCredentialStoreTlsServerName(inTlsServerName string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -23,6 +23,11 @@ type VaultCredentialStoreAttributes struct {
 	ClientCertificateKeyHmac string `json:"client_certificate_key_hmac,omitempty"`
 	WorkerFilter             string `json:"worker_filter,omitempty"`
 	TokenStatus              string `json:"token_status,omitempty"`
+	AuthMethod               string `json:"auth_method,omitempty"`
+	AuthMountPath            string `json:"auth_mount_path,omitempty"`
+	AuthRole                 string `json:"auth_role,omitempty"`
+	AuthSecret               string `json:"auth_secret,omitempty"`
+	AuthSecretHmac           string `json:"auth_secret_hmac,omitempty"`
 }
 
 func AttributesMapToVaultCredentialStoreAttributes(in map[string]interface{}) (*VaultCredentialStoreAttributes, error) {
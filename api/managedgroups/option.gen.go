@@ -126,6 +126,18 @@ func WithOidcManagedGroupFilter(inFilter string) Option {
 	}
 }
 
+func WithSamlManagedGroupFilter(inFilter string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["filter"] = inFilter
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithLdapManagedGroupGroupNames(inGroupNames []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
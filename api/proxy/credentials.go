@@ -13,6 +13,8 @@ import (
 const (
 	usernamePasswordCredentialType = "username_password"
 	sshPrivateKeyCredentialType    = "ssh_private_key"
+	tlsClientCertificateCredType   = "tls_client_certificate"
+	kubeconfigCredentialType       = "kubeconfig"
 )
 
 // UsernamePassword contains username and password credentials
@@ -39,15 +41,40 @@ type SshPrivateKey struct {
 	Consumed bool
 }
 
+// TlsClientCertificate contains a PEM encoded client certificate and private
+// key with an optional CA certificate bundle
+type TlsClientCertificate struct {
+	Certificate   string `mapstructure:"certificate"`
+	PrivateKey    string `mapstructure:"private_key"`
+	CaCertificate string `mapstructure:"ca_certificate"`
+
+	Raw *targets.SessionCredential
+	// Consumed can be set by the caller to indicate that the credential has
+	// been used, e.g. displayed to the user
+	Consumed bool
+}
+
+// Kubeconfig contains the contents of a Kubernetes client configuration file
+type Kubeconfig struct {
+	Kubeconfig string `mapstructure:"kubeconfig"`
+
+	Raw *targets.SessionCredential
+	// Consumed can be set by the caller to indicate that the credential has
+	// been used, e.g. displayed to the user
+	Consumed bool
+}
+
 type Credentials struct {
-	UsernamePassword []UsernamePassword
-	SshPrivateKey    []SshPrivateKey
+	UsernamePassword     []UsernamePassword
+	SshPrivateKey        []SshPrivateKey
+	TlsClientCertificate []TlsClientCertificate
+	Kubeconfig           []Kubeconfig
 	// Unspecified are credentials that do not match one of the types above
 	Unspecified []*targets.SessionCredential
 }
 
 func (c Credentials) UnconsumedSessionCredentials() []*targets.SessionCredential {
-	out := make([]*targets.SessionCredential, 0, len(c.SshPrivateKey)+len(c.UsernamePassword)+len(c.Unspecified))
+	out := make([]*targets.SessionCredential, 0, len(c.SshPrivateKey)+len(c.UsernamePassword)+len(c.TlsClientCertificate)+len(c.Kubeconfig)+len(c.Unspecified))
 
 	// Unspecified credentials cannot be consumed
 	out = append(out, c.Unspecified...)
@@ -62,6 +89,16 @@ func (c Credentials) UnconsumedSessionCredentials() []*targets.SessionCredential
 			out = append(out, c.Raw)
 		}
 	}
+	for _, c := range c.TlsClientCertificate {
+		if !c.Consumed {
+			out = append(out, c.Raw)
+		}
+	}
+	for _, c := range c.Kubeconfig {
+		if !c.Consumed {
+			out = append(out, c.Raw)
+		}
+	}
 	return out
 }
 
@@ -101,6 +138,30 @@ func ParseCredentials(creds []*targets.SessionCredential) (Credentials, error) {
 				out.SshPrivateKey = append(out.SshPrivateKey, spkCred)
 				continue
 			}
+
+		case tlsClientCertificateCredType:
+			var tlsCred TlsClientCertificate
+			if err := mapstructure.Decode(cred.Credential, &tlsCred); err != nil {
+				return Credentials{}, err
+			}
+
+			if tlsCred.Certificate != "" && tlsCred.PrivateKey != "" {
+				tlsCred.Raw = cred
+				out.TlsClientCertificate = append(out.TlsClientCertificate, tlsCred)
+				continue
+			}
+
+		case kubeconfigCredentialType:
+			var kcCred Kubeconfig
+			if err := mapstructure.Decode(cred.Credential, &kcCred); err != nil {
+				return Credentials{}, err
+			}
+
+			if kcCred.Kubeconfig != "" {
+				kcCred.Raw = cred
+				out.Kubeconfig = append(out.Kubeconfig, kcCred)
+				continue
+			}
 		}
 
 		// Credential type is unspecified, make a best effort attempt to parse
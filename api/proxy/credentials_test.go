@@ -39,6 +39,26 @@ var (
 		},
 	}
 
+	typedTlsClientCertificate = &targets.SessionCredential{
+		CredentialSource: &targets.CredentialSource{
+			CredentialType: tlsClientCertificateCredType,
+		},
+		Credential: map[string]any{
+			"certificate":    "my-cert",
+			"private_key":    "my-key",
+			"ca_certificate": "my-ca",
+		},
+	}
+
+	typedKubeconfig = &targets.SessionCredential{
+		CredentialSource: &targets.CredentialSource{
+			CredentialType: kubeconfigCredentialType,
+		},
+		Credential: map[string]any{
+			"kubeconfig": "my-kubeconfig",
+		},
+	}
+
 	vaultUsernamePasswordDeprecatedSubtype = &targets.SessionCredential{
 		CredentialSource: &targets.CredentialSource{
 			Type: vaultSubtype,
@@ -231,6 +251,38 @@ func Test_parseCredentials(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "tls-client-certificate-typed",
+			creds: []*targets.SessionCredential{
+				typedTlsClientCertificate,
+			},
+			wantCreds: Credentials{
+				TlsClientCertificate: []TlsClientCertificate{
+					{
+						Certificate:   "my-cert",
+						PrivateKey:    "my-key",
+						CaCertificate: "my-ca",
+						Raw:           typedTlsClientCertificate,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "kubeconfig-typed",
+			creds: []*targets.SessionCredential{
+				typedKubeconfig,
+			},
+			wantCreds: Credentials{
+				Kubeconfig: []Kubeconfig{
+					{
+						Kubeconfig: "my-kubeconfig",
+						Raw:        typedKubeconfig,
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "vault-username-password-decoded",
 			creds: []*targets.SessionCredential{
@@ -446,6 +498,8 @@ func Test_parseCredentials(t *testing.T) {
 
 			assert.ElementsMatch(tt.wantCreds.UsernamePassword, creds.UsernamePassword)
 			assert.ElementsMatch(tt.wantCreds.SshPrivateKey, creds.SshPrivateKey)
+			assert.ElementsMatch(tt.wantCreds.TlsClientCertificate, creds.TlsClientCertificate)
+			assert.ElementsMatch(tt.wantCreds.Kubeconfig, creds.Kubeconfig)
 			assert.ElementsMatch(tt.wantCreds.Unspecified, creds.Unspecified)
 		})
 	}
@@ -507,6 +561,23 @@ func Test_unconsumedSessionCredentials(t *testing.T) {
 			},
 			wantCreds: nil,
 		},
+		{
+			name: "tls-and-kubeconfig",
+			creds: Credentials{
+				TlsClientCertificate: []TlsClientCertificate{
+					{
+						Raw: typedTlsClientCertificate,
+					},
+				},
+				Kubeconfig: []Kubeconfig{
+					{
+						Raw:      typedKubeconfig,
+						Consumed: true,
+					},
+				},
+			},
+			wantCreds: []*targets.SessionCredential{typedTlsClientCertificate},
+		},
 		{
 			name: "Unspecified",
 			creds: Credentials{
@@ -145,6 +145,30 @@ func DefaultBrokeredCredentialSourceIds() Option {
 	}
 }
 
+func WithConnectionBandwidthLimit(inConnectionBandwidthLimit uint64) Option {
+	return func(o *options) {
+		o.postMap["connection_bandwidth_limit"] = inConnectionBandwidthLimit
+	}
+}
+
+func DefaultConnectionBandwidthLimit() Option {
+	return func(o *options) {
+		o.postMap["connection_bandwidth_limit"] = nil
+	}
+}
+
+func WithConnectionByteQuota(inConnectionByteQuota uint64) Option {
+	return func(o *options) {
+		o.postMap["connection_byte_quota"] = inConnectionByteQuota
+	}
+}
+
+func DefaultConnectionByteQuota() Option {
+	return func(o *options) {
+		o.postMap["connection_byte_quota"] = nil
+	}
+}
+
 func WithSshTargetDefaultClientPort(inDefaultClientPort uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -307,6 +331,30 @@ func DefaultInjectedApplicationCredentialSourceIds() Option {
 	}
 }
 
+func WithMaxActiveSessions(inMaxActiveSessions uint32) Option {
+	return func(o *options) {
+		o.postMap["max_active_sessions"] = inMaxActiveSessions
+	}
+}
+
+func DefaultMaxActiveSessions() Option {
+	return func(o *options) {
+		o.postMap["max_active_sessions"] = nil
+	}
+}
+
+func WithMaxActiveSessionsPerUser(inMaxActiveSessionsPerUser uint32) Option {
+	return func(o *options) {
+		o.postMap["max_active_sessions_per_user"] = inMaxActiveSessionsPerUser
+	}
+}
+
+func DefaultMaxActiveSessionsPerUser() Option {
+	return func(o *options) {
+		o.postMap["max_active_sessions_per_user"] = nil
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -331,6 +379,30 @@ func WithScopeName(inScopeName string) Option {
 	}
 }
 
+func WithSessionBandwidthLimit(inSessionBandwidthLimit uint64) Option {
+	return func(o *options) {
+		o.postMap["session_bandwidth_limit"] = inSessionBandwidthLimit
+	}
+}
+
+func DefaultSessionBandwidthLimit() Option {
+	return func(o *options) {
+		o.postMap["session_bandwidth_limit"] = nil
+	}
+}
+
+func WithSessionByteQuota(inSessionByteQuota uint64) Option {
+	return func(o *options) {
+		o.postMap["session_byte_quota"] = inSessionByteQuota
+	}
+}
+
+func DefaultSessionByteQuota() Option {
+	return func(o *options) {
+		o.postMap["session_byte_quota"] = nil
+	}
+}
+
 func WithSessionConnectionLimit(inSessionConnectionLimit int32) Option {
 	return func(o *options) {
 		o.postMap["session_connection_limit"] = inSessionConnectionLimit
@@ -9,6 +9,7 @@ import (
 )
 
 type SessionSecret struct {
-	Raw     json.RawMessage        `json:"raw,omitempty"`
-	Decoded map[string]interface{} `json:"decoded,omitempty"`
+	Raw      json.RawMessage        `json:"raw,omitempty"`
+	Decoded  map[string]interface{} `json:"decoded,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
 }
@@ -43,6 +43,12 @@ type Target struct {
 	Address                                string                 `json:"address,omitempty"`
 	Aliases                                []*Alias               `json:"aliases,omitempty"`
 	WithAliases                            []*Alias               `json:"with_aliases,omitempty"`
+	ConnectionBandwidthLimit               uint64                 `json:"connection_bandwidth_limit,string,omitempty"`
+	SessionBandwidthLimit                  uint64                 `json:"session_bandwidth_limit,string,omitempty"`
+	ConnectionByteQuota                    uint64                 `json:"connection_byte_quota,string,omitempty"`
+	SessionByteQuota                       uint64                 `json:"session_byte_quota,string,omitempty"`
+	MaxActiveSessions                      uint32                 `json:"max_active_sessions,omitempty"`
+	MaxActiveSessionsPerUser               uint32                 `json:"max_active_sessions_per_user,omitempty"`
 
 	response *api.Response
 }
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// RevokeAuthTokensResult contains the number of auth tokens revoked by a
+// RevokeAuthTokens call.
+type RevokeAuthTokensResult struct {
+	RevokedCount uint32 `json:"revoked_count,omitempty"`
+
+	response *api.Response
+}
+
+func (n RevokeAuthTokensResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// RevokeAuthTokens deletes every auth token issued to the user, across all of
+// the user's accounts.
+func (c *Client) RevokeAuthTokens(ctx context.Context, userId string, opt ...Option) (*RevokeAuthTokensResult, error) {
+	if userId == "" {
+		return nil, fmt.Errorf("empty userId value passed into RevokeAuthTokens request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in RevokeAuthTokens request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("users/%s:revoke-auth-tokens", userId), map[string]any{}, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RevokeAuthTokens request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during RevokeAuthTokens call: %w", err)
+	}
+
+	target := new(RevokeAuthTokensResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding RevokeAuthTokens response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
@@ -8,9 +8,11 @@ type CredentialType string
 
 // Credential type values.
 const (
-	UnspecifiedCredentialType      CredentialType = "unspecified"
-	UsernamePasswordCredentialType CredentialType = "username_password"
-	SshPrivateKeyCredentialType    CredentialType = "ssh_private_key"
-	SshCertificateCredentialType   CredentialType = "ssh_certificate"
-	JsonCredentialType             CredentialType = "json"
+	UnspecifiedCredentialType          CredentialType = "unspecified"
+	UsernamePasswordCredentialType     CredentialType = "username_password"
+	SshPrivateKeyCredentialType        CredentialType = "ssh_private_key"
+	SshCertificateCredentialType       CredentialType = "ssh_certificate"
+	JsonCredentialType                 CredentialType = "json"
+	TlsClientCertificateCredentialType CredentialType = "tls_client_certificate"
+	KubeconfigCredentialType           CredentialType = "kubeconfig"
 )
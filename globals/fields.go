@@ -123,4 +123,15 @@ const (
 	DestinationIdField                          = "destination_id"
 	ValueField                                  = "value"
 	WithAliasesField                            = "with_aliases"
+	AuthTokenTimeToLiveSecondsField             = "auth_token_time_to_live_seconds"
+	AuthTokenTimeToStaleSecondsField            = "auth_token_time_to_stale_seconds"
+	MaxAuthTokensPerUserField                   = "max_auth_tokens_per_user"
+	ConnectionBandwidthLimitField               = "connection_bandwidth_limit"
+	SessionBandwidthLimitField                  = "session_bandwidth_limit"
+	ConnectionByteQuotaField                    = "connection_byte_quota"
+	SessionByteQuotaField                       = "session_byte_quota"
+	MaxActiveSessionsField                      = "max_active_sessions"
+	MaxActiveSessionsPerUserField               = "max_active_sessions_per_user"
+	DrainDeadlineField                          = "drain_deadline"
+	DrainTimeoutSecondsField                    = "drain_timeout_seconds"
 )
@@ -12,4 +12,5 @@ const (
 	KmsPurposeConfig               = "config"
 	KmsPurposeDownstreamWorkerAuth = "downstream-worker-auth"
 	KmsPurposeBsr                  = "bsr"
+	KmsPurposeCredentialFile       = "credential-file"
 )
@@ -42,6 +42,21 @@ const (
 	// AccountPrefix defines the prefix for Account public ids.
 	LdapAccountPrefix = "acctldap"
 
+	// SamlAuthMethodPrefix defines the prefix for SAML AuthMethod public ids
+	SamlAuthMethodPrefix = "amsaml"
+	// SamlAccountPrefix defines the prefix for SAML Account public ids
+	SamlAccountPrefix = "acctsaml"
+	// SamlManagedGroupPrefix defines the prefix for SAML ManagedGroup public
+	// ids
+	SamlManagedGroupPrefix = "mgsaml"
+
+	// CertAuthMethodPrefix defines the prefix for client certificate
+	// AuthMethod public ids
+	CertAuthMethodPrefix = "amcert"
+	// CertAccountPrefix defines the prefix for client certificate Account
+	// public ids
+	CertAccountPrefix = "acctcert"
+
 	// ProjectPrefix is the prefix for project scopes
 	ProjectPrefix = "p"
 	// OrgPrefix is the prefix for org scopes
@@ -72,6 +87,11 @@ const (
 	// DynamicCredentialPrefix is the prefix for Vault dynamic credentials
 	VaultDynamicCredentialPrefix = "cdvlt"
 
+	// FileCredentialStorePrefix is the prefix for file credential stores
+	FileCredentialStorePrefix = "csfile"
+	// FileCredentialLibraryPrefix is the prefix for file credential libraries
+	FileCredentialLibraryPrefix = "clfile"
+
 	// UsernamePasswordCredentialPrefix is the prefix for username/password
 	// creds
 	UsernamePasswordCredentialPrefix = "credup"
@@ -82,6 +102,11 @@ const (
 	SshPrivateKeyCredentialPrefix = "credspk"
 	// JsonCredentialPrefix is the prefix for generic JSON creds
 	JsonCredentialPrefix = "credjson"
+	// TlsClientCertificateCredentialPrefix is the prefix for TLS client
+	// certificate creds
+	TlsClientCertificateCredentialPrefix = "credtls"
+	// KubeconfigCredentialPrefix is the prefix for kubeconfig creds
+	KubeconfigCredentialPrefix = "credkc"
 
 	// StaticHostCatalogPrefix is the prefix for static host catalogs
 	StaticHostCatalogPrefix = "hcst"
@@ -183,6 +208,28 @@ var prefixToResourceType = map[string]ResourceInfo{
 		Subtype: UnknownSubtype,
 	},
 
+	SamlAuthMethodPrefix: {
+		Type:    resource.AuthMethod,
+		Subtype: UnknownSubtype,
+	},
+	SamlAccountPrefix: {
+		Type:    resource.Account,
+		Subtype: UnknownSubtype,
+	},
+	SamlManagedGroupPrefix: {
+		Type:    resource.ManagedGroup,
+		Subtype: UnknownSubtype,
+	},
+
+	CertAuthMethodPrefix: {
+		Type:    resource.AuthMethod,
+		Subtype: UnknownSubtype,
+	},
+	CertAccountPrefix: {
+		Type:    resource.Account,
+		Subtype: UnknownSubtype,
+	},
+
 	ProjectPrefix: {
 		Type:    resource.Scope,
 		Subtype: UnknownSubtype,
@@ -240,6 +287,15 @@ var prefixToResourceType = map[string]ResourceInfo{
 		Subtype: UnknownSubtype,
 	},
 
+	FileCredentialStorePrefix: {
+		Type:    resource.CredentialStore,
+		Subtype: UnknownSubtype,
+	},
+	FileCredentialLibraryPrefix: {
+		Type:    resource.CredentialLibrary,
+		Subtype: UnknownSubtype,
+	},
+
 	UsernamePasswordCredentialPrefix: {
 		Type:    resource.Credential,
 		Subtype: UnknownSubtype,
@@ -256,6 +312,14 @@ var prefixToResourceType = map[string]ResourceInfo{
 		Type:    resource.Credential,
 		Subtype: UnknownSubtype,
 	},
+	TlsClientCertificateCredentialPrefix: {
+		Type:    resource.Credential,
+		Subtype: UnknownSubtype,
+	},
+	KubeconfigCredentialPrefix: {
+		Type:    resource.Credential,
+		Subtype: UnknownSubtype,
+	},
 
 	StaticHostCatalogPrefix: {
 		Type:    resource.HostCatalog,
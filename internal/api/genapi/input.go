@@ -397,6 +397,29 @@ var inputStructs = []*structInfo{
 		outFile:     "authmethods/oidc_auth_method_authenticate_start_response.gen.go",
 		subtypeName: "OidcAuthMethod",
 	},
+	{
+		inProto:        &authmethods.SamlAuthMethodAttributes{},
+		outFile:        "authmethods/saml_auth_method_attributes.gen.go",
+		subtypeName:    "SamlAuthMethod",
+		parentTypeName: "AuthMethod",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:     &authmethods.SamlAuthMethodAuthenticateStartResponse{},
+		outFile:     "authmethods/saml_auth_method_authenticate_start_response.gen.go",
+		subtypeName: "SamlAuthMethod",
+	},
+	{
+		inProto:        &authmethods.CertAuthMethodAttributes{},
+		outFile:        "authmethods/cert_auth_method_attributes.gen.go",
+		subtypeName:    "CertAuthMethod",
+		parentTypeName: "AuthMethod",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto: &authmethods.AuthMethod{},
 		outFile: "authmethods/authmethods.gen.go",
@@ -460,6 +483,24 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:        &accounts.SamlAccountAttributes{},
+		outFile:        "accounts/saml_account_attributes.gen.go",
+		subtypeName:    "SamlAccount",
+		parentTypeName: "Account",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:        &accounts.CertAccountAttributes{},
+		outFile:        "accounts/cert_account_attributes.gen.go",
+		subtypeName:    "CertAccount",
+		parentTypeName: "Account",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto: &accounts.Account{},
 		outFile: "accounts/account.gen.go",
@@ -507,6 +548,21 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:     &managedgroups.SamlManagedGroupAttributes{},
+		outFile:     "managedgroups/saml_managed_group_attributes.gen.go",
+		subtypeName: "SamlManagedGroup",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "Filter",
+				SkipDefault: true,
+			},
+		},
+		parentTypeName: "ManagedGroup",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto: &managedgroups.ManagedGroup{},
 		outFile: "managedgroups/managedgroups.gen.go",
@@ -547,6 +603,30 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:        &credentialstores.StaticCredentialStoreAttributes{},
+		outFile:        "credentialstores/static_credential_store_attributes.gen.go",
+		subtypeName:    "StaticCredentialStore",
+		parentTypeName: "CredentialStore",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:     &credentialstores.FileCredentialStoreAttributes{},
+		outFile:     "credentialstores/file_credential_store_attributes.gen.go",
+		subtypeName: "FileCredentialStore",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "Path",
+				SkipDefault: true,
+			},
+		},
+		parentTypeName: "CredentialStore",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto: &credentialstores.CredentialStore{},
 		outFile: "credentialstores/credential_store.gen.go",
@@ -637,6 +717,22 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:     &credentiallibraries.FileCredentialLibraryAttributes{},
+		outFile:     "credentiallibraries/file_credential_library_attributes.gen.go",
+		subtypeName: "FileCredentialLibrary",
+		subtype:     "file",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "Path",
+				SkipDefault: true,
+			},
+		},
+		parentTypeName: "CredentialLibrary",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto: &credentiallibraries.CredentialLibrary{},
 		outFile: "credentiallibraries/credential_library.gen.go",
@@ -712,6 +808,42 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:     &credentials.TlsClientCertificateAttributes{},
+		outFile:     "credentials/tls_client_certificate_attributes.gen.go",
+		subtypeName: "TlsClientCertificateCredential",
+		subtype:     "tls_client_certificate",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "Certificate",
+				SkipDefault: true,
+			},
+			{
+				Name:        "PrivateKey",
+				SkipDefault: true,
+			},
+		},
+		parentTypeName: "Credential",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:     &credentials.KubeconfigAttributes{},
+		outFile:     "credentials/kubeconfig_attributes.gen.go",
+		subtypeName: "KubeconfigCredential",
+		subtype:     "kubeconfig",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "Kubeconfig",
+				SkipDefault: true,
+			},
+		},
+		parentTypeName: "Credential",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto:     &credentials.JsonAttributes{},
 		outFile:     "credentials/json_attributes.gen.go",
@@ -1146,6 +1278,15 @@ var inputStructs = []*structInfo{
 				SkipDefault: true,
 			},
 		},
+		fieldOverrides: []fieldInfo{
+			// uint64 fields get marshalled by protobuf as strings, so we have
+			// to tell the json parser that their json representation is a
+			// string but they go into Go uint64 types.
+			{Name: "ConnectionBandwidthLimit", JsonTags: []string{"string"}},
+			{Name: "SessionBandwidthLimit", JsonTags: []string{"string"}},
+			{Name: "ConnectionByteQuota", JsonTags: []string{"string"}},
+			{Name: "SessionByteQuota", JsonTags: []string{"string"}},
+		},
 		versionEnabled:      true,
 		createResponseTypes: []string{CreateResponseType, ReadResponseType, UpdateResponseType, DeleteResponseType, ListResponseType},
 		recursiveListing:    true,
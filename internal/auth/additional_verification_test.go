@@ -260,6 +260,8 @@ func TestSelfReadingDifferentOutputFields(t *testing.T) {
 		tc.Controller().IamRepoFn,
 		tc.Controller().AuthTokenRepoFn,
 		tc.Controller().LdapRepoFn,
+		tc.Controller().SamlRepoFn,
+		tc.Controller().CertRepoFn,
 		tc.Controller().AuthMethodRepoFn,
 		1000,
 	)
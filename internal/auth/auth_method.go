@@ -31,7 +31,7 @@ type AuthMethodListQueryResult struct {
 	UpdateTime *timestamp.Timestamp
 	// Version of the auth method.
 	Version uint32
-	// Optionally set by ldap or oidc auth methods.
+	// Optionally set by ldap, oidc, saml or cert auth methods.
 	State string
 	Certs string
 	// Optionally set by ldap auth method.
@@ -57,19 +57,32 @@ type AuthMethodListQueryResult struct {
 	AccountAttributeMap      string
 	DereferenceAliases       string
 	MaximumPageSize          uint32
+	// Optionally set by oidc or saml auth methods.
+	KeyId  string
+	ApiUrl string
 	// Optionally set by oidc auth method.
 	DisableDiscoveredConfigValidation bool
 	Issuer                            string
 	ClientId                          string
 	ClientSecretHmac                  string
-	KeyId                             string
 	MaxAge                            int
 	Algs                              string
-	ApiUrl                            string
 	Auds                              string
 	ClaimsScopes                      string
 	AccountClaimMaps                  string
 	Prompts                           string
+	// Optionally set by saml auth method.
+	IdpEntityId          string
+	IdpSsoUrl            string
+	IdpCertificates      string
+	SpEntityId           string
+	SpCertificate        string
+	SignAuthnRequests    bool
+	NameIdFormat         string
+	AccountAttributeMaps string
+	// Optionally set by cert auth method.
+	CaCertificates string
+	AccountField   string
 	// Optionally set by password auth method.
 	PasswordConfId     string
 	MinLoginNameLength uint32
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cert
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	stderrors "errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/auth/cert/store"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultAuthMethodTableName defines the default table name for an AuthMethod
+const defaultAuthMethodTableName = "auth_cert_method"
+
+// AccountField defines the field of a client certificate which is mapped to
+// the subject of an account.
+type AccountField string
+
+const (
+	// SubjectCommonName maps the common name of the certificate's subject.
+	SubjectCommonName AccountField = "subject-cn"
+	// SubjectDistinguishedName maps the distinguished name of the
+	// certificate's subject.
+	SubjectDistinguishedName AccountField = "subject-dn"
+	// SanEmail maps the first email address subject alternative name.
+	SanEmail AccountField = "san-email"
+	// SanDns maps the first dns name subject alternative name.
+	SanDns AccountField = "san-dns"
+	// SanUri maps the first uri subject alternative name.
+	SanUri AccountField = "san-uri"
+)
+
+// IsValid returns an error if the account field is not supported.
+func (f AccountField) IsValid(ctx context.Context) error {
+	const op = "cert.(AccountField).IsValid"
+	switch f {
+	case SubjectCommonName, SubjectDistinguishedName, SanEmail, SanDns, SanUri:
+		return nil
+	default:
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%q is not a valid cert account field", f))
+	}
+}
+
+// AuthMethod contains a client certificate auth method configuration. It is
+// owned by a scope.  AuthMethods can have Accounts.  AuthMethods also have
+// one State at any given time which determines its behavior for many of its
+// operations.
+type AuthMethod struct {
+	*store.AuthMethod
+	tableName string
+}
+
+// NewAuthMethod creates a new in memory AuthMethod assigned to scopeId.
+//
+// State is not a supported parameter when creating new AuthMethod's unless the
+// AuthMethod is complete, since new AuthMethods are inactive by default.
+//
+// Supports the options of WithName, WithDescription, WithCaCertificates,
+// WithAccountField and WithOperationalState. All other options are ignored.
+func NewAuthMethod(ctx context.Context, scopeId string, opt ...Option) (*AuthMethod, error) {
+	const op = "cert.NewAuthMethod"
+	opts := getOpts(opt...)
+
+	a := &AuthMethod{
+		AuthMethod: &store.AuthMethod{
+			ScopeId:          scopeId,
+			Name:             opts.withName,
+			Description:      opts.withDescription,
+			OperationalState: string(opts.withOperationalState),
+			AccountField:     string(opts.withAccountField),
+		},
+	}
+	if len(opts.withCaCertificates) > 0 {
+		pems, err := EncodeCertificates(ctx, opts.withCaCertificates...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		a.CaCertificates = pems
+	}
+	if a.OperationalState != string(InactiveState) {
+		if err := a.isComplete(ctx); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("new auth method being created with incomplete data but non-inactive state"))
+		}
+	}
+	if err := a.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped.
+	}
+	return a, nil
+}
+
+// validate the AuthMethod.  On success, it will return nil.
+func (am *AuthMethod) validate(ctx context.Context, caller errors.Op) error {
+	if am.ScopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing scope id")
+	}
+	if !validState(am.OperationalState) {
+		return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("invalid state: %s", am.OperationalState))
+	}
+	if err := AccountField(am.AccountField).IsValid(ctx); err != nil {
+		return errors.Wrap(ctx, err, caller)
+	}
+	if am.CaCertificates != "" {
+		if _, err := ParseCertificates(ctx, am.CaCertificates); err != nil {
+			return errors.Wrap(ctx, err, caller)
+		}
+	}
+	return nil
+}
+
+// isComplete checks the auth method to see if it has all the required
+// components of a complete/valid cert auth method.
+func (am *AuthMethod) isComplete(ctx context.Context) error {
+	const op = "cert.(AuthMethod).isComplete"
+	var result error
+	if err := am.validate(ctx, op); err != nil {
+		result = stderrors.Join(result, errors.Wrap(ctx, err, op))
+	}
+	if am.CaCertificates == "" {
+		result = stderrors.Join(result, errors.New(ctx, errors.InvalidParameter, op, "missing ca certificates"))
+	}
+	return result
+}
+
+// AllocAuthMethod makes an empty one in memory
+func AllocAuthMethod() AuthMethod {
+	return AuthMethod{
+		AuthMethod: &store.AuthMethod{},
+	}
+}
+
+// Clone an AuthMethod.
+func (am *AuthMethod) Clone() *AuthMethod {
+	cp := proto.Clone(am.AuthMethod)
+	return &AuthMethod{
+		AuthMethod: cp.(*store.AuthMethod),
+	}
+}
+
+// TableName returns the table name.
+func (am *AuthMethod) TableName() string {
+	if am.tableName != "" {
+		return am.tableName
+	}
+	return defaultAuthMethodTableName
+}
+
+// SetTableName sets the table name.
+func (am *AuthMethod) SetTableName(n string) {
+	am.tableName = n
+}
+
+// GetResourceType returns the resource type of the AuthMethod
+func (am *AuthMethod) GetResourceType() resource.Type {
+	return resource.AuthMethod
+}
+
+// oplog will create oplog metadata for the AuthMethod.
+func (am *AuthMethod) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{am.GetPublicId()},
+		"resource-type":      []string{"cert auth method"},
+		"op-type":            []string{op.String()},
+		"scope-id":           []string{am.ScopeId},
+	}
+	return metadata
+}
+
+// EncodeCertificates encodes the certificates as a PEM bundle.
+func EncodeCertificates(ctx context.Context, certs ...*x509.Certificate) (string, error) {
+	const op = "cert.EncodeCertificates"
+	if len(certs) == 0 {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "no certs provided")
+	}
+	var bundle []byte
+	for _, cert := range certs {
+		if cert == nil {
+			return "", errors.New(ctx, errors.InvalidParameter, op, "nil cert")
+		}
+		bundle = append(bundle, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+	}
+	return string(bundle), nil
+}
+
+// ParseCertificates parses every certificate of the PEM bundles.
+func ParseCertificates(ctx context.Context, pems ...string) ([]*x509.Certificate, error) {
+	const op = "cert.ParseCertificates"
+	if len(pems) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no PEMs provided")
+	}
+	var certs []*x509.Certificate
+	for _, p := range pems {
+		rest := []byte(p)
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, errors.New(ctx, errors.InvalidParameter, op, "failed to parse certificate: "+err.Error(), errors.WithWrap(err))
+			}
+			certs = append(certs, cert)
+		}
+	}
+	if len(certs) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "failed to parse certificate PEM")
+	}
+	return certs, nil
+}
+
+type deletedAuthMethod struct {
+	PublicId   string `gorm:"primary_key"`
+	DeleteTime *timestamp.Timestamp
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (s *deletedAuthMethod) TableName() string {
+	return "auth_cert_method_deleted"
+}
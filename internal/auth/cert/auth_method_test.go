@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cert
+
+import (
+	"context"
+	"crypto/x509"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuthMethod(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ca := NewTestCa(t)
+	caPem, err := EncodeCertificates(ctx, ca.Cert)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		scopeId         string
+		opts            []Option
+		want            *AuthMethod
+		wantErrMatch    *errors.Template
+		wantErrContains string
+	}{
+		{
+			name:    "defaults",
+			scopeId: "o_1234567890",
+			want: func() *AuthMethod {
+				am := AllocAuthMethod()
+				am.ScopeId = "o_1234567890"
+				am.OperationalState = string(InactiveState)
+				am.AccountField = string(SubjectCommonName)
+				return &am
+			}(),
+		},
+		{
+			name:    "all-options",
+			scopeId: "o_1234567890",
+			opts: []Option{
+				WithName("name"),
+				WithDescription("desc"),
+				WithCaCertificates(ca.Cert),
+				WithAccountField(SanEmail),
+				WithOperationalState(ActivePublicState),
+			},
+			want: func() *AuthMethod {
+				am := AllocAuthMethod()
+				am.ScopeId = "o_1234567890"
+				am.Name = "name"
+				am.Description = "desc"
+				am.CaCertificates = caPem
+				am.OperationalState = string(ActivePublicState)
+				am.AccountField = string(SanEmail)
+				return &am
+			}(),
+		},
+		{
+			name:            "missing-scope",
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing scope id",
+		},
+		{
+			name:            "invalid-account-field",
+			scopeId:         "o_1234567890",
+			opts:            []Option{WithAccountField("subject-ou")},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: `"subject-ou" is not a valid cert account field`,
+		},
+		{
+			name:            "active-without-ca-certificates",
+			scopeId:         "o_1234567890",
+			opts:            []Option{WithOperationalState(ActivePrivateState)},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing ca certificates",
+		},
+		{
+			name:            "nil-ca-certificate",
+			scopeId:         "o_1234567890",
+			opts:            []Option{WithCaCertificates((*x509.Certificate)(nil))},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "nil cert",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewAuthMethod(ctx, tc.scopeId, tc.opts...)
+			if tc.wantErrMatch != nil {
+				require.Error(err)
+				assert.Nil(got)
+				assert.Truef(errors.Match(tc.wantErrMatch, err), "want err code: %q got: %q", tc.wantErrMatch.Code, err)
+				assert.Contains(err.Error(), tc.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cert
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+func init() {
+	globals.RegisterPrefixToResourceInfo(globals.CertAuthMethodPrefix, resource.AuthMethod, auth.Domain, Subtype)
+	globals.RegisterPrefixToResourceInfo(globals.CertAccountPrefix, resource.Account, auth.Domain, Subtype)
+}
+
+const Subtype = globals.Subtype("cert")
+
+func newAuthMethodId(ctx context.Context) (string, error) {
+	const op = "cert.newAuthMethodId"
+	id, err := db.NewPublicId(ctx, globals.CertAuthMethodPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
+
+func newAccountId(ctx context.Context, authMethodId, sub string) (string, error) {
+	const op = "cert.newAccountId"
+	if authMethodId == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	if sub == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing subject")
+	}
+	id, err := db.NewPublicId(ctx, globals.CertAccountPrefix, db.WithPrngValues([]string{authMethodId, sub}))
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cert
+
+import (
+	"crypto/x509"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/pagination"
+)
+
+// getOpts - iterate the inbound Options and return a struct.
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName                string
+	withDescription         string
+	withLimit               int
+	withCaCertificates      []*x509.Certificate
+	withAccountField        AccountField
+	withEmail               string
+	withFullName            string
+	withUnauthenticatedUser bool
+	withPublicId            string
+	withOperationalState    AuthMethodState
+	withReader              db.Reader
+	withStartPageAfterItem  pagination.Item
+}
+
+func getDefaultOptions() options {
+	return options{
+		withOperationalState: InactiveState,
+		withAccountField:     SubjectCommonName,
+	}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithLimit provides an option to provide a limit.  Intentionally allowing
+// negative integers.   If WithLimit < 0, then unlimited results are returned.
+// If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithCaCertificates provides optional CA certificates which are used to
+// verify the client certificates presented to the API listener.
+func WithCaCertificates(certs ...*x509.Certificate) Option {
+	return func(o *options) {
+		o.withCaCertificates = certs
+	}
+}
+
+// WithAccountField provides an optional field of the client certificate which
+// is mapped to the subject of an account. Defaults to SubjectCommonName.
+func WithAccountField(f AccountField) Option {
+	return func(o *options) {
+		o.withAccountField = f
+	}
+}
+
+// WithEmail provides an optional email address for the account.
+func WithEmail(email string) Option {
+	return func(o *options) {
+		o.withEmail = email
+	}
+}
+
+// WithFullName provides an optional full name for the account.
+func WithFullName(n string) Option {
+	return func(o *options) {
+		o.withFullName = n
+	}
+}
+
+// WithUnauthenticatedUser provides an option for filtering results for
+// an unauthenticated users.
+func WithUnauthenticatedUser(enabled bool) Option {
+	return func(o *options) {
+		o.withUnauthenticatedUser = enabled
+	}
+}
+
+// WithPublicId provides an option for passing a public id to the operation
+func WithPublicId(publicId string) Option {
+	return func(o *options) {
+		o.withPublicId = publicId
+	}
+}
+
+// WithOperationalState provides an option for specifying a state.
+func WithOperationalState(state AuthMethodState) Option {
+	return func(o *options) {
+		o.withOperationalState = state
+	}
+}
+
+// WithReader provides an option for specifying a reader to use for the
+// operation.
+func WithReader(reader db.Reader) Option {
+	return func(o *options) {
+		o.withReader = reader
+	}
+}
+
+// WithStartPageAfterItem is used to paginate over the results.
+// The next page will start after the provided item.
+func WithStartPageAfterItem(item pagination.Item) Option {
+	return func(o *options) {
+		o.withStartPageAfterItem = item
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cert
+
+const (
+	acctUpsertQuery = `
+	insert into auth_cert_account
+			(%s)
+	values
+			(%s)
+	on conflict on constraint
+			auth_cert_account_auth_method_id_subject_uq
+	do update set
+			%s
+	returning public_id, version
+       `
+
+	estimateCountAccounts = `
+	select sum(reltuples::bigint) as estimate from pg_class where oid in ('auth_cert_account'::regclass)
+	`
+)
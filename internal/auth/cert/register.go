@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cert
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/auth"
+)
+
+func init() {
+	auth.RegisterAuthMethodSubtype("cert", &authMethodHooks{})
+}
+
+type authMethodHooks struct{}
+
+// NewAuthMethod creates a new cert auth method from the result
+func (authMethodHooks) NewAuthMethod(ctx context.Context, result *auth.AuthMethodListQueryResult) (auth.AuthMethod, error) {
+	am := AllocAuthMethod()
+	am.PublicId = result.PublicId
+	am.ScopeId = result.ScopeId
+	am.IsPrimaryAuthMethod = result.IsPrimaryAuthMethod
+	am.Name = result.Name
+	am.Description = result.Description
+	am.CreateTime = result.CreateTime
+	am.UpdateTime = result.UpdateTime
+	am.Version = result.Version
+	am.OperationalState = result.State
+	am.CaCertificates = result.CaCertificates
+	am.AccountField = result.AccountField
+
+	return &am, nil
+}
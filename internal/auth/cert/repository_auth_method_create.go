@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cert
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// CreateAuthMethod creates am (*AuthMethod) in the repo and returns the newly
+// created AuthMethod (with its PublicId set).
+//
+// The AuthMethod's public id and version must be empty (zero values).
+//
+// Supports WithPublicId; all other options are ignored.
+func (r *Repository) CreateAuthMethod(ctx context.Context, am *AuthMethod, opt ...Option) (*AuthMethod, error) {
+	const op = "cert.(Repository).CreateAuthMethod"
+	if am == nil || am.AuthMethod == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if am.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id must be empty")
+	}
+	if am.Version != 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "version must be empty")
+	}
+	if err := am.validate(ctx, op); err != nil {
+		return nil, err // validate properly sets the op to the caller, the code and the msg, so just return it.
+	}
+
+	opts := getOpts(opt...)
+	am.PublicId = opts.withPublicId
+	if am.PublicId == "" {
+		id, err := newAuthMethodId(ctx)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		am.PublicId = id
+	} else {
+		if !strings.HasPrefix(am.PublicId, globals.CertAuthMethodPrefix+"_") {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "wrong auth method id prefix")
+		}
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var returnedAuthMethod *AuthMethod
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			returnedAuthMethod = am.Clone()
+			if err := w.Create(ctx, returnedAuthMethod, db.WithOplog(oplogWrapper, am.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return err
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.New(ctx, errors.NotUnique, op, "name already exists in scope "+am.ScopeId)
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return returnedAuthMethod, nil
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cert
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/cert/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// LookupAuthMethod will lookup an auth method in the repo. If it's not found,
+// it will return nil, nil.  The WithUnauthenticatedUser options is supported
+// and all other options are ignored.
+func (r *Repository) LookupAuthMethod(ctx context.Context, publicId string, opt ...Option) (*AuthMethod, error) {
+	const op = "cert.(Repository).LookupAuthMethod"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	opts := getOpts(opt...)
+	return r.lookupAuthMethod(ctx, publicId, WithUnauthenticatedUser(opts.withUnauthenticatedUser))
+}
+
+// lookupAuthMethod will lookup a single auth method
+func (r *Repository) lookupAuthMethod(ctx context.Context, authMethodId string, opt ...Option) (*AuthMethod, error) {
+	const op = "cert.(Repository).lookupAuthMethod"
+	ams, err := r.getAuthMethods(ctx, authMethodId, nil, opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	switch {
+	case len(ams) == 0:
+		return nil, nil // not an error to return no rows for a "lookup"
+	case len(ams) > 1:
+		return nil, errors.New(ctx, errors.NotSpecificIntegrity, op, fmt.Sprintf("%s matched more than 1 ", authMethodId))
+	default:
+		return ams[0], nil
+	}
+}
+
+// getAuthMethods allows the caller to either lookup a specific AuthMethod via
+// its id or search for a set AuthMethods within a set of scopes.  Passing both
+// scopeIds and a authMethod is an error. The WithUnauthenticatedUser and
+// WithLimit options are supported and all other options are ignored.
+//
+// The AuthMethod returned has its IsPrimaryAuthMethod bool populated.
+//
+// When no record is found it returns nil, nil
+func (r *Repository) getAuthMethods(ctx context.Context, authMethodId string, scopeIds []string, opt ...Option) ([]*AuthMethod, error) {
+	const op = "cert.(Repository).getAuthMethods"
+	if authMethodId == "" && len(scopeIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing search criteria: both auth method id and Scope IDs are empty")
+	}
+	if authMethodId != "" && len(scopeIds) > 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "searching for both an auth method id and Scope IDs is not supported")
+	}
+
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+
+	var args []any
+	var where []string
+	switch {
+	case authMethodId != "":
+		where, args = append(where, "public_id = ?"), append(args, authMethodId)
+	default:
+		where, args = append(where, "scope_id in(?)"), append(args, scopeIds)
+	}
+
+	if opts.withUnauthenticatedUser {
+		where, args = append(where, "state = ?"), append(args, string(ActivePublicState))
+	}
+
+	var views []*authMethodView
+	err := r.reader.SearchWhere(ctx, &views, strings.Join(where, " and "), args, db.WithLimit(limit))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	if len(views) == 0 { // we're done if nothing is found.
+		return nil, nil
+	}
+	authMethods := make([]*AuthMethod, 0, len(views))
+	for _, v := range views {
+		authMethods = append(authMethods, &AuthMethod{AuthMethod: v.AuthMethod})
+	}
+	return authMethods, nil
+}
+
+// authMethodView provides a simple way to read an AuthMethod with its
+// IsPrimaryAuthMethod field set.  By definition, it's used only for reading
+// AuthMethods.
+type authMethodView struct {
+	*store.AuthMethod
+	tableName string
+}
+
+// TableName returns the view name.
+func (a *authMethodView) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return "cert_auth_method_with_is_primary"
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cert
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-dbw"
+)
+
+const (
+	OperationalStateField = "OperationalState"
+	VersionField          = "Version"
+	NameField             = "Name"
+	DescriptionField      = "Description"
+	CaCertificatesField   = "CaCertificates"
+	AccountFieldField     = "AccountField"
+)
+
+// UpdateAuthMethod will retrieve the auth method from the repository,
+// and update it based on the field masks provided.
+//
+// The auth method will not be persisted in the repository if the auth
+// method's OperationalStatus is currently ActivePublic or ActivePrivate
+// and the update would have resulted in an incomplete/non-operational
+// auth method.
+//
+// fieldMaskPaths provides field_mask.proto paths for fields that should
+// be updated.  Fields will be set to NULL if the field is a
+// zero value and included in fieldMask. Name, Description, CaCertificates and
+// AccountField are all updatable fields. Changing the AccountField does not
+// change the subjects of existing accounts. If no updatable fields are
+// included in the fieldMaskPaths, then an error is returned.
+//
+// No options are currently supported.
+func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, version uint32, fieldMaskPaths []string, _ ...Option) (*AuthMethod, int, error) {
+	const op = "cert.(Repository).UpdateAuthMethod"
+	if am == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if am.AuthMethod == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing auth method store")
+	}
+	if am.PublicId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+
+	if err := validateFieldMask(ctx, fieldMaskPaths); err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
+	dbMask, nullFields := dbw.BuildUpdatePaths(
+		map[string]any{
+			NameField:           am.Name,
+			DescriptionField:    am.Description,
+			CaCertificatesField: am.CaCertificates,
+			AccountFieldField:   am.AccountField,
+		},
+		fieldMaskPaths,
+		nil,
+	)
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+	}
+	for _, f := range nullFields {
+		if f == AccountFieldField {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "account field cannot be unset")
+		}
+	}
+
+	origAm, err := r.lookupAuthMethod(ctx, am.PublicId)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if origAm == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s", am.PublicId))
+	}
+	// there's no reason to continue if another controller has already updated this auth method.
+	if origAm.Version != version {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.VersionMismatch, op, fmt.Sprintf("update version %d doesn't match db version %d", version, origAm.Version))
+	}
+
+	updated := applyUpdate(am, origAm, fieldMaskPaths)
+	if err := updated.validate(ctx, op); err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	// prevent an "active" auth method from being updated in a manner that would create
+	// an incomplete and unusable auth method.
+	if origAm.OperationalState != string(InactiveState) {
+		if err := updated.isComplete(ctx); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("update would result in an incomplete auth method"))
+		}
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, origAm.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var updatedAm *AuthMethod
+	var rowsUpdated int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			updatedAm = am.Clone()
+			rowsUpdated, err = w.Update(ctx, updatedAm, dbMask, nullFields, db.WithOplog(oplogWrapper, updatedAm.oplog(oplog.OpType_OP_TYPE_UPDATE)), db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update auth method"))
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated auth method and %d rows updated", rowsUpdated))
+			}
+			// we need a new repo, that's using the same reader/writer as this TxHandler
+			txRepo := &Repository{
+				reader: reader,
+				writer: w,
+				kms:    r.kms,
+			}
+			updatedAm, err = txRepo.lookupAuthMethod(ctx, updatedAm.PublicId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup auth method after update"))
+			}
+			if updatedAm == nil {
+				return errors.New(ctx, errors.RecordNotFound, op, "unable to lookup auth method after update")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("name %s already exists: %s", am.Name, am.PublicId))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	return updatedAm, rowsUpdated, nil
+}
+
+// validateFieldMask check the field mask to ensure all the fields are updatable
+func validateFieldMask(ctx context.Context, fieldMaskPaths []string) error {
+	const op = "cert.validateFieldMask"
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(NameField, f):
+		case strings.EqualFold(DescriptionField, f):
+		case strings.EqualFold(CaCertificatesField, f):
+		case strings.EqualFold(AccountFieldField, f):
+		default:
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid field mask: %s", f))
+		}
+	}
+	return nil
+}
+
+// applyUpdate takes the new and applies it to the orig using the field masks
+func applyUpdate(new, orig *AuthMethod, fieldMaskPaths []string) *AuthMethod {
+	cp := orig.Clone()
+	for _, f := range fieldMaskPaths {
+		switch f {
+		case NameField:
+			cp.Name = new.Name
+		case DescriptionField:
+			cp.Description = new.Description
+		case CaCertificatesField:
+			cp.CaCertificates = new.CaCertificates
+		case AccountFieldField:
+			cp.AccountField = new.AccountField
+		}
+	}
+	return cp
+}
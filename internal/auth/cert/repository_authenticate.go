@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cert
+
+import (
+	"context"
+	"crypto/x509"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+)
+
+// Authenticate verifies the client certificate chain against the CA
+// certificates of the auth method and maps the auth method's account field of
+// the leaf certificate to an account.  The account is created on the first
+// successful authentication and its full name and email are updated on every
+// successful authentication after that.
+//
+// The chain must start with the leaf certificate presented by the client,
+// followed by any intermediate certificates. The auth method must not be
+// inactive.
+func (r *Repository) Authenticate(ctx context.Context, authMethodId string, chain []*x509.Certificate) (*Account, error) {
+	const op = "cert.(Repository).Authenticate"
+	switch {
+	case authMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case len(chain) == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing client certificate")
+	}
+
+	am, err := r.lookupAuthMethod(ctx, authMethodId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s not found", authMethodId))
+	}
+	if am.OperationalState == string(InactiveState) {
+		return nil, errors.New(ctx, errors.AuthMethodInactive, op, "not allowed to authenticate with an inactive auth method")
+	}
+
+	leaf, err := am.verifyChain(ctx, chain, time.Now())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	sub, err := accountSubject(ctx, AccountField(am.AccountField), leaf)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var email string
+	if len(leaf.EmailAddresses) > 0 {
+		email = leaf.EmailAddresses[0]
+	}
+	acct, err := r.upsertAccount(ctx, am, sub, leaf.Subject.CommonName, email)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return acct, nil
+}
+
+// verifyChain verifies that the leaf certificate of the chain was issued for
+// client authentication by one of the auth method's CA certificates, using
+// the rest of the chain as intermediates.  It returns the leaf certificate.
+func (am *AuthMethod) verifyChain(ctx context.Context, chain []*x509.Certificate, now time.Time) (*x509.Certificate, error) {
+	const op = "cert.(AuthMethod).verifyChain"
+	if len(chain) == 0 || chain[0] == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing client certificate")
+	}
+	cas, err := ParseCertificates(ctx, am.CaCertificates)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	roots := x509.NewCertPool()
+	for _, ca := range cas {
+		roots.AddCert(ca)
+	}
+	intermediates := x509.NewCertPool()
+	for _, c := range chain[1:] {
+		if c != nil {
+			intermediates.AddCert(c)
+		}
+	}
+	if _, err := chain[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return nil, errors.New(ctx, errors.Unauthorized, op, "unable to verify client certificate", errors.WithWrap(err))
+	}
+	return chain[0], nil
+}
+
+// accountSubject returns the value of the account field of the certificate.
+func accountSubject(ctx context.Context, f AccountField, c *x509.Certificate) (string, error) {
+	const op = "cert.accountSubject"
+	if c == nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing certificate")
+	}
+	var sub string
+	switch f {
+	case SubjectCommonName:
+		sub = c.Subject.CommonName
+	case SubjectDistinguishedName:
+		sub = c.Subject.String()
+	case SanEmail:
+		if len(c.EmailAddresses) > 0 {
+			sub = c.EmailAddresses[0]
+		}
+	case SanDns:
+		if len(c.DNSNames) > 0 {
+			sub = c.DNSNames[0]
+		}
+	case SanUri:
+		if len(c.URIs) > 0 {
+			sub = c.URIs[0].String()
+		}
+	default:
+		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid account field: %s", f))
+	}
+	if sub == "" {
+		return "", errors.New(ctx, errors.Unauthorized, op, fmt.Sprintf("client certificate has no %s", f))
+	}
+	return sub, nil
+}
+
+// upsertAccount will create/update the account with the subject, full name
+// and email of a verified client certificate.
+func (r *Repository) upsertAccount(ctx context.Context, am *AuthMethod, sub, fullName, email string) (*Account, error) {
+	const op = "cert.(Repository).upsertAccount"
+	if am == nil || am.AuthMethod == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if sub == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing subject")
+	}
+	pubId, err := newAccountId(ctx, am.GetPublicId(), sub)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	columns := []string{"public_id", "auth_method_id", "subject"}
+	values := []any{
+		sql.Named("1", pubId),
+		sql.Named("2", am.PublicId),
+		sql.Named("3", sub),
+	}
+	var conflictClauses, fieldMasks, nullMasks []string
+	if fullName != "" {
+		columns, values = append(columns, "full_name"), append(values, sql.Named(fmt.Sprintf("%d", len(values)+1), fullName))
+		conflictClauses = append(conflictClauses, fmt.Sprintf("full_name = @%d", len(values)))
+		fieldMasks = append(fieldMasks, "FullName")
+	} else {
+		conflictClauses = append(conflictClauses, "full_name = NULL")
+		nullMasks = append(nullMasks, "FullName")
+	}
+	if email != "" {
+		columns, values = append(columns, "email"), append(values, sql.Named(fmt.Sprintf("%d", len(values)+1), email))
+		conflictClauses = append(conflictClauses, fmt.Sprintf("email = @%d", len(values)))
+		fieldMasks = append(fieldMasks, "Email")
+	} else {
+		conflictClauses = append(conflictClauses, "email = NULL")
+		nullMasks = append(nullMasks, "Email")
+	}
+
+	placeHolders := make([]string, 0, len(columns))
+	for colNum := range columns {
+		placeHolders = append(placeHolders, fmt.Sprintf("@%d", colNum+1))
+	}
+	query := fmt.Sprintf(acctUpsertQuery, strings.Join(columns, ", "), strings.Join(placeHolders, ", "), strings.Join(conflictClauses, ", "))
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	updatedAcct := AllocAccount()
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			rows, err := w.Query(ctx, query, values)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to insert/update auth cert account"))
+			}
+			defer rows.Close()
+			result := struct {
+				PublicId string
+				Version  int
+			}{}
+			var rowCnt int
+			for rows.Next() {
+				rowCnt += 1
+				if err := r.reader.ScanRows(ctx, rows, &result); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to scan rows for account"))
+				}
+			}
+			if err := rows.Err(); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get next rows for account"))
+			}
+			if rowCnt > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("expected 1 row but got: %d", rowCnt))
+			}
+			if err := reader.LookupWhere(ctx, &updatedAcct, "auth_method_id = ? and subject = ?", []any{am.PublicId, sub}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to look up auth cert account for: %s / %s", am.PublicId, sub)))
+			}
+			// include the version incase of predictable account public ids based on a calculation using authmethod id and subject
+			if result.Version == 1 && updatedAcct.PublicId == pubId {
+				if err := upsertOplog(ctx, w, oplogWrapper, oplog.OpType_OP_TYPE_CREATE, am.ScopeId, updatedAcct, nil, nil); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write create oplog for account"))
+				}
+				return nil
+			}
+			acctForOplog := AllocAccount()
+			acctForOplog.PublicId = updatedAcct.PublicId
+			acctForOplog.Email = email
+			acctForOplog.FullName = fullName
+			if err := upsertOplog(ctx, w, oplogWrapper, oplog.OpType_OP_TYPE_UPDATE, am.ScopeId, acctForOplog, fieldMasks, nullMasks); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write update oplog for account"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return updatedAcct, nil
+}
+
+// upsertOplog will write oplog msgs for account upserts. The db.Writer needs to be the writer for the current
+// transaction that's executing the upsert. Both fieldMasks and nullMasks are allowed to be nil for update operations.
+func upsertOplog(ctx context.Context, w db.Writer, oplogWrapper wrapping.Wrapper, operation oplog.OpType, scopeId string, acct *Account, fieldMasks, nullMasks []string) error {
+	const op = "cert.upsertOplog"
+	if w == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing db writer")
+	}
+	if oplogWrapper == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing oplog wrapper")
+	}
+	if operation != oplog.OpType_OP_TYPE_CREATE && operation != oplog.OpType_OP_TYPE_UPDATE {
+		return errors.New(ctx, errors.Internal, op, fmt.Sprintf("not a supported operation: %s", operation))
+	}
+	if scopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	if acct == nil || acct.Account == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing account")
+	}
+	if operation == oplog.OpType_OP_TYPE_UPDATE && len(fieldMasks) == 0 && len(nullMasks) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "update operations must specify field masks and/or null masks")
+	}
+	ticket, err := w.GetTicket(ctx, acct)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+	}
+	msg := oplog.Message{
+		Message:        acct,
+		TypeName:       acct.TableName(),
+		OpType:         operation,
+		FieldMaskPaths: fieldMasks,
+		SetToNullPaths: nullMasks,
+	}
+	if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, acct.oplog(operation, scopeId), []*oplog.Message{&msg}); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cert
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthMethod_verifyChain(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ca := NewTestCa(t)
+	otherCa := NewTestCa(t)
+	caPem, err := EncodeCertificates(ctx, ca.Cert)
+	require.NoError(t, err)
+	am := AllocAuthMethod()
+	am.CaCertificates = caPem
+
+	valid, _ := ca.ClientCertificate(pkix.Name{CommonName: "alice"})
+	untrusted, _ := otherCa.ClientCertificate(pkix.Name{CommonName: "alice"})
+	serverOnly, _ := ca.ClientCertificate(pkix.Name{CommonName: "alice"}, func(c *x509.Certificate) {
+		c.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	})
+	expired, _ := ca.ClientCertificate(pkix.Name{CommonName: "alice"}, func(c *x509.Certificate) {
+		c.NotBefore = time.Now().Add(-2 * time.Hour)
+		c.NotAfter = time.Now().Add(-time.Hour)
+	})
+
+	tests := []struct {
+		name         string
+		chain        []*x509.Certificate
+		wantErrMatch *errors.Template
+	}{
+		{name: "valid", chain: []*x509.Certificate{valid}},
+		{name: "missing-chain", wantErrMatch: errors.T(errors.InvalidParameter)},
+		{name: "untrusted", chain: []*x509.Certificate{untrusted}, wantErrMatch: errors.T(errors.Unauthorized)},
+		{name: "not-for-client-auth", chain: []*x509.Certificate{serverOnly}, wantErrMatch: errors.T(errors.Unauthorized)},
+		{name: "expired", chain: []*x509.Certificate{expired}, wantErrMatch: errors.T(errors.Unauthorized)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := am.verifyChain(ctx, tc.chain, time.Now())
+			if tc.wantErrMatch != nil {
+				require.Error(err)
+				assert.Nil(got)
+				assert.Truef(errors.Match(tc.wantErrMatch, err), "want err code: %q got: %q", tc.wantErrMatch.Code, err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.chain[0], got)
+		})
+	}
+}
+
+func Test_accountSubject(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ca := NewTestCa(t)
+	u, err := url.Parse("spiffe://example.org/automation/deployer")
+	require.NoError(t, err)
+	c, _ := ca.ClientCertificate(pkix.Name{CommonName: "deployer", Organization: []string{"example"}}, func(c *x509.Certificate) {
+		c.EmailAddresses = []string{"deployer@example.org", "ops@example.org"}
+		c.DNSNames = []string{"deployer.example.org"}
+		c.URIs = []*url.URL{u}
+	})
+	bare, _ := ca.ClientCertificate(pkix.Name{CommonName: "bare"})
+
+	tests := []struct {
+		name         string
+		field        AccountField
+		cert         *x509.Certificate
+		want         string
+		wantErrMatch *errors.Template
+	}{
+		{name: "subject-cn", field: SubjectCommonName, cert: c, want: "deployer"},
+		{name: "subject-dn", field: SubjectDistinguishedName, cert: c, want: "CN=deployer,O=example"},
+		{name: "san-email", field: SanEmail, cert: c, want: "deployer@example.org"},
+		{name: "san-dns", field: SanDns, cert: c, want: "deployer.example.org"},
+		{name: "san-uri", field: SanUri, cert: c, want: "spiffe://example.org/automation/deployer"},
+		{name: "missing-san", field: SanEmail, cert: bare, wantErrMatch: errors.T(errors.Unauthorized)},
+		{name: "invalid-field", field: "subject-ou", cert: c, wantErrMatch: errors.T(errors.InvalidParameter)},
+		{name: "missing-cert", field: SubjectCommonName, wantErrMatch: errors.T(errors.InvalidParameter)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := accountSubject(ctx, tc.field, tc.cert)
+			if tc.wantErrMatch != nil {
+				require.Error(err)
+				assert.Truef(errors.Match(tc.wantErrMatch, err), "want err code: %q got: %q", tc.wantErrMatch.Code, err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.want, got)
+		})
+	}
+}
+
+func TestRepository_Authenticate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	rootWrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, rootWrapper)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	iamRepo := iam.TestRepo(t, conn, rootWrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	ca := NewTestCa(t)
+	am := TestAuthMethod(t, conn, org.PublicId, ActivePublicState, WithCaCertificates(ca.Cert), WithAccountField(SanEmail))
+	inactiveAm := TestAuthMethod(t, conn, org.PublicId, InactiveState, WithCaCertificates(ca.Cert))
+
+	alice, _ := ca.ClientCertificate(pkix.Name{CommonName: "Alice Eve Smith"}, func(c *x509.Certificate) {
+		c.EmailAddresses = []string{"alice@example.org"}
+	})
+	untrusted, _ := NewTestCa(t).ClientCertificate(pkix.Name{CommonName: "Alice Eve Smith"}, func(c *x509.Certificate) {
+		c.EmailAddresses = []string{"alice@example.org"}
+	})
+
+	t.Run("creates-then-updates-account", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.Authenticate(ctx, am.PublicId, []*x509.Certificate{alice})
+		require.NoError(err)
+		assert.Equal("alice@example.org", got.Subject)
+		assert.Equal("Alice Eve Smith", got.FullName)
+		assert.Equal("alice@example.org", got.Email)
+
+		renamed, _ := ca.ClientCertificate(pkix.Name{CommonName: "Alice Smith"}, func(c *x509.Certificate) {
+			c.EmailAddresses = []string{"alice@example.org"}
+		})
+		again, err := repo.Authenticate(ctx, am.PublicId, []*x509.Certificate{renamed})
+		require.NoError(err)
+		assert.Equal(got.PublicId, again.PublicId)
+		assert.Equal("Alice Smith", again.FullName)
+	})
+	t.Run("untrusted", func(t *testing.T) {
+		_, err := repo.Authenticate(ctx, am.PublicId, []*x509.Certificate{untrusted})
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.Unauthorized), err))
+	})
+	t.Run("inactive", func(t *testing.T) {
+		_, err := repo.Authenticate(ctx, inactiveAm.PublicId, []*x509.Certificate{alice})
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.AuthMethodInactive), err))
+	})
+	t.Run("unknown-auth-method", func(t *testing.T) {
+		_, err := repo.Authenticate(ctx, "amcert_1234567890", []*x509.Certificate{alice})
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.RecordNotFound), err))
+	})
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cert
+
+import (
+	"context"
+	"crypto/x509"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/iam"
+)
+
+type (
+	// AuthenticatorFactory is used by "service functions" to create a new
+	// cert.Authenticator (typically a cert.Repository)
+	AuthenticatorFactory func() (Authenticator, error)
+
+	// LookupUserFactory is used by "service functions" to create a new
+	// LookupUser (typically an iam repo)
+	LookupUserFactory func() (LookupUser, error)
+
+	// AuthTokenCreatorFactory is used by "service functions" to create a new
+	// AuthTokenCreator (typically an auth token repo)
+	AuthTokenCreatorFactory func() (AuthTokenCreator, error)
+)
+
+// Authenticate is a cert domain service function for handling a client
+// certificate authentication flow. On success, it returns an auth token.
+//
+// The service operation includes:
+//   - Verify the client certificate chain against the auth method's CA certificates and upsert the matching Account.
+//   - Use iam.(Repository).LookupUserWithLogin(...) look up the iam.User matching the Account.
+//   - Use the authtoken.(Repository).CreateAuthToken(...) to create an auth token for the authenticated user.
+func Authenticate(
+	ctx context.Context,
+	authenticatorFn AuthenticatorFactory,
+	lookupUserFn LookupUserFactory,
+	tokenCreatorFn AuthTokenCreatorFactory,
+	authMethodId string,
+	chain []*x509.Certificate,
+) (*authtoken.AuthToken, error) {
+	const op = "cert.Authenticate"
+	switch {
+	case authenticatorFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing authenticator factory")
+	case lookupUserFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing lookup user factory")
+	case tokenCreatorFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth token creator factory")
+	case authMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case len(chain) == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing client certificate")
+	}
+
+	r, err := authenticatorFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	acct, err := r.Authenticate(ctx, authMethodId, chain)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	l, err := lookupUserFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	user, err := l.LookupUserWithLogin(ctx, acct.PublicId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	at, err := tokenCreatorFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	token, err := at.CreateAuthToken(ctx, user, acct.PublicId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := event.WriteObservation(ctx, op, event.WithDetails("user_id", user.GetPublicId(), "auth_token_start",
+		token.GetCreateTime(), "auth_token_end", token.GetExpirationTime())); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("Unable to write observation event for authenticate method"))
+	}
+	return token, nil
+}
+
+type Authenticator interface {
+	Authenticate(ctx context.Context, authMethodId string, chain []*x509.Certificate) (*Account, error)
+}
+
+type LookupUser interface {
+	LookupUserWithLogin(ctx context.Context, accountId string, opt ...iam.Option) (*iam.User, error)
+}
+
+type AuthTokenCreator interface {
+	CreateAuthToken(ctx context.Context, withIamUser *iam.User, withAuthAccountId string, opt ...authtoken.Option) (*authtoken.AuthToken, error)
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cert
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAuthMethod creates a test cert auth method.  WithName,
+// WithDescription, WithCaCertificates and WithAccountField options are
+// supported.
+func TestAuthMethod(
+	t testing.TB,
+	conn *db.DB,
+	scopeId string,
+	state AuthMethodState,
+	opt ...Option,
+) *AuthMethod {
+	t.Helper()
+	ctx := context.TODO()
+	require := require.New(t)
+	rw := db.New(conn)
+
+	authMethod, err := NewAuthMethod(ctx, scopeId, opt...)
+	require.NoError(err)
+	id, err := newAuthMethodId(ctx)
+	require.NoError(err)
+	authMethod.PublicId = id
+	require.NoError(rw.Create(ctx, authMethod))
+
+	authMethod.OperationalState = string(state)
+	rowsUpdated, err := rw.Update(ctx, authMethod, []string{OperationalStateField}, nil)
+	require.NoError(err)
+	require.True(rowsUpdated == 0 || rowsUpdated == 1)
+
+	return authMethod
+}
+
+// TestAccount creates a test cert auth account.
+func TestAccount(t testing.TB, conn *db.DB, am *AuthMethod, subject string, opt ...Option) *Account {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	ctx := context.Background()
+
+	a, err := NewAccount(ctx, am.PublicId, subject, opt...)
+	require.NoError(err)
+
+	id, err := newAccountId(ctx, am.GetPublicId(), subject)
+	require.NoError(err)
+	a.PublicId = id
+
+	require.NoError(rw.Create(ctx, a))
+	return a
+}
+
+// TestCa is a certificate authority which issues client certificates for
+// tests.
+type TestCa struct {
+	t    testing.TB
+	Cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// NewTestCa creates a self-signed test certificate authority.
+func NewTestCa(t testing.TB) *TestCa {
+	t.Helper()
+	require := require.New(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	template := &x509.Certificate{
+		SerialNumber:          testSerial(t),
+		Subject:               pkix.Name{CommonName: "boundary test ca"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(err)
+	c, err := x509.ParseCertificate(der)
+	require.NoError(err)
+	return &TestCa{t: t, Cert: c, key: key}
+}
+
+// ClientCertificate issues a client certificate for the subject.  The
+// optional modifiers are applied to the certificate template before it is
+// signed.
+func (ca *TestCa) ClientCertificate(subject pkix.Name, opt ...func(*x509.Certificate)) (*x509.Certificate, *ecdsa.PrivateKey) {
+	ca.t.Helper()
+	require := require.New(ca.t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	template := &x509.Certificate{
+		SerialNumber: testSerial(ca.t),
+		Subject:      subject,
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	for _, o := range opt {
+		o(template)
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.Cert, &key.PublicKey, ca.key)
+	require.NoError(err)
+	c, err := x509.ParseCertificate(der)
+	require.NoError(err)
+	return c, key
+}
+
+func testSerial(t testing.TB) *big.Int {
+	t.Helper()
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	require.NoError(t, err)
+	return serial
+}
@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ldap
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"math"
+	"net"
+	"net/url"
+	"text/template"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/hashicorp/boundary/internal/errors"
+	capldap "github.com/hashicorp/cap/ldap"
+)
+
+var derefAliasesMap = map[string]int{
+	string(NeverDerefAliases):   ldap.NeverDerefAliases,
+	string(DerefInSearching):    ldap.DerefInSearching,
+	string(DerefFindingBaseObj): ldap.DerefFindingBaseObj,
+	string(DerefAlways):         ldap.DerefAlways,
+}
+
+// directoryGroups looks up the groups of accounts in the directory of an auth
+// method without the account's password, by binding with the auth method's
+// bind credential (or anonymously when the auth method is configured for
+// anonymous group searches). Group names are resolved the same way they are
+// during Authenticate, so the results can be compared with an account's
+// MemberOfGroups.
+type directoryGroups struct {
+	am   *AuthMethod
+	conn *ldap.Conn
+}
+
+// newDirectoryGroups connects to the directory of the auth method and binds
+// with its bind credential. The connection must be closed by calling close.
+func newDirectoryGroups(ctx context.Context, am *AuthMethod) (*directoryGroups, error) {
+	const op = "ldap.newDirectoryGroups"
+	switch {
+	case am == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	case !am.EnableGroups:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "auth method does not have groups enabled")
+	case !am.AnonGroupSearch && am.BindDn == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "auth method has neither a bind credential nor anonymous group search")
+	case len(am.Urls) == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing urls")
+	}
+	conn, err := dialDirectory(ctx, am)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if am.BindDn != "" && !am.AnonGroupSearch {
+		if err := conn.Bind(am.BindDn, am.BindPassword); err != nil {
+			conn.Close()
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to bind with the bind credential"))
+		}
+	}
+	return &directoryGroups{am: am, conn: conn}, nil
+}
+
+// close closes the connection to the directory.
+func (d *directoryGroups) close() {
+	if d.conn != nil {
+		d.conn.Close()
+	}
+}
+
+// groups returns the current groups of the account in the directory.
+func (d *directoryGroups) groups(ctx context.Context, acct *Account) ([]string, error) {
+	const op = "ldap.(directoryGroups).groups"
+	switch {
+	case acct == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account")
+	case acct.Dn == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account dn")
+	case acct.LoginName == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account login name")
+	}
+	if d.am.AnonGroupSearch {
+		if err := d.conn.UnauthenticatedBind(acct.Dn); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("group search anonymous bind failed"))
+		}
+	}
+
+	var entries []*ldap.Entry
+	var err error
+	if d.am.UseTokenGroups {
+		entries, err = d.tokenGroupsSearch(ctx, acct.Dn)
+	} else {
+		entries, err = d.filterGroupsSearch(ctx, acct.Dn, acct.LoginName)
+	}
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	groupAttr := d.am.GroupAttr
+	if groupAttr == "" {
+		groupAttr = capldap.DefaultGroupAttr
+	}
+	seen := make(map[string]bool)
+	groups := make([]string, 0, len(entries))
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			groups = append(groups, name)
+		}
+	}
+	for _, e := range entries {
+		dn, err := ldap.ParseDN(e.DN)
+		if err != nil || len(dn.RDNs) == 0 {
+			continue
+		}
+		values := e.GetAttributeValues(groupAttr)
+		if len(values) == 0 {
+			add(groupCN(e.DN))
+			continue
+		}
+		for _, v := range values {
+			add(groupCN(v))
+		}
+	}
+	return groups, nil
+}
+
+func (d *directoryGroups) filterGroupsSearch(ctx context.Context, userDn, loginName string) ([]*ldap.Entry, error) {
+	const op = "ldap.(directoryGroups).filterGroupsSearch"
+	if d.am.GroupDn == "" {
+		return nil, nil
+	}
+	filter := d.am.GroupFilter
+	if filter == "" {
+		filter = capldap.DefaultGroupFilter
+	}
+	t, err := template.New("queryTemplate").Parse(filter)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse group filter"))
+	}
+	var rendered bytes.Buffer
+	if err := t.Execute(&rendered, struct {
+		UserDN   string
+		Username string
+	}{
+		UserDN:   ldap.EscapeFilter(userDn),
+		Username: ldap.EscapeFilter(loginName),
+	}); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to render group filter"))
+	}
+
+	groupAttr := d.am.GroupAttr
+	if groupAttr == "" {
+		groupAttr = capldap.DefaultGroupAttr
+	}
+	req := &ldap.SearchRequest{
+		BaseDN:       d.am.GroupDn,
+		Scope:        ldap.ScopeWholeSubtree,
+		DerefAliases: derefAliasesMap[d.am.DereferenceAliases],
+		Filter:       rendered.String(),
+		Attributes:   []string{groupAttr},
+		SizeLimit:    math.MaxInt32,
+	}
+	var result *ldap.SearchResult
+	switch {
+	case d.am.MaximumPageSize > 0:
+		result, err = d.conn.SearchWithPaging(req, d.am.MaximumPageSize)
+	default:
+		result, err = d.conn.Search(req)
+	}
+	switch {
+	case ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject):
+		return nil, nil
+	case err != nil:
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("group search failed (base dn: %q / filter: %q)", d.am.GroupDn, rendered.String())))
+	}
+	return result.Entries, nil
+}
+
+func (d *directoryGroups) tokenGroupsSearch(ctx context.Context, userDn string) ([]*ldap.Entry, error) {
+	const op = "ldap.(directoryGroups).tokenGroupsSearch"
+	result, err := d.conn.Search(&ldap.SearchRequest{
+		BaseDN:       userDn,
+		Scope:        ldap.ScopeBaseObject,
+		DerefAliases: derefAliasesMap[d.am.DereferenceAliases],
+		Filter:       "(objectClass=*)",
+		Attributes:   []string{"tokenGroups"},
+		SizeLimit:    1,
+	})
+	switch {
+	case ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject):
+		// the user no longer exists in the directory, so it has no groups.
+		return nil, nil
+	case err != nil:
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("token groups search failed (base dn: %q)", userDn)))
+	case len(result.Entries) == 0:
+		return nil, nil
+	}
+
+	sids := result.Entries[0].GetRawAttributeValues("tokenGroups")
+	entries := make([]*ldap.Entry, 0, len(sids))
+	for _, b := range sids {
+		sid, err := sidString(b)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		groupResult, err := d.conn.Search(&ldap.SearchRequest{
+			BaseDN:       fmt.Sprintf("<SID=%s>", sid),
+			Scope:        ldap.ScopeBaseObject,
+			DerefAliases: derefAliasesMap[d.am.DereferenceAliases],
+			Filter:       "(objectClass=*)",
+			Attributes:   []string{"1.1"}, // RFC 4511: no attributes
+			SizeLimit:    1,
+		})
+		if err != nil || len(groupResult.Entries) == 0 {
+			// Authenticate skips groups which cannot be read, so we do as well
+			continue
+		}
+		entries = append(entries, groupResult.Entries[0])
+	}
+	return entries, nil
+}
+
+// dialDirectory connects to the first reachable url of the auth method.
+func dialDirectory(ctx context.Context, am *AuthMethod) (*ldap.Conn, error) {
+	const op = "ldap.dialDirectory"
+	timeout := DefaultRequestTimeout * time.Second
+	var clientKeyPem string
+	if am.ClientCertificateKey != nil {
+		clientKeyPem = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: am.ClientCertificateKey}))
+	}
+	var lastErr error
+	for _, u := range am.Urls {
+		parsed, err := url.Parse(u)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		host, _, err := net.SplitHostPort(parsed.Host)
+		if err != nil {
+			host = parsed.Host
+		}
+		var conn *ldap.Conn
+		switch parsed.Scheme {
+		case "ldap":
+			conn, err = ldap.DialURL(u, ldap.DialWithDialer(&net.Dialer{Timeout: timeout}))
+			if err == nil && am.StartTls {
+				var tlsConf *tls.Config
+				if tlsConf, err = directoryTLSConfig(host, am, clientKeyPem); err == nil {
+					err = conn.StartTLS(tlsConf)
+				}
+				if err != nil {
+					conn.Close()
+				}
+			}
+		case "ldaps":
+			var tlsConf *tls.Config
+			if tlsConf, err = directoryTLSConfig(host, am, clientKeyPem); err == nil {
+				conn, err = ldap.DialURL(u, ldap.DialWithTLSDialer(tlsConf, &net.Dialer{Timeout: timeout}))
+			}
+		default:
+			err = fmt.Errorf("invalid scheme in url %q", u)
+		}
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		conn.SetTimeout(timeout)
+		return conn, nil
+	}
+	return nil, errors.Wrap(ctx, lastErr, op, errors.WithMsg("unable to connect to any of the auth method urls"))
+}
+
+func directoryTLSConfig(host string, am *AuthMethod, clientKeyPem string) (*tls.Config, error) {
+	tlsConf := &tls.Config{
+		ServerName:         host,
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: am.InsecureTls,
+	}
+	if len(am.Certificates) > 0 {
+		pool := x509.NewCertPool()
+		for _, c := range am.Certificates {
+			if !pool.AppendCertsFromPEM([]byte(c)) {
+				return nil, fmt.Errorf("could not append CA certificate")
+			}
+		}
+		tlsConf.RootCAs = pool
+	}
+	if am.ClientCertificate != "" && clientKeyPem != "" {
+		cert, err := tls.X509KeyPair([]byte(am.ClientCertificate), []byte(clientKeyPem))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse client certificate key pair: %w", err)
+		}
+		tlsConf.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConf, nil
+}
+
+// groupCN returns the CN of a group DN, matching how group names are resolved
+// during Authenticate. Values which are not a DN are returned as-is.
+func groupCN(dn string) string {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil || len(parsed.RDNs) == 0 {
+		return dn
+	}
+	for _, rdn := range parsed.RDNs {
+		for _, attr := range rdn.Attributes {
+			if attr.Type == "CN" {
+				return attr.Value
+			}
+		}
+	}
+	return dn
+}
+
+// sidString converts the binary representation of a security identifier into
+// its string form, e.g. S-1-5-21-...
+func sidString(b []byte) (string, error) {
+	r := bytes.NewReader(b)
+	var revision, subAuthorityCount uint8
+	var authorityParts [3]uint16
+	if err := binary.Read(r, binary.LittleEndian, &revision); err != nil {
+		return "", fmt.Errorf("unable to read sid revision: %w", err)
+	}
+	if err := binary.Read(r, binary.LittleEndian, &subAuthorityCount); err != nil {
+		return "", fmt.Errorf("unable to read sid sub authority count: %w", err)
+	}
+	if err := binary.Read(r, binary.BigEndian, &authorityParts); err != nil {
+		return "", fmt.Errorf("unable to read sid identifier authority: %w", err)
+	}
+	authority := uint64(authorityParts[0])<<32 + uint64(authorityParts[1])<<16 + uint64(authorityParts[2])
+	subAuthority := make([]uint32, subAuthorityCount)
+	if err := binary.Read(r, binary.LittleEndian, &subAuthority); err != nil {
+		return "", fmt.Errorf("unable to read sid sub authority: %w", err)
+	}
+	s := fmt.Sprintf("S-%d-%d", revision, authority)
+	for _, p := range subAuthority {
+		s += fmt.Sprintf("-%d", p)
+	}
+	return s, nil
+}
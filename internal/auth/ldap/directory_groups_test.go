@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ldap
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/cap/ldap"
+	"github.com/hashicorp/go-hclog"
+	"github.com/jimlambrt/gldap"
+	"github.com/jimlambrt/gldap/testdirectory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_directoryGroups(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:  "test-logger",
+		Level: hclog.Error,
+	})
+	td := testdirectory.Start(t,
+		testdirectory.WithDefaults(t, &testdirectory.Defaults{AllowAnonymousBind: true}),
+		testdirectory.WithLogger(t, logger),
+	)
+
+	sidBytes, err := ldap.SIDBytes(1, 1)
+	require.NoError(t, err)
+	users := testdirectory.NewUsers(t, []string{"alice", "bob", "admin"}, testdirectory.WithMembersOf(t, "admin"), testdirectory.WithTokenGroups(t, sidBytes))
+	for _, u := range users {
+		u.Attributes = append(u.Attributes, gldap.NewEntryAttribute("password", []string{"password"}))
+	}
+	td.SetUsers(users...)
+	td.SetGroups(testdirectory.NewGroup(t, "admin", []string{"alice"}))
+	td.SetTokenGroups(map[string][]*gldap.Entry{
+		"S-1-1": {
+			testdirectory.NewGroup(t, "admin-token-group", []string{"alice"}),
+		},
+	})
+
+	const bindDn = "cn=admin,ou=people,dc=example,dc=org"
+	testAm := func(opt ...func(*AuthMethod)) *AuthMethod {
+		am := AllocAuthMethod()
+		am.PublicId = "amldap_1234567890"
+		am.Urls = []string{fmt.Sprintf("ldaps://%s:%d", td.Host(), td.Port())}
+		am.Certificates = []string{td.Cert()}
+		am.EnableGroups = true
+		am.GroupDn = testdirectory.DefaultGroupDN
+		am.BindDn = bindDn
+		am.BindPassword = "password"
+		for _, o := range opt {
+			o(&am)
+		}
+		return &am
+	}
+	testAcct := func(loginName string) *Account {
+		a := AllocAccount()
+		a.LoginName = loginName
+		a.Dn = fmt.Sprintf("cn=%s,%s", loginName, testdirectory.DefaultUserDN)
+		return a
+	}
+
+	tests := []struct {
+		name            string
+		am              *AuthMethod
+		acct            *Account
+		want            []string
+		wantNewErrMatch *errors.Template
+		wantErrContains string
+	}{
+		{
+			name: "filter-groups",
+			am:   testAm(),
+			acct: testAcct("alice"),
+			want: []string{"cn=admin,ou=groups,dc=example,dc=org"},
+		},
+		{
+			name: "filter-groups-not-a-member",
+			am:   testAm(),
+			acct: testAcct("bob"),
+			want: []string{},
+		},
+		{
+			name: "token-groups",
+			am:   testAm(func(am *AuthMethod) { am.UseTokenGroups = true }),
+			acct: testAcct("alice"),
+			want: []string{"cn=admin-token-group,ou=groups,dc=example,dc=org"},
+		},
+		{
+			name: "anon-group-search",
+			am: testAm(func(am *AuthMethod) {
+				am.AnonGroupSearch = true
+				am.BindDn, am.BindPassword = "", ""
+			}),
+			acct: testAcct("alice"),
+			want: []string{"cn=admin,ou=groups,dc=example,dc=org"},
+		},
+		{
+			name:            "groups-not-enabled",
+			am:              testAm(func(am *AuthMethod) { am.EnableGroups = false }),
+			wantNewErrMatch: errors.T(errors.InvalidParameter),
+			wantErrContains: "does not have groups enabled",
+		},
+		{
+			name:            "missing-bind-credential",
+			am:              testAm(func(am *AuthMethod) { am.BindDn, am.BindPassword = "", "" }),
+			wantNewErrMatch: errors.T(errors.InvalidParameter),
+			wantErrContains: "neither a bind credential nor anonymous group search",
+		},
+		{
+			name:            "invalid-bind-credential",
+			am:              testAm(func(am *AuthMethod) { am.BindPassword = "bad-password" }),
+			wantErrContains: "unable to bind with the bind credential",
+		},
+		{
+			name:            "unreachable",
+			am:              testAm(func(am *AuthMethod) { am.Urls = []string{"ldaps://127.0.0.1:1"} }),
+			wantErrContains: "unable to connect to any of the auth method urls",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			d, err := newDirectoryGroups(testCtx, tc.am)
+			if tc.wantErrContains != "" {
+				require.Error(err)
+				assert.Nil(d)
+				if tc.wantNewErrMatch != nil {
+					assert.Truef(errors.Match(tc.wantNewErrMatch, err), "want err code: %q got: %q", tc.wantNewErrMatch.Code, err)
+				}
+				assert.Contains(err.Error(), tc.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			defer d.close()
+			got, err := d.groups(testCtx, tc.acct)
+			require.NoError(err)
+			assert.ElementsMatch(tc.want, got)
+		})
+	}
+	t.Run("missing-account-dn", func(t *testing.T) {
+		d, err := newDirectoryGroups(testCtx, testAm())
+		require.NoError(t, err)
+		defer d.close()
+		acct := testAcct("alice")
+		acct.Dn = ""
+		_, err = d.groups(testCtx, acct)
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+}
+
+func Test_compareGroups(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		current     []string
+		updated     []string
+		wantChanged bool
+		wantLost    bool
+	}{
+		{name: "unchanged", current: []string{"a", "b"}, updated: []string{"b", "a"}},
+		{name: "both-empty"},
+		{name: "added", current: []string{"a"}, updated: []string{"a", "b"}, wantChanged: true},
+		{name: "removed", current: []string{"a", "b"}, updated: []string{"a"}, wantChanged: true, wantLost: true},
+		{name: "removed-all", current: []string{"a"}, wantChanged: true, wantLost: true},
+		{name: "replaced", current: []string{"a"}, updated: []string{"b"}, wantChanged: true, wantLost: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			changed, lost := compareGroups(tc.current, tc.updated)
+			assert.Equal(t, tc.wantChanged, changed)
+			assert.Equal(t, tc.wantLost, lost)
+		})
+	}
+}
+
+func Test_sidString(t *testing.T) {
+	t.Parallel()
+	b, err := ldap.SIDBytes(1, 5)
+	require.NoError(t, err)
+	got, err := sidString(b)
+	require.NoError(t, err)
+	assert.Equal(t, "S-1-5", got)
+
+	_, err = sidString([]byte{1})
+	assert.Error(t, err)
+}
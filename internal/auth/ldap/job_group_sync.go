@@ -0,0 +1,373 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ldap
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	ua "go.uber.org/atomic"
+)
+
+const (
+	groupSyncJobName        = "ldap_managed_group_sync"
+	groupSyncJobRunInterval = 15 * time.Minute
+)
+
+// GroupSyncJob is the recurring job that refreshes the groups of ldap accounts
+// from the directory of their auth method, so that managed group memberships
+// no longer depend on the account authenticating again. Sessions of users who
+// lost a group are canceled when their grants no longer allow authorizing a
+// session to the session's target.
+//
+// The GroupSyncJob is not thread safe, an attempt to Run the job concurrently
+// will result in an JobAlreadyRunning error.
+type GroupSyncJob struct {
+	reader db.Reader
+	writer db.Writer
+	kms    *kms.Kms
+
+	running      ua.Bool
+	numAccounts  int
+	numProcessed int
+}
+
+// newGroupSyncJob creates a new in-memory GroupSyncJob.
+func newGroupSyncJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms) (*GroupSyncJob, error) {
+	const op = "ldap.newGroupSyncJob"
+	switch {
+	case r == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Reader")
+	case w == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Writer")
+	case kms == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+	return &GroupSyncJob{
+		reader: r,
+		writer: w,
+		kms:    kms,
+	}, nil
+}
+
+// Status returns the current status of the group sync job. Total is the number
+// of accounts to be synced. Completed is the number of accounts already
+// synced.
+func (j *GroupSyncJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: j.numProcessed,
+		Total:     j.numAccounts,
+	}
+}
+
+// Run refreshes the groups of every previously authenticated account of the
+// active ldap auth methods with groups enabled. An auth method whose directory
+// cannot be reached is skipped and its accounts are left unchanged. Can not be
+// run in parallel, if Run is invoked while already running an error with code
+// JobAlreadyRunning will be returned.
+func (j *GroupSyncJob) Run(ctx context.Context) error {
+	const op = "ldap.(GroupSyncJob).Run"
+	if !j.running.CompareAndSwap(j.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer j.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	j.numAccounts, j.numProcessed = 0, 0
+
+	repo, err := NewRepository(ctx, j.reader, j.writer, j.kms)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	rows, err := j.reader.Query(ctx, groupSyncAuthMethodsQuery, nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	var authMethodIds []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return errors.Wrap(ctx, err, op)
+		}
+		authMethodIds = append(authMethodIds, id)
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	var lostGroups []*Account
+	for _, id := range authMethodIds {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		accts, err := j.syncAuthMethod(ctx, repo, id)
+		lostGroups = append(lostGroups, accts...)
+		if err != nil {
+			// one unreachable or misconfigured directory must not prevent
+			// syncing the other auth methods.
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to sync ldap groups", "auth method id", id))
+		}
+	}
+	if len(lostGroups) == 0 {
+		return nil
+	}
+	if err := j.cancelUnauthorizedSessions(ctx, lostGroups); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// NextRunIn returns the default run frequency of the group sync job.
+func (j *GroupSyncJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return groupSyncJobRunInterval, nil
+}
+
+// Name is the unique name of the job.
+func (j *GroupSyncJob) Name() string {
+	return groupSyncJobName
+}
+
+// Description is the human readable description of the job.
+func (j *GroupSyncJob) Description() string {
+	return "Periodically refreshes the group memberships of ldap accounts from their directory."
+}
+
+// syncAuthMethod refreshes the groups of the accounts of the auth method and
+// returns the accounts which lost at least one group. The accounts which lost
+// a group are returned even if an error stops the sync of the remaining
+// accounts.
+func (j *GroupSyncJob) syncAuthMethod(ctx context.Context, repo *Repository, authMethodId string) ([]*Account, error) {
+	const op = "ldap.(GroupSyncJob).syncAuthMethod"
+	am, err := repo.lookupAuthMethod(ctx, authMethodId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		// deleted since the auth methods were listed
+		return nil, nil
+	}
+	if !am.AnonGroupSearch && am.BindDn == "" {
+		// without a bind credential we can only search groups as the user,
+		// which requires their password.
+		return nil, nil
+	}
+
+	var accts []*Account
+	if err := j.reader.SearchWhere(ctx, &accts, "auth_method_id = ? and dn is not null", []any{authMethodId}, db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(accts) == 0 {
+		return nil, nil
+	}
+	j.numAccounts += len(accts)
+
+	dir, err := newDirectoryGroups(ctx, am)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer dir.close()
+
+	oplogWrapper, err := j.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var lostGroups []*Account
+	for _, acct := range accts {
+		groups, err := dir.groups(ctx, acct)
+		if err != nil {
+			return lostGroups, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to get groups of account %q", acct.PublicId)))
+		}
+		var current []string
+		if acct.MemberOfGroups != "" {
+			if err := json.Unmarshal([]byte(acct.MemberOfGroups), &current); err != nil {
+				return lostGroups, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to decode groups of account %q", acct.PublicId)))
+			}
+		}
+		changed, lost := compareGroups(current, groups)
+		if changed {
+			if err := j.updateGroups(ctx, oplogWrapper, acct, groups); err != nil {
+				return lostGroups, errors.Wrap(ctx, err, op)
+			}
+		}
+		if lost {
+			lostGroups = append(lostGroups, acct)
+		}
+		j.numProcessed++
+	}
+	return lostGroups, nil
+}
+
+// updateGroups sets the MemberOfGroups of the account, which updates its
+// managed group memberships.
+func (j *GroupSyncJob) updateGroups(ctx context.Context, oplogWrapper wrapping.Wrapper, acct *Account, groups []string) error {
+	const op = "ldap.(GroupSyncJob).updateGroups"
+	updated := acct.clone()
+	var nullFields []string
+	switch len(groups) {
+	case 0:
+		updated.MemberOfGroups = ""
+		nullFields = []string{"MemberOfGroups"}
+	default:
+		slices.Sort(groups)
+		encoded, err := json.Marshal(groups)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to encode account groups"))
+		}
+		updated.MemberOfGroups = string(encoded)
+	}
+	var dbMask []string
+	if nullFields == nil {
+		dbMask = []string{"MemberOfGroups"}
+	}
+	md, err := updated.oplog(ctx, oplog.OpType_OP_TYPE_UPDATE)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	_, err = j.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			rowsUpdated, err := w.Update(ctx, updated, dbMask, nullFields, db.WithOplog(oplogWrapper, md))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to update groups of account %q", acct.PublicId)))
+	}
+	return nil
+}
+
+type openSession struct {
+	PublicId  string
+	Version   uint32
+	UserId    string
+	TargetId  string
+	ProjectId string
+}
+
+// cancelUnauthorizedSessions cancels the open sessions of the users of the
+// accounts whose grants no longer allow authorizing a session to the target of
+// the session.
+func (j *GroupSyncJob) cancelUnauthorizedSessions(ctx context.Context, accts []*Account) error {
+	const op = "ldap.(GroupSyncJob).cancelUnauthorizedSessions"
+	iamRepo, err := iam.NewRepository(ctx, j.reader, j.writer, j.kms)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	sessionRepo, err := session.NewRepository(ctx, j.reader, j.writer, j.kms)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	for _, acct := range accts {
+		rows, err := j.reader.Query(ctx, openAccountSessionsQuery, []any{sql.Named("account_id", acct.PublicId)})
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		var sessions []*openSession
+		for rows.Next() {
+			var s openSession
+			if err := j.reader.ScanRows(ctx, rows, &s); err != nil {
+				_ = rows.Close()
+				return errors.Wrap(ctx, err, op)
+			}
+			sessions = append(sessions, &s)
+		}
+		if err := rows.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if len(sessions) == 0 {
+			continue
+		}
+
+		// every session of the account belongs to the same user
+		userId := sessions[0].UserId
+		acl, err := userACL(ctx, iamRepo, userId, acct.PublicId)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		for _, s := range sessions {
+			res := perms.Resource{
+				ScopeId: s.ProjectId,
+				Id:      s.TargetId,
+				Type:    resource.Target,
+			}
+			if acl.Allowed(res, action.AuthorizeSession, userId).Authorized {
+				continue
+			}
+			if _, err := sessionRepo.CancelSession(ctx, s.PublicId, s.Version); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to cancel session %q", s.PublicId)))
+			}
+		}
+	}
+	return nil
+}
+
+// userACL returns the ACL of the user's current grants.
+func userACL(ctx context.Context, iamRepo *iam.Repository, userId, accountId string) (perms.ACL, error) {
+	const op = "ldap.userACL"
+	grantTuples, err := iamRepo.GrantsForUser(ctx, userId)
+	if err != nil {
+		return perms.ACL{}, errors.Wrap(ctx, err, op)
+	}
+	parsedGrants := make([]perms.Grant, 0, len(grantTuples))
+	for _, pair := range grantTuples {
+		// Validation is skipped in the same way as when authorizing requests,
+		// so that grants in formats which have since been restricted do not
+		// cause errors.
+		parsed, err := perms.Parse(ctx, pair.ScopeId, pair.Grant,
+			perms.WithUserId(userId),
+			perms.WithAccountId(accountId),
+			perms.WithSkipFinalValidation(true))
+		if err != nil {
+			return perms.ACL{}, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed to parse grant %#v", pair.Grant)))
+		}
+		parsedGrants = append(parsedGrants, parsed)
+	}
+	return perms.NewACL(parsedGrants...), nil
+}
+
+// compareGroups reports whether the groups changed and, if so, whether any of
+// the current groups were lost.
+func compareGroups(current, updated []string) (changed bool, lost bool) {
+	updatedSet := make(map[string]struct{}, len(updated))
+	for _, g := range updated {
+		updatedSet[g] = struct{}{}
+	}
+	currentSet := make(map[string]struct{}, len(current))
+	for _, g := range current {
+		currentSet[g] = struct{}{}
+		if _, ok := updatedSet[g]; !ok {
+			lost = true
+		}
+	}
+	if lost || len(currentSet) != len(updatedSet) {
+		return true, lost
+	}
+	return false, false
+}
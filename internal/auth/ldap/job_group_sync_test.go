@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ldap
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/go-hclog"
+	"github.com/jimlambrt/gldap"
+	"github.com/jimlambrt/gldap/testdirectory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGroupSyncJob(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+
+	type args struct {
+		r   db.Reader
+		w   db.Writer
+		kms *kms.Kms
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantErr     bool
+		wantErrCode errors.Code
+	}{
+		{
+			name:        "nil reader",
+			args:        args{w: rw, kms: kmsCache},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "nil writer",
+			args:        args{r: rw, kms: kmsCache},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "nil kms",
+			args:        args{r: rw, w: rw},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "valid",
+			args: args{r: rw, w: rw, kms: kmsCache},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := newGroupSyncJob(ctx, tt.args.r, tt.args.w, tt.args.kms)
+			if tt.wantErr {
+				require.Error(err)
+				assert.Nil(got)
+				assert.Truef(errors.Match(errors.T(tt.wantErrCode), err), "Unexpected error %s", err)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			assert.Equal(tt.args.r, got.reader)
+			assert.Equal(tt.args.w, got.writer)
+			assert.Equal(tt.args.kms, got.kms)
+			assert.Equal(groupSyncJobName, got.Name())
+		})
+	}
+}
+
+func TestGroupSyncJob_Run(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	orgDbWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(err)
+
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:  "test-logger",
+		Level: hclog.Error,
+	})
+	td := testdirectory.Start(t,
+		testdirectory.WithDefaults(t, &testdirectory.Defaults{AllowAnonymousBind: true}),
+		testdirectory.WithLogger(t, logger),
+	)
+	tdCerts, err := ParseCertificates(ctx, td.Cert())
+	require.NoError(err)
+	users := testdirectory.NewUsers(t, []string{"alice", "bob", "admin"}, testdirectory.WithMembersOf(t, "admin"))
+	for _, u := range users {
+		u.Attributes = append(u.Attributes, gldap.NewEntryAttribute("password", []string{"password"}))
+	}
+	td.SetUsers(users...)
+	td.SetGroups(
+		testdirectory.NewGroup(t, "admin", []string{"alice"}),
+		testdirectory.NewGroup(t, "dev", []string{"alice", "bob"}),
+	)
+
+	const (
+		adminGroup = "cn=admin,ou=groups,dc=example,dc=org"
+		devGroup   = "cn=dev,ou=groups,dc=example,dc=org"
+	)
+	am := TestAuthMethod(t, conn, orgDbWrapper, org.PublicId,
+		[]string{fmt.Sprintf("ldaps://%s:%d", td.Host(), td.Port())},
+		WithCertificates(ctx, tdCerts...),
+		WithEnableGroups(ctx),
+		WithUserDn(ctx, testdirectory.DefaultUserDN),
+		WithGroupDn(ctx, testdirectory.DefaultGroupDN),
+		WithBindCredential(ctx, "cn=admin,ou=people,dc=example,dc=org", "password"),
+	)
+	adminMg := TestManagedGroup(t, conn, am, []string{adminGroup})
+	devMg := TestManagedGroup(t, conn, am, []string{devGroup})
+
+	alice := TestAccount(t, conn, am, "alice",
+		WithDn(ctx, "cn=alice,ou=people,dc=example,dc=org"),
+		WithMemberOfGroups(ctx, adminGroup, devGroup))
+	bob := TestAccount(t, conn, am, "bob",
+		WithDn(ctx, "cn=bob,ou=people,dc=example,dc=org"),
+		WithMemberOfGroups(ctx, adminGroup))
+	// never authenticated, so it has no dn and is not synced
+	TestAccount(t, conn, am, "eve")
+
+	assert.ElementsMatch([]string{adminMg.PublicId, devMg.PublicId}, TestGetAcctManagedGroups(t, conn, alice.PublicId))
+	assert.ElementsMatch([]string{adminMg.PublicId}, TestGetAcctManagedGroups(t, conn, bob.PublicId))
+
+	j, err := newGroupSyncJob(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	require.NoError(sche.RegisterJob(ctx, j))
+
+	require.NoError(j.Run(ctx))
+	assert.Equal(2, j.numAccounts)
+	assert.Equal(2, j.numProcessed)
+	assert.ElementsMatch([]string{adminMg.PublicId, devMg.PublicId}, TestGetAcctManagedGroups(t, conn, alice.PublicId))
+	// bob was removed from admin and added to dev in the directory
+	assert.ElementsMatch([]string{devMg.PublicId}, TestGetAcctManagedGroups(t, conn, bob.PublicId))
+
+	// alice is removed from every group
+	td.SetGroups(testdirectory.NewGroup(t, "dev", []string{"bob"}))
+	require.NoError(j.Run(ctx))
+	assert.Empty(TestGetAcctManagedGroups(t, conn, alice.PublicId))
+	assert.ElementsMatch([]string{devMg.PublicId}, TestGetAcctManagedGroups(t, conn, bob.PublicId))
+
+	// an unreachable directory leaves the memberships unchanged
+	td.Stop()
+	require.NoError(j.Run(ctx))
+	assert.ElementsMatch([]string{devMg.PublicId}, TestGetAcctManagedGroups(t, conn, bob.PublicId))
+}
@@ -9,5 +9,33 @@ select sum(reltuples::bigint) as estimate from pg_class where oid in ('auth_ldap
 `
 	estimateCountManagedGroups = `
 select sum(reltuples::bigint) as estimate from pg_class where oid in ('auth_ldap_managed_group'::regclass)
+`
+
+	// groupSyncAuthMethodsQuery returns the ids of the active auth methods
+	// with groups enabled.
+	groupSyncAuthMethodsQuery = `
+select public_id
+  from auth_ldap_method
+ where enable_groups = true
+   and state <> 'inactive';
+`
+
+	// openAccountSessionsQuery returns the pending and active sessions of the
+	// user associated with the account @account_id.
+	openAccountSessionsQuery = `
+select s.public_id,
+       s.version,
+       s.user_id,
+       s.target_id,
+       s.project_id
+  from session s
+  join auth_account acct
+    on acct.iam_user_id = s.user_id
+  join session_state ss
+    on ss.session_id = s.public_id
+ where acct.public_id = @account_id
+   and s.target_id is not null
+   and ss.end_time is null
+   and ss.state in ('pending', 'active');
 `
 )
@@ -0,0 +1,420 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+)
+
+const (
+	// deviceCodeGrantType is the grant type used to exchange a device code
+	// for tokens.
+	//
+	// See https://www.rfc-editor.org/rfc/rfc8628#section-3.4
+	deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+	// defaultDeviceInterval is the number of seconds a client must wait
+	// between token requests when the provider doesn't return an interval.
+	//
+	// See https://www.rfc-editor.org/rfc/rfc8628#section-3.2
+	defaultDeviceInterval = 5
+
+	// deviceSlowDownIncrement is added to the interval between token requests
+	// each time the provider responds with slow_down.
+	//
+	// See https://www.rfc-editor.org/rfc/rfc8628#section-3.5
+	deviceSlowDownIncrement = 5 * time.Second
+
+	// maxDeviceResponseSize limits the size of the responses read from the
+	// provider's device authorization and token endpoints.
+	maxDeviceResponseSize = 1 << 20
+)
+
+// The error codes returned by the token endpoint while a device
+// authorization request is pending or has been terminated.
+//
+// See https://www.rfc-editor.org/rfc/rfc8628#section-3.5
+const (
+	deviceErrAuthorizationPending = "authorization_pending"
+	deviceErrSlowDown             = "slow_down"
+	deviceErrAccessDenied         = "access_denied"
+	deviceErrExpiredToken         = "expired_token"
+)
+
+var (
+	// cachedDeviceFlows provides a cache of the in-flight device authorization
+	// requests, so the provider's endpoints aren't discovered for every token
+	// request and its polling interval is honored. Like the providerCache, it
+	// can't be done within the Repository, since a new Repository is created
+	// for every request.
+	cachedDeviceFlows     *deviceFlows
+	initCachedDeviceFlows sync.Once
+)
+
+// deviceFlowCache returns the cache of device authorization requests
+func deviceFlowCache() *deviceFlows {
+	initCachedDeviceFlows.Do(func() {
+		cachedDeviceFlows = newDeviceFlowCache()
+	})
+	return cachedDeviceFlows
+}
+
+// deviceFlow is the state of an in-flight device authorization request.
+type deviceFlow struct {
+	endpoints  *deviceEndpoints
+	configHash uint64
+	interval   time.Duration
+	nextPoll   time.Time
+	expiration time.Time
+}
+
+// deviceFlows is a cache of deviceFlow types keyed by the request id of
+// their request token.
+type deviceFlows struct {
+	cache map[string]*deviceFlow
+	mu    *sync.Mutex
+}
+
+// newDeviceFlowCache make a new cache
+func newDeviceFlowCache() *deviceFlows {
+	return &deviceFlows{
+		cache: map[string]*deviceFlow{},
+		mu:    &sync.Mutex{},
+	}
+}
+
+// set will set an entry in the cache and remove any expired entries.
+func (c *deviceFlows) set(requestId string, f *deviceFlow) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	for id, cached := range c.cache {
+		if now.After(cached.expiration) {
+			delete(c.cache, id)
+		}
+	}
+	c.cache[requestId] = f
+}
+
+// endpoints returns the cached endpoints of the request. It returns false if
+// the request isn't cached or the provider's configuration has changed since
+// it was.
+func (c *deviceFlows) endpoints(requestId string, configHash uint64) (*deviceEndpoints, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	f, ok := c.cache[requestId]
+	if !ok || f.configHash != configHash {
+		return nil, false
+	}
+	return f.endpoints, true
+}
+
+// poll reports whether the request's interval has elapsed since its last
+// token request, in which case the next one is scheduled. It also returns the
+// request's current interval.
+func (c *deviceFlows) poll(requestId string, now time.Time) (bool, time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	f, ok := c.cache[requestId]
+	if !ok {
+		return true, defaultDeviceInterval * time.Second
+	}
+	if now.Before(f.nextPoll) {
+		return false, f.interval
+	}
+	f.nextPoll = now.Add(f.interval)
+	return true, f.interval
+}
+
+// slowDown increases the request's interval by deviceSlowDownIncrement and
+// returns it.
+func (c *deviceFlows) slowDown(requestId string, now time.Time) time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	f, ok := c.cache[requestId]
+	if !ok {
+		return defaultDeviceInterval*time.Second + deviceSlowDownIncrement
+	}
+	f.interval += deviceSlowDownIncrement
+	f.nextPoll = now.Add(f.interval)
+	return f.interval
+}
+
+// delete will delete an entry in the cache.
+func (c *deviceFlows) delete(requestId string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.cache, requestId)
+}
+
+// DeviceAuthorization is the information returned by StartDeviceAuth which
+// the user needs to complete a device authorization request.
+type DeviceAuthorization struct {
+	// UserCode is the code the user enters at the VerificationUri.
+	UserCode string
+	// VerificationUri is the provider's end-user verification URI.
+	VerificationUri string
+	// VerificationUriComplete is the verification URI including the user
+	// code. It's empty if the provider doesn't support it.
+	VerificationUriComplete string
+	// ExpiresIn is the number of seconds until the device code expires.
+	ExpiresIn uint32
+	// Interval is the minimum number of seconds the client should wait
+	// between token requests.
+	Interval uint32
+}
+
+// deviceEndpoints are the endpoints of the provider which are used by the
+// device authorization grant, from its discovery document.
+type deviceEndpoints struct {
+	Issuer                      string `json:"issuer"`
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+	TokenEndpoint               string `json:"token_endpoint"`
+	JwksUri                     string `json:"jwks_uri"`
+}
+
+// deviceAuthorizationResponse is the response of the provider's device
+// authorization endpoint.
+//
+// See https://www.rfc-editor.org/rfc/rfc8628#section-3.2
+type deviceAuthorizationResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationUri         string `json:"verification_uri"`
+	VerificationUriComplete string `json:"verification_uri_complete"`
+	ExpiresIn               uint32 `json:"expires_in"`
+	Interval                uint32 `json:"interval"`
+}
+
+// deviceTokenResponse is the response of the provider's token endpoint to a
+// device access token request.
+//
+// See https://www.rfc-editor.org/rfc/rfc8628#section-3.5
+type deviceTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	IdToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// discoverDeviceEndpoints retrieves the provider's discovery document and
+// returns the endpoints used by the device authorization grant. It returns an
+// error if the provider doesn't support the device authorization grant.
+func discoverDeviceEndpoints(ctx context.Context, client *http.Client, issuer string) (*deviceEndpoints, error) {
+	const op = "oidc.discoverDeviceEndpoints"
+	if client == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing http client")
+	}
+	if issuer == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing issuer")
+	}
+	wellKnown := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, wellKnown, nil)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to create discovery request", errors.WithWrap(err))
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to get discovery document", errors.WithWrap(err))
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxDeviceResponseSize))
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to read discovery document", errors.WithWrap(err))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("unexpected discovery document status %s", resp.Status))
+	}
+	var endpoints deviceEndpoints
+	if err := json.Unmarshal(body, &endpoints); err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to parse discovery document", errors.WithWrap(err))
+	}
+	if endpoints.Issuer != issuer {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("discovered issuer %q does not match %q", endpoints.Issuer, issuer))
+	}
+	switch {
+	case endpoints.DeviceAuthorizationEndpoint == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "provider does not support the device authorization grant")
+	case endpoints.TokenEndpoint == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "provider discovery document is missing the token endpoint")
+	case endpoints.JwksUri == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "provider discovery document is missing the jwks uri")
+	}
+	return &endpoints, nil
+}
+
+// requestDeviceAuthorization sends a device authorization request for the
+// auth method to the provider's device authorization endpoint.
+//
+// See https://www.rfc-editor.org/rfc/rfc8628#section-3.1
+func requestDeviceAuthorization(ctx context.Context, client *http.Client, endpoint string, am *AuthMethod) (*deviceAuthorizationResponse, error) {
+	const op = "oidc.requestDeviceAuthorization"
+	if am == nil || am.AuthMethod == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	scopes := append([]string{gooidc.ScopeOpenID}, am.ClaimsScopes...)
+	form := url.Values{
+		"client_id": {am.ClientId},
+		"scope":     {strings.Join(strutil.RemoveDuplicatesStable(scopes, false), " ")},
+	}
+	var authResp deviceAuthorizationResponse
+	errResp, err := postDeviceForm(ctx, client, endpoint, am, form, &authResp)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if errResp != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("device authorization request failed: %s: %s", errResp.Error, errResp.ErrorDescription))
+	}
+	switch {
+	case authResp.DeviceCode == "":
+		return nil, errors.New(ctx, errors.Unknown, op, "device authorization response is missing the device code")
+	case authResp.UserCode == "":
+		return nil, errors.New(ctx, errors.Unknown, op, "device authorization response is missing the user code")
+	case authResp.VerificationUri == "":
+		return nil, errors.New(ctx, errors.Unknown, op, "device authorization response is missing the verification uri")
+	}
+	if authResp.Interval == 0 {
+		authResp.Interval = defaultDeviceInterval
+	}
+	return &authResp, nil
+}
+
+// requestDeviceToken sends a device access token request for the device
+// code to the provider's token endpoint. When the user hasn't completed the
+// authorization yet, the returned response only contains the
+// authorization_pending or slow_down error.
+//
+// See https://www.rfc-editor.org/rfc/rfc8628#section-3.4
+func requestDeviceToken(ctx context.Context, client *http.Client, endpoint string, am *AuthMethod, deviceCode string) (*deviceTokenResponse, error) {
+	const op = "oidc.requestDeviceToken"
+	if am == nil || am.AuthMethod == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if deviceCode == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing device code")
+	}
+	form := url.Values{
+		"grant_type":  {deviceCodeGrantType},
+		"device_code": {deviceCode},
+		"client_id":   {am.ClientId},
+	}
+	var tkResp deviceTokenResponse
+	errResp, err := postDeviceForm(ctx, client, endpoint, am, form, &tkResp)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if errResp != nil {
+		switch errResp.Error {
+		case deviceErrAuthorizationPending, deviceErrSlowDown:
+			return errResp, nil
+		case deviceErrAccessDenied:
+			return nil, errors.New(ctx, errors.Forbidden, op, "device authorization request was denied")
+		case deviceErrExpiredToken:
+			return nil, errors.New(ctx, errors.AuthAttemptExpired, op, "device code has expired")
+		default:
+			return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("device access token request failed: %s: %s", errResp.Error, errResp.ErrorDescription))
+		}
+	}
+	if tkResp.IdToken == "" {
+		return nil, errors.New(ctx, errors.Unknown, op, "id_token is missing from device access token response")
+	}
+	return &tkResp, nil
+}
+
+// postDeviceForm posts the form to the endpoint using the auth method's
+// client credentials and decodes a successful response into v. If the
+// provider responds with an OAuth 2.0 error, it's returned as errResp.
+func postDeviceForm(ctx context.Context, client *http.Client, endpoint string, am *AuthMethod, form url.Values, v any) (errResp *deviceTokenResponse, e error) {
+	const op = "oidc.postDeviceForm"
+	if client == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing http client")
+	}
+	if endpoint == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing endpoint")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to create request", errors.WithWrap(err))
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if am.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(am.ClientId), url.QueryEscape(am.ClientSecret))
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to send request to provider", errors.WithWrap(err))
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxDeviceResponseSize))
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to read provider response", errors.WithWrap(err))
+	}
+	if resp.StatusCode != http.StatusOK {
+		var oauthErr deviceTokenResponse
+		if err := json.Unmarshal(body, &oauthErr); err != nil || oauthErr.Error == "" {
+			return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("unexpected provider response status %s", resp.Status))
+		}
+		return &oauthErr, nil
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to parse provider response", errors.WithWrap(err))
+	}
+	return nil, nil
+}
+
+// verifyDeviceIdToken verifies the ID Token returned by a device access token
+// request and returns its claims. The ID Token's signature, issuer, audience
+// and expiration are verified. Unlike the authorization code flow, there is no
+// nonce to verify.
+func verifyDeviceIdToken(ctx context.Context, client *http.Client, jwksUri string, am *AuthMethod, rawIdToken string) (map[string]any, error) {
+	const op = "oidc.verifyDeviceIdToken"
+	if am == nil || am.AuthMethod == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if rawIdToken == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing id token")
+	}
+	keySet := gooidc.NewRemoteKeySet(gooidc.ClientContext(ctx, client), jwksUri)
+	verifier := gooidc.NewVerifier(am.Issuer, keySet, &gooidc.Config{
+		ClientID:             am.ClientId,
+		SupportedSigningAlgs: am.SigningAlgs,
+	})
+	idTk, err := verifier.Verify(ctx, rawIdToken)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "invalid id token", errors.WithWrap(err))
+	}
+	if idTk.Subject == "" {
+		return nil, errors.New(ctx, errors.Unknown, op, "id token is missing the subject")
+	}
+	if len(am.AudClaims) > 0 {
+		var found bool
+		for _, aud := range idTk.Audience {
+			if strutil.StrListContains(am.AudClaims, aud) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("id token audiences %q do not include an allowed audience", idTk.Audience))
+		}
+	}
+	claims := map[string]any{}
+	if err := idTk.Claims(&claims); err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to parse id token claims", errors.WithWrap(err))
+	}
+	return claims, nil
+}
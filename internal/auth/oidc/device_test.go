@@ -0,0 +1,361 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/cap/oidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDeviceIdp is a minimal identity provider which supports the device
+// authorization grant.
+type testDeviceIdp struct {
+	t   *testing.T
+	srv *httptest.Server
+
+	mu            sync.Mutex
+	noDeviceAuth  bool
+	authForm      map[string][]string
+	authUser      string
+	authPass      string
+	tokenErr      string
+	idToken       string
+	deviceCode    string
+	tokenRequests int
+}
+
+func newTestDeviceIdp(t *testing.T, pub any) *testDeviceIdp {
+	t.Helper()
+	idp := &testDeviceIdp{t: t, deviceCode: "test-device-code"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		idp.mu.Lock()
+		defer idp.mu.Unlock()
+		doc := map[string]string{
+			"issuer":         idp.srv.URL,
+			"token_endpoint": idp.srv.URL + "/token",
+			"jwks_uri":       idp.srv.URL + "/jwks",
+		}
+		if !idp.noDeviceAuth {
+			doc["device_authorization_endpoint"] = idp.srv.URL + "/device"
+		}
+		idp.writeJson(w, http.StatusOK, doc)
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		idp.writeJson(w, http.StatusOK, jose.JSONWebKeySet{
+			Keys: []jose.JSONWebKey{{Key: pub, Algorithm: string(oidc.ES256), Use: "sig"}},
+		})
+	})
+	mux.HandleFunc("/device", func(w http.ResponseWriter, r *http.Request) {
+		idp.mu.Lock()
+		defer idp.mu.Unlock()
+		require.NoError(t, r.ParseForm())
+		idp.authForm = r.PostForm
+		idp.authUser, idp.authPass, _ = r.BasicAuth()
+		idp.writeJson(w, http.StatusOK, map[string]any{
+			"device_code":               idp.deviceCode,
+			"user_code":                 "ABCD-EFGH",
+			"verification_uri":          idp.srv.URL + "/activate",
+			"verification_uri_complete": idp.srv.URL + "/activate?user_code=ABCD-EFGH",
+			"expires_in":                600,
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		idp.mu.Lock()
+		defer idp.mu.Unlock()
+		idp.tokenRequests++
+		require.NoError(t, r.ParseForm())
+		if r.PostForm.Get("grant_type") != deviceCodeGrantType || r.PostForm.Get("device_code") != idp.deviceCode {
+			idp.writeJson(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+			return
+		}
+		if idp.tokenErr != "" {
+			idp.writeJson(w, http.StatusBadRequest, map[string]string{"error": idp.tokenErr})
+			return
+		}
+		idp.writeJson(w, http.StatusOK, map[string]string{
+			"access_token": "test-access-token",
+			"token_type":   "Bearer",
+			"id_token":     idp.idToken,
+		})
+	})
+	idp.srv = httptest.NewServer(mux)
+	t.Cleanup(idp.srv.Close)
+	return idp
+}
+
+func (idp *testDeviceIdp) writeJson(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	require.NoError(idp.t, json.NewEncoder(w).Encode(v))
+}
+
+func (idp *testDeviceIdp) set(f func(*testDeviceIdp)) {
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	f(idp)
+}
+
+func testDeviceAuthMethod(issuer string) *AuthMethod {
+	am := AllocAuthMethod()
+	am.PublicId = "amoidc_1234567890"
+	am.ScopeId = "global"
+	am.Issuer = issuer
+	am.ClientId = "test-client-id"
+	am.ClientSecret = "test-client-secret"
+	am.SigningAlgs = []string{string(oidc.ES256)}
+	am.ClaimsScopes = []string{"email", "profile"}
+	return &am
+}
+
+func Test_discoverDeviceEndpoints(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	pub, _ := oidc.TestGenerateKeys(t)
+	idp := newTestDeviceIdp(t, pub)
+
+	t.Run("valid", func(t *testing.T) {
+		got, err := discoverDeviceEndpoints(ctx, http.DefaultClient, idp.srv.URL)
+		require.NoError(t, err)
+		assert.Equal(t, idp.srv.URL+"/device", got.DeviceAuthorizationEndpoint)
+		assert.Equal(t, idp.srv.URL+"/token", got.TokenEndpoint)
+		assert.Equal(t, idp.srv.URL+"/jwks", got.JwksUri)
+	})
+	t.Run("issuer-mismatch", func(t *testing.T) {
+		_, err := discoverDeviceEndpoints(ctx, http.DefaultClient, idp.srv.URL+"/")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not match")
+	})
+	t.Run("missing-client", func(t *testing.T) {
+		_, err := discoverDeviceEndpoints(ctx, nil, idp.srv.URL)
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("unsupported", func(t *testing.T) {
+		idp.set(func(idp *testDeviceIdp) { idp.noDeviceAuth = true })
+		defer idp.set(func(idp *testDeviceIdp) { idp.noDeviceAuth = false })
+		_, err := discoverDeviceEndpoints(ctx, http.DefaultClient, idp.srv.URL)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not support the device authorization grant")
+	})
+}
+
+func Test_requestDeviceAuthorization(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	pub, _ := oidc.TestGenerateKeys(t)
+	idp := newTestDeviceIdp(t, pub)
+	am := testDeviceAuthMethod(idp.srv.URL)
+
+	got, err := requestDeviceAuthorization(ctx, http.DefaultClient, idp.srv.URL+"/device", am)
+	require.NoError(t, err)
+	assert.Equal(t, "test-device-code", got.DeviceCode)
+	assert.Equal(t, "ABCD-EFGH", got.UserCode)
+	assert.Equal(t, idp.srv.URL+"/activate", got.VerificationUri)
+	assert.Equal(t, uint32(600), got.ExpiresIn)
+	assert.Equal(t, uint32(defaultDeviceInterval), got.Interval)
+
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	assert.Equal(t, "openid email profile", idp.authForm["scope"][0])
+	assert.Equal(t, am.ClientId, idp.authForm["client_id"][0])
+	assert.Equal(t, am.ClientId, idp.authUser)
+	assert.Equal(t, am.ClientSecret, idp.authPass)
+}
+
+func Test_requestDeviceToken(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	pub, _ := oidc.TestGenerateKeys(t)
+	idp := newTestDeviceIdp(t, pub)
+	am := testDeviceAuthMethod(idp.srv.URL)
+	endpoint := idp.srv.URL + "/token"
+
+	tests := []struct {
+		name        string
+		tokenErr    string
+		deviceCode  string
+		wantPending bool
+		wantErr     bool
+		wantErrCode errors.Code
+	}{
+		{name: "pending", tokenErr: deviceErrAuthorizationPending, wantPending: true},
+		{name: "slow-down", tokenErr: deviceErrSlowDown, wantPending: true},
+		{name: "denied", tokenErr: deviceErrAccessDenied, wantErr: true, wantErrCode: errors.Forbidden},
+		{name: "expired", tokenErr: deviceErrExpiredToken, wantErr: true, wantErrCode: errors.AuthAttemptExpired},
+		{name: "invalid-grant", deviceCode: "unknown", wantErr: true, wantErrCode: errors.Unknown},
+		{name: "missing-device-code", deviceCode: "", wantErr: true, wantErrCode: errors.InvalidParameter},
+		{name: "success"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idp.set(func(idp *testDeviceIdp) {
+				idp.tokenErr = tt.tokenErr
+				idp.idToken = "test-id-token"
+			})
+			deviceCode := tt.deviceCode
+			if deviceCode == "" && tt.name != "missing-device-code" {
+				deviceCode = "test-device-code"
+			}
+			got, err := requestDeviceToken(ctx, http.DefaultClient, endpoint, am, deviceCode)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Truef(t, errors.Match(errors.T(tt.wantErrCode), err), "unexpected error: %s", err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.wantPending {
+				require.NotNil(t, got)
+				assert.Equal(t, tt.tokenErr, got.Error)
+				assert.Empty(t, got.IdToken)
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, "test-id-token", got.IdToken)
+			assert.Equal(t, "test-access-token", got.AccessToken)
+		})
+	}
+}
+
+func Test_deviceFlows(t *testing.T) {
+	t.Parallel()
+	endpoints := &deviceEndpoints{TokenEndpoint: "https://alice.com/token"}
+	interval := defaultDeviceInterval * time.Second
+
+	t.Run("endpoints", func(t *testing.T) {
+		c := newDeviceFlowCache()
+		_, ok := c.endpoints("req_1", 1)
+		assert.False(t, ok)
+
+		c.set("req_1", &deviceFlow{endpoints: endpoints, configHash: 1, interval: interval, expiration: time.Now().Add(time.Minute)})
+		got, ok := c.endpoints("req_1", 1)
+		require.True(t, ok)
+		assert.Equal(t, endpoints, got)
+
+		// the provider's configuration changed
+		_, ok = c.endpoints("req_1", 2)
+		assert.False(t, ok)
+
+		c.delete("req_1")
+		_, ok = c.endpoints("req_1", 1)
+		assert.False(t, ok)
+	})
+	t.Run("poll", func(t *testing.T) {
+		c := newDeviceFlowCache()
+		now := time.Now()
+		c.set("req_1", &deviceFlow{endpoints: endpoints, interval: interval, expiration: now.Add(time.Minute)})
+
+		ready, got := c.poll("req_1", now)
+		assert.True(t, ready)
+		assert.Equal(t, interval, got)
+
+		// polling again before the interval has elapsed is skipped
+		ready, got = c.poll("req_1", now.Add(interval-time.Second))
+		assert.False(t, ready)
+		assert.Equal(t, interval, got)
+
+		ready, _ = c.poll("req_1", now.Add(interval))
+		assert.True(t, ready)
+	})
+	t.Run("slow-down", func(t *testing.T) {
+		c := newDeviceFlowCache()
+		now := time.Now()
+		c.set("req_1", &deviceFlow{endpoints: endpoints, interval: interval, expiration: now.Add(time.Minute)})
+
+		ready, _ := c.poll("req_1", now)
+		require.True(t, ready)
+		got := c.slowDown("req_1", now)
+		assert.Equal(t, interval+deviceSlowDownIncrement, got)
+
+		ready, got = c.poll("req_1", now.Add(interval))
+		assert.False(t, ready)
+		assert.Equal(t, interval+deviceSlowDownIncrement, got)
+
+		ready, _ = c.poll("req_1", now.Add(interval+deviceSlowDownIncrement))
+		assert.True(t, ready)
+
+		assert.Equal(t, interval+2*deviceSlowDownIncrement, c.slowDown("req_1", now))
+	})
+	t.Run("expired", func(t *testing.T) {
+		c := newDeviceFlowCache()
+		c.set("req_1", &deviceFlow{endpoints: endpoints, interval: interval, expiration: time.Now().Add(-time.Second)})
+		c.set("req_2", &deviceFlow{endpoints: endpoints, interval: interval, expiration: time.Now().Add(time.Minute)})
+		_, ok := c.endpoints("req_1", 0)
+		assert.False(t, ok)
+		_, ok = c.endpoints("req_2", 0)
+		assert.True(t, ok)
+	})
+}
+
+func Test_verifyDeviceIdToken(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	pub, priv := oidc.TestGenerateKeys(t)
+	idp := newTestDeviceIdp(t, pub)
+	jwksUri := idp.srv.URL + "/jwks"
+
+	claims := func(aud ...string) map[string]any {
+		now := time.Now()
+		return map[string]any{
+			"iss":   idp.srv.URL,
+			"sub":   "alice",
+			"aud":   aud,
+			"iat":   now.Unix(),
+			"nbf":   now.Add(-time.Minute).Unix(),
+			"exp":   now.Add(time.Minute).Unix(),
+			"email": "alice@example.com",
+		}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		am := testDeviceAuthMethod(idp.srv.URL)
+		raw := oidc.TestSignJWT(t, priv, string(oidc.ES256), claims(am.ClientId), nil)
+		got, err := verifyDeviceIdToken(ctx, http.DefaultClient, jwksUri, am, raw)
+		require.NoError(t, err)
+		assert.Equal(t, "alice", got["sub"])
+		assert.Equal(t, "alice@example.com", got["email"])
+	})
+	t.Run("wrong-client-id", func(t *testing.T) {
+		am := testDeviceAuthMethod(idp.srv.URL)
+		raw := oidc.TestSignJWT(t, priv, string(oidc.ES256), claims("another-client"), nil)
+		_, err := verifyDeviceIdToken(ctx, http.DefaultClient, jwksUri, am, raw)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid id token")
+	})
+	t.Run("missing-allowed-audience", func(t *testing.T) {
+		am := testDeviceAuthMethod(idp.srv.URL)
+		am.AudClaims = []string{"allowed-audience"}
+		raw := oidc.TestSignJWT(t, priv, string(oidc.ES256), claims(am.ClientId), nil)
+		_, err := verifyDeviceIdToken(ctx, http.DefaultClient, jwksUri, am, raw)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "do not include an allowed audience")
+	})
+	t.Run("wrong-key", func(t *testing.T) {
+		am := testDeviceAuthMethod(idp.srv.URL)
+		_, otherPriv := oidc.TestGenerateKeys(t)
+		raw := oidc.TestSignJWT(t, otherPriv, string(oidc.ES256), claims(am.ClientId), nil)
+		_, err := verifyDeviceIdToken(ctx, http.DefaultClient, jwksUri, am, raw)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid id token")
+	})
+	t.Run("expired", func(t *testing.T) {
+		am := testDeviceAuthMethod(idp.srv.URL)
+		c := claims(am.ClientId)
+		c["exp"] = time.Now().Add(-time.Hour).Unix()
+		raw := oidc.TestSignJWT(t, priv, string(oidc.ES256), c, nil)
+		_, err := verifyDeviceIdToken(ctx, http.DefaultClient, jwksUri, am, raw)
+		require.Error(t, err)
+	})
+}
@@ -51,6 +51,8 @@ type options struct {
 	withAccountClaimMap     map[string]AccountToClaim
 	withReader              db.Reader
 	withStartPageAfterItem  pagination.Item
+	withOidcRepoFn          OidcRepoFactory
+	withIamRepoFn           IamRepoFactory
 }
 
 func getDefaultOptions() options {
@@ -250,3 +252,12 @@ func WithStartPageAfterItem(item pagination.Item) Option {
 		o.withStartPageAfterItem = item
 	}
 }
+
+// WithDeviceAuthRepositories provides the repository functions used by
+// TokenRequest to complete a device authorization request.
+func WithDeviceAuthRepositories(oidcRepoFn OidcRepoFactory, iamRepoFn IamRepoFactory) Option {
+	return func(o *options) {
+		o.withOidcRepoFn = oidcRepoFn
+		o.withIamRepoFn = iamRepoFn
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/pagination"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -237,4 +238,15 @@ func Test_GetOpts(t *testing.T) {
 		assert.Equal(opts.withStartPageAfterItem.GetUpdateTime(), timestamp.New(updateTime))
 		assert.Equal(opts.withStartPageAfterItem.GetCreateTime(), timestamp.New(createTime))
 	})
+	t.Run("WithDeviceAuthRepositories", func(t *testing.T) {
+		assert := assert.New(t)
+		testOpts := getDefaultOptions()
+		assert.Nil(testOpts.withOidcRepoFn)
+		assert.Nil(testOpts.withIamRepoFn)
+		oidcRepoFn := func() (*Repository, error) { return nil, nil }
+		iamRepoFn := func() (*iam.Repository, error) { return nil, nil }
+		opts := getOpts(WithDeviceAuthRepositories(oidcRepoFn, iamRepoFn))
+		assert.NotNil(opts.withOidcRepoFn)
+		assert.NotNil(opts.withIamRepoFn)
+	})
 }
@@ -187,15 +187,37 @@ func Callback(
 		}
 	}
 
+	if err := issuePendingToken(ctx, r, iamRepoFn, atRepoFn, am, reqState.TokenRequestId, idTkClaims, userInfoClaims); err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	// tada!  we can return a final redirect URL for the successful authentication.
+	return reqState.FinalRedirectUrl, nil
+}
+
+// issuePendingToken upserts the account of an authenticated user from the ID
+// Token and userinfo claims, sets the account's managed group memberships and
+// creates a pending auth token with the tokenRequestId as its public id, so it
+// can be retrieved by the polling client that initiated the authentication
+// attempt.
+func issuePendingToken(
+	ctx context.Context,
+	r *Repository,
+	iamRepoFn IamRepoFactory,
+	atRepoFn AuthTokenRepoFactory,
+	am *AuthMethod,
+	tokenRequestId string,
+	idTkClaims, userInfoClaims map[string]any,
+) error {
+	const op = "oidc.issuePendingToken"
 	acct, err := r.upsertAccount(ctx, am, idTkClaims, userInfoClaims)
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op)
+		return errors.Wrap(ctx, err, op)
 	}
 
 	// Get the set of all managed groups so we can filter
 	mgs, _, err := r.ListManagedGroups(ctx, am.GetPublicId())
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op)
+		return errors.Wrap(ctx, err, op)
 	}
 	if len(mgs) > 0 {
 		matchedMgs := make([]*ManagedGroup, 0, len(mgs))
@@ -209,11 +231,11 @@ func Callback(
 			if err != nil {
 				// We check all filters on ingress so this should never happen,
 				// but we validate anyways
-				return "", errors.Wrap(ctx, err, op)
+				return errors.Wrap(ctx, err, op)
 			}
 			match, err := eval.Evaluate(evalData)
 			if err != nil && !errors.Is(err, pointerstructure.ErrNotFound) {
-				return "", errors.Wrap(ctx, err, op)
+				return errors.Wrap(ctx, err, op)
 			}
 			if match {
 				matchedMgs = append(matchedMgs, mg)
@@ -222,7 +244,7 @@ func Callback(
 		// We always pass it in, even if none match, because in that case we
 		// need to remove any mappings that exist
 		if _, _, err := r.SetManagedGroupMemberships(ctx, am, acct, matchedMgs); err != nil {
-			return "", errors.Wrap(ctx, err, op)
+			return errors.Wrap(ctx, err, op)
 		}
 	}
 
@@ -231,17 +253,17 @@ func Callback(
 	// autovivify users for the scope.
 	iamRepo, err := iamRepoFn()
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op)
+		return errors.Wrap(ctx, err, op)
 	}
 
 	_, err = iamRepo.LookupScope(ctx, am.ScopeId)
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup account scope: "+am.ScopeId))
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup account scope: "+am.ScopeId))
 	}
 
 	user, err := iamRepo.LookupUserWithLogin(ctx, acct.PublicId)
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op)
+		return errors.Wrap(ctx, err, op)
 	}
 
 	// Now we need to check filters and assign managed groups by filter.
@@ -251,19 +273,18 @@ func Callback(
 	// that initialed the authentication attempt.
 	tokenRepo, err := atRepoFn()
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op)
+		return errors.Wrap(ctx, err, op)
 	}
-	authToken, err := tokenRepo.CreateAuthToken(ctx, user, acct.PublicId, authtoken.WithPublicId(reqState.TokenRequestId), authtoken.WithStatus(authtoken.PendingStatus))
+	authToken, err := tokenRepo.CreateAuthToken(ctx, user, acct.PublicId, authtoken.WithPublicId(tokenRequestId), authtoken.WithStatus(authtoken.PendingStatus))
 	if err != nil {
 		if errors.Match(errors.T(errors.NotUnique), err) {
-			return "", errors.New(ctx, errors.Forbidden, op, "not a unique request", errors.WithWrap(err))
+			return errors.New(ctx, errors.Forbidden, op, "not a unique request", errors.WithWrap(err))
 		}
-		return "", errors.Wrap(ctx, err, op)
+		return errors.Wrap(ctx, err, op)
 	}
 	if err := event.WriteObservation(ctx, op, event.WithDetails("user_id", user.GetPublicId(), "auth_token_start",
 		authToken.GetCreateTime(), "auth_token_end", authToken.GetExpirationTime())); err != nil {
-		return "", errors.Wrap(ctx, err, op, errors.WithMsg("Unable to write observation event for authenticate method"))
+		return errors.Wrap(ctx, err, op, errors.WithMsg("Unable to write observation event for authenticate method"))
 	}
-	// tada!  we can return a final redirect URL for the successful authentication.
-	return reqState.FinalRedirectUrl, nil
+	return nil
 }
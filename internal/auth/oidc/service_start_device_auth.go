@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/oidc/request"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StartDeviceAuth accepts a request to start an OIDC authentication attempt
+// using the OAuth 2.0 device authorization grant, for clients which are unable
+// to open a browser. It sends a device authorization request to the provider
+// and returns the user code and verification URI the user needs to complete
+// the authorization on another device, along with a tokenId.  The tokenId is
+// an encrypted payload, which includes the provider's device code, for the
+// token requests the client polls with until the user has completed the
+// authorization.
+//
+// See https://www.rfc-editor.org/rfc/rfc8628
+//
+// If the auth method is in an InactiveState, then an error is returned.
+func StartDeviceAuth(ctx context.Context, oidcRepoFn OidcRepoFactory, authMethodId string) (*DeviceAuthorization, string, error) {
+	const op = "oidc.StartDeviceAuth"
+	if authMethodId == "" {
+		return nil, "", errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	if oidcRepoFn == nil {
+		return nil, "", errors.New(ctx, errors.InvalidParameter, op, "missing oidc repo function")
+	}
+	r, err := oidcRepoFn()
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	am, err := r.lookupAuthMethod(ctx, authMethodId)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		return nil, "", errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s not found", authMethodId))
+	}
+	if am.OperationalState == string(InactiveState) {
+		return nil, "", errors.New(ctx, errors.AuthMethodInactive, op, "not allowed to start authentication attempt")
+	}
+
+	// get the provider from the cache (if possible)
+	provider, err := providerCache().get(ctx, am)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	hash, err := provider.ConfigHash()
+	if err != nil {
+		return nil, "", errors.New(ctx, errors.Unknown, op, "unable to get provider config hash", errors.WithWrap(err))
+	}
+	client, err := provider.HTTPClient()
+	if err != nil {
+		return nil, "", errors.New(ctx, errors.Unknown, op, "unable to get provider http client", errors.WithWrap(err))
+	}
+	endpoints, err := discoverDeviceEndpoints(ctx, client, am.Issuer)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	authResp, err := requestDeviceAuthorization(ctx, client, endpoints.DeviceAuthorizationEndpoint, am)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+
+	// the attempt expires with the device code, but never later than an
+	// authorization code flow attempt would.
+	expIn := AttemptExpiration
+	if authResp.ExpiresIn > 0 && time.Duration(authResp.ExpiresIn)*time.Second < expIn {
+		expIn = time.Duration(authResp.ExpiresIn) * time.Second
+	}
+	exp := timestamppb.New(time.Now().Add(expIn).Truncate(time.Second))
+
+	tokenRequestId, err := authtoken.NewAuthTokenId(ctx)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	requestWrapper, err := requestWrappingWrapper(ctx, r.kms, am.ScopeId, authMethodId)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	t := &request.Token{
+		RequestId:          tokenRequestId,
+		ExpirationTime:     &timestamp.Timestamp{Timestamp: exp},
+		DeviceCode:         authResp.DeviceCode,
+		ProviderConfigHash: hash,
+		Interval:           authResp.Interval,
+	}
+	encodedEncryptedTk, err := encryptMessage(ctx, requestWrapper, am, t)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+
+	// cache the endpoints for the token requests, which aren't expected
+	// before the interval has elapsed.
+	interval := time.Duration(authResp.Interval) * time.Second
+	deviceFlowCache().set(tokenRequestId, &deviceFlow{
+		endpoints:  endpoints,
+		configHash: hash,
+		interval:   interval,
+		nextPoll:   time.Now().Add(interval),
+		expiration: exp.AsTime(),
+	})
+	return &DeviceAuthorization{
+		UserCode:                authResp.UserCode,
+		VerificationUri:         authResp.VerificationUri,
+		VerificationUriComplete: authResp.VerificationUriComplete,
+		ExpiresIn:               uint32(expIn / time.Second),
+		Interval:                authResp.Interval,
+	}, encodedEncryptedTk, nil
+}
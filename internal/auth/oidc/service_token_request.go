@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
+	"golang.org/x/oauth2"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -22,77 +23,215 @@ import (
 //
 // * Decrypt the tokenRequestId.  If encryption fails, it returns an error.
 //
+// * If the request was started with StartDeviceAuth and the pending token
+// hasn't been created yet, poll the provider's token endpoint with the device
+// code, at most once per the provider's interval.  Once the user has completed
+// the authorization, verify the returned ID Token and create the pending token
+// like Callback does.  Until then, the returned interval is the number of
+// seconds the client should wait before its next token request.
+//
 // * Use the authtoken.(Repository).IssueAuthToken to issue the request id's
 // token and mark it as issued in the repo.  If the token is already issue, an
 // error is returned.
-func TokenRequest(ctx context.Context, kms *kms.Kms, atRepoFn AuthTokenRepoFactory, authMethodId, tokenRequestId string) (*authtoken.AuthToken, error) {
+//
+// Options supported:
+//
+// WithDeviceAuthRepositories(OidcRepoFactory, IamRepoFactory) provides the
+// repository functions required to complete a device authorization request.
+func TokenRequest(ctx context.Context, kms *kms.Kms, atRepoFn AuthTokenRepoFactory, authMethodId, tokenRequestId string, opt ...Option) (*authtoken.AuthToken, uint32, error) {
 	const op = "oidc.TokenRequest"
 	if kms == nil {
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+		return nil, 0, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
 	}
 	if atRepoFn == nil {
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth token repo function")
+		return nil, 0, errors.New(ctx, errors.InvalidParameter, op, "missing auth token repo function")
 	}
 	if authMethodId == "" {
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+		return nil, 0, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
 	}
 	if tokenRequestId == "" {
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing token request id")
+		return nil, 0, errors.New(ctx, errors.InvalidParameter, op, "missing token request id")
 	}
 
 	reqTkWrapper, err := UnwrapMessage(ctx, tokenRequestId)
 	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
+		return nil, 0, errors.Wrap(ctx, err, op)
 	}
 	if reqTkWrapper.ScopeId == "" {
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "request token id wrapper missing scope id")
+		return nil, 0, errors.New(ctx, errors.InvalidParameter, op, "request token id wrapper missing scope id")
 	}
 	if reqTkWrapper.AuthMethodId == "" {
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "request token id wrapper missing auth method id")
+		return nil, 0, errors.New(ctx, errors.InvalidParameter, op, "request token id wrapper missing auth method id")
 	}
 	if reqTkWrapper.AuthMethodId != authMethodId {
-		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s auth method id does not match request wrapper auth method id: %s", authMethodId, reqTkWrapper.AuthMethodId))
+		return nil, 0, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s auth method id does not match request wrapper auth method id: %s", authMethodId, reqTkWrapper.AuthMethodId))
 	}
 
 	// tokenRequestId is a proto request.Wrapper, which contains a cipher text field,
 	// so we need the derived wrapper that was used to encrypt it.
 	requestWrapper, err := requestWrappingWrapper(ctx, kms, reqTkWrapper.ScopeId, reqTkWrapper.AuthMethodId)
 	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
+		return nil, 0, errors.Wrap(ctx, err, op)
 	}
 	reqTkBytes, err := decryptMessage(ctx, requestWrapper, reqTkWrapper)
 	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
+		return nil, 0, errors.Wrap(ctx, err, op)
 	}
 	var reqTk request.Token
 	if err := proto.Unmarshal(reqTkBytes, &reqTk); err != nil {
-		return nil, errors.New(ctx, errors.Unknown, op, "unable to unmarshal request token", errors.WithWrap(err))
+		return nil, 0, errors.New(ctx, errors.Unknown, op, "unable to unmarshal request token", errors.WithWrap(err))
 	}
 
 	if reqTk.ExpirationTime == nil {
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing request token id expiration time")
+		return nil, 0, errors.New(ctx, errors.InvalidParameter, op, "missing request token id expiration time")
 	}
 
 	// before proceeding, make sure the request hasn't timed out
 	if time.Now().After(reqTk.ExpirationTime.Timestamp.AsTime()) {
-		return nil, errors.New(ctx, errors.AuthAttemptExpired, op, "request token id has expired")
+		return nil, 0, errors.New(ctx, errors.AuthAttemptExpired, op, "request token id has expired")
 	}
 
 	tokenRepo, err := atRepoFn()
 	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
+		return nil, 0, errors.Wrap(ctx, err, op)
 	}
 	authTk, err := tokenRepo.IssueAuthToken(ctx, reqTk.RequestId)
+	if err != nil && errors.Match(errors.T(errors.RecordNotFound), err) && reqTk.DeviceCode != "" {
+		opts := getOpts(opt...)
+		var completed bool
+		var interval time.Duration
+		completed, interval, err = completeDeviceAuth(ctx, opts.withOidcRepoFn, opts.withIamRepoFn, atRepoFn, authMethodId, &reqTk)
+		switch {
+		case err != nil:
+			return nil, 0, errors.Wrap(ctx, err, op)
+		case !completed:
+			return nil, uint32(interval / time.Second), nil
+		}
+		authTk, err = tokenRepo.IssueAuthToken(ctx, reqTk.RequestId)
+	}
 	if err != nil {
 		if errors.Match(errors.T(errors.RecordNotFound), err) {
 			// We don't have it -- at least not yet. So don't mark it as an
 			// error, but nothing is returned.
-			return nil, nil
+			return nil, 0, nil
 		}
-		return nil, errors.Wrap(ctx, err, op)
+		return nil, 0, errors.Wrap(ctx, err, op)
 	}
 	if authTk.Token == "" {
-		return nil, errors.New(ctx, errors.Internal, op, "issued token is missing")
+		return nil, 0, errors.New(ctx, errors.Internal, op, "issued token is missing")
+	}
+	return authTk, 0, nil
+}
+
+// completeDeviceAuth polls the provider's token endpoint with the device code
+// of the request token, unless the request's interval hasn't elapsed since
+// the last poll.  It returns false, along with the interval, if the user
+// hasn't completed the authorization yet.  Otherwise, the returned ID Token is
+// verified and a pending token is created for the request token's request id.
+func completeDeviceAuth(
+	ctx context.Context,
+	oidcRepoFn OidcRepoFactory,
+	iamRepoFn IamRepoFactory,
+	atRepoFn AuthTokenRepoFactory,
+	authMethodId string,
+	reqTk *request.Token,
+) (bool, time.Duration, error) {
+	const op = "oidc.completeDeviceAuth"
+	if oidcRepoFn == nil {
+		return false, 0, errors.New(ctx, errors.InvalidParameter, op, "missing oidc repository function")
+	}
+	if iamRepoFn == nil {
+		return false, 0, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository function")
+	}
+	r, err := oidcRepoFn()
+	if err != nil {
+		return false, 0, errors.Wrap(ctx, err, op)
+	}
+	am, err := r.lookupAuthMethod(ctx, authMethodId)
+	if err != nil {
+		return false, 0, errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		return false, 0, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s not found", authMethodId))
+	}
+	provider, err := providerCache().get(ctx, am)
+	if err != nil {
+		return false, 0, errors.Wrap(ctx, err, op)
+	}
+
+	// if auth method is inactive, we don't allow inflight requests to finish if the
+	// auth method's config has changed since the request was kicked off.
+	hash, err := provider.ConfigHash()
+	if err != nil {
+		return false, 0, errors.New(ctx, errors.Unknown, op, "unable to get provider config hash", errors.WithWrap(err))
+	}
+	if reqTk.ProviderConfigHash != hash && am.OperationalState == string(InactiveState) {
+		return false, 0, errors.New(ctx, errors.AuthMethodInactive, op, "auth method configuration changed during in-flight authentication attempt")
+	}
+
+	client, err := provider.HTTPClient()
+	if err != nil {
+		return false, 0, errors.New(ctx, errors.Unknown, op, "unable to get provider http client", errors.WithWrap(err))
+	}
+
+	// the endpoints are only discovered when the request isn't cached, which
+	// happens when another controller started it.
+	flows := deviceFlowCache()
+	endpoints, ok := flows.endpoints(reqTk.RequestId, hash)
+	if !ok {
+		endpoints, err = discoverDeviceEndpoints(ctx, client, am.Issuer)
+		if err != nil {
+			return false, 0, errors.Wrap(ctx, err, op)
+		}
+		interval := time.Duration(reqTk.Interval) * time.Second
+		if interval == 0 {
+			interval = defaultDeviceInterval * time.Second
+		}
+		flows.set(reqTk.RequestId, &deviceFlow{
+			endpoints:  endpoints,
+			configHash: hash,
+			interval:   interval,
+			expiration: reqTk.ExpirationTime.Timestamp.AsTime(),
+		})
+	}
+	now := time.Now()
+	ready, interval := flows.poll(reqTk.RequestId, now)
+	if !ready {
+		return false, interval, nil
+	}
+	tkResp, err := requestDeviceToken(ctx, client, endpoints.TokenEndpoint, am, reqTk.DeviceCode)
+	if err != nil {
+		flows.delete(reqTk.RequestId)
+		return false, 0, errors.Wrap(ctx, err, op)
+	}
+	switch tkResp.Error {
+	case deviceErrAuthorizationPending:
+		return false, interval, nil
+	case deviceErrSlowDown:
+		return false, flows.slowDown(reqTk.RequestId, now), nil
+	}
+	flows.delete(reqTk.RequestId)
+	idTkClaims, err := verifyDeviceIdToken(ctx, client, endpoints.JwksUri, am, tkResp.IdToken)
+	if err != nil {
+		return false, 0, errors.Wrap(ctx, err, op)
+	}
+
+	userInfoClaims := map[string]any{} // intentionally, NOT nil for call to upsertAccount(...)
+	if tkResp.AccessToken != "" {
+		sub, _ := idTkClaims["sub"].(string)
+		tokenSource := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: tkResp.AccessToken})
+		if err := provider.UserInfo(ctx, tokenSource, sub, &userInfoClaims); err != nil {
+			return false, 0, errors.New(ctx, errors.Unknown, op, "unable to get user info from provider", errors.WithWrap(err))
+		}
+	}
+
+	if err := issuePendingToken(ctx, r, iamRepoFn, atRepoFn, am, reqTk.RequestId, idTkClaims, userInfoClaims); err != nil {
+		// a concurrent token request may have completed the authorization
+		// first, in which case the pending token already exists.
+		if errors.Match(errors.T(errors.Forbidden), err) {
+			return true, 0, nil
+		}
+		return false, 0, errors.Wrap(ctx, err, op)
 	}
-	return authTk, nil
+	return true, 0, nil
 }
@@ -132,6 +132,12 @@ type Argon2Credential struct {
 	tableName string
 }
 
+func allocArgon2Credential() *Argon2Credential {
+	return &Argon2Credential{
+		Argon2Credential: &store.Argon2Credential{},
+	}
+}
+
 func newArgon2Credential(ctx context.Context, accountId string, password string, conf *Argon2Configuration) (*Argon2Credential, error) {
 	const op = "password.newArgon2Credential"
 	if accountId == "" {
@@ -208,6 +214,23 @@ func (c *Argon2Credential) decrypt(ctx context.Context, cipher wrapping.Wrapper)
 	return nil
 }
 
+// argon2CredentialHistory is a previous Argon2Credential of an account. It
+// is kept to prevent a password from being reused.
+type argon2CredentialHistory struct {
+	*Argon2Credential
+}
+
+func allocArgon2CredentialHistory() *argon2CredentialHistory {
+	return &argon2CredentialHistory{
+		Argon2Credential: allocArgon2Credential(),
+	}
+}
+
+// TableName returns the table name.
+func (c *argon2CredentialHistory) TableName() string {
+	return "auth_password_argon2_cred_history"
+}
+
 func (c *Argon2Credential) oplog(op oplog.OpType) oplog.Metadata {
 	metadata := oplog.Metadata{
 		"resource-public-id":  []string{c.PrivateId},
@@ -30,6 +30,9 @@ type options struct {
 	withOrderByCreateTime  bool
 	ascending              bool
 	withStartPageAfterItem pagination.Item
+	withTotpCode           string
+	withNewPassword        string
+	withPolicy             *Policy
 }
 
 func getDefaultOptions() options {
@@ -106,3 +109,26 @@ func WithStartPageAfterItem(item pagination.Item) Option {
 		o.withStartPageAfterItem = item
 	}
 }
+
+// WithTotpCode provides an optional TOTP code or recovery code.
+func WithTotpCode(code string) Option {
+	return func(o *options) {
+		o.withTotpCode = code
+	}
+}
+
+// WithNewPassword provides an optional new password which replaces an
+// expired password during authentication.
+func WithNewPassword(password string) Option {
+	return func(o *options) {
+		o.withNewPassword = password
+	}
+}
+
+// WithPolicy provides an optional password Policy which is set in the same
+// transaction as the AuthMethod is created or updated.
+func WithPolicy(p *Policy) Option {
+	return func(o *options) {
+		o.withPolicy = p
+	}
+}
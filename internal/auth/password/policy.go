@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package password
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// A Policy contains the password policy for an AuthMethod. The policy is
+// enforced in addition to the MinPasswordLength of the AuthMethod. A zero
+// value for any setting disables that part of the policy.
+//
+// MinUppercase, MinLowercase, MinDigits and MinSymbols are the minimum number
+// of characters of each class a new password must contain. Any character
+// which is not a letter or a digit counts as a symbol.
+//
+// HistoryCount is the number of most recent passwords, including the
+// current one, that cannot be reused when a password is changed or set.
+//
+// MaxAgeDays is the number of days after which a password expires. An
+// account with an expired password cannot authenticate until its password
+// is changed.
+//
+// MaxFailedAttempts is the number of consecutive failed authentication
+// attempts after which an account is locked. A locked account is unlocked
+// after LockoutDurationSeconds or, if LockoutDurationSeconds is 0, when its
+// password is set.
+//
+// TotpRequired requires every account to provide a TOTP code as a second
+// factor when authenticating. Accounts with a verified TOTP enrollment must
+// always provide a code, regardless of TotpRequired.
+type Policy struct {
+	PasswordMethodId       string               `gorm:"primary_key"`
+	CreateTime             *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	UpdateTime             *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	MinUppercase           uint32
+	MinLowercase           uint32
+	MinDigits              uint32
+	MinSymbols             uint32
+	HistoryCount           uint32
+	MaxAgeDays             uint32
+	MaxFailedAttempts      uint32
+	LockoutDurationSeconds uint32
+	TotpRequired           bool
+}
+
+// NewPolicy creates a new in memory Policy for authMethodId with every
+// setting disabled.
+func NewPolicy(ctx context.Context, authMethodId string) (*Policy, error) {
+	const op = "password.NewPolicy"
+	if authMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	return &Policy{PasswordMethodId: authMethodId}, nil
+}
+
+// TableName returns the table name.
+func (p *Policy) TableName() string {
+	return "auth_password_policy"
+}
+
+func (p *Policy) clone() *Policy {
+	cp := *p
+	return &cp
+}
+
+// checkComplexity returns an error with code PasswordTooWeak if password
+// does not contain the minimum number of characters of each class required
+// by p.
+func (p *Policy) checkComplexity(ctx context.Context, password string) error {
+	const op = "password.(Policy).checkComplexity"
+	var upper, lower, digits, symbols uint32
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper++
+		case unicode.IsLower(r):
+			lower++
+		case unicode.IsDigit(r):
+			digits++
+		case !unicode.IsLetter(r):
+			symbols++
+		}
+	}
+
+	var missing []string
+	if upper < p.MinUppercase {
+		missing = append(missing, fmt.Sprintf("%d uppercase", p.MinUppercase))
+	}
+	if lower < p.MinLowercase {
+		missing = append(missing, fmt.Sprintf("%d lowercase", p.MinLowercase))
+	}
+	if digits < p.MinDigits {
+		missing = append(missing, fmt.Sprintf("%d digit", p.MinDigits))
+	}
+	if symbols < p.MinSymbols {
+		missing = append(missing, fmt.Sprintf("%d symbol", p.MinSymbols))
+	}
+	if len(missing) > 0 {
+		return errors.New(ctx, errors.PasswordTooWeak, op, fmt.Sprintf("must contain at least %s characters", strings.Join(missing, ", ")))
+	}
+	return nil
+}
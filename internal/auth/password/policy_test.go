@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package password
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy_checkComplexity(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		name       string
+		policy     *Policy
+		password   string
+		wantErrMsg string
+	}{
+		{
+			name:     "no-requirements",
+			policy:   &Policy{},
+			password: "password",
+		},
+		{
+			name:     "all-met",
+			policy:   &Policy{MinUppercase: 1, MinLowercase: 2, MinDigits: 1, MinSymbols: 1},
+			password: "Pa55w.rd",
+		},
+		{
+			name:     "unicode",
+			policy:   &Policy{MinUppercase: 1, MinLowercase: 1, MinDigits: 1, MinSymbols: 1},
+			password: "Ünïcödé٣€",
+		},
+		{
+			name:       "missing-uppercase",
+			policy:     &Policy{MinUppercase: 2},
+			password:   "Password",
+			wantErrMsg: "must contain at least 2 uppercase characters",
+		},
+		{
+			name:       "missing-several",
+			policy:     &Policy{MinLowercase: 1, MinDigits: 2, MinSymbols: 1},
+			password:   "PASSWORD1",
+			wantErrMsg: "must contain at least 1 lowercase, 2 digit, 1 symbol characters",
+		},
+		{
+			name:       "spaces-are-symbols",
+			policy:     &Policy{MinSymbols: 2},
+			password:   "pass word",
+			wantErrMsg: "must contain at least 2 symbol characters",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.checkComplexity(ctx, tt.password)
+			if tt.wantErrMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Truef(t, errors.Match(errors.T(errors.PasswordTooWeak), err), "want err code: %q got: %q", errors.PasswordTooWeak, err)
+			assert.Contains(t, err.Error(), tt.wantErrMsg)
+		})
+	}
+}
@@ -16,23 +16,155 @@ select acct.name,                        -- Account.Name
        acct.version,                     -- Account.Version
        cred.private_id as credential_id, -- Account.CredentialId
        cred.private_id,                  -- Argon2Credential.PrivateId
+       cred.password_account_id,         -- Argon2Credential.PasswordAccountId
        cred.password_conf_id,            -- Argon2Credential.PasswordConfId
+       cred.password_method_id,          -- Argon2Credential.PasswordMethodId
        cred.salt,                        -- Argon2Credential.CtSalt/Salt
        cred.derived_key,                 -- Argon2Credential.DerivedKey
+       cred.key_id,                      -- Argon2Credential.KeyId
        conf.key_length,                  -- Argon2Configuration.KeyLength
        conf.iterations,                  -- Argon2Configuration.Iterations
        conf.memory,                      -- Argon2Configuration.Memory
        conf.threads,                     -- Argon2Configuration.Threads
-       meth.password_conf_id = cred.password_conf_id as is_current_conf
-  from auth_password_argon2_cred cred,
-       auth_password_argon2_conf conf,
-       auth_password_account acct,
-       auth_password_method meth
- where acct.auth_method_id = @auth_method_id
-   and acct.login_name = @login_name
-   and cred.password_conf_id = conf.private_id
-   and cred.password_account_id = acct.public_id
-   and acct.auth_method_id = meth.public_id ;
+       meth.password_conf_id = cred.password_conf_id as is_current_conf,
+       coalesce(status.failed_attempts, 0) as failed_attempts,
+       coalesce(status.locked_until > now(), false) as is_locked,
+       coalesce(pol.max_failed_attempts, 0) as max_failed_attempts,
+       coalesce(pol.lockout_duration_seconds, 0) as lockout_duration_seconds,
+       totp.verify_time is not null as totp_enrolled,
+       coalesce(pol.totp_required, false) as totp_required,
+       coalesce(pol.max_age_days > 0
+            and coalesce(status.password_change_time, cred.create_time) + make_interval(days => pol.max_age_days) < now(),
+            false) as is_expired
+            from auth_password_account acct
+            join auth_password_method meth
+              on acct.auth_method_id = meth.public_id
+            join auth_password_argon2_cred cred
+              on cred.password_account_id = acct.public_id
+            join auth_password_argon2_conf conf
+              on cred.password_conf_id = conf.private_id
+ left outer join auth_password_account_status status
+              on status.password_account_id = acct.public_id
+ left outer join auth_password_policy pol
+              on pol.password_method_id = meth.public_id
+ left outer join auth_password_totp totp
+              on totp.password_account_id = acct.public_id
+           where acct.auth_method_id = @auth_method_id
+             and acct.login_name = @login_name;
+`
+	// recordFailedAttemptQuery increments the failed authentication attempts
+	// of an account and locks the account when max_failed_attempts is
+	// reached. The count restarts after a lockout has expired.
+	recordFailedAttemptQuery = `
+insert into auth_password_account_status as status
+       (password_account_id, failed_attempts, last_failed_time, locked_until)
+values (@password_account_id, 1, now(),
+       case
+         when @max_failed_attempts::int = 1 then
+           case when @lockout_duration_seconds::int > 0
+             then now() + make_interval(secs => @lockout_duration_seconds::int)
+             else 'infinity'::timestamptz
+           end
+       end)
+    on conflict (password_account_id) do update
+   set failed_attempts  = case
+                            when status.locked_until <= now() then 1
+                            else status.failed_attempts + 1
+                          end,
+       last_failed_time = now(),
+       locked_until     = case
+                            when @max_failed_attempts::int > 0
+                             and (case when status.locked_until <= now() then 1 else status.failed_attempts + 1 end) >= @max_failed_attempts::int then
+                              case when @lockout_duration_seconds::int > 0
+                                then now() + make_interval(secs => @lockout_duration_seconds::int)
+                                else 'infinity'::timestamptz
+                              end
+                          end;
+`
+	resetFailedAttemptsQuery = `
+update auth_password_account_status
+   set failed_attempts  = 0,
+       last_failed_time = null,
+       locked_until     = null
+ where password_account_id = @password_account_id;
+`
+	// passwordChangedQuery records the time an account's password was
+	// changed. Changing the password also unlocks the account.
+	passwordChangedQuery = `
+insert into auth_password_account_status as status
+       (password_account_id, password_change_time)
+values (@password_account_id, now())
+    on conflict (password_account_id) do update
+   set password_change_time = now(),
+       failed_attempts      = 0,
+       last_failed_time     = null,
+       locked_until         = null;
+`
+	// pruneCredentialHistoryQuery deletes all but the most recent @keep
+	// previous credentials of an account.
+	pruneCredentialHistoryQuery = `
+delete from auth_password_argon2_cred_history
+ where password_account_id = @password_account_id
+   and private_id not in (
+         select private_id
+           from auth_password_argon2_cred_history
+          where password_account_id = @password_account_id
+       order by create_time desc
+          limit @keep
+       );
+`
+	deletePendingTotpQuery = `
+delete from auth_password_totp
+ where password_account_id = @password_account_id
+   and verify_time is null;
+`
+	deleteTotpQuery = `
+delete from auth_password_totp
+ where password_account_id = @password_account_id;
+`
+	// verifyTotpQuery completes a pending TOTP enrollment.
+	verifyTotpQuery = `
+update auth_password_totp
+   set verify_time    = now(),
+       last_used_step = @step
+ where password_account_id = @password_account_id
+   and verify_time is null;
+`
+	// useTotpStepQuery records the time step of a TOTP code used to
+	// authenticate and resets the failed attempts. A code can only be used
+	// once, so no row is updated if the code's time step has already been
+	// used or the enrollment has too many failed attempts.
+	useTotpStepQuery = `
+update auth_password_totp
+   set last_used_step  = @step,
+       failed_attempts = 0
+ where password_account_id = @password_account_id
+   and verify_time is not null
+   and last_used_step < @step
+   and failed_attempts < @max_failed_attempts;
+`
+	recordTotpFailedAttemptQuery = `
+update auth_password_totp
+   set failed_attempts = failed_attempts + 1
+ where password_account_id = @password_account_id
+   and verify_time is not null;
+`
+	resetTotpFailedAttemptsQuery = `
+update auth_password_totp
+   set failed_attempts = 0
+ where password_account_id = @password_account_id
+   and failed_attempts > 0;
+`
+	useRecoveryCodeQuery = `
+delete from auth_password_totp_recovery_code
+ where password_account_id = @password_account_id
+   and code_hash = @code_hash;
+`
+	totpRewrapQuery = `
+update auth_password_totp
+   set ct_secret = @ct_secret,
+       key_id    = @key_id
+ where password_account_id = @password_account_id;
 `
 	currentConfigForAccountQuery = `
 select *
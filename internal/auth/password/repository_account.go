@@ -84,6 +84,13 @@ func (r *Repository) CreateAccount(ctx context.Context, scopeId string, a *Accou
 		if cc.MinPasswordLength > len(opts.password) {
 			return nil, errors.New(ctx, errors.PasswordTooShort, op, fmt.Sprintf("must be longer than %v", cc.MinPasswordLength))
 		}
+		policy, err := getPolicy(ctx, r.reader, a.AuthMethodId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if err := policy.checkComplexity(ctx, opts.password); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
 		if cred, err = newArgon2Credential(ctx, a.PublicId, opts.password, cc.argon2()); err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
@@ -21,8 +21,9 @@ import (
 // contain a valid ScopeId. m must not contain a PublicId. The PublicId is
 // generated and assigned by this method.
 //
-// WithConfiguration and WithPublicId are the only valid options. All other
-// options are ignored.
+// WithConfiguration, WithPublicId and WithPolicy are the only valid
+// options. All other options are ignored. The PasswordMethodId of a Policy
+// provided with WithPolicy is set to the PublicId of the new AuthMethod.
 //
 // Both m.Name and m.Description are optional. If m.Name is set, it must be
 // unique within m.ScopeId.
@@ -89,6 +90,13 @@ func (r *Repository) CreateAuthMethod(ctx context.Context, m *AuthMethod, opt ..
 			if err := w.Create(ctx, newAuthMethod, db.WithOplog(oplogWrapper, m.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create auth method"))
 			}
+			if opts.withPolicy != nil {
+				p := opts.withPolicy.clone()
+				p.PasswordMethodId = newAuthMethod.PublicId
+				if _, err := setPolicy(ctx, w, p); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to set password policy"))
+				}
+			}
 			return nil
 		},
 	)
@@ -166,6 +174,11 @@ func (r *Repository) DeleteAuthMethod(ctx context.Context, scopeId, publicId str
 // value and included in fieldMask. Name, Description, MinPasswordLength,
 // and MinLoginNameLength are the only updatable fields, If no updatable fields
 // are included in the fieldMaskPaths, then an error is returned.
+//
+// WithPolicy is the only valid option. The Policy, which must be for
+// authMethod, is set in the same transaction and the fieldMaskPaths may be
+// empty. The version of the auth method is incremented when only the Policy
+// is set.
 func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMethod, version uint32, fieldMaskPaths []string, opt ...Option) (*AuthMethod, int, error) {
 	const op = "password.(Repository).UpdateAuthMethod"
 	if authMethod == nil {
@@ -187,6 +200,10 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMetho
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
 		}
 	}
+	opts := GetOpts(opt...)
+	if opts.withPolicy != nil && opts.withPolicy.PasswordMethodId != authMethod.PublicId {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "policy is not for auth method")
+	}
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbw.BuildUpdatePaths(
 		map[string]any{
@@ -199,7 +216,12 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMetho
 		nil,
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
-		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "field mask must not be empty")
+		if opts.withPolicy == nil {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "field mask must not be empty")
+		}
+		// Only the policy is set, which is part of the auth method, so its
+		// version is incremented.
+		dbMask = []string{"Version"}
 	}
 
 	oplogWrapper, err := r.kms.GetWrapper(ctx, authMethod.ScopeId, kms.KeyPurposeOplog)
@@ -209,6 +231,7 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMetho
 	}
 
 	upAuthMethod := authMethod.Clone()
+	upAuthMethod.Version = version + 1
 	var rowsUpdated int
 	_, err = r.writer.DoTx(
 		ctx,
@@ -233,6 +256,11 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMetho
 			if rowsUpdated > 1 {
 				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
 			}
+			if rowsUpdated == 1 && opts.withPolicy != nil {
+				if _, err := setPolicy(ctx, w, opts.withPolicy); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to set password policy"))
+				}
+			}
 			// we need a new repo, that's using the same reader/writer as this TxHandler
 			txRepo := &Repository{
 				reader: reader,
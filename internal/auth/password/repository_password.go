@@ -22,6 +22,14 @@ type authAccount struct {
 	*Argon2Credential
 	*Argon2Configuration
 	IsCurrentConf bool
+
+	FailedAttempts         uint32
+	IsLocked               bool
+	IsExpired              bool
+	MaxFailedAttempts      uint32
+	LockoutDurationSeconds uint32
+	TotpEnrolled           bool
+	TotpRequired           bool
 }
 
 // Authenticate authenticates loginName and password match for loginName in
@@ -35,7 +43,28 @@ type authAccount struct {
 // Authenticate will update the stored values for password to the current
 // password settings for authMethodId if authentication is successful and
 // the stored values are not using the current password settings.
-func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, loginName, password string) (*Account, error) {
+//
+// If the password Policy for authMethodId limits the number of failed
+// attempts, a failed authentication is recorded and Authenticate returns an
+// error with code AccountLocked while the account is locked. If the password
+// is older than the maximum age allowed by the Policy, Authenticate returns
+// an error with code PasswordExpired unless a new password is provided with
+// WithNewPassword. The password is then changed as with ChangePassword and
+// the returned account has the new CredentialId.
+//
+// If the account has a verified TOTP enrollment, or the Policy requires
+// TOTP, a TOTP code or recovery code must be provided with WithTotpCode.
+// Authenticate returns an error with code TotpRequired if no code is
+// provided, an error with code TotpNotEnrolled if the Policy requires TOTP
+// and the account is not enrolled, and nil if the code is not valid. An
+// account which must use TOTP but is not enrolled yet completes a pending
+// enrollment from EnrollTotpWithPassword by providing a code for it.
+//
+// Invalid TOTP codes are counted separately from the failed attempts of the
+// Policy. After maxTotpFailedAttempts invalid codes, Authenticate returns an
+// error with code AccountLocked unless a recovery code is provided, even if
+// the Policy doesn't limit the number of failed attempts.
+func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, loginName, password string, opt ...Option) (*Account, error) {
 	const op = "password.(Repository).Authenticate"
 	if authMethodId == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing authMethodId", errors.WithoutEvent())
@@ -62,6 +91,61 @@ func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, lo
 	if acct == nil {
 		return nil, nil
 	}
+	opts := GetOpts(opt...)
+	// An expired password is reported before the TOTP code is checked so
+	// that the code is not used up by a request which can't succeed.
+	if acct.IsExpired && opts.withNewPassword == "" {
+		return nil, errors.New(ctx, errors.PasswordExpired, op, "password has expired and must be changed")
+	}
+	if acct.TotpEnrolled || acct.TotpRequired {
+		switch {
+		case !acct.TotpEnrolled && opts.withTotpCode == "":
+			return nil, errors.New(ctx, errors.TotpNotEnrolled, op, "account must be enrolled in totp")
+		case opts.withTotpCode == "":
+			return nil, errors.New(ctx, errors.TotpRequired, op, "missing totp code")
+		}
+		var ok bool
+		switch {
+		case acct.TotpEnrolled:
+			if ok, err = r.checkTotp(ctx, scopeId, acct.Account.PublicId, opts.withTotpCode); err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+		default:
+			// The code completes the pending enrollment created with
+			// EnrollTotpWithPassword.
+			switch err := r.VerifyTotp(ctx, scopeId, acct.Account.PublicId, opts.withTotpCode); {
+			case err == nil:
+				ok = true
+			case errors.IsNotFoundError(err):
+				return nil, errors.New(ctx, errors.TotpNotEnrolled, op, "account must be enrolled in totp")
+			case !errors.Match(errors.T(errors.TotpInvalid), err):
+				return nil, errors.Wrap(ctx, err, op)
+			}
+		}
+		if !ok {
+			if err := r.recordFailedAttempt(ctx, acct); err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			return nil, nil
+		}
+		if err := r.resetFailedAttempts(ctx, acct); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if acct.IsExpired {
+		// The account can't get an auth token to change its password with
+		// so the password is changed while authenticating.
+		changed, err := r.ChangePassword(ctx, scopeId, acct.PublicId, password, opts.withNewPassword, acct.Version)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if changed == nil {
+			return nil, nil
+		}
+		acct.Account.Version = changed.Version
+		acct.Account.CredentialId = changed.CredentialId
+		return acct.Account, nil
+	}
 
 	if !acct.IsCurrentConf {
 		cc, err := r.currentConfig(ctx, authMethodId)
@@ -113,6 +197,8 @@ func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, lo
 // Returns nil, db.ErrorRecordNotFound if the account doesn't exist.
 // Returns nil, nil if old does not match the stored password for accountId.
 // Returns nil, error with code PasswordsEqual if old and new are equal.
+// Returns nil, error with code PasswordTooWeak or PasswordReused if new does
+// not meet the password Policy of the account's auth method.
 func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old, new string, version uint32) (*Account, error) {
 	const op = "password.(Repository).ChangePassword"
 	if accountId == "" {
@@ -166,6 +252,24 @@ func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old
 	if cc.MinPasswordLength > len(new) {
 		return nil, errors.New(ctx, errors.PasswordTooShort, op, fmt.Sprintf("must be at least %d", cc.MinPasswordLength))
 	}
+	policy, err := r.GetPolicy(ctx, authAccount.GetAuthMethodId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := policy.checkComplexity(ctx, new); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	// The current password is old, which is known not to equal new, so only
+	// the previous passwords need to be checked.
+	if policy.HistoryCount > 1 {
+		reused, err := r.passwordReused(ctx, scopeId, accountId, new, nil, policy.HistoryCount-1)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if reused {
+			return nil, errors.New(ctx, errors.PasswordReused, op, fmt.Sprintf("must not match any of the last %d passwords", policy.HistoryCount))
+		}
+	}
 	newCred, err := newArgon2Credential(ctx, accountId, new, cc.argon2())
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -191,6 +295,9 @@ func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old
 				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated account and %d rows updated", rowsUpdated))
 			}
 
+			if err := archiveCredential(ctx, w, oldCred, policy.HistoryCount); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
 			rowsDeleted, err := w.Delete(ctx, oldCred, db.WithOplog(oplogWrapper, oldCred.oplog(oplog.OpType_OP_TYPE_DELETE)))
 			if err != nil {
 				return errors.Wrap(ctx, err, op)
@@ -201,6 +308,9 @@ func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old
 			if err = w.Create(ctx, newCred, db.WithOplog(oplogWrapper, newCred.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create new credential"))
 			}
+			if _, err := w.Exec(ctx, passwordChangedQuery, []any{sql.Named("password_account_id", accountId)}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update account status"))
+			}
 			return nil
 		},
 	)
@@ -243,6 +353,9 @@ func (r *Repository) authenticate(ctx context.Context, scopeId, authMethodId, lo
 	default:
 		acct = accts[0]
 	}
+	if acct.IsLocked {
+		return nil, errors.New(ctx, errors.AccountLocked, op, "too many failed authentication attempts")
+	}
 
 	// We don't pass a wrapper in here because for ecryption we want to indicate the expected key ID
 	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(acct.GetKeyId()))
@@ -257,13 +370,125 @@ func (r *Repository) authenticate(ctx context.Context, scopeId, authMethodId, lo
 	inputKey := argon2.IDKey([]byte(password), acct.Salt, acct.Iterations, acct.Memory, uint8(acct.Threads), acct.KeyLength)
 	if subtle.ConstantTimeCompare(inputKey, acct.DerivedKey) == 0 {
 		// authentication failed, password does not match
+		if err := r.recordFailedAttempt(ctx, &acct); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
 		return nil, nil
 	}
+	// The failed attempts of an account which uses TOTP are reset once the
+	// TOTP code has been checked by Authenticate.
+	if !acct.TotpEnrolled && !acct.TotpRequired {
+		if err := r.resetFailedAttempts(ctx, &acct); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
 	return &acct, nil
 }
 
+// recordFailedAttempt records a failed authentication attempt for acct if
+// the password Policy limits the number of failed attempts.
+func (r *Repository) recordFailedAttempt(ctx context.Context, acct *authAccount) error {
+	const op = "password.(Repository).recordFailedAttempt"
+	if acct.MaxFailedAttempts == 0 {
+		return nil
+	}
+	args := []any{
+		sql.Named("password_account_id", acct.Account.PublicId),
+		sql.Named("max_failed_attempts", acct.MaxFailedAttempts),
+		sql.Named("lockout_duration_seconds", acct.LockoutDurationSeconds),
+	}
+	if _, err := r.writer.Exec(ctx, recordFailedAttemptQuery, args); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to record failed attempt"))
+	}
+	return nil
+}
+
+// resetFailedAttempts resets the failed authentication attempts of acct
+// after a successful authentication.
+func (r *Repository) resetFailedAttempts(ctx context.Context, acct *authAccount) error {
+	const op = "password.(Repository).resetFailedAttempts"
+	if acct.FailedAttempts == 0 {
+		return nil
+	}
+	if _, err := r.writer.Exec(ctx, resetFailedAttemptsQuery, []any{sql.Named("password_account_id", acct.Account.PublicId)}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to reset failed attempts"))
+	}
+	return nil
+}
+
+// passwordReused reports whether password matches current or one of the n
+// most recent previous credentials of accountId. current may be nil.
+func (r *Repository) passwordReused(ctx context.Context, scopeId, accountId, password string, current *Argon2Credential, n uint32) (bool, error) {
+	const op = "password.(Repository).passwordReused"
+	var creds []*Argon2Credential
+	if current != nil {
+		creds = append(creds, current)
+	}
+	if n > 0 {
+		var history []*argon2CredentialHistory
+		if err := r.reader.SearchWhere(ctx, &history, "password_account_id = ?", []any{accountId}, db.WithOrder("create_time desc"), db.WithLimit(int(n))); err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+		for _, h := range history {
+			creds = append(creds, h.Argon2Credential)
+		}
+	}
+
+	confs := make(map[string]*Argon2Configuration)
+	for _, cred := range creds {
+		conf, ok := confs[cred.PasswordConfId]
+		if !ok {
+			conf = NewArgon2Configuration()
+			if err := r.reader.LookupWhere(ctx, conf, "private_id = ?", []any{cred.PasswordConfId}); err != nil {
+				return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to retrieve password configuration"))
+			}
+			confs[cred.PasswordConfId] = conf
+		}
+		databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(cred.GetKeyId()))
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := cred.decrypt(ctx, databaseWrapper); err != nil {
+			return false, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt), errors.WithMsg("unable to decrypt credential"))
+		}
+		key := argon2.IDKey([]byte(password), cred.Salt, conf.Iterations, conf.Memory, uint8(conf.Threads), conf.KeyLength)
+		if subtle.ConstantTimeCompare(key, cred.DerivedKey) == 1 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// archiveCredential moves cred into the credential history of its account
+// if historyCount requires previous passwords to be remembered. It also
+// deletes any history beyond the historyCount-1 most recent credentials.
+// cred may be nil.
+func archiveCredential(ctx context.Context, w db.Writer, cred *Argon2Credential, historyCount uint32) error {
+	const op = "password.archiveCredential"
+	if cred == nil {
+		return nil
+	}
+	var keep uint32
+	if historyCount > 1 {
+		keep = historyCount - 1
+		h := &argon2CredentialHistory{Argon2Credential: cred.clone()}
+		h.CreateTime, h.UpdateTime = nil, nil
+		if err := w.Create(ctx, h); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to archive credential"))
+		}
+	}
+	if _, err := w.Exec(ctx, pruneCredentialHistoryQuery, []any{sql.Named("password_account_id", cred.PasswordAccountId), sql.Named("keep", keep)}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to prune credential history"))
+	}
+	return nil
+}
+
 // SetPassword sets the password for accountId to password. If password
 // contains an empty string, the password for accountId will be deleted.
+// Setting the password unlocks the account if it is locked.
+//
+// Returns nil, error with code PasswordTooWeak or PasswordReused if password
+// does not meet the password Policy of the account's auth method.
 func (r *Repository) SetPassword(ctx context.Context, scopeId, accountId, password string, version uint32) (*Account, error) {
 	const op = "password.(Repository).SetPassword"
 	if accountId == "" {
@@ -297,6 +522,29 @@ func (r *Repository) SetPassword(ctx context.Context, scopeId, accountId, passwo
 		if cc.MinPasswordLength > len(password) {
 			return nil, errors.New(ctx, errors.PasswordTooShort, op, fmt.Sprintf("password must be at least %v", cc.MinPasswordLength))
 		}
+		policy, err := getPolicy(ctx, r.reader, cc.PasswordMethodId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if err := policy.checkComplexity(ctx, password); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if policy.HistoryCount > 0 {
+			current := allocArgon2Credential()
+			if err := r.reader.LookupWhere(ctx, current, "password_account_id = ?", []any{accountId}); err != nil {
+				if !errors.IsNotFoundError(err) {
+					return nil, errors.Wrap(ctx, err, op)
+				}
+				current = nil
+			}
+			reused, err := r.passwordReused(ctx, scopeId, accountId, password, current, policy.HistoryCount-1)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			if reused {
+				return nil, errors.New(ctx, errors.PasswordReused, op, fmt.Sprintf("must not match any of the last %d passwords", policy.HistoryCount))
+			}
+		}
 		newCred, err = newArgon2Credential(ctx, accountId, password, cc.argon2())
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
@@ -328,6 +576,17 @@ func (r *Repository) SetPassword(ctx context.Context, scopeId, accountId, passwo
 				}
 			}
 			if oldCred.PrivateId != "" {
+				policy, err := getPolicy(ctx, rr, oldCred.PasswordMethodId)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				archived := allocArgon2Credential()
+				if err := rr.LookupWhere(ctx, archived, "private_id = ?", []any{oldCred.PrivateId}); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if err := archiveCredential(ctx, w, archived, policy.HistoryCount); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
 				dCred := oldCred.clone()
 				rowsDeleted, err := w.Delete(ctx, dCred, db.WithOplog(oplogWrapper, oldCred.oplog(oplog.OpType_OP_TYPE_DELETE)))
 				if err != nil {
@@ -338,7 +597,12 @@ func (r *Repository) SetPassword(ctx context.Context, scopeId, accountId, passwo
 				}
 			}
 			if newCred != nil {
-				return w.Create(ctx, newCred, db.WithOplog(oplogWrapper, newCred.oplog(oplog.OpType_OP_TYPE_CREATE)))
+				if err := w.Create(ctx, newCred, db.WithOplog(oplogWrapper, newCred.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+					return err
+				}
+			}
+			if _, err := w.Exec(ctx, passwordChangedQuery, []any{sql.Named("password_account_id", accountId)}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update account status"))
 			}
 			return nil
 		},
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package password
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// GetPolicy returns the password policy for authMethodId. If no policy has
+// been set for authMethodId, a Policy with every setting disabled is
+// returned.
+func (r *Repository) GetPolicy(ctx context.Context, authMethodId string) (*Policy, error) {
+	const op = "password.(Repository).GetPolicy"
+	if authMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	p, err := getPolicy(ctx, r.reader, authMethodId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return p, nil
+}
+
+func getPolicy(ctx context.Context, reader db.Reader, authMethodId string) (*Policy, error) {
+	const op = "password.getPolicy"
+	p := &Policy{}
+	if err := reader.LookupWhere(ctx, p, "password_method_id = ?", []any{authMethodId}); err != nil {
+		if !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return &Policy{PasswordMethodId: authMethodId}, nil
+	}
+	return p, nil
+}
+
+// SetPolicy sets the password policy for p.PasswordMethodId to p and
+// returns the stored Policy. p is not changed. Every setting in p replaces
+// the current setting, so to change a single setting the current Policy
+// should be retrieved with GetPolicy and modified.
+//
+// The policy applies to passwords set after it is changed. Existing
+// passwords which do not meet the complexity requirements remain valid.
+func (r *Repository) SetPolicy(ctx context.Context, p *Policy) (*Policy, error) {
+	const op = "password.(Repository).SetPolicy"
+	if p == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing policy")
+	}
+	if p.PasswordMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+
+	var newPolicy *Policy
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			if newPolicy, err = setPolicy(ctx, w, p); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(p.PasswordMethodId))
+	}
+	return newPolicy, nil
+}
+
+// setPolicy upserts p using w, which must be within a transaction, and
+// returns the stored Policy. p is not changed.
+func setPolicy(ctx context.Context, w db.Writer, p *Policy) (*Policy, error) {
+	const op = "password.setPolicy"
+	newPolicy := p.clone()
+	newPolicy.CreateTime, newPolicy.UpdateTime = nil, nil
+	onConflict := &db.OnConflict{
+		Target: db.Columns{"password_method_id"},
+		Action: db.SetColumns([]string{
+			"min_uppercase",
+			"min_lowercase",
+			"min_digits",
+			"min_symbols",
+			"history_count",
+			"max_age_days",
+			"max_failed_attempts",
+			"lockout_duration_seconds",
+			"totp_required",
+		}),
+	}
+	if err := w.Create(ctx, newPolicy, db.WithOnConflict(onConflict)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return newPolicy, nil
+}
@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package password
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_GetSetPolicy(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	authMethod := TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	_, err = repo.GetPolicy(ctx, "")
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	_, err = repo.SetPolicy(ctx, nil)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	_, err = repo.SetPolicy(ctx, &Policy{})
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+
+	got, err := repo.GetPolicy(ctx, authMethod.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, &Policy{PasswordMethodId: authMethod.PublicId}, got, "default policy should have every setting disabled")
+
+	want, err := NewPolicy(ctx, authMethod.PublicId)
+	require.NoError(t, err)
+	want.MinUppercase = 1
+	want.MinDigits = 2
+	want.HistoryCount = 3
+	want.MaxFailedAttempts = 5
+	_, err = repo.SetPolicy(ctx, want)
+	require.NoError(t, err)
+
+	got, err = repo.GetPolicy(ctx, authMethod.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), got.MinUppercase)
+	assert.Equal(t, uint32(2), got.MinDigits)
+	assert.Equal(t, uint32(3), got.HistoryCount)
+	assert.Equal(t, uint32(5), got.MaxFailedAttempts)
+
+	got.MinDigits = 0
+	_, err = repo.SetPolicy(ctx, got)
+	require.NoError(t, err)
+	got, err = repo.GetPolicy(ctx, authMethod.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), got.MinDigits)
+	assert.Equal(t, uint32(1), got.MinUppercase)
+}
+
+func TestRepository_AuthMethodWithPolicy(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	in, err := NewAuthMethod(ctx, o.GetPublicId())
+	require.NoError(t, err)
+	am, err := repo.CreateAuthMethod(ctx, in, WithPolicy(&Policy{MinDigits: 2}))
+	require.NoError(t, err)
+	got, err := repo.GetPolicy(ctx, am.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), got.MinDigits)
+
+	// A policy for another auth method is rejected.
+	_, _, err = repo.UpdateAuthMethod(ctx, am, am.Version, nil, WithPolicy(&Policy{PasswordMethodId: "ampw_other"}))
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+
+	// Without fields or a policy there is nothing to update.
+	_, _, err = repo.UpdateAuthMethod(ctx, am, am.Version, nil)
+	assert.Truef(t, errors.Match(errors.T(errors.EmptyFieldMask), err), "want err code: %q got: %q", errors.EmptyFieldMask, err)
+
+	// Updating only the policy increments the version of the auth method.
+	got.MinSymbols = 1
+	updated, rowsUpdated, err := repo.UpdateAuthMethod(ctx, am, am.Version, nil, WithPolicy(got))
+	require.NoError(t, err)
+	assert.Equal(t, 1, rowsUpdated)
+	assert.Equal(t, am.Version+1, updated.Version)
+	got, err = repo.GetPolicy(ctx, am.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), got.MinSymbols)
+	assert.Equal(t, uint32(2), got.MinDigits)
+
+	// A stale version does not change the policy.
+	got.MinSymbols = 3
+	_, rowsUpdated, err = repo.UpdateAuthMethod(ctx, am, am.Version, nil, WithPolicy(got))
+	require.NoError(t, err)
+	assert.Equal(t, 0, rowsUpdated)
+	got, err = repo.GetPolicy(ctx, am.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), got.MinSymbols)
+}
+
+func TestRepository_PolicyEnforcement(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	setup := func(t *testing.T, p *Policy) (*AuthMethod, *Account) {
+		t.Helper()
+		authMethod := TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+		p.PasswordMethodId = authMethod.PublicId
+		_, err := repo.SetPolicy(ctx, p)
+		require.NoError(t, err)
+		acct, err := repo.CreateAccount(ctx, o.GetPublicId(), &Account{
+			Account: &store.Account{
+				AuthMethodId: authMethod.PublicId,
+				LoginName:    "kazmierczak",
+			},
+		}, WithPassword("Passw0rd!"))
+		require.NoError(t, err)
+		return authMethod, acct
+	}
+
+	t.Run("complexity", func(t *testing.T) {
+		authMethod, acct := setup(t, &Policy{MinUppercase: 1, MinDigits: 1})
+
+		_, err := repo.CreateAccount(ctx, o.GetPublicId(), &Account{
+			Account: &store.Account{
+				AuthMethodId: authMethod.PublicId,
+				LoginName:    "weak",
+			},
+		}, WithPassword("password"))
+		assert.Truef(t, errors.Match(errors.T(errors.PasswordTooWeak), err), "want err code: %q got: %q", errors.PasswordTooWeak, err)
+
+		_, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, "Passw0rd!", "password", acct.Version)
+		assert.Truef(t, errors.Match(errors.T(errors.PasswordTooWeak), err), "want err code: %q got: %q", errors.PasswordTooWeak, err)
+
+		_, err = repo.SetPassword(ctx, o.GetPublicId(), acct.PublicId, "Password", acct.Version)
+		assert.Truef(t, errors.Match(errors.T(errors.PasswordTooWeak), err), "want err code: %q got: %q", errors.PasswordTooWeak, err)
+
+		_, err = repo.SetPassword(ctx, o.GetPublicId(), acct.PublicId, "Passw0rd2", acct.Version)
+		assert.NoError(t, err)
+	})
+
+	t.Run("history", func(t *testing.T) {
+		_, acct := setup(t, &Policy{HistoryCount: 3})
+
+		_, err := repo.SetPassword(ctx, o.GetPublicId(), acct.PublicId, "Passw0rd!", acct.Version)
+		assert.Truef(t, errors.Match(errors.T(errors.PasswordReused), err), "want err code: %q got: %q", errors.PasswordReused, err)
+
+		version := acct.Version
+		for _, pw := range []string{"second-password", "third-password"} {
+			acct, err = repo.SetPassword(ctx, o.GetPublicId(), acct.PublicId, pw, version)
+			require.NoError(t, err)
+			version = acct.Version
+		}
+
+		// The original password is one of the last three.
+		_, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, "third-password", "Passw0rd!", version)
+		assert.Truef(t, errors.Match(errors.T(errors.PasswordReused), err), "want err code: %q got: %q", errors.PasswordReused, err)
+
+		acct, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, "third-password", "fourth-password", version)
+		require.NoError(t, err)
+		require.NotNil(t, acct)
+		version = acct.Version
+
+		// Only the last three passwords are remembered.
+		var history []*argon2CredentialHistory
+		require.NoError(t, rw.SearchWhere(ctx, &history, "password_account_id = ?", []any{acct.PublicId}))
+		assert.Len(t, history, 2)
+
+		acct, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, "fourth-password", "Passw0rd!", version)
+		require.NoError(t, err)
+		assert.NotNil(t, acct)
+	})
+
+	t.Run("lockout", func(t *testing.T) {
+		authMethod, acct := setup(t, &Policy{MaxFailedAttempts: 2, LockoutDurationSeconds: 3600})
+
+		// A successful authentication resets the count.
+		got, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "wrong")
+		require.NoError(t, err)
+		assert.Nil(t, got)
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!")
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+
+		for i := 0; i < 2; i++ {
+			got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "wrong")
+			require.NoError(t, err)
+			assert.Nil(t, got)
+		}
+		_, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!")
+		assert.Truef(t, errors.Match(errors.T(errors.AccountLocked), err), "want err code: %q got: %q", errors.AccountLocked, err)
+
+		// Setting the password unlocks the account.
+		_, err = repo.SetPassword(ctx, o.GetPublicId(), acct.PublicId, "new-password", acct.Version)
+		require.NoError(t, err)
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "new-password")
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		authMethod, acct := setup(t, &Policy{MaxAgeDays: 1})
+
+		got, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!")
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+
+		_, err = rw.Exec(ctx, `
+insert into auth_password_account_status
+       (password_account_id, password_change_time)
+values (?, now() - interval '2 days')
+    on conflict (password_account_id) do update
+   set password_change_time = excluded.password_change_time`, []any{acct.PublicId})
+		require.NoError(t, err)
+
+		_, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!")
+		assert.Truef(t, errors.Match(errors.T(errors.PasswordExpired), err), "want err code: %q got: %q", errors.PasswordExpired, err)
+
+		// An expired password can still be changed.
+		got, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, "Passw0rd!", "new-password", acct.Version)
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "new-password")
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+	})
+
+	t.Run("expired-new-password", func(t *testing.T) {
+		authMethod, acct := setup(t, &Policy{MaxAgeDays: 1, MinDigits: 1})
+		_, err := rw.Exec(ctx, `
+insert into auth_password_account_status
+       (password_account_id, password_change_time)
+values (?, now() - interval '2 days')
+    on conflict (password_account_id) do update
+   set password_change_time = excluded.password_change_time`, []any{acct.PublicId})
+		require.NoError(t, err)
+
+		// The new password must meet the policy.
+		_, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!", WithNewPassword("no-digits"))
+		assert.Truef(t, errors.Match(errors.T(errors.PasswordTooWeak), err), "want err code: %q got: %q", errors.PasswordTooWeak, err)
+		_, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!", WithNewPassword("Passw0rd!"))
+		assert.Truef(t, errors.Match(errors.T(errors.PasswordsEqual), err), "want err code: %q got: %q", errors.PasswordsEqual, err)
+
+		// The current password is still required.
+		got, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "wrong", WithNewPassword("new-passw0rd"))
+		require.NoError(t, err)
+		assert.Nil(t, got)
+
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!", WithNewPassword("new-passw0rd"))
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		assert.Equal(t, acct.PublicId, got.PublicId)
+		assert.NotEqual(t, acct.CredentialId, got.CredentialId)
+		assert.Equal(t, acct.Version+1, got.Version)
+
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!")
+		require.NoError(t, err)
+		assert.Nil(t, got)
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "new-passw0rd")
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+		// A new password is ignored once the password has been changed.
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "new-passw0rd", WithNewPassword("other-passw0rd"))
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "new-passw0rd")
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+	})
+}
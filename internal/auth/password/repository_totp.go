@@ -0,0 +1,297 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package password
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+// EnrollTotp creates a new TOTP secret and recovery codes for accountId.
+// The enrollment is pending until it is verified with VerifyTotp, and a
+// pending enrollment is not used during authentication. A pending
+// enrollment of accountId is replaced.
+//
+// Returns nil, error with code RecordNotFound if the account doesn't exist.
+// Returns nil, error with code TotpAlreadyEnrolled if the account has a
+// verified enrollment. The enrollment must be removed with DeleteTotp
+// before the account can enroll again.
+func (r *Repository) EnrollTotp(ctx context.Context, scopeId, accountId string) (*TotpEnrollment, error) {
+	const op = "password.(Repository).EnrollTotp"
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	if scopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+
+	acct, err := r.LookupAccount(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if acct == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "account not found")
+	}
+	current, err := r.lookupTotp(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if current != nil && current.VerifyTime != nil {
+		return nil, errors.New(ctx, errors.TotpAlreadyEnrolled, op, "account is already enrolled in totp")
+	}
+
+	t, err := newTotp(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	enrollment := &TotpEnrollment{
+		Secret: totpEncoding.EncodeToString(t.Secret),
+		Url:    t.url(acct.GetLoginName()),
+	}
+	codes, hashed, err := newRecoveryCodes(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	enrollment.RecoveryCodes = codes
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := t.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	items := make([]any, 0, len(hashed))
+	for _, c := range hashed {
+		items = append(items, c)
+	}
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			// The recovery codes of a pending enrollment are deleted by
+			// cascade.
+			if _, err := w.Exec(ctx, deletePendingTotpQuery, []any{sql.Named("password_account_id", accountId)}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete pending enrollment"))
+			}
+			if err := w.Create(ctx, t); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if err := w.CreateItems(ctx, items); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create recovery codes"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(accountId))
+	}
+	return enrollment, nil
+}
+
+// EnrollTotpWithPassword creates a pending TOTP enrollment for the account
+// of loginName in authMethodId if password matches. It lets an account
+// which the Policy requires to use TOTP enroll before it can authenticate.
+// The enrollment is verified by passing a code for it to Authenticate with
+// WithTotpCode.
+//
+// Returns nil, nil if the login name and password do not match an account.
+// Returns nil, error with code AccountLocked if the account is locked and
+// TotpAlreadyEnrolled if the account has a verified enrollment.
+func (r *Repository) EnrollTotpWithPassword(ctx context.Context, scopeId, authMethodId, loginName, password string) (*TotpEnrollment, error) {
+	const op = "password.(Repository).EnrollTotpWithPassword"
+	switch {
+	case scopeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id", errors.WithoutEvent())
+	case authMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id", errors.WithoutEvent())
+	case loginName == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing login name", errors.WithoutEvent())
+	case password == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing password", errors.WithoutEvent())
+	}
+	acct, err := r.authenticate(ctx, scopeId, authMethodId, loginName, password)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if acct == nil {
+		return nil, nil
+	}
+	if acct.TotpEnrolled {
+		return nil, errors.New(ctx, errors.TotpAlreadyEnrolled, op, "account is already enrolled in totp")
+	}
+	enrollment, err := r.EnrollTotp(ctx, scopeId, acct.Account.PublicId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return enrollment, nil
+}
+
+// VerifyTotp completes the pending TOTP enrollment of accountId if code is
+// a valid code for the enrollment's secret. Once verified, a TOTP code or a
+// recovery code is required when the account authenticates.
+//
+// Returns error with code RecordNotFound if the account has no enrollment.
+// Returns error with code TotpAlreadyEnrolled if the enrollment has already
+// been verified. Returns error with code TotpInvalid if code is not valid.
+func (r *Repository) VerifyTotp(ctx context.Context, scopeId, accountId, code string) error {
+	const op = "password.(Repository).VerifyTotp"
+	if accountId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	if scopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	if code == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing code")
+	}
+
+	t, err := r.lookupTotp(ctx, accountId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	switch {
+	case t == nil:
+		return errors.New(ctx, errors.RecordNotFound, op, "account has no totp enrollment")
+	case t.VerifyTime != nil:
+		return errors.New(ctx, errors.TotpAlreadyEnrolled, op, "totp enrollment has already been verified")
+	}
+	if err := r.decryptTotp(ctx, scopeId, t); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	step := t.validate(code, time.Now())
+	if step == 0 {
+		return errors.New(ctx, errors.TotpInvalid, op, "invalid totp code")
+	}
+
+	args := []any{
+		sql.Named("password_account_id", accountId),
+		sql.Named("step", step),
+	}
+	rowsUpdated, err := r.writer.Exec(ctx, verifyTotpQuery, args)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(accountId))
+	}
+	if rowsUpdated == 0 {
+		// The enrollment was replaced or verified concurrently.
+		return errors.New(ctx, errors.TotpInvalid, op, "totp enrollment changed during verification")
+	}
+	return nil
+}
+
+// DeleteTotp removes the TOTP enrollment and the recovery codes of
+// accountId, whether verified or pending, returning a count of the number
+// of enrollments deleted.
+func (r *Repository) DeleteTotp(ctx context.Context, accountId string) (int, error) {
+	const op = "password.(Repository).DeleteTotp"
+	if accountId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	rowsDeleted, err := r.writer.Exec(ctx, deleteTotpQuery, []any{sql.Named("password_account_id", accountId)})
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(accountId))
+	}
+	return rowsDeleted, nil
+}
+
+// checkTotp reports whether code is a valid TOTP code or an unused recovery
+// code for the verified enrollment of accountId. A TOTP code can only be
+// used once and a recovery code is deleted when it is used.
+//
+// An invalid code is recorded as a failed attempt of the enrollment. Once
+// maxTotpFailedAttempts is reached, TOTP codes are refused with an error with
+// code AccountLocked until a recovery code is used.
+func (r *Repository) checkTotp(ctx context.Context, scopeId, accountId, code string) (bool, error) {
+	const op = "password.(Repository).checkTotp"
+	t, err := r.lookupTotp(ctx, accountId)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	if t == nil || t.VerifyTime == nil {
+		return false, nil
+	}
+	if err := r.decryptTotp(ctx, scopeId, t); err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+
+	locked := t.FailedAttempts >= maxTotpFailedAttempts
+	if step := t.validate(code, time.Now()); step != 0 && !locked {
+		args := []any{
+			sql.Named("password_account_id", accountId),
+			sql.Named("step", step),
+			sql.Named("max_failed_attempts", maxTotpFailedAttempts),
+		}
+		rowsUpdated, err := r.writer.Exec(ctx, useTotpStepQuery, args)
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+		if rowsUpdated == 1 {
+			return true, nil
+		}
+	} else {
+		var rowsDeleted int
+		_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+			func(_ db.Reader, w db.Writer) error {
+				args := []any{
+					sql.Named("password_account_id", accountId),
+					sql.Named("code_hash", hashRecoveryCode(code)),
+				}
+				var err error
+				if rowsDeleted, err = w.Exec(ctx, useRecoveryCodeQuery, args); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to use recovery code"))
+				}
+				if rowsDeleted == 1 {
+					if _, err := w.Exec(ctx, resetTotpFailedAttemptsQuery, []any{sql.Named("password_account_id", accountId)}); err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg("unable to reset failed attempts"))
+					}
+				}
+				return nil
+			},
+		)
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+		if rowsDeleted == 1 {
+			return true, nil
+		}
+	}
+
+	if locked {
+		return false, errors.New(ctx, errors.AccountLocked, op, "too many invalid totp codes")
+	}
+	if _, err := r.writer.Exec(ctx, recordTotpFailedAttemptQuery, []any{sql.Named("password_account_id", accountId)}); err != nil {
+		return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to record failed attempt"))
+	}
+	return false, nil
+}
+
+// lookupTotp returns the TOTP enrollment of accountId or nil if the account
+// has no enrollment.
+func (r *Repository) lookupTotp(ctx context.Context, accountId string) (*totp, error) {
+	const op = "password.(Repository).lookupTotp"
+	t := &totp{}
+	if err := r.reader.LookupWhere(ctx, t, "password_account_id = ?", []any{accountId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return t, nil
+}
+
+func (r *Repository) decryptTotp(ctx context.Context, scopeId string, t *totp) error {
+	const op = "password.(Repository).decryptTotp"
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(t.KeyId))
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := t.decrypt(ctx, databaseWrapper); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
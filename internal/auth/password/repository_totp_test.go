@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package password
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Totp(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	setup := func(t *testing.T, p *Policy) (*AuthMethod, *Account) {
+		t.Helper()
+		authMethod := TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+		p.PasswordMethodId = authMethod.PublicId
+		_, err := repo.SetPolicy(ctx, p)
+		require.NoError(t, err)
+		acct, err := repo.CreateAccount(ctx, o.GetPublicId(), &Account{
+			Account: &store.Account{
+				AuthMethodId: authMethod.PublicId,
+				LoginName:    "kazmierczak",
+			},
+		}, WithPassword("Passw0rd!"))
+		require.NoError(t, err)
+		return authMethod, acct
+	}
+
+	currentCode := func(t *testing.T, e *TotpEnrollment, offset int64) string {
+		t.Helper()
+		secret, err := totpEncoding.DecodeString(e.Secret)
+		require.NoError(t, err)
+		return totpCode(secret, time.Now().Unix()/int64(totpPeriod.Seconds())+offset)
+	}
+
+	t.Run("enroll-and-verify", func(t *testing.T) {
+		authMethod, acct := setup(t, &Policy{})
+
+		_, err := repo.EnrollTotp(ctx, o.GetPublicId(), "")
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+		_, err = repo.EnrollTotp(ctx, o.GetPublicId(), "apw_doesnotexist")
+		assert.Truef(t, errors.Match(errors.T(errors.RecordNotFound), err), "want err code: %q got: %q", errors.RecordNotFound, err)
+
+		// A pending enrollment is replaced and not used during authentication.
+		first, err := repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId)
+		require.NoError(t, err)
+		e, err := repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId)
+		require.NoError(t, err)
+		assert.NotEqual(t, first.Secret, e.Secret)
+		assert.Len(t, e.RecoveryCodes, recoveryCodeCount)
+		assert.Contains(t, e.Url, "otpauth://totp/")
+
+		got, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!")
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+
+		err = repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, currentCode(t, first, 0))
+		assert.Truef(t, errors.Match(errors.T(errors.TotpInvalid), err), "want err code: %q got: %q", errors.TotpInvalid, err)
+		require.NoError(t, repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, currentCode(t, e, 0)))
+
+		err = repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, currentCode(t, e, 1))
+		assert.Truef(t, errors.Match(errors.T(errors.TotpAlreadyEnrolled), err), "want err code: %q got: %q", errors.TotpAlreadyEnrolled, err)
+		_, err = repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId)
+		assert.Truef(t, errors.Match(errors.T(errors.TotpAlreadyEnrolled), err), "want err code: %q got: %q", errors.TotpAlreadyEnrolled, err)
+
+		_, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!")
+		assert.Truef(t, errors.Match(errors.T(errors.TotpRequired), err), "want err code: %q got: %q", errors.TotpRequired, err)
+
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!", WithTotpCode("000000"))
+		require.NoError(t, err)
+		assert.Nil(t, got)
+
+		// The code used to verify the enrollment cannot be reused.
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!", WithTotpCode(currentCode(t, e, 0)))
+		require.NoError(t, err)
+		assert.Nil(t, got)
+
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!", WithTotpCode(currentCode(t, e, 1)))
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+
+		// A recovery code can be used once.
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!", WithTotpCode(e.RecoveryCodes[0]))
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!", WithTotpCode(e.RecoveryCodes[0]))
+		require.NoError(t, err)
+		assert.Nil(t, got)
+
+		deleted, err := repo.DeleteTotp(ctx, acct.PublicId)
+		require.NoError(t, err)
+		assert.Equal(t, 1, deleted)
+		var codes []*totpRecoveryCode
+		require.NoError(t, rw.SearchWhere(ctx, &codes, "password_account_id = ?", []any{acct.PublicId}))
+		assert.Empty(t, codes)
+
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!")
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+	})
+
+	t.Run("failed-attempts-without-lockout", func(t *testing.T) {
+		authMethod, acct := setup(t, &Policy{})
+		e, err := repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId)
+		require.NoError(t, err)
+		require.NoError(t, repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, currentCode(t, e, -1)))
+
+		// A valid code resets the failed attempts.
+		for i := 0; i < maxTotpFailedAttempts-1; i++ {
+			got, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!", WithTotpCode("000000"))
+			require.NoError(t, err)
+			assert.Nil(t, got)
+		}
+		got, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!", WithTotpCode(currentCode(t, e, 0)))
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+
+		for i := 0; i < maxTotpFailedAttempts; i++ {
+			got, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!", WithTotpCode("000000"))
+			require.NoError(t, err)
+			assert.Nil(t, got)
+		}
+
+		// Once the limit is reached, even a valid code is refused although
+		// the policy doesn't lock the account.
+		_, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!", WithTotpCode(currentCode(t, e, 1)))
+		assert.Truef(t, errors.Match(errors.T(errors.AccountLocked), err), "want err code: %q got: %q", errors.AccountLocked, err)
+		_, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!", WithTotpCode("000000"))
+		assert.Truef(t, errors.Match(errors.T(errors.AccountLocked), err), "want err code: %q got: %q", errors.AccountLocked, err)
+
+		// A recovery code unlocks the enrollment.
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!", WithTotpCode(e.RecoveryCodes[0]))
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!", WithTotpCode(currentCode(t, e, 1)))
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+	})
+
+	t.Run("required", func(t *testing.T) {
+		authMethod, acct := setup(t, &Policy{TotpRequired: true})
+
+		_, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!")
+		assert.Truef(t, errors.Match(errors.T(errors.TotpNotEnrolled), err), "want err code: %q got: %q", errors.TotpNotEnrolled, err)
+
+		// An incorrect password is reported before the missing enrollment.
+		got, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "wrong")
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("required-enroll-with-password", func(t *testing.T) {
+		authMethod, acct := setup(t, &Policy{})
+		got, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!")
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+
+		// TOTP is required after the account was created.
+		p, err := repo.GetPolicy(ctx, authMethod.PublicId)
+		require.NoError(t, err)
+		p.TotpRequired = true
+		_, err = repo.SetPolicy(ctx, p)
+		require.NoError(t, err)
+		_, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!")
+		assert.Truef(t, errors.Match(errors.T(errors.TotpNotEnrolled), err), "want err code: %q got: %q", errors.TotpNotEnrolled, err)
+		// A code without a pending enrollment does not complete one.
+		_, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!", WithTotpCode("000000"))
+		assert.Truef(t, errors.Match(errors.T(errors.TotpNotEnrolled), err), "want err code: %q got: %q", errors.TotpNotEnrolled, err)
+
+		e, err := repo.EnrollTotpWithPassword(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "wrong")
+		require.NoError(t, err)
+		assert.Nil(t, e)
+		e, err = repo.EnrollTotpWithPassword(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!")
+		require.NoError(t, err)
+		require.NotNil(t, e)
+
+		// A pending enrollment still requires a code.
+		_, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!")
+		assert.Truef(t, errors.Match(errors.T(errors.TotpNotEnrolled), err), "want err code: %q got: %q", errors.TotpNotEnrolled, err)
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!", WithTotpCode("000000"))
+		require.NoError(t, err)
+		assert.Nil(t, got)
+
+		// A valid code completes the enrollment and authenticates.
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!", WithTotpCode(currentCode(t, e, 0)))
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+
+		_, err = repo.EnrollTotpWithPassword(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!")
+		assert.Truef(t, errors.Match(errors.T(errors.TotpAlreadyEnrolled), err), "want err code: %q got: %q", errors.TotpAlreadyEnrolled, err)
+		_, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!")
+		assert.Truef(t, errors.Match(errors.T(errors.TotpRequired), err), "want err code: %q got: %q", errors.TotpRequired, err)
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!", WithTotpCode(currentCode(t, e, 1)))
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+	})
+
+	t.Run("lockout", func(t *testing.T) {
+		authMethod, acct := setup(t, &Policy{MaxFailedAttempts: 2, LockoutDurationSeconds: 3600})
+		e, err := repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId)
+		require.NoError(t, err)
+		require.NoError(t, repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, currentCode(t, e, 0)))
+
+		// Invalid TOTP codes count as failed attempts even when the password
+		// is correct.
+		for i := 0; i < 2; i++ {
+			got, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!", WithTotpCode("000000"))
+			require.NoError(t, err)
+			assert.Nil(t, got)
+		}
+		_, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Passw0rd!", WithTotpCode(currentCode(t, e, 1)))
+		assert.Truef(t, errors.Match(errors.T(errors.AccountLocked), err), "want err code: %q got: %q", errors.AccountLocked, err)
+	})
+}
@@ -5,6 +5,7 @@ package password
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -14,6 +15,8 @@ import (
 
 func init() {
 	kms.RegisterTableRewrapFn("auth_password_argon2_cred", argon2ConfigRewrapFn)
+	kms.RegisterTableRewrapFn("auth_password_argon2_cred_history", argon2CredHistoryRewrapFn)
+	kms.RegisterTableRewrapFn("auth_password_totp", totpRewrapFn)
 }
 
 func argon2ConfigRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsRepo kms.GetWrapperer) error {
@@ -56,3 +59,86 @@ func argon2ConfigRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string,
 	}
 	return nil
 }
+
+func argon2CredHistoryRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsRepo kms.GetWrapperer) error {
+	const op = "password.argon2CredHistoryRewrapFn"
+	if dataKeyVersionId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing data key version id")
+	}
+	if scopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	if util.IsNil(reader) {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database reader")
+	}
+	if util.IsNil(writer) {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database writer")
+	}
+	if kmsRepo == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing kms repository")
+	}
+	var credentials []*argon2CredentialHistory
+	if err := reader.SearchWhere(ctx, &credentials, "key_id=?", []any{dataKeyVersionId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query sql for rows that need rewrapping"))
+	}
+	wrapper, err := kmsRepo.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to fetch kms wrapper for rewrapping"))
+	}
+	for _, cred := range credentials {
+		if err := cred.decrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to decrypt argon2 credential history"))
+		}
+		if err := cred.encrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to re-encrypt argon2 credential history"))
+		}
+		if _, err := writer.Update(ctx, cred, []string{"CtSalt", "KeyId"}, nil); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to update argon2 credential history row with rewrapped fields"))
+		}
+	}
+	return nil
+}
+
+func totpRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsRepo kms.GetWrapperer) error {
+	const op = "password.totpRewrapFn"
+	if dataKeyVersionId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing data key version id")
+	}
+	if scopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	if util.IsNil(reader) {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database reader")
+	}
+	if util.IsNil(writer) {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database writer")
+	}
+	if kmsRepo == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing kms repository")
+	}
+	var secrets []*totp
+	if err := reader.SearchWhere(ctx, &secrets, "key_id=?", []any{dataKeyVersionId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query sql for rows that need rewrapping"))
+	}
+	wrapper, err := kmsRepo.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to fetch kms wrapper for rewrapping"))
+	}
+	for _, t := range secrets {
+		if err := t.decrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to decrypt totp secret"))
+		}
+		if err := t.encrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to re-encrypt totp secret"))
+		}
+		args := []any{
+			sql.Named("ct_secret", t.CtSecret),
+			sql.Named("key_id", t.KeyId),
+			sql.Named("password_account_id", t.PasswordAccountId),
+		}
+		if _, err := writer.Exec(ctx, totpRewrapQuery, args); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to update totp row with rewrapped fields"))
+		}
+	}
+	return nil
+}
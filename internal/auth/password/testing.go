@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/stretchr/testify/assert"
@@ -98,3 +99,12 @@ func TestAccount(t testing.TB, conn *db.DB, authMethodId, loginName string, opt
 	require.NoError(err2)
 	return cat
 }
+
+// TestTotpCode returns the current TOTP code for the base32 encoded secret
+// of a TotpEnrollment.
+func TestTotpCode(t testing.TB, secret string) string {
+	t.Helper()
+	s, err := totpEncoding.DecodeString(secret)
+	require.NoError(t, err)
+	return totpCode(s, time.Now().Unix()/int64(totpPeriod.Seconds()))
+}
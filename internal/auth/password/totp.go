@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package password
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+)
+
+// The TOTP parameters follow the defaults of RFC 6238, which are the only
+// parameters supported by many authenticator apps.
+const (
+	totpIssuer       = "Boundary"
+	totpSecretLength = 20
+	totpDigits       = 6
+	totpPeriod       = 30 * time.Second
+
+	// totpSkew is the number of time steps before and after the current time
+	// step for which a code is accepted to allow for clock drift.
+	totpSkew = 1
+
+	// maxTotpFailedAttempts is the number of invalid codes after which the
+	// TOTP codes of an enrollment are refused until a recovery code is used.
+	// It applies whether or not the password Policy limits failed attempts,
+	// since the password alone must not allow guessing codes.
+	maxTotpFailedAttempts = 5
+
+	recoveryCodeCount = 10
+
+	// recoveryCodeBytes is the number of random bytes of a recovery code.
+	// Recovery codes are stored as unsalted hashes, so they must have enough
+	// entropy that they can't be brute forced from a copy of the database.
+	recoveryCodeBytes = 20
+
+	// recoveryCodeGroupLength is the number of characters between the
+	// separators of a recovery code.
+	recoveryCodeGroupLength = 8
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// A TotpEnrollment is returned when an account is enrolled in TOTP. It is
+// the only time the secret and the recovery codes are available in plain
+// text.
+type TotpEnrollment struct {
+	// Secret is the base32 encoded TOTP secret.
+	Secret string
+	// Url is an otpauth URL containing the secret which can be rendered as a
+	// QR code for authenticator apps.
+	Url string
+	// RecoveryCodes are single use codes which can be used instead of a TOTP
+	// code.
+	RecoveryCodes []string
+}
+
+// totp is the TOTP secret of an Account. The enrollment is pending until
+// VerifyTime is set.
+type totp struct {
+	PasswordAccountId string               `gorm:"primary_key"`
+	CreateTime        *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	UpdateTime        *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	Secret            []byte               `gorm:"-" wrapping:"pt,secret"`
+	CtSecret          []byte               `gorm:"column:ct_secret;not_null" wrapping:"ct,secret"`
+	KeyId             string
+	VerifyTime        *timestamp.Timestamp
+	LastUsedStep      int64
+	FailedAttempts    uint32
+}
+
+// TableName returns the table name.
+func (t *totp) TableName() string {
+	return "auth_password_totp"
+}
+
+func newTotp(ctx context.Context, accountId string) (*totp, error) {
+	const op = "password.newTotp"
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	secret := make([]byte, totpSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io))
+	}
+	return &totp{
+		PasswordAccountId: accountId,
+		Secret:            secret,
+	}, nil
+}
+
+func (t *totp) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "password.(totp).encrypt"
+	if err := structwrapping.WrapStruct(ctx, cipher, t, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	keyId, err := cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("error reading cipher key id"))
+	}
+	t.KeyId = keyId
+	return nil
+}
+
+func (t *totp) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "password.(totp).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, t, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+// url returns the otpauth URL for t using loginName as the account name.
+func (t *totp) url(loginName string) string {
+	label := url.PathEscape(totpIssuer + ":" + loginName)
+	v := url.Values{}
+	v.Set("secret", totpEncoding.EncodeToString(t.Secret))
+	v.Set("issuer", totpIssuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(totpDigits))
+	v.Set("period", fmt.Sprint(int(totpPeriod.Seconds())))
+	return "otpauth://totp/" + label + "?" + v.Encode()
+}
+
+// validate returns the time step code was generated for if code is valid for
+// t at now and was not generated for a time step at or before
+// t.LastUsedStep. Otherwise it returns 0. t must be decrypted.
+func (t *totp) validate(code string, now time.Time) int64 {
+	if len(code) != totpDigits {
+		return 0
+	}
+	current := now.Unix() / int64(totpPeriod.Seconds())
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if step <= t.LastUsedStep {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(totpCode(t.Secret, step)), []byte(code)) == 1 {
+			return step
+		}
+	}
+	return 0
+}
+
+// totpCode returns the code for secret at step as defined in RFC 4226.
+func totpCode(secret []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	v := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, v%1000000)
+}
+
+// totpRecoveryCode is a hashed recovery code of a TOTP enrollment. Recovery
+// codes are 160 bits of random data, so a salted, slow hash is not needed to
+// keep them from being brute forced from their SHA-256 hashes.
+type totpRecoveryCode struct {
+	PasswordAccountId string               `gorm:"primary_key"`
+	CodeHash          []byte               `gorm:"primary_key"`
+	CreateTime        *timestamp.Timestamp `gorm:"default:current_timestamp"`
+}
+
+// TableName returns the table name.
+func (c *totpRecoveryCode) TableName() string {
+	return "auth_password_totp_recovery_code"
+}
+
+// newRecoveryCodes returns recoveryCodeCount random recovery codes for
+// accountId and their hashes.
+func newRecoveryCodes(ctx context.Context, accountId string) ([]string, []*totpRecoveryCode, error) {
+	const op = "password.newRecoveryCodes"
+	codes := make([]string, 0, recoveryCodeCount)
+	hashed := make([]*totpRecoveryCode, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		b := make([]byte, recoveryCodeBytes)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io))
+		}
+		encoded := strings.ToLower(totpEncoding.EncodeToString(b))
+		groups := make([]string, 0, len(encoded)/recoveryCodeGroupLength+1)
+		for len(encoded) > recoveryCodeGroupLength {
+			groups = append(groups, encoded[:recoveryCodeGroupLength])
+			encoded = encoded[recoveryCodeGroupLength:]
+		}
+		code := strings.Join(append(groups, encoded), "-")
+		codes = append(codes, code)
+		hashed = append(hashed, &totpRecoveryCode{
+			PasswordAccountId: accountId,
+			CodeHash:          hashRecoveryCode(code),
+		})
+	}
+	return codes, hashed, nil
+}
+
+// hashRecoveryCode returns the hash of code. Recovery codes are compared
+// case insensitively and without separators.
+func hashRecoveryCode(code string) []byte {
+	code = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(code))
+	return sum[:]
+}
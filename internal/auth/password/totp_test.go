@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package password
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTotp_totpCode(t *testing.T) {
+	// Test vectors from RFC 6238 Appendix B truncated to 6 digits.
+	secret := []byte("12345678901234567890")
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+		{unix: 20000000000, want: "353130"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, totpCode(secret, tt.unix/30))
+		})
+	}
+}
+
+func TestTotp_validate(t *testing.T) {
+	ctx := context.Background()
+	tp, err := newTotp(ctx, "apw_1234567890")
+	require.NoError(t, err)
+	require.Len(t, tp.Secret, totpSecretLength)
+
+	now := time.Unix(1700000000, 0)
+	current := now.Unix() / 30
+
+	tests := []struct {
+		name     string
+		code     string
+		lastUsed int64
+		want     int64
+	}{
+		{name: "current", code: totpCode(tp.Secret, current), want: current},
+		{name: "previous", code: totpCode(tp.Secret, current-1), want: current - 1},
+		{name: "next", code: totpCode(tp.Secret, current+1), want: current + 1},
+		{name: "too-old", code: totpCode(tp.Secret, current-2), want: 0},
+		{name: "too-new", code: totpCode(tp.Secret, current+2), want: 0},
+		{name: "already-used", code: totpCode(tp.Secret, current), lastUsed: current, want: 0},
+		{name: "wrong-length", code: "12345", want: 0},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tp.LastUsedStep = tt.lastUsed
+			assert.Equal(t, tt.want, tp.validate(tt.code, now))
+		})
+	}
+}
+
+func TestTotp_url(t *testing.T) {
+	ctx := context.Background()
+	tp, err := newTotp(ctx, "apw_1234567890")
+	require.NoError(t, err)
+
+	u, err := url.Parse(tp.url("jim"))
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/Boundary:jim", u.Path)
+	q := u.Query()
+	assert.Equal(t, totpEncoding.EncodeToString(tp.Secret), q.Get("secret"))
+	assert.Equal(t, "Boundary", q.Get("issuer"))
+	assert.Equal(t, "6", q.Get("digits"))
+	assert.Equal(t, "30", q.Get("period"))
+}
+
+func TestTotp_newRecoveryCodes(t *testing.T) {
+	ctx := context.Background()
+	codes, hashed, err := newRecoveryCodes(ctx, "apw_1234567890")
+	require.NoError(t, err)
+	require.Len(t, codes, recoveryCodeCount)
+	require.Len(t, hashed, recoveryCodeCount)
+
+	seen := make(map[string]bool)
+	for i, code := range codes {
+		// 160 bits encoded in base32, in groups of 8 characters.
+		assert.Regexp(t, `^[a-z2-7]{8}-[a-z2-7]{8}-[a-z2-7]{8}-[a-z2-7]{8}$`, code)
+		assert.False(t, seen[code], "duplicate recovery code")
+		seen[code] = true
+		assert.Equal(t, "apw_1234567890", hashed[i].PasswordAccountId)
+		assert.Equal(t, hashRecoveryCode(code), hashed[i].CodeHash)
+		// Recovery codes are compared case insensitively and without
+		// separators.
+		assert.Equal(t, hashed[i].CodeHash, hashRecoveryCode(" "+strings.ToUpper(strings.ReplaceAll(code, "-", ""))+" "))
+	}
+}
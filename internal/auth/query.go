@@ -8,7 +8,9 @@ const (
 select sum(reltuples::bigint) as estimate from pg_class where oid in (
     'auth_password_method'::regclass,
     'auth_ldap_method'::regclass,
-    'auth_oidc_method'::regclass
+    'auth_oidc_method'::regclass,
+    'auth_saml_method'::regclass,
+    'auth_cert_method'::regclass
 )
 `
 
@@ -24,6 +26,14 @@ select public_id
 select public_id
   from auth_ldap_method_deleted
  where delete_time >= @since
+ union
+select public_id
+  from auth_saml_method_deleted
+ where delete_time >= @since
+ union
+select public_id
+  from auth_cert_method_deleted
+ where delete_time >= @since
 `
 
 	listAuthMethodsTemplate = `
@@ -44,6 +54,16 @@ oidc as (
       from oidc_auth_method_with_value_obj
      where public_id in (select public_id from auth_methods)
 ),
+saml as (
+    select *
+      from saml_auth_method_with_value_obj
+     where public_id in (select public_id from auth_methods)
+),
+cert as (
+    select *
+      from cert_auth_method_with_is_primary
+     where public_id in (select public_id from auth_methods)
+),
 password as (
     select *
       from auth_password_method_with_is_primary
@@ -99,6 +119,16 @@ final as (
            null as claims_scopes,
            null as prompts,
            null as account_claim_maps,
+           null as idp_entity_id,
+           null as idp_sso_url,
+           null as idp_certificates,
+           null as sp_entity_id,
+           null as sp_certificate,
+           null as sign_authn_requests,
+           null as name_id_format,
+           null as account_attribute_maps,
+           null as ca_certificates,
+           null as account_field,
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
@@ -154,12 +184,87 @@ final as (
            claims_scopes,
            prompts,
            account_claim_maps,
+           null as idp_entity_id,
+           null as idp_sso_url,
+           null as idp_certificates,
+           null as sp_entity_id,
+           null as sp_certificate,
+           null as sign_authn_requests,
+           null as name_id_format,
+           null as account_attribute_maps,
+           null as ca_certificates,
+           null as account_field,
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
            'oidc' as subtype
       from oidc
      union
+    select public_id,
+           scope_id,
+           is_primary_auth_method,
+           name,
+           description,
+           create_time,
+           update_time,
+           version,
+           state,
+           null as start_tls,                    -- Add to make union uniform
+           null as insecure_tls,
+           null as discover_dn,
+           null as anon_group_search,
+           null as upn_domain,
+           null as enable_groups,
+           null as use_token_groups,
+           null as maximum_page_size,
+           null as urls,
+           null as certs,
+           null as account_attribute_map,
+           null as user_dn,
+           null as user_attr,
+           null as user_filter,
+           null as group_dn,
+           null as group_attr,
+           null as group_filter,
+           null as client_certificate_key,
+           null as client_certificate_key_hmac,
+           null as client_certificate_key_id,
+           null as client_certificate_cert,
+           null as bind_dn,
+           null as bind_password,
+           null as bind_password_hmac,
+           null as bind_password_key_id,
+           null as dereference_aliases,
+           null as disable_discovered_config_validation,
+           api_url,
+           null as issuer,
+           null as client_id,
+           null as client_secret,
+           null as client_secret_hmac,
+           key_id,
+           null as max_age,
+           null as algs,
+           null as auds,
+           null as certs,
+           null as claims_scopes,
+           null as prompts,
+           null as account_claim_maps,
+           idp_entity_id,
+           idp_sso_url,
+           idp_certificates,
+           sp_entity_id,
+           sp_certificate,
+           sign_authn_requests,
+           name_id_format,
+           account_attribute_maps,
+           null as ca_certificates,
+           null as account_field,
+           null as password_conf_id,
+           null::integer as min_login_name_length,
+           null::integer as min_password_length,
+           'saml' as subtype
+      from saml
+     union
     select public_id,
            scope_id,
            is_primary_auth_method,
@@ -209,11 +314,86 @@ final as (
            null as claims_scopes,
            null as prompts,
            null as account_claim_maps,
+           null as idp_entity_id,
+           null as idp_sso_url,
+           null as idp_certificates,
+           null as sp_entity_id,
+           null as sp_certificate,
+           null as sign_authn_requests,
+           null as name_id_format,
+           null as account_attribute_maps,
+           null as ca_certificates,
+           null as account_field,
            password_conf_id,
            min_login_name_length,
            min_password_length,
            'password' as subtype
       from password
+     union
+    select public_id,
+           scope_id,
+           is_primary_auth_method,
+           name,
+           description,
+           create_time,
+           update_time,
+           version,
+           state,
+           null as start_tls,                    -- Add to make union uniform
+           null as insecure_tls,
+           null as discover_dn,
+           null as anon_group_search,
+           null as upn_domain,
+           null as enable_groups,
+           null as use_token_groups,
+           null as maximum_page_size,
+           null as urls,
+           null as certs,
+           null as account_attribute_map,
+           null as user_dn,
+           null as user_attr,
+           null as user_filter,
+           null as group_dn,
+           null as group_attr,
+           null as group_filter,
+           null as client_certificate_key,
+           null as client_certificate_key_hmac,
+           null as client_certificate_key_id,
+           null as client_certificate_cert,
+           null as bind_dn,
+           null as bind_password,
+           null as bind_password_hmac,
+           null as bind_password_key_id,
+           null as dereference_aliases,
+           null as disable_discovered_config_validation,
+           null as api_url,
+           null as issuer,
+           null as client_id,
+           null as client_secret,
+           null as client_secret_hmac,
+           null as key_id,
+           null as max_age,
+           null as algs,
+           null as auds,
+           null as certs,
+           null as claims_scopes,
+           null as prompts,
+           null as account_claim_maps,
+           null as idp_entity_id,
+           null as idp_sso_url,
+           null as idp_certificates,
+           null as sp_entity_id,
+           null as sp_certificate,
+           null as sign_authn_requests,
+           null as name_id_format,
+           null as account_attribute_maps,
+           ca_certificates,
+           account_field,
+           null as password_conf_id,
+           null::integer as min_login_name_length,
+           null::integer as min_password_length,
+           'cert' as subtype
+      from cert
 )
   select *
     from final
@@ -239,6 +419,16 @@ oidc as (
       from oidc_auth_method_with_value_obj
      where public_id in (select public_id from auth_methods)
 ),
+saml as (
+    select *
+      from saml_auth_method_with_value_obj
+     where public_id in (select public_id from auth_methods)
+),
+cert as (
+    select *
+      from cert_auth_method_with_is_primary
+     where public_id in (select public_id from auth_methods)
+),
 password as (
     select *
       from auth_password_method_with_is_primary
@@ -294,6 +484,16 @@ final as (
            null as claims_scopes,
            null as prompts,
            null as account_claim_maps,
+           null as idp_entity_id,
+           null as idp_sso_url,
+           null as idp_certificates,
+           null as sp_entity_id,
+           null as sp_certificate,
+           null as sign_authn_requests,
+           null as name_id_format,
+           null as account_attribute_maps,
+           null as ca_certificates,
+           null as account_field,
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
@@ -349,12 +549,87 @@ final as (
            claims_scopes,
            prompts,
            account_claim_maps,
+           null as idp_entity_id,
+           null as idp_sso_url,
+           null as idp_certificates,
+           null as sp_entity_id,
+           null as sp_certificate,
+           null as sign_authn_requests,
+           null as name_id_format,
+           null as account_attribute_maps,
+           null as ca_certificates,
+           null as account_field,
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
            'oidc' as subtype
       from oidc
      union
+    select public_id,
+           scope_id,
+           is_primary_auth_method,
+           name,
+           description,
+           create_time,
+           update_time,
+           version,
+           state,
+           null as start_tls,                    -- Add to make union uniform
+           null as insecure_tls,
+           null as discover_dn,
+           null as anon_group_search,
+           null as upn_domain,
+           null as enable_groups,
+           null as use_token_groups,
+           null as maximum_page_size,
+           null as urls,
+           null as certs,
+           null as account_attribute_map,
+           null as user_dn,
+           null as user_attr,
+           null as user_filter,
+           null as group_dn,
+           null as group_attr,
+           null as group_filter,
+           null as client_certificate_key,
+           null as client_certificate_key_hmac,
+           null as client_certificate_key_id,
+           null as client_certificate_cert,
+           null as bind_dn,
+           null as bind_password,
+           null as bind_password_hmac,
+           null as bind_password_key_id,
+           null as dereference_aliases,
+           null as disable_discovered_config_validation,
+           api_url,
+           null as issuer,
+           null as client_id,
+           null as client_secret,
+           null as client_secret_hmac,
+           key_id,
+           null as max_age,
+           null as algs,
+           null as auds,
+           null as certs,
+           null as claims_scopes,
+           null as prompts,
+           null as account_claim_maps,
+           idp_entity_id,
+           idp_sso_url,
+           idp_certificates,
+           sp_entity_id,
+           sp_certificate,
+           sign_authn_requests,
+           name_id_format,
+           account_attribute_maps,
+           null as ca_certificates,
+           null as account_field,
+           null as password_conf_id,
+           null::integer as min_login_name_length,
+           null::integer as min_password_length,
+           'saml' as subtype
+      from saml
+     union
     select public_id,
            scope_id,
            is_primary_auth_method,
@@ -404,11 +679,86 @@ final as (
            null as claims_scopes,
            null as prompts,
            null as account_claim_maps,
+           null as idp_entity_id,
+           null as idp_sso_url,
+           null as idp_certificates,
+           null as sp_entity_id,
+           null as sp_certificate,
+           null as sign_authn_requests,
+           null as name_id_format,
+           null as account_attribute_maps,
+           null as ca_certificates,
+           null as account_field,
            password_conf_id,
            min_login_name_length,
            min_password_length,
            'password' as subtype
       from password
+     union
+    select public_id,
+           scope_id,
+           is_primary_auth_method,
+           name,
+           description,
+           create_time,
+           update_time,
+           version,
+           state,
+           null as start_tls,                    -- Add to make union uniform
+           null as insecure_tls,
+           null as discover_dn,
+           null as anon_group_search,
+           null as upn_domain,
+           null as enable_groups,
+           null as use_token_groups,
+           null as maximum_page_size,
+           null as urls,
+           null as certs,
+           null as account_attribute_map,
+           null as user_dn,
+           null as user_attr,
+           null as user_filter,
+           null as group_dn,
+           null as group_attr,
+           null as group_filter,
+           null as client_certificate_key,
+           null as client_certificate_key_hmac,
+           null as client_certificate_key_id,
+           null as client_certificate_cert,
+           null as bind_dn,
+           null as bind_password,
+           null as bind_password_hmac,
+           null as bind_password_key_id,
+           null as dereference_aliases,
+           null as disable_discovered_config_validation,
+           null as api_url,
+           null as issuer,
+           null as client_id,
+           null as client_secret,
+           null as client_secret_hmac,
+           null as key_id,
+           null as max_age,
+           null as algs,
+           null as auds,
+           null as certs,
+           null as claims_scopes,
+           null as prompts,
+           null as account_claim_maps,
+           null as idp_entity_id,
+           null as idp_sso_url,
+           null as idp_certificates,
+           null as sp_entity_id,
+           null as sp_certificate,
+           null as sign_authn_requests,
+           null as name_id_format,
+           null as account_attribute_maps,
+           ca_certificates,
+           account_field,
+           null as password_conf_id,
+           null::integer as min_login_name_length,
+           null::integer as min_password_length,
+           'cert' as subtype
+      from cert
 )
   select *
     from final
@@ -434,6 +784,16 @@ oidc as (
       from oidc_auth_method_with_value_obj
      where public_id in (select public_id from auth_methods)
 ),
+saml as (
+    select *
+      from saml_auth_method_with_value_obj
+     where public_id in (select public_id from auth_methods)
+),
+cert as (
+    select *
+      from cert_auth_method_with_is_primary
+     where public_id in (select public_id from auth_methods)
+),
 password as (
     select *
       from auth_password_method_with_is_primary
@@ -489,6 +849,16 @@ final as (
            null as claims_scopes,
            null as prompts,
            null as account_claim_maps,
+           null as idp_entity_id,
+           null as idp_sso_url,
+           null as idp_certificates,
+           null as sp_entity_id,
+           null as sp_certificate,
+           null as sign_authn_requests,
+           null as name_id_format,
+           null as account_attribute_maps,
+           null as ca_certificates,
+           null as account_field,
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
@@ -544,12 +914,87 @@ final as (
            claims_scopes,
            prompts,
            account_claim_maps,
+           null as idp_entity_id,
+           null as idp_sso_url,
+           null as idp_certificates,
+           null as sp_entity_id,
+           null as sp_certificate,
+           null as sign_authn_requests,
+           null as name_id_format,
+           null as account_attribute_maps,
+           null as ca_certificates,
+           null as account_field,
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
            'oidc' as subtype
       from oidc
      union
+    select public_id,
+           scope_id,
+           is_primary_auth_method,
+           name,
+           description,
+           create_time,
+           update_time,
+           version,
+           state,
+           null as start_tls,                    -- Add to make union uniform
+           null as insecure_tls,
+           null as discover_dn,
+           null as anon_group_search,
+           null as upn_domain,
+           null as enable_groups,
+           null as use_token_groups,
+           null as maximum_page_size,
+           null as urls,
+           null as certs,
+           null as account_attribute_map,
+           null as user_dn,
+           null as user_attr,
+           null as user_filter,
+           null as group_dn,
+           null as group_attr,
+           null as group_filter,
+           null as client_certificate_key,
+           null as client_certificate_key_hmac,
+           null as client_certificate_key_id,
+           null as client_certificate_cert,
+           null as bind_dn,
+           null as bind_password,
+           null as bind_password_hmac,
+           null as bind_password_key_id,
+           null as dereference_aliases,
+           null as disable_discovered_config_validation,
+           api_url,
+           null as issuer,
+           null as client_id,
+           null as client_secret,
+           null as client_secret_hmac,
+           key_id,
+           null as max_age,
+           null as algs,
+           null as auds,
+           null as certs,
+           null as claims_scopes,
+           null as prompts,
+           null as account_claim_maps,
+           idp_entity_id,
+           idp_sso_url,
+           idp_certificates,
+           sp_entity_id,
+           sp_certificate,
+           sign_authn_requests,
+           name_id_format,
+           account_attribute_maps,
+           null as ca_certificates,
+           null as account_field,
+           null as password_conf_id,
+           null::integer as min_login_name_length,
+           null::integer as min_password_length,
+           'saml' as subtype
+      from saml
+     union
     select public_id,
            scope_id,
            is_primary_auth_method,
@@ -599,11 +1044,86 @@ final as (
            null as claims_scopes,
            null as prompts,
            null as account_claim_maps,
+           null as idp_entity_id,
+           null as idp_sso_url,
+           null as idp_certificates,
+           null as sp_entity_id,
+           null as sp_certificate,
+           null as sign_authn_requests,
+           null as name_id_format,
+           null as account_attribute_maps,
+           null as ca_certificates,
+           null as account_field,
            password_conf_id,
            min_login_name_length,
            min_password_length,
            'password' as subtype
       from password
+     union
+    select public_id,
+           scope_id,
+           is_primary_auth_method,
+           name,
+           description,
+           create_time,
+           update_time,
+           version,
+           state,
+           null as start_tls,                    -- Add to make union uniform
+           null as insecure_tls,
+           null as discover_dn,
+           null as anon_group_search,
+           null as upn_domain,
+           null as enable_groups,
+           null as use_token_groups,
+           null as maximum_page_size,
+           null as urls,
+           null as certs,
+           null as account_attribute_map,
+           null as user_dn,
+           null as user_attr,
+           null as user_filter,
+           null as group_dn,
+           null as group_attr,
+           null as group_filter,
+           null as client_certificate_key,
+           null as client_certificate_key_hmac,
+           null as client_certificate_key_id,
+           null as client_certificate_cert,
+           null as bind_dn,
+           null as bind_password,
+           null as bind_password_hmac,
+           null as bind_password_key_id,
+           null as dereference_aliases,
+           null as disable_discovered_config_validation,
+           null as api_url,
+           null as issuer,
+           null as client_id,
+           null as client_secret,
+           null as client_secret_hmac,
+           null as key_id,
+           null as max_age,
+           null as algs,
+           null as auds,
+           null as certs,
+           null as claims_scopes,
+           null as prompts,
+           null as account_claim_maps,
+           null as idp_entity_id,
+           null as idp_sso_url,
+           null as idp_certificates,
+           null as sp_entity_id,
+           null as sp_certificate,
+           null as sign_authn_requests,
+           null as name_id_format,
+           null as account_attribute_maps,
+           ca_certificates,
+           account_field,
+           null as password_conf_id,
+           null::integer as min_login_name_length,
+           null::integer as min_password_length,
+           'cert' as subtype
+      from cert
 )
   select *
     from final
@@ -630,6 +1150,16 @@ oidc as (
       from oidc_auth_method_with_value_obj
      where public_id in (select public_id from auth_methods)
 ),
+saml as (
+    select *
+      from saml_auth_method_with_value_obj
+     where public_id in (select public_id from auth_methods)
+),
+cert as (
+    select *
+      from cert_auth_method_with_is_primary
+     where public_id in (select public_id from auth_methods)
+),
 password as (
     select *
       from auth_password_method_with_is_primary
@@ -685,6 +1215,16 @@ final as (
            null as claims_scopes,
            null as prompts,
            null as account_claim_maps,
+           null as idp_entity_id,
+           null as idp_sso_url,
+           null as idp_certificates,
+           null as sp_entity_id,
+           null as sp_certificate,
+           null as sign_authn_requests,
+           null as name_id_format,
+           null as account_attribute_maps,
+           null as ca_certificates,
+           null as account_field,
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
@@ -740,12 +1280,87 @@ final as (
            claims_scopes,
            prompts,
            account_claim_maps,
+           null as idp_entity_id,
+           null as idp_sso_url,
+           null as idp_certificates,
+           null as sp_entity_id,
+           null as sp_certificate,
+           null as sign_authn_requests,
+           null as name_id_format,
+           null as account_attribute_maps,
+           null as ca_certificates,
+           null as account_field,
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
            'oidc' as subtype
       from oidc
      union
+    select public_id,
+           scope_id,
+           is_primary_auth_method,
+           name,
+           description,
+           create_time,
+           update_time,
+           version,
+           state,
+           null as start_tls,                    -- Add to make union uniform
+           null as insecure_tls,
+           null as discover_dn,
+           null as anon_group_search,
+           null as upn_domain,
+           null as enable_groups,
+           null as use_token_groups,
+           null as maximum_page_size,
+           null as urls,
+           null as certs,
+           null as account_attribute_map,
+           null as user_dn,
+           null as user_attr,
+           null as user_filter,
+           null as group_dn,
+           null as group_attr,
+           null as group_filter,
+           null as client_certificate_key,
+           null as client_certificate_key_hmac,
+           null as client_certificate_key_id,
+           null as client_certificate_cert,
+           null as bind_dn,
+           null as bind_password,
+           null as bind_password_hmac,
+           null as bind_password_key_id,
+           null as dereference_aliases,
+           null as disable_discovered_config_validation,
+           api_url,
+           null as issuer,
+           null as client_id,
+           null as client_secret,
+           null as client_secret_hmac,
+           key_id,
+           null as max_age,
+           null as algs,
+           null as auds,
+           null as certs,
+           null as claims_scopes,
+           null as prompts,
+           null as account_claim_maps,
+           idp_entity_id,
+           idp_sso_url,
+           idp_certificates,
+           sp_entity_id,
+           sp_certificate,
+           sign_authn_requests,
+           name_id_format,
+           account_attribute_maps,
+           null as ca_certificates,
+           null as account_field,
+           null as password_conf_id,
+           null::integer as min_login_name_length,
+           null::integer as min_password_length,
+           'saml' as subtype
+      from saml
+     union
     select public_id,
            scope_id,
            is_primary_auth_method,
@@ -795,11 +1410,86 @@ final as (
            null as claims_scopes,
            null as prompts,
            null as account_claim_maps,
+           null as idp_entity_id,
+           null as idp_sso_url,
+           null as idp_certificates,
+           null as sp_entity_id,
+           null as sp_certificate,
+           null as sign_authn_requests,
+           null as name_id_format,
+           null as account_attribute_maps,
+           null as ca_certificates,
+           null as account_field,
            password_conf_id,
            min_login_name_length,
            min_password_length,
            'password' as subtype
       from password
+     union
+    select public_id,
+           scope_id,
+           is_primary_auth_method,
+           name,
+           description,
+           create_time,
+           update_time,
+           version,
+           state,
+           null as start_tls,                    -- Add to make union uniform
+           null as insecure_tls,
+           null as discover_dn,
+           null as anon_group_search,
+           null as upn_domain,
+           null as enable_groups,
+           null as use_token_groups,
+           null as maximum_page_size,
+           null as urls,
+           null as certs,
+           null as account_attribute_map,
+           null as user_dn,
+           null as user_attr,
+           null as user_filter,
+           null as group_dn,
+           null as group_attr,
+           null as group_filter,
+           null as client_certificate_key,
+           null as client_certificate_key_hmac,
+           null as client_certificate_key_id,
+           null as client_certificate_cert,
+           null as bind_dn,
+           null as bind_password,
+           null as bind_password_hmac,
+           null as bind_password_key_id,
+           null as dereference_aliases,
+           null as disable_discovered_config_validation,
+           null as api_url,
+           null as issuer,
+           null as client_id,
+           null as client_secret,
+           null as client_secret_hmac,
+           null as key_id,
+           null as max_age,
+           null as algs,
+           null as auds,
+           null as certs,
+           null as claims_scopes,
+           null as prompts,
+           null as account_claim_maps,
+           null as idp_entity_id,
+           null as idp_sso_url,
+           null as idp_certificates,
+           null as sp_entity_id,
+           null as sp_certificate,
+           null as sign_authn_requests,
+           null as name_id_format,
+           null as account_attribute_maps,
+           ca_certificates,
+           account_field,
+           null as password_conf_id,
+           null::integer as min_login_name_length,
+           null::integer as min_password_length,
+           'cert' as subtype
+      from cert
 )
   select *
     from final
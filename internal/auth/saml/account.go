@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/boundary/internal/auth/saml/store"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultAccountTableName defines the default table name for an Account
+const defaultAccountTableName = "auth_saml_account"
+
+// Account contains a SAML auth account. It is assigned to a SAML AuthMethod
+// and updates/deletes to that AuthMethod are cascaded to its Accounts.
+type Account struct {
+	*store.Account
+	tableName string
+}
+
+// NewAccount creates a new in memory Account assigned to SAML AuthMethod.
+// WithIssuer, WithFullName, WithEmail, WithName and WithDescription are
+// the only valid options. All other options are ignored.
+//
+// Subject equals the value of the attribute mapped to "sub" or, when no such
+// mapping exists, the NameID of the assertion's subject.
+//
+// Issuer equals the entity id of the identity provider which issued the
+// assertion.
+func NewAccount(ctx context.Context, authMethodId string, subject string, opt ...Option) (*Account, error) {
+	const op = "saml.NewAccount"
+	opts := getOpts(opt...)
+	a := &Account{
+		Account: &store.Account{
+			AuthMethodId: authMethodId,
+			Subject:      subject,
+			Issuer:       opts.withIssuer,
+			Name:         opts.withName,
+			Description:  opts.withDescription,
+			FullName:     opts.withFullName,
+			Email:        opts.withEmail,
+		},
+	}
+	if err := a.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped.
+	}
+
+	return a, nil
+}
+
+// validate the Account.  On success, it will return nil.
+func (a *Account) validate(ctx context.Context, caller errors.Op) error {
+	if a.AuthMethodId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing auth method id")
+	}
+	if a.Subject == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing subject")
+	}
+	if a.Email != "" && len(a.Email) > 320 {
+		return errors.New(ctx, errors.InvalidParameter, caller, "email address is too long")
+	}
+	if a.FullName != "" && len(a.FullName) > 512 {
+		return errors.New(ctx, errors.InvalidParameter, caller, "full name is too long")
+	}
+	return nil
+}
+
+// AllocAccount makes an empty one in memory
+func AllocAccount() *Account {
+	return &Account{
+		Account: &store.Account{},
+	}
+}
+
+// Clone an Account.
+func (a *Account) Clone() *Account {
+	cp := proto.Clone(a.Account)
+	return &Account{
+		Account: cp.(*store.Account),
+	}
+}
+
+// TableName returns the table name.
+func (a *Account) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return defaultAccountTableName
+}
+
+// SetTableName sets the table name.
+func (a *Account) SetTableName(n string) {
+	a.tableName = n
+}
+
+// GetResourceType returns the resource type of the Account
+func (a *Account) GetResourceType() resource.Type {
+	return resource.Account
+}
+
+// GetLoginName returns the login name, which will always be empty as this type
+// doesn't currently support login name
+func (a *Account) GetLoginName() string {
+	return ""
+}
+
+// AttributeValues returns the assertion attributes which were recorded for
+// the Account during its last authentication.
+func (a *Account) AttributeValues(ctx context.Context) (map[string][]string, error) {
+	const op = "saml.(Account).AttributeValues"
+	attrs := map[string][]string{}
+	if a.Attributes == "" {
+		return attrs, nil
+	}
+	if err := json.Unmarshal([]byte(a.Attributes), &attrs); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode))
+	}
+	return attrs, nil
+}
+
+// oplog will create oplog metadata for the Account.
+func (a *Account) oplog(op oplog.OpType, authMethodScopeId string) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{a.GetPublicId()},
+		"resource-type":      []string{"saml account"},
+		"op-type":            []string{op.String()},
+	}
+	if a.AuthMethodId != "" {
+		metadata["auth-method-id"] = []string{a.AuthMethodId}
+	}
+	if authMethodScopeId != "" {
+		metadata["scope-id"] = []string{authMethodScopeId}
+	}
+	return metadata
+}
+
+type deletedAccount struct {
+	PublicId   string `gorm:"primary_key"`
+	DeleteTime *timestamp.Timestamp
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (s *deletedAccount) TableName() string {
+	return "auth_saml_account_deleted"
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/auth/saml/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// defaultAcctAttributeMapTableName defines the default table name for an AccountAttributeMap
+	defaultAcctAttributeMapTableName = "auth_saml_account_attribute_map"
+)
+
+type AccountToAttribute string
+
+const (
+	ToSubAttribute   AccountToAttribute = "sub"
+	ToEmailAttribute AccountToAttribute = "email"
+	ToNameAttribute  AccountToAttribute = "name"
+)
+
+func ConvertToAccountToAttribute(ctx context.Context, s string) (AccountToAttribute, error) {
+	const op = "saml.ConvertToAccountToAttribute"
+	switch s {
+	case string(ToSubAttribute):
+		return ToSubAttribute, nil
+	case string(ToEmailAttribute):
+		return ToEmailAttribute, nil
+	case string(ToNameAttribute):
+		return ToNameAttribute, nil
+	default:
+		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s is not a valid ToAccountAttribute value", s))
+	}
+}
+
+// AccountAttributeMap maps a SAML assertion attribute to a field of the
+// Account (sub, name or email).
+type AccountAttributeMap struct {
+	*store.AccountAttributeMap
+	tableName string
+}
+
+func NewAccountAttributeMap(ctx context.Context, authMethodId, fromAttribute string, toAttribute AccountToAttribute) (*AccountAttributeMap, error) {
+	const op = "saml.NewAccountAttributeMap"
+	m := &AccountAttributeMap{
+		AccountAttributeMap: &store.AccountAttributeMap{
+			SamlMethodId:  authMethodId,
+			FromAttribute: fromAttribute,
+			ToAttribute:   string(toAttribute),
+		},
+	}
+	if err := m.validate(ctx, op); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// validate the AccountAttributeMap.  On success, it will return nil.
+func (m *AccountAttributeMap) validate(ctx context.Context, caller errors.Op) error {
+	if m.SamlMethodId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing saml auth method id")
+	}
+	if m.FromAttribute == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing from attribute")
+	}
+	if _, err := ConvertToAccountToAttribute(ctx, m.ToAttribute); err != nil {
+		return errors.Wrap(ctx, err, caller)
+	}
+	return nil
+}
+
+// AllocAccountAttributeMap makes an empty one in memory
+func AllocAccountAttributeMap() AccountAttributeMap {
+	return AccountAttributeMap{
+		AccountAttributeMap: &store.AccountAttributeMap{},
+	}
+}
+
+// Clone an AccountAttributeMap
+func (m *AccountAttributeMap) Clone() *AccountAttributeMap {
+	cp := proto.Clone(m.AccountAttributeMap)
+	return &AccountAttributeMap{
+		AccountAttributeMap: cp.(*store.AccountAttributeMap),
+	}
+}
+
+// TableName returns the table name.
+func (m *AccountAttributeMap) TableName() string {
+	if m.tableName != "" {
+		return m.tableName
+	}
+	return defaultAcctAttributeMapTableName
+}
+
+// SetTableName sets the table name.
+func (m *AccountAttributeMap) SetTableName(n string) {
+	m.tableName = n
+}
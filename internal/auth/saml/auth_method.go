@@ -0,0 +1,435 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	stderrors "errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/saml/store"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+	kvbuilder "github.com/hashicorp/go-secure-stdlib/kv-builder"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultAuthMethodTableName defines the default table name for an AuthMethod
+const defaultAuthMethodTableName = "auth_saml_method"
+
+const (
+	// spCertificateValidity is how long the generated service provider
+	// certificate is valid.
+	spCertificateValidity = 10 * 365 * 24 * time.Hour
+
+	// spKeyBits is the size of the generated service provider RSA key.
+	spKeyBits = 2048
+)
+
+// AuthMethod contains a SAML auth method configuration. It is owned by a
+// scope.  AuthMethods can have Accounts, ManagedGroups and
+// AccountAttributeMaps.  AuthMethods also have one State at any given time
+// which determines its behavior for many of its operations.
+type AuthMethod struct {
+	*store.AuthMethod
+	tableName string
+}
+
+// NewAuthMethod creates a new in memory AuthMethod assigned to scopeId.
+//
+// State is not a supported parameter when creating new AuthMethod's unless the
+// AuthMethod is complete, since new AuthMethods are inactive by default.
+//
+// The service provider certificate and its private key are generated by the
+// repository when the AuthMethod is created.
+//
+// Supports the options of WithName, WithDescription, WithApiUrl,
+// WithIdpEntityId, WithIdpSsoUrl, WithIdpCertificates, WithSpEntityId,
+// WithSignAuthnRequests, WithNameIdFormat, WithAccountAttributeMap and
+// WithOperationalState. All other options are ignored.
+func NewAuthMethod(ctx context.Context, scopeId string, opt ...Option) (*AuthMethod, error) {
+	const op = "saml.NewAuthMethod"
+	opts := getOpts(opt...)
+
+	a := &AuthMethod{
+		AuthMethod: &store.AuthMethod{
+			ScopeId:           scopeId,
+			Name:              opts.withName,
+			Description:       opts.withDescription,
+			OperationalState:  string(opts.withOperationalState),
+			IdpEntityId:       opts.withIdpEntityId,
+			SpEntityId:        opts.withSpEntityId,
+			SignAuthnRequests: opts.withSignAuthnRequests,
+			NameIdFormat:      opts.withNameIdFormat,
+		},
+	}
+	if opts.withApiUrl != nil {
+		a.ApiUrl = opts.withApiUrl.String()
+	}
+	if opts.withIdpSsoUrl != nil {
+		a.IdpSsoUrl = opts.withIdpSsoUrl.String()
+	}
+	if len(opts.withIdpCertificates) > 0 {
+		pems, err := EncodeCertificates(ctx, opts.withIdpCertificates...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		a.IdpCertificates = pems
+	}
+	if len(opts.withAccountAttributeMap) > 0 {
+		a.AccountAttributeMaps = make([]string, 0, len(opts.withAccountAttributeMap))
+		for k, v := range opts.withAccountAttributeMap {
+			a.AccountAttributeMaps = append(a.AccountAttributeMaps, fmt.Sprintf("%s=%s", k, v))
+		}
+		sort.Strings(a.AccountAttributeMaps)
+	}
+	if a.OperationalState != string(InactiveState) {
+		if err := a.isComplete(ctx); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("new auth method being created with incomplete data but non-inactive state"))
+		}
+	}
+	if err := a.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped.
+	}
+	return a, nil
+}
+
+// validate the AuthMethod.  On success, it will return nil. Since the
+// configuration of the identity provider is usually only known after the
+// service provider metadata of the AuthMethod has been registered with it, the
+// identity provider fields can be empty until the AuthMethod leaves the
+// inactive state.
+func (am *AuthMethod) validate(ctx context.Context, caller errors.Op) error {
+	if am.ScopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing scope id")
+	}
+	if !validState(am.OperationalState) {
+		return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("invalid state: %s", am.OperationalState))
+	}
+	if am.ApiUrl != "" {
+		if _, err := url.Parse(am.ApiUrl); err != nil {
+			return errors.New(ctx, errors.InvalidParameter, caller, "not a valid api url", errors.WithWrap(err))
+		}
+	}
+	if am.IdpSsoUrl != "" {
+		u, err := url.Parse(am.IdpSsoUrl)
+		if err != nil {
+			return errors.New(ctx, errors.InvalidParameter, caller, "not a valid idp sso url", errors.WithWrap(err))
+		}
+		if u.Scheme != "https" && u.Scheme != "http" {
+			return errors.New(ctx, errors.InvalidParameter, caller, "idp sso url must use http or https")
+		}
+	}
+	if am.IdpCertificates != "" {
+		if _, err := ParseCertificates(ctx, am.IdpCertificates); err != nil {
+			return errors.Wrap(ctx, err, caller)
+		}
+	}
+	if _, err := ParseAccountAttributeMaps(ctx, am.AccountAttributeMaps...); err != nil {
+		return errors.Wrap(ctx, err, caller)
+	}
+	return nil
+}
+
+// isComplete checks the auth method to see if it has all the required
+// components of a complete/valid saml auth method.
+func (am *AuthMethod) isComplete(ctx context.Context) error {
+	const op = "saml.(AuthMethod).isComplete"
+	var result error
+	if err := am.validate(ctx, op); err != nil {
+		result = stderrors.Join(result, errors.Wrap(ctx, err, op))
+	}
+	if am.ApiUrl == "" {
+		result = stderrors.Join(result, errors.New(ctx, errors.InvalidParameter, op, "missing api url"))
+	}
+	if am.IdpEntityId == "" {
+		result = stderrors.Join(result, errors.New(ctx, errors.InvalidParameter, op, "missing idp entity id"))
+	}
+	if am.IdpSsoUrl == "" {
+		result = stderrors.Join(result, errors.New(ctx, errors.InvalidParameter, op, "missing idp sso url"))
+	}
+	if am.IdpCertificates == "" {
+		result = stderrors.Join(result, errors.New(ctx, errors.InvalidParameter, op, "missing idp certificates"))
+	}
+	return result
+}
+
+// AllocAuthMethod makes an empty one in memory
+func AllocAuthMethod() AuthMethod {
+	return AuthMethod{
+		AuthMethod: &store.AuthMethod{},
+	}
+}
+
+// Clone an AuthMethod.
+func (am *AuthMethod) Clone() *AuthMethod {
+	cp := proto.Clone(am.AuthMethod)
+	return &AuthMethod{
+		AuthMethod: cp.(*store.AuthMethod),
+	}
+}
+
+// TableName returns the table name.
+func (am *AuthMethod) TableName() string {
+	if am.tableName != "" {
+		return am.tableName
+	}
+	return defaultAuthMethodTableName
+}
+
+// SetTableName sets the table name.
+func (am *AuthMethod) SetTableName(n string) {
+	am.tableName = n
+}
+
+// GetResourceType returns the resource type of the AuthMethod
+func (am *AuthMethod) GetResourceType() resource.Type {
+	return resource.AuthMethod
+}
+
+// AcsUrl returns the assertion consumer service URL of the AuthMethod which
+// receives the responses of the identity provider.
+func (am *AuthMethod) AcsUrl() string {
+	return fmt.Sprintf(CallbackEndpoint, am.GetApiUrl(), am.GetPublicId())
+}
+
+// EntityId returns the entity id of Boundary as a service provider for the
+// AuthMethod.
+func (am *AuthMethod) EntityId() string {
+	if am.GetSpEntityId() != "" {
+		return am.GetSpEntityId()
+	}
+	return fmt.Sprintf(EntityIdEndpoint, am.GetApiUrl(), am.GetPublicId())
+}
+
+// oplog will create oplog metadata for the AuthMethod.
+func (am *AuthMethod) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{am.GetPublicId()},
+		"resource-type":      []string{"saml auth method"},
+		"op-type":            []string{op.String()},
+		"scope-id":           []string{am.ScopeId},
+	}
+	return metadata
+}
+
+// encrypt the auth method before writing it to the db
+func (am *AuthMethod) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "saml.(AuthMethod).encrypt"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, am.AuthMethod, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	keyId, err := cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("failed to read cipher key id"))
+	}
+	am.KeyId = keyId
+	return nil
+}
+
+// decrypt the auth method after reading it from the db
+func (am *AuthMethod) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "saml.(AuthMethod).decrypt"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	if err := structwrapping.UnwrapStruct(ctx, cipher, am.AuthMethod, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+// generateSpCertificate generates the self-signed certificate and private key
+// which Boundary uses to sign AuthnRequests for the AuthMethod.  It will
+// return an error if the AuthMethod's public id is not set.
+func (am *AuthMethod) generateSpCertificate(ctx context.Context) error {
+	const op = "saml.(AuthMethod).generateSpCertificate"
+	if am.PublicId == "" {
+		return errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	key, err := rsa.GenerateKey(rand.Reader, spKeyBits)
+	if err != nil {
+		return errors.New(ctx, errors.Encrypt, op, "unable to generate key", errors.WithWrap(err))
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return errors.New(ctx, errors.Encrypt, op, "unable to generate serial number", errors.WithWrap(err))
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: am.PublicId},
+		NotBefore:             now.Add(-time.Minute),
+		NotAfter:              now.Add(spCertificateValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return errors.New(ctx, errors.Encrypt, op, "unable to create certificate", errors.WithWrap(err))
+	}
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return errors.New(ctx, errors.Encode, op, "unable to marshal private key", errors.WithWrap(err))
+	}
+	am.SpCertificate = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	am.SpPrivateKey = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer}))
+	return nil
+}
+
+// spSigner returns the private key and certificate of the service provider.
+func (am *AuthMethod) spSigner(ctx context.Context) (*rsa.PrivateKey, *x509.Certificate, error) {
+	const op = "saml.(AuthMethod).spSigner"
+	keyBlock, _ := pem.Decode([]byte(am.SpPrivateKey))
+	if keyBlock == nil {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing service provider private key")
+	}
+	k, err := x509.ParsePKCS8PrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "unable to parse service provider private key", errors.WithWrap(err))
+	}
+	key, ok := k.(*rsa.PrivateKey)
+	if !ok {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "service provider private key is not an rsa key")
+	}
+	certs, err := ParseCertificates(ctx, am.SpCertificate)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	return key, certs[0], nil
+}
+
+// convertAccountAttributeMaps converts the embedded account attribute maps
+// from []string to []interface{} where each slice element is a
+// *AccountAttributeMap. It will return an error if the AuthMethod's public id
+// is not set or it can't convert the account attribute maps.
+func (am *AuthMethod) convertAccountAttributeMaps(ctx context.Context) ([]any, error) {
+	const op = "saml.(AuthMethod).convertAccountAttributeMaps"
+	if am.PublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	aams, err := ParseAccountAttributeMaps(ctx, am.AccountAttributeMaps...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	newInterfaces := make([]any, 0, len(aams))
+	for _, m := range aams {
+		toAttr, err := ConvertToAccountToAttribute(ctx, m.To)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		obj, err := NewAccountAttributeMap(ctx, am.PublicId, m.From, toAttr)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		newInterfaces = append(newInterfaces, obj)
+	}
+	return newInterfaces, nil
+}
+
+// AttributeMap defines the To and From of a saml attribute map
+type AttributeMap struct {
+	To   string
+	From string
+}
+
+// ParseAccountAttributeMaps will parse the inbound attribute maps
+func ParseAccountAttributeMaps(ctx context.Context, m ...string) ([]AttributeMap, error) {
+	const op = "saml.ParseAccountAttributeMaps"
+	var b kvbuilder.Builder
+	if err := b.Add(m...); err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "error parsing map", errors.WithWrap(err))
+	}
+	fromKeys := make([]string, 0, len(m))
+	for k := range b.Map() {
+		fromKeys = append(fromKeys, k)
+	}
+	sort.Strings(fromKeys)
+
+	attrMap := make([]AttributeMap, 0, len(fromKeys))
+	for _, from := range fromKeys {
+		to, ok := b.Map()[from].(string)
+		if !ok {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("account attribute map %s value %q is not a string", from, b.Map()[from]))
+		}
+		if _, err := ConvertToAccountToAttribute(ctx, to); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		attrMap = append(attrMap, AttributeMap{
+			To:   to,
+			From: from,
+		})
+	}
+	return attrMap, nil
+}
+
+// EncodeCertificates encodes the certificates as a PEM bundle.
+func EncodeCertificates(ctx context.Context, certs ...*x509.Certificate) (string, error) {
+	const op = "saml.EncodeCertificates"
+	if len(certs) == 0 {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "no certs provided")
+	}
+	var bundle []byte
+	for _, cert := range certs {
+		if cert == nil {
+			return "", errors.New(ctx, errors.InvalidParameter, op, "nil cert")
+		}
+		bundle = append(bundle, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+	}
+	return string(bundle), nil
+}
+
+// ParseCertificates parses every certificate of the PEM bundles.
+func ParseCertificates(ctx context.Context, pems ...string) ([]*x509.Certificate, error) {
+	const op = "saml.ParseCertificates"
+	if len(pems) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no PEMs provided")
+	}
+	var certs []*x509.Certificate
+	for _, p := range pems {
+		rest := []byte(p)
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, errors.New(ctx, errors.InvalidParameter, op, "failed to parse certificate: "+err.Error(), errors.WithWrap(err))
+			}
+			certs = append(certs, cert)
+		}
+	}
+	if len(certs) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "failed to parse certificate PEM")
+	}
+	return certs, nil
+}
+
+type deletedAuthMethod struct {
+	PublicId   string `gorm:"primary_key"`
+	DeleteTime *timestamp.Timestamp
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (s *deletedAuthMethod) TableName() string {
+	return "auth_saml_method_deleted"
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+const (
+	// bindingHttpPost is the binding the identity provider must use to send
+	// its response to the assertion consumer service.
+	bindingHttpPost = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+
+	// statusSuccess is the status code of a successful response.
+	statusSuccess = "urn:oasis:names:tc:SAML:2.0:status:Success"
+
+	// subjectConfirmationBearer is the only supported subject confirmation
+	// method.
+	subjectConfirmationBearer = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+)
+
+// authnRequestUrl builds the AuthnRequest for the request id and returns the
+// URL of the identity provider's single sign-on service which will receive it
+// using the HTTP-Redirect binding.  The request id is used as both the ID of
+// the AuthnRequest and the RelayState.  If the AuthMethod signs its
+// AuthnRequests, the query string is signed with the service provider's key.
+func (am *AuthMethod) authnRequestUrl(ctx context.Context, requestId string, issueInstant time.Time) (*url.URL, error) {
+	const op = "saml.(AuthMethod).authnRequestUrl"
+	if requestId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing request id")
+	}
+	ssoUrl, err := url.Parse(am.IdpSsoUrl)
+	if err != nil || am.IdpSsoUrl == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "invalid idp sso url", errors.WithWrap(err))
+	}
+
+	var doc bytes.Buffer
+	doc.WriteString(`<samlp:AuthnRequest xmlns:samlp="` + nsProtocol + `" xmlns:saml="` + nsAssert + `"`)
+	writeAttr(&doc, "ID", requestId)
+	writeAttr(&doc, "Version", "2.0")
+	writeAttr(&doc, "IssueInstant", issueInstant.UTC().Format(time.RFC3339))
+	writeAttr(&doc, "Destination", am.IdpSsoUrl)
+	writeAttr(&doc, "AssertionConsumerServiceURL", am.AcsUrl())
+	writeAttr(&doc, "ProtocolBinding", bindingHttpPost)
+	doc.WriteString(`><saml:Issuer>`)
+	escapeText(&doc, am.EntityId())
+	doc.WriteString(`</saml:Issuer>`)
+	if am.NameIdFormat != "" {
+		doc.WriteString(`<samlp:NameIDPolicy`)
+		writeAttr(&doc, "Format", am.NameIdFormat)
+		writeAttr(&doc, "AllowCreate", "true")
+		doc.WriteString(`/>`)
+	}
+	doc.WriteString(`</samlp:AuthnRequest>`)
+
+	var deflated bytes.Buffer
+	fw, err := flate.NewWriter(&deflated, flate.DefaultCompression)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Encode, op, "unable to create deflate writer", errors.WithWrap(err))
+	}
+	if _, err := fw.Write(doc.Bytes()); err != nil {
+		return nil, errors.New(ctx, errors.Encode, op, "unable to deflate authn request", errors.WithWrap(err))
+	}
+	if err := fw.Close(); err != nil {
+		return nil, errors.New(ctx, errors.Encode, op, "unable to deflate authn request", errors.WithWrap(err))
+	}
+
+	// The query string is built by hand since the signature covers the
+	// parameters in this exact order and encoding.
+	query := "SAMLRequest=" + url.QueryEscape(base64.StdEncoding.EncodeToString(deflated.Bytes())) +
+		"&RelayState=" + url.QueryEscape(requestId)
+	if am.SignAuthnRequests {
+		key, _, err := am.spSigner(ctx)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		query += "&SigAlg=" + url.QueryEscape(algRsaSha256)
+		hashed := sha256.Sum256([]byte(query))
+		sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed[:])
+		if err != nil {
+			return nil, errors.New(ctx, errors.Encrypt, op, "unable to sign authn request", errors.WithWrap(err))
+		}
+		query += "&Signature=" + url.QueryEscape(base64.StdEncoding.EncodeToString(sig))
+	}
+	if ssoUrl.RawQuery != "" {
+		query = ssoUrl.RawQuery + "&" + query
+	}
+	ssoUrl.RawQuery = query
+	return ssoUrl, nil
+}
+
+// Metadata returns the SAML metadata of Boundary as a service provider for
+// the AuthMethod, which is registered with the identity provider.
+func (am *AuthMethod) Metadata(ctx context.Context) (string, error) {
+	const op = "saml.(AuthMethod).Metadata"
+	if am.PublicId == "" {
+		return "", errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	if am.ApiUrl == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing api url")
+	}
+	certs, err := ParseCertificates(ctx, am.SpCertificate)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	authnRequestsSigned := "false"
+	if am.SignAuthnRequests {
+		authnRequestsSigned = "true"
+	}
+
+	var doc bytes.Buffer
+	doc.WriteString(`<md:EntityDescriptor xmlns:md="` + nsMetadata + `"`)
+	writeAttr(&doc, "entityID", am.EntityId())
+	doc.WriteString(`><md:SPSSODescriptor`)
+	writeAttr(&doc, "AuthnRequestsSigned", authnRequestsSigned)
+	writeAttr(&doc, "WantAssertionsSigned", "true")
+	writeAttr(&doc, "protocolSupportEnumeration", nsProtocol)
+	doc.WriteString(`><md:KeyDescriptor use="signing"><ds:KeyInfo xmlns:ds="` + nsDsig + `"><ds:X509Data><ds:X509Certificate>`)
+	doc.WriteString(base64.StdEncoding.EncodeToString(certs[0].Raw))
+	doc.WriteString(`</ds:X509Certificate></ds:X509Data></ds:KeyInfo></md:KeyDescriptor>`)
+	if am.NameIdFormat != "" {
+		doc.WriteString(`<md:NameIDFormat>`)
+		escapeText(&doc, am.NameIdFormat)
+		doc.WriteString(`</md:NameIDFormat>`)
+	}
+	doc.WriteString(`<md:AssertionConsumerService`)
+	writeAttr(&doc, "Binding", bindingHttpPost)
+	writeAttr(&doc, "Location", am.AcsUrl())
+	writeAttr(&doc, "index", "0")
+	writeAttr(&doc, "isDefault", "true")
+	doc.WriteString(`/></md:SPSSODescriptor></md:EntityDescriptor>`)
+	return doc.String(), nil
+}
+
+func writeAttr(buf *bytes.Buffer, name, value string) {
+	buf.WriteString(" " + name + `="`)
+	escapeAttrValue(buf, value)
+	buf.WriteByte('"')
+}
+
+// decodeSamlResponse decodes the base64 encoded SAMLResponse parameter of
+// the HTTP-POST binding.
+func decodeSamlResponse(ctx context.Context, samlResponse string) ([]byte, error) {
+	const op = "saml.decodeSamlResponse"
+	doc, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(samlResponse), ""))
+	if err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "unable to decode saml response", errors.WithWrap(err))
+	}
+	return doc, nil
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthMethod_authnRequestUrl(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	idp := NewTestIdp(t)
+	am := testResponseAuthMethod(t, idp)
+	am.NameIdFormat = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	require.NoError(t, am.generateSpCertificate(ctx))
+	now := time.Now()
+
+	inflate := func(t *testing.T, samlRequest string) *xmlElement {
+		t.Helper()
+		deflated, err := base64.StdEncoding.DecodeString(samlRequest)
+		require.NoError(t, err)
+		doc, err := io.ReadAll(flate.NewReader(bytes.NewReader(deflated)))
+		require.NoError(t, err)
+		e, err := parseXml(ctx, doc)
+		require.NoError(t, err)
+		return e
+	}
+
+	t.Run("unsigned", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		u, err := am.authnRequestUrl(ctx, "samlreq_1234567890", now)
+		require.NoError(err)
+		assert.True(strings.HasPrefix(u.String(), idp.SsoUrl()+"?"))
+		q := u.Query()
+		assert.Equal("samlreq_1234567890", q.Get("RelayState"))
+		assert.Empty(q.Get("SigAlg"))
+		assert.Empty(q.Get("Signature"))
+
+		req := inflate(t, q.Get("SAMLRequest"))
+		assert.True(req.is(nsProtocol, "AuthnRequest"))
+		assert.Equal("samlreq_1234567890", req.attr("ID"))
+		assert.Equal(idp.SsoUrl(), req.attr("Destination"))
+		assert.Equal(am.AcsUrl(), req.attr("AssertionConsumerServiceURL"))
+		assert.Equal(bindingHttpPost, req.attr("ProtocolBinding"))
+		assert.Equal(am.EntityId(), req.child(nsAssert, "Issuer").text())
+		assert.Equal(am.NameIdFormat, req.child(nsProtocol, "NameIDPolicy").attr("Format"))
+	})
+	t.Run("signed", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		signing := am.Clone()
+		signing.SignAuthnRequests = true
+		u, err := signing.authnRequestUrl(ctx, "samlreq_1234567890", now)
+		require.NoError(err)
+		q := u.Query()
+		assert.Equal(algRsaSha256, q.Get("SigAlg"))
+
+		signed, _, found := strings.Cut(u.RawQuery, "&Signature=")
+		require.True(found)
+		sig, err := base64.StdEncoding.DecodeString(q.Get("Signature"))
+		require.NoError(err)
+		key, _, err := signing.spSigner(ctx)
+		require.NoError(err)
+		hashed := sha256.Sum256([]byte(signed))
+		assert.NoError(rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, hashed[:], sig))
+	})
+	t.Run("missing-request-id", func(t *testing.T) {
+		_, err := am.authnRequestUrl(ctx, "", now)
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("missing-sso-url", func(t *testing.T) {
+		incomplete := am.Clone()
+		incomplete.IdpSsoUrl = ""
+		_, err := incomplete.authnRequestUrl(ctx, "samlreq_1234567890", now)
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+}
+
+func TestAuthMethod_Metadata(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	am := testResponseAuthMethod(t, NewTestIdp(t))
+	require.NoError(t, am.generateSpCertificate(ctx))
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		md, err := am.Metadata(ctx)
+		require.NoError(err)
+		e, err := parseXml(ctx, []byte(md))
+		require.NoError(err)
+		assert.True(e.is(nsMetadata, "EntityDescriptor"))
+		assert.Equal(am.EntityId(), e.attr("entityID"))
+		sp := e.child(nsMetadata, "SPSSODescriptor")
+		require.NotNil(sp)
+		assert.Equal("false", sp.attr("AuthnRequestsSigned"))
+		assert.Equal("true", sp.attr("WantAssertionsSigned"))
+		acs := sp.child(nsMetadata, "AssertionConsumerService")
+		require.NotNil(acs)
+		assert.Equal(am.AcsUrl(), acs.attr("Location"))
+		assert.Equal(bindingHttpPost, acs.attr("Binding"))
+
+		_, cert, err := am.spSigner(ctx)
+		require.NoError(err)
+		x509Data := sp.child(nsMetadata, "KeyDescriptor").child(nsDsig, "KeyInfo").child(nsDsig, "X509Data")
+		assert.Equal(base64.StdEncoding.EncodeToString(cert.Raw), x509Data.child(nsDsig, "X509Certificate").text())
+	})
+	t.Run("missing-public-id", func(t *testing.T) {
+		cp := am.Clone()
+		cp.PublicId = ""
+		_, err := cp.Metadata(ctx)
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.InvalidPublicId), err))
+	})
+	t.Run("missing-api-url", func(t *testing.T) {
+		cp := am.Clone()
+		cp.ApiUrl = ""
+		_, err := cp.Metadata(ctx)
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+func init() {
+	globals.RegisterPrefixToResourceInfo(globals.SamlAuthMethodPrefix, resource.AuthMethod, auth.Domain, Subtype)
+	globals.RegisterPrefixToResourceInfo(globals.SamlAccountPrefix, resource.Account, auth.Domain, Subtype)
+	globals.RegisterPrefixToResourceInfo(globals.SamlManagedGroupPrefix, resource.ManagedGroup, auth.Domain, Subtype)
+}
+
+const (
+	Subtype = globals.Subtype("saml")
+
+	// requestPrefix is the prefix of the ids of in-flight authentication
+	// requests. They are never exposed through the API.
+	requestPrefix = "samlreq"
+)
+
+func newAuthMethodId(ctx context.Context) (string, error) {
+	const op = "saml.newAuthMethodId"
+	id, err := db.NewPublicId(ctx, globals.SamlAuthMethodPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
+
+func newAccountId(ctx context.Context, authMethodId, issuer, sub string) (string, error) {
+	const op = "saml.newAccountId"
+	if authMethodId == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	if issuer == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing issuer")
+	}
+	if sub == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing subject")
+	}
+	id, err := db.NewPublicId(ctx, globals.SamlAccountPrefix, db.WithPrngValues([]string{authMethodId, issuer, sub}))
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
+
+func newManagedGroupId(ctx context.Context) (string, error) {
+	const op = "saml.newManagedGroupId"
+	id, err := db.NewPublicId(ctx, globals.SamlManagedGroupPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
+
+func newRequestId(ctx context.Context) (string, error) {
+	const op = "saml.newRequestId"
+	id, err := db.NewPublicId(ctx, requestPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"crypto/x509"
+	"net/url"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/pagination"
+)
+
+// getOpts - iterate the inbound Options and return a struct.
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName                string
+	withDescription         string
+	withLimit               int
+	withApiUrl              *url.URL
+	withIdpEntityId         string
+	withIdpSsoUrl           *url.URL
+	withIdpCertificates     []*x509.Certificate
+	withSpEntityId          string
+	withSignAuthnRequests   bool
+	withNameIdFormat        string
+	withAccountAttributeMap map[string]AccountToAttribute
+	withEmail               string
+	withFullName            string
+	withIssuer              string
+	withUnauthenticatedUser bool
+	withPublicId            string
+	withRoundtripPayload    string
+	withOperationalState    AuthMethodState
+	withReader              db.Reader
+	withStartPageAfterItem  pagination.Item
+}
+
+func getDefaultOptions() options {
+	return options{
+		withOperationalState: InactiveState,
+	}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithLimit provides an option to provide a limit.  Intentionally allowing
+// negative integers.   If WithLimit < 0, then unlimited results are returned.
+// If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithApiUrl provides an optional api URL which is used to build the
+// assertion consumer service URL and the service provider metadata URL.
+func WithApiUrl(u *url.URL) Option {
+	return func(o *options) {
+		o.withApiUrl = u
+	}
+}
+
+// WithIdpEntityId provides an optional entity id of the identity provider.
+func WithIdpEntityId(id string) Option {
+	return func(o *options) {
+		o.withIdpEntityId = id
+	}
+}
+
+// WithIdpSsoUrl provides an optional single sign-on service URL of the
+// identity provider.
+func WithIdpSsoUrl(u *url.URL) Option {
+	return func(o *options) {
+		o.withIdpSsoUrl = u
+	}
+}
+
+// WithIdpCertificates provides optional certificates which are used to verify
+// the signatures of the identity provider.
+func WithIdpCertificates(certs ...*x509.Certificate) Option {
+	return func(o *options) {
+		o.withIdpCertificates = certs
+	}
+}
+
+// WithSpEntityId provides an optional entity id for Boundary as a service
+// provider.
+func WithSpEntityId(id string) Option {
+	return func(o *options) {
+		o.withSpEntityId = id
+	}
+}
+
+// WithSignAuthnRequests provides an option to sign AuthnRequests.
+func WithSignAuthnRequests(sign bool) Option {
+	return func(o *options) {
+		o.withSignAuthnRequests = sign
+	}
+}
+
+// WithNameIdFormat provides an optional NameID format to request from the
+// identity provider.
+func WithNameIdFormat(f string) Option {
+	return func(o *options) {
+		o.withNameIdFormat = f
+	}
+}
+
+// WithAccountAttributeMap provides an option for specifying an Account
+// Attribute map.
+func WithAccountAttributeMap(aam map[string]AccountToAttribute) Option {
+	return func(o *options) {
+		o.withAccountAttributeMap = aam
+	}
+}
+
+// WithEmail provides an optional email address for the account.
+func WithEmail(email string) Option {
+	return func(o *options) {
+		o.withEmail = email
+	}
+}
+
+// WithFullName provides an optional full name for the account.
+func WithFullName(n string) Option {
+	return func(o *options) {
+		o.withFullName = n
+	}
+}
+
+// WithIssuer provides an option for specifying an issuer.
+func WithIssuer(iss string) Option {
+	return func(o *options) {
+		o.withIssuer = iss
+	}
+}
+
+// WithUnauthenticatedUser provides an option for filtering results for
+// an unauthenticated users.
+func WithUnauthenticatedUser(enabled bool) Option {
+	return func(o *options) {
+		o.withUnauthenticatedUser = enabled
+	}
+}
+
+// WithPublicId provides an option for passing a public id to the operation
+func WithPublicId(publicId string) Option {
+	return func(o *options) {
+		o.withPublicId = publicId
+	}
+}
+
+// WithRoundtripPayload provides an option for passing an payload to be
+// roundtripped during an authentication process.
+func WithRoundtripPayload(payload string) Option {
+	return func(o *options) {
+		o.withRoundtripPayload = payload
+	}
+}
+
+// WithOperationalState provides an option for specifying a state.
+func WithOperationalState(state AuthMethodState) Option {
+	return func(o *options) {
+		o.withOperationalState = state
+	}
+}
+
+// WithReader provides an option for specifying a reader to use for the
+// operation.
+func WithReader(reader db.Reader) Option {
+	return func(o *options) {
+		o.withReader = reader
+	}
+}
+
+// WithStartPageAfterItem is used to paginate over the results.
+// The next page will start after the provided item.
+func WithStartPageAfterItem(item pagination.Item) Option {
+	return func(o *options) {
+		o.withStartPageAfterItem = item
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"crypto/x509"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test_GetOpts provides unit tests for GetOpts and all the options
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithName", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithName("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withName = "test"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithDescription", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithDescription("test desc"))
+		testOpts := getDefaultOptions()
+		testOpts.withDescription = "test desc"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithLimit", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithLimit(5))
+		testOpts := getDefaultOptions()
+		testOpts.withLimit = 5
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithApiUrl", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		u, err := url.Parse("https://api.example.com")
+		require.NoError(err)
+		opts := getOpts(WithApiUrl(u))
+		testOpts := getDefaultOptions()
+		testOpts.withApiUrl = u
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithIdpEntityId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithIdpEntityId("https://idp.example.com"))
+		testOpts := getDefaultOptions()
+		testOpts.withIdpEntityId = "https://idp.example.com"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithIdpSsoUrl", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		u, err := url.Parse("https://idp.example.com/sso")
+		require.NoError(err)
+		opts := getOpts(WithIdpSsoUrl(u))
+		testOpts := getDefaultOptions()
+		testOpts.withIdpSsoUrl = u
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithIdpCertificates", func(t *testing.T) {
+		assert := assert.New(t)
+		cert := NewTestIdp(t).Certificate()
+		opts := getOpts(WithIdpCertificates(cert))
+		testOpts := getDefaultOptions()
+		testOpts.withIdpCertificates = []*x509.Certificate{cert}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithSpEntityId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithSpEntityId("boundary"))
+		testOpts := getDefaultOptions()
+		testOpts.withSpEntityId = "boundary"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithSignAuthnRequests", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithSignAuthnRequests(true))
+		testOpts := getDefaultOptions()
+		testOpts.withSignAuthnRequests = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithNameIdFormat", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithNameIdFormat("urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"))
+		testOpts := getDefaultOptions()
+		testOpts.withNameIdFormat = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithAccountAttributeMap", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithAccountAttributeMap(map[string]AccountToAttribute{"mail": ToEmailAttribute}))
+		testOpts := getDefaultOptions()
+		testOpts.withAccountAttributeMap = map[string]AccountToAttribute{"mail": ToEmailAttribute}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithIssuer", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithIssuer("https://idp.example.com"))
+		testOpts := getDefaultOptions()
+		testOpts.withIssuer = "https://idp.example.com"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithRoundtripPayload", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithRoundtripPayload("payload"))
+		testOpts := getDefaultOptions()
+		testOpts.withRoundtripPayload = "payload"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithOperationalState", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts()
+		assert.Equal(InactiveState, opts.withOperationalState)
+		opts = getOpts(WithOperationalState(ActivePublicState))
+		testOpts := getDefaultOptions()
+		testOpts.withOperationalState = ActivePublicState
+		assert.Equal(opts, testOpts)
+	})
+}
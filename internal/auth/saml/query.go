@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+const (
+	acctUpsertQuery = `
+	insert into auth_saml_account
+			(%s)
+	values
+			(%s)
+	on conflict on constraint
+			auth_saml_account_auth_method_id_issuer_subject_uq
+	do update set
+			%s
+	returning public_id, version
+       `
+
+	deleteExpiredRequestsQuery = `
+	delete from auth_saml_request
+	 where expiration_time <= current_timestamp
+	`
+
+	estimateCountAccounts = `
+	select sum(reltuples::bigint) as estimate from pg_class where oid in ('auth_saml_account'::regclass)
+	`
+	estimateCountManagedGroups = `
+	select sum(reltuples::bigint) as estimate from pg_class where oid in ('auth_saml_managed_group'::regclass)
+	`
+)
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth"
+)
+
+func init() {
+	auth.RegisterAuthMethodSubtype("saml", &authMethodHooks{})
+}
+
+type authMethodHooks struct{}
+
+// NewAuthMethod creates a new saml auth method from the result
+func (authMethodHooks) NewAuthMethod(ctx context.Context, result *auth.AuthMethodListQueryResult) (auth.AuthMethod, error) {
+	delimiter := "|"
+
+	am := AllocAuthMethod()
+	am.PublicId = result.PublicId
+	am.ScopeId = result.ScopeId
+	am.IsPrimaryAuthMethod = result.IsPrimaryAuthMethod
+	am.Name = result.Name
+	am.Description = result.Description
+	am.CreateTime = result.CreateTime
+	am.UpdateTime = result.UpdateTime
+	am.Version = result.Version
+	am.OperationalState = result.State
+	am.ApiUrl = result.ApiUrl
+	am.KeyId = result.KeyId
+	am.IdpEntityId = result.IdpEntityId
+	am.IdpSsoUrl = result.IdpSsoUrl
+	am.IdpCertificates = result.IdpCertificates
+	am.SpEntityId = result.SpEntityId
+	am.SpCertificate = result.SpCertificate
+	am.SignAuthnRequests = result.SignAuthnRequests
+	am.NameIdFormat = result.NameIdFormat
+	if result.AccountAttributeMaps != "" {
+		am.AccountAttributeMaps = strings.Split(result.AccountAttributeMaps, delimiter)
+	}
+
+	return &am, nil
+}
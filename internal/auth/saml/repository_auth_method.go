@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+// Account must implement oplog.Replayable for upsertAccount to work
+var _ oplog.ReplayableMessage = (*Account)(nil)
+
+// Account must implement proto.Message for upsertAccount to work
+var _ proto.Message = (*Account)(nil)
+
+// upsertAccount will create/update account using the subject and attributes
+// of the identity provider's assertion.
+func (r *Repository) upsertAccount(ctx context.Context, am *AuthMethod, a *assertion) (*Account, error) {
+	const op = "saml.(Repository).upsertAccount"
+	if am == nil || am.AuthMethod == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if a == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing assertion")
+	}
+
+	fromSub, fromName, fromEmail := "", string(ToNameAttribute), string(ToEmailAttribute)
+	if len(am.AccountAttributeMaps) > 0 {
+		aams, err := ParseAccountAttributeMaps(ctx, am.AccountAttributeMaps...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for _, m := range aams {
+			toAttr, err := ConvertToAccountToAttribute(ctx, m.To)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			switch toAttr {
+			case ToSubAttribute:
+				fromSub = m.From
+			case ToEmailAttribute:
+				fromEmail = m.From
+			case ToNameAttribute:
+				fromName = m.From
+			default:
+				// should never happen, but including it just in case.
+				return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s=%s is not a valid account attribute map", m.From, m.To))
+			}
+		}
+	}
+
+	iss := a.issuer
+	sub := a.nameId
+	if fromSub != "" {
+		if len(a.attributes[fromSub]) == 0 {
+			return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("mapping attribute %s to account subject and it is not present in the assertion", fromSub))
+		}
+		sub = a.attributes[fromSub][0]
+	}
+	if iss == "" {
+		return nil, errors.New(ctx, errors.Unknown, op, "issuer is not present in the assertion")
+	}
+	if sub == "" {
+		return nil, errors.New(ctx, errors.Unknown, op, "subject is not present in the assertion")
+	}
+	pubId, err := newAccountId(ctx, am.GetPublicId(), iss, sub)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	columns := []string{"public_id", "auth_method_id", "issuer", "subject"}
+	values := []any{
+		sql.Named("1", pubId),
+		sql.Named("2", am.PublicId),
+		sql.Named("3", iss),
+		sql.Named("4", sub),
+	}
+	var conflictClauses, fieldMasks, nullMasks []string
+
+	marshaledAttributes, err := json.Marshal(a.attributes)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encode))
+	}
+	columns, values = append(columns, "attributes"), append(values, sql.Named(fmt.Sprintf("%d", len(values)+1), string(marshaledAttributes)))
+	conflictClauses = append(conflictClauses, fmt.Sprintf("attributes = @%d", len(values)))
+	fieldMasks = append(fieldMasks, AttributesField)
+
+	var foundName, foundEmail string
+	if v := a.attributes[fromName]; len(v) > 0 {
+		foundName = v[0]
+	}
+	if foundName != "" {
+		columns, values = append(columns, "full_name"), append(values, sql.Named(fmt.Sprintf("%d", len(values)+1), foundName))
+		conflictClauses = append(conflictClauses, fmt.Sprintf("full_name = @%d", len(values)))
+		fieldMasks = append(fieldMasks, "FullName")
+	} else {
+		conflictClauses = append(conflictClauses, "full_name = NULL")
+		nullMasks = append(nullMasks, "FullName")
+	}
+	if v := a.attributes[fromEmail]; len(v) > 0 {
+		foundEmail = v[0]
+	}
+	if foundEmail != "" {
+		columns, values = append(columns, "email"), append(values, sql.Named(fmt.Sprintf("%d", len(values)+1), foundEmail))
+		conflictClauses = append(conflictClauses, fmt.Sprintf("email = @%d", len(values)))
+		fieldMasks = append(fieldMasks, "Email")
+	} else {
+		conflictClauses = append(conflictClauses, "email = NULL")
+		nullMasks = append(nullMasks, "Email")
+	}
+
+	placeHolders := make([]string, 0, len(columns))
+	for colNum := range columns {
+		placeHolders = append(placeHolders, fmt.Sprintf("@%d", colNum+1))
+	}
+	query := fmt.Sprintf(acctUpsertQuery, strings.Join(columns, ", "), strings.Join(placeHolders, ", "), strings.Join(conflictClauses, ", "))
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	updatedAcct := AllocAccount()
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			rows, err := w.Query(ctx, query, values)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to insert/update auth saml account"))
+			}
+			defer rows.Close()
+			result := struct {
+				PublicId string
+				Version  int
+			}{}
+			var rowCnt int
+			for rows.Next() {
+				rowCnt += 1
+				if err := r.reader.ScanRows(ctx, rows, &result); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to scan rows for account"))
+				}
+			}
+			if err := rows.Err(); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get next rows for account"))
+			}
+			if rowCnt > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("expected 1 row but got: %d", rowCnt))
+			}
+			if err := reader.LookupWhere(ctx, &updatedAcct, "auth_method_id = ? and issuer = ? and subject = ?", []any{am.PublicId, iss, sub}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to look up auth saml account for: %s / %s / %s", am.PublicId, iss, sub)))
+			}
+			// include the version incase of predictable account public ids based on a calculation using authmethod id and subject
+			if result.Version == 1 && updatedAcct.PublicId == pubId {
+				if err := upsertOplog(ctx, w, oplogWrapper, oplog.OpType_OP_TYPE_CREATE, am.ScopeId, updatedAcct, nil, nil); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write create oplog for account"))
+				}
+				return nil
+			}
+			acctForOplog := AllocAccount()
+			acctForOplog.PublicId = updatedAcct.PublicId
+			acctForOplog.Attributes = string(marshaledAttributes)
+			acctForOplog.Email = foundEmail
+			acctForOplog.FullName = foundName
+			if err := upsertOplog(ctx, w, oplogWrapper, oplog.OpType_OP_TYPE_UPDATE, am.ScopeId, acctForOplog, fieldMasks, nullMasks); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write update oplog for account"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return updatedAcct, nil
+}
+
+// upsertOplog will write oplog msgs for account upserts. The db.Writer needs to be the writer for the current
+// transaction that's executing the upsert. Both fieldMasks and nullMasks are allowed to be nil for update operations.
+func upsertOplog(ctx context.Context, w db.Writer, oplogWrapper wrapping.Wrapper, operation oplog.OpType, scopeId string, acct *Account, fieldMasks, nullMasks []string) error {
+	const op = "saml.upsertOplog"
+	if w == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing db writer")
+	}
+	if oplogWrapper == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing oplog wrapper")
+	}
+	if operation != oplog.OpType_OP_TYPE_CREATE && operation != oplog.OpType_OP_TYPE_UPDATE {
+		return errors.New(ctx, errors.Internal, op, fmt.Sprintf("not a supported operation: %s", operation))
+	}
+	if scopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	if acct == nil || acct.Account == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing account")
+	}
+	if operation == oplog.OpType_OP_TYPE_UPDATE && len(fieldMasks) == 0 && len(nullMasks) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "update operations must specify field masks and/or null masks")
+	}
+	ticket, err := w.GetTicket(ctx, acct)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+	}
+	msg := oplog.Message{
+		Message:        acct,
+		TypeName:       acct.TableName(),
+		OpType:         operation,
+		FieldMaskPaths: fieldMasks,
+		SetToNullPaths: nullMasks,
+	}
+	if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, acct.oplog(operation, scopeId), []*oplog.Message{&msg}); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-dbw"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+)
+
+const (
+	OperationalStateField     = "OperationalState"
+	VersionField              = "Version"
+	NameField                 = "Name"
+	DescriptionField          = "Description"
+	FilterField               = "Filter"
+	ApiUrlField               = "ApiUrl"
+	IdpEntityIdField          = "IdpEntityId"
+	IdpSsoUrlField            = "IdpSsoUrl"
+	IdpCertificatesField      = "IdpCertificates"
+	SpEntityIdField           = "SpEntityId"
+	SignAuthnRequestsField    = "SignAuthnRequests"
+	NameIdFormatField         = "NameIdFormat"
+	AccountAttributeMapsField = "AccountAttributeMaps"
+	AttributesField           = "Attributes"
+	CtSpPrivateKeyField       = "CtSpPrivateKey"
+	KeyIdField                = "KeyId"
+)
+
+// UpdateAuthMethod will retrieve the auth method from the repository,
+// and update it based on the field masks provided.
+//
+// The auth method will not be persisted in the repository if the auth
+// method's OperationalStatus is currently ActivePublic or ActivePrivate
+// and the update would have resulted in an incomplete/non-operational
+// auth method.
+//
+// fieldMaskPaths provides field_mask.proto paths for fields that should
+// be updated.  Fields will be set to NULL if the field is a
+// zero value and included in fieldMask. Name, Description, ApiUrl,
+// IdpEntityId, IdpSsoUrl, IdpCertificates, SpEntityId, SignAuthnRequests and
+// NameIdFormat are all updatable fields.  The AuthMethod's Value Objects of
+// AccountAttributeMaps are also updatable, with the exception of the map to
+// the "sub" attribute. If no updatable fields are included in the
+// fieldMaskPaths, then an error is returned.
+//
+// No options are currently supported.
+func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, version uint32, fieldMaskPaths []string, _ ...Option) (*AuthMethod, int, error) {
+	const op = "saml.(Repository).UpdateAuthMethod"
+	if am == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if am.AuthMethod == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing auth method store")
+	}
+	if am.PublicId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+
+	if err := validateFieldMask(ctx, fieldMaskPaths); err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
+	dbMask, nullFields := dbw.BuildUpdatePaths(
+		map[string]any{
+			NameField:                 am.Name,
+			DescriptionField:          am.Description,
+			ApiUrlField:               am.ApiUrl,
+			IdpEntityIdField:          am.IdpEntityId,
+			IdpSsoUrlField:            am.IdpSsoUrl,
+			IdpCertificatesField:      am.IdpCertificates,
+			SpEntityIdField:           am.SpEntityId,
+			SignAuthnRequestsField:    am.SignAuthnRequests,
+			NameIdFormatField:         am.NameIdFormat,
+			AccountAttributeMapsField: am.AccountAttributeMaps,
+		},
+		fieldMaskPaths,
+		[]string{SignAuthnRequestsField},
+	)
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+	}
+
+	origAm, err := r.lookupAuthMethod(ctx, am.PublicId)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if origAm == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s", am.PublicId))
+	}
+	// there's no reason to continue if another controller has already updated this auth method.
+	if origAm.Version != version {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.VersionMismatch, op, fmt.Sprintf("update version %d doesn't match db version %d", version, origAm.Version))
+	}
+
+	updated := applyUpdate(am, origAm, fieldMaskPaths)
+	if err := updated.validate(ctx, op); err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	// prevent an "active" auth method from being updated in a manner that would create
+	// an incomplete and unusable auth method.
+	if origAm.OperationalState != string(InactiveState) {
+		if err := updated.isComplete(ctx); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("update would result in an incomplete auth method"))
+		}
+	}
+
+	addMaps, deleteMaps, err := attributeMapChanges(ctx, origAm.PublicId, am.AccountAttributeMaps, origAm.AccountAttributeMaps, dbMask, nullFields)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
+	// we don't allow updates for "sub" attribute maps, because we have no way
+	// to determine if the updated "from" attribute in the map might create
+	// collisions with any existing account's subject.
+	for _, rawM := range append(addMaps, deleteMaps...) {
+		m := rawM.(*AccountAttributeMap)
+		if m.ToAttribute == string(ToSubAttribute) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("you cannot update account attribute map %s=%s for the \"sub\" attribute", m.FromAttribute, m.ToAttribute))
+		}
+	}
+
+	var filteredDbMask, filteredNullFields []string
+	for _, f := range dbMask {
+		if f != AccountAttributeMapsField {
+			filteredDbMask = append(filteredDbMask, f)
+		}
+	}
+	for _, f := range nullFields {
+		if f != AccountAttributeMapsField {
+			filteredNullFields = append(filteredNullFields, f)
+		}
+	}
+
+	// handle no changes...
+	if len(filteredDbMask) == 0 &&
+		len(filteredNullFields) == 0 &&
+		len(addMaps) == 0 &&
+		len(deleteMaps) == 0 {
+		return origAm, db.NoRowsAffected, nil
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, origAm.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var updatedAm *AuthMethod
+	var rowsUpdated int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, 3) // AuthMethod, AccountAttributeMaps*2
+			ticket, err := w.GetTicket(ctx, am)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+			var authMethodOplogMsg oplog.Message
+			updatedAm = am.Clone()
+			switch {
+			case len(filteredDbMask) == 0 && len(filteredNullFields) == 0:
+				// the auth method's fields are not being updated, just it's value objects, so we need to just update the auth
+				// method's version.
+				updatedAm.Version = uint32(version) + 1
+				rowsUpdated, err = w.Update(ctx, updatedAm, []string{VersionField}, nil, db.NewOplogMsg(&authMethodOplogMsg), db.WithVersion(&version))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update auth method version"))
+				}
+			default:
+				rowsUpdated, err = w.Update(ctx, updatedAm, filteredDbMask, filteredNullFields, db.NewOplogMsg(&authMethodOplogMsg), db.WithVersion(&version))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update auth method"))
+				}
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated auth method and %d rows updated", rowsUpdated))
+			}
+			msgs = append(msgs, &authMethodOplogMsg)
+
+			if len(deleteMaps) > 0 {
+				deleteMapsOplogMsgs := make([]*oplog.Message, 0, len(deleteMaps))
+				rowsDeleted, err := w.DeleteItems(ctx, deleteMaps, db.NewOplogMsgs(&deleteMapsOplogMsgs))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete account attribute maps"))
+				}
+				if rowsDeleted != len(deleteMaps) {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("account attribute maps deleted %d did not match request for %d", rowsDeleted, len(deleteMaps)))
+				}
+				msgs = append(msgs, deleteMapsOplogMsgs...)
+			}
+			if len(addMaps) > 0 {
+				addMapsOplogMsgs := make([]*oplog.Message, 0, len(addMaps))
+				if err := w.CreateItems(ctx, addMaps, db.NewOplogMsgs(&addMapsOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add account attribute maps"))
+				}
+				msgs = append(msgs, addMapsOplogMsgs...)
+			}
+
+			metadata := updatedAm.oplog(oplog.OpType_OP_TYPE_UPDATE)
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+			// we need a new repo, that's using the same reader/writer as this TxHandler
+			txRepo := &Repository{
+				reader: reader,
+				writer: w,
+				kms:    r.kms,
+			}
+			updatedAm, err = txRepo.lookupAuthMethod(ctx, updatedAm.PublicId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup auth method after update"))
+			}
+			if updatedAm == nil {
+				return errors.New(ctx, errors.RecordNotFound, op, "unable to lookup auth method after update")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	return updatedAm, rowsUpdated, nil
+}
+
+// attributeMapChanges takes the new and old list of account attribute maps
+// and using the dbMasks/nullFields it will return lists of
+// AccountAttributeMaps which need to be added and deleted in order to
+// reconcile the auth method's account attribute maps.
+func attributeMapChanges(ctx context.Context, publicId string, newMaps, oldMaps, dbMask, nullFields []string) (add []any, del []any, e error) {
+	const op = "saml.attributeMapChanges"
+	if publicId == "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	if !strutil.StrListContains(dbMask, AccountAttributeMapsField) && !strutil.StrListContains(nullFields, AccountAttributeMapsField) {
+		return nil, nil, nil
+	}
+	if len(strutil.RemoveDuplicates(newMaps, false)) != len(newMaps) {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "duplicate new account attribute maps")
+	}
+
+	toAttributeMap := func(s string) (any, error) {
+		m, err := ParseAccountAttributeMaps(ctx, s)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if len(m) != 1 {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to parse account attribute map %s", s))
+		}
+		to, err := ConvertToAccountToAttribute(ctx, m[0].To)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return NewAccountAttributeMap(ctx, publicId, m[0].From, to)
+	}
+
+	found := map[string]bool{}
+	for _, m := range oldMaps {
+		found[m] = true
+	}
+	var adds, deletes []any
+	if strutil.StrListContains(dbMask, AccountAttributeMapsField) {
+		for _, m := range newMaps {
+			if found[m] {
+				delete(found, m)
+				continue
+			}
+			obj, err := toAttributeMap(m)
+			if err != nil {
+				return nil, nil, err
+			}
+			adds = append(adds, obj)
+		}
+	}
+	for m := range found {
+		obj, err := toAttributeMap(m)
+		if err != nil {
+			return nil, nil, err
+		}
+		deletes = append(deletes, obj)
+	}
+	return adds, deletes, nil
+}
+
+// validateFieldMask check the field mask to ensure all the fields are updatable
+func validateFieldMask(ctx context.Context, fieldMaskPaths []string) error {
+	const op = "saml.validateFieldMask"
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(NameField, f):
+		case strings.EqualFold(DescriptionField, f):
+		case strings.EqualFold(ApiUrlField, f):
+		case strings.EqualFold(IdpEntityIdField, f):
+		case strings.EqualFold(IdpSsoUrlField, f):
+		case strings.EqualFold(IdpCertificatesField, f):
+		case strings.EqualFold(SpEntityIdField, f):
+		case strings.EqualFold(SignAuthnRequestsField, f):
+		case strings.EqualFold(NameIdFormatField, f):
+		case strings.EqualFold(AccountAttributeMapsField, f):
+		default:
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid field mask: %s", f))
+		}
+	}
+	return nil
+}
+
+// applyUpdate takes the new and applies it to the orig using the field masks
+func applyUpdate(new, orig *AuthMethod, fieldMaskPaths []string) *AuthMethod {
+	cp := orig.Clone()
+	for _, f := range fieldMaskPaths {
+		switch f {
+		case NameField:
+			cp.Name = new.Name
+		case DescriptionField:
+			cp.Description = new.Description
+		case ApiUrlField:
+			cp.ApiUrl = new.ApiUrl
+		case IdpEntityIdField:
+			cp.IdpEntityId = new.IdpEntityId
+		case IdpSsoUrlField:
+			cp.IdpSsoUrl = new.IdpSsoUrl
+		case IdpCertificatesField:
+			cp.IdpCertificates = new.IdpCertificates
+		case SpEntityIdField:
+			cp.SpEntityId = new.SpEntityId
+		case SignAuthnRequestsField:
+			cp.SignAuthnRequests = new.SignAuthnRequests
+		case NameIdFormatField:
+			cp.NameIdFormat = new.NameIdFormat
+		case AccountAttributeMapsField:
+			switch {
+			case len(new.AccountAttributeMaps) == 0:
+				cp.AccountAttributeMaps = nil
+			default:
+				cp.AccountAttributeMaps = make([]string, 0, len(new.AccountAttributeMaps))
+				cp.AccountAttributeMaps = append(cp.AccountAttributeMaps, new.AccountAttributeMaps...)
+			}
+		}
+	}
+	return cp
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// createRequest inserts the in-flight authentication request.  Expired
+// requests of every auth method are deleted in the same transaction, since
+// they can no longer be completed.
+func (r *Repository) createRequest(ctx context.Context, req *Request) (*Request, error) {
+	const op = "saml.(Repository).createRequest"
+	if req == nil || req.Request == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing request")
+	}
+	if err := req.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped.
+	}
+	var created *Request
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if _, err := w.Exec(ctx, deleteExpiredRequestsQuery, nil); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete expired requests"))
+			}
+			created = req.Clone()
+			if err := w.Create(ctx, created); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return created, nil
+}
+
+// lookupRequest returns the request of the auth method by the id of the
+// request.  If it's not found, it will return nil, nil.
+func (r *Repository) lookupRequest(ctx context.Context, authMethodId, requestId string) (*Request, error) {
+	const op = "saml.(Repository).lookupRequest"
+	switch {
+	case authMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case requestId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing request id")
+	}
+	req := allocRequest()
+	if err := r.reader.LookupWhere(ctx, req, "public_id = ? and auth_method_id = ?", []any{requestId, authMethodId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return req, nil
+}
+
+// lookupRequestByTokenId returns the request of the auth method by its token
+// id.  If it's not found, it will return nil, nil.
+func (r *Repository) lookupRequestByTokenId(ctx context.Context, authMethodId, tokenId string) (*Request, error) {
+	const op = "saml.(Repository).lookupRequestByTokenId"
+	switch {
+	case authMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case tokenId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing token id")
+	}
+	req := allocRequest()
+	if err := r.reader.LookupWhere(ctx, req, "token_id = ? and auth_method_id = ?", []any{tokenId, authMethodId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return req, nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/auth/saml/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultRequestTableName defines the default table name for a Request
+const defaultRequestTableName = "auth_saml_request"
+
+// Request is an in-flight authentication attempt with a SAML AuthMethod.  Its
+// PublicId is sent to the identity provider as the ID of the AuthnRequest and
+// as the RelayState, so the response of the identity provider can be matched
+// to the attempt.  The TokenId is returned to the client which started the
+// attempt and is used to poll for the auth token with the TokenRequestId.
+type Request struct {
+	*store.Request
+	tableName string
+}
+
+// validate the Request.  On success, it will return nil.
+func (r *Request) validate(ctx context.Context, caller errors.Op) error {
+	if r.PublicId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing public id")
+	}
+	if r.AuthMethodId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing auth method id")
+	}
+	if r.TokenRequestId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing token request id")
+	}
+	if r.TokenId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing token id")
+	}
+	if r.FinalRedirectUrl == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing final redirect url")
+	}
+	if r.ExpirationTime == nil {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing expiration time")
+	}
+	return nil
+}
+
+// allocRequest makes an empty one in memory
+func allocRequest() *Request {
+	return &Request{
+		Request: &store.Request{},
+	}
+}
+
+// Clone a Request.
+func (r *Request) Clone() *Request {
+	cp := proto.Clone(r.Request)
+	return &Request{
+		Request: cp.(*store.Request),
+	}
+}
+
+// TableName returns the table name.
+func (r *Request) TableName() string {
+	if r.tableName != "" {
+		return r.tableName
+	}
+	return defaultRequestTableName
+}
+
+// SetTableName sets the table name.
+func (r *Request) SetTableName(n string) {
+	r.tableName = n
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// assertion is the validated content of an identity provider's assertion.
+type assertion struct {
+	issuer     string
+	nameId     string
+	attributes map[string][]string
+}
+
+// validateResponse validates the identity provider's response to the
+// request and returns the content of its assertion.
+//
+// Either the response or its assertion must be signed by one of the
+// AuthMethod's identity provider certificates, and only the signed element
+// is used.  Encrypted assertions are not supported.  The response must be
+// successful, must be in response to the request and must be addressed to
+// the assertion consumer service of the AuthMethod.  The assertion must be
+// issued by the identity provider, must have a bearer subject confirmation
+// for the request and the assertion consumer service, must be restricted to
+// the service provider's audience and must be valid at now.
+func (am *AuthMethod) validateResponse(ctx context.Context, req *Request, doc []byte, now time.Time) (*assertion, error) {
+	const op = "saml.(AuthMethod).validateResponse"
+	if req == nil || req.Request == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing request")
+	}
+	certs, err := ParseCertificates(ctx, am.IdpCertificates)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse idp certificates"))
+	}
+	resp, err := parseXml(ctx, doc)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if !resp.is(nsProtocol, "Response") {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "not a saml response")
+	}
+	if resp.attr("Version") != "2.0" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "unsupported saml version")
+	}
+	if dest := resp.attr("Destination"); dest != "" && dest != am.AcsUrl() {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("response destination %q is not the assertion consumer service", dest))
+	}
+	if resp.attr("InResponseTo") != req.PublicId {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "response is not in response to the request")
+	}
+	if iss := resp.child(nsAssert, "Issuer"); iss != nil && strings.TrimSpace(iss.text()) != am.IdpEntityId {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("response issuer %q is not the identity provider", strings.TrimSpace(iss.text())))
+	}
+	status := resp.child(nsProtocol, "Status")
+	if status == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing response status")
+	}
+	if code := status.child(nsProtocol, "StatusCode"); code == nil || code.attr("Value") != statusSuccess {
+		var msg string
+		if code != nil {
+			msg = code.attr("Value")
+		}
+		if m := status.child(nsProtocol, "StatusMessage"); m != nil {
+			msg = fmt.Sprintf("%s: %s", msg, strings.TrimSpace(m.text()))
+		}
+		return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("identity provider did not authenticate the user: %s", msg))
+	}
+	if resp.child(nsAssert, "EncryptedAssertion") != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "encrypted assertions are not supported")
+	}
+	assertions := resp.childrenNamed(nsAssert, "Assertion")
+	if len(assertions) != 1 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("response must contain exactly one assertion and it contains %d", len(assertions)))
+	}
+	var a *xmlElement
+
+	// A signed response covers its assertion, otherwise the assertion itself
+	// must be signed.  Only the content covered by the signature is used.
+	if resp.child(nsDsig, "Signature") != nil {
+		if resp, err = verifySignature(ctx, doc, false, certs); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("invalid response signature"))
+		}
+		a = resp.child(nsAssert, "Assertion")
+	} else if a, err = verifySignature(ctx, doc, true, certs); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("invalid assertion signature"))
+	}
+
+	if iss := strings.TrimSpace(a.child(nsAssert, "Issuer").text()); iss != am.IdpEntityId {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("assertion issuer %q is not the identity provider", iss))
+	}
+
+	subject := a.child(nsAssert, "Subject")
+	if subject == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing assertion subject")
+	}
+	var confirmed bool
+	for _, sc := range subject.childrenNamed(nsAssert, "SubjectConfirmation") {
+		if sc.attr("Method") != subjectConfirmationBearer {
+			continue
+		}
+		data := sc.child(nsAssert, "SubjectConfirmationData")
+		if data == nil {
+			continue
+		}
+		if data.attr("Recipient") != am.AcsUrl() {
+			continue
+		}
+		if data.attr("InResponseTo") != req.PublicId {
+			continue
+		}
+		notBefore, err := parseInstant(ctx, data.attr("NotBefore"))
+		if err != nil || (!notBefore.IsZero() && now.Add(clockSkew).Before(notBefore)) {
+			continue
+		}
+		notOnOrAfter, err := parseInstant(ctx, data.attr("NotOnOrAfter"))
+		if err != nil || notOnOrAfter.IsZero() || !now.Before(notOnOrAfter.Add(clockSkew)) {
+			continue
+		}
+		confirmed = true
+		break
+	}
+	if !confirmed {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "assertion subject could not be confirmed")
+	}
+
+	conditions := a.child(nsAssert, "Conditions")
+	if conditions == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing assertion conditions")
+	}
+	notBefore, err := parseInstant(ctx, conditions.attr("NotBefore"))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if !notBefore.IsZero() && now.Add(clockSkew).Before(notBefore) {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "assertion is not yet valid")
+	}
+	notOnOrAfter, err := parseInstant(ctx, conditions.attr("NotOnOrAfter"))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if notOnOrAfter.IsZero() {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "assertion conditions must have an expiration")
+	}
+	if !now.Before(notOnOrAfter.Add(clockSkew)) {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "assertion has expired")
+	}
+	// Every audience restriction must include the service provider and at
+	// least one is required.
+	restrictions := conditions.childrenNamed(nsAssert, "AudienceRestriction")
+	if len(restrictions) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "assertion has no audience restriction")
+	}
+	for _, ar := range restrictions {
+		var found bool
+		for _, aud := range ar.childrenNamed(nsAssert, "Audience") {
+			if strings.TrimSpace(aud.text()) == am.EntityId() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "assertion is not intended for this service provider")
+		}
+	}
+
+	result := &assertion{
+		issuer:     am.IdpEntityId,
+		nameId:     strings.TrimSpace(subject.child(nsAssert, "NameID").text()),
+		attributes: map[string][]string{},
+	}
+	for _, stmt := range a.childrenNamed(nsAssert, "AttributeStatement") {
+		for _, attr := range stmt.childrenNamed(nsAssert, "Attribute") {
+			name := attr.attr("Name")
+			if name == "" {
+				continue
+			}
+			for _, v := range attr.childrenNamed(nsAssert, "AttributeValue") {
+				result.attributes[name] = append(result.attributes[name], strings.TrimSpace(v.text()))
+			}
+		}
+	}
+	return result, nil
+}
+
+// parseInstant parses a SAML dateTime.  An empty value returns the zero time.
+func parseInstant(ctx context.Context, s string) (time.Time, error) {
+	const op = "saml.parseInstant"
+	if s == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid instant %q", s), errors.WithWrap(err))
+	}
+	return t, nil
+}
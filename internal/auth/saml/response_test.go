@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+	"encoding/base64"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/saml/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testResponseAuthMethod(t *testing.T, idp *TestIdp) *AuthMethod {
+	t.Helper()
+	ctx := context.Background()
+	apiUrl, err := url.Parse("https://api.example.com")
+	require.NoError(t, err)
+	ssoUrl, err := url.Parse(idp.SsoUrl())
+	require.NoError(t, err)
+	am, err := NewAuthMethod(ctx, "o_1234567890",
+		WithApiUrl(apiUrl),
+		WithIdpEntityId(idp.EntityId()),
+		WithIdpSsoUrl(ssoUrl),
+		WithIdpCertificates(idp.Certificate()),
+		WithOperationalState(ActivePublicState),
+	)
+	require.NoError(t, err)
+	am.PublicId = "amsaml_1234567890"
+	return am
+}
+
+func TestAuthMethod_validateResponse(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	idp := NewTestIdp(t)
+	am := testResponseAuthMethod(t, idp)
+	req := &Request{Request: &store.Request{PublicId: "samlreq_1234567890", AuthMethodId: am.PublicId}}
+
+	decode := func(t *testing.T, s string) string {
+		t.Helper()
+		doc, err := decodeSamlResponse(ctx, s)
+		require.NoError(t, err)
+		return string(doc)
+	}
+
+	tests := []struct {
+		name            string
+		am              *AuthMethod
+		req             *Request
+		doc             func(t *testing.T) string
+		now             time.Time
+		wantErrMatch    *errors.Template
+		wantErrContains string
+	}{
+		{
+			name: "valid",
+			doc: func(t *testing.T) string {
+				return decode(t, idp.Response(am, req.PublicId, "alice", map[string][]string{"email": {"alice@example.com"}, "groups": {"eng", "ops"}}))
+			},
+		},
+		{
+			name: "missing-request",
+			req:  &Request{},
+			doc: func(t *testing.T) string {
+				return decode(t, idp.Response(am, req.PublicId, "alice", nil))
+			},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing request",
+		},
+		{
+			name: "not-a-response",
+			doc: func(t *testing.T) string {
+				return `<samlp:AuthnRequest xmlns:samlp="` + nsProtocol + `"/>`
+			},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "not a saml response",
+		},
+		{
+			name: "wrong-request",
+			doc: func(t *testing.T) string {
+				return decode(t, idp.Response(am, "samlreq_other", "alice", nil))
+			},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "not in response to the request",
+		},
+		{
+			name: "tampered-assertion",
+			doc: func(t *testing.T) string {
+				return strings.Replace(decode(t, idp.Response(am, req.PublicId, "alice", nil)), ">alice<", ">mallory<", 1)
+			},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "invalid assertion signature",
+		},
+		{
+			name: "unsigned",
+			doc: func(t *testing.T) string {
+				doc := decode(t, idp.Response(am, req.PublicId, "alice", nil))
+				start := strings.Index(doc, "<ds:Signature")
+				end := strings.Index(doc, "</ds:Signature>") + len("</ds:Signature>")
+				return doc[:start] + doc[end:]
+			},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "invalid assertion signature",
+		},
+		{
+			name: "other-idp",
+			doc: func(t *testing.T) string {
+				other := NewTestIdp(t)
+				return decode(t, other.Response(am, req.PublicId, "alice", nil))
+			},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "invalid assertion signature",
+		},
+		{
+			name: "expired",
+			doc: func(t *testing.T) string {
+				return decode(t, idp.Response(am, req.PublicId, "alice", nil))
+			},
+			now:             time.Now().Add(time.Hour),
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "assertion subject could not be confirmed",
+		},
+		{
+			name: "not-yet-valid",
+			doc: func(t *testing.T) string {
+				return decode(t, idp.Response(am, req.PublicId, "alice", nil))
+			},
+			now:             time.Now().Add(-time.Hour),
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "assertion is not yet valid",
+		},
+		{
+			name: "wrong-audience",
+			am: func() *AuthMethod {
+				cp := am.Clone()
+				cp.SpEntityId = "https://other.example.com"
+				return cp
+			}(),
+			doc: func(t *testing.T) string {
+				return decode(t, idp.Response(am, req.PublicId, "alice", nil))
+			},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "not intended for this service provider",
+		},
+		{
+			name: "signature-references-other-element",
+			doc: func(t *testing.T) string {
+				return strings.Replace(decode(t, idp.Response(am, req.PublicId, "alice", nil)), `ID="_assertion-`, `ID="_other-`, 1)
+			},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "invalid assertion signature",
+		},
+		{
+			name: "missing-conditions",
+			doc: func(t *testing.T) string {
+				return decode(t, idp.response(am, req.PublicId, "alice", nil, func(a string) string {
+					return a[:strings.Index(a, "<saml:Conditions")] + a[strings.Index(a, "</saml:Conditions>")+len("</saml:Conditions>"):]
+				}))
+			},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing assertion conditions",
+		},
+		{
+			name: "missing-audience-restriction",
+			doc: func(t *testing.T) string {
+				return decode(t, idp.response(am, req.PublicId, "alice", nil, func(a string) string {
+					return a[:strings.Index(a, "<saml:AudienceRestriction>")] + a[strings.Index(a, "</saml:AudienceRestriction>")+len("</saml:AudienceRestriction>"):]
+				}))
+			},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "assertion has no audience restriction",
+		},
+		{
+			name: "conditions-without-expiration",
+			doc: func(t *testing.T) string {
+				return decode(t, idp.response(am, req.PublicId, "alice", nil, func(a string) string {
+					i := strings.Index(a, "<saml:Conditions")
+					return a[:i] + regexp.MustCompile(` NotOnOrAfter="[^"]*"`).ReplaceAllString(a[i:], "")
+				}))
+			},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "assertion conditions must have an expiration",
+		},
+		{
+			name: "subject-confirmation-wrong-recipient",
+			doc: func(t *testing.T) string {
+				return decode(t, idp.response(am, req.PublicId, "alice", nil, func(a string) string {
+					return strings.Replace(a, `Recipient="`+am.AcsUrl()+`"`, `Recipient="https://other.example.com/acs"`, 1)
+				}))
+			},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "assertion subject could not be confirmed",
+		},
+		{
+			name: "subject-confirmation-missing-in-response-to",
+			doc: func(t *testing.T) string {
+				return decode(t, idp.response(am, req.PublicId, "alice", nil, func(a string) string {
+					return strings.Replace(a, ` InResponseTo="`+req.PublicId+`"`, "", 1)
+				}))
+			},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "assertion subject could not be confirmed",
+		},
+		{
+			name: "subject-confirmation-missing-expiration",
+			doc: func(t *testing.T) string {
+				return decode(t, idp.response(am, req.PublicId, "alice", nil, func(a string) string {
+					i := strings.Index(a, "<saml:SubjectConfirmationData")
+					j := strings.Index(a, "<saml:Conditions")
+					return a[:i] + regexp.MustCompile(` NotOnOrAfter="[^"]*"`).ReplaceAllString(a[i:j], "") + a[j:]
+				}))
+			},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "assertion subject could not be confirmed",
+		},
+		{
+			name: "encrypted-assertion",
+			doc: func(t *testing.T) string {
+				doc := decode(t, idp.Response(am, req.PublicId, "alice", nil))
+				return strings.Replace(doc, "</samlp:Status>", `</samlp:Status><saml:EncryptedAssertion></saml:EncryptedAssertion>`, 1)
+			},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "encrypted assertions are not supported",
+		},
+		{
+			name: "failed-status",
+			doc: func(t *testing.T) string {
+				doc := decode(t, idp.Response(am, req.PublicId, "alice", nil))
+				return strings.Replace(doc, statusSuccess, "urn:oasis:names:tc:SAML:2.0:status:Requester", 1)
+			},
+			wantErrMatch:    errors.T(errors.Unknown),
+			wantErrContains: "identity provider did not authenticate the user",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			testAm := am
+			if tt.am != nil {
+				testAm = tt.am
+			}
+			testReq := req
+			if tt.req != nil {
+				testReq = tt.req
+			}
+			now := time.Now()
+			if !tt.now.IsZero() {
+				now = tt.now
+			}
+			got, err := testAm.validateResponse(ctx, testReq, []byte(tt.doc(t)), now)
+			if tt.wantErrMatch != nil {
+				require.Error(err)
+				assert.Truef(errors.Match(tt.wantErrMatch, err), "want err code: %q got: %q", tt.wantErrMatch.Code, err)
+				assert.Contains(err.Error(), tt.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(idp.EntityId(), got.issuer)
+			assert.Equal("alice", got.nameId)
+			assert.Equal(map[string][]string{"email": {"alice@example.com"}, "groups": {"eng", "ops"}}, got.attributes)
+		})
+	}
+}
+
+func Test_decodeSamlResponse(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	encoded := base64.StdEncoding.EncodeToString([]byte("<samlp:Response/>"))
+
+	got, err := decodeSamlResponse(ctx, encoded[:8]+"\r\n"+encoded[8:])
+	require.NoError(t, err)
+	assert.Equal(t, "<samlp:Response/>", string(got))
+
+	_, err = decodeSamlResponse(ctx, "not base64!")
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/iam"
+)
+
+const (
+	// AttemptExpiration defines the TTL for an authentication attempt
+	AttemptExpiration = 5 * 60 * time.Second
+
+	// FinalRedirectEndpoint is the endpoint that the saml callback redirects
+	// the client to after the callback is complete.
+	FinalRedirectEndpoint = "%s/authentication-complete"
+
+	// AuthenticationErrorsEndpoint is the endpoint that will returned as the final redirect
+	// from the callback when there are auth errors
+	AuthenticationErrorsEndpoint = "%s/authentication-error"
+
+	// CallbackEndpoint is the assertion consumer service endpoint of an auth
+	// method which receives the responses of the identity provider.
+	CallbackEndpoint = "%s/v1/auth-methods/%s:authenticate:callback"
+
+	// EntityIdEndpoint is the default entity id of Boundary as a service
+	// provider for an auth method.
+	EntityIdEndpoint = "%s/v1/auth-methods/%s"
+
+	// clockSkew is the tolerated difference between the clocks of Boundary
+	// and the identity provider when validating assertions.
+	clockSkew = 2 * time.Minute
+)
+
+type (
+	// SamlRepoFactory is used by "service functions" to create a new saml repo
+	SamlRepoFactory func() (*Repository, error)
+
+	// IamRepoFactory is used by "service functions" to create a new iam repo
+	IamRepoFactory func() (*iam.Repository, error)
+
+	// AuthTokenRepoFactory is used by "service functions" to create a new auth token repo
+	AuthTokenRepoFactory func() (*authtoken.Repository, error)
+)
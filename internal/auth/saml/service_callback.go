@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/mitchellh/pointerstructure"
+)
+
+// Callback is a saml domain service function for processing a response
+// which the identity provider posted to the assertion consumer service of
+// the auth method. On success, it returns a final redirect URL for the
+// response to the identity provider.
+//
+// Callback can return several errors including errors.Forbidden for
+// responses which have already been processed (which are replays)
+//
+// The service operation includes:
+//
+// * Look up the in-flight authentication request using the relayState. The
+// request includes the token_request_id and final_redirect_url.
+//
+// * Validate the response and its assertion. See
+// AuthMethod.validateResponse(...)
+//
+// * Use saml.(Repository).upsertAccount to create/update account using the
+// subject and attributes of the assertion.
+//
+// * Evaluate the filters of the auth method's managed groups against the
+// attributes of the assertion and update the account's memberships.
+//
+// * Use iam.(Repository).LookupUserWithLogin(...) look up the iam.User matching
+// the Account.
+//
+// * Use the authtoken.(Repository).CreateAuthToken(...) to create a pending
+// auth token for the authenticated user.
+func Callback(
+	ctx context.Context,
+	samlRepoFn SamlRepoFactory,
+	iamRepoFn IamRepoFactory,
+	atRepoFn AuthTokenRepoFactory,
+	am *AuthMethod,
+	samlResponse, relayState string,
+) (finalRedirect string, e error) {
+	const op = "saml.Callback"
+	if samlRepoFn == nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing saml repository function")
+	}
+	if iamRepoFn == nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing iam repository function")
+	}
+	if atRepoFn == nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing auth token repository function")
+	}
+	if am == nil || am.AuthMethod == nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if samlResponse == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing saml response")
+	}
+	if relayState == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing relay state")
+	}
+
+	r, err := samlRepoFn()
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	req, err := r.lookupRequest(ctx, am.PublicId, relayState)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	if req == nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "unknown authentication request")
+	}
+	// before proceeding, make sure the request hasn't timed out
+	now := time.Now()
+	if now.After(req.ExpirationTime.AsTime()) {
+		return "", errors.New(ctx, errors.AuthAttemptExpired, op, "authentication request has expired")
+	}
+
+	doc, err := decodeSamlResponse(ctx, samlResponse)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	a, err := am.validateResponse(ctx, req, doc, now)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+
+	acct, err := r.upsertAccount(ctx, am, a)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+
+	// Get the set of all managed groups so we can filter
+	mgs, _, err := r.ListManagedGroups(ctx, am.GetPublicId())
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	if len(mgs) > 0 {
+		matchedMgs := make([]*ManagedGroup, 0, len(mgs))
+		evalData := map[string]any{
+			"attributes": a.attributes,
+			"name_id":    a.nameId,
+		}
+		// Iterate through and check attributes against filters
+		for _, mg := range mgs {
+			eval, err := bexpr.CreateEvaluator(mg.Filter)
+			if err != nil {
+				// We check all filters on ingress so this should never happen,
+				// but we validate anyways
+				return "", errors.Wrap(ctx, err, op)
+			}
+			match, err := eval.Evaluate(evalData)
+			if err != nil && !errors.Is(err, pointerstructure.ErrNotFound) {
+				return "", errors.Wrap(ctx, err, op)
+			}
+			if match {
+				matchedMgs = append(matchedMgs, mg)
+			}
+		}
+		// We always pass it in, even if none match, because in that case we
+		// need to remove any mappings that exist
+		if _, _, err := r.SetManagedGroupMemberships(ctx, am, acct, matchedMgs); err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+	}
+
+	iamRepo, err := iamRepoFn()
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	if _, err := iamRepo.LookupScope(ctx, am.ScopeId); err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup account scope: "+am.ScopeId))
+	}
+	user, err := iamRepo.LookupUserWithLogin(ctx, acct.PublicId)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+
+	// create a pending token for this successful authentication process, so
+	// it can be retrieved by the polling client that initialed the
+	// authentication attempt.
+	tokenRepo, err := atRepoFn()
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	authToken, err := tokenRepo.CreateAuthToken(ctx, user, acct.PublicId, authtoken.WithPublicId(req.TokenRequestId), authtoken.WithStatus(authtoken.PendingStatus))
+	if err != nil {
+		if errors.Match(errors.T(errors.NotUnique), err) {
+			return "", errors.New(ctx, errors.Forbidden, op, "not a unique request", errors.WithWrap(err))
+		}
+		return "", errors.Wrap(ctx, err, op)
+	}
+	if err := event.WriteObservation(ctx, op, event.WithDetails("user_id", user.GetPublicId(), "auth_token_start",
+		authToken.GetCreateTime(), "auth_token_end", authToken.GetExpirationTime())); err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg("Unable to write observation event for authenticate method"))
+	}
+	return req.FinalRedirectUrl, nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_StartAuthCallbackTokenRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	rootWrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, rootWrapper)
+
+	iamRepo := iam.TestRepo(t, conn, rootWrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	samlRepoFn := func() (*Repository, error) {
+		return NewRepository(ctx, rw, rw, kmsCache)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, rootWrapper), nil
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kmsCache)
+	}
+
+	idp := NewTestIdp(t)
+	apiUrl, err := url.Parse("https://api.example.com")
+	require.NoError(t, err)
+	ssoUrl, err := url.Parse(idp.SsoUrl())
+	require.NoError(t, err)
+	testAuthMethod := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, ActivePublicState,
+		WithApiUrl(apiUrl),
+		WithIdpEntityId(idp.EntityId()),
+		WithIdpSsoUrl(ssoUrl),
+		WithIdpCertificates(idp.Certificate()),
+		WithAccountAttributeMap(map[string]AccountToAttribute{"displayName": ToNameAttribute}),
+	)
+	testAcct := TestAccount(t, conn, testAuthMethod, "alice")
+	testUser := iam.TestUser(t, iamRepo, org.PublicId, iam.WithAccountIds(testAcct.PublicId))
+	testMg := TestManagedGroup(t, conn, testAuthMethod, `"eng" in "/attributes/groups"`)
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		authUrl, tokenId, err := StartAuth(ctx, samlRepoFn, testAuthMethod.PublicId, WithRoundtripPayload("payload"))
+		require.NoError(err)
+		requestId := authUrl.Query().Get("RelayState")
+		require.NotEmpty(requestId)
+
+		tk, err := TokenRequest(ctx, samlRepoFn, atRepoFn, testAuthMethod.PublicId, tokenId)
+		require.NoError(err)
+		assert.Nil(tk, "token must not be issued before the callback")
+
+		resp := idp.Response(testAuthMethod, requestId, "alice", map[string][]string{
+			"displayName": {"Alice Doe"},
+			"email":       {"alice@example.com"},
+			"groups":      {"eng"},
+		})
+		finalRedirect, err := Callback(ctx, samlRepoFn, iamRepoFn, atRepoFn, testAuthMethod, resp, requestId)
+		require.NoError(err)
+		assert.Contains(finalRedirect, "roundtrip_payload=payload")
+
+		repo, err := samlRepoFn()
+		require.NoError(err)
+		acct, err := repo.LookupAccount(ctx, testAcct.PublicId)
+		require.NoError(err)
+		assert.Equal("Alice Doe", acct.FullName)
+		assert.Equal("alice@example.com", acct.Email)
+
+		members, err := repo.ListManagedGroupMembershipsByMember(ctx, testAcct.PublicId)
+		require.NoError(err)
+		require.Len(members, 1)
+		assert.Equal(testMg.PublicId, members[0].ManagedGroupId)
+
+		tk, err = TokenRequest(ctx, samlRepoFn, atRepoFn, testAuthMethod.PublicId, tokenId)
+		require.NoError(err)
+		require.NotNil(tk)
+		assert.Equal(testUser.PublicId, tk.IamUserId)
+		assert.NotEmpty(tk.Token)
+
+		// replaying the response must fail
+		_, err = Callback(ctx, samlRepoFn, iamRepoFn, atRepoFn, testAuthMethod, resp, requestId)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.Forbidden), err))
+	})
+	t.Run("unknown-relay-state", func(t *testing.T) {
+		resp := idp.Response(testAuthMethod, "samlreq_unknown", "alice", nil)
+		_, err := Callback(ctx, samlRepoFn, iamRepoFn, atRepoFn, testAuthMethod, resp, "samlreq_unknown")
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("unknown-token-id", func(t *testing.T) {
+		_, err := TokenRequest(ctx, samlRepoFn, atRepoFn, testAuthMethod.PublicId, "unknown")
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("inactive", func(t *testing.T) {
+		inactive := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, InactiveState)
+		_, _, err := StartAuth(ctx, samlRepoFn, inactive.PublicId)
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.AuthMethodInactive), err))
+	})
+}
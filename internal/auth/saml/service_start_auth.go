@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/saml/store"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-secure-stdlib/base62"
+)
+
+// tokenIdLength is the length of the random token id returned to the client
+// which started an authentication attempt.
+const tokenIdLength = 32
+
+// StartAuth accepts a request to start a SAML authentication attempt. It
+// returns the authUrl, which is the URL of the identity provider's single
+// sign-on service including an AuthnRequest, and a tokenId.  The tokenId is
+// the payload the client uses to retrieve the results of the user's
+// authentication attempt.
+//
+// If the auth method is in an InactiveState, then an error is returned.
+//
+// Options supported:
+//
+// WithRoundtripPayload(string) provides an option for a client roundtrip
+// payload. This payload will be added to the final redirect as a query
+// parameter.
+func StartAuth(ctx context.Context, samlRepoFn SamlRepoFactory, authMethodId string, opt ...Option) (authUrl *url.URL, tokenId string, e error) {
+	const op = "saml.StartAuth"
+	if authMethodId == "" {
+		return nil, "", errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	if samlRepoFn == nil {
+		return nil, "", errors.New(ctx, errors.InvalidParameter, op, "missing saml repo function")
+	}
+	r, err := samlRepoFn()
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	am, err := r.lookupAuthMethod(ctx, authMethodId)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		return nil, "", errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s not found", authMethodId))
+	}
+	if am.OperationalState == string(InactiveState) {
+		return nil, "", errors.New(ctx, errors.AuthMethodInactive, op, "not allowed to start authentication attempt")
+	}
+
+	opts := getOpts(opt...)
+	finalRedirect := fmt.Sprintf(FinalRedirectEndpoint, am.GetApiUrl())
+	if opts.withRoundtripPayload != "" {
+		u := make(url.Values)
+		u.Add("roundtrip_payload", opts.withRoundtripPayload)
+		finalRedirect = fmt.Sprintf("%s?%s", finalRedirect, u.Encode())
+	}
+
+	requestId, err := newRequestId(ctx)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	tokenRequestId, err := authtoken.NewAuthTokenId(ctx)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	tokenId, err = base62.Random(tokenIdLength)
+	if err != nil {
+		return nil, "", errors.New(ctx, errors.Unknown, op, "unable to generate token id", errors.WithWrap(err))
+	}
+	now := time.Now()
+	req := &Request{
+		Request: &store.Request{
+			PublicId:         requestId,
+			AuthMethodId:     am.PublicId,
+			TokenRequestId:   tokenRequestId,
+			TokenId:          tokenId,
+			FinalRedirectUrl: finalRedirect,
+			ExpirationTime:   timestamp.New(now.Add(AttemptExpiration).Truncate(time.Second)),
+		},
+	}
+	if _, err := r.createRequest(ctx, req); err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+
+	authUrl, err = am.authnRequestUrl(ctx, requestId, now)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	return authUrl, tokenId, nil
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// TokenRequest is a saml domain service function for processing a token
+// request from a client polling with the tokenId they received via
+// StartAuth.  On success, it returns Boundary token.
+//
+// * Look up the in-flight authentication request of the tokenId.  If it's
+// not found or it has expired, an error is returned.
+//
+// * Use the authtoken.(Repository).IssueAuthToken to issue the request's
+// token and mark it as issued in the repo.  If the token is already issued,
+// an error is returned.  If the token does not exist yet, because the
+// identity provider's response has not been received, nil is returned.
+func TokenRequest(ctx context.Context, samlRepoFn SamlRepoFactory, atRepoFn AuthTokenRepoFactory, authMethodId, tokenId string) (*authtoken.AuthToken, error) {
+	const op = "saml.TokenRequest"
+	if samlRepoFn == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing saml repo function")
+	}
+	if atRepoFn == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth token repo function")
+	}
+	if authMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	if tokenId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing token id")
+	}
+
+	r, err := samlRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	req, err := r.lookupRequestByTokenId(ctx, authMethodId, tokenId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "unknown token id")
+	}
+	// before proceeding, make sure the request hasn't timed out
+	if time.Now().After(req.ExpirationTime.AsTime()) {
+		return nil, errors.New(ctx, errors.AuthAttemptExpired, op, "token id has expired")
+	}
+
+	tokenRepo, err := atRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	authTk, err := tokenRepo.IssueAuthToken(ctx, req.TokenRequestId)
+	if err != nil {
+		if errors.Match(errors.T(errors.RecordNotFound), err) {
+			// We don't have it -- at least not yet. So don't mark it as an
+			// error, but nothing is returned.
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if authTk.Token == "" {
+		return nil, errors.New(ctx, errors.Internal, op, "issued token is missing")
+	}
+	return authTk, nil
+}
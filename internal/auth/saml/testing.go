@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/hashicorp/boundary/internal/db"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/stretchr/testify/require"
+)
+
+const TestFakeManagedGroupFilter = `"/attributes/foo" contains "bar"`
+
+// TestAuthMethod creates a test saml auth method.  WithName,
+// WithDescription, WithApiUrl, WithIdpEntityId, WithIdpSsoUrl,
+// WithIdpCertificates, WithSpEntityId, WithSignAuthnRequests,
+// WithNameIdFormat and WithAccountAttributeMap options are supported.
+func TestAuthMethod(
+	t testing.TB,
+	conn *db.DB,
+	databaseWrapper wrapping.Wrapper,
+	scopeId string,
+	state AuthMethodState,
+	opt ...Option,
+) *AuthMethod {
+	t.Helper()
+	ctx := context.TODO()
+	opts := getOpts(opt...)
+	require := require.New(t)
+	rw := db.New(conn)
+
+	authMethod, err := NewAuthMethod(ctx, scopeId, opt...)
+	require.NoError(err)
+	id, err := newAuthMethodId(ctx)
+	require.NoError(err)
+	authMethod.PublicId = id
+	require.NoError(authMethod.generateSpCertificate(ctx))
+	require.NoError(authMethod.encrypt(ctx, databaseWrapper))
+	require.NoError(rw.Create(ctx, authMethod))
+
+	if len(opts.withAccountAttributeMap) > 0 {
+		newMaps := make([]any, 0, len(opts.withAccountAttributeMap))
+		for k, v := range opts.withAccountAttributeMap {
+			m, err := NewAccountAttributeMap(ctx, authMethod.PublicId, k, v)
+			require.NoError(err)
+			newMaps = append(newMaps, m)
+		}
+		require.NoError(rw.CreateItems(ctx, newMaps))
+		require.Equal(len(opts.withAccountAttributeMap), len(authMethod.AccountAttributeMaps))
+	}
+	authMethod.OperationalState = string(state)
+	rowsUpdated, err := rw.Update(ctx, authMethod, []string{OperationalStateField}, nil)
+	require.NoError(err)
+	require.True(rowsUpdated == 0 || rowsUpdated == 1)
+
+	return authMethod
+}
+
+// TestSortAuthMethods will sort the provided auth methods by public id and it
+// will sort each auth method's account attribute maps.
+func TestSortAuthMethods(t testing.TB, methods []*AuthMethod) {
+	sort.Slice(methods, func(a, b int) bool {
+		return methods[a].PublicId < methods[b].PublicId
+	})
+	for _, am := range methods {
+		sort.Strings(am.AccountAttributeMaps)
+	}
+}
+
+// TestAccount creates a test saml auth account.
+func TestAccount(t testing.TB, conn *db.DB, am *AuthMethod, subject string, opt ...Option) *Account {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	ctx := context.Background()
+
+	opt = append(opt, WithIssuer(am.GetIdpEntityId()))
+	a, err := NewAccount(ctx, am.PublicId, subject, opt...)
+	require.NoError(err)
+
+	id, err := newAccountId(ctx, am.GetPublicId(), am.GetIdpEntityId(), subject)
+	require.NoError(err)
+	a.PublicId = id
+
+	require.NoError(rw.Create(ctx, a))
+	return a
+}
+
+// TestManagedGroup creates a test saml managed group.
+func TestManagedGroup(t testing.TB, conn *db.DB, am *AuthMethod, filter string, opt ...Option) *ManagedGroup {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	ctx := context.Background()
+
+	mg, err := NewManagedGroup(ctx, am.PublicId, filter, opt...)
+	require.NoError(err)
+
+	id, err := newManagedGroupId(ctx)
+	require.NoError(err)
+	mg.PublicId = id
+
+	require.NoError(rw.Create(ctx, mg))
+	return mg
+}
+
+// TestManagedGroupMember adds given account IDs to a managed group
+func TestManagedGroupMember(t testing.TB, conn *db.DB, managedGroupId, memberId string, opt ...Option) *ManagedGroupMemberAccount {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	ctx := context.Background()
+
+	mg, err := NewManagedGroupMemberAccount(ctx, managedGroupId, memberId, opt...)
+	require.NoError(err)
+
+	require.NoError(rw.Create(ctx, mg))
+	return mg
+}
+
+// TestIdp is an identity provider which issues signed SAML responses for
+// tests.  It does not serve any endpoints.
+type TestIdp struct {
+	t        testing.TB
+	key      *rsa.PrivateKey
+	cert     *x509.Certificate
+	entityId string
+	ssoUrl   string
+}
+
+// NewTestIdp creates a new TestIdp with a generated signing key and
+// certificate.
+func NewTestIdp(t testing.TB) *TestIdp {
+	t.Helper()
+	require := require.New(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test idp"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(err)
+	return &TestIdp{
+		t:        t,
+		key:      key,
+		cert:     cert,
+		entityId: "https://idp.example.com/metadata",
+		ssoUrl:   "https://idp.example.com/sso",
+	}
+}
+
+// Certificate returns the signing certificate of the identity provider.
+func (i *TestIdp) Certificate() *x509.Certificate { return i.cert }
+
+// EntityId returns the entity id of the identity provider.
+func (i *TestIdp) EntityId() string { return i.entityId }
+
+// SsoUrl returns the single sign-on service URL of the identity provider.
+func (i *TestIdp) SsoUrl() string { return i.ssoUrl }
+
+// Response returns a base64 encoded response to the request for the auth
+// method, as it would be posted to the assertion consumer service.  The
+// assertion is signed by the identity provider.
+func (i *TestIdp) Response(am *AuthMethod, requestId, nameId string, attributes map[string][]string) string {
+	i.t.Helper()
+	return i.response(am, requestId, nameId, attributes, nil)
+}
+
+// response is Response with an edit of the assertion applied before it is
+// signed.
+func (i *TestIdp) response(am *AuthMethod, requestId, nameId string, attributes map[string][]string, edit func(assertion string) string) string {
+	i.t.Helper()
+	now := time.Now().UTC()
+	notOnOrAfter := now.Add(5 * time.Minute).Format(time.RFC3339)
+
+	var a bytes.Buffer
+	a.WriteString(`<saml:Assertion xmlns:saml="` + nsAssert + `"`)
+	writeAttr(&a, "ID", "_assertion-"+requestId)
+	writeAttr(&a, "IssueInstant", now.Format(time.RFC3339))
+	writeAttr(&a, "Version", "2.0")
+	a.WriteString(`><saml:Issuer>`)
+	escapeText(&a, i.entityId)
+	a.WriteString(`</saml:Issuer>{{signature}}<saml:Subject><saml:NameID>`)
+	escapeText(&a, nameId)
+	a.WriteString(`</saml:NameID><saml:SubjectConfirmation`)
+	writeAttr(&a, "Method", subjectConfirmationBearer)
+	a.WriteString(`><saml:SubjectConfirmationData`)
+	writeAttr(&a, "InResponseTo", requestId)
+	writeAttr(&a, "NotOnOrAfter", notOnOrAfter)
+	writeAttr(&a, "Recipient", am.AcsUrl())
+	a.WriteString(`/></saml:SubjectConfirmation></saml:Subject><saml:Conditions`)
+	writeAttr(&a, "NotBefore", now.Add(-time.Minute).Format(time.RFC3339))
+	writeAttr(&a, "NotOnOrAfter", notOnOrAfter)
+	a.WriteString(`><saml:AudienceRestriction><saml:Audience>`)
+	escapeText(&a, am.EntityId())
+	a.WriteString(`</saml:Audience></saml:AudienceRestriction></saml:Conditions>`)
+	if len(attributes) > 0 {
+		names := make([]string, 0, len(attributes))
+		for n := range attributes {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		a.WriteString(`<saml:AttributeStatement>`)
+		for _, n := range names {
+			a.WriteString(`<saml:Attribute`)
+			writeAttr(&a, "Name", n)
+			a.WriteString(`>`)
+			for _, v := range attributes[n] {
+				a.WriteString(`<saml:AttributeValue>`)
+				escapeText(&a, v)
+				a.WriteString(`</saml:AttributeValue>`)
+			}
+			a.WriteString(`</saml:Attribute>`)
+		}
+		a.WriteString(`</saml:AttributeStatement>`)
+	}
+	a.WriteString(`</saml:Assertion>`)
+	unsigned := a.String()
+	if edit != nil {
+		unsigned = edit(unsigned)
+	}
+	assertion := i.sign(unsigned, "_assertion-"+requestId)
+
+	var r bytes.Buffer
+	r.WriteString(`<samlp:Response xmlns:samlp="` + nsProtocol + `" xmlns:saml="` + nsAssert + `"`)
+	writeAttr(&r, "ID", "_response-"+requestId)
+	writeAttr(&r, "Version", "2.0")
+	writeAttr(&r, "IssueInstant", now.Format(time.RFC3339))
+	writeAttr(&r, "Destination", am.AcsUrl())
+	writeAttr(&r, "InResponseTo", requestId)
+	r.WriteString(`><saml:Issuer>`)
+	escapeText(&r, i.entityId)
+	r.WriteString(`</saml:Issuer><samlp:Status><samlp:StatusCode`)
+	writeAttr(&r, "Value", statusSuccess)
+	r.WriteString(`/></samlp:Status>`)
+	r.WriteString(assertion)
+	r.WriteString(`</samlp:Response>`)
+	return base64.StdEncoding.EncodeToString(r.Bytes())
+}
+
+// sign replaces the {{signature}} placeholder of the element with an
+// enveloped signature of the element.
+func (i *TestIdp) sign(element, id string) string {
+	i.t.Helper()
+	require := require.New(i.t)
+
+	doc := etree.NewDocument()
+	require.NoError(doc.ReadFromString(strings.Replace(element, "{{signature}}", "", 1)))
+	el := doc.Root()
+	require.Equal(id, el.SelectAttrValue("ID", ""))
+
+	signer, err := dsig.NewSigningContext(i.key, [][]byte{i.cert.Raw})
+	require.NoError(err)
+	signer.Canonicalizer = dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList("")
+	sig, err := signer.ConstructSignature(el, true)
+	require.NoError(err)
+
+	// The signature follows the issuer of the element.
+	issuer := el.SelectElement("saml:Issuer")
+	require.NotNil(issuer)
+	el.InsertChildAt(issuer.Index()+1, sig)
+	signed, err := doc.WriteToString()
+	require.NoError(err)
+	return signed
+}
@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package saml
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/hashicorp/boundary/internal/errors"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+)
+
+// The XML namespaces and algorithm identifiers used by SAML requests and
+// responses.
+const (
+	nsXml      = "http://www.w3.org/XML/1998/namespace"
+	nsDsig     = "http://www.w3.org/2000/09/xmldsig#"
+	nsProtocol = "urn:oasis:names:tc:SAML:2.0:protocol"
+	nsAssert   = "urn:oasis:names:tc:SAML:2.0:assertion"
+	nsMetadata = "urn:oasis:names:tc:SAML:2.0:metadata"
+
+	algRsaSha256 = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+)
+
+// xmlAttr is an attribute of an xmlElement.  Namespace declarations are not
+// kept as attributes.
+type xmlAttr struct {
+	prefix string
+	space  string
+	local  string
+	value  string
+}
+
+// xmlNode is either an element or character data.
+type xmlNode struct {
+	elem *xmlElement
+	text string
+}
+
+// xmlElement is a minimal DOM of a SAML document.
+type xmlElement struct {
+	prefix   string
+	space    string
+	local    string
+	attrs    []xmlAttr
+	nsDecls  map[string]string
+	parent   *xmlElement
+	children []xmlNode
+}
+
+// parseXml parses the document and returns its root element.  DTDs are
+// rejected.
+func parseXml(ctx context.Context, doc []byte) (*xmlElement, error) {
+	const op = "saml.parseXml"
+	dec := xml.NewDecoder(bytes.NewReader(doc))
+	var root, cur *xmlElement
+	for {
+		tok, err := dec.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "unable to parse xml", errors.WithWrap(err))
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			el := &xmlElement{
+				prefix:  t.Name.Space,
+				local:   t.Name.Local,
+				nsDecls: map[string]string{},
+				parent:  cur,
+			}
+			for _, a := range t.Attr {
+				switch {
+				case a.Name.Space == "" && a.Name.Local == "xmlns":
+					el.nsDecls[""] = a.Value
+				case a.Name.Space == "xmlns":
+					el.nsDecls[a.Name.Local] = a.Value
+				default:
+					el.attrs = append(el.attrs, xmlAttr{prefix: a.Name.Space, local: a.Name.Local, value: a.Value})
+				}
+			}
+			var ok bool
+			if el.space, ok = el.lookupNamespace(el.prefix); !ok {
+				return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("undeclared namespace prefix %q", el.prefix))
+			}
+			for i := range el.attrs {
+				if el.attrs[i].prefix == "" {
+					continue
+				}
+				if el.attrs[i].space, ok = el.lookupNamespace(el.attrs[i].prefix); !ok {
+					return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("undeclared namespace prefix %q", el.attrs[i].prefix))
+				}
+			}
+			switch {
+			case cur != nil:
+				cur.children = append(cur.children, xmlNode{elem: el})
+			case root != nil:
+				return nil, errors.New(ctx, errors.InvalidParameter, op, "multiple root elements")
+			default:
+				root = el
+			}
+			cur = el
+		case xml.EndElement:
+			if cur == nil || cur.prefix != t.Name.Space || cur.local != t.Name.Local {
+				return nil, errors.New(ctx, errors.InvalidParameter, op, "unexpected end element")
+			}
+			cur = cur.parent
+		case xml.CharData:
+			if cur != nil {
+				cur.children = append(cur.children, xmlNode{text: string(t)})
+			}
+		case xml.Directive:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "xml directives are not supported")
+		}
+	}
+	if root == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing root element")
+	}
+	if cur != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "unexpected end of document")
+	}
+	return root, nil
+}
+
+// lookupNamespace resolves the prefix in the scope of the element.
+func (e *xmlElement) lookupNamespace(prefix string) (string, bool) {
+	if prefix == "xml" {
+		return nsXml, true
+	}
+	for cur := e; cur != nil; cur = cur.parent {
+		if ns, ok := cur.nsDecls[prefix]; ok {
+			return ns, true
+		}
+	}
+	// the default namespace is empty unless declared
+	return "", prefix == ""
+}
+
+// is reports whether the element has the namespace and local name.
+func (e *xmlElement) is(space, local string) bool {
+	return e != nil && e.space == space && e.local == local
+}
+
+// attr returns the value of the unqualified attribute.
+func (e *xmlElement) attr(local string) string {
+	for _, a := range e.attrs {
+		if a.space == "" && a.local == local {
+			return a.value
+		}
+	}
+	return ""
+}
+
+// child returns the first child element with the namespace and local name.
+func (e *xmlElement) child(space, local string) *xmlElement {
+	for _, c := range e.children {
+		if c.elem.is(space, local) {
+			return c.elem
+		}
+	}
+	return nil
+}
+
+// childrenNamed returns every child element with the namespace and local name.
+func (e *xmlElement) childrenNamed(space, local string) []*xmlElement {
+	var elems []*xmlElement
+	for _, c := range e.children {
+		if c.elem.is(space, local) {
+			elems = append(elems, c.elem)
+		}
+	}
+	return elems
+}
+
+// text returns the concatenated character data of the element.
+func (e *xmlElement) text() string {
+	if e == nil {
+		return ""
+	}
+	var b strings.Builder
+	for _, c := range e.children {
+		if c.elem == nil {
+			b.WriteString(c.text)
+		}
+	}
+	return b.String()
+}
+
+func escapeText(buf *bytes.Buffer, s string) {
+	for _, r := range s {
+		switch r {
+		case '&':
+			buf.WriteString("&amp;")
+		case '<':
+			buf.WriteString("&lt;")
+		case '>':
+			buf.WriteString("&gt;")
+		case '\r':
+			buf.WriteString("&#xD;")
+		default:
+			buf.WriteRune(r)
+		}
+	}
+}
+
+func escapeAttrValue(buf *bytes.Buffer, s string) {
+	for _, r := range s {
+		switch r {
+		case '&':
+			buf.WriteString("&amp;")
+		case '<':
+			buf.WriteString("&lt;")
+		case '"':
+			buf.WriteString("&quot;")
+		case '\t':
+			buf.WriteString("&#x9;")
+		case '\n':
+			buf.WriteString("&#xA;")
+		case '\r':
+			buf.WriteString("&#xD;")
+		default:
+			buf.WriteRune(r)
+		}
+	}
+}
+
+// verifySignature verifies the enveloped signature of the root element of
+// the document, or of its assertion when assertion is true, with one of the
+// certificates.  The signature must reference the element.  Only the content
+// covered by the signature is returned.
+func verifySignature(ctx context.Context, doc []byte, assertion bool, certs []*x509.Certificate) (*xmlElement, error) {
+	const op = "saml.verifySignature"
+	d := etree.NewDocument()
+	if err := d.ReadFromBytes(doc); err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "unable to parse xml", errors.WithWrap(err))
+	}
+	el := d.Root()
+	if el == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing root element")
+	}
+	if assertion {
+		var err error
+		if el, err = etreeutils.NSFindOneChild(el, nsAssert, "Assertion"); err != nil || el == nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "missing assertion", errors.WithWrap(err))
+		}
+		// Carry the namespace declarations in scope of the assertion
+		// over so it can be validated on its own.
+		nsCtx, err := etreeutils.NSBuildParentContext(el)
+		if err != nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "unable to resolve namespaces", errors.WithWrap(err))
+		}
+		if el, err = etreeutils.NSDetatch(nsCtx, el); err != nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "unable to resolve namespaces", errors.WithWrap(err))
+		}
+	}
+	if len(certs) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing certificates")
+	}
+	validated, err := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{Roots: certs}).Validate(el)
+	if err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s signature is not valid", el.Tag), errors.WithWrap(err))
+	}
+	signed := etree.NewDocument()
+	signed.SetRoot(validated)
+	b, err := signed.WriteToBytes()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return parseXml(ctx, b)
+}
@@ -29,9 +29,12 @@ import (
 // API.  Defining a type allows us to easily override the tableName to use the
 // view name.  authTokenViews share the same store struct/proto, which makes
 // them easily convertable to vanilla AuthTokens when required.
+// TimeToStaleSeconds is the TokenPolicy setting of the auth method, which the
+// view includes so that validating a token takes a single read.
 type authTokenView struct {
 	*store.AuthToken
-	tableName string `gorm:"-"`
+	TimeToStaleSeconds uint32
+	tableName          string `gorm:"-"`
 }
 
 // allocAuthTokenView is just easier/better than leaking the underlying type
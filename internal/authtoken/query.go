@@ -6,5 +6,33 @@ package authtoken
 const (
 	estimateCountAuthTokens = `
 select reltuples::bigint as estimate from pg_class where oid in ('auth_token'::regclass)
+`
+
+	// evictAuthTokensQuery deletes the oldest auth tokens of a user from an
+	// auth method, other than the auth token @public_id, so that at most
+	// @keep_tokens of them remain.
+	evictAuthTokensQuery = `
+delete from auth_token
+ where public_id in (
+         select tok.public_id
+           from auth_token tok
+           join auth_account acct
+             on tok.auth_account_id = acct.public_id
+          where acct.iam_user_id    = @iam_user_id
+            and acct.auth_method_id = @auth_method_id
+            and tok.public_id      <> @public_id
+       order by tok.create_time desc
+         offset @keep_tokens
+       );
+`
+
+	// deleteUserAuthTokensQuery deletes every auth token of a user.
+	deleteUserAuthTokensQuery = `
+delete from auth_token
+ where auth_account_id in (
+         select public_id
+           from auth_account
+          where iam_user_id = @iam_user_id
+       );
 `
 )
@@ -62,6 +62,9 @@ func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms,
 // Auth Token.  The returned auth token contains the auth token value. The
 // provided IAM User ID must be associated to the provided auth account id or an
 // error will be returned.  The Auth Token will have a Status of "issued".
+// The TokenPolicy of the account's auth method sets the time to live of the
+// Auth Token and, if it limits the number of auth tokens per user, the oldest
+// auth tokens of the user from the auth method are deleted.
 // The WithStatus and WithPublicId options are supported and all other options
 // are ignored.
 func (r *Repository) CreateAuthToken(ctx context.Context, withIamUser *iam.User, withAuthAccountId string, opt ...Option) (*AuthToken, error) {
@@ -102,11 +105,6 @@ func (r *Repository) CreateAuthToken(ctx context.Context, withIamUser *iam.User,
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
 	}
 
-	// We truncate the expiration time to the nearest second to make testing in different platforms with
-	// different time resolutions easier.
-	expiration := timestamppb.New(time.Now().Add(r.timeToLiveDuration).Truncate(time.Second))
-	at.ExpirationTime = &timestamp.Timestamp{Timestamp: expiration}
-
 	var newAuthToken *AuthToken
 	_, err = r.writer.DoTx(
 		ctx,
@@ -126,6 +124,15 @@ func (r *Repository) CreateAuthToken(ctx context.Context, withIamUser *iam.User,
 			at.AuthMethodId = acct.GetAuthMethodId()
 			at.IamUserId = acct.GetIamUserId()
 
+			policy, err := getTokenPolicy(ctx, read, acct.GetAuthMethodId())
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			// We truncate the expiration time to the nearest second to make testing in different platforms with
+			// different time resolutions easier.
+			expiration := timestamppb.New(time.Now().Add(policy.timeToLive(r.timeToLiveDuration)).Truncate(time.Second))
+			at.ExpirationTime = &timestamp.Timestamp{Timestamp: expiration}
+
 			newAuthToken = at.clone()
 			if err := newAuthToken.encrypt(ctx, databaseWrapper); err != nil {
 				return errors.Wrap(ctx, err, op)
@@ -136,6 +143,18 @@ func (r *Repository) CreateAuthToken(ctx context.Context, withIamUser *iam.User,
 			}
 			newAuthToken.CtToken = nil
 
+			if policy.MaxTokensPerUser > 0 {
+				args := []any{
+					sql.Named("iam_user_id", acct.GetIamUserId()),
+					sql.Named("auth_method_id", acct.GetAuthMethodId()),
+					sql.Named("public_id", newAuthToken.GetPublicId()),
+					sql.Named("keep_tokens", policy.MaxTokensPerUser-1),
+				}
+				if _, err := w.Exec(ctx, evictAuthTokensQuery, args); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("evict auth tokens"))
+				}
+			}
+
 			return nil
 		},
 	)
@@ -154,8 +173,20 @@ func (r *Repository) LookupAuthToken(ctx context.Context, id string, opt ...Opti
 	if id == "" {
 		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
 	}
-	opts := getOpts(opt...)
+	atv, err := r.lookupAuthTokenView(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if atv == nil {
+		return nil, nil
+	}
+	return r.toAuthToken(ctx, atv, opt...)
+}
 
+// lookupAuthTokenView returns the authTokenView for id. Returns nil, nil if
+// no auth token is found for id.
+func (r *Repository) lookupAuthTokenView(ctx context.Context, id string) (*authTokenView, error) {
+	const op = "authtoken.(Repository).lookupAuthTokenView"
 	// use the view, to bring in the required account columns. Just don't forget
 	// to convert it before returning it.
 	atv := allocAuthTokenView()
@@ -166,7 +197,14 @@ func (r *Repository) LookupAuthToken(ctx context.Context, id string, opt ...Opti
 		}
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	return atv, nil
+}
 
+// toAuthToken converts atv to the AuthToken returned to repo callers. The
+// token is decrypted if the withTokenValue option is provided.
+func (r *Repository) toAuthToken(ctx context.Context, atv *authTokenView, opt ...Option) (*AuthToken, error) {
+	const op = "authtoken.(Repository).toAuthToken"
+	opts := getOpts(opt...)
 	at := atv.toAuthToken()
 	if opts.withTokenValue {
 		databaseWrapper, err := r.kms.GetWrapper(ctx, at.GetScopeId(), kms.KeyPurposeDatabase, kms.WithKeyId(at.GetKeyId()))
@@ -185,7 +223,8 @@ func (r *Repository) LookupAuthToken(ctx context.Context, id string, opt ...Opti
 
 // ValidateToken returns a token from storage if the auth token with the provided id and token exists.  The
 // approximate last accessed time may be updated depending on how long it has been since the last time the token
-// was validated.  If a token is returned it is guaranteed to be valid. For security reasons, the actual token
+// was validated.  The token is stale if it has not been accessed within the time to stale duration of the
+// TokenPolicy of its auth method.  If a token is returned it is guaranteed to be valid. For security reasons, the actual token
 // value is not included in the returned AuthToken. If no valid auth token is found nil, nil is returned.
 // All options are ignored.
 //
@@ -199,17 +238,19 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
 	}
 
-	retAT, err := r.LookupAuthToken(ctx, id, withTokenValue())
+	// The view includes the idle timeout from the TokenPolicy.
+	atv, err := r.lookupAuthTokenView(ctx, id)
 	if err != nil {
-		retAT = nil
-		if errors.IsNotFoundError(err) {
-			return nil, nil
-		}
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	if retAT == nil {
+	if atv == nil {
 		return nil, nil
 	}
+	retAT, err := r.toAuthToken(ctx, atv, withTokenValue())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	policy := &TokenPolicy{AuthMethodId: retAT.GetAuthMethodId(), TimeToStaleSeconds: atv.TimeToStaleSeconds}
 
 	// If the token is too old or stale invalidate it and return nothing.
 	exp := retAT.GetExpirationTime().AsTime()
@@ -219,7 +260,7 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 	sinceLastAccessed := now.Sub(lastAccessed) + timeSkew
 	// TODO (jimlambrt 9/2020) - investigate the need for the timeSkew and see
 	// if it can be eliminated.
-	if now.After(exp.Add(-timeSkew)) || sinceLastAccessed >= r.timeToStaleDuration {
+	if now.After(exp.Add(-timeSkew)) || sinceLastAccessed >= policy.timeToStale(r.timeToStaleDuration) {
 		// If the token has expired or has become too stale, delete it from the DB.
 		_, err = r.writer.DoTx(
 			ctx,
@@ -463,6 +504,36 @@ func (r *Repository) DeleteAuthToken(ctx context.Context, id string, opt ...Opti
 	return rowsDeleted, nil
 }
 
+// DeleteUserAuthTokens deletes every auth token of the user with the provided
+// id, returning a count of the number of records deleted. All options are
+// ignored.
+func (r *Repository) DeleteUserAuthTokens(ctx context.Context, userId string, opt ...Option) (int, error) {
+	const op = "authtoken.(Repository).DeleteUserAuthTokens"
+	if userId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+
+	var rowsDeleted int
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			// tokens are not replicated, so they don't need oplog entries.
+			rowsDeleted, err = w.Exec(ctx, deleteUserAuthTokensQuery, []any{sql.Named("iam_user_id", userId)})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(userId))
+	}
+	return rowsDeleted, nil
+}
+
 // IssueAuthToken will retrieve the "pending" token and update it's status to
 // "issued".  If the token has already been issued, an error is returned with a
 // nil token.  If no token is found for the tokenRequestId an error is returned
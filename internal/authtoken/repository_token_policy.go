@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package authtoken
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// GetTokenPolicy returns the auth token policy for authMethodId. If no
+// policy has been set for authMethodId, a TokenPolicy with every setting
+// using the default is returned.
+func (r *Repository) GetTokenPolicy(ctx context.Context, authMethodId string) (*TokenPolicy, error) {
+	const op = "authtoken.(Repository).GetTokenPolicy"
+	if authMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	p, err := getTokenPolicy(ctx, r.reader, authMethodId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return p, nil
+}
+
+func getTokenPolicy(ctx context.Context, reader db.Reader, authMethodId string) (*TokenPolicy, error) {
+	const op = "authtoken.getTokenPolicy"
+	p := &TokenPolicy{}
+	if err := reader.LookupWhere(ctx, p, "auth_method_id = ?", []any{authMethodId}); err != nil {
+		if !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return &TokenPolicy{AuthMethodId: authMethodId}, nil
+	}
+	return p, nil
+}
+
+// SetTokenPolicy sets the auth token policy for p.AuthMethodId to p and
+// returns the stored TokenPolicy. p is not changed. Every setting in p
+// replaces the current setting, so to change a single setting the current
+// TokenPolicy should be retrieved with GetTokenPolicy and modified.
+func (r *Repository) SetTokenPolicy(ctx context.Context, p *TokenPolicy) (*TokenPolicy, error) {
+	const op = "authtoken.(Repository).SetTokenPolicy"
+	if p == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing policy")
+	}
+	if p.AuthMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+
+	newPolicy := p.clone()
+	newPolicy.CreateTime, newPolicy.UpdateTime = nil, nil
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			onConflict := &db.OnConflict{
+				Target: db.Columns{"auth_method_id"},
+				Action: db.SetColumns([]string{
+					"time_to_live_seconds",
+					"time_to_stale_seconds",
+					"max_tokens_per_user",
+				}),
+			}
+			if err := w.Create(ctx, newPolicy, db.WithOnConflict(onConflict)); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(p.AuthMethodId))
+	}
+	return newPolicy, nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package authtoken
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_TokenPolicy(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	setup := func(t *testing.T, p *TokenPolicy) (*iam.User, string) {
+		t.Helper()
+		am := password.TestAuthMethods(t, conn, org.GetPublicId(), 1)[0]
+		acct := password.TestAccount(t, conn, am.GetPublicId(), "name1")
+		u := iam.TestUser(t, iamRepo, org.GetPublicId(), iam.WithAccountIds(acct.PublicId))
+		if p != nil {
+			p.AuthMethodId = am.GetPublicId()
+			_, err := repo.SetTokenPolicy(ctx, p)
+			require.NoError(t, err)
+		}
+		return u, acct.GetPublicId()
+	}
+
+	t.Run("get-and-set", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		am := password.TestAuthMethods(t, conn, org.GetPublicId(), 1)[0]
+
+		_, err := repo.GetTokenPolicy(ctx, "")
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+		_, err = repo.SetTokenPolicy(ctx, &TokenPolicy{})
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+
+		got, err := repo.GetTokenPolicy(ctx, am.GetPublicId())
+		require.NoError(err)
+		assert.Equal(&TokenPolicy{AuthMethodId: am.GetPublicId()}, got)
+
+		_, err = repo.SetTokenPolicy(ctx, &TokenPolicy{AuthMethodId: am.GetPublicId(), TimeToLiveSeconds: 60, MaxTokensPerUser: 2})
+		require.NoError(err)
+		_, err = repo.SetTokenPolicy(ctx, &TokenPolicy{AuthMethodId: am.GetPublicId(), TimeToStaleSeconds: 30, MaxTokensPerUser: 3})
+		require.NoError(err)
+		got, err = repo.GetTokenPolicy(ctx, am.GetPublicId())
+		require.NoError(err)
+		assert.Equal(uint32(0), got.TimeToLiveSeconds)
+		assert.Equal(uint32(30), got.TimeToStaleSeconds)
+		assert.Equal(uint32(3), got.MaxTokensPerUser)
+	})
+
+	t.Run("time-to-live", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		u, acctId := setup(t, &TokenPolicy{TimeToLiveSeconds: 60})
+
+		at, err := repo.CreateAuthToken(ctx, u, acctId)
+		require.NoError(err)
+		assert.WithinDuration(time.Now().Add(time.Minute), at.GetExpirationTime().AsTime(), 5*time.Second)
+	})
+
+	t.Run("time-to-stale", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		u, acctId := setup(t, &TokenPolicy{TimeToStaleSeconds: 1})
+
+		at, err := repo.CreateAuthToken(ctx, u, acctId)
+		require.NoError(err)
+		time.Sleep(time.Second)
+		got, err := repo.ValidateToken(ctx, at.GetPublicId(), at.GetToken())
+		require.NoError(err)
+		assert.Nil(got)
+	})
+
+	t.Run("max-tokens-per-user", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		u, acctId := setup(t, &TokenPolicy{MaxTokensPerUser: 2})
+
+		var ids []string
+		for i := 0; i < 3; i++ {
+			at, err := repo.CreateAuthToken(ctx, u, acctId)
+			require.NoError(err)
+			ids = append(ids, at.GetPublicId())
+		}
+		// The oldest auth token is evicted.
+		got, err := repo.LookupAuthToken(ctx, ids[0])
+		require.NoError(err)
+		assert.Nil(got)
+		for _, id := range ids[1:] {
+			got, err := repo.LookupAuthToken(ctx, id)
+			require.NoError(err)
+			assert.NotNil(got)
+		}
+	})
+
+	t.Run("delete-user-auth-tokens", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		u, acctId := setup(t, nil)
+		other, otherAcctId := setup(t, nil)
+
+		_, err := repo.DeleteUserAuthTokens(ctx, "")
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+
+		for i := 0; i < 2; i++ {
+			_, err := repo.CreateAuthToken(ctx, u, acctId)
+			require.NoError(err)
+		}
+		otherAt, err := repo.CreateAuthToken(ctx, other, otherAcctId)
+		require.NoError(err)
+
+		deleted, err := repo.DeleteUserAuthTokens(ctx, u.GetPublicId())
+		require.NoError(err)
+		assert.Equal(2, deleted)
+
+		got, err := repo.LookupAuthToken(ctx, otherAt.GetPublicId())
+		require.NoError(err)
+		assert.NotNil(got)
+	})
+}
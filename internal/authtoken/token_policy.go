@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package authtoken
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// A TokenPolicy contains the auth token settings for an auth method. A zero
+// value for any setting uses the default of the Repository.
+//
+// TimeToLiveSeconds is the maximum lifetime of auth tokens issued by the
+// auth method. It applies to auth tokens created after it is changed.
+//
+// TimeToStaleSeconds is the idle timeout of auth tokens issued by the auth
+// method. An auth token which has not been used within the idle timeout is
+// deleted the next time it is validated.
+//
+// MaxTokensPerUser is the maximum number of auth tokens a user can hold from
+// the auth method. When a new auth token would exceed the limit, the oldest
+// auth tokens of the user from the auth method are deleted.
+type TokenPolicy struct {
+	AuthMethodId       string               `gorm:"primary_key"`
+	CreateTime         *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	UpdateTime         *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	TimeToLiveSeconds  uint32
+	TimeToStaleSeconds uint32
+	MaxTokensPerUser   uint32
+}
+
+// NewTokenPolicy creates a new in memory TokenPolicy for authMethodId with
+// every setting using the default.
+func NewTokenPolicy(ctx context.Context, authMethodId string) (*TokenPolicy, error) {
+	const op = "authtoken.NewTokenPolicy"
+	if authMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	return &TokenPolicy{AuthMethodId: authMethodId}, nil
+}
+
+// TableName returns the table name.
+func (p *TokenPolicy) TableName() string {
+	return "auth_token_policy"
+}
+
+func (p *TokenPolicy) clone() *TokenPolicy {
+	cp := *p
+	return &cp
+}
+
+// timeToLive returns the auth token time to live of p, or def if p does not
+// set one.
+func (p *TokenPolicy) timeToLive(def time.Duration) time.Duration {
+	if p.TimeToLiveSeconds == 0 {
+		return def
+	}
+	return time.Duration(p.TimeToLiveSeconds) * time.Second
+}
+
+// timeToStale returns the auth token idle timeout of p, or def if p does not
+// set one.
+func (p *TokenPolicy) timeToStale(def time.Duration) time.Duration {
+	if p.TimeToStaleSeconds == 0 {
+		return def
+	}
+	return time.Duration(p.TimeToStaleSeconds) * time.Second
+}
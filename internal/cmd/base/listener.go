@@ -109,8 +109,12 @@ func NewListener(l *listenerutil.ListenerConfig, ui cli.Ui) (net.Listener, map[s
 	}
 
 	// Don't request a client cert unless they've explicitly configured it to do
-	// so
-	if !l.TLSRequireAndVerifyClientCert {
+	// so. API listeners can opt in by setting tls_disable_client_certs to false,
+	// which requests but does not verify a client cert so that cert auth
+	// methods can verify it against their own CAs.
+	_, clientCertsSet := l.RawConfig["tls_disable_client_certs"]
+	requestClientCerts := purpose == "api" && clientCertsSet && !l.TLSDisableClientCerts
+	if !l.TLSRequireAndVerifyClientCert && !requestClientCerts {
 		l.TLSDisableClientCerts = true
 	}
 	tlsConfig, reloadFunc, err := listenerutil.TLSConfig(l, props, ui)
@@ -86,6 +86,7 @@ type Server struct {
 	WorkerAuthStorageKms    wrapping.Wrapper
 	RecoveryKms             wrapping.Wrapper
 	BsrKms                  wrapping.Wrapper
+	CredentialFileKms       wrapping.Wrapper
 	Kms                     *kms.Kms
 	SecureRandomReader      io.Reader
 
@@ -576,7 +577,8 @@ func (b *Server) SetupKMSes(ctx context.Context, ui cli.Ui, config *config.Confi
 				globals.KmsPurposeWorkerAuth,
 				globals.KmsPurposeDownstreamWorkerAuth,
 				globals.KmsPurposeWorkerAuthStorage,
-				globals.KmsPurposeBsr:
+				globals.KmsPurposeBsr,
+				globals.KmsPurposeCredentialFile:
 			case globals.KmsPurposeRecovery:
 				if config.Controller != nil && config.DevRecoveryKey != "" {
 					kms.Config["key"] = config.DevRecoveryKey
@@ -677,6 +679,11 @@ func (b *Server) SetupKMSes(ctx context.Context, ui cli.Ui, config *config.Confi
 					return fmt.Errorf("Duplicate KMS block for purpose '%s'. You may need to remove all but the last KMS block for this purpose.", purpose)
 				}
 				b.BsrKms = wrapper
+			case globals.KmsPurposeCredentialFile:
+				if b.CredentialFileKms != nil {
+					return fmt.Errorf("Duplicate KMS block for purpose '%s'. You may need to remove all but the last KMS block for this purpose.", purpose)
+				}
+				b.CredentialFileKms = wrapper
 			case globals.KmsPurposeRecovery:
 				if b.RecoveryKms != nil {
 					return fmt.Errorf("Duplicate KMS block for purpose '%s'. You may need to remove all but the last KMS block for this purpose.", purpose)
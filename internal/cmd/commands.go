@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/commands/credentialscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/credentialstorescmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/database"
+	"github.com/hashicorp/boundary/internal/cmd/commands/declarative"
 	"github.com/hashicorp/boundary/internal/cmd/commands/dev"
 	"github.com/hashicorp/boundary/internal/cmd/commands/genericcmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/groupscmd"
@@ -54,6 +55,7 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 			return &server.Command{
 				Server:    base.NewServer(base.NewServerCommand(serverCmdUi)),
 				SighupCh:  base.MakeSighupCh(),
+				SigUSR1Ch: MakeSigUSR1Ch(),
 				SigUSR2Ch: MakeSigUSR2Ch(),
 			}, nil
 		},
@@ -86,6 +88,14 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 			&authenticate.LdapCommand{
 				Command: base.NewCommand(ui, opts...),
 			}),
+		"authenticate saml": clientCacheWrapper(
+			&authenticate.SamlCommand{
+				Command: base.NewCommand(ui, opts...),
+			}),
+		"authenticate cert": clientCacheWrapper(
+			&authenticate.CertCommand{
+				Command: base.NewCommand(ui, opts...),
+			}),
 
 		"accounts": func() (cli.Command, error) {
 			return &accountscmd.Command{
@@ -117,6 +127,21 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "change-password",
 			}),
+		"accounts enroll-totp": clientCacheWrapper(
+			&accountscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "enroll-totp",
+			}),
+		"accounts verify-totp": clientCacheWrapper(
+			&accountscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "verify-totp",
+			}),
+		"accounts remove-totp": clientCacheWrapper(
+			&accountscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "remove-totp",
+			}),
 		"accounts create": clientCacheWrapper(
 			&accountscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -137,6 +162,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "create",
 			}),
+		"accounts create saml": clientCacheWrapper(
+			&accountscmd.SamlCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}),
+		"accounts create cert": clientCacheWrapper(
+			&accountscmd.CertCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}),
 		"accounts update": clientCacheWrapper(
 			&accountscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -157,6 +192,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "update",
 			}),
+		"accounts update saml": clientCacheWrapper(
+			&accountscmd.SamlCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}),
+		"accounts update cert": clientCacheWrapper(
+			&accountscmd.CertCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}),
 
 		"aliases": func() (cli.Command, error) {
 			return &aliasescmd.Command{
@@ -199,6 +244,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}),
 
+		"apply": clientCacheWrapper(
+			&declarative.ApplyCommand{
+				Command: base.NewCommand(ui, opts...),
+			}),
+
 		"auth-methods": func() (cli.Command, error) {
 			return &authmethodscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -239,6 +289,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "create",
 			}),
+		"auth-methods create saml": clientCacheWrapper(
+			&authmethodscmd.SamlCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}),
+		"auth-methods create cert": clientCacheWrapper(
+			&authmethodscmd.CertCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}),
 		"auth-methods update": clientCacheWrapper(
 			&authmethodscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -259,11 +319,31 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "update",
 			}),
+		"auth-methods update saml": clientCacheWrapper(
+			&authmethodscmd.SamlCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}),
+		"auth-methods update cert": clientCacheWrapper(
+			&authmethodscmd.CertCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}),
 		"auth-methods change-state oidc": clientCacheWrapper(
 			&authmethodscmd.OidcCommand{
 				Command: base.NewCommand(ui, opts...),
 				Func:    "change-state",
 			}),
+		"auth-methods change-state saml": clientCacheWrapper(
+			&authmethodscmd.SamlCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "change-state",
+			}),
+		"auth-methods change-state cert": clientCacheWrapper(
+			&authmethodscmd.CertCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "change-state",
+			}),
 
 		"auth-tokens": func() (cli.Command, error) {
 			return &authtokenscmd.Command{
@@ -406,6 +486,10 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "list",
 			}),
+		"credential-libraries list-issuances": clientCacheWrapper(
+			&credentiallibrariescmd.ListIssuancesCommand{
+				Command: base.NewCommand(ui, opts...),
+			}),
 		"credential-libraries create": clientCacheWrapper(
 			&credentiallibrariescmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -426,6 +510,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "create",
 			}),
+		"credential-libraries create file": clientCacheWrapper(
+			&credentiallibrariescmd.FileCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}),
 		"credential-libraries update": clientCacheWrapper(
 			&credentiallibrariescmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -446,6 +535,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "update",
 			}),
+		"credential-libraries update file": clientCacheWrapper(
+			&credentiallibrariescmd.FileCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}),
 
 		"credential-stores": func() (cli.Command, error) {
 			return &credentialstorescmd.Command{
@@ -482,6 +576,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "create",
 			}),
+		"credential-stores create file": clientCacheWrapper(
+			&credentialstorescmd.FileCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}),
 		"credential-stores update": clientCacheWrapper(
 			&credentialstorescmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -497,6 +596,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "update",
 			}),
+		"credential-stores update file": clientCacheWrapper(
+			&credentialstorescmd.FileCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}),
 
 		"credentials": func() (cli.Command, error) {
 			return &credentialscmd.Command{
@@ -518,6 +622,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "list",
 			}),
+		"credentials list-issuances": clientCacheWrapper(
+			&credentialscmd.ListIssuancesCommand{
+				Command: base.NewCommand(ui, opts...),
+			}),
+		"credentials list-versions": clientCacheWrapper(
+			&credentialscmd.ListVersionsCommand{
+				Command: base.NewCommand(ui, opts...),
+			}),
+		"credentials rollback": clientCacheWrapper(
+			&credentialscmd.RollbackCommand{
+				Command: base.NewCommand(ui, opts...),
+			}),
 		"credentials create": clientCacheWrapper(
 			&credentialscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -533,6 +649,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "create",
 			}),
+		"credentials create tls-client-certificate": clientCacheWrapper(
+			&credentialscmd.TlsClientCertificateCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}),
+		"credentials create kubeconfig": clientCacheWrapper(
+			&credentialscmd.KubeconfigCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}),
 		"credentials create json": clientCacheWrapper(
 			&credentialscmd.JsonCommand{
 				Command: base.NewCommand(ui, opts...),
@@ -553,6 +679,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "update",
 			}),
+		"credentials update tls-client-certificate": clientCacheWrapper(
+			&credentialscmd.TlsClientCertificateCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}),
+		"credentials update kubeconfig": clientCacheWrapper(
+			&credentialscmd.KubeconfigCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}),
 		"credentials update json": clientCacheWrapper(
 			&credentialscmd.JsonCommand{
 				Command: base.NewCommand(ui, opts...),
@@ -573,6 +709,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 			}, nil
 		},
 
+		"export": clientCacheWrapper(
+			&declarative.ExportCommand{
+				Command: base.NewCommand(ui, opts...),
+			}),
+
 		"groups": func() (cli.Command, error) {
 			return &groupscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -818,6 +959,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "create",
 			}),
+		"managed-groups create saml": clientCacheWrapper(
+			&managedgroupscmd.SamlCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}),
 		"managed-groups update": clientCacheWrapper(
 			&managedgroupscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -833,6 +979,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "update",
 			}),
+		"managed-groups update saml": clientCacheWrapper(
+			&managedgroupscmd.SamlCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}),
 
 		"policies": func() (cli.Command, error) {
 			return &policiescmd.Command{
@@ -1248,6 +1399,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "remove-accounts",
 			}),
+		"users revoke-auth-tokens": clientCacheWrapper(
+			&userscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "revoke-auth-tokens",
+			}),
 
 		"workers": func() (cli.Command, error) {
 			return &workerscmd.Command{
@@ -1304,6 +1460,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 				Func:    "remove-worker-tags",
 			}),
+		"workers drain": clientCacheWrapper(
+			&workerscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "drain",
+			}),
 		"workers certificate-authority": clientCacheWrapper(
 			&workerscmd.WorkerCACommand{
 				Command: base.NewCommand(ui, opts...),
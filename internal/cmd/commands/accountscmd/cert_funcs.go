@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package accountscmd
+
+import (
+	"github.com/hashicorp/boundary/api/accounts"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func init() {
+	extraCertActionsFlagsMapFunc = extraCertActionsFlagsMapFuncImpl
+	extraCertFlagsFunc = extraCertFlagsFuncImpl
+	extraCertFlagsHandlingFunc = extraCertFlagsHandlingFuncImpl
+}
+
+func extraCertActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {subjectFlagName},
+	}
+}
+
+type extraCertCmdVars struct {
+	flagSubject string
+}
+
+func (c *CertCommand) extraCertHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts create cert [options] [args]",
+			"",
+			"  Create a cert-type account. Accounts are also created automatically the first time a client certificate authenticates. Example:",
+			"",
+			`    $ boundary accounts create cert -subject "prodops" -description "Cert account for ProdOps"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts update cert [options] [args]",
+			"",
+			"  Update a cert-type account given its ID. Example:",
+			"",
+			`    $ boundary accounts update cert -id acctcert_1234567890 -name "devops" -description "Cert account for DevOps"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraCertFlagsFuncImpl(c *CertCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("Cert Account Options")
+
+	for _, name := range flagsCertMap[c.Func] {
+		switch name {
+		case subjectFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   subjectFlagName,
+				Target: &c.flagSubject,
+				Usage:  "The value of the auth method's account field in the client certificates for this account.",
+			})
+		}
+	}
+}
+
+func extraCertFlagsHandlingFuncImpl(c *CertCommand, _ *base.FlagSets, opts *[]accounts.Option) bool {
+	switch c.flagSubject {
+	case "null", "":
+		if c.Func == "create" {
+			c.UI.Error("Subject must be passed in via -subject")
+			return false
+		}
+	default:
+		if c.Func != "create" {
+			c.UI.Error("-subject can only be set when creating a cert account")
+			return false
+		}
+		*opts = append(*opts, accounts.WithCertAccountSubject(c.flagSubject))
+	}
+	return true
+}
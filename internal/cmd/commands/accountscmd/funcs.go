@@ -24,18 +24,24 @@ func init() {
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
 	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
 }
 
 type extraCmdVars struct {
 	flagPassword        string
 	flagCurrentPassword string
 	flagNewPassword     string
+	flagCode            string
+	enrollTotpResult    *accounts.EnrollTotpResult
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"change-password": {"id", "current-password", "new-password", "version"},
 		"set-password":    {"id", "password", "version"},
+		"enroll-totp":     {"id"},
+		"verify-totp":     {"id", "code"},
+		"remove-totp":     {"id"},
 	}
 }
 
@@ -47,6 +53,15 @@ func extraSynopsisFuncImpl(c *Command) string {
 	case "set-password":
 		return "Directly set the password on an account"
 
+	case "enroll-totp":
+		return "Enroll an account in TOTP multi-factor authentication"
+
+	case "verify-totp":
+		return "Verify the pending TOTP enrollment of an account"
+
+	case "remove-totp":
+		return "Remove the TOTP enrollment of an account"
+
 	default:
 		return ""
 	}
@@ -91,6 +106,42 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			"",
 		})
+	case "enroll-totp":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts enroll-totp [options] [args]",
+			"",
+			"  This command creates a pending TOTP enrollment for a password-type account. The returned secret and recovery codes are only shown once. The enrollment must be completed with the verify-totp command. Example:",
+			"",
+			"    Enroll a password-type account in TOTP:",
+			"",
+			`      $ boundary accounts enroll-totp -id acctpw_1234567890`,
+			"",
+			"",
+		})
+	case "verify-totp":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts verify-totp [options] [args]",
+			"",
+			"  This command completes the pending TOTP enrollment of a password-type account using a code generated by an authenticator app. Example:",
+			"",
+			"    Verify the TOTP enrollment of a password-type account:",
+			"",
+			`      $ boundary accounts verify-totp -id acctpw_1234567890 -code <empty, to be read by stdin>`,
+			"",
+			"",
+		})
+	case "remove-totp":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts remove-totp [options] [args]",
+			"",
+			"  This command removes the TOTP enrollment and recovery codes of a password-type account. Example:",
+			"",
+			"    Remove the TOTP enrollment of a password-type account:",
+			"",
+			`      $ boundary accounts remove-totp -id acctpw_1234567890`,
+			"",
+			"",
+		})
 	}
 	return helpStr + c.Flags().Help()
 }
@@ -116,6 +167,12 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagNewPassword,
 				Usage:  "The new password for the account. If not specified, the command will prompt for the password to be entered in a non-echoing way.",
 			})
+		case "code":
+			f.StringVar(&base.StringVar{
+				Name:   "code",
+				Target: &c.flagCode,
+				Usage:  "A TOTP code generated by an authenticator app. If not specified, the command will prompt for the code.",
+			})
 		}
 	}
 }
@@ -224,6 +281,17 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]accounts.O
 		}
 	}
 
+	if strutil.StrListContains(flagsMap[c.Func], "code") && c.flagCode == "" {
+		fmt.Print("Please enter the TOTP code: ")
+		value, err := password.Read(os.Stdin)
+		fmt.Print("\n")
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("An error occurred attempting to read the code. The raw error message is shown below but usually this is because you attempted to pipe a value into the command or you are executing outside of a terminal (TTY). The raw error was:\n\n%s", err.Error()))
+			return false
+		}
+		c.flagCode = strings.TrimSpace(value)
+	}
+
 	return true
 }
 
@@ -241,10 +309,60 @@ func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *accou
 			return nil, nil, nil, err
 		}
 		return result.GetResponse(), result.GetItem(), nil, err
+	case "enroll-totp":
+		var err error
+		c.enrollTotpResult, err = accountClient.EnrollTotp(c.Context, c.FlagId, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return c.enrollTotpResult.GetResponse(), nil, nil, err
+	case "verify-totp":
+		result, err := accountClient.VerifyTotp(c.Context, c.FlagId, c.flagCode, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return result.GetResponse(), result.GetItem(), nil, err
+	case "remove-totp":
+		result, err := accountClient.RemoveTotp(c.Context, c.FlagId, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return result.GetResponse(), result.GetItem(), nil, err
 	}
 	return origResp, origItem, origItems, origError
 }
 
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "enroll-totp":
+		switch base.Format(c.UI) {
+		case "table":
+			ret := []string{
+				"",
+				"TOTP enrollment information:",
+				fmt.Sprintf("  Secret:          %s", c.enrollTotpResult.Secret),
+				fmt.Sprintf("  URL:             %s", c.enrollTotpResult.Url),
+				"",
+				"  Recovery Codes:",
+				base.WrapSlice(4, c.enrollTotpResult.RecoveryCodes),
+				"",
+				"  The secret and recovery codes will not be shown again. Add the secret to",
+				"  an authenticator app and run verify-totp to complete the enrollment.",
+			}
+			c.UI.Output(base.WrapForHelpText(ret))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.enrollTotpResult.GetResponse()); !ok {
+				return false, fmt.Errorf("error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (c *Command) printListTable(items []*accounts.Account) string {
 	if len(items) == 0 {
 		return "No accounts found"
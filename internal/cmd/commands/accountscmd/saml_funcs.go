@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package accountscmd
+
+import (
+	"github.com/hashicorp/boundary/api/accounts"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func init() {
+	extraSamlActionsFlagsMapFunc = extraSamlActionsFlagsMapFuncImpl
+	extraSamlFlagsFunc = extraSamlFlagsFuncImpl
+	extraSamlFlagsHandlingFunc = extraSamlFlagsHandlingFuncImpl
+}
+
+func extraSamlActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {subjectFlagName, issuerFlagName},
+	}
+}
+
+type extraSamlCmdVars struct {
+	flagIssuer  string
+	flagSubject string
+}
+
+func (c *SamlCommand) extraSamlHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts create saml [options] [args]",
+			"",
+			"  Create a saml-type account. Example:",
+			"",
+			`    $ boundary accounts create saml -subject "prodops" -description "Saml account for ProdOps"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts update saml [options] [args]",
+			"",
+			"  Update a saml-type account given its ID. Example:",
+			"",
+			`    $ boundary accounts update saml -id acctsaml_1234567890 -name "devops" -description "Saml account for DevOps"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraSamlFlagsFuncImpl(c *SamlCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("Saml Account Options")
+
+	for _, name := range flagsSamlMap[c.Func] {
+		switch name {
+		case issuerFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   issuerFlagName,
+				Target: &c.flagIssuer,
+				Usage:  "The entity id of the identity provider which issued the account's assertions.",
+			})
+		case subjectFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   subjectFlagName,
+				Target: &c.flagSubject,
+				Usage:  "The NameID of the subject for this account on the SAML identity provider.",
+			})
+		}
+	}
+}
+
+func extraSamlFlagsHandlingFuncImpl(c *SamlCommand, _ *base.FlagSets, opts *[]accounts.Option) bool {
+	switch c.flagSubject {
+	case "null", "":
+		if c.Func == "create" {
+			c.UI.Error("Subject must be passed in via -subject")
+			return false
+		}
+	default:
+		if c.Func != "create" {
+			c.UI.Error("-subject can only be set when creating a saml account")
+			return false
+		}
+		*opts = append(*opts, accounts.WithSamlAccountSubject(c.flagSubject))
+	}
+
+	switch c.flagIssuer {
+	case "":
+	case "null":
+	default:
+		if c.Func != "create" {
+			c.UI.Error("-issuer can only be set when creating a saml account")
+			return false
+		}
+		*opts = append(*opts, accounts.WithSamlAccountIssuer(c.flagIssuer))
+	}
+	return true
+}
@@ -52,6 +52,14 @@ func (c *Command) Help() string {
 		"",
 		"      $ boundary authenticate ldap -auth-method-id amldap_1234567890",
 		"",
+		"    Authenticate with a SAML auth method:",
+		"",
+		"      $ boundary authenticate saml -auth-method-id amsaml_1234567890",
+		"",
+		"    Authenticate with a cert auth method:",
+		"",
+		"      $ boundary authenticate cert -auth-method-id amcert_1234567890 -client-cert client.pem -client-key client-key.pem",
+		"",
 		"  Please see the auth method subcommand help for detailed usage information.",
 	}) + c.Flags().Help()
 }
@@ -118,8 +126,18 @@ func (c *Command) Run(args []string) int {
 		cmd.Opts = append(c.Opts, base.WithSkipScopeIdFlag(true))
 		result = cmd.Run([]string{})
 
+	case strings.HasPrefix(c.FlagAuthMethodId, globals.SamlAuthMethodPrefix):
+		cmd := SamlCommand{Command: c.Command}
+		cmd.Opts = append(c.Opts, base.WithSkipScopeIdFlag(true))
+		result = cmd.Run([]string{})
+
+	case strings.HasPrefix(c.FlagAuthMethodId, globals.CertAuthMethodPrefix):
+		cmd := CertCommand{Command: c.Command}
+		cmd.Opts = append(c.Opts, base.WithSkipScopeIdFlag(true))
+		result = cmd.Run([]string{})
+
 	default:
-		c.PrintCliError(fmt.Errorf("The primary auth method was of an unsupported type. The given ID was %s; only 'ampw' (password), 'amoidc' (OIDC) and 'amldap' (LDAP) auth method prefixes are supported.", c.FlagAuthMethodId))
+		c.PrintCliError(fmt.Errorf("The primary auth method was of an unsupported type. The given ID was %s; only 'ampw' (password), 'amoidc' (OIDC), 'amldap' (LDAP), 'amsaml' (SAML) and 'amcert' (cert) auth method prefixes are supported.", c.FlagAuthMethodId))
 		return cli.RunResultHelp
 	}
 
@@ -29,6 +29,8 @@ var (
 type OidcCommand struct {
 	*base.Command
 
+	flagDevice bool
+
 	parsedOpts base.Options
 }
 
@@ -44,6 +46,11 @@ func (c *OidcCommand) Help() string {
 		"",
 		`    $ boundary authenticate oidc -auth-method-id amoidc_1234567890`,
 		"",
+		"  On a machine without a browser, use the device authorization grant and",
+		"  complete the authentication on another device. Example:",
+		"",
+		`    $ boundary authenticate oidc -auth-method-id amoidc_1234567890 -device`,
+		"",
 		"",
 	}) + c.Flags().Help()
 }
@@ -59,6 +66,12 @@ func (c *OidcCommand) Flags() *base.FlagSets {
 		Usage:  "The auth-method resource to use for the operation",
 	})
 
+	f.BoolVar(&base.BoolVar{
+		Name:   "device",
+		Target: &c.flagDevice,
+		Usage:  "Use the OAuth 2.0 device authorization grant instead of opening a browser. A user code and verification URL are printed, which can be used to authenticate on another device.",
+	})
+
 	if !c.parsedOpts.WithSkipScopeIdFlag {
 		f.StringVar(&base.StringVar{
 			Name:   "scope-id",
@@ -118,7 +131,11 @@ func (c *OidcCommand) Run(args []string) int {
 		c.FlagAuthMethodId = pri
 	}
 
-	result, err := aClient.Authenticate(c.Context, c.FlagAuthMethodId, "start", nil)
+	var startAttrs map[string]any
+	if c.flagDevice {
+		startAttrs = map[string]any{"device_authorization": true}
+	}
+	result, err := aClient.Authenticate(c.Context, c.FlagAuthMethodId, "start", startAttrs)
 	if err != nil {
 		if apiErr := api.AsServerError(err); apiErr != nil {
 			c.PrintApiError(apiErr, "Error from controller when performing authentication start")
@@ -134,14 +151,32 @@ func (c *OidcCommand) Run(args []string) int {
 		return base.CommandCliError
 	}
 
-	if base.Format(c.UI) == "table" {
-		c.UI.Output("Opening returned authentication URL in your browser...")
-		c.UI.Output(startResp.AuthUrl)
-	}
-	if err := util.OpenURL(startResp.AuthUrl); err != nil {
-		c.UI.Error(fmt.Errorf("Unable to open authentication URL in browser: %w", err).Error())
-		c.UI.Warn("Please copy and paste this link into a browser manually:")
-		c.UI.Output(startResp.AuthUrl)
+	pollInterval := 1500 * time.Millisecond
+	switch {
+	case c.flagDevice:
+		if startResp.UserCode == "" || startResp.VerificationUri == "" {
+			c.PrintCliError(errors.New("Authenticate start response is missing the device user code or verification URL."))
+			return base.CommandCliError
+		}
+		// The device authorization is shown regardless of the output format,
+		// since the user can't authenticate without it.
+		c.UI.Warn(fmt.Sprintf("To authenticate, open %s on another device and enter the code: %s", startResp.VerificationUri, startResp.UserCode))
+		if startResp.VerificationUriComplete != "" {
+			c.UI.Warn(fmt.Sprintf("Or open: %s", startResp.VerificationUriComplete))
+		}
+		if startResp.Interval > 0 {
+			pollInterval = time.Duration(startResp.Interval) * time.Second
+		}
+	default:
+		if base.Format(c.UI) == "table" {
+			c.UI.Output("Opening returned authentication URL in your browser...")
+			c.UI.Output(startResp.AuthUrl)
+		}
+		if err := util.OpenURL(startResp.AuthUrl); err != nil {
+			c.UI.Error(fmt.Errorf("Unable to open authentication URL in browser: %w", err).Error())
+			c.UI.Warn("Please copy and paste this link into a browser manually:")
+			c.UI.Output(startResp.AuthUrl)
+		}
 	}
 
 	var watchCode int
@@ -156,7 +191,7 @@ func (c *OidcCommand) Run(args []string) int {
 				watchCode = base.CommandCliError
 				return
 
-			case <-time.After(1500 * time.Millisecond):
+			case <-time.After(pollInterval):
 				result, err = aClient.Authenticate(c.Context, c.FlagAuthMethodId, "token", map[string]any{
 					"token_id": startResp.TokenId,
 				})
@@ -171,7 +206,14 @@ func (c *OidcCommand) Run(args []string) int {
 					return
 				}
 				if result.GetResponse().StatusCode() == http.StatusAccepted {
-					// Nothing yet -- circle around.
+					// Nothing yet -- circle around. The device authorization
+					// grant's interval may have been increased by the provider.
+					var tokenResp struct {
+						Interval uint32 `json:"interval"`
+					}
+					if err := json.Unmarshal(result.GetRawAttributes(), &tokenResp); err == nil && tokenResp.Interval > 0 {
+						pollInterval = time.Duration(tokenResp.Interval) * time.Second
+					}
 					continue
 				}
 				return
@@ -4,12 +4,14 @@
 package authenticate
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"strings"
 
 	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/accounts"
 	"github.com/hashicorp/boundary/api/authmethods"
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/cmd/base"
@@ -28,13 +30,26 @@ var (
 var (
 	envPassword  = "BOUNDARY_AUTHENTICATE_PASSWORD_PASSWORD"
 	envLoginName = "BOUNDARY_AUTHENTICATE_PASSWORD_LOGIN_NAME"
+	envTotpCode  = "BOUNDARY_AUTHENTICATE_PASSWORD_TOTP_CODE"
+)
+
+const (
+	// totpCodeField is the request field the controller reports as missing
+	// when the account requires a TOTP code to authenticate.
+	totpCodeField = "attributes.totp_code"
+
+	// newPasswordField is the request field the controller reports as
+	// missing when the password of the account has expired.
+	newPasswordField = "attributes.new_password"
 )
 
 type PasswordCommand struct {
 	*base.Command
 
-	flagLoginName string
-	flagPassword  string
+	flagLoginName  string
+	flagPassword   string
+	flagTotpCode   string
+	flagEnrollTotp bool
 
 	parsedOpts base.Options
 }
@@ -73,6 +88,19 @@ func (c *PasswordCommand) Flags() *base.FlagSets {
 		Usage:  "The password associated with the login name. If blank, the command will prompt for the password to be entered interactively in a non-echoing way. Otherwise, this can refer to a file on disk (file://) from which a password will be read or an env var (env://) from which the password will be read.",
 	})
 
+	f.StringVar(&base.StringVar{
+		Name:   "totp-code",
+		Target: &c.flagTotpCode,
+		EnvVar: envTotpCode,
+		Usage:  "A TOTP code or recovery code for accounts enrolled in TOTP. If blank and the account requires a code, the command will prompt for it.",
+	})
+
+	f.BoolVar(&base.BoolVar{
+		Name:   "enroll-totp",
+		Target: &c.flagEnrollTotp,
+		Usage:  "Enroll the account in TOTP before authenticating. This is required the first time an account authenticates with an auth method which requires TOTP. The secret and recovery codes are printed and the command prompts for a TOTP code to complete the enrollment unless -totp-code is set.",
+	})
+
 	f.StringVar(&base.StringVar{
 		Name:   "auth-method-id",
 		EnvVar: "BOUNDARY_AUTH_METHOD_ID",
@@ -178,11 +206,91 @@ func (c *PasswordCommand) Run(args []string) int {
 		c.flagPassword = password
 	}
 
-	result, err := aClient.Authenticate(c.Context, c.FlagAuthMethodId, "login",
-		map[string]any{
-			"login_name": c.flagLoginName,
-			"password":   c.flagPassword,
-		})
+	attributes := map[string]any{
+		"login_name": c.flagLoginName,
+		"password":   c.flagPassword,
+	}
+	if c.flagEnrollTotp {
+		result, err := aClient.Authenticate(c.Context, c.FlagAuthMethodId, "enroll-totp", attributes)
+		if err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.PrintApiError(apiErr, "Error from controller when enrolling in TOTP")
+				return base.CommandApiError
+			}
+			c.PrintCliError(fmt.Errorf("Error trying to enroll in TOTP: %w", err))
+			return base.CommandCliError
+		}
+		enrollment := new(accounts.EnrollTotpResult)
+		if err := json.Unmarshal(result.GetRawAttributes(), enrollment); err != nil {
+			c.PrintCliError(fmt.Errorf("Error trying to decode TOTP enrollment: %w", err))
+			return base.CommandCliError
+		}
+		// The enrollment is shown regardless of the output format, since
+		// the account can't authenticate without it.
+		c.UI.Warn(base.WrapForHelpText([]string{
+			"",
+			"TOTP enrollment information:",
+			fmt.Sprintf("  Secret:          %s", enrollment.Secret),
+			fmt.Sprintf("  URL:             %s", enrollment.Url),
+			"",
+			"  Recovery Codes:",
+			base.WrapSlice(4, enrollment.RecoveryCodes),
+			"",
+			"  The secret and recovery codes will not be shown again. Add the secret to",
+			"  an authenticator app and enter a code from it to complete the enrollment.",
+			"",
+		}))
+		if c.flagTotpCode == "" {
+			fmt.Print("Please enter the TOTP code (it will be hidden): ")
+			value, err := password.Read(os.Stdin)
+			fmt.Print("\n")
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("An error occurred attempting to read the TOTP code. The raw error message is shown below but usually this is because you attempted to pipe a value into the command or you are executing outside of a terminal (TTY). The raw error was:\n\n%s", err.Error()))
+				return base.CommandUserError
+			}
+			c.flagTotpCode = strings.TrimSpace(value)
+		}
+	}
+	if c.flagTotpCode != "" {
+		attributes["totp_code"] = c.flagTotpCode
+	}
+	result, err := aClient.Authenticate(c.Context, c.FlagAuthMethodId, "login", attributes)
+	// The password is checked before the TOTP code, so an expired password
+	// is reported first.
+	if err != nil && fieldRequired(err, newPasswordField) {
+		c.UI.Warn("The password has expired and must be changed.")
+		fmt.Print("Please enter a new password (it will be hidden): ")
+		value, readErr := password.Read(os.Stdin)
+		fmt.Print("\n")
+		if readErr != nil {
+			c.UI.Error(fmt.Sprintf("An error occurred attempting to read the new password. The raw error message is shown below but usually this is because you attempted to pipe a value into the command or you are executing outside of a terminal (TTY). The raw error was:\n\n%s", readErr.Error()))
+			return base.CommandUserError
+		}
+		fmt.Print("Please enter the new password again (it will be hidden): ")
+		confirm, readErr := password.Read(os.Stdin)
+		fmt.Print("\n")
+		if readErr != nil {
+			c.UI.Error(fmt.Sprintf("An error occurred attempting to read the new password. The raw error message is shown below but usually this is because you attempted to pipe a value into the command or you are executing outside of a terminal (TTY). The raw error was:\n\n%s", readErr.Error()))
+			return base.CommandUserError
+		}
+		if strings.TrimSpace(value) != strings.TrimSpace(confirm) {
+			c.UI.Error("The new passwords do not match.")
+			return base.CommandUserError
+		}
+		attributes["new_password"] = strings.TrimSpace(value)
+		result, err = aClient.Authenticate(c.Context, c.FlagAuthMethodId, "login", attributes)
+	}
+	if err != nil && c.flagTotpCode == "" && fieldRequired(err, totpCodeField) {
+		fmt.Print("Please enter the TOTP code or a recovery code (it will be hidden): ")
+		value, readErr := password.Read(os.Stdin)
+		fmt.Print("\n")
+		if readErr != nil {
+			c.UI.Error(fmt.Sprintf("An error occurred attempting to read the TOTP code. The raw error message is shown below but usually this is because you attempted to pipe a value into the command or you are executing outside of a terminal (TTY). The raw error was:\n\n%s", readErr.Error()))
+			return base.CommandUserError
+		}
+		attributes["totp_code"] = strings.TrimSpace(value)
+		result, err = aClient.Authenticate(c.Context, c.FlagAuthMethodId, "login", attributes)
+	}
 	if err != nil {
 		if apiErr := api.AsServerError(err); apiErr != nil {
 			c.PrintApiError(apiErr, "Error from controller when performing authentication")
@@ -194,3 +302,19 @@ func (c *PasswordCommand) Run(args []string) int {
 
 	return saveAndOrPrintToken(c.Command, result, c.Opts...)
 }
+
+// fieldRequired reports whether err is the error returned by the controller
+// when the request is missing field, such as the TOTP code of an account
+// enrolled in TOTP.
+func fieldRequired(err error, field string) bool {
+	apiErr := api.AsServerError(err)
+	if apiErr == nil || apiErr.Details == nil {
+		return false
+	}
+	for _, f := range apiErr.Details.RequestFields {
+		if f.Name == field {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package authmethodscmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraCertActionsFlagsMapFunc = extraCertActionsFlagsMapFuncImpl
+	extraCertFlagsFunc = extraCertFlagsFuncImpl
+	extraCertFlagsHandlingFunc = extraCertFlagHandlingFuncImpl
+	executeExtraCertActions = executeExtraCertActionsImpl
+}
+
+type extraCertCmdVars struct {
+	flagState          string
+	flagCaCertificates []string
+	flagAccountField   string
+	tokenPolicyCmdVars
+}
+
+const (
+	caCertificateFlagName = "ca-certificate"
+	accountFieldFlagName  = "account-field"
+)
+
+func extraCertActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			caCertificateFlagName,
+			accountFieldFlagName,
+			authTokenTimeToLiveFlagName,
+			authTokenTimeToStaleFlagName,
+			maxAuthTokensPerUserFlagName,
+		},
+		"change-state": {
+			idFlagName,
+			stateFlagName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraCertFlagsFuncImpl(c *CertCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("Cert Auth Method Options")
+
+	for _, name := range flagsCertMap[c.Func] {
+		switch name {
+		case caCertificateFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   caCertificateFlagName,
+				Target: &c.flagCaCertificates,
+				Usage:  "PEM-encoded X.509 CA certificate used to verify client certificates. May be specified multiple times.",
+			})
+		case accountFieldFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   accountFieldFlagName,
+				Target: &c.flagAccountField,
+				Usage:  `The client certificate field mapped to the account subject. One of "subject-cn", "subject-dn", "san-email", "san-dns" or "san-uri". Defaults to "subject-cn".`,
+			})
+		case stateFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   stateFlagName,
+				Target: &c.flagState,
+				Usage:  "The desired operational state of the auth method.",
+			})
+		}
+	}
+
+	c.addTokenPolicyFlags(set, flagsCertMap[c.Func])
+}
+
+func (c *CertCommand) extraCertHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods create cert [options] [args]",
+			"",
+			"  Create a cert-type auth method. Example:",
+			"",
+			`    $ boundary auth-methods create cert -name prodops -ca-certificate file://ca.pem -account-field subject-cn`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods update cert [options] [args]",
+			"",
+			"  Update a cert-type auth method given its ID. Example:",
+			"",
+			`    $ boundary auth-methods update cert -id amcert_1234567890 -name "devops" -description "Cert auth-method for DevOps"`,
+			"",
+			"",
+		})
+
+	case "change-state":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods change-state cert [options] [args]",
+			"",
+			"  Change the active state of a cert-type auth method given its ID. Example:",
+			"",
+			`    $ boundary auth-methods change-state cert -id amcert_1234567890 -state "active-public"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraCertFlagHandlingFuncImpl(c *CertCommand, _ *base.FlagSets, opts *[]authmethods.Option) bool {
+	if c.Func == "change-state" {
+		return true
+	}
+
+	switch {
+	case len(c.flagCaCertificates) == 0:
+	case len(c.flagCaCertificates) == 1 && c.flagCaCertificates[0] == "null":
+		*opts = append(*opts, authmethods.DefaultCertAuthMethodCaCertificates())
+	default:
+		pems := make([]string, 0, len(c.flagCaCertificates))
+		for _, certFlag := range c.flagCaCertificates {
+			p, err := parseutil.MustParsePath(certFlag)
+			switch {
+			case err == nil:
+				if validationErr := validateCerts(p); validationErr != nil {
+					c.UI.Error(fmt.Sprintf("invalid certificate in %q: %s", certFlag, validationErr.Error()))
+					return false
+				}
+				pems = append(pems, p)
+			case errors.Is(err, parseutil.ErrNotParsed):
+				c.UI.Error("Certificate flag must be used with env:// or file:// syntax")
+				return false
+			default:
+				c.UI.Error(fmt.Sprintf("Error parsing certificate flag: %v", err))
+				return false
+			}
+		}
+		*opts = append(*opts, authmethods.WithCertAuthMethodCaCertificates(pems))
+	}
+
+	switch c.flagAccountField {
+	case "":
+	case "null":
+		*opts = append(*opts, authmethods.DefaultCertAuthMethodAccountField())
+	default:
+		*opts = append(*opts, authmethods.WithCertAuthMethodAccountField(c.flagAccountField))
+	}
+
+	return c.handleTokenPolicyFlags(c.UI, opts)
+}
+
+func executeExtraCertActionsImpl(c *CertCommand, origResp *api.Response, origItem *authmethods.AuthMethod, origError error, amClient *authmethods.Client, version uint32, opts []authmethods.Option) (*api.Response, *authmethods.AuthMethod, error) {
+	switch c.Func {
+	case "change-state":
+		result, err := amClient.ChangeState(c.Context, c.FlagId, version, c.flagState, opts...)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result.GetResponse(), result.GetItem(), nil
+	}
+	return origResp, origItem, origError
+}
@@ -159,6 +159,15 @@ func printItemTable(item *authmethods.AuthMethod, resp *api.Response) string {
 	if item.Description != "" {
 		nonAttributeMap["Description"] = item.Description
 	}
+	if item.AuthTokenTimeToLiveSeconds != 0 {
+		nonAttributeMap["Auth Token Time To Live Seconds"] = item.AuthTokenTimeToLiveSeconds
+	}
+	if item.AuthTokenTimeToStaleSeconds != 0 {
+		nonAttributeMap["Auth Token Time To Stale Seconds"] = item.AuthTokenTimeToStaleSeconds
+	}
+	if item.MaxAuthTokensPerUser != 0 {
+		nonAttributeMap["Max Auth Tokens Per User"] = item.MaxAuthTokensPerUser
+	}
 	if resp != nil && resp.Map != nil {
 		if resp.Map[globals.IsPrimaryField] != nil {
 			nonAttributeMap["Is Primary For Scope"] = item.IsPrimary
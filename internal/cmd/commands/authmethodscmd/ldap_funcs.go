@@ -47,6 +47,7 @@ type extraLdapCmdVars struct {
 	flagAccountAttributeMaps []string
 	flagMaxPageSize          uint
 	flagDerefAliases         string
+	tokenPolicyCmdVars
 }
 
 const (
@@ -100,6 +101,9 @@ func extraLdapActionsFlagsMapFuncImpl() map[string][]string {
 			stateFlagName,
 			maxPageSizeFlagName,
 			derefAliasesFlagName,
+			authTokenTimeToLiveFlagName,
+			authTokenTimeToStaleFlagName,
+			maxAuthTokensPerUserFlagName,
 		},
 	}
 	flags["update"] = flags["create"]
@@ -245,6 +249,8 @@ func extraLdapFlagsFuncImpl(c *LdapCommand, set *base.FlagSets, _ *base.FlagSet)
 			})
 		}
 	}
+
+	c.addTokenPolicyFlags(set, flagsLdapMap[c.Func])
 }
 
 func (c *LdapCommand) extraLdapHelpFunc(helpMap map[string]func() string) string {
@@ -536,7 +542,7 @@ func extraLdapFlagHandlingFuncImpl(c *LdapCommand, _ *base.FlagSets, opts *[]aut
 	default:
 		*opts = append(*opts, authmethods.WithLdapAuthMethodState(c.flagState))
 	}
-	return true
+	return c.handleTokenPolicyFlags(c.UI, opts)
 }
 
 func validateCerts(pems ...string) error {
@@ -34,6 +34,7 @@ type extraOidcCmdVars struct {
 	flagDisableDiscoveredConfigValidation bool
 	flagDryRun                            bool
 	flagPrompts                           []string
+	tokenPolicyCmdVars
 }
 
 const (
@@ -68,6 +69,9 @@ func extraOidcActionsFlagsMapFuncImpl() map[string][]string {
 			claimsScopes,
 			accountClaimMaps,
 			promptsFlagName,
+			authTokenTimeToLiveFlagName,
+			authTokenTimeToStaleFlagName,
+			maxAuthTokensPerUserFlagName,
 		},
 		"change-state": {
 			idFlagName,
@@ -170,6 +174,8 @@ func extraOidcFlagsFuncImpl(c *OidcCommand, set *base.FlagSets, _ *base.FlagSet)
 			})
 		}
 	}
+
+	c.addTokenPolicyFlags(set, flagsOidcMap[c.Func])
 }
 
 func (c *OidcCommand) extraOidcHelpFunc(helpMap map[string]func() string) string {
@@ -300,7 +306,7 @@ func extraOidcFlagHandlingFuncImpl(c *OidcCommand, f *base.FlagSets, opts *[]aut
 		*opts = append(*opts, authmethods.WithOidcAuthMethodPrompts(c.flagPrompts))
 	}
 
-	return true
+	return c.handleTokenPolicyFlags(c.UI, opts)
 }
 
 func executeExtraOidcActionsImpl(c *OidcCommand, origResp *api.Response, origItem *authmethods.AuthMethod, origError error, amClient *authmethods.Client, version uint32, opts []authmethods.Option) (*api.Response, *authmethods.AuthMethod, error) {
@@ -18,14 +18,60 @@ func init() {
 }
 
 type extraPasswordCmdVars struct {
-	flagMinLoginNameLength string
-	flagMinPasswordLength  string
+	flagMinLoginNameLength     string
+	flagMinPasswordLength      string
+	flagMinUppercase           string
+	flagMinLowercase           string
+	flagMinDigits              string
+	flagMinSymbols             string
+	flagPasswordHistoryCount   string
+	flagMaxPasswordAgeDays     string
+	flagMaxFailedAttempts      string
+	flagLockoutDurationSeconds string
+	flagTotpRequired           string
+	tokenPolicyCmdVars
+}
+
+// passwordPolicyFlag is a flag that sets one of the password policy
+// attributes of the auth method.
+type passwordPolicyFlag struct {
+	name      string
+	attribute string
+	usage     string
+	target    *string
+}
+
+func (c *PasswordCommand) passwordPolicyFlags() []passwordPolicyFlag {
+	return []passwordPolicyFlag{
+		{"min-uppercase", "min_uppercase", "The minimum number of uppercase letters in passwords", &c.flagMinUppercase},
+		{"min-lowercase", "min_lowercase", "The minimum number of lowercase letters in passwords", &c.flagMinLowercase},
+		{"min-digits", "min_digits", "The minimum number of digits in passwords", &c.flagMinDigits},
+		{"min-symbols", "min_symbols", "The minimum number of characters in passwords that are neither letters nor digits", &c.flagMinSymbols},
+		{"password-history-count", "password_history_count", "The number of most recent passwords, including the current one, that cannot be reused", &c.flagPasswordHistoryCount},
+		{"max-password-age-days", "max_password_age_days", "The number of days after which a password expires and must be changed", &c.flagMaxPasswordAgeDays},
+		{"max-failed-attempts", "max_failed_attempts", "The number of consecutive failed authentication attempts after which an account is locked", &c.flagMaxFailedAttempts},
+		{"lockout-duration-seconds", "lockout_duration_seconds", "The number of seconds an account remains locked. If zero, the account remains locked until its password is set", &c.flagLockoutDurationSeconds},
+	}
 }
 
 func extraPasswordActionsFlagsMapFuncImpl() map[string][]string {
+	flags := []string{
+		"min-login-name-length",
+		"min-password-length",
+		"min-uppercase",
+		"min-lowercase",
+		"min-digits",
+		"min-symbols",
+		"password-history-count",
+		"max-password-age-days",
+		"max-failed-attempts",
+		"lockout-duration-seconds",
+		"totp-required",
+	}
+	flags = append(flags, tokenPolicyFlagNames...)
 	return map[string][]string{
-		"create": {"min-login-name-length", "min-password-length"},
-		"update": {"min-login-name-length", "min-password-length"},
+		"create": flags,
+		"update": flags,
 	}
 }
 
@@ -74,8 +120,26 @@ func extraPasswordFlagsFuncImpl(c *PasswordCommand, set *base.FlagSets, _ *base.
 				Target: &c.flagMinPasswordLength,
 				Usage:  "The minimum length of passwords",
 			})
+		case "totp-required":
+			f.StringVar(&base.StringVar{
+				Name:   "totp-required",
+				Target: &c.flagTotpRequired,
+				Usage:  "If true, accounts must be enrolled in TOTP and provide a TOTP code to authenticate",
+			})
+		default:
+			for _, pf := range c.passwordPolicyFlags() {
+				if pf.name == name {
+					f.StringVar(&base.StringVar{
+						Name:   pf.name,
+						Target: pf.target,
+						Usage:  pf.usage,
+					})
+				}
+			}
 		}
 	}
+
+	c.addTokenPolicyFlags(set, flagsPasswordMap[c.Func])
 }
 
 func extraPasswordFlagHandlingFuncImpl(c *PasswordCommand, _ *base.FlagSets, opts *[]authmethods.Option) bool {
@@ -112,9 +176,37 @@ func extraPasswordFlagHandlingFuncImpl(c *PasswordCommand, _ *base.FlagSets, opt
 		addAttribute("min_password_length", uint32(length))
 	}
 
+	for _, pf := range c.passwordPolicyFlags() {
+		switch *pf.target {
+		case "":
+		case "null":
+			addAttribute(pf.attribute, nil)
+		default:
+			val, err := strconv.ParseUint(*pf.target, 10, 32)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", *pf.target, err))
+				return false
+			}
+			addAttribute(pf.attribute, uint32(val))
+		}
+	}
+
+	switch c.flagTotpRequired {
+	case "":
+	case "null":
+		addAttribute("totp_required", nil)
+	default:
+		required, err := strconv.ParseBool(c.flagTotpRequired)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagTotpRequired, err))
+			return false
+		}
+		addAttribute("totp_required", required)
+	}
+
 	if attributes != nil {
 		*opts = append(*opts, authmethods.WithAttributes(attributes))
 	}
 
-	return true
+	return c.handleTokenPolicyFlags(c.UI, opts)
 }
@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package authmethodscmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraSamlActionsFlagsMapFunc = extraSamlActionsFlagsMapFuncImpl
+	extraSamlFlagsFunc = extraSamlFlagsFuncImpl
+	extraSamlFlagsHandlingFunc = extraSamlFlagHandlingFuncImpl
+	executeExtraSamlActions = executeExtraSamlActionsImpl
+}
+
+type extraSamlCmdVars struct {
+	flagState                string
+	flagApiUrlPrefix         string
+	flagIdpEntityId          string
+	flagIdpSsoUrl            string
+	flagIdpCertificates      []string
+	flagSpEntityId           string
+	flagSignAuthnRequests    bool
+	flagNameIdFormat         string
+	flagAccountAttributeMaps []string
+	tokenPolicyCmdVars
+}
+
+const (
+	idpEntityIdFlagName       = "idp-entity-id"
+	idpSsoUrlFlagName         = "idp-sso-url"
+	idpCertificateFlagName    = "idp-certificate"
+	spEntityIdFlagName        = "sp-entity-id"
+	signAuthnRequestsFlagName = "sign-authn-requests"
+	nameIdFormatFlagName      = "name-id-format"
+)
+
+func extraSamlActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			apiUrlPrefixFlagName,
+			idpEntityIdFlagName,
+			idpSsoUrlFlagName,
+			idpCertificateFlagName,
+			spEntityIdFlagName,
+			signAuthnRequestsFlagName,
+			nameIdFormatFlagName,
+			accountAttributeMaps,
+			authTokenTimeToLiveFlagName,
+			authTokenTimeToStaleFlagName,
+			maxAuthTokensPerUserFlagName,
+		},
+		"change-state": {
+			idFlagName,
+			stateFlagName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraSamlFlagsFuncImpl(c *SamlCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("SAML Auth Method Options")
+
+	for _, name := range flagsSamlMap[c.Func] {
+		switch name {
+		case apiUrlPrefixFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   apiUrlPrefixFlagName,
+				Target: &c.flagApiUrlPrefix,
+				Usage:  "The URL prefix used by the identity provider to reach the assertion consumer service of the auth method.",
+			})
+		case idpEntityIdFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   idpEntityIdFlagName,
+				Target: &c.flagIdpEntityId,
+				Usage:  "The entity id of the identity provider.",
+			})
+		case idpSsoUrlFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   idpSsoUrlFlagName,
+				Target: &c.flagIdpSsoUrl,
+				Usage:  "The URL of the single sign-on service of the identity provider.",
+			})
+		case idpCertificateFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   idpCertificateFlagName,
+				Target: &c.flagIdpCertificates,
+				Usage:  "PEM-encoded X.509 certificate used to verify the signatures of the identity provider. May be specified multiple times.",
+			})
+		case spEntityIdFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   spEntityIdFlagName,
+				Target: &c.flagSpEntityId,
+				Usage:  "The entity id of Boundary as a service provider (optional). Defaults to the URL of the auth method.",
+			})
+		case signAuthnRequestsFlagName:
+			f.BoolVar(&base.BoolVar{
+				Name:   signAuthnRequestsFlagName,
+				Target: &c.flagSignAuthnRequests,
+				Usage:  "Sign the AuthnRequests sent to the identity provider (optional).",
+			})
+		case nameIdFormatFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   nameIdFormatFlagName,
+				Target: &c.flagNameIdFormat,
+				Usage:  "The NameID format requested from the identity provider (optional).",
+			})
+		case accountAttributeMaps:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   accountAttributeMaps,
+				Target: &c.flagAccountAttributeMaps,
+				Usage:  `The attribute maps from the SAML attribute to the account field, in the form "from=to". May be specified multiple times.`,
+			})
+		case stateFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   stateFlagName,
+				Target: &c.flagState,
+				Usage:  "The desired operational state of the auth method.",
+			})
+		}
+	}
+
+	c.addTokenPolicyFlags(set, flagsSamlMap[c.Func])
+}
+
+func (c *SamlCommand) extraSamlHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods create saml [options] [args]",
+			"",
+			"  Create a saml-type auth method. Example:",
+			"",
+			`    $ boundary auth-methods create saml -name prodops -idp-entity-id "https://idp.example.com" -idp-sso-url "https://idp.example.com/sso" -idp-certificate file://idp.pem -api-url-prefix "https://boundary.example.com"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods update saml [options] [args]",
+			"",
+			"  Update a saml-type auth method given its ID. Example:",
+			"",
+			`    $ boundary auth-methods update saml -id amsaml_1234567890 -name "devops" -description "SAML auth-method for DevOps"`,
+			"",
+			"",
+		})
+
+	case "change-state":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods change-state saml [options] [args]",
+			"",
+			"  Change the active state of a saml-type auth method given its ID. Example:",
+			"",
+			`    $ boundary auth-methods change-state saml -id amsaml_1234567890 -state "active-public"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraSamlFlagHandlingFuncImpl(c *SamlCommand, _ *base.FlagSets, opts *[]authmethods.Option) bool {
+	if c.Func == "change-state" {
+		return true
+	}
+
+	switch c.flagApiUrlPrefix {
+	case "":
+	case "null":
+		*opts = append(*opts, authmethods.DefaultSamlAuthMethodApiUrlPrefix())
+	default:
+		*opts = append(*opts, authmethods.WithSamlAuthMethodApiUrlPrefix(c.flagApiUrlPrefix))
+	}
+
+	switch c.flagIdpEntityId {
+	case "":
+	case "null":
+		*opts = append(*opts, authmethods.DefaultSamlAuthMethodIdpEntityId())
+	default:
+		*opts = append(*opts, authmethods.WithSamlAuthMethodIdpEntityId(c.flagIdpEntityId))
+	}
+
+	switch c.flagIdpSsoUrl {
+	case "":
+	case "null":
+		*opts = append(*opts, authmethods.DefaultSamlAuthMethodIdpSsoUrl())
+	default:
+		*opts = append(*opts, authmethods.WithSamlAuthMethodIdpSsoUrl(c.flagIdpSsoUrl))
+	}
+
+	switch {
+	case len(c.flagIdpCertificates) == 0:
+	case len(c.flagIdpCertificates) == 1 && c.flagIdpCertificates[0] == "null":
+		c.UI.Error(fmt.Sprintf("There must be at least one %q", idpCertificateFlagName))
+		return false
+	default:
+		pems := make([]string, 0, len(c.flagIdpCertificates))
+		for _, certFlag := range c.flagIdpCertificates {
+			p, err := parseutil.MustParsePath(certFlag)
+			switch {
+			case err == nil:
+				if validationErr := validateCerts(p); validationErr != nil {
+					c.UI.Error(fmt.Sprintf("invalid certificate in %q: %s", certFlag, validationErr.Error()))
+					return false
+				}
+				pems = append(pems, p)
+			case errors.Is(err, parseutil.ErrNotParsed):
+				c.UI.Error("Certificate flag must be used with env:// or file:// syntax")
+				return false
+			default:
+				c.UI.Error(fmt.Sprintf("Error parsing certificate flag: %v", err))
+				return false
+			}
+		}
+		*opts = append(*opts, authmethods.WithSamlAuthMethodIdpCertificates(pems))
+	}
+
+	switch c.flagSpEntityId {
+	case "":
+	case "null":
+		*opts = append(*opts, authmethods.DefaultSamlAuthMethodSpEntityId())
+	default:
+		*opts = append(*opts, authmethods.WithSamlAuthMethodSpEntityId(c.flagSpEntityId))
+	}
+
+	if c.flagSignAuthnRequests {
+		*opts = append(*opts, authmethods.WithSamlAuthMethodSignAuthnRequests(true))
+	}
+
+	switch c.flagNameIdFormat {
+	case "":
+	case "null":
+		*opts = append(*opts, authmethods.DefaultSamlAuthMethodNameIdFormat())
+	default:
+		*opts = append(*opts, authmethods.WithSamlAuthMethodNameIdFormat(c.flagNameIdFormat))
+	}
+
+	switch {
+	case len(c.flagAccountAttributeMaps) == 0:
+	case len(c.flagAccountAttributeMaps) == 1 && c.flagAccountAttributeMaps[0] == "null":
+		*opts = append(*opts, authmethods.DefaultSamlAuthMethodAccountAttributeMaps())
+	default:
+		*opts = append(*opts, authmethods.WithSamlAuthMethodAccountAttributeMaps(c.flagAccountAttributeMaps))
+	}
+
+	return c.handleTokenPolicyFlags(c.UI, opts)
+}
+
+func executeExtraSamlActionsImpl(c *SamlCommand, origResp *api.Response, origItem *authmethods.AuthMethod, origError error, amClient *authmethods.Client, version uint32, opts []authmethods.Option) (*api.Response, *authmethods.AuthMethod, error) {
+	switch c.Func {
+	case "change-state":
+		result, err := amClient.ChangeState(c.Context, c.FlagId, version, c.flagState, opts...)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result.GetResponse(), result.GetItem(), nil
+	}
+	return origResp, origItem, origError
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package authmethodscmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+)
+
+const (
+	authTokenTimeToLiveFlagName  = "auth-token-time-to-live-seconds"
+	authTokenTimeToStaleFlagName = "auth-token-time-to-stale-seconds"
+	maxAuthTokensPerUserFlagName = "max-auth-tokens-per-user"
+)
+
+// tokenPolicyFlagNames are the flags shared by every auth method subtype
+// which set the auth token policy of the auth method.
+var tokenPolicyFlagNames = []string{
+	authTokenTimeToLiveFlagName,
+	authTokenTimeToStaleFlagName,
+	maxAuthTokensPerUserFlagName,
+}
+
+type tokenPolicyCmdVars struct {
+	flagAuthTokenTimeToLiveSeconds  string
+	flagAuthTokenTimeToStaleSeconds string
+	flagMaxAuthTokensPerUser        string
+}
+
+// addTokenPolicyFlags adds the auth token policy flags included in names to
+// set.
+func (v *tokenPolicyCmdVars) addTokenPolicyFlags(set *base.FlagSets, names []string) {
+	var f *base.FlagSet
+	for _, name := range names {
+		var target *string
+		var usage string
+		switch name {
+		case authTokenTimeToLiveFlagName:
+			target = &v.flagAuthTokenTimeToLiveSeconds
+			usage = "The maximum lifetime in seconds of auth tokens issued by the auth method. If zero, the controller default is used."
+		case authTokenTimeToStaleFlagName:
+			target = &v.flagAuthTokenTimeToStaleSeconds
+			usage = "The number of seconds after which an unused auth token issued by the auth method expires. If zero, the controller default is used."
+		case maxAuthTokensPerUserFlagName:
+			target = &v.flagMaxAuthTokensPerUser
+			usage = "The maximum number of auth tokens a user can hold from the auth method. The oldest auth tokens are revoked when the limit is exceeded. If zero, the number is not limited."
+		default:
+			continue
+		}
+		if f == nil {
+			f = set.NewFlagSet("Auth Token Options")
+		}
+		f.StringVar(&base.StringVar{
+			Name:   name,
+			Target: target,
+			Usage:  usage,
+		})
+	}
+}
+
+// handleTokenPolicyFlags appends the options for the auth token policy
+// flags that were set to opts. It returns false if a flag value is invalid.
+func (v *tokenPolicyCmdVars) handleTokenPolicyFlags(ui cli.Ui, opts *[]authmethods.Option) bool {
+	for _, f := range []struct {
+		value      string
+		withOpt    func(uint32) authmethods.Option
+		defaultOpt func() authmethods.Option
+	}{
+		{v.flagAuthTokenTimeToLiveSeconds, authmethods.WithAuthTokenTimeToLiveSeconds, authmethods.DefaultAuthTokenTimeToLiveSeconds},
+		{v.flagAuthTokenTimeToStaleSeconds, authmethods.WithAuthTokenTimeToStaleSeconds, authmethods.DefaultAuthTokenTimeToStaleSeconds},
+		{v.flagMaxAuthTokensPerUser, authmethods.WithMaxAuthTokensPerUser, authmethods.DefaultMaxAuthTokensPerUser},
+	} {
+		switch f.value {
+		case "":
+		case "null":
+			*opts = append(*opts, f.defaultOpt())
+		default:
+			val, err := strconv.ParseUint(f.value, 10, 32)
+			if err != nil {
+				ui.Error(fmt.Sprintf("Error parsing %q: %s", f.value, err))
+				return false
+			}
+			*opts = append(*opts, f.withOpt(uint32(val)))
+		}
+	}
+	return true
+}
@@ -599,6 +599,47 @@ func (c *Command) updateConnsLeft(connsLeft int32) {
 	}
 }
 
+// writeTempCredentialFile writes contents to a new temporary file which is
+// removed when the command exits and returns the name of the file. desc is
+// used in error messages to describe the contents.
+func (c *Command) writeTempCredentialFile(desc, contents string) (string, error) {
+	f, err := os.CreateTemp("", "*")
+	if err != nil {
+		return "", fmt.Errorf("Error saving %s to tmp file: %w", desc, err)
+	}
+	c.cleanupFuncs = append(c.cleanupFuncs, func() error {
+		if err := os.Remove(f.Name()); err != nil {
+			return fmt.Errorf("Error removing temporary %s file; consider removing %s manually: %w", desc, f.Name(), err)
+		}
+		return nil
+	})
+	if _, err := f.WriteString(contents); err != nil {
+		return "", fmt.Errorf("Error writing %s file to %s: %w", desc, f.Name(), err)
+	}
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("Error closing %s file after writing to %s: %w", desc, f.Name(), err)
+	}
+	return f.Name(), nil
+}
+
+// writeTlsClientCertificateFiles writes the certificate, private key and, if
+// present, CA certificate of cred to temporary files and returns their names.
+// caFile is empty if cred does not contain a CA certificate.
+func (c *Command) writeTlsClientCertificateFiles(cred apiproxy.TlsClientCertificate) (certFile, keyFile, caFile string, retErr error) {
+	if certFile, retErr = c.writeTempCredentialFile("client certificate", cred.Certificate); retErr != nil {
+		return "", "", "", retErr
+	}
+	if keyFile, retErr = c.writeTempCredentialFile("client private key", cred.PrivateKey); retErr != nil {
+		return "", "", "", retErr
+	}
+	if cred.CaCertificate != "" {
+		if caFile, retErr = c.writeTempCredentialFile("CA certificate", cred.CaCertificate); retErr != nil {
+			return "", "", "", retErr
+		}
+	}
+	return certFile, keyFile, caFile, nil
+}
+
 func (c *Command) handleExec(clientProxy *apiproxy.ClientProxy, passthroughArgs []string) {
 	defer c.proxyCancel()
 
@@ -633,13 +674,14 @@ func (c *Command) handleExec(clientProxy *apiproxy.ClientProxy, passthroughArgs
 
 	switch c.Func {
 	case "http":
-		httpArgs, err := c.httpFlags.buildArgs(c, port, host, addr)
+		httpArgs, httpCreds, err := c.httpFlags.buildArgs(c, port, host, addr, creds)
 		if err != nil {
 			c.PrintCliError(fmt.Errorf("Error parsing session args: %w", err))
 			c.execCmdReturnValue.Store(int32(3))
 			return
 		}
 		args = append(args, httpArgs...)
+		creds = httpCreds
 
 	case "postgres":
 		pgArgs, pgEnvs, pgCreds, pgErr := c.postgresFlags.buildArgs(c, port, host, addr, creds)
@@ -665,13 +707,14 @@ func (c *Command) handleExec(clientProxy *apiproxy.ClientProxy, passthroughArgs
 		creds = sshCreds
 
 	case "kube":
-		kubeArgs, err := c.kubeFlags.buildArgs(c, port, host, addr)
+		kubeArgs, kubeCreds, err := c.kubeFlags.buildArgs(c, port, host, addr, creds)
 		if err != nil {
 			c.PrintCliError(fmt.Errorf("Error parsing session args: %w", err))
 			c.execCmdReturnValue.Store(int32(3))
 			return
 		}
 		args = append(args, kubeArgs...)
+		creds = kubeCreds
 	}
 
 	if argsErr != nil {
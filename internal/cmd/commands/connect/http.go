@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"strings"
 
+	apiproxy "github.com/hashicorp/boundary/api/proxy"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/posener/complete"
 )
@@ -74,19 +75,32 @@ func (h *httpFlags) defaultExec() string {
 	return strings.ToLower(h.flagHttpStyle)
 }
 
-func (h *httpFlags) buildArgs(c *Command, port, ip, addr string) ([]string, error) {
+func (h *httpFlags) buildArgs(c *Command, port, ip, addr string, creds apiproxy.Credentials) ([]string, apiproxy.Credentials, error) {
 	var args []string
+	retCreds := creds
 	host := h.flagHttpHost
 	if host == "" && c.sessInfo.Endpoint != "" {
 		hostUrl := c.sessInfo.Endpoint
 		u, err := url.Parse(hostUrl)
 		if err != nil {
-			return nil, fmt.Errorf("error parsing endpoint URL: %w", err)
+			return nil, apiproxy.Credentials{}, fmt.Errorf("error parsing endpoint URL: %w", err)
 		}
 		host = u.Hostname()
 	}
 	switch h.flagHttpStyle {
 	case "curl":
+		if len(retCreds.TlsClientCertificate) > 0 {
+			// For now just grab the first TLS client certificate credential brokered
+			certFile, keyFile, caFile, err := c.writeTlsClientCertificateFiles(retCreds.TlsClientCertificate[0])
+			if err != nil {
+				return nil, apiproxy.Credentials{}, err
+			}
+			retCreds.TlsClientCertificate[0].Consumed = true
+			args = append(args, "--cert", certFile, "--key", keyFile)
+			if caFile != "" {
+				args = append(args, "--cacert", caFile)
+			}
+		}
 		if h.flagHttpMethod != "" {
 			args = append(args, "-X", h.flagHttpMethod)
 		}
@@ -110,5 +124,5 @@ func (h *httpFlags) buildArgs(c *Command, port, ip, addr string) ([]string, erro
 		}
 		args = append(args, uri)
 	}
-	return args, nil
+	return args, retCreds, nil
 }
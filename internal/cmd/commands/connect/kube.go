@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"strings"
 
+	apiproxy "github.com/hashicorp/boundary/api/proxy"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/posener/complete"
 )
@@ -56,24 +57,51 @@ func (f *kubeFlags) defaultExec() string {
 	return strings.ToLower(f.flagKubeStyle)
 }
 
-func (f *kubeFlags) buildArgs(c *Command, port, ip, addr string) ([]string, error) {
+func (f *kubeFlags) buildArgs(c *Command, port, ip, addr string, creds apiproxy.Credentials) ([]string, apiproxy.Credentials, error) {
 	var args []string
+	retCreds := creds
 	host := f.flagKubeHost
 	if host == "" && c.sessInfo.Endpoint != "" {
 		hostUrl := c.sessInfo.Endpoint
 		u, err := url.Parse(hostUrl)
 		if err != nil {
-			return nil, fmt.Errorf("error parsing endpoint URL: %w", err)
+			return nil, apiproxy.Credentials{}, fmt.Errorf("error parsing endpoint URL: %w", err)
 		}
 		host = u.Hostname()
 	}
 	switch f.flagKubeStyle {
 	case "kubectl":
+		switch {
+		// A kubeconfig takes precedence as it may carry more than client
+		// credentials; the server is still overridden below to go through
+		// the proxy.
+		case len(retCreds.Kubeconfig) > 0:
+			// For now just grab the first kubeconfig credential brokered
+			kcFile, err := c.writeTempCredentialFile("kubeconfig", retCreds.Kubeconfig[0].Kubeconfig)
+			if err != nil {
+				return nil, apiproxy.Credentials{}, err
+			}
+			retCreds.Kubeconfig[0].Consumed = true
+			args = append(args, "--kubeconfig", kcFile)
+
+		case len(retCreds.TlsClientCertificate) > 0:
+			// For now just grab the first TLS client certificate credential brokered
+			certFile, keyFile, caFile, err := c.writeTlsClientCertificateFiles(retCreds.TlsClientCertificate[0])
+			if err != nil {
+				return nil, apiproxy.Credentials{}, err
+			}
+			retCreds.TlsClientCertificate[0].Consumed = true
+			args = append(args, "--client-certificate", certFile, "--client-key", keyFile)
+			if caFile != "" {
+				args = append(args, "--certificate-authority", caFile)
+			}
+		}
+
 		if host != "" && f.flagKubeScheme == "https" {
 			host = strings.TrimSuffix(host, "/")
 			args = append(args, "--tls-server-name", host)
 		}
 		args = append(args, "--server", fmt.Sprintf("%s://%s", f.flagKubeScheme, addr))
 	}
-	return args, nil
+	return args, retCreds, nil
 }
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credentiallibrariescmd
+
+import (
+	"github.com/hashicorp/boundary/api/credentiallibraries"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func init() {
+	extraFileFlagsFunc = extraFileFlagsFuncImpl
+	extraFileActionsFlagsMapFunc = extraFileActionsFlagsMapFuncImpl
+	extraFileFlagsHandlingFunc = extraFileFlagHandlingFuncImpl
+}
+
+const (
+	entryPathFlagName = "path"
+)
+
+type extraFileCmdVars struct {
+	flagEntryPath         string
+	flagCredentialType    string
+	flagCredentialMapping []base.CombinedSliceFlagValue
+}
+
+func extraFileActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			entryPathFlagName,
+			credentialTypeFlagName,
+			credentialMappingFlagName,
+		},
+		"update": {
+			entryPathFlagName,
+			credentialMappingFlagName,
+		},
+	}
+	return flags
+}
+
+func extraFileFlagsFuncImpl(c *FileCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("File Credential Library Options")
+
+	for _, name := range flagsFileMap[c.Func] {
+		switch name {
+		case entryPathFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   entryPathFlagName,
+				Target: &c.flagEntryPath,
+				Usage:  "The slash separated path of the entry in the credential bundle to issue credentials from.",
+			})
+		case credentialTypeFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   credentialTypeFlagName,
+				Target: &c.flagCredentialType,
+				Usage:  "The type of credential this library will issue, defaults to Unspecified.",
+			})
+		case credentialMappingFlagName:
+			f.CombinationSliceVar(&base.CombinationSliceVar{
+				Name:    credentialMappingFlagName,
+				Target:  &c.flagCredentialMapping,
+				KvSplit: true,
+				Usage:   "The credential mapping override.",
+			})
+		}
+	}
+}
+
+func extraFileFlagHandlingFuncImpl(c *FileCommand, _ *base.FlagSets, opts *[]credentiallibraries.Option) bool {
+	switch c.flagEntryPath {
+	case "":
+	default:
+		*opts = append(*opts, credentiallibraries.WithFileCredentialLibraryPath(c.flagEntryPath))
+	}
+	switch c.flagCredentialType {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultCredentialType())
+	default:
+		*opts = append(*opts, credentiallibraries.WithCredentialType(c.flagCredentialType))
+	}
+	switch len(c.flagCredentialMapping) {
+	case 0:
+	case 1:
+		if len(c.flagCredentialMapping[0].Keys) == 1 && c.flagCredentialMapping[0].Keys[0] == "null" && c.flagCredentialMapping[0].Value == nil {
+			*opts = append(*opts, credentiallibraries.DefaultCredentialMappingOverrides())
+			break
+		}
+		fallthrough
+	default:
+		mappings := make(map[string]any, len(c.flagCredentialMapping))
+		for _, mapping := range c.flagCredentialMapping {
+			switch {
+			case len(mapping.Keys) != 1 || mapping.Keys[0] == "" || mapping.Value == nil || mapping.Value.GetValue() == "":
+				// mapping override does not support key segments (e.g. 'x.y=z')
+				c.UI.Error("Credential mapping override must be in the format 'key=value', 'key=null' to clear field or 'null' to clear all.")
+				return false
+			case mapping.Value.GetValue() == "null":
+				mappings[mapping.Keys[0]] = nil
+			default:
+				mappings[mapping.Keys[0]] = mapping.Value.GetValue()
+			}
+		}
+		*opts = append(*opts, credentiallibraries.WithCredentialMappingOverrides(mappings))
+	}
+
+	return true
+}
+
+func (c *FileCommand) extraFileHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-libraries create file -credential-store-id [options] [args]",
+			"",
+			"  Create a file-type credential library. Example:",
+			"",
+			`    $ boundary credential-libraries create file -credential-store-id csfile_1234567890 -path prod/postgres -credential-type username_password`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-libraries update file [options] [args]",
+			"",
+			"  Update a file-type credential library given its ID. Example:",
+			"",
+			`    $ boundary credential-libraries update file -id clfile_1234567890 -path "prod/{{ .User.Name }}"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
@@ -151,6 +151,8 @@ func printItemTable(item *credentiallibraries.CredentialLibrary, resp *api.Respo
 		keySubstMap = genericKeySubstMap
 	case "vault-ssh-certificate":
 		keySubstMap = sshCertKeySubstMap
+	case "file":
+		keySubstMap = fileKeySubstMap
 	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, item.Attributes, keySubstMap)
@@ -203,9 +205,12 @@ func printItemTable(item *credentiallibraries.CredentialLibrary, resp *api.Respo
 }
 
 var genericKeySubstMap = map[string]string{
-	"path":              "Path",
-	"http_method":       "HTTP Method",
-	"http_request_body": "HTTP Request Body",
+	"path":                   "Path",
+	"http_method":            "HTTP Method",
+	"http_request_body":      "HTTP Request Body",
+	"kv_v2":                  "KV v2",
+	"kv_v2_version":          "KV v2 Version",
+	"lease_ttl_from_session": "Lease TTL From Session",
 }
 
 var sshCertKeySubstMap = map[string]string{
@@ -218,3 +223,7 @@ var sshCertKeySubstMap = map[string]string{
 	"critical_options": "Critical Options",
 	"extensions":       "Extensions",
 }
+
+var fileKeySubstMap = map[string]string{
+	"path": "Path",
+}
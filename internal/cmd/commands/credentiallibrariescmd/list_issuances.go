@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credentiallibrariescmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/credentiallibraries"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/commands/credentialscmd"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ListIssuancesCommand)(nil)
+	_ cli.CommandAutocomplete = (*ListIssuancesCommand)(nil)
+)
+
+type ListIssuancesCommand struct {
+	*base.Command
+}
+
+func (c *ListIssuancesCommand) Synopsis() string {
+	return wordwrap.WrapString("List the sessions credentials were issued to from a credential library", base.TermWidth)
+}
+
+func (c *ListIssuancesCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary credential-libraries list-issuances [args]",
+		"",
+		"  List the sessions credentials were issued to from a credential library, most recently issued first. Issuances are kept after their session is deleted. Example:",
+		"",
+		`    $ boundary credential-libraries list-issuances -id clvlt_1234567890`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ListIssuancesCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the credential library whose issuances should be listed",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "filter",
+		Target: &c.FlagFilter,
+		Usage:  "If set, the list operation will be filtered before being returned. The filter operates against each item in the list. Using single quotes is recommended as filters contain double quotes. See https://www.boundaryproject.io/docs/concepts/filtering/resource-listing for details.",
+	})
+
+	return set
+}
+
+func (c *ListIssuancesCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *ListIssuancesCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ListIssuancesCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	var opts []credentiallibraries.Option
+	if c.FlagFilter != "" {
+		opts = append(opts, credentiallibraries.WithFilter(c.FlagFilter))
+	}
+
+	clClient := credentiallibraries.NewClient(client)
+	result, err := clClient.ListIssuances(c.Context, c.FlagId, opts...)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when listing credential library issuances")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to list credential issuances: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItems(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(credentialscmd.PrintIssuanceListTable(result.GetItems()))
+	}
+
+	return base.CommandSuccess
+}
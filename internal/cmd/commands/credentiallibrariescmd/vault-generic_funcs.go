@@ -4,6 +4,9 @@
 package credentiallibrariescmd
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/hashicorp/boundary/api/credentiallibraries"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/go-secure-stdlib/parseutil"
@@ -16,19 +19,25 @@ func init() {
 }
 
 const (
-	pathFlagName              = "vault-path"
-	httpMethodFlagName        = "vault-http-method"
-	httpRequestBodyFlagName   = "vault-http-request-body"
-	credentialTypeFlagName    = "credential-type"
-	credentialMappingFlagName = "credential-mapping-override"
+	pathFlagName                = "vault-path"
+	httpMethodFlagName          = "vault-http-method"
+	httpRequestBodyFlagName     = "vault-http-request-body"
+	kvV2FlagName                = "vault-kv-v2"
+	kvV2VersionFlagName         = "vault-kv-v2-version"
+	leaseTtlFromSessionFlagName = "vault-lease-ttl-from-session"
+	credentialTypeFlagName      = "credential-type"
+	credentialMappingFlagName   = "credential-mapping-override"
 )
 
 type extraVaultGenericCmdVars struct {
-	flagPath              string
-	flagHttpMethod        string
-	flagHttpRequestBody   string
-	flagCredentialType    string
-	flagCredentialMapping []base.CombinedSliceFlagValue
+	flagPath                string
+	flagHttpMethod          string
+	flagHttpRequestBody     string
+	flagKvV2                string
+	flagKvV2Version         string
+	flagLeaseTtlFromSession string
+	flagCredentialType      string
+	flagCredentialMapping   []base.CombinedSliceFlagValue
 }
 
 func extraVaultGenericActionsFlagsMapFuncImpl() map[string][]string {
@@ -37,6 +46,9 @@ func extraVaultGenericActionsFlagsMapFuncImpl() map[string][]string {
 			pathFlagName,
 			httpMethodFlagName,
 			httpRequestBodyFlagName,
+			kvV2FlagName,
+			kvV2VersionFlagName,
+			leaseTtlFromSessionFlagName,
 			credentialTypeFlagName,
 			credentialMappingFlagName,
 		},
@@ -44,6 +56,9 @@ func extraVaultGenericActionsFlagsMapFuncImpl() map[string][]string {
 			pathFlagName,
 			httpMethodFlagName,
 			httpRequestBodyFlagName,
+			kvV2FlagName,
+			kvV2VersionFlagName,
+			leaseTtlFromSessionFlagName,
 			credentialMappingFlagName,
 		},
 	}
@@ -73,6 +88,24 @@ func extraVaultGenericFlagsFuncImpl(c *VaultGenericCommand, set *base.FlagSets,
 				Target: &c.flagHttpRequestBody,
 				Usage:  "The http request body the library uses to communicate with vault. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
 			})
+		case kvV2FlagName:
+			f.StringVar(&base.StringVar{
+				Name:   kvV2FlagName,
+				Target: &c.flagKvV2,
+				Usage:  "If true, the path is a Vault KV version 2 secret. The data envelope of the secret is removed and the version and created time of the secret are returned with the credential. Requires the GET http method.",
+			})
+		case kvV2VersionFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   kvV2VersionFlagName,
+				Target: &c.flagKvV2Version,
+				Usage:  "The version of the Vault KV version 2 secret to request. If unset, the latest version is requested.",
+			})
+		case leaseTtlFromSessionFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   leaseTtlFromSessionFlagName,
+				Target: &c.flagLeaseTtlFromSession,
+				Usage:  "If true, the library requests a lease TTL equal to the remaining time of the session the credential is brokered for. Only applies to secrets with a renewable lease.",
+			})
 		case credentialTypeFlagName:
 			f.StringVar(&base.StringVar{
 				Name:   credentialTypeFlagName,
@@ -111,6 +144,42 @@ func extraVaultGenericFlagHandlingFuncImpl(c *VaultGenericCommand, _ *base.FlagS
 		rb, _ := parseutil.ParsePath(c.flagHttpRequestBody)
 		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibraryHttpRequestBody(rb))
 	}
+	switch c.flagKvV2 {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultCredentialLibraryKvV2())
+	default:
+		kvV2, err := strconv.ParseBool(c.flagKvV2)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagKvV2, err))
+			return false
+		}
+		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibraryKvV2(kvV2))
+	}
+	switch c.flagKvV2Version {
+	case "":
+	case "0", "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultCredentialLibraryKvV2Version())
+	default:
+		kvV2Version, err := strconv.ParseUint(c.flagKvV2Version, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagKvV2Version, err))
+			return false
+		}
+		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibraryKvV2Version(uint32(kvV2Version)))
+	}
+	switch c.flagLeaseTtlFromSession {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultCredentialLibraryLeaseTtlFromSession())
+	default:
+		leaseTtlFromSession, err := strconv.ParseBool(c.flagLeaseTtlFromSession)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagLeaseTtlFromSession, err))
+			return false
+		}
+		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibraryLeaseTtlFromSession(leaseTtlFromSession))
+	}
 	switch c.flagCredentialType {
 	case "":
 	case "null":
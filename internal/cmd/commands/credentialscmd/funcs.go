@@ -18,6 +18,9 @@ const (
 	privateKeyFlagName           = "private-key"
 	privateKeyPassphraseFlagName = "private-key-passphrase"
 	secretFlagName               = "secret"
+	certificateFlagName          = "certificate"
+	caCertificateFlagName        = "ca-certificate"
+	kubeconfigFlagName           = "kubeconfig"
 )
 
 func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
@@ -186,4 +189,7 @@ var keySubstMap = map[string]string{
 	"password_hmac":               "Password HMAC",
 	"private_key_hmac":            "Private Key HMAC",
 	"private_key_passphrase_hmac": "Private Key Passphrase HMAC",
+	"certificate":                 "Certificate",
+	"ca_certificate":              "CA Certificate",
+	"kubeconfig_hmac":             "Kubeconfig HMAC",
 }
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credentialscmd
+
+import (
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func init() {
+	extraKubeconfigFlagsFunc = extraKubeconfigFlagsFuncImpl
+	extraKubeconfigActionsFlagsMapFunc = extraKubeconfigActionsFlagsMapFuncImpl
+	extraKubeconfigFlagsHandlingFunc = extraKubeconfigFlagHandlingFuncImpl
+}
+
+type extraKubeconfigCmdVars struct {
+	flagKubeconfig string
+}
+
+func extraKubeconfigActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			kubeconfigFlagName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraKubeconfigFlagsFuncImpl(c *KubeconfigCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("Kubeconfig Credential Options")
+
+	for _, name := range flagsKubeconfigMap[c.Func] {
+		switch name {
+		case kubeconfigFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   kubeconfigFlagName,
+				Target: &c.flagKubeconfig,
+				Usage:  "The kubeconfig file contents associated with the credential. This can refer to a file on disk (file://) from which the value will be read or an env var (env://) from which the value will be read.",
+			})
+		}
+	}
+}
+
+func extraKubeconfigFlagHandlingFuncImpl(c *KubeconfigCommand, _ *base.FlagSets, opts *[]credentials.Option) bool {
+	switch c.flagKubeconfig {
+	case "":
+	default:
+		kubeconfig, err := parsePathFlag(c.Command, "Kubeconfig", c.flagKubeconfig)
+		if err != nil {
+			return false
+		}
+		*opts = append(*opts, credentials.WithKubeconfigCredentialKubeconfig(kubeconfig))
+	}
+
+	return true
+}
+
+func (c *KubeconfigCommand) extraKubeconfigHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credentials create kubeconfig -credential-store-id [options] [args]",
+			"",
+			"  Create a kubeconfig credential. Example:",
+			"",
+			`    $ boundary credentials create kubeconfig -credential-store-id csst_1234567890 -kubeconfig file:///home/user/.kube/config`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credentials update kubeconfig [options] [args]",
+			"",
+			"  Update a kubeconfig credential given its ID. Example:",
+			"",
+			`    $ boundary credentials update kubeconfig -id credkc_1234567890 -name devops -description "For DevOps usage"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
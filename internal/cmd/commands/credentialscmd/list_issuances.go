@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credentialscmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ListIssuancesCommand)(nil)
+	_ cli.CommandAutocomplete = (*ListIssuancesCommand)(nil)
+)
+
+type ListIssuancesCommand struct {
+	*base.Command
+}
+
+func (c *ListIssuancesCommand) Synopsis() string {
+	return wordwrap.WrapString("List the sessions a static credential was issued to", base.TermWidth)
+}
+
+func (c *ListIssuancesCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary credentials list-issuances [args]",
+		"",
+		"  List the sessions a static credential was issued to, most recently issued first. Issuances are kept after their session is deleted. Example:",
+		"",
+		`    $ boundary credentials list-issuances -id credup_1234567890`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ListIssuancesCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the credential whose issuances should be listed",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "filter",
+		Target: &c.FlagFilter,
+		Usage:  "If set, the list operation will be filtered before being returned. The filter operates against each item in the list. Using single quotes is recommended as filters contain double quotes. See https://www.boundaryproject.io/docs/concepts/filtering/resource-listing for details.",
+	})
+
+	return set
+}
+
+func (c *ListIssuancesCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *ListIssuancesCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ListIssuancesCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	var opts []credentials.Option
+	if c.FlagFilter != "" {
+		opts = append(opts, credentials.WithFilter(c.FlagFilter))
+	}
+
+	cClient := credentials.NewClient(client)
+	result, err := cClient.ListIssuances(c.Context, c.FlagId, opts...)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when listing credential issuances")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to list credential issuances: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItems(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(PrintIssuanceListTable(result.GetItems()))
+	}
+
+	return base.CommandSuccess
+}
+
+// PrintIssuanceListTable formats credential issuances for table output. It is
+// shared with the credential libraries list-issuances command.
+func PrintIssuanceListTable(items []*credentials.CredentialIssuance) string {
+	if len(items) == 0 {
+		return "No issuances found"
+	}
+	var output []string
+	output = []string{
+		"",
+		"Credential issuance information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		output = append(output,
+			fmt.Sprintf("  ID:                        %s", item.Id),
+			fmt.Sprintf("    Session ID:              %s", item.SessionId),
+		)
+		if item.UserId != "" {
+			output = append(output,
+				fmt.Sprintf("    User ID:                 %s", item.UserId),
+			)
+		}
+		if item.TargetId != "" {
+			output = append(output,
+				fmt.Sprintf("    Target ID:               %s", item.TargetId),
+			)
+		}
+		if item.ScopeId != "" {
+			output = append(output,
+				fmt.Sprintf("    Scope ID:                %s", item.ScopeId),
+			)
+		}
+		if item.CredentialLibraryId != "" {
+			output = append(output,
+				fmt.Sprintf("    Credential Library ID:   %s", item.CredentialLibraryId),
+			)
+		}
+		if item.CredentialId != "" {
+			output = append(output,
+				fmt.Sprintf("    Credential ID:           %s", item.CredentialId),
+			)
+		}
+		if item.CredentialVersion != 0 {
+			output = append(output,
+				fmt.Sprintf("    Credential Version:      %d", item.CredentialVersion),
+			)
+		}
+		if item.Purpose != "" {
+			output = append(output,
+				fmt.Sprintf("    Purpose:                 %s", item.Purpose),
+			)
+		}
+		if !item.CreatedTime.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    Issued:                  %s", item.CreatedTime.Local().Format(time.RFC1123)),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credentialscmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ListVersionsCommand)(nil)
+	_ cli.CommandAutocomplete = (*ListVersionsCommand)(nil)
+)
+
+type ListVersionsCommand struct {
+	*base.Command
+}
+
+func (c *ListVersionsCommand) Synopsis() string {
+	return wordwrap.WrapString("List the versions of a static credential", base.TermWidth)
+}
+
+func (c *ListVersionsCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary credentials list-versions [args]",
+		"",
+		"  List the versions of a static credential, newest first. A new version is recorded each time the credential is created or updated. Example:",
+		"",
+		`    $ boundary credentials list-versions -id credup_1234567890`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ListVersionsCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the credential whose versions should be listed",
+	})
+
+	return set
+}
+
+func (c *ListVersionsCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *ListVersionsCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ListVersionsCommand) printListTable(items []*credentials.CredentialVersion) string {
+	if len(items) == 0 {
+		return "No versions found"
+	}
+	var output []string
+	output = []string{
+		"",
+		"Credential version information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		output = append(output,
+			fmt.Sprintf("  Version:      %d", item.Version),
+		)
+		if item.AuthorId != "" {
+			output = append(output,
+				fmt.Sprintf("    Author ID:  %s", item.AuthorId),
+			)
+		}
+		if !item.CreatedTime.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    Created:    %s", item.CreatedTime.Local().Format(time.RFC1123)),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
+func (c *ListVersionsCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	cClient := credentials.NewClient(client)
+	result, err := cClient.ListVersions(c.Context, c.FlagId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when listing credential versions")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to list credential versions: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItems(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(c.printListTable(result.GetItems()))
+	}
+
+	return base.CommandSuccess
+}
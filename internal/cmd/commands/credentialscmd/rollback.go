@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credentialscmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*RollbackCommand)(nil)
+	_ cli.CommandAutocomplete = (*RollbackCommand)(nil)
+)
+
+type RollbackCommand struct {
+	*base.Command
+	flagToVersion uint
+}
+
+func (c *RollbackCommand) Synopsis() string {
+	return wordwrap.WrapString("Roll a static credential back to an earlier version", base.TermWidth)
+}
+
+func (c *RollbackCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary credentials rollback [args]",
+		"",
+		"  Set the attributes of a static credential back to those of an earlier version. The rollback is recorded as a new version of the credential. Example:",
+		"",
+		`    $ boundary credentials rollback -id credup_1234567890 -to-version 2`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *RollbackCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the credential to roll back",
+	})
+
+	f.UintVar(&base.UintVar{
+		Name:   "to-version",
+		Target: &c.flagToVersion,
+		Usage:  "The version of the credential to roll back to",
+	})
+
+	f.IntVar(&base.IntVar{
+		Name:   "version",
+		Target: &c.FlagVersion,
+		Usage:  "The version of the credential against which to perform the rollback. If not specified, the command will perform a check-and-set automatically.",
+	})
+
+	return set
+}
+
+func (c *RollbackCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *RollbackCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *RollbackCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	case c.flagToVersion == 0:
+		c.PrintCliError(errors.New("Version to roll back to must be provided via -to-version"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	var opts []credentials.Option
+	if c.FlagVersion == 0 {
+		opts = append(opts, credentials.WithAutomaticVersioning(true))
+	}
+
+	cClient := credentials.NewClient(client)
+	result, err := cClient.Rollback(c.Context, c.FlagId, uint32(c.flagToVersion), uint32(c.FlagVersion), opts...)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when rolling back credential")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to roll back credential: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(printItemTable(result.GetItem(), result.GetResponse()))
+	}
+
+	return base.CommandSuccess
+}
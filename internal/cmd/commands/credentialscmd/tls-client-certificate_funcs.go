@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credentialscmd
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraTlsClientCertificateFlagsFunc = extraTlsClientCertificateFlagsFuncImpl
+	extraTlsClientCertificateActionsFlagsMapFunc = extraTlsClientCertificateActionsFlagsMapFuncImpl
+	extraTlsClientCertificateFlagsHandlingFunc = extraTlsClientCertificateFlagHandlingFuncImpl
+}
+
+type extraTlsClientCertificateCmdVars struct {
+	flagCertificate   string
+	flagPrivateKey    string
+	flagCaCertificate string
+}
+
+func extraTlsClientCertificateActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			certificateFlagName,
+			privateKeyFlagName,
+			caCertificateFlagName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraTlsClientCertificateFlagsFuncImpl(c *TlsClientCertificateCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("TLS Client Certificate Credential Options")
+
+	for _, name := range flagsTlsClientCertificateMap[c.Func] {
+		switch name {
+		case certificateFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   certificateFlagName,
+				Target: &c.flagCertificate,
+				Usage:  "The PEM encoded client certificate, optionally followed by its intermediate certificates. This can refer to a file on disk (file://) from which the value will be read or an env var (env://) from which the value will be read.",
+			})
+		case privateKeyFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   privateKeyFlagName,
+				Target: &c.flagPrivateKey,
+				Usage:  "The PEM encoded private key matching the client certificate. This can refer to a file on disk (file://) from which the value will be read or an env var (env://) from which the value will be read.",
+			})
+		case caCertificateFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   caCertificateFlagName,
+				Target: &c.flagCaCertificate,
+				Usage:  "The PEM encoded CA certificates used to verify the server. This can refer to a file on disk (file://) from which the value will be read or an env var (env://) from which the value will be read.",
+			})
+		}
+	}
+}
+
+func extraTlsClientCertificateFlagHandlingFuncImpl(c *TlsClientCertificateCommand, _ *base.FlagSets, opts *[]credentials.Option) bool {
+	var certificate, privateKey string
+	var err error
+	if c.flagCertificate != "" {
+		if certificate, err = parsePathFlag(c.Command, "Certificate", c.flagCertificate); err != nil {
+			return false
+		}
+		*opts = append(*opts, credentials.WithTlsClientCertificateCredentialCertificate(certificate))
+	}
+	if c.flagPrivateKey != "" {
+		if privateKey, err = parsePathFlag(c.Command, "Private key", c.flagPrivateKey); err != nil {
+			return false
+		}
+		*opts = append(*opts, credentials.WithTlsClientCertificateCredentialPrivateKey(privateKey))
+	}
+	if c.flagCaCertificate != "" {
+		caCertificate, err := parsePathFlag(c.Command, "CA certificate", c.flagCaCertificate)
+		if err != nil {
+			return false
+		}
+		*opts = append(*opts, credentials.WithTlsClientCertificateCredentialCaCertificate(caCertificate))
+	}
+
+	// Catch a mismatched pair locally when both halves are supplied; the
+	// controller performs the same check otherwise.
+	if certificate != "" && privateKey != "" {
+		if _, err := tls.X509KeyPair([]byte(certificate), []byte(privateKey)); err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing certificate and private key: %v", err))
+			return false
+		}
+	}
+
+	return true
+}
+
+// parsePathFlag reads the value of a flag that must use env:// or file://
+// syntax, reporting any error to the user using name to identify the flag.
+func parsePathFlag(c *base.Command, name, value string) (string, error) {
+	ret, err := parseutil.MustParsePath(value)
+	switch {
+	case err == nil:
+	case errors.Is(err, parseutil.ErrNotAUrl), errors.Is(err, parseutil.ErrNotParsed):
+		c.UI.Error(fmt.Sprintf("%s flag must be used with env:// or file:// syntax", name))
+	default:
+		c.UI.Error(fmt.Sprintf("Error parsing %s flag: %v", name, err))
+	}
+	return ret, err
+}
+
+func (c *TlsClientCertificateCommand) extraTlsClientCertificateHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credentials create tls-client-certificate -credential-store-id [options] [args]",
+			"",
+			"  Create a TLS client certificate credential. Example:",
+			"",
+			`    $ boundary credentials create tls-client-certificate -credential-store-id csst_1234567890 -certificate file:///home/user/client.crt -private-key file:///home/user/client.key -ca-certificate file:///home/user/ca.crt`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credentials update tls-client-certificate [options] [args]",
+			"",
+			"  Update a TLS client certificate credential given its ID. Example:",
+			"",
+			`    $ boundary credentials update tls-client-certificate -id credtls_1234567890 -name devops -description "For DevOps usage"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credentialstorescmd
+
+import (
+	"github.com/hashicorp/boundary/api/credentialstores"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func init() {
+	extraFileFlagsFunc = extraFileFlagsFuncImpl
+	extraFileActionsFlagsMapFunc = extraFileActionsFlagsMapFuncImpl
+	extraFileFlagsHandlingFunc = extraFileFlagHandlingFuncImpl
+}
+
+const (
+	bundlePathFlagName      = "bundle-path"
+	ageIdentityPathFlagName = "age-identity-path"
+)
+
+type extraFileCmdVars struct {
+	flagBundlePath      string
+	flagAgeIdentityPath string
+}
+
+func extraFileActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			bundlePathFlagName,
+			ageIdentityPathFlagName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraFileFlagsFuncImpl(c *FileCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("File Credential Store Options")
+
+	for _, name := range flagsFileMap[c.Func] {
+		switch name {
+		case bundlePathFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   bundlePathFlagName,
+				Target: &c.flagBundlePath,
+				Usage:  "The absolute path of the credential bundle on the controllers.",
+			})
+		case ageIdentityPathFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   ageIdentityPathFlagName,
+				Target: &c.flagAgeIdentityPath,
+				Usage:  "The absolute path of a file on the controllers containing the age identities used to decrypt the bundle.",
+			})
+		}
+	}
+}
+
+func extraFileFlagHandlingFuncImpl(c *FileCommand, _ *base.FlagSets, opts *[]credentialstores.Option) bool {
+	switch c.flagBundlePath {
+	case "":
+	default:
+		*opts = append(*opts, credentialstores.WithFileCredentialStorePath(c.flagBundlePath))
+	}
+	switch c.flagAgeIdentityPath {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultFileCredentialStoreAgeIdentityPath())
+	default:
+		*opts = append(*opts, credentialstores.WithFileCredentialStoreAgeIdentityPath(c.flagAgeIdentityPath))
+	}
+
+	return true
+}
+
+func (c *FileCommand) extraFileHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-stores create file [options] [args]",
+			"",
+			"  Create a file-type credential store. Example:",
+			"",
+			`    $ boundary credential-stores create file -scope-id p_1234567890 -bundle-path /etc/boundary/credentials.yaml.age -age-identity-path /etc/boundary/identity.txt`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-stores update file [options] [args]",
+			"",
+			"  Update a file-type credential store given its ID. Example:",
+			"",
+			`    $ boundary credential-stores update file -id csfile_1234567890 -bundle-path /etc/boundary/credentials.json`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
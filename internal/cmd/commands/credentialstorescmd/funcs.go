@@ -42,6 +42,10 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`      $ boundary credential-stores create static -scope-id p_1234567890`,
 			"",
+			"    Create a file-type credential store:",
+			"",
+			`      $ boundary credential-stores create file -scope-id p_1234567890 -bundle-path /etc/boundary/credentials.yaml`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	case "update":
@@ -58,6 +62,10 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`      $ boundary credential-stores update static -id cs_1234567890 -name devops -description "For DevOps usage"`,
 			"",
+			"    Update a file-type credential store:",
+			"",
+			`      $ boundary credential-stores update file -id csfile_1234567890 -name devops -description "For DevOps usage"`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	default:
@@ -212,4 +220,8 @@ var keySubstMap = map[string]string{
 	"client_certificate":          "Client Certificate",
 	"client_certificate_key_hmac": "Client Certificate Key HMAC",
 	"worker_filter":               "Worker Filter",
+	"auth_method":                 "Auth Method",
+	"auth_mount_path":             "Auth Mount Path",
+	"auth_role":                   "Auth Role",
+	"auth_secret_hmac":            "Auth Secret HMAC",
 }
@@ -37,6 +37,8 @@ type StaticCommand struct {
 	Func string
 
 	plural string
+
+	extraStaticCmdVars
 }
 
 func (c *StaticCommand) AutocompleteArgs() complete.Predictor {
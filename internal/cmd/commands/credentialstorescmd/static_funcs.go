@@ -4,9 +4,148 @@
 package credentialstorescmd
 
 import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api/credentialstores"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 )
 
+func init() {
+	extraStaticFlagsFunc = extraStaticFlagsFuncImpl
+	extraStaticActionsFlagsMapFunc = extraStaticActionsFlagsMapFuncImpl
+	extraStaticFlagsHandlingFunc = extraStaticFlagHandlingFuncImpl
+}
+
+const (
+	rotationPeriodFlagName      = "rotation-period"
+	rotationGracePeriodFlagName = "rotation-grace-period"
+	rotatorFlagName             = "rotator"
+	exclusiveCheckoutFlagName   = "exclusive-checkout"
+	rotateOnCheckinFlagName     = "rotate-on-checkin"
+)
+
+type extraStaticCmdVars struct {
+	flagRotationPeriod      string
+	flagRotationGracePeriod string
+	flagRotator             string
+	flagExclusiveCheckout   string
+	flagRotateOnCheckin     string
+}
+
+func extraStaticActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			rotationPeriodFlagName,
+			rotationGracePeriodFlagName,
+			rotatorFlagName,
+			exclusiveCheckoutFlagName,
+			rotateOnCheckinFlagName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraStaticFlagsFuncImpl(c *StaticCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("Static Credential Store Options")
+
+	for _, name := range flagsStaticMap[c.Func] {
+		switch name {
+		case rotationPeriodFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   rotationPeriodFlagName,
+				Target: &c.flagRotationPeriod,
+				Usage:  "The number of seconds between automatic rotations of the username password and ssh private key credentials in the store. Requires a rotator. Set to 0 to disable rotation.",
+			})
+		case rotationGracePeriodFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   rotationGracePeriodFlagName,
+				Target: &c.flagRotationGracePeriod,
+				Usage:  "The number of seconds the previous version of a rotated credential remains valid on the target host.",
+			})
+		case rotatorFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   rotatorFlagName,
+				Target: &c.flagRotator,
+				Usage:  "The name of a credential rotator, configured on the controllers, used to push rotated credentials to target hosts.",
+			})
+		case exclusiveCheckoutFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   exclusiveCheckoutFlagName,
+				Target: &c.flagExclusiveCheckout,
+				Usage:  "Whether a credential in the store can only be used by one active session at a time.",
+			})
+		case rotateOnCheckinFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   rotateOnCheckinFlagName,
+				Target: &c.flagRotateOnCheckin,
+				Usage:  "Whether a checked out credential is rotated when the session using it is terminated. Requires a rotator.",
+			})
+		}
+	}
+}
+
+func extraStaticFlagHandlingFuncImpl(c *StaticCommand, _ *base.FlagSets, opts *[]credentialstores.Option) bool {
+	switch c.flagRotationPeriod {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultStaticCredentialStoreRotationPeriod())
+	default:
+		period, err := strconv.ParseUint(c.flagRotationPeriod, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagRotationPeriod, err))
+			return false
+		}
+		*opts = append(*opts, credentialstores.WithStaticCredentialStoreRotationPeriod(uint32(period)))
+	}
+	switch c.flagRotationGracePeriod {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultStaticCredentialStoreRotationGracePeriod())
+	default:
+		grace, err := strconv.ParseUint(c.flagRotationGracePeriod, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagRotationGracePeriod, err))
+			return false
+		}
+		*opts = append(*opts, credentialstores.WithStaticCredentialStoreRotationGracePeriod(uint32(grace)))
+	}
+	switch c.flagRotator {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultStaticCredentialStoreRotator())
+	default:
+		*opts = append(*opts, credentialstores.WithStaticCredentialStoreRotator(c.flagRotator))
+	}
+	switch c.flagExclusiveCheckout {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultStaticCredentialStoreExclusiveCheckout())
+	default:
+		exclusive, err := strconv.ParseBool(c.flagExclusiveCheckout)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagExclusiveCheckout, err))
+			return false
+		}
+		*opts = append(*opts, credentialstores.WithStaticCredentialStoreExclusiveCheckout(exclusive))
+	}
+	switch c.flagRotateOnCheckin {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultStaticCredentialStoreRotateOnCheckin())
+	default:
+		rotate, err := strconv.ParseBool(c.flagRotateOnCheckin)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagRotateOnCheckin, err))
+			return false
+		}
+		*opts = append(*opts, credentialstores.WithStaticCredentialStoreRotateOnCheckin(rotate))
+	}
+
+	return true
+}
+
 func (c *StaticCommand) extraStaticHelpFunc(helpMap map[string]func() string) string {
 	var helpStr string
 	switch c.Func {
@@ -18,6 +157,14 @@ func (c *StaticCommand) extraStaticHelpFunc(helpMap map[string]func() string) st
 			"",
 			`    $ boundary credential-stores create static -scope-id p_1234567890`,
 			"",
+			"  Create a static-type credential store that rotates its credentials every day:",
+			"",
+			`    $ boundary credential-stores create static -scope-id p_1234567890 -rotation-period 86400 -rotation-grace-period 3600 -rotator linux`,
+			"",
+			"  Create a static-type credential store whose credentials are used by one session at a time and rotated after each use:",
+			"",
+			`    $ boundary credential-stores create static -scope-id p_1234567890 -exclusive-checkout true -rotate-on-checkin true -rotator linux`,
+			"",
 			"",
 		})
 
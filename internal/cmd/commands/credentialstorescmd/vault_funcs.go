@@ -4,6 +4,7 @@
 package credentialstorescmd
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/boundary/api/credentialstores"
@@ -28,6 +29,10 @@ const (
 	clientCertificateFlagName    = "vault-client-certificate"
 	clientCertificateKeyFlagName = "vault-client-certificate-key"
 	workerFilterFlagName         = "worker-filter"
+	authMethodFlagName           = "vault-auth-method"
+	authMountPathFlagName        = "vault-auth-mount-path"
+	authRoleFlagName             = "vault-auth-role"
+	authSecretFlagName           = "vault-auth-secret"
 )
 
 type extraVaultCmdVars struct {
@@ -40,6 +45,10 @@ type extraVaultCmdVars struct {
 	flagTlsServerName string
 	flagTlsSkipVerify bool
 	flagWorkerFilter  string
+	flagAuthMethod    string
+	flagAuthMountPath string
+	flagAuthRole      string
+	flagAuthSecret    string
 }
 
 func extraVaultActionsFlagsMapFuncImpl() map[string][]string {
@@ -54,6 +63,10 @@ func extraVaultActionsFlagsMapFuncImpl() map[string][]string {
 			clientCertificateFlagName,
 			clientCertificateKeyFlagName,
 			workerFilterFlagName,
+			authMethodFlagName,
+			authMountPathFlagName,
+			authRoleFlagName,
+			authSecretFlagName,
 		},
 	}
 	flags["update"] = flags["create"]
@@ -119,6 +132,30 @@ func extraVaultFlagsFuncImpl(c *VaultCommand, set *base.FlagSets, _ *base.FlagSe
 				Target: &c.flagWorkerFilter,
 				Usage:  `A boolean expression to filter which workers can handle Vault commands for this credential store.`,
 			})
+		case authMethodFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   authMethodFlagName,
+				Target: &c.flagAuthMethod,
+				Usage:  `The Vault auth method boundary uses to log in to vault and obtain its own token for this store, instead of a vault token. One of "approle", "jwt", or "kubernetes".`,
+			})
+		case authMountPathFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   authMountPathFlagName,
+				Target: &c.flagAuthMountPath,
+				Usage:  "The path the Vault auth method is mounted at. Defaults to the name of the auth method.",
+			})
+		case authRoleFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   authRoleFlagName,
+				Target: &c.flagAuthRole,
+				Usage:  "The role to log in with. This is the role_id for the approle auth method and the name of the role for the jwt and kubernetes auth methods.",
+			})
+		case authSecretFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   authSecretFlagName,
+				Target: &c.flagAuthSecret,
+				Usage:  "The secret to log in with. This is the secret_id for the approle auth method and the JWT for the jwt auth method. If not set for the kubernetes auth method, the service account token of the controller is used. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
+			})
 		}
 	}
 }
@@ -176,6 +213,39 @@ func extraVaultFlagHandlingFuncImpl(c *VaultCommand, f *base.FlagSets, opts *[]c
 		}
 		*opts = append(*opts, credentialstores.WithVaultCredentialStoreWorkerFilter(c.flagWorkerFilter))
 	}
+	switch c.flagAuthMethod {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultVaultCredentialStoreAuthMethod())
+	default:
+		*opts = append(*opts, credentialstores.WithVaultCredentialStoreAuthMethod(c.flagAuthMethod))
+	}
+	switch c.flagAuthMountPath {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultVaultCredentialStoreAuthMountPath())
+	default:
+		*opts = append(*opts, credentialstores.WithVaultCredentialStoreAuthMountPath(c.flagAuthMountPath))
+	}
+	switch c.flagAuthRole {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultVaultCredentialStoreAuthRole())
+	default:
+		*opts = append(*opts, credentialstores.WithVaultCredentialStoreAuthRole(c.flagAuthRole))
+	}
+	switch c.flagAuthSecret {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultVaultCredentialStoreAuthSecret())
+	default:
+		secret, err := parseutil.ParsePath(c.flagAuthSecret)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing vault auth secret: %s", err))
+			return false
+		}
+		*opts = append(*opts, credentialstores.WithVaultCredentialStoreAuthSecret(secret))
+	}
 	if c.flagTlsSkipVerify {
 		*opts = append(*opts, credentialstores.WithVaultCredentialStoreTlsSkipVerify(c.flagTlsSkipVerify))
 	}
@@ -194,6 +264,10 @@ func (c *VaultCommand) extraVaultHelpFunc(helpMap map[string]func() string) stri
 			"",
 			`    $ boundary credential-stores create vault -vault-address "http://localhost:8200" -vault-token "s.s0m3t0k3n"`,
 			"",
+			"  Create a vault-type credential store which logs in to Vault with an AppRole. Example:",
+			"",
+			`    $ boundary credential-stores create vault -vault-address "http://localhost:8200" -vault-auth-method approle -vault-auth-role "0f3a...role-id" -vault-auth-secret "env://VAULT_SECRET_ID"`,
+			"",
 			"",
 		})
 
@@ -0,0 +1,355 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package declarative
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/api/groups"
+	"github.com/hashicorp/boundary/api/roles"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ApplyCommand)(nil)
+	_ cli.CommandAutocomplete = (*ApplyCommand)(nil)
+)
+
+type ApplyCommand struct {
+	*base.Command
+
+	flagFile        string
+	flagPrune       bool
+	flagDryRun      bool
+	flagAutoApprove bool
+}
+
+func (c *ApplyCommand) Synopsis() string {
+	return wordwrap.WrapString("Reconcile Boundary with a declarative IAM configuration document", base.TermWidth)
+}
+
+func (c *ApplyCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary apply [options]",
+		"",
+		"  Compare a document produced by \"boundary export\" (or written by hand) with the current state of Boundary, show the differences, and make the changes needed for Boundary to match the document. Example:",
+		"",
+		`    $ boundary apply -file boundary.hcl`,
+		"",
+		"  Resources are matched by id when one is given and by name otherwise, so applying the same document twice makes no changes. Changes are applied in dependency order: scopes, then auth methods and groups, then roles. Resources that exist in Boundary but not in the document are left alone unless -prune is set.",
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ApplyCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:       "file",
+		Target:     &c.flagFile,
+		Completion: complete.PredictOr(complete.PredictFiles("*.hcl"), complete.PredictFiles("*.json")),
+		Usage:      "The document to apply, in HCL or JSON format.",
+	})
+
+	f.BoolVar(&base.BoolVar{
+		Name:   "prune",
+		Target: &c.flagPrune,
+		Usage:  "Delete scopes, auth methods, groups and roles within the document's root scope that are not present in the document.",
+	})
+
+	f.BoolVar(&base.BoolVar{
+		Name:   "dry-run",
+		Target: &c.flagDryRun,
+		Usage:  "Show the changes that would be made without making them.",
+	})
+
+	f.BoolVar(&base.BoolVar{
+		Name:   "auto-approve",
+		Target: &c.flagAutoApprove,
+		Usage:  "Apply the changes without asking for confirmation.",
+	})
+
+	return set
+}
+
+func (c *ApplyCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ApplyCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ApplyCommand) Run(args []string) int {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	if c.flagFile == "" {
+		c.PrintCliError(errors.New("Document must be provided via -file"))
+		return base.CommandUserError
+	}
+	in, err := os.ReadFile(c.flagFile)
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error reading document: %w", err))
+		return base.CommandUserError
+	}
+	desired, err := ParseDocument(in)
+	if err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	current, err := fetchState(c.Context, client, desired.Scope.Id)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when reading configuration")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error reading configuration: %w", err))
+		return base.CommandCliError
+	}
+
+	plan, err := ComputePlan(desired, current, c.flagPrune)
+	if err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+	c.UI.Output(plan.String())
+	if plan.Empty() || c.flagDryRun {
+		return base.CommandSuccess
+	}
+
+	if !c.flagAutoApprove {
+		answer, err := c.UI.Ask("Apply these changes? Only 'yes' will be accepted:")
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error reading confirmation: %w", err))
+			return base.CommandCliError
+		}
+		if strings.TrimSpace(answer) != "yes" {
+			c.UI.Output("Apply cancelled.")
+			return base.CommandSuccess
+		}
+	}
+
+	for i, change := range plan.Changes {
+		if err := applyChange(c.Context, client, change); err != nil {
+			msg := fmt.Sprintf("Error applying change %d of %d (%s %s %q in %s)", i+1, len(plan.Changes), change.Action, change.Kind, change.Name, change.Path)
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.PrintApiError(apiErr, msg)
+				return base.CommandApiError
+			}
+			c.PrintCliError(fmt.Errorf("%s: %w", msg, err))
+			return base.CommandCliError
+		}
+	}
+	c.UI.Output(fmt.Sprintf("Apply complete: %d changes made.", len(plan.Changes)))
+	return base.CommandSuccess
+}
+
+// applyChange performs a single change. Creates record the new resource's ID
+// in the desired document so that later changes can refer to it.
+func applyChange(ctx context.Context, client *api.Client, change *Change) error {
+	switch change.Kind {
+	case KindScope:
+		return applyScope(ctx, scopes.NewClient(client), change)
+	case KindAuthMethod:
+		return applyAuthMethod(ctx, authmethods.NewClient(client), change)
+	case KindGroup:
+		return applyGroup(ctx, groups.NewClient(client), change)
+	case KindRole:
+		return applyRole(ctx, roles.NewClient(client), change)
+	default:
+		return fmt.Errorf("unknown resource kind %q", change.Kind)
+	}
+}
+
+func applyScope(ctx context.Context, sClient *scopes.Client, change *Change) error {
+	if change.Action == ActionDelete {
+		_, err := sClient.Delete(ctx, change.Id)
+		return err
+	}
+	s := change.scope
+	opts := []scopes.Option{scopes.WithAutomaticVersioning(true)}
+	switch {
+	case s.Name != "":
+		opts = append(opts, scopes.WithName(s.Name))
+	case change.Action == ActionUpdate:
+		opts = append(opts, scopes.DefaultName())
+	}
+	switch {
+	case s.Description != "":
+		opts = append(opts, scopes.WithDescription(s.Description))
+	case change.Action == ActionUpdate:
+		opts = append(opts, scopes.DefaultDescription())
+	}
+	if change.Action == ActionUpdate {
+		_, err := sClient.Update(ctx, change.Id, 0, opts...)
+		return err
+	}
+	// Roles declared in the document replace the ones Boundary would
+	// otherwise create alongside a new scope.
+	if len(s.Roles) > 0 {
+		opts = append(opts, scopes.WithSkipAdminRoleCreation(true), scopes.WithSkipDefaultRoleCreation(true))
+	}
+	result, err := sClient.Create(ctx, change.container.Id, opts...)
+	if err != nil {
+		return err
+	}
+	s.Id = result.Item.Id
+	return nil
+}
+
+func applyAuthMethod(ctx context.Context, amClient *authmethods.Client, change *Change) error {
+	if change.Action == ActionDelete {
+		_, err := amClient.Delete(ctx, change.Id)
+		return err
+	}
+	am := change.authMethod
+	opts := []authmethods.Option{authmethods.WithAutomaticVersioning(true)}
+	switch {
+	case am.Name != "":
+		opts = append(opts, authmethods.WithName(am.Name))
+	case change.Action == ActionUpdate:
+		opts = append(opts, authmethods.DefaultName())
+	}
+	switch {
+	case am.Description != "":
+		opts = append(opts, authmethods.WithDescription(am.Description))
+	case change.Action == ActionUpdate:
+		opts = append(opts, authmethods.DefaultDescription())
+	}
+	if len(am.Attributes) > 0 {
+		opts = append(opts, authmethods.WithAttributes(am.Attributes))
+	}
+	if change.Action == ActionUpdate {
+		_, err := amClient.Update(ctx, change.Id, 0, opts...)
+		return err
+	}
+	result, err := amClient.Create(ctx, am.Type, change.container.Id, opts...)
+	if err != nil {
+		return err
+	}
+	am.Id = result.Item.Id
+	return nil
+}
+
+func applyGroup(ctx context.Context, gClient *groups.Client, change *Change) error {
+	if change.Action == ActionDelete {
+		_, err := gClient.Delete(ctx, change.Id)
+		return err
+	}
+	g := change.group
+	opts := []groups.Option{groups.WithAutomaticVersioning(true)}
+	switch {
+	case g.Name != "":
+		opts = append(opts, groups.WithName(g.Name))
+	case change.Action == ActionUpdate:
+		opts = append(opts, groups.DefaultName())
+	}
+	switch {
+	case g.Description != "":
+		opts = append(opts, groups.WithDescription(g.Description))
+	case change.Action == ActionUpdate:
+		opts = append(opts, groups.DefaultDescription())
+	}
+	switch change.Action {
+	case ActionUpdate:
+		if _, err := gClient.Update(ctx, g.Id, 0, opts...); err != nil {
+			return err
+		}
+	default:
+		result, err := gClient.Create(ctx, change.container.Id, opts...)
+		if err != nil {
+			return err
+		}
+		g.Id = result.Item.Id
+	}
+	_, err := gClient.SetMembers(ctx, g.Id, 0, nonNil(g.MemberIds), groups.WithAutomaticVersioning(true))
+	return err
+}
+
+func applyRole(ctx context.Context, rClient *roles.Client, change *Change) error {
+	if change.Action == ActionDelete {
+		_, err := rClient.Delete(ctx, change.Id)
+		return err
+	}
+	r := change.role
+	opts := []roles.Option{roles.WithAutomaticVersioning(true)}
+	switch {
+	case r.Name != "":
+		opts = append(opts, roles.WithName(r.Name))
+	case change.Action == ActionUpdate:
+		opts = append(opts, roles.DefaultName())
+	}
+	switch {
+	case r.Description != "":
+		opts = append(opts, roles.WithDescription(r.Description))
+	case change.Action == ActionUpdate:
+		opts = append(opts, roles.DefaultDescription())
+	}
+	switch change.Action {
+	case ActionUpdate:
+		if _, err := rClient.Update(ctx, r.Id, 0, opts...); err != nil {
+			return err
+		}
+	default:
+		result, err := rClient.Create(ctx, change.container.Id, opts...)
+		if err != nil {
+			return err
+		}
+		r.Id = result.Item.Id
+	}
+	principals := resolvePrincipals(change.container, r.Principals)
+	for _, p := range principals {
+		if strings.HasPrefix(p, GroupRefPrefix) {
+			return fmt.Errorf("unable to resolve principal %q", p)
+		}
+	}
+	versioning := roles.WithAutomaticVersioning(true)
+	if _, err := rClient.SetGrantScopes(ctx, r.Id, 0, nonNil(r.GrantScopeIds), versioning); err != nil {
+		return err
+	}
+	if _, err := rClient.SetGrants(ctx, r.Id, 0, nonNil(r.Grants), versioning); err != nil {
+		return err
+	}
+	_, err := rClient.SetPrincipals(ctx, r.Id, 0, nonNil(principals), versioning)
+	return err
+}
+
+// nonNil ensures an empty list is sent as an empty JSON array, which clears
+// the values on the server, rather than null.
+func nonNil(in []string) []string {
+	if in == nil {
+		return []string{}
+	}
+	return in
+}
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package declarative contains the export and apply commands, which convert a
+// scope subtree and its IAM resources (auth methods, groups and roles) into a
+// declarative document and reconcile such a document back into Boundary.
+package declarative
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// GroupRefPrefix is the prefix used in a role's principals and in references
+// between resources of the same scope to refer to a group by its name rather
+// than its ID. This keeps documents portable between Boundary deployments.
+const GroupRefPrefix = "group:"
+
+// Document is the root of a declarative IAM configuration. It describes a
+// single scope subtree, rooted at Scope.
+type Document struct {
+	Scope *Scope `json:"scope"`
+}
+
+// Scope describes a scope and the IAM resources that live within it. Child
+// scopes are nested within their parent. The root scope of a document must
+// have an ID; all other resources are matched by ID if one is given and by
+// name otherwise.
+type Scope struct {
+	Id          string        `json:"id,omitempty" hcl:"id"`
+	Name        string        `json:"name,omitempty" hcl:"name"`
+	Description string        `json:"description,omitempty" hcl:"description"`
+	AuthMethods []*AuthMethod `json:"auth_method,omitempty" hcl:"-"`
+	Groups      []*Group      `json:"group,omitempty" hcl:"-"`
+	Roles       []*Role       `json:"role,omitempty" hcl:"-"`
+	Scopes      []*Scope      `json:"scope,omitempty" hcl:"-"`
+}
+
+// AuthMethod describes an auth method. Attributes are passed through to the
+// API as-is for the auth method's type.
+type AuthMethod struct {
+	Id          string         `json:"id,omitempty" hcl:"id"`
+	Name        string         `json:"name,omitempty" hcl:"name"`
+	Description string         `json:"description,omitempty" hcl:"description"`
+	Type        string         `json:"type" hcl:"type"`
+	Attributes  map[string]any `json:"attributes,omitempty" hcl:"-"`
+}
+
+// Group describes a group and its members.
+type Group struct {
+	Id          string   `json:"id,omitempty" hcl:"id"`
+	Name        string   `json:"name,omitempty" hcl:"name"`
+	Description string   `json:"description,omitempty" hcl:"description"`
+	MemberIds   []string `json:"member_ids,omitempty" hcl:"member_ids"`
+}
+
+// Role describes a role, its grants and its principals. Principals may refer
+// to a group in the same scope with the "group:<name>" form.
+type Role struct {
+	Id            string   `json:"id,omitempty" hcl:"id"`
+	Name          string   `json:"name,omitempty" hcl:"name"`
+	Description   string   `json:"description,omitempty" hcl:"description"`
+	GrantScopeIds []string `json:"grant_scope_ids,omitempty" hcl:"grant_scope_ids"`
+	Grants        []string `json:"grants,omitempty" hcl:"grants"`
+	Principals    []string `json:"principals,omitempty" hcl:"principals"`
+}
+
+// ParseDocument parses a document in either JSON or HCL format. JSON is
+// detected by a leading '{'.
+func ParseDocument(in []byte) (*Document, error) {
+	var doc *Document
+	switch trimmed := bytes.TrimSpace(in); {
+	case len(trimmed) == 0:
+		return nil, errors.New("document is empty")
+	case trimmed[0] == '{':
+		doc = new(Document)
+		dec := json.NewDecoder(bytes.NewReader(trimmed))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(doc); err != nil {
+			return nil, fmt.Errorf("error decoding JSON document: %w", err)
+		}
+	default:
+		var err error
+		doc, err = parseHcl(string(trimmed))
+		if err != nil {
+			return nil, fmt.Errorf("error decoding HCL document: %w", err)
+		}
+	}
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+	doc.normalize()
+	return doc, nil
+}
+
+// Validate checks the document for structural problems that would make it
+// impossible to reconcile, such as duplicate names within a scope.
+func (d *Document) Validate() error {
+	if d == nil || d.Scope == nil {
+		return errors.New("document must contain a root scope")
+	}
+	if d.Scope.Id == "" {
+		return errors.New("root scope must specify an id")
+	}
+	return d.Scope.validate(d.Scope.Id, 0)
+}
+
+func (s *Scope) validate(path string, depth int) error {
+	// Boundary scopes are at most three levels deep: global, org and project.
+	if depth > 2 {
+		return fmt.Errorf("scope %q: scopes cannot be nested more than three levels deep", path)
+	}
+	if err := checkUnique(path, "scope", len(s.Scopes), func(i int) (string, string) { return s.Scopes[i].Id, s.Scopes[i].Name }); err != nil {
+		return err
+	}
+	if err := checkUnique(path, "auth method", len(s.AuthMethods), func(i int) (string, string) { return s.AuthMethods[i].Id, s.AuthMethods[i].Name }); err != nil {
+		return err
+	}
+	if err := checkUnique(path, "group", len(s.Groups), func(i int) (string, string) { return s.Groups[i].Id, s.Groups[i].Name }); err != nil {
+		return err
+	}
+	if err := checkUnique(path, "role", len(s.Roles), func(i int) (string, string) { return s.Roles[i].Id, s.Roles[i].Name }); err != nil {
+		return err
+	}
+	for _, am := range s.AuthMethods {
+		if am.Type == "" {
+			return fmt.Errorf("scope %q: auth method %q is missing a type", path, am.Name)
+		}
+	}
+	groupNames := make(map[string]bool, len(s.Groups))
+	for _, g := range s.Groups {
+		groupNames[g.Name] = true
+	}
+	for _, r := range s.Roles {
+		for _, p := range r.Principals {
+			if name, ok := strings.CutPrefix(p, GroupRefPrefix); ok && !groupNames[name] {
+				return fmt.Errorf("scope %q: role %q references unknown group %q", path, r.Name, name)
+			}
+		}
+	}
+	for _, c := range s.Scopes {
+		if err := c.validate(path+"/"+c.displayName(), depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkUnique ensures every resource of the given kind has an ID or a name and
+// that neither is repeated within the scope.
+func checkUnique(path, kind string, n int, get func(int) (id, name string)) error {
+	ids := make(map[string]bool, n)
+	names := make(map[string]bool, n)
+	for i := 0; i < n; i++ {
+		id, name := get(i)
+		if id == "" && name == "" {
+			return fmt.Errorf("scope %q: %s at index %d must have an id or a name", path, kind, i)
+		}
+		if id != "" {
+			if ids[id] {
+				return fmt.Errorf("scope %q: duplicate %s id %q", path, kind, id)
+			}
+			ids[id] = true
+		}
+		if name != "" {
+			if names[name] {
+				return fmt.Errorf("scope %q: duplicate %s name %q", path, kind, name)
+			}
+			names[name] = true
+		}
+	}
+	return nil
+}
+
+func (s *Scope) displayName() string {
+	if s.Name != "" {
+		return s.Name
+	}
+	return s.Id
+}
+
+// normalize sorts list-valued fields whose order is not significant so that
+// documents can be compared and rendered deterministically.
+func (d *Document) normalize() {
+	var walk func(*Scope)
+	walk = func(s *Scope) {
+		for _, g := range s.Groups {
+			sort.Strings(g.MemberIds)
+		}
+		for _, r := range s.Roles {
+			sort.Strings(r.GrantScopeIds)
+			sort.Strings(r.Grants)
+			sort.Strings(r.Principals)
+		}
+		for _, am := range s.AuthMethods {
+			am.Attributes = normalizeAttributes(am.Attributes)
+		}
+		for _, c := range s.Scopes {
+			walk(c)
+		}
+	}
+	walk(d.Scope)
+}
+
+// normalizeAttributes round-trips attributes through JSON so that values
+// decoded from HCL, JSON and API responses compare equal.
+func normalizeAttributes(in map[string]any) map[string]any {
+	if len(in) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(in)
+	if err != nil {
+		return in
+	}
+	var out map[string]any
+	if err := json.Unmarshal(b, &out); err != nil {
+		return in
+	}
+	return out
+}
+
+// EncodeJSON renders the document as indented JSON.
+func (d *Document) EncodeJSON() ([]byte, error) {
+	return json.MarshalIndent(d, "", "  ")
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package declarative
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testHclDocument = `
+scope {
+  id = "global"
+  name = "Global"
+
+  auth_method {
+    name = "corp-oidc"
+    type = "oidc"
+    attributes = {
+      issuer = "https://idp.example.com"
+      max_age = 300
+      claims_scopes = ["profile", "email"]
+    }
+  }
+
+  group {
+    name = "admins"
+    member_ids = ["u_2222222222", "u_1111111111"]
+  }
+
+  role {
+    name = "admin"
+    grant_scope_ids = ["this", "descendants"]
+    grants = ["ids=*;type=*;actions=*"]
+    principals = ["group:admins"]
+  }
+
+  scope {
+    name = "eng"
+    description = "Engineering"
+
+    scope {
+      name = "prod"
+    }
+  }
+}
+`
+
+func TestParseDocument(t *testing.T) {
+	t.Parallel()
+
+	t.Run("hcl", func(t *testing.T) {
+		doc, err := ParseDocument([]byte(testHclDocument))
+		require.NoError(t, err)
+
+		root := doc.Scope
+		assert.Equal(t, "global", root.Id)
+		assert.Equal(t, "Global", root.Name)
+		require.Len(t, root.AuthMethods, 1)
+		assert.Equal(t, "oidc", root.AuthMethods[0].Type)
+		assert.Equal(t, map[string]any{
+			"issuer":        "https://idp.example.com",
+			"max_age":       float64(300),
+			"claims_scopes": []any{"profile", "email"},
+		}, root.AuthMethods[0].Attributes)
+		require.Len(t, root.Groups, 1)
+		assert.Equal(t, []string{"u_1111111111", "u_2222222222"}, root.Groups[0].MemberIds)
+		require.Len(t, root.Roles, 1)
+		assert.Equal(t, []string{"descendants", "this"}, root.Roles[0].GrantScopeIds)
+		assert.Equal(t, []string{"group:admins"}, root.Roles[0].Principals)
+		require.Len(t, root.Scopes, 1)
+		assert.Equal(t, "Engineering", root.Scopes[0].Description)
+		require.Len(t, root.Scopes[0].Scopes, 1)
+		assert.Equal(t, "prod", root.Scopes[0].Scopes[0].Name)
+	})
+
+	t.Run("round-trip", func(t *testing.T) {
+		doc, err := ParseDocument([]byte(testHclDocument))
+		require.NoError(t, err)
+
+		fromHcl, err := ParseDocument(doc.EncodeHCL())
+		require.NoError(t, err)
+		assert.Equal(t, doc, fromHcl)
+
+		js, err := doc.EncodeJSON()
+		require.NoError(t, err)
+		fromJson, err := ParseDocument(js)
+		require.NoError(t, err)
+		assert.Equal(t, doc, fromJson)
+	})
+
+	tests := []struct {
+		name    string
+		in      string
+		wantErr string
+	}{
+		{
+			name:    "empty",
+			in:      "  ",
+			wantErr: "document is empty",
+		},
+		{
+			name:    "no-root-id",
+			in:      `scope { name = "x" }`,
+			wantErr: "root scope must specify an id",
+		},
+		{
+			name:    "unknown-key",
+			in:      `scope { id = "global" colour = "blue" }`,
+			wantErr: `invalid key "colour" in scope`,
+		},
+		{
+			name:    "duplicate-name",
+			in:      `scope { id = "global" group { name = "a" } group { name = "a" } }`,
+			wantErr: `duplicate group name "a"`,
+		},
+		{
+			name:    "unnamed",
+			in:      `scope { id = "global" role { description = "a" } }`,
+			wantErr: "role at index 0 must have an id or a name",
+		},
+		{
+			name:    "missing-type",
+			in:      `scope { id = "global" auth_method { name = "a" } }`,
+			wantErr: `auth method "a" is missing a type`,
+		},
+		{
+			name:    "unknown-group-ref",
+			in:      `scope { id = "global" role { name = "r" principals = ["group:nope"] } }`,
+			wantErr: `role "r" references unknown group "nope"`,
+		},
+		{
+			name:    "too-deep",
+			in:      `scope { id = "global" scope { name = "o" scope { name = "p" scope { name = "x" } } } }`,
+			wantErr: "cannot be nested more than three levels deep",
+		},
+		{
+			name:    "json-unknown-field",
+			in:      `{"scope": {"id": "global", "colour": "blue"}}`,
+			wantErr: `unknown field "colour"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseDocument([]byte(tt.in))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestDocument_useGroupRefs(t *testing.T) {
+	t.Parallel()
+	doc := &Document{Scope: &Scope{
+		Id: "global",
+		Groups: []*Group{
+			{Id: "g_1234567890", Name: "admins"},
+			{Id: "g_0987654321"},
+		},
+		Roles: []*Role{
+			{Name: "r", Principals: []string{"g_1234567890", "g_0987654321", "u_1234567890"}},
+		},
+	}}
+	doc.useGroupRefs()
+	assert.Equal(t, []string{"g_0987654321", "group:admins", "u_1234567890"}, doc.Scope.Roles[0].Principals)
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package declarative
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ExportCommand)(nil)
+	_ cli.CommandAutocomplete = (*ExportCommand)(nil)
+)
+
+type ExportCommand struct {
+	*base.Command
+
+	flagFile   string
+	flagFormat string
+}
+
+func (c *ExportCommand) Synopsis() string {
+	return wordwrap.WrapString("Export the IAM configuration of a scope subtree as a document", base.TermWidth)
+}
+
+func (c *ExportCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary export [options]",
+		"",
+		"  Export a scope, its child scopes, and the auth methods, groups and roles within them as a declarative document. The document can be stored in version control and reconciled back into Boundary with \"boundary apply\". Example:",
+		"",
+		`    $ boundary export -scope-id global -file boundary.hcl`,
+		"",
+		"  Secrets such as OIDC client secrets and LDAP bind passwords cannot be read from Boundary and are not exported. Role principals that are named groups in the same scope are written as \"group:<name>\" so the document can be applied to another deployment.",
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ExportCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:    "scope-id",
+		Target:  &c.FlagScopeId,
+		Default: "global",
+		Usage:   "The id of the scope at the root of the exported subtree.",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "file",
+		Target:     &c.flagFile,
+		Completion: complete.PredictFiles("*"),
+		Usage:      "The file to write the document to. If not set, the document is written to standard output.",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "format",
+		Target:     &c.flagFormat,
+		Default:    "hcl",
+		Completion: complete.PredictSet("hcl", "json"),
+		Usage:      `The document format, either "hcl" or "json".`,
+	})
+
+	return set
+}
+
+func (c *ExportCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ExportCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ExportCommand) Run(args []string) int {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagScopeId == "":
+		c.PrintCliError(errors.New("Scope ID must be provided via -scope-id"))
+		return base.CommandUserError
+	case c.flagFormat != "hcl" && c.flagFormat != "json":
+		c.PrintCliError(fmt.Errorf("Unknown format %q; must be \"hcl\" or \"json\"", c.flagFormat))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	doc, err := fetchState(c.Context, client, c.FlagScopeId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when reading configuration")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error reading configuration: %w", err))
+		return base.CommandCliError
+	}
+	doc.useGroupRefs()
+
+	var out []byte
+	switch c.flagFormat {
+	case "json":
+		out, err = doc.EncodeJSON()
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error encoding document: %w", err))
+			return base.CommandCliError
+		}
+		out = append(out, '\n')
+	default:
+		out = doc.EncodeHCL()
+	}
+
+	if c.flagFile == "" {
+		c.UI.Output(string(out))
+		return base.CommandSuccess
+	}
+	if err := os.WriteFile(c.flagFile, out, 0o644); err != nil {
+		c.PrintCliError(fmt.Errorf("Error writing document: %w", err))
+		return base.CommandCliError
+	}
+	c.UI.Output(fmt.Sprintf("Configuration of scope %s written to %s.", doc.Scope.Id, c.flagFile))
+	return base.CommandSuccess
+}
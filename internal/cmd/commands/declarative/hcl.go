@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package declarative
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/hcl/hcl/ast"
+)
+
+var hclIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_\-]*$`)
+
+// parseHcl walks the HCL AST block by block, mirroring how the server
+// configuration is parsed, since nested repeated blocks do not decode reliably
+// into slices of structs.
+func parseHcl(in string) (*Document, error) {
+	obj, err := hcl.Parse(in)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := obj.Node.(*ast.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("file doesn't contain a root object")
+	}
+	if err := checkKeys(list, "document", "scope"); err != nil {
+		return nil, err
+	}
+	scopes := list.Filter("scope")
+	if len(scopes.Items) != 1 {
+		return nil, fmt.Errorf("expected exactly one root scope block, found %d", len(scopes.Items))
+	}
+	root, err := parseScope(scopes.Items[0])
+	if err != nil {
+		return nil, err
+	}
+	return &Document{Scope: root}, nil
+}
+
+func parseScope(item *ast.ObjectItem) (*Scope, error) {
+	body, err := blockBody(item, "scope")
+	if err != nil {
+		return nil, err
+	}
+	if err := checkKeys(body, "scope", "id", "name", "description", "auth_method", "group", "role", "scope"); err != nil {
+		return nil, err
+	}
+	s := new(Scope)
+	if err := hcl.DecodeObject(s, item.Val); err != nil {
+		return nil, fmt.Errorf("error decoding scope: %w", err)
+	}
+	for i, item := range body.Filter("auth_method").Items {
+		am := new(AuthMethod)
+		if err := decodeBlock(item, "auth_method", am, "id", "name", "description", "type", "attributes"); err != nil {
+			return nil, fmt.Errorf("error decoding auth_method entry %d: %w", i, err)
+		}
+		if attrs := item.Val.(*ast.ObjectType).List.Filter("attributes"); len(attrs.Items) > 0 {
+			var m map[string]any
+			if err := hcl.DecodeObject(&m, attrs.Items[0].Val); err != nil {
+				return nil, fmt.Errorf("error decoding attributes of auth_method entry %d: %w", i, err)
+			}
+			am.Attributes = flattenHclValue(m).(map[string]any)
+		}
+		s.AuthMethods = append(s.AuthMethods, am)
+	}
+	for i, item := range body.Filter("group").Items {
+		g := new(Group)
+		if err := decodeBlock(item, "group", g, "id", "name", "description", "member_ids"); err != nil {
+			return nil, fmt.Errorf("error decoding group entry %d: %w", i, err)
+		}
+		s.Groups = append(s.Groups, g)
+	}
+	for i, item := range body.Filter("role").Items {
+		r := new(Role)
+		if err := decodeBlock(item, "role", r, "id", "name", "description", "grant_scope_ids", "grants", "principals"); err != nil {
+			return nil, fmt.Errorf("error decoding role entry %d: %w", i, err)
+		}
+		s.Roles = append(s.Roles, r)
+	}
+	for _, item := range body.Filter("scope").Items {
+		c, err := parseScope(item)
+		if err != nil {
+			return nil, err
+		}
+		s.Scopes = append(s.Scopes, c)
+	}
+	return s, nil
+}
+
+func decodeBlock(item *ast.ObjectItem, kind string, out any, allowed ...string) error {
+	body, err := blockBody(item, kind)
+	if err != nil {
+		return err
+	}
+	if err := checkKeys(body, kind, allowed...); err != nil {
+		return err
+	}
+	return hcl.DecodeObject(out, item.Val)
+}
+
+func blockBody(item *ast.ObjectItem, kind string) (*ast.ObjectList, error) {
+	if len(item.Keys) > 1 {
+		return nil, fmt.Errorf("%s blocks do not take labels", kind)
+	}
+	obj, ok := item.Val.(*ast.ObjectType)
+	if !ok {
+		return nil, fmt.Errorf("%s must be a block", kind)
+	}
+	return obj.List, nil
+}
+
+func checkKeys(list *ast.ObjectList, kind string, allowed ...string) error {
+	for _, item := range list.Items {
+		if len(item.Keys) == 0 {
+			continue
+		}
+		key := item.Keys[0].Token.Value().(string)
+		var found bool
+		for _, a := range allowed {
+			if key == a {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("invalid key %q in %s", key, kind)
+		}
+	}
+	return nil
+}
+
+// flattenHclValue undoes HCL's habit of decoding nested objects into a list of
+// maps when decoding into an interface value.
+func flattenHclValue(in any) any {
+	switch v := in.(type) {
+	case []map[string]any:
+		if len(v) == 1 {
+			return flattenHclValue(v[0])
+		}
+		out := make([]any, 0, len(v))
+		for _, e := range v {
+			out = append(out, flattenHclValue(e))
+		}
+		return out
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, e := range v {
+			out[k] = flattenHclValue(e)
+		}
+		return out
+	case []any:
+		out := make([]any, 0, len(v))
+		for _, e := range v {
+			out = append(out, flattenHclValue(e))
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// EncodeHCL renders the document in HCL format.
+func (d *Document) EncodeHCL() []byte {
+	var b strings.Builder
+	writeScope(&b, 0, d.Scope)
+	return []byte(b.String())
+}
+
+func writeScope(b *strings.Builder, depth int, s *Scope) {
+	openBlock(b, depth, "scope")
+	writeString(b, depth+1, "id", s.Id)
+	writeString(b, depth+1, "name", s.Name)
+	writeString(b, depth+1, "description", s.Description)
+	for _, am := range s.AuthMethods {
+		b.WriteString("\n")
+		openBlock(b, depth+1, "auth_method")
+		writeString(b, depth+2, "id", am.Id)
+		writeString(b, depth+2, "name", am.Name)
+		writeString(b, depth+2, "description", am.Description)
+		writeString(b, depth+2, "type", am.Type)
+		if len(am.Attributes) > 0 {
+			indent(b, depth+2)
+			b.WriteString("attributes = ")
+			writeValue(b, depth+2, am.Attributes)
+			b.WriteString("\n")
+		}
+		closeBlock(b, depth+1)
+	}
+	for _, g := range s.Groups {
+		b.WriteString("\n")
+		openBlock(b, depth+1, "group")
+		writeString(b, depth+2, "id", g.Id)
+		writeString(b, depth+2, "name", g.Name)
+		writeString(b, depth+2, "description", g.Description)
+		writeList(b, depth+2, "member_ids", g.MemberIds)
+		closeBlock(b, depth+1)
+	}
+	for _, r := range s.Roles {
+		b.WriteString("\n")
+		openBlock(b, depth+1, "role")
+		writeString(b, depth+2, "id", r.Id)
+		writeString(b, depth+2, "name", r.Name)
+		writeString(b, depth+2, "description", r.Description)
+		writeList(b, depth+2, "grant_scope_ids", r.GrantScopeIds)
+		writeList(b, depth+2, "grants", r.Grants)
+		writeList(b, depth+2, "principals", r.Principals)
+		closeBlock(b, depth+1)
+	}
+	for _, c := range s.Scopes {
+		b.WriteString("\n")
+		writeScope(b, depth+1, c)
+	}
+	closeBlock(b, depth)
+}
+
+func indent(b *strings.Builder, depth int) {
+	b.WriteString(strings.Repeat("  ", depth))
+}
+
+func openBlock(b *strings.Builder, depth int, name string) {
+	indent(b, depth)
+	b.WriteString(name + " {\n")
+}
+
+func closeBlock(b *strings.Builder, depth int) {
+	indent(b, depth)
+	b.WriteString("}\n")
+}
+
+func writeString(b *strings.Builder, depth int, key, value string) {
+	if value == "" {
+		return
+	}
+	indent(b, depth)
+	fmt.Fprintf(b, "%s = %s\n", key, strconv.Quote(value))
+}
+
+func writeList(b *strings.Builder, depth int, key string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+	indent(b, depth)
+	b.WriteString(key + " = [\n")
+	for _, v := range values {
+		indent(b, depth+1)
+		b.WriteString(strconv.Quote(v) + ",\n")
+	}
+	indent(b, depth)
+	b.WriteString("]\n")
+}
+
+func writeValue(b *strings.Builder, depth int, value any) {
+	switch v := value.(type) {
+	case string:
+		b.WriteString(strconv.Quote(v))
+	case bool:
+		b.WriteString(strconv.FormatBool(v))
+	case float64:
+		b.WriteString(strconv.FormatFloat(v, 'f', -1, 64))
+	case int:
+		b.WriteString(strconv.Itoa(v))
+	case []any:
+		b.WriteString("[")
+		for i, e := range v {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			writeValue(b, depth, e)
+		}
+		b.WriteString("]")
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		b.WriteString("{\n")
+		for _, k := range keys {
+			if v[k] == nil {
+				continue
+			}
+			indent(b, depth+1)
+			if hclIdentifier.MatchString(k) {
+				b.WriteString(k)
+			} else {
+				b.WriteString(strconv.Quote(k))
+			}
+			b.WriteString(" = ")
+			writeValue(b, depth+1, v[k])
+			b.WriteString("\n")
+		}
+		indent(b, depth)
+		b.WriteString("}")
+	default:
+		b.WriteString(strconv.Quote(fmt.Sprint(v)))
+	}
+}
@@ -0,0 +1,407 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package declarative
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Action is the kind of operation a Change performs.
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Kind is the type of resource a Change operates on.
+type Kind string
+
+const (
+	KindScope      Kind = "scope"
+	KindAuthMethod Kind = "auth-method"
+	KindGroup      Kind = "group"
+	KindRole       Kind = "role"
+)
+
+// Change is a single operation needed to reconcile the server with a
+// document.
+type Change struct {
+	Action Action
+	Kind   Kind
+	// Path is the slash-separated path of the scope containing the resource,
+	// or of the scope itself for scope changes.
+	Path string
+	Name string
+	// Id is the ID of the existing resource for updates and deletes.
+	Id string
+	// Details describes the differences found for an update.
+	Details []string
+
+	// container is the desired scope the resource lives in. For a scope
+	// change it is the scope's parent.
+	container  *Scope
+	scope      *Scope
+	authMethod *AuthMethod
+	group      *Group
+	role       *Role
+}
+
+// Plan is an ordered list of changes. Creates and updates are ordered so that
+// every resource is created after the resources it depends on: scopes first,
+// parents before children, then auth methods and groups, and finally roles.
+// Deletes come last, children before their scopes.
+type Plan struct {
+	Changes []*Change
+}
+
+// Empty reports whether applying the plan would change anything.
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String renders the plan as a human readable diff preview.
+func (p *Plan) String() string {
+	if p.Empty() {
+		return "No changes. The server matches the document.\n"
+	}
+	var b strings.Builder
+	var creates, updates, deletes int
+	for _, c := range p.Changes {
+		var sym string
+		switch c.Action {
+		case ActionCreate:
+			sym = "+"
+			creates++
+		case ActionUpdate:
+			sym = "~"
+			updates++
+		case ActionDelete:
+			sym = "-"
+			deletes++
+		}
+		fmt.Fprintf(&b, "%s %s %s %q", sym, c.Action, c.Kind, c.Name)
+		if c.Id != "" {
+			fmt.Fprintf(&b, " (%s)", c.Id)
+		}
+		fmt.Fprintf(&b, " in %s\n", c.Path)
+		for _, d := range c.Details {
+			fmt.Fprintf(&b, "    %s\n", d)
+		}
+	}
+	fmt.Fprintf(&b, "\nPlan: %d to create, %d to update, %d to delete.\n", creates, updates, deletes)
+	return b.String()
+}
+
+// ComputePlan compares the desired document with the current state read from
+// the server and returns the changes needed to make the server match. When
+// prune is true, resources that exist on the server but not in the document
+// are deleted.
+//
+// ComputePlan fills in the IDs of desired resources that match existing ones
+// so that the plan can be executed against the desired document.
+func ComputePlan(desired, current *Document, prune bool) (*Plan, error) {
+	if err := desired.Validate(); err != nil {
+		return nil, err
+	}
+	if current == nil || current.Scope == nil {
+		return nil, fmt.Errorf("current state has no root scope")
+	}
+	if desired.Scope.Id != current.Scope.Id {
+		return nil, fmt.Errorf("document root scope %q does not match server scope %q", desired.Scope.Id, current.Scope.Id)
+	}
+	p := &planner{prune: prune}
+	if err := p.planScope(nil, desired.Scope, current.Scope, desired.Scope.Id); err != nil {
+		return nil, err
+	}
+	p.planRoles(desired.Scope, current.Scope, desired.Scope.Id)
+
+	changes := append(p.changes, p.roleChanges...)
+	changes = append(changes, p.deletes...)
+	// Scope deletes are collected parent first; delete children first so that
+	// no delete refers to an already cascaded scope.
+	for i := len(p.scopeDeletes) - 1; i >= 0; i-- {
+		changes = append(changes, p.scopeDeletes[i])
+	}
+	return &Plan{Changes: changes}, nil
+}
+
+type planner struct {
+	prune        bool
+	changes      []*Change
+	roleChanges  []*Change
+	deletes      []*Change
+	scopeDeletes []*Change
+}
+
+// planScope plans changes for a scope, its auth methods and groups, and
+// recurses into its children. current is nil if the scope does not exist.
+func (p *planner) planScope(parent, desired, current *Scope, path string) error {
+	switch {
+	case current == nil:
+		p.changes = append(p.changes, &Change{
+			Action:    ActionCreate,
+			Kind:      KindScope,
+			Path:      path,
+			Name:      desired.displayName(),
+			container: parent,
+			scope:     desired,
+		})
+	default:
+		desired.Id = current.Id
+		var details []string
+		details = diffString(details, "name", current.Name, desired.Name)
+		details = diffString(details, "description", current.Description, desired.Description)
+		if len(details) > 0 {
+			p.changes = append(p.changes, &Change{
+				Action:    ActionUpdate,
+				Kind:      KindScope,
+				Path:      path,
+				Name:      desired.displayName(),
+				Id:        current.Id,
+				Details:   details,
+				container: parent,
+				scope:     desired,
+			})
+		}
+	}
+
+	var currentAuthMethods []*AuthMethod
+	var currentGroups []*Group
+	var currentScopes []*Scope
+	if current != nil {
+		currentAuthMethods = current.AuthMethods
+		currentGroups = current.Groups
+		currentScopes = current.Scopes
+	}
+
+	used := make(map[string]bool)
+	for _, am := range desired.AuthMethods {
+		cur := findMatch(len(currentAuthMethods), func(i int) (string, string) {
+			return currentAuthMethods[i].Id, currentAuthMethods[i].Name
+		}, am.Id, am.Name)
+		if cur < 0 {
+			p.changes = append(p.changes, &Change{Action: ActionCreate, Kind: KindAuthMethod, Path: path, Name: am.Name, container: desired, authMethod: am})
+			continue
+		}
+		c := currentAuthMethods[cur]
+		used[c.Id] = true
+		if c.Type != am.Type {
+			return fmt.Errorf("scope %q: auth method %q is of type %q on the server and cannot be changed to %q", path, c.Id, c.Type, am.Type)
+		}
+		am.Id = c.Id
+		var details []string
+		details = diffString(details, "name", c.Name, am.Name)
+		details = diffString(details, "description", c.Description, am.Description)
+		details = diffAttributes(details, c.Attributes, am.Attributes)
+		if len(details) > 0 {
+			p.changes = append(p.changes, &Change{Action: ActionUpdate, Kind: KindAuthMethod, Path: path, Name: am.Name, Id: c.Id, Details: details, container: desired, authMethod: am})
+		}
+	}
+	if p.prune {
+		for _, c := range currentAuthMethods {
+			if !used[c.Id] {
+				p.deletes = append(p.deletes, &Change{Action: ActionDelete, Kind: KindAuthMethod, Path: path, Name: c.Name, Id: c.Id})
+			}
+		}
+	}
+
+	for _, g := range desired.Groups {
+		cur := findMatch(len(currentGroups), func(i int) (string, string) {
+			return currentGroups[i].Id, currentGroups[i].Name
+		}, g.Id, g.Name)
+		if cur < 0 {
+			p.changes = append(p.changes, &Change{Action: ActionCreate, Kind: KindGroup, Path: path, Name: g.Name, container: desired, group: g})
+			continue
+		}
+		c := currentGroups[cur]
+		used[c.Id] = true
+		g.Id = c.Id
+		var details []string
+		details = diffString(details, "name", c.Name, g.Name)
+		details = diffString(details, "description", c.Description, g.Description)
+		details = diffSet(details, "member_ids", c.MemberIds, g.MemberIds)
+		if len(details) > 0 {
+			p.changes = append(p.changes, &Change{Action: ActionUpdate, Kind: KindGroup, Path: path, Name: g.Name, Id: c.Id, Details: details, container: desired, group: g})
+		}
+	}
+	if p.prune {
+		for _, c := range currentGroups {
+			if !used[c.Id] {
+				p.deletes = append(p.deletes, &Change{Action: ActionDelete, Kind: KindGroup, Path: path, Name: c.Name, Id: c.Id})
+			}
+		}
+	}
+
+	for _, child := range desired.Scopes {
+		childPath := path + "/" + child.displayName()
+		cur := findMatch(len(currentScopes), func(i int) (string, string) {
+			return currentScopes[i].Id, currentScopes[i].Name
+		}, child.Id, child.Name)
+		var c *Scope
+		if cur >= 0 {
+			c = currentScopes[cur]
+			used[c.Id] = true
+		}
+		if err := p.planScope(desired, child, c, childPath); err != nil {
+			return err
+		}
+	}
+	if p.prune {
+		for _, c := range currentScopes {
+			if !used[c.Id] {
+				// Deleting a scope cascades to everything inside it.
+				p.scopeDeletes = append(p.scopeDeletes, &Change{Action: ActionDelete, Kind: KindScope, Path: path + "/" + c.displayName(), Name: c.displayName(), Id: c.Id})
+			}
+		}
+	}
+	return nil
+}
+
+// planRoles plans role changes once every scope, auth method and group has
+// been planned, as roles may refer to any of them.
+func (p *planner) planRoles(desired, current *Scope, path string) {
+	var currentRoles []*Role
+	var currentScopes []*Scope
+	if current != nil {
+		currentRoles = current.Roles
+		currentScopes = current.Scopes
+	}
+	used := make(map[string]bool)
+	for _, r := range desired.Roles {
+		cur := findMatch(len(currentRoles), func(i int) (string, string) {
+			return currentRoles[i].Id, currentRoles[i].Name
+		}, r.Id, r.Name)
+		if cur < 0 {
+			p.roleChanges = append(p.roleChanges, &Change{Action: ActionCreate, Kind: KindRole, Path: path, Name: r.Name, container: desired, role: r})
+			continue
+		}
+		c := currentRoles[cur]
+		used[c.Id] = true
+		r.Id = c.Id
+		var details []string
+		details = diffString(details, "name", c.Name, r.Name)
+		details = diffString(details, "description", c.Description, r.Description)
+		details = diffSet(details, "grant_scope_ids", c.GrantScopeIds, r.GrantScopeIds)
+		details = diffSet(details, "grants", c.Grants, r.Grants)
+		details = diffSet(details, "principals", c.Principals, resolvePrincipals(desired, r.Principals))
+		if len(details) > 0 {
+			p.roleChanges = append(p.roleChanges, &Change{Action: ActionUpdate, Kind: KindRole, Path: path, Name: r.Name, Id: c.Id, Details: details, container: desired, role: r})
+		}
+	}
+	if p.prune && current != nil {
+		for _, c := range currentRoles {
+			if !used[c.Id] {
+				p.deletes = append(p.deletes, &Change{Action: ActionDelete, Kind: KindRole, Path: path, Name: c.Name, Id: c.Id})
+			}
+		}
+	}
+	for _, child := range desired.Scopes {
+		var c *Scope
+		if cur := findMatch(len(currentScopes), func(i int) (string, string) {
+			return currentScopes[i].Id, currentScopes[i].Name
+		}, child.Id, child.Name); cur >= 0 {
+			c = currentScopes[cur]
+		}
+		p.planRoles(child, c, path+"/"+child.displayName())
+	}
+}
+
+// resolvePrincipals replaces "group:<name>" references with the ID of the
+// named group in the given scope. References to groups that do not exist yet
+// are left as-is, which guarantees they show up as a difference.
+func resolvePrincipals(s *Scope, principals []string) []string {
+	out := make([]string, 0, len(principals))
+	for _, p := range principals {
+		if name, ok := strings.CutPrefix(p, GroupRefPrefix); ok {
+			for _, g := range s.Groups {
+				if g.Name == name && g.Id != "" {
+					p = g.Id
+					break
+				}
+			}
+		}
+		out = append(out, p)
+	}
+	return out
+}
+
+// findMatch returns the index of the current resource matching the desired
+// one, preferring a match by ID, or -1 if there is none.
+func findMatch(n int, get func(int) (id, name string), id, name string) int {
+	for i := 0; i < n; i++ {
+		curId, curName := get(i)
+		if id != "" {
+			if curId == id {
+				return i
+			}
+			continue
+		}
+		if name != "" && curName == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func diffString(details []string, field, current, desired string) []string {
+	if current == desired {
+		return details
+	}
+	return append(details, fmt.Sprintf("%s: %q => %q", field, current, desired))
+}
+
+func diffSet(details []string, field string, current, desired []string) []string {
+	have := make(map[string]bool, len(current))
+	for _, v := range current {
+		have[v] = true
+	}
+	want := make(map[string]bool, len(desired))
+	for _, v := range desired {
+		want[v] = true
+	}
+	var added, removed []string
+	for v := range want {
+		if !have[v] {
+			added = append(added, v)
+		}
+	}
+	for v := range have {
+		if !want[v] {
+			removed = append(removed, v)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	for _, v := range added {
+		details = append(details, fmt.Sprintf("%s: + %s", field, v))
+	}
+	for _, v := range removed {
+		details = append(details, fmt.Sprintf("%s: - %s", field, v))
+	}
+	return details
+}
+
+// diffAttributes compares only the attributes present in the document, since
+// the server fills in defaults for anything left unset.
+func diffAttributes(details []string, current, desired map[string]any) []string {
+	keys := make([]string, 0, len(desired))
+	for k := range desired {
+		if readOnlyAttributes[k] || writeOnlyAttributes[k] {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if !reflect.DeepEqual(current[k], desired[k]) {
+			details = append(details, fmt.Sprintf("attributes.%s: %v => %v", k, current[k], desired[k]))
+		}
+	}
+	return details
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package declarative
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCurrentState() *Document {
+	doc := &Document{Scope: &Scope{
+		Id:   "global",
+		Name: "Global",
+		AuthMethods: []*AuthMethod{
+			{Id: "amoidc_1234567890", Name: "corp-oidc", Type: "oidc", Attributes: map[string]any{
+				"issuer":        "https://idp.example.com",
+				"max_age":       float64(300),
+				"claims_scopes": []any{"profile", "email"},
+				"client_id":     "boundary",
+			}},
+		},
+		Groups: []*Group{
+			{Id: "g_1234567890", Name: "admins", MemberIds: []string{"u_1111111111", "u_2222222222"}},
+		},
+		Roles: []*Role{
+			{Id: "r_1234567890", Name: "admin", GrantScopeIds: []string{"this", "descendants"}, Grants: []string{"ids=*;type=*;actions=*"}, Principals: []string{"g_1234567890"}},
+		},
+		Scopes: []*Scope{
+			{Id: "o_1234567890", Name: "eng", Description: "Engineering", Scopes: []*Scope{
+				{Id: "p_1234567890", Name: "prod"},
+			}},
+		},
+	}}
+	doc.normalize()
+	return doc
+}
+
+func testDesiredState(t *testing.T) *Document {
+	t.Helper()
+	doc, err := ParseDocument([]byte(testHclDocument))
+	require.NoError(t, err)
+	return doc
+}
+
+func kinds(p *Plan) []string {
+	var out []string
+	for _, c := range p.Changes {
+		out = append(out, string(c.Action)+" "+string(c.Kind)+" "+c.Name)
+	}
+	return out
+}
+
+func TestComputePlan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no-changes", func(t *testing.T) {
+		plan, err := ComputePlan(testDesiredState(t), testCurrentState(), true)
+		require.NoError(t, err)
+		assert.True(t, plan.Empty(), plan.String())
+	})
+
+	t.Run("export-is-idempotent", func(t *testing.T) {
+		exported := testCurrentState()
+		exported.useGroupRefs()
+		desired, err := ParseDocument(exported.EncodeHCL())
+		require.NoError(t, err)
+		plan, err := ComputePlan(desired, testCurrentState(), true)
+		require.NoError(t, err)
+		assert.True(t, plan.Empty(), plan.String())
+	})
+
+	t.Run("create-everything", func(t *testing.T) {
+		current := &Document{Scope: &Scope{Id: "global", Name: "Global"}}
+		desired := testDesiredState(t)
+		plan, err := ComputePlan(desired, current, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"create auth-method corp-oidc",
+			"create group admins",
+			"create scope eng",
+			"create scope prod",
+			"create role admin",
+		}, kinds(plan))
+		// The root scope is matched, so creating its children can refer to it.
+		assert.Same(t, desired.Scope, plan.Changes[0].container)
+		assert.Same(t, desired.Scope.Scopes[0], plan.Changes[3].container)
+	})
+
+	t.Run("roles-after-child-scopes", func(t *testing.T) {
+		current := &Document{Scope: &Scope{Id: "global", Name: "Global"}}
+		desired := &Document{Scope: &Scope{
+			Id:    "global",
+			Name:  "Global",
+			Roles: []*Role{{Name: "r"}},
+			Scopes: []*Scope{
+				{Name: "o", Groups: []*Group{{Name: "g"}}},
+			},
+		}}
+		plan, err := ComputePlan(desired, current, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"create scope o",
+			"create group g",
+			"create role r",
+		}, kinds(plan))
+	})
+
+	t.Run("updates", func(t *testing.T) {
+		desired := testDesiredState(t)
+		desired.Scope.Scopes[0].Description = "Engineering org"
+		desired.Scope.Groups[0].MemberIds = []string{"u_1111111111", "u_3333333333"}
+		desired.Scope.Roles[0].Grants = append(desired.Scope.Roles[0].Grants, "ids=*;type=session;actions=list")
+		desired.Scope.AuthMethods[0].Attributes["max_age"] = float64(600)
+		// Secrets are never read back so they must not cause a difference.
+		desired.Scope.AuthMethods[0].Attributes["client_secret"] = "shh"
+
+		plan, err := ComputePlan(desired, testCurrentState(), false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"update auth-method corp-oidc",
+			"update group admins",
+			"update scope eng",
+			"update role admin",
+		}, kinds(plan))
+		assert.Equal(t, []string{"attributes.max_age: 300 => 600"}, plan.Changes[0].Details)
+		assert.Equal(t, []string{"member_ids: + u_3333333333", "member_ids: - u_2222222222"}, plan.Changes[1].Details)
+		assert.Equal(t, []string{`description: "Engineering" => "Engineering org"`}, plan.Changes[2].Details)
+		assert.Equal(t, []string{"grants: + ids=*;type=session;actions=list"}, plan.Changes[3].Details)
+	})
+
+	t.Run("prune", func(t *testing.T) {
+		desired := testDesiredState(t)
+		desired.Scope.Roles = nil
+		desired.Scope.Scopes = nil
+		desired.Scope.AuthMethods = nil
+
+		plan, err := ComputePlan(desired, testCurrentState(), false)
+		require.NoError(t, err)
+		assert.True(t, plan.Empty())
+
+		plan, err = ComputePlan(desired, testCurrentState(), true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"delete auth-method corp-oidc",
+			"delete role admin",
+			"delete scope eng",
+		}, kinds(plan))
+	})
+
+	t.Run("match-by-id", func(t *testing.T) {
+		desired := testDesiredState(t)
+		desired.Scope.Scopes[0].Id = "o_1234567890"
+		desired.Scope.Scopes[0].Name = "engineering"
+		plan, err := ComputePlan(desired, testCurrentState(), true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"update scope engineering"}, kinds(plan))
+	})
+
+	t.Run("type-change", func(t *testing.T) {
+		desired := testDesiredState(t)
+		desired.Scope.AuthMethods[0].Type = "ldap"
+		_, err := ComputePlan(desired, testCurrentState(), false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot be changed")
+	})
+
+	t.Run("root-mismatch", func(t *testing.T) {
+		desired := testDesiredState(t)
+		desired.Scope.Id = "o_1234567890"
+		_, err := ComputePlan(desired, testCurrentState(), false)
+		require.Error(t, err)
+	})
+}
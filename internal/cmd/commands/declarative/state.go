@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package declarative
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/api/groups"
+	"github.com/hashicorp/boundary/api/roles"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/globals"
+)
+
+// readOnlyAttributes are auth method attributes that are computed by the
+// server or only meaningful for a single request. They are never exported
+// and never compared.
+var readOnlyAttributes = map[string]bool{
+	"state":                                true,
+	"callback_url":                         true,
+	"client_secret_hmac":                   true,
+	"bind_password_hmac":                   true,
+	"client_certificate_key_hmac":          true,
+	"dry_run":                              true,
+	"disable_discovered_config_validation": true,
+}
+
+// writeOnlyAttributes are secrets the server accepts but never returns. They
+// are sent when an auth method is created or updated, but differences in them
+// alone never cause an update, as the current value cannot be read back.
+var writeOnlyAttributes = map[string]bool{
+	"client_secret":          true,
+	"bind_password":          true,
+	"client_certificate_key": true,
+}
+
+// fetchState reads the scope subtree rooted at rootId along with the auth
+// methods, groups and roles inside it. Scopes are listed recursively, which
+// the controller services through iam.Repository.ListScopesRecursively. Group
+// members, role grants and role principals are not part of list responses so
+// each group and role is read individually. Principals are returned as IDs.
+func fetchState(ctx context.Context, client *api.Client, rootId string) (*Document, error) {
+	scopeClient := scopes.NewClient(client)
+	rootResult, err := scopeClient.Read(ctx, rootId)
+	if err != nil {
+		return nil, fmt.Errorf("error reading scope %q: %w", rootId, err)
+	}
+	root := &Scope{
+		Id:          rootResult.Item.Id,
+		Name:        rootResult.Item.Name,
+		Description: rootResult.Item.Description,
+	}
+	byId := map[string]*Scope{root.Id: root}
+
+	// A project has no child scopes, so listing beneath one is an error.
+	if !strings.HasPrefix(rootId, globals.ProjectPrefix+"_") {
+		scopeList, err := scopeClient.List(ctx, rootId, scopes.WithRecursive(true))
+		if err != nil {
+			return nil, fmt.Errorf("error listing scopes: %w", err)
+		}
+		// Recursive list results are not ordered parent first, so create
+		// every node before linking them together.
+		items := scopeList.Items
+		for _, item := range items {
+			if item.Id == root.Id {
+				continue
+			}
+			byId[item.Id] = &Scope{
+				Id:          item.Id,
+				Name:        item.Name,
+				Description: item.Description,
+			}
+		}
+		for _, item := range items {
+			parent, ok := byId[item.ScopeId]
+			if !ok || item.Id == root.Id {
+				continue
+			}
+			parent.Scopes = append(parent.Scopes, byId[item.Id])
+		}
+	}
+
+	amList, err := authmethods.NewClient(client).List(ctx, rootId, authmethods.WithRecursive(true))
+	if err != nil {
+		return nil, fmt.Errorf("error listing auth methods: %w", err)
+	}
+	for _, item := range amList.Items {
+		s, ok := byId[item.ScopeId]
+		if !ok {
+			continue
+		}
+		attrs := make(map[string]any, len(item.Attributes))
+		for k, v := range item.Attributes {
+			if readOnlyAttributes[k] || writeOnlyAttributes[k] {
+				continue
+			}
+			attrs[k] = v
+		}
+		s.AuthMethods = append(s.AuthMethods, &AuthMethod{
+			Id:          item.Id,
+			Name:        item.Name,
+			Description: item.Description,
+			Type:        item.Type,
+			Attributes:  attrs,
+		})
+	}
+
+	groupClient := groups.NewClient(client)
+	groupList, err := groupClient.List(ctx, rootId, groups.WithRecursive(true))
+	if err != nil {
+		return nil, fmt.Errorf("error listing groups: %w", err)
+	}
+	for _, item := range groupList.Items {
+		s, ok := byId[item.ScopeId]
+		if !ok {
+			continue
+		}
+		g, err := groupClient.Read(ctx, item.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error reading group %q: %w", item.Id, err)
+		}
+		s.Groups = append(s.Groups, &Group{
+			Id:          g.Item.Id,
+			Name:        g.Item.Name,
+			Description: g.Item.Description,
+			MemberIds:   g.Item.MemberIds,
+		})
+	}
+
+	roleClient := roles.NewClient(client)
+	roleList, err := roleClient.List(ctx, rootId, roles.WithRecursive(true))
+	if err != nil {
+		return nil, fmt.Errorf("error listing roles: %w", err)
+	}
+	for _, item := range roleList.Items {
+		s, ok := byId[item.ScopeId]
+		if !ok {
+			continue
+		}
+		r, err := roleClient.Read(ctx, item.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error reading role %q: %w", item.Id, err)
+		}
+		s.Roles = append(s.Roles, &Role{
+			Id:            r.Item.Id,
+			Name:          r.Item.Name,
+			Description:   r.Item.Description,
+			GrantScopeIds: r.Item.GrantScopeIds,
+			Grants:        r.Item.GrantStrings,
+			Principals:    r.Item.PrincipalIds,
+		})
+	}
+
+	doc := &Document{Scope: root}
+	doc.normalize()
+	return doc, nil
+}
+
+// useGroupRefs rewrites role principals that are named groups in the role's
+// own scope into the portable "group:<name>" form. It is applied to exported
+// documents only; planning always operates on IDs.
+func (d *Document) useGroupRefs() {
+	var walk func(*Scope)
+	walk = func(s *Scope) {
+		names := make(map[string]string, len(s.Groups))
+		for _, g := range s.Groups {
+			if g.Name != "" && g.Id != "" {
+				names[g.Id] = g.Name
+			}
+		}
+		for _, r := range s.Roles {
+			for i, p := range r.Principals {
+				if name, ok := names[p]; ok {
+					r.Principals[i] = GroupRefPrefix + name
+				}
+			}
+		}
+		for _, c := range s.Scopes {
+			walk(c)
+		}
+	}
+	walk(d.Scope)
+	d.normalize()
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/event"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-secure-stdlib/mlock"
 	"github.com/hashicorp/go-secure-stdlib/parseutil"
@@ -47,6 +48,7 @@ type Command struct {
 	opsServer *ops.Server
 
 	SighupCh  chan struct{}
+	SigUSR1Ch chan struct{}
 	SigUSR2Ch chan struct{}
 
 	Config *config.Config
@@ -812,6 +814,14 @@ func (c *Command) WaitForInterrupt() int {
 				c.UI.Error(fmt.Errorf("Error(s) were encountered during reload: %w", err).Error())
 			}
 
+		case <-c.SigUSR1Ch:
+			if c.worker == nil {
+				c.UI.Warn("Ignoring drain request, no worker is running")
+				continue
+			}
+			c.UI.Output(fmt.Sprintf("==> Boundary worker drain triggered, remaining connections will be closed in %s", server.DefaultDrainTimeout))
+			c.worker.Drain(time.Now().Add(server.DefaultDrainTimeout))
+
 		case <-c.SigUSR2Ch:
 			buf := make([]byte, 32*1024*1024)
 			n := runtime.Stack(buf[:], true)
@@ -539,6 +539,24 @@ func printItemTable(item *targets.Target, resp *api.Response) string {
 		if resp.Map[globals.SessionMaxSecondsField] != nil {
 			nonAttributeMap["Session Max Seconds"] = item.SessionMaxSeconds
 		}
+		if resp.Map[globals.ConnectionBandwidthLimitField] != nil {
+			nonAttributeMap["Connection Bandwidth Limit"] = item.ConnectionBandwidthLimit
+		}
+		if resp.Map[globals.SessionBandwidthLimitField] != nil {
+			nonAttributeMap["Session Bandwidth Limit"] = item.SessionBandwidthLimit
+		}
+		if resp.Map[globals.ConnectionByteQuotaField] != nil {
+			nonAttributeMap["Connection Byte Quota"] = item.ConnectionByteQuota
+		}
+		if resp.Map[globals.SessionByteQuotaField] != nil {
+			nonAttributeMap["Session Byte Quota"] = item.SessionByteQuota
+		}
+		if resp.Map[globals.MaxActiveSessionsField] != nil {
+			nonAttributeMap["Max Active Sessions"] = item.MaxActiveSessions
+		}
+		if resp.Map[globals.MaxActiveSessionsPerUserField] != nil {
+			nonAttributeMap["Max Active Sessions Per User"] = item.MaxActiveSessionsPerUser
+		}
 	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, item.Attributes, keySubstMap)
@@ -24,28 +24,38 @@ func extraTcpActionsFlagsMapFuncImpl() map[string][]string {
 		"create": {
 			"address", "default-port", "default-client-port", "session-max-seconds",
 			"session-connection-limit", "egress-worker-filter", "ingress-worker-filter",
+			"connection-bandwidth-limit", "session-bandwidth-limit", "connection-byte-quota",
+			"session-byte-quota", "max-active-sessions", "max-active-sessions-per-user",
 			"with-alias-value", "with-alias-scope-id", "with-alias-authorize-session-host-id",
 		},
 		"update": {
 			"address", "default-port", "default-client-port", "session-max-seconds",
 			"session-connection-limit", "worker-filter", "egress-worker-filter",
-			"ingress-worker-filter",
+			"ingress-worker-filter", "connection-bandwidth-limit", "session-bandwidth-limit",
+			"connection-byte-quota", "session-byte-quota", "max-active-sessions",
+			"max-active-sessions-per-user",
 		},
 	}
 }
 
 type extraTcpCmdVars struct {
-	flagDefaultPort            string
-	flagDefaultClientPort      string
-	flagSessionMaxSeconds      string
-	flagSessionConnectionLimit string
-	flagWorkerFilter           string
-	flagEgressWorkerFilter     string
-	flagIngressWorkerFilter    string
-	flagAddress                string
-	flagWithAliasValue         string
-	flagWithAliasScopeId       string
-	flagWithAliasHostId        string
+	flagDefaultPort              string
+	flagDefaultClientPort        string
+	flagSessionMaxSeconds        string
+	flagSessionConnectionLimit   string
+	flagWorkerFilter             string
+	flagEgressWorkerFilter       string
+	flagIngressWorkerFilter      string
+	flagAddress                  string
+	flagWithAliasValue           string
+	flagWithAliasScopeId         string
+	flagWithAliasHostId          string
+	flagConnectionBandwidthLimit string
+	flagSessionBandwidthLimit    string
+	flagConnectionByteQuota      string
+	flagSessionByteQuota         string
+	flagMaxActiveSessions        string
+	flagMaxActiveSessionsPerUser string
 }
 
 func (c *TcpCommand) extraTcpHelpFunc(helpMap map[string]func() string) string {
@@ -129,6 +139,42 @@ func extraTcpFlagsFuncImpl(c *TcpCommand, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagIngressWorkerFilter,
 				Usage:  "A boolean expression to filter which ingress workers can handle sessions for this target.",
 			})
+		case "connection-bandwidth-limit":
+			fs.StringVar(&base.StringVar{
+				Name:   "connection-bandwidth-limit",
+				Target: &c.flagConnectionBandwidthLimit,
+				Usage:  "The maximum number of bytes per second each connection of a session may transfer. 0 means unlimited.",
+			})
+		case "session-bandwidth-limit":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-bandwidth-limit",
+				Target: &c.flagSessionBandwidthLimit,
+				Usage:  "The maximum number of bytes per second all connections of a session proxied by a worker may transfer together. 0 means unlimited.",
+			})
+		case "connection-byte-quota":
+			fs.StringVar(&base.StringVar{
+				Name:   "connection-byte-quota",
+				Target: &c.flagConnectionByteQuota,
+				Usage:  "The maximum number of bytes each connection of a session may transfer before it is closed. 0 means unlimited.",
+			})
+		case "session-byte-quota":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-byte-quota",
+				Target: &c.flagSessionByteQuota,
+				Usage:  "The maximum number of bytes all connections of a session may transfer before the session is terminated. 0 means unlimited.",
+			})
+		case "max-active-sessions":
+			fs.StringVar(&base.StringVar{
+				Name:   "max-active-sessions",
+				Target: &c.flagMaxActiveSessions,
+				Usage:  "The maximum number of active sessions to this target across all users. 0 means unlimited.",
+			})
+		case "max-active-sessions-per-user":
+			fs.StringVar(&base.StringVar{
+				Name:   "max-active-sessions-per-user",
+				Target: &c.flagMaxActiveSessionsPerUser,
+				Usage:  "The maximum number of active sessions each user may have to this target. 0 means unlimited.",
+			})
 		case "with-alias-value":
 			fs.StringVar(&base.StringVar{
 				Name:   "with-alias-value",
@@ -247,6 +293,54 @@ func extraTcpFlagsHandlingFuncImpl(c *TcpCommand, _ *base.FlagSets, opts *[]targ
 		*opts = append(*opts, targets.WithIngressWorkerFilter(c.flagIngressWorkerFilter))
 	}
 
+	trafficFlags := []struct {
+		value string
+		with  func(uint64) targets.Option
+		def   func() targets.Option
+	}{
+		{c.flagConnectionBandwidthLimit, targets.WithConnectionBandwidthLimit, targets.DefaultConnectionBandwidthLimit},
+		{c.flagSessionBandwidthLimit, targets.WithSessionBandwidthLimit, targets.DefaultSessionBandwidthLimit},
+		{c.flagConnectionByteQuota, targets.WithConnectionByteQuota, targets.DefaultConnectionByteQuota},
+		{c.flagSessionByteQuota, targets.WithSessionByteQuota, targets.DefaultSessionByteQuota},
+	}
+	for _, f := range trafficFlags {
+		switch f.value {
+		case "":
+		case "null":
+			*opts = append(*opts, f.def())
+		default:
+			v, err := strconv.ParseUint(f.value, 10, 64)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", f.value, err))
+				return false
+			}
+			*opts = append(*opts, f.with(v))
+		}
+	}
+
+	sessionFlags := []struct {
+		value string
+		with  func(uint32) targets.Option
+		def   func() targets.Option
+	}{
+		{c.flagMaxActiveSessions, targets.WithMaxActiveSessions, targets.DefaultMaxActiveSessions},
+		{c.flagMaxActiveSessionsPerUser, targets.WithMaxActiveSessionsPerUser, targets.DefaultMaxActiveSessionsPerUser},
+	}
+	for _, f := range sessionFlags {
+		switch f.value {
+		case "":
+		case "null":
+			*opts = append(*opts, f.def())
+		default:
+			v, err := strconv.ParseUint(f.value, 10, 32)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", f.value, err))
+				return false
+			}
+			*opts = append(*opts, f.with(uint32(v)))
+		}
+	}
+
 	switch c.flagAddress {
 	case "":
 	case "null":
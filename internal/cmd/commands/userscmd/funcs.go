@@ -20,17 +20,20 @@ func init() {
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
 	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
 }
 
 type extraCmdVars struct {
-	flagAccounts []string
+	flagAccounts           []string
+	revokeAuthTokensResult *users.RevokeAuthTokensResult
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"add-accounts":    {"id", "account", "version"},
-		"set-accounts":    {"id", "account", "version"},
-		"remove-accounts": {"id", "account", "version"},
+		"add-accounts":       {"id", "account", "version"},
+		"set-accounts":       {"id", "account", "version"},
+		"remove-accounts":    {"id", "account", "version"},
+		"revoke-auth-tokens": {"id"},
 	}
 }
 
@@ -47,6 +50,8 @@ func extraSynopsisFuncImpl(c *Command) string {
 			in = "Remove accounts from"
 		}
 		return wordwrap.WrapString(fmt.Sprintf("%s a user within Boundary", in), base.TermWidth)
+	case "revoke-auth-tokens":
+		return wordwrap.WrapString("Revoke every auth token of a user within Boundary", base.TermWidth)
 	}
 
 	return ""
@@ -88,6 +93,17 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 		})
 
+	case "revoke-auth-tokens":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary users revoke-auth-tokens [options] [args]",
+			"",
+			`  Revokes every auth token issued to a user given its ID, across all of the user's accounts. Example:`,
+			"",
+			`    $ boundary users revoke-auth-tokens -id u_1234567890`,
+			"",
+			"",
+		})
+
 	default:
 		helpStr = helpMap["base"]()
 	}
@@ -150,10 +166,36 @@ func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *users
 			return nil, nil, nil, err
 		}
 		return result.GetResponse(), result.GetItem(), nil, err
+	case "revoke-auth-tokens":
+		var err error
+		c.revokeAuthTokensResult, err = userClient.RevokeAuthTokens(c.Context, c.FlagId, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return c.revokeAuthTokensResult.GetResponse(), nil, nil, err
 	}
 	return origResp, origItem, origItems, origError
 }
 
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "revoke-auth-tokens":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(fmt.Sprintf("Revoked %d auth token(s).", c.revokeAuthTokensResult.RevokedCount))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.revokeAuthTokensResult.GetResponse()); !ok {
+				return false, fmt.Errorf("error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (c *Command) printListTable(items []*users.User) string {
 	if len(items) == 0 {
 		return "No users found"
@@ -22,11 +22,16 @@ func init() {
 	executeExtraActions = executeExtraActionsImpl
 }
 
+type extraCmdVars struct {
+	flagDrainTimeout time.Duration
+}
+
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"add-worker-tags":    {"id", "tag", "version"},
 		"set-worker-tags":    {"id", "tag", "version"},
 		"remove-worker-tags": {"id", "tag", "version"},
+		"drain":              {"id", "drain-timeout", "version"},
 	}
 }
 
@@ -38,6 +43,8 @@ func extraSynopsisFuncImpl(c *Command) string {
 		return "Set api tags for the specified worker"
 	case "remove-worker-tags":
 		return "Remove api tags from the specified worker"
+	case "drain":
+		return "Drain the specified worker before shutting it down"
 	default:
 		return ""
 	}
@@ -94,6 +101,18 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			"",
 		})
+	case "drain":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary workers drain [args]",
+			"",
+			"  Drain a worker. The worker is not given new sessions, and shuts down once its existing connections have closed. Connections still open when the drain timeout passes are terminated. Example:",
+			"",
+			"    Drain a worker, allowing an hour for its connections to close:",
+			"",
+			`      $ boundary workers drain -id w_1234567890 -drain-timeout 1h`,
+			"",
+			"",
+		})
 	default:
 		helpStr = helpMap[c.Func]()
 	}
@@ -116,6 +135,12 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				NullCheck: nullCheckFn,
 				Usage:     "The api tag resources to add, remove, or set.",
 			})
+		case "drain-timeout":
+			f.DurationVar(&base.DurationVar{
+				Name:   "drain-timeout",
+				Target: &c.flagDrainTimeout,
+				Usage:  "How long the worker waits for its connections to close before terminating them. Defaults to 30 minutes if unset.",
+			})
 		}
 	}
 }
@@ -161,6 +186,12 @@ func executeExtraActionsImpl(c *Command, inResp *api.Response, inItem *workers.W
 			return nil, nil, nil, err
 		}
 		return result.GetResponse(), result.GetItem(), nil, err
+	case "drain":
+		result, err := workerClient.Drain(c.Context, c.FlagId, version, c.flagDrainTimeout, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return result.GetResponse(), result.GetItem(), nil, err
 	}
 	return inResp, inItem, inItems, inErr
 }
@@ -272,6 +303,9 @@ func printItemTable(item *workers.Worker, resp *api.Response) string {
 	if !item.LastStatusTime.IsZero() {
 		nonAttributeMap["Last Status Time"] = item.LastStatusTime
 	}
+	if !item.DrainDeadline.IsZero() {
+		nonAttributeMap["Drain Deadline"] = item.DrainDeadline.Local().Format(time.RFC1123)
+	}
 	if item.ControllerGeneratedActivationToken != "" {
 		nonAttributeMap["Controller-Generated Activation Token"] = item.ControllerGeneratedActivationToken
 	}
@@ -12,6 +12,23 @@ import (
 	"syscall"
 )
 
+// MakeSigUSR1Ch returns a channel that can be used for SIGUSR1
+// worker drain requests. This channel will send a message for every
+// SIGUSR1 received.
+func MakeSigUSR1Ch() chan struct{} {
+	resultCh := make(chan struct{})
+
+	signalCh := make(chan os.Signal, 4)
+	signal.Notify(signalCh, syscall.SIGUSR1)
+	go func() {
+		for {
+			<-signalCh
+			resultCh <- struct{}{}
+		}
+	}()
+	return resultCh
+}
+
 // MakeSigUSR2Ch returns a channel that can be used for SIGUSR2
 // goroutine logging. This channel will send a message for every
 // SIGUSR2 received.
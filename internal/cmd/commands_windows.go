@@ -3,6 +3,11 @@
 
 package cmd
 
+// MakeSigUSR1Ch does nothing useful on Windows.
+func MakeSigUSR1Ch() chan struct{} {
+	return make(chan struct{})
+}
+
 // MakeSigUSR2Ch does nothing useful on Windows.
 func MakeSigUSR2Ch() chan struct{} {
 	return make(chan struct{})
@@ -14,15 +14,19 @@ import (
 	"io"
 	"net"
 	"os"
+	"path/filepath"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/metric"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/event"
 	"github.com/hashicorp/boundary/internal/ratelimit"
+	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/util"
 	kms_plugin_assets "github.com/hashicorp/boundary/plugins/kms"
 	"github.com/hashicorp/boundary/sdk/wrapper"
@@ -229,6 +233,31 @@ type Controller struct {
 	// it is rejected by the controller.
 	MaxPageSizeRaw any  `hcl:"max_page_size"`
 	MaxPageSize    uint `hcl:"-"`
+
+	// Scim enables the SCIM 2.0 provisioning endpoint for the users and
+	// groups of a scope.
+	Scim *Scim `hcl:"scim"`
+
+	// WorkerRoutingStrategy sets how the workers able to handle a session are
+	// ordered when the session is authorized. It can be "random", the
+	// default, or "least_loaded" to prefer the workers reporting the lowest
+	// load.
+	WorkerRoutingStrategy server.WorkerRoutingStrategy `hcl:"worker_routing_strategy"`
+
+	// MaxActiveSessionsPerUser is the maximum number of active sessions a
+	// single user can have to all targets. Authorizing a session fails once
+	// the limit is reached. If zero, the default, sessions are not limited.
+	MaxActiveSessionsPerUser int `hcl:"max_active_sessions_per_user"`
+
+	// CredentialRotators are the rotators static credential stores can
+	// reference by name to push rotated credentials to target hosts.
+	CredentialRotators []*CredentialRotator `hcl:"credential_rotator"`
+
+	// CredentialFileAllowedDirectories are the directories the bundle and
+	// age identity files of file credential stores must be within once
+	// symbolic links are resolved. If empty, the default, file credential
+	// stores cannot be created or issue credentials.
+	CredentialFileAllowedDirectories []string `hcl:"credential_file_allowed_directories"`
 }
 
 func (c *Controller) InitNameIfEmpty(ctx context.Context) error {
@@ -303,6 +332,12 @@ type Worker struct {
 	// pre-0.13 method of using KMSes to authenticate. This is currently only
 	// supported to throw an error if used telling people they need to upgrade.
 	UseDeprecatedKmsAuthMethod bool `hcl:"use_deprecated_kms_auth_method"`
+
+	// ProxyMetricLabels are the labels attached to the worker's proxy session
+	// metrics. Each one multiplies the number of reported series, so only
+	// target_id and project_id are supported and none are attached by
+	// default.
+	ProxyMetricLabels []string `hcl:"proxy_metric_labels"`
 }
 
 type Database struct {
@@ -337,6 +372,29 @@ type Scheduler struct {
 	MonitorIntervalDuration time.Duration
 }
 
+type Scim struct {
+	// ScopeId is the scope whose users and groups are provisioned through
+	// the SCIM endpoint.
+	ScopeId string `hcl:"scope_id"`
+
+	// BearerToken is the token SCIM clients must present to the endpoint. It
+	// can refer to an env var or file.
+	BearerToken string `hcl:"bearer_token"`
+}
+
+type CredentialRotator struct {
+	// Name is the name static credential stores use to reference the
+	// rotator.
+	Name string `hcl:",key"`
+
+	// Command is the local command run to rotate or retire a credential. The
+	// request is written to the standard input of the command as JSON.
+	Command string `hcl:"command"`
+
+	// Args are the arguments passed to Command.
+	Args []string `hcl:"args"`
+}
+
 type Plugins struct {
 	ExecutionDir string `hcl:"execution_dir"`
 }
@@ -638,6 +696,15 @@ func Parse(d string) (*Config, error) {
 			return nil, errors.New("Controller liveness time to stale value is negative")
 		}
 
+		if !result.Controller.WorkerRoutingStrategy.Valid() {
+			return nil, fmt.Errorf("Controller worker routing strategy %q is not one of %q or %q",
+				result.Controller.WorkerRoutingStrategy, server.RandomWorkerRouting, server.LeastLoadedWorkerRouting)
+		}
+
+		if result.Controller.MaxActiveSessionsPerUser < 0 {
+			return nil, fmt.Errorf("Controller max active sessions per user value must not be negative, was %d", result.Controller.MaxActiveSessionsPerUser)
+		}
+
 		if result.Controller.MaxPageSizeRaw != nil {
 			switch t := result.Controller.MaxPageSizeRaw.(type) {
 			case string:
@@ -663,6 +730,39 @@ func Parse(d string) (*Config, error) {
 			}
 		}
 
+		if result.Controller.Scim != nil {
+			if result.Controller.Scim.ScopeId == "" {
+				return nil, errors.New("SCIM scope_id must be set")
+			}
+			result.Controller.Scim.BearerToken, err = parseutil.ParsePath(result.Controller.Scim.BearerToken)
+			if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+				return nil, fmt.Errorf("Error parsing SCIM bearer token: %w", err)
+			}
+			if result.Controller.Scim.BearerToken == "" {
+				return nil, errors.New("SCIM bearer_token must be set")
+			}
+		}
+
+		rotatorNames := make(map[string]struct{}, len(result.Controller.CredentialRotators))
+		for _, r := range result.Controller.CredentialRotators {
+			if r.Name == "" {
+				return nil, errors.New("Credential rotator name must be set")
+			}
+			if _, ok := rotatorNames[r.Name]; ok {
+				return nil, fmt.Errorf("Credential rotator %q is defined more than once", r.Name)
+			}
+			rotatorNames[r.Name] = struct{}{}
+			if r.Command == "" {
+				return nil, fmt.Errorf("Credential rotator %q command must be set", r.Name)
+			}
+		}
+
+		for _, dir := range result.Controller.CredentialFileAllowedDirectories {
+			if !filepath.IsAbs(dir) {
+				return nil, fmt.Errorf("Credential file allowed directory %q must be an absolute path", dir)
+			}
+		}
+
 		if result.Controller.Database != nil {
 			if result.Controller.Database.MaxOpenConnectionsRaw != nil {
 				switch t := result.Controller.Database.MaxOpenConnectionsRaw.(type) {
@@ -913,6 +1013,15 @@ func Parse(d string) (*Config, error) {
 		if err != nil {
 			return nil, fmt.Errorf("Failed to parse worker upstreams: %w", err)
 		}
+
+		for i, l := range result.Worker.ProxyMetricLabels {
+			if !slices.Contains(metric.ListProxySessionLabels, l) {
+				return nil, fmt.Errorf("Unsupported proxy metric label %q, supported labels are %q", l, metric.ListProxySessionLabels)
+			}
+			if slices.Contains(result.Worker.ProxyMetricLabels[:i], l) {
+				return nil, fmt.Errorf("Duplicate proxy metric label %q", l)
+			}
+		}
 	}
 
 	// Now that we can have multiple KMSes for downstream workers, allow an
@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/boundary/internal/event"
 	"github.com/hashicorp/boundary/internal/ratelimit"
+	"github.com/hashicorp/boundary/internal/server"
 	configutil "github.com/hashicorp/go-secure-stdlib/configutil/v2"
 	"github.com/hashicorp/go-secure-stdlib/listenerutil"
 	"github.com/hashicorp/go-secure-stdlib/parseutil"
@@ -2785,3 +2786,327 @@ func TestMaxPageSize(t *testing.T) {
 		})
 	}
 }
+
+func TestControllerScim(t *testing.T) {
+	tests := []struct {
+		name           string
+		in             string
+		envBearerToken string
+		expScim        *Scim
+		expErrStr      string
+	}{
+		{
+			name: "Not configured",
+			in: `
+			controller {
+				name = "example-controller"
+			}`,
+		},
+		{
+			name: "Valid value",
+			in: `
+			controller {
+				name = "example-controller"
+				scim {
+					scope_id = "o_1234567890"
+					bearer_token = "secret"
+				}
+			}`,
+			expScim: &Scim{ScopeId: "o_1234567890", BearerToken: "secret"},
+		},
+		{
+			name: "Valid env var",
+			in: `
+			controller {
+				name = "example-controller"
+				scim {
+					scope_id = "o_1234567890"
+					bearer_token = "env://ENV_SCIM_TOKEN"
+				}
+			}`,
+			envBearerToken: "env-secret",
+			expScim:        &Scim{ScopeId: "o_1234567890", BearerToken: "env-secret"},
+		},
+		{
+			name: "Missing scope id",
+			in: `
+			controller {
+				name = "example-controller"
+				scim {
+					bearer_token = "secret"
+				}
+			}`,
+			expErrStr: "SCIM scope_id must be set",
+		},
+		{
+			name: "Missing bearer token",
+			in: `
+			controller {
+				name = "example-controller"
+				scim {
+					scope_id = "o_1234567890"
+					bearer_token = "env://ENV_SCIM_TOKEN"
+				}
+			}`,
+			expErrStr: "SCIM bearer_token must be set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV_SCIM_TOKEN", tt.envBearerToken)
+			c, err := Parse(tt.in)
+			if tt.expErrStr != "" {
+				require.EqualError(t, err, tt.expErrStr)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Controller)
+			require.Equal(t, tt.expScim, c.Controller.Scim)
+		})
+	}
+}
+
+func TestControllerWorkerRoutingStrategy(t *testing.T) {
+	tests := []struct {
+		name        string
+		in          string
+		expStrategy server.WorkerRoutingStrategy
+		expErrStr   string
+	}{
+		{
+			name: "Not configured",
+			in: `
+			controller {
+				name = "example-controller"
+			}`,
+		},
+		{
+			name: "Random",
+			in: `
+			controller {
+				name = "example-controller"
+				worker_routing_strategy = "random"
+			}`,
+			expStrategy: server.RandomWorkerRouting,
+		},
+		{
+			name: "Least loaded",
+			in: `
+			controller {
+				name = "example-controller"
+				worker_routing_strategy = "least_loaded"
+			}`,
+			expStrategy: server.LeastLoadedWorkerRouting,
+		},
+		{
+			name: "Unknown",
+			in: `
+			controller {
+				name = "example-controller"
+				worker_routing_strategy = "round_robin"
+			}`,
+			expErrStr: `Controller worker routing strategy "round_robin" is not one of "random" or "least_loaded"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErrStr != "" {
+				require.EqualError(t, err, tt.expErrStr)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Controller)
+			require.Equal(t, tt.expStrategy, c.Controller.WorkerRoutingStrategy)
+		})
+	}
+}
+
+func TestControllerMaxActiveSessionsPerUser(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        string
+		expMax    int
+		expErrStr string
+	}{
+		{
+			name: "Not configured",
+			in: `
+			controller {
+				name = "example-controller"
+			}`,
+		},
+		{
+			name: "Configured",
+			in: `
+			controller {
+				name = "example-controller"
+				max_active_sessions_per_user = 5
+			}`,
+			expMax: 5,
+		},
+		{
+			name: "Negative",
+			in: `
+			controller {
+				name = "example-controller"
+				max_active_sessions_per_user = -1
+			}`,
+			expErrStr: "Controller max active sessions per user value must not be negative, was -1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErrStr != "" {
+				require.EqualError(t, err, tt.expErrStr)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Controller)
+			require.Equal(t, tt.expMax, c.Controller.MaxActiveSessionsPerUser)
+		})
+	}
+}
+
+func TestWorkerProxyMetricLabels(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        string
+		expLabels []string
+		expErrStr string
+	}{
+		{
+			name: "not set",
+			in: `
+			worker {
+				name = "w"
+			}`,
+			expLabels: nil,
+		},
+		{
+			name: "valid labels",
+			in: `
+			worker {
+				proxy_metric_labels = ["project_id", "target_id"]
+			}`,
+			expLabels: []string{"project_id", "target_id"},
+		},
+		{
+			name: "unsupported label",
+			in: `
+			worker {
+				proxy_metric_labels = ["user_id"]
+			}`,
+			expErrStr: `Unsupported proxy metric label "user_id", supported labels are ["target_id" "project_id"]`,
+		},
+		{
+			name: "duplicate label",
+			in: `
+			worker {
+				proxy_metric_labels = ["target_id", "target_id"]
+			}`,
+			expErrStr: `Duplicate proxy metric label "target_id"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErrStr != "" {
+				require.EqualError(t, err, tt.expErrStr)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Worker)
+			require.Equal(t, tt.expLabels, c.Worker.ProxyMetricLabels)
+		})
+	}
+}
+
+func TestControllerCredentialRotators(t *testing.T) {
+	tests := []struct {
+		name        string
+		in          string
+		expRotators []*CredentialRotator
+		expErrStr   string
+	}{
+		{
+			name: "Not configured",
+			in: `
+			controller {
+				name = "example-controller"
+			}`,
+		},
+		{
+			name: "Valid rotators",
+			in: `
+			controller {
+				name = "example-controller"
+				credential_rotator "linux" {
+					command = "/usr/local/bin/rotate-linux"
+					args = ["--inventory", "/etc/hosts.yaml"]
+				}
+				credential_rotator "windows" {
+					command = "/usr/local/bin/rotate-windows"
+				}
+			}`,
+			expRotators: []*CredentialRotator{
+				{Name: "linux", Command: "/usr/local/bin/rotate-linux", Args: []string{"--inventory", "/etc/hosts.yaml"}},
+				{Name: "windows", Command: "/usr/local/bin/rotate-windows"},
+			},
+		},
+		{
+			name: "Missing command",
+			in: `
+			controller {
+				name = "example-controller"
+				credential_rotator "linux" {
+					args = ["--inventory", "/etc/hosts.yaml"]
+				}
+			}`,
+			expErrStr: `Credential rotator "linux" command must be set`,
+		},
+		{
+			name: "Duplicate name",
+			in: `
+			controller {
+				name = "example-controller"
+				credential_rotator "linux" {
+					command = "/usr/local/bin/rotate-linux"
+				}
+				credential_rotator "linux" {
+					command = "/usr/local/bin/rotate-other"
+				}
+			}`,
+			expErrStr: `Credential rotator "linux" is defined more than once`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErrStr != "" {
+				require.EqualError(t, err, tt.expErrStr)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Controller)
+			require.Equal(t, tt.expRotators, c.Controller.CredentialRotators)
+		})
+	}
+}
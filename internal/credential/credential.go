@@ -7,6 +7,7 @@ package credential
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/boundary"
@@ -76,6 +77,20 @@ type Dynamic interface {
 	Purpose() Purpose
 }
 
+// VersionedSecret is a dynamic credential whose secret is versioned by the
+// credential provider, e.g. a Vault KV version 2 secret.
+type VersionedSecret interface {
+	Dynamic
+
+	// SecretVersion returns the version of the secret. It returns 0 if
+	// the credential provider did not return a version.
+	SecretVersion() uint32
+
+	// SecretCreateTime returns the time the version of the secret was
+	// created.
+	SecretCreateTime() time.Time
+}
+
 // Static is a static credential that is stored directly in a credential store.
 type Static interface {
 	boundary.Resource
@@ -123,6 +138,9 @@ type Password string
 // PrivateKey represents a secret private key.
 type PrivateKey []byte
 
+// Kubeconfig represents a secret Kubernetes client configuration file.
+type Kubeconfig []byte
+
 // JsonObject represents a JSON object that is serialized.
 type JsonObject struct {
 	*structpb.Struct
@@ -143,3 +161,20 @@ type SshPrivateKey interface {
 	PrivateKey() PrivateKey
 	PrivateKeyPassphrase() []byte
 }
+
+// TlsClientCertificate is a credential containing a PEM encoded X.509
+// client certificate, its private key and an optional bundle of PEM encoded
+// CA certificates used to verify the server.
+type TlsClientCertificate interface {
+	Credential
+	Certificate() []byte
+	PrivateKey() PrivateKey
+	CaCertificate() []byte
+}
+
+// KubeconfigCredential is a credential containing a Kubernetes client
+// configuration file.
+type KubeconfigCredential interface {
+	Credential
+	Kubeconfig() Kubeconfig
+}
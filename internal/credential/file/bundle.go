@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package file
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"github.com/hashicorp/boundary/internal/errors"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-secure-stdlib/configutil/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// wrappedValueRegex matches a bundle value which was encrypted with the
+// credential-file KMS by the boundary config encrypt command.
+var wrappedValueRegex = regexp.MustCompile(`^{{decrypt\(.*\)}}$`)
+
+// fileStat records the attributes of a file used to detect changes.
+type fileStat struct {
+	modTime time.Time
+	size    int64
+}
+
+func statFile(p string) (fileStat, error) {
+	fi, err := os.Stat(p)
+	if err != nil {
+		return fileStat{}, err
+	}
+	return fileStat{modTime: fi.ModTime(), size: fi.Size()}, nil
+}
+
+type cachedBundle struct {
+	storeVersion uint32
+	bundle       fileStat
+	identity     fileStat
+	data         map[string]any
+}
+
+// bundleCache holds the decrypted bundles of the file credential stores
+// keyed by store id. A cached bundle is used until the store is updated or
+// the bundle file or age identity file changes on disk, so a bundle that
+// is replaced on the controller takes effect on the next credential
+// request without a restart.
+type bundleCache struct {
+	mu      sync.Mutex
+	bundles map[string]*cachedBundle
+}
+
+var bundles = &bundleCache{
+	bundles: make(map[string]*cachedBundle),
+}
+
+// load returns the decrypted content of the bundle of cs. wrapper is used
+// to decrypt KMS wrapped values and may be nil if the bundle does not
+// contain any.
+func (c *bundleCache) load(ctx context.Context, cs *CredentialStore, wrapper wrapping.Wrapper) (map[string]any, error) {
+	const op = "file.(bundleCache).load"
+	bStat, err := statFile(cs.GetPath())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.CredentialFileRead), errors.WithMsg("unable to stat bundle file"))
+	}
+	var iStat fileStat
+	if cs.GetAgeIdentityPath() != "" {
+		if iStat, err = statFile(cs.GetAgeIdentityPath()); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.CredentialFileRead), errors.WithMsg("unable to stat age identity file"))
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cb, ok := c.bundles[cs.GetPublicId()]; ok &&
+		cb.storeVersion == cs.GetVersion() && cb.bundle == bStat && cb.identity == iStat {
+		return cb.data, nil
+	}
+
+	raw, err := os.ReadFile(cs.GetPath())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.CredentialFileRead), errors.WithMsg("unable to read bundle file"))
+	}
+	if cs.GetAgeIdentityPath() != "" {
+		identities, err := os.ReadFile(cs.GetAgeIdentityPath())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.CredentialFileRead), errors.WithMsg("unable to read age identity file"))
+		}
+		if raw, err = ageDecrypt(raw, identities); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.CredentialFileRead))
+		}
+	}
+	data, err := parseBundle(ctx, raw, wrapper)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	c.bundles[cs.GetPublicId()] = &cachedBundle{
+		storeVersion: cs.GetVersion(),
+		bundle:       bStat,
+		identity:     iStat,
+		data:         data,
+	}
+	return data, nil
+}
+
+// ageDecrypt decrypts the age encrypted, optionally armored, content with
+// the age identities in identities.
+func ageDecrypt(content, identities []byte) ([]byte, error) {
+	ids, err := age.ParseIdentities(bytes.NewReader(identities))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse age identities: %w", err)
+	}
+	var src io.Reader = bytes.NewReader(content)
+	if bytes.HasPrefix(bytes.TrimSpace(content), []byte(armor.Header)) {
+		src = armor.NewReader(bytes.NewReader(bytes.TrimSpace(content)))
+	}
+	r, err := age.Decrypt(bufio.NewReader(src), ids...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt bundle: %w", err)
+	}
+	out, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt bundle: %w", err)
+	}
+	return out, nil
+}
+
+// parseBundle parses the JSON or YAML content of a bundle and decrypts any
+// KMS wrapped values with wrapper.
+func parseBundle(ctx context.Context, content []byte, wrapper wrapping.Wrapper) (map[string]any, error) {
+	const op = "file.parseBundle"
+	var data map[string]any
+	if err := yaml.Unmarshal(content, &data); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.CredentialFileRead), errors.WithMsg("unable to parse bundle"))
+	}
+	if data == nil {
+		return nil, errors.New(ctx, errors.CredentialFileRead, op, "bundle is empty")
+	}
+	if err := unwrapValues(ctx, data, wrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return data, nil
+}
+
+// unwrapValues replaces every KMS wrapped string value in v with its
+// decrypted value.
+func unwrapValues(ctx context.Context, v any, wrapper wrapping.Wrapper) error {
+	const op = "file.unwrapValues"
+	unwrap := func(s string) (string, error) {
+		if wrapper == nil {
+			return "", errors.New(ctx, errors.CredentialFileRead, op, "bundle contains wrapped values but no credential-file kms is configured")
+		}
+		dec, err := configutil.EncryptDecrypt(s, true, true, wrapper)
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op, errors.WithCode(errors.CredentialFileRead), errors.WithMsg("unable to decrypt wrapped value"))
+		}
+		return dec, nil
+	}
+	switch t := v.(type) {
+	case map[string]any:
+		for k, e := range t {
+			if s, ok := e.(string); ok && wrappedValueRegex.MatchString(strings.TrimSpace(s)) {
+				dec, err := unwrap(strings.TrimSpace(s))
+				if err != nil {
+					return err
+				}
+				t[k] = dec
+				continue
+			}
+			if err := unwrapValues(ctx, e, wrapper); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for i, e := range t {
+			if s, ok := e.(string); ok && wrappedValueRegex.MatchString(strings.TrimSpace(s)) {
+				dec, err := unwrap(strings.TrimSpace(s))
+				if err != nil {
+					return err
+				}
+				t[i] = dec
+				continue
+			}
+			if err := unwrapValues(ctx, e, wrapper); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// lookupEntry returns the entry in data at the slash separated path.
+func lookupEntry(ctx context.Context, data map[string]any, path string) (map[string]any, error) {
+	const op = "file.lookupEntry"
+	entry := data
+	for _, seg := range strings.Split(strings.Trim(path, "/"), "/") {
+		next, ok := entry[seg].(map[string]any)
+		if !ok {
+			return nil, errors.New(ctx, errors.CredentialFileEntryNotFound, op, fmt.Sprintf("no entry at path %q", path))
+		}
+		entry = next
+	}
+	return entry, nil
+}
@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package file
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"github.com/hashicorp/boundary/internal/credential/file/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-secure-stdlib/configutil/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testAgeEncrypt(t *testing.T, recipient age.Recipient, content []byte, armored bool) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	var dst io.Writer = &buf
+	var aw io.WriteCloser
+	if armored {
+		aw = armor.NewWriter(&buf)
+		dst = aw
+	}
+	w, err := age.Encrypt(dst, recipient)
+	require.NoError(t, err)
+	_, err = w.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	if aw != nil {
+		require.NoError(t, aw.Close())
+	}
+	return buf.Bytes()
+}
+
+func TestParseBundle(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	wrapper := db.TestWrapper(t)
+	wrapped, err := configutil.EncryptDecrypt("{{encrypt(s3cr3t)}}", false, false, wrapper)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		content   string
+		noWrapper bool
+		want      map[string]any
+		wantErr   errors.Code
+	}{
+		{
+			name:    "yaml",
+			content: "db:\n  admin:\n    username: admin\n    password: pass\n",
+			want: map[string]any{
+				"db": map[string]any{
+					"admin": map[string]any{"username": "admin", "password": "pass"},
+				},
+			},
+		},
+		{
+			name:    "json",
+			content: `{"db": {"admin": {"username": "admin", "password": "pass"}}}`,
+			want: map[string]any{
+				"db": map[string]any{
+					"admin": map[string]any{"username": "admin", "password": "pass"},
+				},
+			},
+		},
+		{
+			name:    "wrapped-value",
+			content: "db:\n  username: admin\n  password: \"" + wrapped + "\"\n  hosts:\n    - \"" + wrapped + "\"\n",
+			want: map[string]any{
+				"db": map[string]any{
+					"username": "admin",
+					"password": "s3cr3t",
+					"hosts":    []any{"s3cr3t"},
+				},
+			},
+		},
+		{
+			name:      "wrapped-value-no-wrapper",
+			content:   "db:\n  password: \"" + wrapped + "\"\n",
+			noWrapper: true,
+			wantErr:   errors.CredentialFileRead,
+		},
+		{
+			name:    "empty",
+			content: "",
+			wantErr: errors.CredentialFileRead,
+		},
+		{
+			name:    "invalid",
+			content: "- a\n- b\n",
+			wantErr: errors.CredentialFileRead,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			w := wrapper
+			if tt.noWrapper {
+				w = nil
+			}
+			got, err := parseBundle(ctx, []byte(tt.content), w)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
+
+func TestAgeDecrypt(t *testing.T) {
+	t.Parallel()
+	id, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	other, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	content := []byte("db:\n  username: admin\n")
+
+	t.Run("binary", func(t *testing.T) {
+		got, err := ageDecrypt(testAgeEncrypt(t, id.Recipient(), content, false), []byte(id.String()))
+		require.NoError(t, err)
+		assert.Equal(t, content, got)
+	})
+	t.Run("armored", func(t *testing.T) {
+		got, err := ageDecrypt(testAgeEncrypt(t, id.Recipient(), content, true), []byte(id.String()))
+		require.NoError(t, err)
+		assert.Equal(t, content, got)
+	})
+	t.Run("wrong-identity", func(t *testing.T) {
+		got, err := ageDecrypt(testAgeEncrypt(t, id.Recipient(), content, false), []byte(other.String()))
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+	t.Run("invalid-identity", func(t *testing.T) {
+		got, err := ageDecrypt(testAgeEncrypt(t, id.Recipient(), content, false), []byte("not an identity"))
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+}
+
+func TestBundleCache_Load(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	dir := t.TempDir()
+
+	id, err := age.GenerateX25519Identity()
+	require.NoError(err)
+	idPath := filepath.Join(dir, "identity.txt")
+	require.NoError(os.WriteFile(idPath, []byte(id.String()+"\n"), 0o600))
+
+	bundlePath := filepath.Join(dir, "bundle.age")
+	require.NoError(os.WriteFile(bundlePath, testAgeEncrypt(t, id.Recipient(), []byte("a:\n  password: one\n"), true), 0o600))
+
+	cs := &CredentialStore{
+		CredentialStore: &store.CredentialStore{
+			PublicId:        "csfile_1234567890",
+			Version:         1,
+			Path:            bundlePath,
+			AgeIdentityPath: idPath,
+		},
+	}
+	c := &bundleCache{bundles: make(map[string]*cachedBundle)}
+
+	data, err := c.load(ctx, cs, nil)
+	require.NoError(err)
+	entry, err := lookupEntry(ctx, data, "a")
+	require.NoError(err)
+	assert.Equal("one", entry["password"])
+
+	// Replacing the bundle file is picked up on the next load.
+	require.NoError(os.WriteFile(bundlePath, testAgeEncrypt(t, id.Recipient(), []byte("a:\n  password: second\n"), true), 0o600))
+	data, err = c.load(ctx, cs, nil)
+	require.NoError(err)
+	entry, err = lookupEntry(ctx, data, "a")
+	require.NoError(err)
+	assert.Equal("second", entry["password"])
+
+	// A missing bundle file is an error.
+	require.NoError(os.Remove(bundlePath))
+	data, err = c.load(ctx, cs, nil)
+	assert.Truef(errors.Match(errors.T(errors.CredentialFileRead), err), "unexpected error: %v", err)
+	assert.Nil(data)
+}
+
+func TestLookupEntry(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	data := map[string]any{
+		"prod": map[string]any{
+			"db": map[string]any{"username": "admin"},
+		},
+		"flat": "value",
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		want    map[string]any
+		wantErr bool
+	}{
+		{name: "nested", path: "prod/db", want: map[string]any{"username": "admin"}},
+		{name: "slashes", path: "/prod/db/", want: map[string]any{"username": "admin"}},
+		{name: "missing", path: "prod/cache", wantErr: true},
+		{name: "not-an-entry", path: "flat", wantErr: true},
+		{name: "value", path: "prod/db/username", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := lookupEntry(ctx, data, tt.path)
+			if tt.wantErr {
+				assert.Truef(t, errors.Match(errors.T(errors.CredentialFileEntryNotFound), err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
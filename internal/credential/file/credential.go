@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package file
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/internal/kubeconfig"
+	"github.com/hashicorp/boundary/internal/credential/internal/sshprivatekey"
+	"github.com/hashicorp/boundary/internal/credential/internal/tlsclientcertificate"
+	"github.com/hashicorp/boundary/internal/credential/internal/usernamepassword"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+var _ credential.Dynamic = (*baseCred)(nil)
+
+// baseCred is a credential read from an entry of a credential bundle for a
+// session. Credentials read from a bundle are not stored in the database
+// and have no public id.
+type baseCred struct {
+	sessionId  string
+	lib        *CredentialLibrary
+	purpose    credential.Purpose
+	secretData map[string]any
+}
+
+func (bc *baseCred) GetPublicId() string           { return "" }
+func (bc *baseCred) GetSessionId() string          { return bc.sessionId }
+func (bc *baseCred) Secret() credential.SecretData { return bc.secretData }
+func (bc *baseCred) Library() credential.Library   { return bc.lib }
+func (bc *baseCred) Purpose() credential.Purpose   { return bc.purpose }
+
+// convert converts bc to a specific credential type if the library of bc
+// is not UnspecifiedType.
+func convert(ctx context.Context, bc *baseCred) (credential.Dynamic, error) {
+	switch bc.lib.CredentialType() {
+	case globals.UsernamePasswordCredentialType:
+		return baseToUsrPass(ctx, bc)
+	case globals.SshPrivateKeyCredentialType:
+		return baseToSshPriKey(ctx, bc)
+	case globals.TlsClientCertificateCredentialType:
+		return baseToTlsClientCert(ctx, bc)
+	case globals.KubeconfigCredentialType:
+		return baseToKubeconfig(ctx, bc)
+	}
+	return bc, nil
+}
+
+var _ credential.UsernamePassword = (*usrPassCred)(nil)
+
+type usrPassCred struct {
+	*baseCred
+	username string
+	password credential.Password
+}
+
+func (c *usrPassCred) Username() string              { return c.username }
+func (c *usrPassCred) Password() credential.Password { return c.password }
+
+func baseToUsrPass(ctx context.Context, bc *baseCred) (*usrPassCred, error) {
+	uAttr, pAttr := bc.lib.UsernameAttribute, bc.lib.PasswordAttribute
+	if uAttr == "" {
+		uAttr = "username"
+	}
+	if pAttr == "" {
+		pAttr = "password"
+	}
+	username, password := usernamepassword.Extract(bc.secretData, uAttr, pAttr)
+	if username == "" || password == "" {
+		return nil, errors.E(ctx, errors.WithCode(errors.CredentialFileInvalidMapping))
+	}
+
+	return &usrPassCred{
+		baseCred: bc,
+		username: username,
+		password: credential.Password(password),
+	}, nil
+}
+
+var _ credential.SshPrivateKey = (*sshPrivateKeyCred)(nil)
+
+type sshPrivateKeyCred struct {
+	*baseCred
+	username   string
+	privateKey credential.PrivateKey
+	passphrase []byte
+}
+
+func (c *sshPrivateKeyCred) Username() string                  { return c.username }
+func (c *sshPrivateKeyCred) PrivateKey() credential.PrivateKey { return c.privateKey }
+func (c *sshPrivateKeyCred) PrivateKeyPassphrase() []byte      { return c.passphrase }
+
+func baseToSshPriKey(ctx context.Context, bc *baseCred) (*sshPrivateKeyCred, error) {
+	uAttr, pkAttr, pAttr := bc.lib.UsernameAttribute, bc.lib.PrivateKeyAttribute, bc.lib.PrivateKeyPassphraseAttribute
+	if uAttr == "" {
+		uAttr = "username"
+	}
+	if pkAttr == "" {
+		pkAttr = "private_key"
+	}
+	if pAttr == "" {
+		pAttr = "private_key_passphrase"
+	}
+	username, pk, pass := sshprivatekey.Extract(bc.secretData, uAttr, pkAttr, pAttr)
+	if username == "" || pk == nil {
+		return nil, errors.E(ctx, errors.WithCode(errors.CredentialFileInvalidMapping))
+	}
+
+	return &sshPrivateKeyCred{
+		baseCred:   bc,
+		username:   username,
+		privateKey: pk,
+		passphrase: pass,
+	}, nil
+}
+
+var _ credential.TlsClientCertificate = (*tlsClientCertCred)(nil)
+
+type tlsClientCertCred struct {
+	*baseCred
+	certificate   []byte
+	privateKey    credential.PrivateKey
+	caCertificate []byte
+}
+
+func (c *tlsClientCertCred) Certificate() []byte               { return c.certificate }
+func (c *tlsClientCertCred) PrivateKey() credential.PrivateKey { return c.privateKey }
+func (c *tlsClientCertCred) CaCertificate() []byte             { return c.caCertificate }
+
+func baseToTlsClientCert(ctx context.Context, bc *baseCred) (*tlsClientCertCred, error) {
+	cAttr, pkAttr, caAttr := bc.lib.CertificateAttribute, bc.lib.PrivateKeyAttribute, bc.lib.CaCertificateAttribute
+	if cAttr == "" {
+		cAttr = "certificate"
+	}
+	if pkAttr == "" {
+		pkAttr = "private_key"
+	}
+	if caAttr == "" {
+		caAttr = "ca_certificate"
+	}
+	cert, pk, ca := tlsclientcertificate.Extract(bc.secretData, cAttr, pkAttr, caAttr)
+	if cert == nil || pk == nil {
+		return nil, errors.E(ctx, errors.WithCode(errors.CredentialFileInvalidMapping))
+	}
+
+	return &tlsClientCertCred{
+		baseCred:      bc,
+		certificate:   cert,
+		privateKey:    pk,
+		caCertificate: ca,
+	}, nil
+}
+
+var _ credential.KubeconfigCredential = (*kubeconfigCred)(nil)
+
+type kubeconfigCred struct {
+	*baseCred
+	kubeconfig credential.Kubeconfig
+}
+
+func (c *kubeconfigCred) Kubeconfig() credential.Kubeconfig { return c.kubeconfig }
+
+func baseToKubeconfig(ctx context.Context, bc *baseCred) (*kubeconfigCred, error) {
+	kcAttr := bc.lib.KubeconfigAttribute
+	if kcAttr == "" {
+		kcAttr = "kubeconfig"
+	}
+	kc := kubeconfig.Extract(bc.secretData, kcAttr)
+	if kc == nil {
+		return nil, errors.E(ctx, errors.WithCode(errors.CredentialFileInvalidMapping))
+	}
+
+	return &kubeconfigCred{
+		baseCred:   bc,
+		kubeconfig: kc,
+	}, nil
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package file
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/file/store"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"google.golang.org/protobuf/proto"
+)
+
+// A CredentialLibrary selects an entry in the bundle of a file credential
+// store by path and is owned by the credential store.
+type CredentialLibrary struct {
+	*store.CredentialLibrary
+	tableName string `gorm:"-"`
+}
+
+// NewCredentialLibrary creates a new in memory CredentialLibrary for the
+// entry at path in the bundle of storeId. Name, description, credential
+// type and the mapping override attributes are the only valid options. All
+// other options are ignored.
+func NewCredentialLibrary(storeId string, path string, opt ...Option) (*CredentialLibrary, error) {
+	opts := getOpts(opt...)
+
+	l := &CredentialLibrary{
+		CredentialLibrary: &store.CredentialLibrary{
+			StoreId:                       storeId,
+			Name:                          opts.withName,
+			Description:                   opts.withDescription,
+			Path:                          path,
+			CredentialType:                string(opts.withCredentialType),
+			UsernameAttribute:             opts.withOverrideUsernameAttribute,
+			PasswordAttribute:             opts.withOverridePasswordAttribute,
+			PrivateKeyAttribute:           opts.withOverridePrivateKeyAttribute,
+			PrivateKeyPassphraseAttribute: opts.withOverridePrivateKeyPassphraseAttribute,
+			CertificateAttribute:          opts.withOverrideCertificateAttribute,
+			CaCertificateAttribute:        opts.withOverrideCaCertificateAttribute,
+			KubeconfigAttribute:           opts.withOverrideKubeconfigAttribute,
+		},
+	}
+
+	return l, nil
+}
+
+// validate checks that only the mapping override attributes of the
+// library's credential type are set.
+func (l *CredentialLibrary) validate(ctx context.Context, caller errors.Op) error {
+	allowed := map[string]bool{}
+	switch l.CredentialType() {
+	case globals.UsernamePasswordCredentialType:
+		allowed[usernameAttributeField] = true
+		allowed[passwordAttributeField] = true
+	case globals.SshPrivateKeyCredentialType:
+		allowed[usernameAttributeField] = true
+		allowed[privateKeyAttributeField] = true
+		allowed[privateKeyPassphraseAttributeField] = true
+	case globals.TlsClientCertificateCredentialType:
+		allowed[certificateAttributeField] = true
+		allowed[privateKeyAttributeField] = true
+		allowed[caCertificateAttributeField] = true
+	case globals.KubeconfigCredentialType:
+		allowed[kubeconfigAttributeField] = true
+	case globals.UnspecifiedCredentialType:
+	default:
+		return errors.New(ctx, errors.InvalidParameter, caller, "unsupported credential type")
+	}
+	for f, v := range l.mappingOverrides() {
+		if v != "" && !allowed[f] {
+			return errors.New(ctx, errors.InvalidParameter, caller, "invalid credential type for mapping override")
+		}
+	}
+	return nil
+}
+
+// mappingOverrides returns the mapping override attributes of the library
+// keyed by field name.
+func (l *CredentialLibrary) mappingOverrides() map[string]string {
+	return map[string]string{
+		usernameAttributeField:             l.UsernameAttribute,
+		passwordAttributeField:             l.PasswordAttribute,
+		privateKeyAttributeField:           l.PrivateKeyAttribute,
+		privateKeyPassphraseAttributeField: l.PrivateKeyPassphraseAttribute,
+		certificateAttributeField:          l.CertificateAttribute,
+		caCertificateAttributeField:        l.CaCertificateAttribute,
+		kubeconfigAttributeField:           l.KubeconfigAttribute,
+	}
+}
+
+func allocCredentialLibrary() *CredentialLibrary {
+	return &CredentialLibrary{
+		CredentialLibrary: &store.CredentialLibrary{},
+	}
+}
+
+func (l *CredentialLibrary) clone() *CredentialLibrary {
+	cp := proto.Clone(l.CredentialLibrary)
+	return &CredentialLibrary{
+		CredentialLibrary: cp.(*store.CredentialLibrary),
+	}
+}
+
+// TableName returns the table name.
+func (l *CredentialLibrary) TableName() string {
+	if l.tableName != "" {
+		return l.tableName
+	}
+	return "credential_file_library"
+}
+
+// SetTableName sets the table name.
+func (l *CredentialLibrary) SetTableName(n string) {
+	l.tableName = n
+}
+
+// GetResourceType returns the resource type of the CredentialLibrary
+func (l *CredentialLibrary) GetResourceType() resource.Type {
+	return resource.CredentialLibrary
+}
+
+func (l *CredentialLibrary) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{l.PublicId},
+		"resource-type":      []string{"credential-file-library"},
+		"op-type":            []string{op.String()},
+	}
+	if l.StoreId != "" {
+		metadata["store-id"] = []string{l.StoreId}
+	}
+	return metadata
+}
+
+// CredentialType returns the type of credential the library retrieves.
+func (l *CredentialLibrary) CredentialType() globals.CredentialType {
+	switch ct := l.GetCredentialType(); ct {
+	case "":
+		return globals.UnspecifiedCredentialType
+	default:
+		return globals.CredentialType(ct)
+	}
+}
+
+var _ credential.Library = (*CredentialLibrary)(nil)
+
+type deletedCredentialLibrary struct {
+	PublicId   string `gorm:"primary_key"`
+	DeleteTime *timestamp.Timestamp
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (s *deletedCredentialLibrary) TableName() string {
+	return "credential_file_library_deleted"
+}
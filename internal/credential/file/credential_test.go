@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package file
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		data    map[string]any
+		check   func(*testing.T, credential.Dynamic)
+		wantErr bool
+	}{
+		{
+			name: "unspecified",
+			data: map[string]any{"anything": "goes"},
+			check: func(t *testing.T, c credential.Dynamic) {
+				assert.Equal(t, map[string]any{"anything": "goes"}, c.Secret())
+			},
+		},
+		{
+			name: "username-password",
+			opts: []Option{WithCredentialType(globals.UsernamePasswordCredentialType)},
+			data: map[string]any{"username": "admin", "password": "pass"},
+			check: func(t *testing.T, c credential.Dynamic) {
+				up, ok := c.(credential.UsernamePassword)
+				require.True(t, ok)
+				assert.Equal(t, "admin", up.Username())
+				assert.Equal(t, credential.Password("pass"), up.Password())
+			},
+		},
+		{
+			name: "username-password-override",
+			opts: []Option{
+				WithCredentialType(globals.UsernamePasswordCredentialType),
+				WithOverrideUsernameAttribute("user"),
+				WithOverridePasswordAttribute("pw"),
+			},
+			data: map[string]any{"user": "admin", "pw": "pass"},
+			check: func(t *testing.T, c credential.Dynamic) {
+				up, ok := c.(credential.UsernamePassword)
+				require.True(t, ok)
+				assert.Equal(t, "admin", up.Username())
+				assert.Equal(t, credential.Password("pass"), up.Password())
+			},
+		},
+		{
+			name:    "username-password-missing-password",
+			opts:    []Option{WithCredentialType(globals.UsernamePasswordCredentialType)},
+			data:    map[string]any{"username": "admin"},
+			wantErr: true,
+		},
+		{
+			name: "ssh-private-key",
+			opts: []Option{WithCredentialType(globals.SshPrivateKeyCredentialType)},
+			data: map[string]any{"username": "admin", "private_key": "key", "private_key_passphrase": "phrase"},
+			check: func(t *testing.T, c credential.Dynamic) {
+				spk, ok := c.(credential.SshPrivateKey)
+				require.True(t, ok)
+				assert.Equal(t, "admin", spk.Username())
+				assert.Equal(t, credential.PrivateKey("key"), spk.PrivateKey())
+				assert.Equal(t, []byte("phrase"), spk.PrivateKeyPassphrase())
+			},
+		},
+		{
+			name:    "ssh-private-key-missing-key",
+			opts:    []Option{WithCredentialType(globals.SshPrivateKeyCredentialType)},
+			data:    map[string]any{"username": "admin"},
+			wantErr: true,
+		},
+		{
+			name: "kubeconfig",
+			opts: []Option{WithCredentialType(globals.KubeconfigCredentialType)},
+			data: map[string]any{"kubeconfig": "apiVersion: v1"},
+			check: func(t *testing.T, c credential.Dynamic) {
+				kc, ok := c.(credential.KubeconfigCredential)
+				require.True(t, ok)
+				assert.Equal(t, credential.Kubeconfig("apiVersion: v1"), kc.Kubeconfig())
+			},
+		},
+		{
+			name:    "kubeconfig-missing",
+			opts:    []Option{WithCredentialType(globals.KubeconfigCredentialType)},
+			data:    map[string]any{"config": "apiVersion: v1"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			lib, err := NewCredentialLibrary("csfile_1234567890", "path", tt.opts...)
+			require.NoError(t, err)
+			got, err := convert(ctx, &baseCred{
+				sessionId:  "s_1234567890",
+				lib:        lib,
+				purpose:    credential.BrokeredPurpose,
+				secretData: tt.data,
+			})
+			if tt.wantErr {
+				assert.Truef(t, errors.Match(errors.T(errors.CredentialFileInvalidMapping), err), "unexpected error: %v", err)
+				assert.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "s_1234567890", got.GetSessionId())
+			assert.Equal(t, credential.BrokeredPurpose, got.Purpose())
+			assert.Equal(t, lib, got.Library())
+			tt.check(t, got)
+		})
+	}
+}
+
+func TestCredentialLibrary_Validate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr bool
+	}{
+		{name: "unspecified"},
+		{
+			name: "username-password-overrides",
+			opts: []Option{
+				WithCredentialType(globals.UsernamePasswordCredentialType),
+				WithOverrideUsernameAttribute("user"),
+				WithOverridePasswordAttribute("pw"),
+			},
+		},
+		{
+			name: "tls-overrides",
+			opts: []Option{
+				WithCredentialType(globals.TlsClientCertificateCredentialType),
+				WithOverrideCertificateAttribute("cert"),
+				WithOverridePrivateKeyAttribute("key"),
+				WithOverrideCaCertificateAttribute("ca"),
+			},
+		},
+		{
+			name:    "unspecified-with-override",
+			opts:    []Option{WithOverrideUsernameAttribute("user")},
+			wantErr: true,
+		},
+		{
+			name: "username-password-with-private-key-override",
+			opts: []Option{
+				WithCredentialType(globals.UsernamePasswordCredentialType),
+				WithOverridePrivateKeyAttribute("key"),
+			},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported-type",
+			opts:    []Option{WithCredentialType(globals.JsonCredentialType)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			lib, err := NewCredentialLibrary("csfile_1234567890", "path", tt.opts...)
+			require.NoError(t, err)
+			err = lib.validate(ctx, "test")
+			if tt.wantErr {
+				assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
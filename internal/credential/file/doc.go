@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package file implements a credential store which reads credentials from
+// an encrypted bundle file mounted on the controllers. It is intended for
+// deployments which cannot reach a Vault server.
+//
+// A bundle is a JSON or YAML document of nested objects. A credential
+// library selects an entry in the bundle by a slash separated path, e.g.
+// "databases/prod/admin", and issues the attributes of the entry as a
+// credential for each session. The bundle is either encrypted for a set of
+// age identities or contains values wrapped by the controller's
+// credential-file KMS, and it is read again whenever it changes on disk.
+package file
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package file
+
+// These constants are the field names used in the file related field masks.
+const (
+	nameField                          = "Name"
+	descriptionField                   = "Description"
+	pathField                          = "Path"
+	ageIdentityPathField               = "AgeIdentityPath"
+	usernameAttributeField             = "UsernameAttribute"
+	passwordAttributeField             = "PasswordAttribute"
+	privateKeyAttributeField           = "PrivateKeyAttribute"
+	privateKeyPassphraseAttributeField = "PrivateKeyPassphraseAttribute"
+	certificateAttributeField          = "CertificateAttribute"
+	caCertificateAttributeField        = "CaCertificateAttribute"
+	kubeconfigAttributeField           = "KubeconfigAttribute"
+)
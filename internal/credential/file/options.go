@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package file
+
+import (
+	"github.com/hashicorp/boundary/globals"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+)
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName            string
+	withDescription     string
+	withLimit           int
+	withAgeIdentityPath string
+	withCredentialType  globals.CredentialType
+	withBundleWrapper   wrapping.Wrapper
+	withAllowedDirs     []string
+
+	withOverrideUsernameAttribute             string
+	withOverridePasswordAttribute             string
+	withOverridePrivateKeyAttribute           string
+	withOverridePrivateKeyPassphraseAttribute string
+	withOverrideCertificateAttribute          string
+	withOverrideCaCertificateAttribute        string
+	withOverrideKubeconfigAttribute           string
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithLimit provides an option to provide a limit. Intentionally allowing
+// negative integers. If WithLimit < 0, then unlimited results are
+// returned. If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithAgeIdentityPath provides an optional path of the file containing the
+// age identities used to decrypt the bundle of a credential store.
+func WithAgeIdentityPath(p string) Option {
+	return func(o *options) {
+		o.withAgeIdentityPath = p
+	}
+}
+
+// WithCredentialType provides an optional credential type to associate
+// with a credential library.
+func WithCredentialType(t globals.CredentialType) Option {
+	return func(o *options) {
+		o.withCredentialType = t
+	}
+}
+
+// WithBundleWrapper provides the wrapper used to decrypt the values in a
+// bundle which were encrypted with the credential-file KMS.
+func WithBundleWrapper(w wrapping.Wrapper) Option {
+	return func(o *options) {
+		o.withBundleWrapper = w
+	}
+}
+
+// WithAllowedDirectories provides the directories the bundle and age
+// identity files of credential stores must be within.
+func WithAllowedDirectories(dirs ...string) Option {
+	return func(o *options) {
+		o.withAllowedDirs = dirs
+	}
+}
+
+// WithOverrideUsernameAttribute provides the name of an attribute in the
+// bundle entry for the username of a credential.
+func WithOverrideUsernameAttribute(s string) Option {
+	return func(o *options) {
+		o.withOverrideUsernameAttribute = s
+	}
+}
+
+// WithOverridePasswordAttribute provides the name of an attribute in the
+// bundle entry for the password of a credential.
+func WithOverridePasswordAttribute(s string) Option {
+	return func(o *options) {
+		o.withOverridePasswordAttribute = s
+	}
+}
+
+// WithOverridePrivateKeyAttribute provides the name of an attribute in the
+// bundle entry for the private key of a credential.
+func WithOverridePrivateKeyAttribute(s string) Option {
+	return func(o *options) {
+		o.withOverridePrivateKeyAttribute = s
+	}
+}
+
+// WithOverridePrivateKeyPassphraseAttribute provides the name of an
+// attribute in the bundle entry for the private key passphrase of a
+// credential.
+func WithOverridePrivateKeyPassphraseAttribute(s string) Option {
+	return func(o *options) {
+		o.withOverridePrivateKeyPassphraseAttribute = s
+	}
+}
+
+// WithOverrideCertificateAttribute provides the name of an attribute in the
+// bundle entry for the client certificate of a credential.
+func WithOverrideCertificateAttribute(s string) Option {
+	return func(o *options) {
+		o.withOverrideCertificateAttribute = s
+	}
+}
+
+// WithOverrideCaCertificateAttribute provides the name of an attribute in
+// the bundle entry for the CA certificates of a credential.
+func WithOverrideCaCertificateAttribute(s string) Option {
+	return func(o *options) {
+		o.withOverrideCaCertificateAttribute = s
+	}
+}
+
+// WithOverrideKubeconfigAttribute provides the name of an attribute in the
+// bundle entry for the kubeconfig of a credential.
+func WithOverrideKubeconfigAttribute(s string) Option {
+	return func(o *options) {
+		o.withOverrideKubeconfigAttribute = s
+	}
+}
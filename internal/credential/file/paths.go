@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package file
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// resolvePath cleans p, resolves any symbolic links in it and returns the
+// result if it is within one of the allowed directories. File credential
+// stores are disabled unless the controller is configured with allowed
+// directories, so an empty allowed returns an error for every path.
+func resolvePath(ctx context.Context, p string, allowed []string) (string, error) {
+	const op = "file.resolvePath"
+	if len(allowed) == 0 {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "file credential stores are disabled: no allowed directories are configured on the controller")
+	}
+	if !filepath.IsAbs(p) {
+		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("path %q is not absolute", p))
+	}
+	resolved, err := evalPath(p)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to resolve path %q", p)))
+	}
+	for _, dir := range allowed {
+		resolvedDir, err := evalPath(dir)
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to resolve allowed directory %q", dir)))
+		}
+		rel, err := filepath.Rel(resolvedDir, resolved)
+		if err != nil {
+			continue
+		}
+		if rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return resolved, nil
+		}
+	}
+	return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("path %q is not within an allowed directory", p))
+}
+
+// evalPath returns the cleaned path p with its symbolic links evaluated. The
+// links of a path which does not exist yet are evaluated from its nearest
+// existing ancestor.
+func evalPath(p string) (string, error) {
+	p = filepath.Clean(p)
+	var rest []string
+	for {
+		resolved, err := filepath.EvalSymlinks(p)
+		if err == nil {
+			return filepath.Join(append([]string{resolved}, rest...)...), nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+		parent := filepath.Dir(p)
+		if parent == p {
+			return "", err
+		}
+		rest = append([]string{filepath.Base(p)}, rest...)
+		p = parent
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolvePath(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	allowed := t.TempDir()
+	outside := t.TempDir()
+	// The temp dirs may themselves be behind a link, e.g. on macOS.
+	resolvedAllowed, err := filepath.EvalSymlinks(allowed)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(allowed, "bundle.yaml"), nil, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(outside, "secrets.yaml"), nil, 0o600))
+	require.NoError(t, os.Symlink(filepath.Join(outside, "secrets.yaml"), filepath.Join(allowed, "escape.yaml")))
+	require.NoError(t, os.Symlink(outside, filepath.Join(allowed, "escapedir")))
+	require.NoError(t, os.Symlink(filepath.Join(allowed, "bundle.yaml"), filepath.Join(allowed, "link.yaml")))
+
+	tests := []struct {
+		name            string
+		path            string
+		allowed         []string
+		want            string
+		wantErrContains string
+	}{
+		{
+			name: "file",
+			path: filepath.Join(allowed, "bundle.yaml"),
+			want: filepath.Join(resolvedAllowed, "bundle.yaml"),
+		},
+		{
+			name: "not-yet-created",
+			path: filepath.Join(allowed, "sub", "bundle.yaml"),
+			want: filepath.Join(resolvedAllowed, "sub", "bundle.yaml"),
+		},
+		{
+			name: "link-within",
+			path: filepath.Join(allowed, "link.yaml"),
+			want: filepath.Join(resolvedAllowed, "bundle.yaml"),
+		},
+		{
+			name: "unclean-within",
+			path: allowed + "/sub/../bundle.yaml",
+			want: filepath.Join(resolvedAllowed, "bundle.yaml"),
+		},
+		{
+			name:            "traversal",
+			path:            allowed + "/../" + filepath.Base(outside) + "/secrets.yaml",
+			wantErrContains: "not within an allowed directory",
+		},
+		{
+			name:            "traversal-of-missing",
+			path:            allowed + "/missing/../../etc/passwd",
+			wantErrContains: "not within an allowed directory",
+		},
+		{
+			name:            "symlink-escape",
+			path:            filepath.Join(allowed, "escape.yaml"),
+			wantErrContains: "not within an allowed directory",
+		},
+		{
+			name:            "symlink-dir-escape",
+			path:            filepath.Join(allowed, "escapedir", "secrets.yaml"),
+			wantErrContains: "not within an allowed directory",
+		},
+		{
+			name:            "allowed-dir-itself",
+			path:            allowed,
+			wantErrContains: "not within an allowed directory",
+		},
+		{
+			name:            "relative",
+			path:            "bundle.yaml",
+			wantErrContains: "is not absolute",
+		},
+		{
+			name:            "disabled",
+			path:            filepath.Join(allowed, "bundle.yaml"),
+			allowed:         []string{},
+			wantErrContains: "no allowed directories are configured",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			dirs := []string{allowed}
+			if tt.allowed != nil {
+				dirs = tt.allowed
+			}
+			got, err := resolvePath(ctx, tt.path, dirs)
+			if tt.wantErrContains != "" {
+				require.Error(err)
+				assert.ErrorContains(err, tt.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package file
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+func init() {
+	globals.RegisterPrefixToResourceInfo(globals.FileCredentialStorePrefix, resource.CredentialStore, credential.Domain, Subtype)
+	globals.RegisterPrefixToResourceInfo(globals.FileCredentialLibraryPrefix, resource.CredentialLibrary, credential.Domain, Subtype)
+}
+
+// PublicId prefixes for the resources in the file package.
+const (
+	Subtype = globals.Subtype("file")
+)
+
+func newCredentialStoreId(ctx context.Context) (string, error) {
+	id, err := db.NewPublicId(ctx, globals.FileCredentialStorePrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "file.newCredentialStoreId")
+	}
+	return id, nil
+}
+
+func newCredentialLibraryId(ctx context.Context) (string, error) {
+	id, err := db.NewPublicId(ctx, globals.FileCredentialLibraryPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "file.newCredentialLibraryId")
+	}
+	return id, nil
+}
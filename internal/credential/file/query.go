@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package file
+
+const (
+	estimateCountCredentialLibraries = `
+select sum(reltuples::bigint) as estimate
+  from pg_class
+ where oid in (
+  'credential_file_library'::regclass
+)
+`
+)
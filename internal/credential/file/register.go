@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package file
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/credential"
+)
+
+func init() {
+	credential.RegisterStoreSubtype("file", &credentialHooks{})
+}
+
+type credentialHooks struct{}
+
+// NewStore creates a new file credential store from the result
+func (credentialHooks) NewStore(ctx context.Context, result *credential.StoreListQueryResult) (credential.Store, error) {
+	s := allocCredentialStore()
+	s.PublicId = result.PublicId
+	s.ProjectId = result.ProjectId
+	s.CreateTime = result.CreateTime
+	s.UpdateTime = result.UpdateTime
+	s.Name = result.Name
+	s.Description = result.Description
+	s.Version = result.Version
+	s.Path = result.Path
+	s.AgeIdentityPath = result.AgeIdentityPath
+
+	return s, nil
+}
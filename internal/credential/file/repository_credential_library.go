@@ -0,0 +1,404 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package file
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-dbw"
+)
+
+// CreateCredentialLibrary inserts l into the repository and returns a new
+// CredentialLibrary containing the credential library's PublicId. l is not
+// changed. l must contain a valid StoreId and a Path. l must not contain a
+// PublicId. The PublicId is generated and assigned by this method.
+//
+// Both l.Name and l.Description are optional. If l.Name is set, it must be
+// unique within l.StoreId. The mapping override attributes can only be set
+// for the credential type of l.
+//
+// Both l.CreateTime and l.UpdateTime are ignored.
+func (r *Repository) CreateCredentialLibrary(ctx context.Context, projectId string, l *CredentialLibrary, _ ...Option) (*CredentialLibrary, error) {
+	const op = "file.(Repository).CreateCredentialLibrary"
+	switch {
+	case l == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil CredentialLibrary")
+	case l.CredentialLibrary == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil embedded CredentialLibrary")
+	case l.StoreId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no store id")
+	case l.Path == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no path")
+	case l.PublicId != "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	case projectId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+	l = l.clone()
+
+	if err := l.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped.
+	}
+
+	id, err := newCredentialLibraryId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	l.PublicId = id
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var newCredentialLibrary *CredentialLibrary
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newCredentialLibrary = l.clone()
+			if err := w.Create(ctx, newCredentialLibrary,
+				db.WithOplog(oplogWrapper, newCredentialLibrary.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in credential store: %s: name %s already exists", l.StoreId, l.Name)))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in credential store: %s", l.StoreId)))
+	}
+	return newCredentialLibrary, nil
+}
+
+// LookupCredentialLibrary returns the CredentialLibrary for publicId.
+// Returns nil, nil if no CredentialLibrary is found for publicId.
+func (r *Repository) LookupCredentialLibrary(ctx context.Context, publicId string, _ ...Option) (*CredentialLibrary, error) {
+	const op = "file.(Repository).LookupCredentialLibrary"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	l := allocCredentialLibrary()
+	l.PublicId = publicId
+	if err := r.reader.LookupByPublicId(ctx, l); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", publicId)))
+	}
+	return l, nil
+}
+
+// UpdateCredentialLibrary updates the repository entry for l.PublicId with
+// the values in l for the fields listed in fieldMaskPaths. It returns a
+// new CredentialLibrary containing the updated values and a count of the
+// number of records updated. l is not changed.
+//
+// l must contain a valid PublicId. Only Name, Description, Path and the
+// mapping override attributes can be updated. If l.Name is set to a
+// non-empty string, it must be unique within l.StoreId. Path cannot be
+// unset. The mapping override attributes can only be set for the
+// credential type of the library.
+//
+// An attribute of l will be set to NULL in the database if the attribute
+// in l is the zero value and it is included in fieldMaskPaths.
+func (r *Repository) UpdateCredentialLibrary(ctx context.Context, projectId string, l *CredentialLibrary, version uint32, fieldMaskPaths []string, _ ...Option) (*CredentialLibrary, int, error) {
+	const op = "file.(Repository).UpdateCredentialLibrary"
+	switch {
+	case l == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing CredentialLibrary")
+	case l.CredentialLibrary == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing embedded CredentialLibrary")
+	case l.PublicId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	case version == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	case projectId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	}
+	l = l.clone()
+
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(nameField, f):
+		case strings.EqualFold(descriptionField, f):
+		case strings.EqualFold(pathField, f) && l.Path == "":
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "cannot unset path")
+		case strings.EqualFold(pathField, f):
+		case strings.EqualFold(usernameAttributeField, f):
+		case strings.EqualFold(passwordAttributeField, f):
+		case strings.EqualFold(privateKeyAttributeField, f):
+		case strings.EqualFold(privateKeyPassphraseAttributeField, f):
+		case strings.EqualFold(certificateAttributeField, f):
+		case strings.EqualFold(caCertificateAttributeField, f):
+		case strings.EqualFold(kubeconfigAttributeField, f):
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
+		}
+	}
+	dbMask, nullFields := dbw.BuildUpdatePaths(
+		map[string]any{
+			nameField:                          l.Name,
+			descriptionField:                   l.Description,
+			pathField:                          l.Path,
+			usernameAttributeField:             l.UsernameAttribute,
+			passwordAttributeField:             l.PasswordAttribute,
+			privateKeyAttributeField:           l.PrivateKeyAttribute,
+			privateKeyPassphraseAttributeField: l.PrivateKeyPassphraseAttribute,
+			certificateAttributeField:          l.CertificateAttribute,
+			caCertificateAttributeField:        l.CaCertificateAttribute,
+			kubeconfigAttributeField:           l.KubeconfigAttribute,
+		},
+		fieldMaskPaths,
+		nil,
+	)
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
+	}
+
+	origLib, err := r.LookupCredentialLibrary(ctx, l.PublicId)
+	switch {
+	case err != nil:
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	case origLib == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("credential library %s", l.PublicId))
+	}
+	// Check the mapping overrides the library will have after the update
+	// are valid for its credential type.
+	merged := origLib.clone()
+	for _, f := range append(dbMask, nullFields...) {
+		switch f {
+		case usernameAttributeField:
+			merged.UsernameAttribute = l.UsernameAttribute
+		case passwordAttributeField:
+			merged.PasswordAttribute = l.PasswordAttribute
+		case privateKeyAttributeField:
+			merged.PrivateKeyAttribute = l.PrivateKeyAttribute
+		case privateKeyPassphraseAttributeField:
+			merged.PrivateKeyPassphraseAttribute = l.PrivateKeyPassphraseAttribute
+		case certificateAttributeField:
+			merged.CertificateAttribute = l.CertificateAttribute
+		case caCertificateAttributeField:
+			merged.CaCertificateAttribute = l.CaCertificateAttribute
+		case kubeconfigAttributeField:
+			merged.KubeconfigAttribute = l.KubeconfigAttribute
+		}
+	}
+	if err := merged.validate(ctx, op); err != nil {
+		return nil, db.NoRowsAffected, err // intentionally not wrapped.
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt),
+			errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsUpdated int
+	var returnedCredentialLibrary *CredentialLibrary
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			returnedCredentialLibrary = l.clone()
+			var err error
+			rowsUpdated, err = w.Update(ctx, returnedCredentialLibrary,
+				dbMask, nullFields,
+				db.WithOplog(oplogWrapper, returnedCredentialLibrary.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.NotUnique, op,
+				fmt.Sprintf("name %s already exists: %s", l.Name, l.PublicId))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(l.PublicId))
+	}
+
+	return returnedCredentialLibrary, rowsUpdated, nil
+}
+
+// DeleteCredentialLibrary deletes publicId from the repository and returns
+// the number of records deleted.
+func (r *Repository) DeleteCredentialLibrary(ctx context.Context, projectId string, publicId string, _ ...Option) (int, error) {
+	const op = "file.(Repository).DeleteCredentialLibrary"
+	if publicId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	if projectId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+
+	l := allocCredentialLibrary()
+	l.PublicId = publicId
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) (err error) {
+			dl := l.clone()
+			rowsDeleted, err = w.Delete(ctx, dl, db.WithOplog(oplogWrapper, l.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err == nil && rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 CredentialLibrary would have been deleted")
+			}
+			return err
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("delete failed for %s", l.PublicId)))
+	}
+
+	return rowsDeleted, nil
+}
+
+// ListLibraries returns a slice of CredentialLibraries for the
+// storeId. Supports the following options:
+//   - credential.WithLimit
+//   - credential.WithStartPageAfterItem
+func (r *Repository) ListLibraries(ctx context.Context, storeId string, opt ...credential.Option) ([]credential.Library, time.Time, error) {
+	const op = "file.(Repository).ListLibraries"
+	if storeId == "" {
+		return nil, time.Time{}, errors.New(ctx, errors.InvalidParameter, op, "missing store id")
+	}
+	opts, err := credential.GetOpts(opt...)
+	if err != nil {
+		return nil, time.Time{}, errors.Wrap(ctx, err, op)
+	}
+	limit := r.defaultLimit
+	if opts.WithLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.WithLimit
+	}
+	whereClause := "store_id = @store_id"
+	args := []any{sql.Named("store_id", storeId)}
+	if opts.WithStartPageAfterItem != nil {
+		args = append(args,
+			sql.Named("last_item_create_time", opts.WithStartPageAfterItem.GetCreateTime()),
+			sql.Named("last_item_id", opts.WithStartPageAfterItem.GetPublicId()),
+		)
+		whereClause = whereClause + " and (create_time, public_id) < (@last_item_create_time, @last_item_id)"
+	}
+	return r.queryLibraries(ctx, whereClause, args, "create_time desc, public_id desc", limit)
+}
+
+// ListLibrariesRefresh returns a slice of credential libraries
+// for the store ID. Supports the following options:
+//   - credential.WithLimit
+//   - credential.WithStartPageAfterItem
+func (r *Repository) ListLibrariesRefresh(ctx context.Context, storeId string, updatedAfter time.Time, opt ...credential.Option) ([]credential.Library, time.Time, error) {
+	const op = "file.(Repository).ListLibrariesRefresh"
+	switch {
+	case storeId == "":
+		return nil, time.Time{}, errors.New(ctx, errors.InvalidParameter, op, "missing credential store ID")
+	case updatedAfter.IsZero():
+		return nil, time.Time{}, errors.New(ctx, errors.InvalidParameter, op, "missing updated after time")
+	}
+	opts, err := credential.GetOpts(opt...)
+	if err != nil {
+		return nil, time.Time{}, errors.Wrap(ctx, err, op)
+	}
+	limit := r.defaultLimit
+	if opts.WithLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.WithLimit
+	}
+	whereClause := "store_id = @store_id and update_time > @updated_after_time"
+	args := []any{
+		sql.Named("store_id", storeId),
+		sql.Named("updated_after_time", updatedAfter),
+	}
+	if opts.WithStartPageAfterItem != nil {
+		args = append(args,
+			sql.Named("last_item_update_time", opts.WithStartPageAfterItem.GetUpdateTime()),
+			sql.Named("last_item_id", opts.WithStartPageAfterItem.GetPublicId()),
+		)
+		whereClause = whereClause + " and (update_time, public_id) < (@last_item_update_time, @last_item_id)"
+	}
+	return r.queryLibraries(ctx, whereClause, args, "update_time desc, public_id desc", limit)
+}
+
+func (r *Repository) queryLibraries(ctx context.Context, whereClause string, args []any, order string, limit int) ([]credential.Library, time.Time, error) {
+	const op = "file.(Repository).queryLibraries"
+	var libs []*CredentialLibrary
+	var transactionTimestamp time.Time
+	if _, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(r db.Reader, _ db.Writer) error {
+		err := r.SearchWhere(ctx, &libs, whereClause, args, db.WithLimit(limit), db.WithOrder(order))
+		if err != nil {
+			return err
+		}
+		transactionTimestamp, err = r.Now(ctx)
+		return err
+	}); err != nil {
+		return nil, time.Time{}, errors.Wrap(ctx, err, op)
+	}
+	ret := make([]credential.Library, 0, len(libs))
+	for _, l := range libs {
+		ret = append(ret, l)
+	}
+	return ret, transactionTimestamp, nil
+}
+
+// EstimatedLibraryCount returns an estimate of the number of file credential libraries
+func (r *Repository) EstimatedLibraryCount(ctx context.Context) (int, error) {
+	const op = "file.(Repository).EstimatedLibraryCount"
+	rows, err := r.reader.Query(ctx, estimateCountCredentialLibraries, nil)
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op, errors.WithMsg("failed to query total file credential libraries"))
+	}
+	var count int
+	for rows.Next() {
+		if err := r.reader.ScanRows(ctx, rows, &count); err != nil {
+			return 0, errors.Wrap(ctx, err, op, errors.WithMsg("failed to query total file credential libraries"))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, errors.Wrap(ctx, err, op, errors.WithMsg("failed to query total file credential libraries"))
+	}
+	return count, nil
+}
+
+// ListDeletedLibraryIds lists the public IDs of any credential libraries deleted since the timestamp provided.
+func (r *Repository) ListDeletedLibraryIds(ctx context.Context, since time.Time) ([]string, time.Time, error) {
+	const op = "file.(Repository).ListDeletedLibraryIds"
+	var credentialLibraryIds []string
+	var transactionTimestamp time.Time
+	if _, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(r db.Reader, w db.Writer) error {
+		var deletedCredentialLibraries []*deletedCredentialLibrary
+		if err := r.SearchWhere(ctx, &deletedCredentialLibraries, "delete_time >= ?", []any{since}); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query deleted credential libraries"))
+		}
+		for _, cl := range deletedCredentialLibraries {
+			credentialLibraryIds = append(credentialLibraryIds, cl.PublicId)
+		}
+		var err error
+		transactionTimestamp, err = r.Now(ctx)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query transaction timestamp"))
+		}
+		return nil
+	}); err != nil {
+		return nil, time.Time{}, err
+	}
+	return credentialLibraryIds, transactionTimestamp, nil
+}
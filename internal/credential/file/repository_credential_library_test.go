@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package file
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateCredentialLibrary(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kkms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, prj.GetPublicId(), "/etc/boundary/bundle.yaml")
+
+	repo, err := NewRepository(ctx, rw, rw, kkms)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		path    string
+		opts    []Option
+		wantErr errors.Code
+	}{
+		{
+			name: "valid-no-options",
+			path: "prod/db",
+		},
+		{
+			name: "valid-with-overrides",
+			path: "prod/db",
+			opts: []Option{
+				WithName("ssh"),
+				WithCredentialType(globals.SshPrivateKeyCredentialType),
+				WithOverrideUsernameAttribute("user"),
+				WithOverridePrivateKeyAttribute("key"),
+			},
+		},
+		{
+			name:    "missing-path",
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "invalid-override",
+			path: "prod/db",
+			opts: []Option{
+				WithCredentialType(globals.UsernamePasswordCredentialType),
+				WithOverrideKubeconfigAttribute("kc"),
+			},
+			wantErr: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			in, err := NewCredentialLibrary(cs.GetPublicId(), tt.path, tt.opts...)
+			require.NoError(err)
+			got, err := repo.CreateCredentialLibrary(ctx, prj.GetPublicId(), in)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.NotEmpty(got.GetPublicId())
+			assert.Equal(tt.path, got.GetPath())
+
+			found, err := repo.LookupCredentialLibrary(ctx, got.GetPublicId())
+			require.NoError(err)
+			assert.Equal(got.GetCredentialType(), found.GetCredentialType())
+			assert.Equal(got.GetUsernameAttribute(), found.GetUsernameAttribute())
+		})
+	}
+}
+
+func TestRepository_UpdateCredentialLibrary(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kkms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, prj.GetPublicId(), "/etc/boundary/bundle.yaml")
+
+	repo, err := NewRepository(ctx, rw, rw, kkms)
+	require.NoError(t, err)
+
+	in, err := NewCredentialLibrary(cs.GetPublicId(), "prod/db", WithCredentialType(globals.UsernamePasswordCredentialType))
+	require.NoError(t, err)
+	lib, err := repo.CreateCredentialLibrary(ctx, prj.GetPublicId(), in)
+	require.NoError(t, err)
+
+	t.Run("path-and-override", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		upd := lib.clone()
+		upd.Path = "prod/other"
+		upd.UsernameAttribute = "user"
+		got, n, err := repo.UpdateCredentialLibrary(ctx, prj.GetPublicId(), upd, lib.GetVersion(), []string{pathField, usernameAttributeField})
+		require.NoError(err)
+		assert.Equal(1, n)
+		assert.Equal("prod/other", got.GetPath())
+		assert.Equal("user", got.GetUsernameAttribute())
+		lib = got
+	})
+	t.Run("unset-path", func(t *testing.T) {
+		upd := lib.clone()
+		upd.Path = ""
+		_, n, err := repo.UpdateCredentialLibrary(ctx, prj.GetPublicId(), upd, lib.GetVersion(), []string{pathField})
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+		assert.Equal(t, db.NoRowsAffected, n)
+	})
+	t.Run("override-for-other-type", func(t *testing.T) {
+		upd := lib.clone()
+		upd.CertificateAttribute = "cert"
+		_, n, err := repo.UpdateCredentialLibrary(ctx, prj.GetPublicId(), upd, lib.GetVersion(), []string{certificateAttributeField})
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+		assert.Equal(t, db.NoRowsAffected, n)
+	})
+	t.Run("invalid-field-mask", func(t *testing.T) {
+		upd := lib.clone()
+		_, n, err := repo.UpdateCredentialLibrary(ctx, prj.GetPublicId(), upd, lib.GetVersion(), []string{"CredentialType"})
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidFieldMask), err), "unexpected error: %v", err)
+		assert.Equal(t, db.NoRowsAffected, n)
+	})
+}
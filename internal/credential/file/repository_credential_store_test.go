@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CredentialStore_AllowedDirectories(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kkms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	allowed := t.TempDir()
+	outside := t.TempDir()
+	require.NoError(t, os.Symlink(outside, filepath.Join(allowed, "escape")))
+
+	repo, err := NewRepository(ctx, rw, rw, kkms, WithAllowedDirectories(allowed))
+	require.NoError(t, err)
+
+	newStore := func(t *testing.T, path string, opt ...Option) *CredentialStore {
+		t.Helper()
+		cs, err := NewCredentialStore(prj.GetPublicId(), path, opt...)
+		require.NoError(t, err)
+		return cs
+	}
+
+	t.Run("create", func(t *testing.T) {
+		tests := []struct {
+			name            string
+			in              *CredentialStore
+			wantErrContains string
+		}{
+			{
+				name: "valid",
+				in:   newStore(t, filepath.Join(allowed, "bundle.yaml.age"), WithAgeIdentityPath(filepath.Join(allowed, "identity.txt"))),
+			},
+			{
+				name:            "path-traversal",
+				in:              newStore(t, allowed+"/../"+filepath.Base(outside)+"/bundle.yaml"),
+				wantErrContains: "not within an allowed directory",
+			},
+			{
+				name:            "path-symlink-escape",
+				in:              newStore(t, filepath.Join(allowed, "escape", "bundle.yaml")),
+				wantErrContains: "not within an allowed directory",
+			},
+			{
+				name:            "age-identity-path-traversal",
+				in:              newStore(t, filepath.Join(allowed, "bundle.yaml.age"), WithAgeIdentityPath(allowed+"/../identity.txt")),
+				wantErrContains: "not within an allowed directory",
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				assert, require := assert.New(t), require.New(t)
+				got, err := repo.CreateCredentialStore(ctx, tt.in)
+				if tt.wantErrContains != "" {
+					require.Error(err)
+					assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+					assert.ErrorContains(err, tt.wantErrContains)
+					assert.Nil(got)
+					return
+				}
+				require.NoError(err)
+				assert.NotEmpty(got.GetPublicId())
+			})
+		}
+	})
+
+	t.Run("create-disabled", func(t *testing.T) {
+		disabledRepo, err := NewRepository(ctx, rw, rw, kkms)
+		require.NoError(t, err)
+		got, err := disabledRepo.CreateCredentialStore(ctx, newStore(t, filepath.Join(allowed, "bundle.yaml")))
+		assert.ErrorContains(t, err, "no allowed directories are configured")
+		assert.Nil(t, got)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		cs, err := repo.CreateCredentialStore(ctx, newStore(t, filepath.Join(allowed, "bundle.yaml")))
+		require.NoError(err)
+
+		in := cs.clone()
+		in.Path = filepath.Join(allowed, "escape", "bundle.yaml")
+		_, _, err = repo.UpdateCredentialStore(ctx, in, cs.GetVersion(), []string{pathField})
+		assert.ErrorContains(err, "not within an allowed directory")
+
+		in = cs.clone()
+		in.AgeIdentityPath = allowed + "/../identity.txt"
+		_, _, err = repo.UpdateCredentialStore(ctx, in, cs.GetVersion(), []string{ageIdentityPathField})
+		assert.ErrorContains(err, "not within an allowed directory")
+
+		in = cs.clone()
+		in.Path = filepath.Join(allowed, "other.yaml")
+		got, n, err := repo.UpdateCredentialStore(ctx, in, cs.GetVersion(), []string{pathField})
+		require.NoError(err)
+		assert.Equal(1, n)
+		assert.Equal(in.Path, got.GetPath())
+	})
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package file
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/util/template"
+)
+
+var _ credential.Issuer = (*Repository)(nil)
+
+// Issue reads the credentials for all of the requests from the bundles of
+// the file credential stores and assigns them to sessionId. The path of a
+// library is templated with the credential.WithTemplateData option before
+// the entry is looked up in the bundle.
+func (r *Repository) Issue(ctx context.Context, sessionId string, requests []credential.Request, opt ...credential.Option) ([]credential.Dynamic, error) {
+	const op = "file.(Repository).Issue"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	}
+	if len(requests) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no requests")
+	}
+	opts, err := credential.GetOpts(opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	stores := make(map[string]*CredentialStore)
+	creds := make([]credential.Dynamic, 0, len(requests))
+	for _, req := range requests {
+		lib, err := r.LookupCredentialLibrary(ctx, req.SourceId)
+		switch {
+		case err != nil:
+			return nil, errors.Wrap(ctx, err, op)
+		case lib == nil:
+			return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("credential library %s", req.SourceId))
+		}
+
+		cs, ok := stores[lib.GetStoreId()]
+		if !ok {
+			cs, err = r.LookupCredentialStore(ctx, lib.GetStoreId())
+			switch {
+			case err != nil:
+				return nil, errors.Wrap(ctx, err, op)
+			case cs == nil:
+				return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("credential store %s", lib.GetStoreId()))
+			}
+			stores[lib.GetStoreId()] = cs
+		}
+
+		// The allowed directories may have changed, or a link may have
+		// been replaced, since the store was written.
+		resolved := cs.clone()
+		if resolved.Path, err = resolvePath(ctx, cs.GetPath(), r.allowedDirs); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.CredentialFileRead), errors.WithMsg(fmt.Sprintf("credential store %s", cs.GetPublicId())))
+		}
+		if cs.GetAgeIdentityPath() != "" {
+			if resolved.AgeIdentityPath, err = resolvePath(ctx, cs.GetAgeIdentityPath(), r.allowedDirs); err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.CredentialFileRead), errors.WithMsg(fmt.Sprintf("credential store %s", cs.GetPublicId())))
+			}
+		}
+		data, err := bundles.load(ctx, resolved, r.bundleWrapper)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("credential store %s", cs.GetPublicId())))
+		}
+
+		parsedTmpl, err := template.New(ctx, lib.GetPath())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		path, err := parsedTmpl.Generate(ctx, opts.WithTemplateData)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		entry, err := lookupEntry(ctx, data, path)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("credential library %s", lib.GetPublicId())))
+		}
+
+		cred, err := convert(ctx, &baseCred{
+			sessionId:  sessionId,
+			lib:        lib,
+			purpose:    req.Purpose,
+			secretData: entry,
+		})
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("credential library %s", lib.GetPublicId())))
+		}
+		creds = append(creds, cred)
+	}
+	return creds, nil
+}
+
+var _ credential.Revoker = (*Repository)(nil)
+
+// Revoke is a no-op. Credentials read from a bundle are not leased and
+// there is nothing to revoke for sessionId.
+func (r *Repository) Revoke(ctx context.Context, sessionId string) error {
+	const op = "file.(Repository).Revoke"
+	if sessionId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/util/template"
+	"github.com/hashicorp/go-secure-stdlib/configutil/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Issue(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kkms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	bundleWrapper := db.TestWrapper(t)
+	wrapped, err := configutil.EncryptDecrypt("{{encrypt(s3cr3t)}}", false, false, bundleWrapper)
+	require.NoError(t, err)
+	bundleDir := t.TempDir()
+	bundlePath := filepath.Join(bundleDir, "bundle.yaml")
+	content := "" +
+		"prod:\n" +
+		"  db:\n" +
+		"    username: admin\n" +
+		"    password: \"" + wrapped + "\"\n" +
+		"  users:\n" +
+		"    alice:\n" +
+		"      user: alice\n" +
+		"      pw: alicepw\n"
+	require.NoError(t, os.WriteFile(bundlePath, []byte(content), 0o600))
+
+	repo, err := NewRepository(ctx, rw, rw, kkms, WithBundleWrapper(bundleWrapper), WithAllowedDirectories(bundleDir))
+	require.NoError(t, err)
+	cs := TestCredentialStore(t, conn, prj.GetPublicId(), bundlePath)
+
+	newLib := func(path string, opt ...Option) *CredentialLibrary {
+		t.Helper()
+		in, err := NewCredentialLibrary(cs.GetPublicId(), path, opt...)
+		require.NoError(t, err)
+		lib, err := repo.CreateCredentialLibrary(ctx, prj.GetPublicId(), in)
+		require.NoError(t, err)
+		return lib
+	}
+	genericLib := newLib("prod/db")
+	upLib := newLib("prod/db", WithCredentialType(globals.UsernamePasswordCredentialType))
+	templatedLib := newLib("prod/users/{{.User.Name}}",
+		WithCredentialType(globals.UsernamePasswordCredentialType),
+		WithOverrideUsernameAttribute("user"),
+		WithOverridePasswordAttribute("pw"))
+	missingLib := newLib("prod/cache")
+	badMappingLib := newLib("prod/users/alice", WithCredentialType(globals.UsernamePasswordCredentialType))
+
+	t.Run("generic", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		creds, err := repo.Issue(ctx, "s_1234567890", []credential.Request{{SourceId: genericLib.GetPublicId(), Purpose: credential.BrokeredPurpose}})
+		require.NoError(err)
+		require.Len(creds, 1)
+		assert.Equal(map[string]any{"username": "admin", "password": "s3cr3t"}, creds[0].Secret())
+		assert.Equal(credential.BrokeredPurpose, creds[0].Purpose())
+		assert.Equal(genericLib.GetPublicId(), creds[0].Library().GetPublicId())
+	})
+	t.Run("username-password", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		creds, err := repo.Issue(ctx, "s_1234567890", []credential.Request{{SourceId: upLib.GetPublicId(), Purpose: credential.InjectedApplicationPurpose}})
+		require.NoError(err)
+		require.Len(creds, 1)
+		up, ok := creds[0].(credential.UsernamePassword)
+		require.True(ok)
+		assert.Equal("admin", up.Username())
+		assert.Equal(credential.Password("s3cr3t"), up.Password())
+	})
+	t.Run("templated-path", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		name := "alice"
+		creds, err := repo.Issue(ctx, "s_1234567890",
+			[]credential.Request{{SourceId: templatedLib.GetPublicId(), Purpose: credential.BrokeredPurpose}},
+			credential.WithTemplateData(template.Data{User: template.User{Name: &name}}))
+		require.NoError(err)
+		require.Len(creds, 1)
+		up, ok := creds[0].(credential.UsernamePassword)
+		require.True(ok)
+		assert.Equal("alice", up.Username())
+		assert.Equal(credential.Password("alicepw"), up.Password())
+	})
+	t.Run("missing-entry", func(t *testing.T) {
+		creds, err := repo.Issue(ctx, "s_1234567890", []credential.Request{{SourceId: missingLib.GetPublicId(), Purpose: credential.BrokeredPurpose}})
+		assert.Truef(t, errors.Match(errors.T(errors.CredentialFileEntryNotFound), err), "unexpected error: %v", err)
+		assert.Empty(t, creds)
+	})
+	t.Run("invalid-mapping", func(t *testing.T) {
+		creds, err := repo.Issue(ctx, "s_1234567890", []credential.Request{{SourceId: badMappingLib.GetPublicId(), Purpose: credential.BrokeredPurpose}})
+		assert.Truef(t, errors.Match(errors.T(errors.CredentialFileInvalidMapping), err), "unexpected error: %v", err)
+		assert.Empty(t, creds)
+	})
+	t.Run("unknown-library", func(t *testing.T) {
+		creds, err := repo.Issue(ctx, "s_1234567890", []credential.Request{{SourceId: "clfile_doesnotexist", Purpose: credential.BrokeredPurpose}})
+		assert.Truef(t, errors.IsNotFoundError(err), "unexpected error: %v", err)
+		assert.Empty(t, creds)
+	})
+	t.Run("no-allowed-directories", func(t *testing.T) {
+		disabledRepo, err := NewRepository(ctx, rw, rw, kkms, WithBundleWrapper(bundleWrapper))
+		require.NoError(t, err)
+		creds, err := disabledRepo.Issue(ctx, "s_1234567890", []credential.Request{{SourceId: genericLib.GetPublicId(), Purpose: credential.BrokeredPurpose}})
+		assert.Truef(t, errors.Match(errors.T(errors.CredentialFileRead), err), "unexpected error: %v", err)
+		assert.Empty(t, creds)
+	})
+	t.Run("symlink-escape", func(t *testing.T) {
+		// Replacing the bundle with a link out of the allowed directory
+		// after the store is created is caught when it is read.
+		outside := filepath.Join(t.TempDir(), "secrets.yaml")
+		require.NoError(t, os.WriteFile(outside, []byte(content), 0o600))
+		require.NoError(t, os.Remove(bundlePath))
+		require.NoError(t, os.Symlink(outside, bundlePath))
+		creds, err := repo.Issue(ctx, "s_1234567890", []credential.Request{{SourceId: genericLib.GetPublicId(), Purpose: credential.BrokeredPurpose}})
+		assert.Truef(t, errors.Match(errors.T(errors.CredentialFileRead), err), "unexpected error: %v", err)
+		assert.ErrorContains(t, err, "not within an allowed directory")
+		assert.Empty(t, creds)
+	})
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package file
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCredentialStore creates a file credential store in the provided DB
+// with the provided project id and bundle path and any values passed in
+// through the Options vargs. If any errors are encountered during the
+// creation of the store, the test will fail.
+func TestCredentialStore(t testing.TB, conn *db.DB, projectId, path string, opts ...Option) *CredentialStore {
+	t.Helper()
+	ctx := context.Background()
+	w := db.New(conn)
+
+	cs, err := NewCredentialStore(projectId, path, opts...)
+	assert.NoError(t, err)
+	require.NotNil(t, cs)
+	id, err := newCredentialStoreId(ctx)
+	assert.NoError(t, err)
+	require.NotEmpty(t, id)
+	cs.PublicId = id
+
+	_, err2 := w.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, iw db.Writer) error {
+			require.NoError(t, iw.Create(ctx, cs))
+			return nil
+		},
+	)
+	require.NoError(t, err2)
+
+	return cs
+}
+
+// TestCredentialLibraries creates count number of file credential
+// libraries in the provided DB with the provided store id. If any errors
+// are encountered during the creation of the credential libraries, the
+// test will fail.
+func TestCredentialLibraries(t testing.TB, conn *db.DB, storeId string, count int) []*CredentialLibrary {
+	t.Helper()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	w := db.New(conn)
+	var libs []*CredentialLibrary
+
+	for i := 0; i < count; i++ {
+		lib, err := NewCredentialLibrary(storeId, fmt.Sprintf("path%d", i))
+		assert.NoError(err)
+		require.NotNil(lib)
+		id, err := newCredentialLibraryId(ctx)
+		assert.NoError(err)
+		require.NotEmpty(id)
+		lib.PublicId = id
+
+		_, err2 := w.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+			func(_ db.Reader, iw db.Writer) error {
+				return iw.Create(ctx, lib)
+			},
+		)
+
+		require.NoError(err2)
+		libs = append(libs, lib)
+	}
+	return libs
+}
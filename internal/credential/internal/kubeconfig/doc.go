@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package kubeconfig provides access to the kubeconfig stored in a Vault
+// secret or an entry of a credential bundle file.
+package kubeconfig
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package kubeconfig
+
+import (
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/mitchellh/pointerstructure"
+)
+
+type (
+	data map[string]any
+
+	// extractFunc attempts to extract the kubeconfig from sd using the
+	// provided attribute name, using a known Vault data response format.
+	extractFunc func(sd data, kubeconfigAttr string) credential.Kubeconfig
+)
+
+// Extract attempts to extract the value of the kubeconfig stored within the
+// provided data using the given attribute name.
+func Extract(d data, kubeconfigAttr string) credential.Kubeconfig {
+	for _, f := range []extractFunc{
+		defaultExtract,
+		kv2Extract,
+	} {
+		if kc := f(d, kubeconfigAttr); kc != nil {
+			// got valid kubeconfig from secret
+			return kc
+		}
+	}
+
+	return nil
+}
+
+// defaultExtract looks for the kubeconfigAttr in the data map.
+func defaultExtract(sd data, kubeconfigAttr string) credential.Kubeconfig {
+	if sd == nil {
+		// nothing to do return early
+		return nil
+	}
+
+	var kc any
+	switch {
+	case strings.HasPrefix(kubeconfigAttr, "/"):
+		var err error
+		kc, err = pointerstructure.Get(sd, kubeconfigAttr)
+		if err != nil {
+			return nil
+		}
+	default:
+		kc = sd[kubeconfigAttr]
+	}
+	if kc, ok := kc.(string); ok && kc != "" {
+		return credential.Kubeconfig(kc)
+	}
+	return nil
+}
+
+// kv2Extract looks for the kubeconfigAttr in the embedded 'data' field
+// within the data map.
+//
+// Additionally it validates the data is in the expected KV-v2 format:
+//
+//	{
+//		"data": {},
+//		"metadata: {}
+//	}
+//
+// If the format does not match, it returns nil. See:
+// https://www.vaultproject.io/api/secret/kv/kv-v2#sample-response-1
+func kv2Extract(sd data, kubeconfigAttr string) credential.Kubeconfig {
+	if sd == nil {
+		// nothing to do return early
+		return nil
+	}
+
+	var data, metadata map[string]any
+	for k, v := range sd {
+		switch k {
+		case "data":
+			var ok bool
+			if data, ok = v.(map[string]any); !ok {
+				// data field should be of type map[string]any in KV-v2
+				return nil
+			}
+		case "metadata":
+			var ok bool
+			if metadata, ok = v.(map[string]any); !ok {
+				// metadata field should be of type map[string]any in KV-v2
+				return nil
+			}
+		default:
+			// secretData contains a non valid KV-v2 top level field
+			return nil
+		}
+	}
+	if data == nil || metadata == nil {
+		// missing required KV-v2 field
+		return nil
+	}
+
+	if kc, ok := data[kubeconfigAttr].(string); ok && kc != "" {
+		return credential.Kubeconfig(kc)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package kubeconfig
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtract(t *testing.T) {
+	t.Parallel()
+
+	const kc = "apiVersion: v1\nkind: Config\n"
+
+	tests := []struct {
+		name string
+		s    data
+		attr string
+		want credential.Kubeconfig
+	}{
+		{
+			name: "nil-input",
+		},
+		{
+			name: "no-match",
+			s:    data{"kubeconfig-wrong": kc},
+			attr: "kubeconfig",
+		},
+		{
+			name: "not-a-string",
+			s:    data{"kubeconfig": 1},
+			attr: "kubeconfig",
+		},
+		{
+			name: "valid-default",
+			s:    data{"kubeconfig": kc},
+			attr: "kubeconfig",
+			want: credential.Kubeconfig(kc),
+		},
+		{
+			name: "valid-json-pointer",
+			s:    data{"cluster": map[string]any{"config": kc}},
+			attr: "/cluster/config",
+			want: credential.Kubeconfig(kc),
+		},
+		{
+			name: "valid-kv2",
+			s: data{
+				"metadata": map[string]any{},
+				"data":     map[string]any{"kubeconfig": kc},
+			},
+			attr: "kubeconfig",
+			want: credential.Kubeconfig(kc),
+		},
+		{
+			name: "invalid-kv2-missing-metadata",
+			s: data{
+				"data": map[string]any{"kubeconfig": kc},
+			},
+			attr: "kubeconfig",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, Extract(tt.s, tt.attr))
+		})
+	}
+}
@@ -2,5 +2,5 @@
 // SPDX-License-Identifier: BUSL-1.1
 
 // Package sshprivatekey provides access to the username and ssh private key
-// stored in a Vault secret.
+// stored in a Vault secret or an entry of a credential bundle file.
 package sshprivatekey
@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package tlsclientcertificate provides access to the client certificate,
+// private key and CA certificate stored in a Vault secret or an entry of a
+// credential bundle file.
+package tlsclientcertificate
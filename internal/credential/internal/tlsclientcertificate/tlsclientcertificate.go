@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tlsclientcertificate
+
+import (
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/mitchellh/pointerstructure"
+)
+
+type (
+	data map[string]any
+
+	// extractFunc attempts to extract the certificate, private key and CA
+	// certificate from sd using the provided attribute names, using a known
+	// Vault data response format.
+	extractFunc func(sd data, certificateAttr, privateKeyAttr, caCertificateAttr string) ([]byte, credential.PrivateKey, []byte)
+)
+
+// Extract attempts to extract the values of the certificate, private key and
+// optional CA certificate stored within the provided data using the given
+// attribute names.
+//
+// Extract does not return partial results, i.e. if the certificate was
+// extracted but not the private key (nil, nil, nil) will be returned.
+func Extract(d data, certificateAttr, privateKeyAttr, caCertificateAttr string) ([]byte, credential.PrivateKey, []byte) {
+	for _, f := range []extractFunc{
+		defaultExtract,
+		kv2Extract,
+	} {
+		certificate, privateKey, caCertificate := f(d, certificateAttr, privateKeyAttr, caCertificateAttr)
+		if certificate != nil && privateKey != nil {
+			// got valid certificate and privateKey from secret
+			return certificate, privateKey, caCertificate
+		}
+	}
+
+	return nil, nil, nil
+}
+
+// lookup returns the string value of attr in sd. If attr starts with a '/'
+// it is treated as a JSON pointer.
+func lookup(sd data, attr string) string {
+	var v any
+	switch {
+	case strings.HasPrefix(attr, "/"):
+		var err error
+		v, err = pointerstructure.Get(sd, attr)
+		if err != nil {
+			return ""
+		}
+	default:
+		v = sd[attr]
+	}
+	s, _ := v.(string)
+	return s
+}
+
+// defaultExtract looks for the certificateAttr, privateKeyAttr and
+// caCertificateAttr in the data map.
+func defaultExtract(sd data, certificateAttr, privateKeyAttr, caCertificateAttr string) (
+	certificate []byte, privateKey credential.PrivateKey, caCertificate []byte,
+) {
+	if sd == nil {
+		// nothing to do return early
+		return nil, nil, nil
+	}
+
+	if c := lookup(sd, certificateAttr); c != "" {
+		certificate = []byte(c)
+	}
+	if pk := lookup(sd, privateKeyAttr); pk != "" {
+		privateKey = credential.PrivateKey(pk)
+	}
+	if ca := lookup(sd, caCertificateAttr); ca != "" {
+		caCertificate = []byte(ca)
+	}
+
+	return certificate, privateKey, caCertificate
+}
+
+// kv2Extract looks for the certificateAttr, privateKeyAttr and
+// caCertificateAttr in the embedded 'data' field within the data map.
+//
+// Additionally it validates the data is in the expected KV-v2 format:
+//
+//	{
+//		"data": {},
+//		"metadata: {}
+//	}
+//
+// If the format does not match, it returns (nil, nil, nil). See:
+// https://www.vaultproject.io/api/secret/kv/kv-v2#sample-response-1
+func kv2Extract(sd data, certificateAttr, privateKeyAttr, caCertificateAttr string) (
+	certificate []byte, privateKey credential.PrivateKey, caCertificate []byte,
+) {
+	if sd == nil {
+		// nothing to do return early
+		return nil, nil, nil
+	}
+
+	var data, metadata map[string]any
+	for k, v := range sd {
+		switch k {
+		case "data":
+			var ok bool
+			if data, ok = v.(map[string]any); !ok {
+				// data field should be of type map[string]any in KV-v2
+				return nil, nil, nil
+			}
+		case "metadata":
+			var ok bool
+			if metadata, ok = v.(map[string]any); !ok {
+				// metadata field should be of type map[string]any in KV-v2
+				return nil, nil, nil
+			}
+		default:
+			// secretData contains a non valid KV-v2 top level field
+			return nil, nil, nil
+		}
+	}
+	if data == nil || metadata == nil {
+		// missing required KV-v2 field
+		return nil, nil, nil
+	}
+
+	if c, ok := data[certificateAttr].(string); ok && c != "" {
+		certificate = []byte(c)
+	}
+	if pk, ok := data[privateKeyAttr].(string); ok && pk != "" {
+		privateKey = credential.PrivateKey(pk)
+	}
+	if ca, ok := data[caCertificateAttr].(string); ok && ca != "" {
+		caCertificate = []byte(ca)
+	}
+
+	return certificate, privateKey, caCertificate
+}
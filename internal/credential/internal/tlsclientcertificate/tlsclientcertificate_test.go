@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tlsclientcertificate
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtract(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		s      data
+		cAttr  string
+		pkAttr string
+		caAttr string
+	}
+	type tlscc struct {
+		certificate   []byte
+		privateKey    credential.PrivateKey
+		caCertificate []byte
+	}
+	tests := []struct {
+		name  string
+		given args
+		want  tlscc
+	}{
+		{
+			name: "nil-input",
+			want: tlscc{},
+		},
+		{
+			name: "no-secret",
+			given: args{
+				cAttr:  "certificate",
+				pkAttr: "private_key",
+			},
+			want: tlscc{},
+		},
+		{
+			name: "missing-private-key",
+			given: args{
+				s: data{
+					"certificate": "cert",
+				},
+				cAttr:  "certificate",
+				pkAttr: "private_key",
+			},
+			want: tlscc{},
+		},
+		{
+			name: "valid-default",
+			given: args{
+				s: data{
+					"certificate":    "cert",
+					"private_key":    "key",
+					"ca_certificate": "ca",
+				},
+				cAttr:  "certificate",
+				pkAttr: "private_key",
+				caAttr: "ca_certificate",
+			},
+			want: tlscc{certificate: []byte("cert"), privateKey: credential.PrivateKey("key"), caCertificate: []byte("ca")},
+		},
+		{
+			name: "valid-pki-issue",
+			given: args{
+				s: data{
+					"certificate": "cert",
+					"private_key": "key",
+					"issuing_ca":  "ca",
+				},
+				cAttr:  "certificate",
+				pkAttr: "private_key",
+				caAttr: "issuing_ca",
+			},
+			want: tlscc{certificate: []byte("cert"), privateKey: credential.PrivateKey("key"), caCertificate: []byte("ca")},
+		},
+		{
+			name: "valid-json-pointer",
+			given: args{
+				s: data{
+					"tls": map[string]any{
+						"cert": "cert",
+						"key":  "key",
+					},
+				},
+				cAttr:  "/tls/cert",
+				pkAttr: "/tls/key",
+				caAttr: "/tls/ca",
+			},
+			want: tlscc{certificate: []byte("cert"), privateKey: credential.PrivateKey("key")},
+		},
+		{
+			name: "valid-kv2",
+			given: args{
+				s: data{
+					"metadata": map[string]any{},
+					"data": map[string]any{
+						"certificate": "cert",
+						"private_key": "key",
+					},
+				},
+				cAttr:  "certificate",
+				pkAttr: "private_key",
+				caAttr: "ca_certificate",
+			},
+			want: tlscc{certificate: []byte("cert"), privateKey: credential.PrivateKey("key")},
+		},
+		{
+			name: "invalid-kv2-extra-field",
+			given: args{
+				s: data{
+					"metadata": map[string]any{},
+					"data": map[string]any{
+						"certificate": "cert",
+						"private_key": "key",
+					},
+					"extra": "field",
+				},
+				cAttr:  "certificate",
+				pkAttr: "private_key",
+			},
+			want: tlscc{},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert := assert.New(t)
+			c, pk, ca := Extract(tt.given.s, tt.given.cAttr, tt.given.pkAttr, tt.given.caAttr)
+			assert.Equal(tt.want.certificate, c)
+			assert.Equal(tt.want.privateKey, pk)
+			assert.Equal(tt.want.caCertificate, ca)
+		})
+	}
+}
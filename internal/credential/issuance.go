@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credential
+
+import (
+	"github.com/hashicorp/boundary/internal/boundary"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+var _ boundary.Resource = (*Issuance)(nil)
+
+// Issuance records a credential issued to a session. Issuances are kept
+// after the session is deleted and are only deleted with the credential
+// library, static credential or project they belong to.
+type Issuance struct {
+	// PublicId is a surrogate key of the issuance.
+	PublicId string `gorm:"primary_key"`
+	// The Id of the session the credential was issued to.
+	SessionId string
+	// Optional Id of the user of the session. It is empty if the session
+	// was not created by a user.
+	UserId string
+	// Optional Id of the target of the session.
+	TargetId string
+	// The Id of the project of the session.
+	ProjectId string
+	// Optional Id of the credential library the credential was issued
+	// from. It is empty for static credentials.
+	CredentialLibraryId string
+	// Optional Id of the issued credential. It is empty for dynamic
+	// credentials which are not stored by Boundary.
+	CredentialId string
+	// Optional version of the issued static credential.
+	CredentialVersion uint32
+	// The purpose of the credential in the session.
+	Purpose Purpose
+	// The time the credential was issued.
+	CreateTime *timestamp.Timestamp
+	// The time the issuance was recorded or, for a credential library
+	// which stores the credentials it issues, the time CredentialId was
+	// set.
+	UpdateTime *timestamp.Timestamp
+}
+
+// GetPublicId returns the public id of the issuance.
+func (i *Issuance) GetPublicId() string {
+	return i.PublicId
+}
+
+// GetCreateTime returns the time the credential was issued.
+func (i *Issuance) GetCreateTime() *timestamp.Timestamp {
+	return i.CreateTime
+}
+
+// GetUpdateTime returns the time the issuance was last updated.
+func (i *Issuance) GetUpdateTime() *timestamp.Timestamp {
+	return i.UpdateTime
+}
+
+// GetVersion returns 0, issuances are not versioned.
+func (i *Issuance) GetVersion() uint32 {
+	return 0
+}
+
+// GetName returns an empty string, issuances do not have a name.
+func (i *Issuance) GetName() string {
+	return ""
+}
+
+// GetDescription returns an empty string, issuances do not have a
+// description.
+func (i *Issuance) GetDescription() string {
+	return ""
+}
+
+// GetResourceType returns the session resource type since each issuance
+// describes a session.
+func (i *Issuance) GetResourceType() resource.Type {
+	return resource.Session
+}
@@ -5,6 +5,7 @@ package credential
 
 import (
 	"errors"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/pagination"
@@ -36,6 +37,7 @@ type options struct {
 	WithWriter             db.Writer
 	WithLimit              int
 	WithStartPageAfterItem pagination.Item
+	WithSessionExpiration  time.Time
 }
 
 func getDefaultOptions() *options {
@@ -87,3 +89,14 @@ func WithStartPageAfterItem(item pagination.Item) Option {
 		return nil
 	}
 }
+
+// WithSessionExpiration provides the expiration time of the session
+// credentials are being issued for. Libraries that scope the lease of a
+// credential to the session use it to request a lease TTL equal to the
+// remaining time of the session.
+func WithSessionExpiration(t time.Time) Option {
+	return func(o *options) error {
+		o.WithSessionExpiration = t
+		return nil
+	}
+}
@@ -119,4 +119,13 @@ func Test_GetOpts(t *testing.T) {
 		assert.Equal(opts.WithStartPageAfterItem.GetUpdateTime(), timestamp.New(updateTime))
 		assert.Equal(opts.WithStartPageAfterItem.GetCreateTime(), timestamp.New(createTime))
 	})
+	t.Run("WithSessionExpiration", func(t *testing.T) {
+		t.Parallel()
+		opts := getDefaultOptions()
+		assert.Empty(t, opts.WithSessionExpiration)
+		exp := time.Now().Add(time.Hour)
+		opts, err := GetOpts(WithSessionExpiration(exp))
+		require.NoError(t, err)
+		assert.Equal(t, exp, opts.WithSessionExpiration)
+	})
 }
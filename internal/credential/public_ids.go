@@ -17,6 +17,8 @@ func init() {
 	globals.RegisterPrefixToResourceInfo(globals.UsernamePasswordCredentialPreviousPrefix, resource.Credential, Domain, UsernamePasswordSubtype)
 	globals.RegisterPrefixToResourceInfo(globals.SshPrivateKeyCredentialPrefix, resource.Credential, Domain, SshPrivateKeySubtype)
 	globals.RegisterPrefixToResourceInfo(globals.JsonCredentialPrefix, resource.Credential, Domain, JsonSubtype)
+	globals.RegisterPrefixToResourceInfo(globals.TlsClientCertificateCredentialPrefix, resource.Credential, Domain, TlsClientCertificateSubtype)
+	globals.RegisterPrefixToResourceInfo(globals.KubeconfigCredentialPrefix, resource.Credential, Domain, KubeconfigSubtype)
 }
 
 const (
@@ -25,6 +27,10 @@ const (
 	SshPrivateKeySubtype = globals.Subtype("ssh_private_key")
 
 	JsonSubtype = globals.Subtype("json")
+
+	TlsClientCertificateSubtype = globals.Subtype("tls_client_certificate")
+
+	KubeconfigSubtype = globals.Subtype("kubeconfig")
 )
 
 func NewUsernamePasswordCredentialId(ctx context.Context) (string, error) {
@@ -50,3 +56,19 @@ func NewJsonCredentialId(ctx context.Context) (string, error) {
 	}
 	return id, nil
 }
+
+func NewTlsClientCertificateCredentialId(ctx context.Context) (string, error) {
+	id, err := db.NewPublicId(ctx, globals.TlsClientCertificateCredentialPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "credential.NewTlsClientCertificateCredentialId")
+	}
+	return id, nil
+}
+
+func NewKubeconfigCredentialId(ctx context.Context) (string, error) {
+	id, err := db.NewPublicId(ctx, globals.KubeconfigCredentialPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "credential.NewKubeconfigCredentialId")
+	}
+	return id, nil
+}
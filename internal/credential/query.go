@@ -7,7 +7,8 @@ const (
 	estimateCountStoresQuery = `
 select sum(reltuples::bigint) as estimate from pg_class where oid in (
 	'credential_vault_store'::regclass,
-	'credential_static_store'::regclass
+	'credential_static_store'::regclass,
+	'credential_file_store'::regclass
 )
 `
 
@@ -19,6 +20,10 @@ select public_id
 select public_id
   from credential_static_store_deleted
  where delete_time >= @since
+ union
+select public_id
+  from credential_file_store_deleted
+ where delete_time >= @since
 `
 
 	listStoresTemplate = `
@@ -50,11 +55,25 @@ vault_client_certs as (
     from credential_vault_client_certificate
    where store_id in (select public_id from stores)
 ),
+vault_store_auths as (
+  select store_id,
+         method,
+         mount_path,
+         role,
+         secret_hmac
+    from credential_vault_store_auth
+   where store_id in (select public_id from stores)
+),
 static_stores as (
   select *
     from credential_static_store
    where public_id in (select public_id from stores)
 ),
+file_stores as (
+  select *
+    from credential_file_store
+   where public_id in (select public_id from stores)
+),
 final as (
      select store.public_id,
             store.project_id,
@@ -74,10 +93,22 @@ final as (
             coalesce(token.status, 'expired') as token_status,
             cert.certificate                  as client_cert,
             cert.certificate_key_hmac         as client_cert_key_hmac,
+            auth.method                       as auth_method,
+            auth.mount_path                   as auth_mount_path,
+            auth.role                         as auth_role,
+            auth.secret_hmac                  as auth_secret_hmac,
+            null                              as rotation_period,
+            null                              as rotation_grace_period,
+            null                              as rotator,
+            null                              as exclusive_checkout,
+            null                              as rotate_on_checkin,
+            null                              as path,
+            null                              as age_identity_path,
             'vault' as subtype
        from vault_stores store
   left join vault_tokens token      on store.public_id = token.store_id
   left join vault_client_certs cert on store.public_id = cert.store_id
+  left join vault_store_auths auth  on store.public_id = auth.store_id
       union
      select public_id,
             project_id,
@@ -97,8 +128,51 @@ final as (
             null as token_status,         -- Add to make union uniform
             null as client_cert,          -- Add to make union uniform
             null as client_cert_key_hmac, -- Add to make union uniform
+            null as auth_method,          -- Add to make union uniform
+            null as auth_mount_path,      -- Add to make union uniform
+            null as auth_role,            -- Add to make union uniform
+            null as auth_secret_hmac,     -- Add to make union uniform
+            rotation_period,
+            rotation_grace_period,
+            rotator,
+            exclusive_checkout,
+            rotate_on_checkin,
+            null as path,                 -- Add to make union uniform
+            null as age_identity_path,    -- Add to make union uniform
             'static' as subtype
        from static_stores
+      union
+     select public_id,
+            project_id,
+            name,
+            description,
+            create_time,
+            update_time,
+            version,
+            null as delete_time,          -- Add to make union uniform
+            null as vault_address,        -- Add to make union uniform
+            null as namespace,            -- Add to make union uniform
+            null as ca_cert,              -- Add to make union uniform
+            null as tls_server_name,      -- Add to make union uniform
+            null as tls_skip_verify,      -- Add to make union uniform
+            null as worker_filter,        -- Add to make union uniform
+            null as token_hmac,           -- Add to make union uniform
+            null as token_status,         -- Add to make union uniform
+            null as client_cert,          -- Add to make union uniform
+            null as client_cert_key_hmac, -- Add to make union uniform
+            null as auth_method,          -- Add to make union uniform
+            null as auth_mount_path,      -- Add to make union uniform
+            null as auth_role,            -- Add to make union uniform
+            null as auth_secret_hmac,     -- Add to make union uniform
+            null as rotation_period,      -- Add to make union uniform
+            null as rotation_grace_period, -- Add to make union uniform
+            null as rotator,              -- Add to make union uniform
+            null as exclusive_checkout,   -- Add to make union uniform
+            null as rotate_on_checkin,    -- Add to make union uniform
+            path,
+            age_identity_path,
+            'file' as subtype
+       from file_stores
 )
   select *
     from final
@@ -135,11 +209,25 @@ vault_client_certs as (
     from credential_vault_client_certificate
    where store_id in (select public_id from stores)
 ),
+vault_store_auths as (
+  select store_id,
+         method,
+         mount_path,
+         role,
+         secret_hmac
+    from credential_vault_store_auth
+   where store_id in (select public_id from stores)
+),
 static_stores as (
   select *
     from credential_static_store
    where public_id in (select public_id from stores)
 ),
+file_stores as (
+  select *
+    from credential_file_store
+   where public_id in (select public_id from stores)
+),
 final as (
      select store.public_id,
             store.project_id,
@@ -159,10 +247,22 @@ final as (
             coalesce(token.status, 'expired') as token_status,
             cert.certificate                  as client_cert,
             cert.certificate_key_hmac         as client_cert_key_hmac,
+            auth.method                       as auth_method,
+            auth.mount_path                   as auth_mount_path,
+            auth.role                         as auth_role,
+            auth.secret_hmac                  as auth_secret_hmac,
+            null                              as rotation_period,
+            null                              as rotation_grace_period,
+            null                              as rotator,
+            null                              as exclusive_checkout,
+            null                              as rotate_on_checkin,
+            null                              as path,
+            null                              as age_identity_path,
             'vault' as subtype
        from vault_stores store
   left join vault_tokens token      on store.public_id = token.store_id
   left join vault_client_certs cert on store.public_id = cert.store_id
+  left join vault_store_auths auth  on store.public_id = auth.store_id
       union
      select public_id,
             project_id,
@@ -182,8 +282,51 @@ final as (
             null as token_status,         -- Add to make union uniform
             null as client_cert,          -- Add to make union uniform
             null as client_cert_key_hmac, -- Add to make union uniform
+            null as auth_method,          -- Add to make union uniform
+            null as auth_mount_path,      -- Add to make union uniform
+            null as auth_role,            -- Add to make union uniform
+            null as auth_secret_hmac,     -- Add to make union uniform
+            rotation_period,
+            rotation_grace_period,
+            rotator,
+            exclusive_checkout,
+            rotate_on_checkin,
+            null as path,                 -- Add to make union uniform
+            null as age_identity_path,    -- Add to make union uniform
             'static' as subtype
        from static_stores
+      union
+     select public_id,
+            project_id,
+            name,
+            description,
+            create_time,
+            update_time,
+            version,
+            null as delete_time,          -- Add to make union uniform
+            null as vault_address,        -- Add to make union uniform
+            null as namespace,            -- Add to make union uniform
+            null as ca_cert,              -- Add to make union uniform
+            null as tls_server_name,      -- Add to make union uniform
+            null as tls_skip_verify,      -- Add to make union uniform
+            null as worker_filter,        -- Add to make union uniform
+            null as token_hmac,           -- Add to make union uniform
+            null as token_status,         -- Add to make union uniform
+            null as client_cert,          -- Add to make union uniform
+            null as client_cert_key_hmac, -- Add to make union uniform
+            null as auth_method,          -- Add to make union uniform
+            null as auth_mount_path,      -- Add to make union uniform
+            null as auth_role,            -- Add to make union uniform
+            null as auth_secret_hmac,     -- Add to make union uniform
+            null as rotation_period,      -- Add to make union uniform
+            null as rotation_grace_period, -- Add to make union uniform
+            null as rotator,              -- Add to make union uniform
+            null as exclusive_checkout,   -- Add to make union uniform
+            null as rotate_on_checkin,    -- Add to make union uniform
+            path,
+            age_identity_path,
+            'file' as subtype
+       from file_stores
 )
   select *
     from final
@@ -220,11 +363,25 @@ vault_client_certs as (
     from credential_vault_client_certificate
    where store_id in (select public_id from stores)
 ),
+vault_store_auths as (
+  select store_id,
+         method,
+         mount_path,
+         role,
+         secret_hmac
+    from credential_vault_store_auth
+   where store_id in (select public_id from stores)
+),
 static_stores as (
   select *
     from credential_static_store
    where public_id in (select public_id from stores)
 ),
+file_stores as (
+  select *
+    from credential_file_store
+   where public_id in (select public_id from stores)
+),
 final as (
      select store.public_id,
             store.project_id,
@@ -244,10 +401,22 @@ final as (
             coalesce(token.status, 'expired') as token_status,
             cert.certificate                  as client_cert,
             cert.certificate_key_hmac         as client_cert_key_hmac,
+            auth.method                       as auth_method,
+            auth.mount_path                   as auth_mount_path,
+            auth.role                         as auth_role,
+            auth.secret_hmac                  as auth_secret_hmac,
+            null                              as rotation_period,
+            null                              as rotation_grace_period,
+            null                              as rotator,
+            null                              as exclusive_checkout,
+            null                              as rotate_on_checkin,
+            null                              as path,
+            null                              as age_identity_path,
             'vault' as subtype
        from vault_stores store
   left join vault_tokens token      on store.public_id = token.store_id
   left join vault_client_certs cert on store.public_id = cert.store_id
+  left join vault_store_auths auth  on store.public_id = auth.store_id
       union
      select public_id,
             project_id,
@@ -267,8 +436,51 @@ final as (
             null as token_status,         -- Add to make union uniform
             null as client_cert,          -- Add to make union uniform
             null as client_cert_key_hmac, -- Add to make union uniform
+            null as auth_method,          -- Add to make union uniform
+            null as auth_mount_path,      -- Add to make union uniform
+            null as auth_role,            -- Add to make union uniform
+            null as auth_secret_hmac,     -- Add to make union uniform
+            rotation_period,
+            rotation_grace_period,
+            rotator,
+            exclusive_checkout,
+            rotate_on_checkin,
+            null as path,                 -- Add to make union uniform
+            null as age_identity_path,    -- Add to make union uniform
             'static' as subtype
        from static_stores
+      union
+     select public_id,
+            project_id,
+            name,
+            description,
+            create_time,
+            update_time,
+            version,
+            null as delete_time,          -- Add to make union uniform
+            null as vault_address,        -- Add to make union uniform
+            null as namespace,            -- Add to make union uniform
+            null as ca_cert,              -- Add to make union uniform
+            null as tls_server_name,      -- Add to make union uniform
+            null as tls_skip_verify,      -- Add to make union uniform
+            null as worker_filter,        -- Add to make union uniform
+            null as token_hmac,           -- Add to make union uniform
+            null as token_status,         -- Add to make union uniform
+            null as client_cert,          -- Add to make union uniform
+            null as client_cert_key_hmac, -- Add to make union uniform
+            null as auth_method,          -- Add to make union uniform
+            null as auth_mount_path,      -- Add to make union uniform
+            null as auth_role,            -- Add to make union uniform
+            null as auth_secret_hmac,     -- Add to make union uniform
+            null as rotation_period,      -- Add to make union uniform
+            null as rotation_grace_period, -- Add to make union uniform
+            null as rotator,              -- Add to make union uniform
+            null as exclusive_checkout,   -- Add to make union uniform
+            null as rotate_on_checkin,    -- Add to make union uniform
+            path,
+            age_identity_path,
+            'file' as subtype
+       from file_stores
 )
   select *
     from final
@@ -306,11 +518,25 @@ vault_client_certs as (
     from credential_vault_client_certificate
    where store_id in (select public_id from stores)
 ),
+vault_store_auths as (
+  select store_id,
+         method,
+         mount_path,
+         role,
+         secret_hmac
+    from credential_vault_store_auth
+   where store_id in (select public_id from stores)
+),
 static_stores as (
   select *
     from credential_static_store
    where public_id in (select public_id from stores)
 ),
+file_stores as (
+  select *
+    from credential_file_store
+   where public_id in (select public_id from stores)
+),
 final as (
      select store.public_id,
             store.project_id,
@@ -330,10 +556,22 @@ final as (
             coalesce(token.status, 'expired') as token_status,
             cert.certificate                  as client_cert,
             cert.certificate_key_hmac         as client_cert_key_hmac,
+            auth.method                       as auth_method,
+            auth.mount_path                   as auth_mount_path,
+            auth.role                         as auth_role,
+            auth.secret_hmac                  as auth_secret_hmac,
+            null                              as rotation_period,
+            null                              as rotation_grace_period,
+            null                              as rotator,
+            null                              as exclusive_checkout,
+            null                              as rotate_on_checkin,
+            null                              as path,
+            null                              as age_identity_path,
             'vault' as subtype
        from vault_stores store
   left join vault_tokens token      on store.public_id = token.store_id
   left join vault_client_certs cert on store.public_id = cert.store_id
+  left join vault_store_auths auth  on store.public_id = auth.store_id
       union
      select public_id,
             project_id,
@@ -353,11 +591,138 @@ final as (
             null as token_status,         -- Add to make union uniform
             null as client_cert,          -- Add to make union uniform
             null as client_cert_key_hmac, -- Add to make union uniform
+            null as auth_method,          -- Add to make union uniform
+            null as auth_mount_path,      -- Add to make union uniform
+            null as auth_role,            -- Add to make union uniform
+            null as auth_secret_hmac,     -- Add to make union uniform
+            rotation_period,
+            rotation_grace_period,
+            rotator,
+            exclusive_checkout,
+            rotate_on_checkin,
+            null as path,                 -- Add to make union uniform
+            null as age_identity_path,    -- Add to make union uniform
             'static' as subtype
        from static_stores
+      union
+     select public_id,
+            project_id,
+            name,
+            description,
+            create_time,
+            update_time,
+            version,
+            null as delete_time,          -- Add to make union uniform
+            null as vault_address,        -- Add to make union uniform
+            null as namespace,            -- Add to make union uniform
+            null as ca_cert,              -- Add to make union uniform
+            null as tls_server_name,      -- Add to make union uniform
+            null as tls_skip_verify,      -- Add to make union uniform
+            null as worker_filter,        -- Add to make union uniform
+            null as token_hmac,           -- Add to make union uniform
+            null as token_status,         -- Add to make union uniform
+            null as client_cert,          -- Add to make union uniform
+            null as client_cert_key_hmac, -- Add to make union uniform
+            null as auth_method,          -- Add to make union uniform
+            null as auth_mount_path,      -- Add to make union uniform
+            null as auth_role,            -- Add to make union uniform
+            null as auth_secret_hmac,     -- Add to make union uniform
+            null as rotation_period,      -- Add to make union uniform
+            null as rotation_grace_period, -- Add to make union uniform
+            null as rotator,              -- Add to make union uniform
+            null as exclusive_checkout,   -- Add to make union uniform
+            null as rotate_on_checkin,    -- Add to make union uniform
+            path,
+            age_identity_path,
+            'file' as subtype
+       from file_stores
 )
   select *
     from final
 order by update_time desc, public_id desc;
 `
 )
+
+const (
+	countIssuancesQuery = `
+select count(*)
+  from credential_issuance
+ where (credential_library_id = @credential_id or credential_static_id = @credential_id)
+`
+
+	listIssuancesTemplate = `
+  select public_id,
+         session_id,
+         user_id,
+         target_id,
+         project_id,
+         credential_library_id,
+         coalesce(credential_static_id, credential_dynamic_id) as credential_id,
+         credential_version,
+         credential_purpose as purpose,
+         create_time,
+         update_time
+    from credential_issuance
+   where (credential_library_id = @credential_id or credential_static_id = @credential_id)
+order by create_time desc, public_id desc
+   limit %d;
+`
+
+	listIssuancesPageTemplate = `
+  select public_id,
+         session_id,
+         user_id,
+         target_id,
+         project_id,
+         credential_library_id,
+         coalesce(credential_static_id, credential_dynamic_id) as credential_id,
+         credential_version,
+         credential_purpose as purpose,
+         create_time,
+         update_time
+    from credential_issuance
+   where (credential_library_id = @credential_id or credential_static_id = @credential_id)
+     and (create_time, public_id) < (@last_item_create_time, @last_item_id)
+order by create_time desc, public_id desc
+   limit %d;
+`
+
+	listIssuancesRefreshTemplate = `
+  select public_id,
+         session_id,
+         user_id,
+         target_id,
+         project_id,
+         credential_library_id,
+         coalesce(credential_static_id, credential_dynamic_id) as credential_id,
+         credential_version,
+         credential_purpose as purpose,
+         create_time,
+         update_time
+    from credential_issuance
+   where (credential_library_id = @credential_id or credential_static_id = @credential_id)
+     and update_time > @updated_after_time
+order by update_time desc, public_id desc
+   limit %d;
+`
+
+	listIssuancesRefreshPageTemplate = `
+  select public_id,
+         session_id,
+         user_id,
+         target_id,
+         project_id,
+         credential_library_id,
+         coalesce(credential_static_id, credential_dynamic_id) as credential_id,
+         credential_version,
+         credential_purpose as purpose,
+         create_time,
+         update_time
+    from credential_issuance
+   where (credential_library_id = @credential_id or credential_static_id = @credential_id)
+     and update_time > @updated_after_time
+     and (update_time, public_id) < (@last_item_update_time, @last_item_id)
+order by update_time desc, public_id desc
+   limit %d;
+`
+)
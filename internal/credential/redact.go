@@ -9,6 +9,7 @@ const (
 	redactedPassword   = "[REDACTED: password]"
 	redactedPrivateKey = "[REDACTED: private key]"
 	redactedJson       = "[REDACTED: json]"
+	redactedKubeconfig = "[REDACTED: kubeconfig]"
 )
 
 // String returns a string with the password redacted.
@@ -56,3 +57,19 @@ func (s *JsonObject) GoString() string {
 func (s *JsonObject) MarshalJSON() ([]byte, error) {
 	return json.Marshal([]byte(redactedJson))
 }
+
+// String returns a string with the kubeconfig redacted.
+func (s Kubeconfig) String() string {
+	return redactedKubeconfig
+}
+
+// GoString returns a string with the kubeconfig redacted.
+func (s Kubeconfig) GoString() string {
+	return redactedKubeconfig
+}
+
+// MarshalJSON returns a JSON-encoded byte slice with the kubeconfig
+// redacted.
+func (s Kubeconfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]byte(redactedKubeconfig))
+}
@@ -135,6 +135,67 @@ func TestPrivateKey_MarshalJSON(t *testing.T) {
 	})
 }
 
+func TestKubeconfig_String(t *testing.T) {
+	t.Parallel()
+	t.Run("redacted", func(t *testing.T) {
+		assert := assert.New(t)
+		const want = redactedKubeconfig
+		kc := Kubeconfig("special secret")
+		assert.Equalf(want, kc.String(), "Kubeconfig.String() = %v, want %v", kc.String(), want)
+
+		// Verify stringer is called
+		s := fmt.Sprintf("%s", kc)
+		assert.Equalf(want, s, "Kubeconfig.String() = %v, want %v", s, want)
+	})
+}
+
+func TestKubeconfig_GoString(t *testing.T) {
+	t.Parallel()
+	t.Run("redacted", func(t *testing.T) {
+		assert := assert.New(t)
+		const want = redactedKubeconfig
+		kc := Kubeconfig("magic secret")
+		assert.Equalf(want, kc.GoString(), "Kubeconfig.GoString() = %v, want %v", kc.GoString(), want)
+
+		// Verify gostringer is called
+		s := fmt.Sprintf("%#v", kc)
+		assert.Equalf(want, s, "Kubeconfig.GoString() = %v, want %v", s, want)
+	})
+}
+
+func TestKubeconfig_MarshalJSON(t *testing.T) {
+	t.Parallel()
+	t.Run("redacted", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		want, err := json.Marshal([]byte(redactedKubeconfig))
+		require.NoError(err)
+		kc := Kubeconfig("normal secret")
+		got, err := kc.MarshalJSON()
+		require.NoError(err)
+		assert.Equalf(want, got, "Kubeconfig.MarshalJSON() = %s, want %s", got, want)
+	})
+	t.Run("within-struct", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		want := fmt.Sprintf(`%s`, redactedKubeconfig)
+
+		type secretContainer struct {
+			S Kubeconfig
+			B []byte
+		}
+		testB := []byte("my secret")
+		secret := secretContainer{S: testB, B: testB}
+
+		m, err := json.Marshal(secret)
+		require.NoError(err)
+
+		var sec secretContainer
+		err = json.Unmarshal(m, &sec)
+		require.NoError(err)
+		assert.Equal(Kubeconfig(want), sec.S)
+		assert.Equal(testB, sec.B)
+	})
+}
+
 func TestJsonObject_String(t *testing.T) {
 	t.Parallel()
 	t.Run("redacted", func(t *testing.T) {
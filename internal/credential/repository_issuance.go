@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credential
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/pagination"
+	"github.com/hashicorp/boundary/internal/util"
+)
+
+// IssuanceRepository lists the credentials issued to sessions from credential
+// libraries and static credentials of all subtypes.
+type IssuanceRepository struct {
+	reader db.Reader
+	writer db.Writer
+}
+
+// NewIssuanceRepository returns a new credential issuance repository.
+func NewIssuanceRepository(ctx context.Context, reader db.Reader, writer db.Writer) (*IssuanceRepository, error) {
+	const op = "credential.NewIssuanceRepository"
+	switch {
+	case util.IsNil(reader):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing DB reader")
+	case util.IsNil(writer):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing DB writer")
+	}
+	return &IssuanceRepository{
+		reader: reader,
+		writer: writer,
+	}, nil
+}
+
+// List lists the issuances of the credential library or static credential
+// credentialId.
+func (s *IssuanceRepository) List(ctx context.Context, credentialId string, afterItem pagination.Item, limit int) ([]*Issuance, time.Time, error) {
+	const op = "credential.(*IssuanceRepository).List"
+	switch {
+	case credentialId == "":
+		return nil, time.Time{}, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	case limit < 1:
+		return nil, time.Time{}, errors.New(ctx, errors.InvalidParameter, op, "missing limit")
+	}
+
+	args := []any{sql.Named("credential_id", credentialId)}
+	query := fmt.Sprintf(listIssuancesTemplate, limit)
+	if afterItem != nil {
+		query = fmt.Sprintf(listIssuancesPageTemplate, limit)
+		args = append(args,
+			sql.Named("last_item_create_time", afterItem.GetCreateTime()),
+			sql.Named("last_item_id", afterItem.GetPublicId()),
+		)
+	}
+
+	return s.queryIssuances(ctx, query, args)
+}
+
+// ListRefresh lists the issuances of the credential library or static
+// credential credentialId updated after updatedAfter.
+func (s *IssuanceRepository) ListRefresh(ctx context.Context, credentialId string, updatedAfter time.Time, afterItem pagination.Item, limit int) ([]*Issuance, time.Time, error) {
+	const op = "credential.(*IssuanceRepository).ListRefresh"
+	switch {
+	case credentialId == "":
+		return nil, time.Time{}, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	case updatedAfter.IsZero():
+		return nil, time.Time{}, errors.New(ctx, errors.InvalidParameter, op, "missing updated after time")
+	case limit < 1:
+		return nil, time.Time{}, errors.New(ctx, errors.InvalidParameter, op, "missing limit")
+	}
+
+	args := []any{
+		sql.Named("credential_id", credentialId),
+		sql.Named("updated_after_time", timestamp.New(updatedAfter)),
+	}
+	query := fmt.Sprintf(listIssuancesRefreshTemplate, limit)
+	if afterItem != nil {
+		query = fmt.Sprintf(listIssuancesRefreshPageTemplate, limit)
+		args = append(args,
+			sql.Named("last_item_update_time", afterItem.GetUpdateTime()),
+			sql.Named("last_item_id", afterItem.GetPublicId()),
+		)
+	}
+
+	return s.queryIssuances(ctx, query, args)
+}
+
+// EstimatedCount returns the number of issuances of the credential library
+// or static credential credentialId. The count is exact since an estimate
+// from the table statistics would include the issuances of every credential.
+func (s *IssuanceRepository) EstimatedCount(ctx context.Context, credentialId string) (int, error) {
+	const op = "credential.(*IssuanceRepository).EstimatedCount"
+	if credentialId == "" {
+		return 0, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	}
+	rows, err := s.reader.Query(ctx, countIssuancesQuery, []any{sql.Named("credential_id", credentialId)})
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op, errors.WithMsg("failed to query total credential issuances"))
+	}
+	var count int
+	for rows.Next() {
+		if err := s.reader.ScanRows(ctx, rows, &count); err != nil {
+			return 0, errors.Wrap(ctx, err, op, errors.WithMsg("failed to query total credential issuances"))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, errors.Wrap(ctx, err, op, errors.WithMsg("failed to query total credential issuances"))
+	}
+	return count, nil
+}
+
+// ListDeletedIds lists the IDs of the credential issuances deleted since the
+// time specified. Issuances are only deleted with the credential library,
+// static credential or project they belong to, which can no longer be
+// listed, so it never returns any IDs. It only returns the timestamp of the
+// DB transaction.
+func (s *IssuanceRepository) ListDeletedIds(ctx context.Context, since time.Time) ([]string, time.Time, error) {
+	const op = "credential.(*IssuanceRepository).ListDeletedIds"
+	transactionTimestamp, err := s.reader.Now(ctx)
+	if err != nil {
+		return nil, time.Time{}, errors.Wrap(ctx, err, op)
+	}
+	return nil, transactionTimestamp, nil
+}
+
+func (s *IssuanceRepository) queryIssuances(ctx context.Context, query string, args []any) ([]*Issuance, time.Time, error) {
+	const op = "credential.(*IssuanceRepository).queryIssuances"
+
+	var issuances []*Issuance
+	var transactionTimestamp time.Time
+	if _, err := s.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(r db.Reader, w db.Writer) error {
+		rows, err := r.Query(ctx, query, args)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			if err := r.ScanRows(ctx, rows, &issuances); err != nil {
+				return err
+			}
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		transactionTimestamp, err = r.Now(ctx)
+		return err
+	}); err != nil {
+		return nil, time.Time{}, errors.Wrap(ctx, err, op)
+	}
+
+	return issuances, transactionTimestamp, nil
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credential_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static"
+	"github.com/hashicorp/boundary/internal/credential/vault"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssuanceRepository_List(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	composedOf := session.TestSessionParams(t, conn, wrapper, iamRepo)
+	staticStore := static.TestCredentialStore(t, conn, wrapper, composedOf.ProjectId)
+	cred := static.TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", staticStore.GetPublicId(), composedOf.ProjectId)
+	vaultStore := vault.TestCredentialStore(t, conn, wrapper, composedOf.ProjectId, "http://some-addr", "some-token", "some-accessor")
+	lib := vault.TestCredentialLibraries(t, conn, wrapper, vaultStore.GetPublicId(), 1)[0]
+
+	// Issue the static credential and the library to three sessions, newest
+	// last.
+	var sessions []*session.Session
+	for i := 0; i < 3; i++ {
+		c := composedOf
+		c.StaticCredentials = []*session.StaticCredential{session.NewStaticCredential(cred.GetPublicId(), credential.BrokeredPurpose)}
+		c.DynamicCredentials = []*session.DynamicCredential{session.NewDynamicCredential(lib.GetPublicId(), credential.InjectedApplicationPurpose)}
+		sessions = append(sessions, session.TestSession(t, conn, wrapper, c))
+	}
+
+	repo, err := credential.NewIssuanceRepository(ctx, rw, rw)
+	require.NoError(t, err)
+
+	t.Run("validation", func(t *testing.T) {
+		t.Parallel()
+		t.Run("missing credential id", func(t *testing.T) {
+			t.Parallel()
+			_, _, err := repo.List(ctx, "", nil, 1)
+			require.ErrorContains(t, err, "missing credential id")
+		})
+		t.Run("invalid limit", func(t *testing.T) {
+			t.Parallel()
+			_, _, err := repo.List(ctx, cred.GetPublicId(), nil, 0)
+			require.ErrorContains(t, err, "missing limit")
+		})
+		t.Run("missing updated after", func(t *testing.T) {
+			t.Parallel()
+			_, _, err := repo.ListRefresh(ctx, cred.GetPublicId(), time.Time{}, nil, 1)
+			require.ErrorContains(t, err, "missing updated after time")
+		})
+	})
+
+	t.Run("static credential", func(t *testing.T) {
+		t.Parallel()
+		assert, require := assert.New(t), require.New(t)
+		resp, ttime, err := repo.List(ctx, cred.GetPublicId(), nil, 10)
+		require.NoError(err)
+		assert.True(time.Now().Before(ttime.Add(10 * time.Second)))
+		assert.True(time.Now().After(ttime.Add(-10 * time.Second)))
+		require.Len(resp, 3)
+		for i, iss := range resp {
+			want := sessions[len(sessions)-1-i]
+			assert.NotEmpty(iss.GetPublicId())
+			assert.Equal(want.PublicId, iss.SessionId)
+			assert.Equal(want.UserId, iss.UserId)
+			assert.Equal(want.TargetId, iss.TargetId)
+			assert.Equal(want.ProjectId, iss.ProjectId)
+			assert.Empty(iss.CredentialLibraryId)
+			assert.Equal(cred.GetPublicId(), iss.CredentialId)
+			assert.Equal(cred.GetVersion(), iss.CredentialVersion)
+			assert.Equal(credential.BrokeredPurpose, iss.Purpose)
+			assert.NotNil(iss.GetCreateTime())
+		}
+	})
+
+	t.Run("credential library", func(t *testing.T) {
+		t.Parallel()
+		assert, require := assert.New(t), require.New(t)
+		resp, _, err := repo.List(ctx, lib.GetPublicId(), nil, 10)
+		require.NoError(err)
+		require.Len(resp, 3)
+		for i, iss := range resp {
+			assert.Equal(sessions[len(sessions)-1-i].PublicId, iss.SessionId)
+			assert.Equal(lib.GetPublicId(), iss.CredentialLibraryId)
+			assert.Empty(iss.CredentialId)
+			assert.Equal(credential.InjectedApplicationPurpose, iss.Purpose)
+		}
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		t.Parallel()
+		assert, require := assert.New(t), require.New(t)
+		page1, _, err := repo.List(ctx, cred.GetPublicId(), nil, 2)
+		require.NoError(err)
+		require.Len(page1, 2)
+		page2, _, err := repo.List(ctx, cred.GetPublicId(), page1[1], 2)
+		require.NoError(err)
+		require.Len(page2, 1)
+		assert.Equal(sessions[0].PublicId, page2[0].SessionId)
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		t.Parallel()
+		assert, require := assert.New(t), require.New(t)
+		all, _, err := repo.List(ctx, cred.GetPublicId(), nil, 10)
+		require.NoError(err)
+		require.Len(all, 3)
+		resp, _, err := repo.ListRefresh(ctx, cred.GetPublicId(), all[1].GetUpdateTime().AsTime(), nil, 10)
+		require.NoError(err)
+		require.Len(resp, 1)
+		assert.Equal(all[0].GetPublicId(), resp[0].GetPublicId())
+	})
+
+	t.Run("count", func(t *testing.T) {
+		t.Parallel()
+		assert, require := assert.New(t), require.New(t)
+		_, err := repo.EstimatedCount(ctx, "")
+		require.ErrorContains(err, "missing credential id")
+
+		// The issuances of other credentials are not counted.
+		other := static.TestUsernamePasswordCredential(t, conn, wrapper, "other", "pass", staticStore.GetPublicId(), composedOf.ProjectId)
+		c := composedOf
+		c.StaticCredentials = []*session.StaticCredential{session.NewStaticCredential(other.GetPublicId(), credential.BrokeredPurpose)}
+		_ = session.TestSession(t, conn, wrapper, c)
+
+		count, err := repo.EstimatedCount(ctx, cred.GetPublicId())
+		require.NoError(err)
+		assert.Equal(3, count)
+		count, err = repo.EstimatedCount(ctx, lib.GetPublicId())
+		require.NoError(err)
+		assert.Equal(3, count)
+		count, err = repo.EstimatedCount(ctx, other.GetPublicId())
+		require.NoError(err)
+		assert.Equal(1, count)
+	})
+}
+
+func TestIssuanceRepository_RefreshDynamicCredential(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	composedOf := session.TestSessionParams(t, conn, wrapper, iamRepo)
+	vaultStore := vault.TestCredentialStore(t, conn, wrapper, composedOf.ProjectId, "http://some-addr", "some-token", "some-accessor")
+	lib := vault.TestCredentialLibraries(t, conn, wrapper, vaultStore.GetPublicId(), 1)[0]
+	var sessions []*session.Session
+	for i := 0; i < 2; i++ {
+		c := composedOf
+		c.DynamicCredentials = []*session.DynamicCredential{session.NewDynamicCredential(lib.GetPublicId(), credential.BrokeredPurpose)}
+		sessions = append(sessions, session.TestSession(t, conn, wrapper, c))
+	}
+
+	repo, err := credential.NewIssuanceRepository(ctx, rw, rw)
+	require.NoError(err)
+	before, err := rw.Now(ctx)
+	require.NoError(err)
+	resp, _, err := repo.ListRefresh(ctx, lib.GetPublicId(), before, nil, 10)
+	require.NoError(err)
+	assert.Empty(resp)
+
+	// Issuing the dynamic credential of the older session updates its
+	// issuance, which is then listed by a refresh.
+	cred := vault.TestCredentials(t, conn, wrapper, lib.GetPublicId(), sessions[0].PublicId, 1)[0]
+	_, err = rw.Exec(ctx, "update session_credential_dynamic set credential_id = ? where session_id = ? and library_id = ?",
+		[]any{cred.GetPublicId(), sessions[0].PublicId, lib.GetPublicId()})
+	require.NoError(err)
+
+	resp, _, err = repo.ListRefresh(ctx, lib.GetPublicId(), before, nil, 10)
+	require.NoError(err)
+	require.Len(resp, 1)
+	assert.Equal(sessions[0].PublicId, resp[0].SessionId)
+	assert.Equal(cred.GetPublicId(), resp[0].CredentialId)
+	assert.True(resp[0].GetUpdateTime().AsTime().After(resp[0].GetCreateTime().AsTime()))
+}
+
+func TestIssuanceRepository_SessionDeleted(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	composedOf := session.TestSessionParams(t, conn, wrapper, iamRepo)
+	staticStore := static.TestCredentialStore(t, conn, wrapper, composedOf.ProjectId)
+	cred := static.TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", staticStore.GetPublicId(), composedOf.ProjectId)
+	composedOf.StaticCredentials = []*session.StaticCredential{session.NewStaticCredential(cred.GetPublicId(), credential.BrokeredPurpose)}
+	sess := session.TestSession(t, conn, wrapper, composedOf)
+
+	repo, err := credential.NewIssuanceRepository(ctx, rw, rw)
+	require.NoError(err)
+
+	// The issuance is kept after the session is deleted
+	_, err = rw.Exec(ctx, "delete from session where public_id = ?", []any{sess.PublicId})
+	require.NoError(err)
+	resp, _, err := repo.List(ctx, cred.GetPublicId(), nil, 10)
+	require.NoError(err)
+	require.Len(resp, 1)
+	assert.Equal(sess.PublicId, resp[0].SessionId)
+
+	deletedIds, _, err := repo.ListDeletedIds(ctx, time.Now().Add(-time.Hour))
+	require.NoError(err)
+	assert.Empty(deletedIds)
+
+	// The issuance is deleted with the credential
+	staticRepo, err := static.NewRepository(ctx, rw, rw, kms.TestKms(t, conn, wrapper))
+	require.NoError(err)
+	_, err = staticRepo.DeleteCredential(ctx, composedOf.ProjectId, cred.GetPublicId())
+	require.NoError(err)
+	resp, _, err = repo.List(ctx, cred.GetPublicId(), nil, 10)
+	require.NoError(err)
+	assert.Empty(resp)
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credential
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/pagination"
+)
+
+// ListIssuances lists up to page size issuances of the credential library or
+// static credential credentialId, filtering out entries that do not pass the
+// filter item function. It will automatically request more issuances from the
+// database, at page size chunks, to fill the page.
+// It returns a new list token used to continue pagination or refresh items.
+// Issuances are ordered by create time descending (most recently issued first).
+func ListIssuances(
+	ctx context.Context,
+	grantsHash []byte,
+	pageSize int,
+	filterItemFn pagination.ListFilterFunc[*Issuance],
+	repo *IssuanceRepository,
+	credentialId string,
+) (*pagination.ListResponse[*Issuance], error) {
+	const op = "credential.ListIssuances"
+
+	switch {
+	case len(grantsHash) == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing grants hash")
+	case pageSize < 1:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "page size must be at least 1")
+	case filterItemFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing filter item callback")
+	case credentialId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	case repo == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing repo")
+	}
+
+	listItemsFn := func(ctx context.Context, lastPageItem *Issuance, limit int) ([]*Issuance, time.Time, error) {
+		// A nil *Issuance must not be passed as a non-nil pagination.Item.
+		if lastPageItem != nil {
+			return repo.List(ctx, credentialId, lastPageItem, limit)
+		}
+		return repo.List(ctx, credentialId, nil, limit)
+	}
+
+	estimatedCountFn := func(ctx context.Context) (int, error) {
+		return repo.EstimatedCount(ctx, credentialId)
+	}
+
+	return pagination.List(ctx, grantsHash, pageSize, filterItemFn, listItemsFn, estimatedCountFn)
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credential
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/listtoken"
+	"github.com/hashicorp/boundary/internal/pagination"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+// ListIssuancesPage lists up to page size issuances of the credential library
+// or static credential credentialId, filtering out entries that do not pass
+// the filter item function. It will automatically request more issuances from
+// the database, at page size chunks, to fill the page.
+// It will start its paging based on the information in the token.
+// It returns a new list token used to continue pagination or refresh items.
+// Issuances are ordered by create time descending (most recently issued first).
+func ListIssuancesPage(
+	ctx context.Context,
+	grantsHash []byte,
+	pageSize int,
+	filterItemFn pagination.ListFilterFunc[*Issuance],
+	tok *listtoken.Token,
+	repo *IssuanceRepository,
+	credentialId string,
+) (*pagination.ListResponse[*Issuance], error) {
+	const op = "credential.ListIssuancesPage"
+
+	switch {
+	case len(grantsHash) == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing grants hash")
+	case pageSize < 1:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "page size must be at least 1")
+	case filterItemFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing filter item callback")
+	case tok == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing token")
+	case credentialId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	case repo == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing repo")
+	case tok.ResourceType != resource.Session:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "token did not have a session resource type")
+	}
+	if _, ok := tok.Subtype.(*listtoken.PaginationToken); !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "token did not have a pagination token component")
+	}
+
+	listItemsFn := func(ctx context.Context, lastPageItem *Issuance, limit int) ([]*Issuance, time.Time, error) {
+		if lastPageItem != nil {
+			return repo.List(ctx, credentialId, lastPageItem, limit)
+		}
+		lastItem, err := tok.LastItem(ctx)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		return repo.List(ctx, credentialId, lastItem, limit)
+	}
+
+	estimatedCountFn := func(ctx context.Context) (int, error) {
+		return repo.EstimatedCount(ctx, credentialId)
+	}
+
+	return pagination.ListPage(ctx, grantsHash, pageSize, filterItemFn, listItemsFn, estimatedCountFn, tok)
+}
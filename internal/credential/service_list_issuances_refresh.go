@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credential
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/listtoken"
+	"github.com/hashicorp/boundary/internal/pagination"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+// ListIssuancesRefresh lists up to page size issuances of the credential
+// library or static credential credentialId, filtering out entries that do
+// not pass the filter item function. It will automatically request more
+// issuances from the database, at page size chunks, to fill the page.
+// It will start its paging based on the information in the token.
+// It returns a new list token used to continue pagination or refresh items.
+// Issuances are ordered by create time descending (most recently issued first)
+// and only issuances created since the start of the initial pagination phase
+// are returned.
+func ListIssuancesRefresh(
+	ctx context.Context,
+	grantsHash []byte,
+	pageSize int,
+	filterItemFn pagination.ListFilterFunc[*Issuance],
+	tok *listtoken.Token,
+	repo *IssuanceRepository,
+	credentialId string,
+) (*pagination.ListResponse[*Issuance], error) {
+	const op = "credential.ListIssuancesRefresh"
+
+	switch {
+	case len(grantsHash) == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing grants hash")
+	case pageSize < 1:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "page size must be at least 1")
+	case filterItemFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing filter item callback")
+	case tok == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing token")
+	case credentialId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	case repo == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing repo")
+	case tok.ResourceType != resource.Session:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "token did not have a session resource type")
+	}
+	rt, ok := tok.Subtype.(*listtoken.StartRefreshToken)
+	if !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "token did not have a start-refresh token component")
+	}
+
+	listItemsFn := func(ctx context.Context, lastPageItem *Issuance, limit int) ([]*Issuance, time.Time, error) {
+		// Add the database read timeout to account for any creations missed due to concurrent
+		// transactions in the initial pagination phase.
+		since := rt.PreviousPhaseUpperBound.Add(-globals.RefreshReadLookbackDuration)
+		// A nil *Issuance must not be passed as a non-nil pagination.Item.
+		if lastPageItem != nil {
+			return repo.ListRefresh(ctx, credentialId, since, lastPageItem, limit)
+		}
+		return repo.ListRefresh(ctx, credentialId, since, nil, limit)
+	}
+	listDeletedIDsFn := func(ctx context.Context, since time.Time) ([]string, time.Time, error) {
+		// Add the database read timeout to account for any deletions missed due to concurrent
+		// transactions in previous requests.
+		return repo.ListDeletedIds(ctx, since.Add(-globals.RefreshReadLookbackDuration))
+	}
+
+	estimatedCountFn := func(ctx context.Context) (int, error) {
+		return repo.EstimatedCount(ctx, credentialId)
+	}
+
+	return pagination.ListRefresh(ctx, grantsHash, pageSize, filterItemFn, listItemsFn, estimatedCountFn, listDeletedIDsFn, tok)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credential
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/listtoken"
+	"github.com/hashicorp/boundary/internal/pagination"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+// ListIssuancesRefreshPage lists up to page size issuances of the credential
+// library or static credential credentialId, filtering out entries that do
+// not pass the filter item function. It will automatically request more
+// issuances from the database, at page size chunks, to fill the page.
+// It will start its paging based on the information in the token.
+// It returns a new list token used to continue pagination or refresh items.
+// Issuances are ordered by create time descending (most recently issued first)
+// and only issuances created since the start of the refresh phase are
+// returned.
+func ListIssuancesRefreshPage(
+	ctx context.Context,
+	grantsHash []byte,
+	pageSize int,
+	filterItemFn pagination.ListFilterFunc[*Issuance],
+	tok *listtoken.Token,
+	repo *IssuanceRepository,
+	credentialId string,
+) (*pagination.ListResponse[*Issuance], error) {
+	const op = "credential.ListIssuancesRefreshPage"
+
+	switch {
+	case len(grantsHash) == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing grants hash")
+	case pageSize < 1:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "page size must be at least 1")
+	case filterItemFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing filter item callback")
+	case tok == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing token")
+	case credentialId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	case repo == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing repo")
+	case tok.ResourceType != resource.Session:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "token did not have a session resource type")
+	}
+	rt, ok := tok.Subtype.(*listtoken.RefreshToken)
+	if !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "token did not have a refresh token component")
+	}
+
+	listItemsFn := func(ctx context.Context, lastPageItem *Issuance, limit int) ([]*Issuance, time.Time, error) {
+		// Add the database read timeout to account for any creations missed due to concurrent
+		// transactions in the initial pagination phase.
+		since := rt.PhaseLowerBound.Add(-globals.RefreshReadLookbackDuration)
+		if lastPageItem != nil {
+			return repo.ListRefresh(ctx, credentialId, since, lastPageItem, limit)
+		}
+		lastItem, err := tok.LastItem(ctx)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		return repo.ListRefresh(ctx, credentialId, since, lastItem, limit)
+	}
+	listDeletedIDsFn := func(ctx context.Context, since time.Time) ([]string, time.Time, error) {
+		// Add the database read timeout to account for any deletes missed due to concurrent
+		// transactions in the original list pagination phase.
+		return repo.ListDeletedIds(ctx, since.Add(-globals.RefreshReadLookbackDuration))
+	}
+
+	estimatedCountFn := func(ctx context.Context) (int, error) {
+		return repo.EstimatedCount(ctx, credentialId)
+	}
+
+	return pagination.ListRefreshPage(ctx, grantsHash, pageSize, filterItemFn, listItemsFn, estimatedCountFn, listDeletedIDsFn, tok)
+}
@@ -17,14 +17,26 @@ type CredentialStore struct {
 }
 
 // NewCredentialStore creates a new in memory static CredentialStore assigned to projectId.
-// Name and description are the only valid options. All other options are ignored.
+// WithName, WithDescription, WithRotationPeriod, WithRotationGracePeriod,
+// WithRotator, WithExclusiveCheckout and WithRotateOnCheckin are the only
+// valid options. All other options are ignored.
+//
+// The credentials in the store are rotated periodically only if
+// WithRotationPeriod is greater than zero and on check in only if
+// WithRotateOnCheckin is true. In both cases WithRotator must also be
+// provided.
 func NewCredentialStore(projectId string, opt ...Option) (*CredentialStore, error) {
 	opts := getOpts(opt...)
 	cs := &CredentialStore{
 		CredentialStore: &store.CredentialStore{
-			ProjectId:   projectId,
-			Name:        opts.withName,
-			Description: opts.withDescription,
+			ProjectId:           projectId,
+			Name:                opts.withName,
+			Description:         opts.withDescription,
+			RotationPeriod:      opts.withRotationPeriod,
+			RotationGracePeriod: opts.withRotationGracePeriod,
+			Rotator:             opts.withRotator,
+			ExclusiveCheckout:   opts.withExclusiveCheckout,
+			RotateOnCheckin:     opts.withRotateOnCheckin,
 		},
 	}
 	return cs, nil
@@ -81,6 +81,78 @@ func TestCredentialStore_New(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid-with-rotation-policy",
+			args: args{
+				projectId: prj.PublicId,
+				opts: []Option{
+					WithRotationPeriod(3600),
+					WithRotationGracePeriod(60),
+					WithRotator("ssh-hosts"),
+				},
+			},
+			want: &CredentialStore{
+				CredentialStore: &store.CredentialStore{
+					ProjectId:           prj.PublicId,
+					RotationPeriod:      3600,
+					RotationGracePeriod: 60,
+					Rotator:             "ssh-hosts",
+				},
+			},
+		},
+		{
+			name: "rotation-period-without-rotator",
+			args: args{
+				projectId: prj.PublicId,
+				opts: []Option{
+					WithRotationPeriod(3600),
+				},
+			},
+			want: &CredentialStore{
+				CredentialStore: &store.CredentialStore{
+					ProjectId:      prj.PublicId,
+					RotationPeriod: 3600,
+				},
+			},
+			wantCreateErr: true,
+		},
+		{
+			name: "valid-with-exclusive-checkout",
+			args: args{
+				projectId: prj.PublicId,
+				opts: []Option{
+					WithRotator("ssh-hosts"),
+					WithExclusiveCheckout(true),
+					WithRotateOnCheckin(true),
+				},
+			},
+			want: &CredentialStore{
+				CredentialStore: &store.CredentialStore{
+					ProjectId:         prj.PublicId,
+					Rotator:           "ssh-hosts",
+					ExclusiveCheckout: true,
+					RotateOnCheckin:   true,
+				},
+			},
+		},
+		{
+			name: "rotate-on-checkin-without-rotator",
+			args: args{
+				projectId: prj.PublicId,
+				opts: []Option{
+					WithExclusiveCheckout(true),
+					WithRotateOnCheckin(true),
+				},
+			},
+			want: &CredentialStore{
+				CredentialStore: &store.CredentialStore{
+					ProjectId:         prj.PublicId,
+					ExclusiveCheckout: true,
+					RotateOnCheckin:   true,
+				},
+			},
+			wantCreateErr: true,
+		},
 	}
 
 	for _, tt := range tests {
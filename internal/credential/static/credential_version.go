@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/credential/static/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+// A CredentialVersion is a snapshot of the attributes of a static credential
+// taken each time the credential is created or updated. The attributes,
+// including the secret, are encrypted with the database key of the project
+// the credential belongs to.
+type CredentialVersion struct {
+	*store.CredentialVersion
+	tableName string `gorm:"-"`
+}
+
+func allocCredentialVersion() *CredentialVersion {
+	return &CredentialVersion{
+		CredentialVersion: &store.CredentialVersion{},
+	}
+}
+
+func (v *CredentialVersion) clone() *CredentialVersion {
+	cp := proto.Clone(v.CredentialVersion)
+	return &CredentialVersion{
+		CredentialVersion: cp.(*store.CredentialVersion),
+	}
+}
+
+// TableName returns the table name.
+func (v *CredentialVersion) TableName() string {
+	if v.tableName != "" {
+		return v.tableName
+	}
+	return "credential_static_version"
+}
+
+// SetTableName sets the table name.
+func (v *CredentialVersion) SetTableName(n string) {
+	v.tableName = n
+}
+
+func (v *CredentialVersion) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(CredentialVersion).encrypt"
+	if len(v.Data) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "no data defined")
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, v.CredentialVersion, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	keyId, err := cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("error reading cipher key id"))
+	}
+	v.KeyId = keyId
+	return nil
+}
+
+func (v *CredentialVersion) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(CredentialVersion).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, v.CredentialVersion, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+// versionData returns the marshaled attributes of c which are recorded in a
+// CredentialVersion. The secret fields of c must have been decrypted.
+func versionData(ctx context.Context, c any) ([]byte, error) {
+	const op = "static.versionData"
+	var m proto.Message
+	switch c := c.(type) {
+	case *UsernamePasswordCredential:
+		m = &store.UsernamePasswordCredential{
+			Username: c.Username,
+			Password: c.Password,
+		}
+	case *SshPrivateKeyCredential:
+		m = &store.SshPrivateKeyCredential{
+			Username:             c.Username,
+			PrivateKey:           c.PrivateKey,
+			PrivateKeyPassphrase: c.PrivateKeyPassphrase,
+		}
+	case *JsonCredential:
+		m = &store.JsonCredential{
+			Object: c.Object,
+		}
+	case *TlsClientCertificateCredential:
+		m = &store.TlsClientCertificateCredential{
+			Certificate:   c.Certificate,
+			CaCertificate: c.CaCertificate,
+			PrivateKey:    c.PrivateKey,
+		}
+	case *KubeconfigCredential:
+		m = &store.KubeconfigCredential{
+			Kubeconfig: c.Kubeconfig,
+		}
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential type %T", c))
+	}
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encode))
+	}
+	return data, nil
+}
+
+// versionableCredential is a static credential with encrypted attributes
+// which can be recorded in a CredentialVersion.
+type versionableCredential interface {
+	db.ResourcePublicIder
+	GetVersion() uint32
+	decrypt(context.Context, wrapping.Wrapper) error
+}
+
+// insertCredentialVersion reads the current state of c from the database
+// and inserts it as a new CredentialVersion of c authored by authorId. c
+// must have been allocated with only its PublicId set. It must be called
+// within the transaction which created or updated c.
+func insertCredentialVersion(ctx context.Context, r db.Reader, w db.Writer, cipher wrapping.Wrapper, c versionableCredential, authorId string) error {
+	const op = "static.insertCredentialVersion"
+	if err := r.LookupByPublicId(ctx, c); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed to read credential %s", c.GetPublicId())))
+	}
+	if err := c.decrypt(ctx, cipher); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	data, err := versionData(ctx, c)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	v := allocCredentialVersion()
+	v.CredentialId = c.GetPublicId()
+	v.Version = c.GetVersion()
+	v.AuthorId = authorId
+	v.Data = data
+	if err := v.encrypt(ctx, cipher); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err := w.Create(ctx, v); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed to insert version %d of credential %s", v.Version, v.CredentialId)))
+	}
+	return nil
+}
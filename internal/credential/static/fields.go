@@ -12,4 +12,12 @@ const (
 	privateKeyField           = "PrivateKey"
 	PrivateKeyPassphraseField = "PrivateKeyPassphrase"
 	objectField               = "Object"
+	certificateField          = "Certificate"
+	caCertificateField        = "CaCertificate"
+	kubeconfigField           = "Kubeconfig"
+	RotationPeriodField       = "RotationPeriod"
+	RotationGracePeriodField  = "RotationGracePeriod"
+	RotatorField              = "Rotator"
+	ExclusiveCheckoutField    = "ExclusiveCheckout"
+	RotateOnCheckinField      = "RotateOnCheckin"
 )
@@ -0,0 +1,359 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	ua "go.uber.org/atomic"
+)
+
+const (
+	credentialRotationJobName = "static_credential_rotation"
+
+	defaultRotationNextRunIn = 5 * time.Minute
+)
+
+// RegisterJobs registers the static credential jobs with the scheduler.
+// rotators maps the rotator names that credential stores can reference to
+// the Rotator used to push rotated credentials to target hosts.
+func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Reader, w db.Writer, kms *kms.Kms, rotators map[string]Rotator) error {
+	const op = "static.RegisterJobs"
+	rotation, err := newCredentialRotationJob(ctx, r, w, kms, rotators)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err = scheduler.RegisterJob(ctx, rotation); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("credential rotation job"))
+	}
+	return nil
+}
+
+// CredentialRotationJob is the recurring job that rotates the username
+// password and ssh private key credentials of static credential stores with
+// a rotation policy. The new version of a credential is pushed to the target
+// host by the store's Rotator before it is saved. The previous version is
+// retired by the Rotator once the store's grace period has ended.
+// Credentials checked out by an active session are not rotated until they
+// are checked in. The CredentialRotationJob is not thread safe, an attempt to Run the job
+// concurrently will result in an JobAlreadyRunning error.
+type CredentialRotationJob struct {
+	reader   db.Reader
+	writer   db.Writer
+	repo     *Repository
+	rotators map[string]Rotator
+	limit    int
+
+	running      ua.Bool
+	numCreds     int
+	numProcessed int
+}
+
+// newCredentialRotationJob creates a new in-memory CredentialRotationJob.
+//
+// WithLimit is the only supported option.
+func newCredentialRotationJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, rotators map[string]Rotator, opt ...Option) (*CredentialRotationJob, error) {
+	const op = "static.newCredentialRotationJob"
+	switch {
+	case r == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Reader")
+	case w == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Writer")
+	case kms == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+
+	repo, err := NewRepository(ctx, r, w, kms)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+	return &CredentialRotationJob{
+		reader:   r,
+		writer:   w,
+		repo:     repo,
+		rotators: rotators,
+		limit:    opts.withLimit,
+	}, nil
+}
+
+type dueRotation struct {
+	PublicId            string
+	StoreId             string
+	Type                string
+	ProjectId           string
+	Rotator             string
+	RotationGracePeriod uint32
+}
+
+type dueRetirement struct {
+	PublicId          string
+	StoreId           string
+	Type              string
+	Username          string
+	Rotator           string
+	PreviousPublicKey sql.NullString
+}
+
+// Status returns the current status of the credential rotation job. Total
+// is the number of credentials to rotate or retire. Completed is the number
+// of credentials already processed.
+func (r *CredentialRotationJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: r.numProcessed,
+		Total:     r.numCreds,
+	}
+}
+
+// Run rotates the credentials whose rotation period has elapsed or whose
+// rotation was requested on check in and retires the previous versions of credentials whose grace period has ended.
+// Failures are reported as error events and do not stop the job. Can not be
+// run in parallel, if Run is invoked while already running an error with
+// code JobAlreadyRunning will be returned.
+func (r *CredentialRotationJob) Run(ctx context.Context) error {
+	const op = "static.(CredentialRotationJob).Run"
+	if !r.running.CompareAndSwap(r.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer r.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	var due []*dueRotation
+	if err := r.query(ctx, credStaticRotationDueQuery, &due); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	// Set numProcessed and numCreds for status report
+	r.numProcessed, r.numCreds = 0, len(due)
+
+	for _, d := range due {
+		// Verify context is not done before rotating next credential
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := r.rotate(ctx, d); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error rotating credential", "credential id", d.PublicId, "credential store id", d.StoreId, "rotator", d.Rotator))
+		}
+		r.numProcessed++
+	}
+
+	// Retirements are queried after the rotations so a zero grace period
+	// retires the previous version in the same run.
+	var retire []*dueRetirement
+	if err := r.query(ctx, credStaticRotationRetireQuery, &retire); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	r.numCreds += len(retire)
+
+	for _, d := range retire {
+		// Verify context is not done before retiring next credential
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := r.retire(ctx, d); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error retiring previous credential version", "credential id", d.PublicId, "credential store id", d.StoreId, "rotator", d.Rotator))
+		}
+		r.numProcessed++
+	}
+
+	return nil
+}
+
+func (r *CredentialRotationJob) query(ctx context.Context, query string, results any) error {
+	const op = "static.(CredentialRotationJob).query"
+	rows, err := r.reader.Query(ctx, query, []any{r.limit})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := r.reader.ScanRows(ctx, rows, results); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+func (r *CredentialRotationJob) rotator(ctx context.Context, name string) (Rotator, error) {
+	const op = "static.(CredentialRotationJob).rotator"
+	rotator, ok := r.rotators[name]
+	if !ok || rotator == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("rotator %q is not configured on this controller", name))
+	}
+	return rotator, nil
+}
+
+// rotate generates a new version of the credential, pushes it to the target
+// host and saves it. The new version is only saved after the rotator
+// succeeds. The rotator keeps the current version valid so a failure to
+// save leaves the credential usable.
+func (r *CredentialRotationJob) rotate(ctx context.Context, d *dueRotation) error {
+	const op = "static.(CredentialRotationJob).rotate"
+	rotator, err := r.rotator(ctx, d.Rotator)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	creds, err := r.repo.Retrieve(ctx, d.ProjectId, []string{d.PublicId})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	var previousPublicKey *string
+	switch c := creds[0].(type) {
+	case *UsernamePasswordCredential:
+		password, err := generatePassword(ctx)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := rotator.Rotate(ctx, &RotateRequest{
+			CredentialId:    c.PublicId,
+			StoreId:         c.StoreId,
+			CredentialType:  globals.UsernamePasswordCredentialType,
+			Username:        c.Username,
+			CurrentPassword: string(c.Password),
+			NewPassword:     password,
+		}); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		c.Password = []byte(password)
+		if _, _, err := r.repo.UpdateUsernamePasswordCredential(ctx, d.ProjectId, c, c.Version, []string{passwordField}); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+
+	case *SshPrivateKeyCredential:
+		privateKey, publicKey, err := generateSshKeyPair(ctx, c.PrivateKeyPassphrase)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		currentPublicKey := sshPublicKey(c.PrivateKey, c.PrivateKeyPassphrase)
+		if err := rotator.Rotate(ctx, &RotateRequest{
+			CredentialId:     c.PublicId,
+			StoreId:          c.StoreId,
+			CredentialType:   globals.SshPrivateKeyCredentialType,
+			Username:         c.Username,
+			CurrentPublicKey: currentPublicKey,
+			NewPublicKey:     publicKey,
+		}); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		c.PrivateKey = credential.PrivateKey(privateKey)
+		if _, _, err := r.repo.UpdateSshPrivateKeyCredential(ctx, d.ProjectId, c, c.Version, []string{privateKeyField}); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if currentPublicKey != "" {
+			previousPublicKey = &currentPublicKey
+		}
+
+	default:
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential type %T", c))
+	}
+
+	if _, err := r.writer.Exec(ctx, upsertCredStaticRotationQuery, []any{
+		sql.Named("credential_id", d.PublicId),
+		sql.Named("grace_period", d.RotationGracePeriod),
+		sql.Named("previous_public_key", previousPublicKey),
+	}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("credential rotated but failed to update rotation state"))
+	}
+	event.WriteSysEvent(ctx, op, "static credential rotated", "credential id", d.PublicId, "credential store id", d.StoreId, "rotator", d.Rotator)
+	return nil
+}
+
+// retire asks the rotator to invalidate the previous version of the
+// credential and clears the grace period of the credential.
+func (r *CredentialRotationJob) retire(ctx context.Context, d *dueRetirement) error {
+	const op = "static.(CredentialRotationJob).retire"
+	rotator, err := r.rotator(ctx, d.Rotator)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	req := &RetireRequest{
+		CredentialId:      d.PublicId,
+		StoreId:           d.StoreId,
+		Username:          d.Username,
+		PreviousPublicKey: d.PreviousPublicKey.String,
+	}
+	switch d.Type {
+	case "upw":
+		req.CredentialType = globals.UsernamePasswordCredentialType
+	case "ssh":
+		req.CredentialType = globals.SshPrivateKeyCredentialType
+	}
+	if err := rotator.Retire(ctx, req); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	numRows, err := r.writer.Exec(ctx, retireCredStaticRotationQuery, []any{d.PublicId})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if numRows != 1 {
+		return errors.New(ctx, errors.Unknown, op, "previous version retired but failed to update rotation state")
+	}
+	return nil
+}
+
+// NextRunIn queries the static credential repo to determine when the next
+// credential rotation or retirement is due.
+func (r *CredentialRotationJob) NextRunIn(ctx context.Context) (time.Duration, error) {
+	const op = "static.(CredentialRotationJob).NextRunIn"
+	rows, err := r.reader.Query(ctx, credStaticRotationNextRunInQuery, nil)
+	if err != nil {
+		return defaultRotationNextRunIn, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		type NextRotation struct {
+			RotateIn time.Duration
+		}
+		var n NextRotation
+		if err := r.reader.ScanRows(ctx, rows, &n); err != nil {
+			return defaultRotationNextRunIn, errors.Wrap(ctx, err, op)
+		}
+		if n.RotateIn < 0 {
+			// If we are past the next rotation time, return 0 to schedule immediately
+			return 0, nil
+		}
+		if next := n.RotateIn * time.Second; next < defaultRotationNextRunIn {
+			return next, nil
+		}
+		return defaultRotationNextRunIn, nil
+	}
+	if err := rows.Err(); err != nil {
+		return defaultRotationNextRunIn, errors.Wrap(ctx, err, op)
+	}
+
+	return defaultRotationNextRunIn, nil
+}
+
+// Name is the unique name of the job.
+func (r *CredentialRotationJob) Name() string {
+	return credentialRotationJobName
+}
+
+// Description is the human readable description of the job.
+func (r *CredentialRotationJob) Description() string {
+	return "Periodically rotates static credentials of credential stores with a rotation policy and retires their previous versions after the grace period."
+}
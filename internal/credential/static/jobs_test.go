@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRotator struct {
+	sync.Mutex
+	rotated []*RotateRequest
+	retired []*RetireRequest
+	err     error
+}
+
+func (r *testRotator) Rotate(_ context.Context, req *RotateRequest) error {
+	r.Lock()
+	defer r.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	r.rotated = append(r.rotated, req)
+	return nil
+}
+
+func (r *testRotator) Retire(_ context.Context, req *RetireRequest) error {
+	r.Lock()
+	defer r.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	r.retired = append(r.retired, req)
+	return nil
+}
+
+func TestNewCredentialRotationJob(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+
+	tests := []struct {
+		name string
+		r    db.Reader
+		w    db.Writer
+		kms  *kms.Kms
+	}{
+		{
+			name: "nil-reader",
+			w:    rw,
+			kms:  kmsCache,
+		},
+		{
+			name: "nil-writer",
+			r:    rw,
+			kms:  kmsCache,
+		},
+		{
+			name: "nil-kms",
+			r:    rw,
+			w:    rw,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			got, err := newCredentialRotationJob(ctx, tt.r, tt.w, tt.kms, nil)
+			assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+			assert.Nil(got)
+		})
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := newCredentialRotationJob(ctx, rw, rw, kmsCache, nil, WithLimit(10))
+		require.NoError(err)
+		require.NotNil(got)
+		assert.Equal(10, got.limit)
+		assert.Equal(credentialRotationJobName, got.Name())
+		assert.NotEmpty(got.Description())
+	})
+}
+
+func TestCredentialRotationJob_Run(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	rotator := &testRotator{}
+	job, err := newCredentialRotationJob(ctx, rw, rw, kmsCache, map[string]Rotator{"test": rotator})
+	require.NoError(t, err)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	cs := TestCredentialStore(t, conn, wrapper, prj.PublicId, WithRotationPeriod(60), WithRotationGracePeriod(3600), WithRotator("test"))
+	upCred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "password", cs.PublicId, prj.PublicId)
+	spkCred := TestSshPrivateKeyCredential(t, conn, wrapper, "user", TestSshPrivateKeyPem, cs.PublicId, prj.PublicId)
+	unrotated := TestCredentialStore(t, conn, wrapper, prj.PublicId)
+	TestUsernamePasswordCredential(t, conn, wrapper, "user", "password", unrotated.PublicId, prj.PublicId)
+
+	// Nothing is due yet
+	require.NoError(t, job.Run(ctx))
+	assert.Empty(t, rotator.rotated)
+	assert.Equal(t, 0, job.Status().Total)
+
+	// Make both credentials due for rotation
+	for _, id := range []string{upCred.PublicId, spkCred.PublicId} {
+		_, err := rw.Exec(ctx, `insert into credential_static_rotation (credential_id, rotate_time) values (?, now() - interval '1 hour')`, []any{id})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, job.Run(ctx))
+	require.Len(t, rotator.rotated, 2)
+	assert.Empty(t, rotator.retired)
+	assert.Equal(t, 2, job.Status().Total)
+	assert.Equal(t, 2, job.Status().Completed)
+
+	creds, err := repo.Retrieve(ctx, prj.PublicId, []string{upCred.PublicId, spkCred.PublicId})
+	require.NoError(t, err)
+	for _, c := range creds {
+		switch c := c.(type) {
+		case *UsernamePasswordCredential:
+			assert.NotEqual(t, []byte("password"), c.Password)
+		case *SshPrivateKeyCredential:
+			assert.NotEqual(t, []byte(TestSshPrivateKeyPem), c.PrivateKey)
+		}
+	}
+
+	// Rotated credentials are not due again during the grace period
+	require.NoError(t, job.Run(ctx))
+	assert.Len(t, rotator.rotated, 2)
+	assert.Empty(t, rotator.retired)
+
+	// End the grace period
+	_, err = rw.Exec(ctx, `update credential_static_rotation set grace_period_end = now() - interval '1 second'`, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, job.Run(ctx))
+	require.Len(t, rotator.retired, 2)
+	for _, r := range rotator.retired {
+		if r.CredentialId == spkCred.PublicId {
+			assert.Equal(t, sshPublicKey([]byte(TestSshPrivateKeyPem), nil), r.PreviousPublicKey)
+		} else {
+			assert.Empty(t, r.PreviousPublicKey)
+		}
+	}
+
+	var count int
+	rows, err := rw.Query(ctx, `select count(*) from credential_static_rotation where grace_period_end is not null`, nil)
+	require.NoError(t, err)
+	defer rows.Close()
+	require.True(t, rows.Next())
+	require.NoError(t, rows.Scan(&count))
+	assert.Equal(t, 0, count)
+}
+
+func TestCredentialRotationJob_RunRotatorFailure(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	rotator := &testRotator{err: fmt.Errorf("host unreachable")}
+	job, err := newCredentialRotationJob(ctx, rw, rw, kmsCache, map[string]Rotator{"test": rotator})
+	require.NoError(t, err)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	cs := TestCredentialStore(t, conn, wrapper, prj.PublicId, WithRotationPeriod(60), WithRotator("test"))
+	upCred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "password", cs.PublicId, prj.PublicId)
+	missing := TestCredentialStore(t, conn, wrapper, prj.PublicId, WithRotationPeriod(60), WithRotator("missing"))
+	missingCred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "password", missing.PublicId, prj.PublicId)
+	for _, id := range []string{upCred.PublicId, missingCred.PublicId} {
+		_, err := rw.Exec(ctx, `insert into credential_static_rotation (credential_id, rotate_time) values (?, now() - interval '1 hour')`, []any{id})
+		require.NoError(t, err)
+	}
+
+	// Failures are reported as events and do not fail the job
+	require.NoError(t, job.Run(ctx))
+	assert.Equal(t, 2, job.Status().Completed)
+
+	// The credentials are unchanged
+	creds, err := repo.Retrieve(ctx, prj.PublicId, []string{upCred.PublicId, missingCred.PublicId})
+	require.NoError(t, err)
+	for _, c := range creds {
+		assert.Equal(t, []byte("password"), c.(*UsernamePasswordCredential).Password)
+	}
+}
+
+func TestCredentialRotationJob_RunRequestedRotation(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	rotator := &testRotator{}
+	job, err := newCredentialRotationJob(ctx, rw, rw, kmsCache, map[string]Rotator{"test": rotator})
+	require.NoError(t, err)
+
+	// The store has no rotation period, its credentials are only rotated
+	// when they are checked in.
+	cs := TestCredentialStore(t, conn, wrapper, prj.PublicId, WithRotator("test"), WithExclusiveCheckout(true), WithRotateOnCheckin(true))
+	upCred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "password", cs.PublicId, prj.PublicId)
+
+	require.NoError(t, job.Run(ctx))
+	assert.Empty(t, rotator.rotated)
+
+	_, err = rw.Exec(ctx, `insert into credential_static_rotation (credential_id, rotation_requested) values (?, true)`, []any{upCred.PublicId})
+	require.NoError(t, err)
+	nextRunIn, err := job.NextRunIn(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, nextRunIn)
+
+	require.NoError(t, job.Run(ctx))
+	require.Len(t, rotator.rotated, 1)
+	assert.Equal(t, upCred.PublicId, rotator.rotated[0].CredentialId)
+
+	// The request is reset by the rotation
+	require.NoError(t, job.Run(ctx))
+	assert.Len(t, rotator.rotated, 1)
+}
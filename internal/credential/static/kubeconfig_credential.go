@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static/store"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ credential.Static = (*KubeconfigCredential)(nil)
+
+// A KubeconfigCredential contains the credential with a Kubernetes client
+// configuration file. It is owned by a credential store.
+type KubeconfigCredential struct {
+	*store.KubeconfigCredential
+	tableName string `gorm:"-"`
+}
+
+// NewKubeconfigCredential creates a new in memory static Credential
+// containing a kubeconfig that is assigned to storeId. Name and description
+// are the only valid options. All other options are ignored.
+func NewKubeconfigCredential(
+	ctx context.Context,
+	storeId string,
+	kubeconfig credential.Kubeconfig,
+	opt ...Option,
+) (*KubeconfigCredential, error) {
+	opts := getOpts(opt...)
+	return &KubeconfigCredential{
+		KubeconfigCredential: &store.KubeconfigCredential{
+			StoreId:     storeId,
+			Name:        opts.withName,
+			Description: opts.withDescription,
+			Kubeconfig:  kubeconfig,
+		},
+	}, nil
+}
+
+func allocKubeconfigCredential() *KubeconfigCredential {
+	return &KubeconfigCredential{
+		KubeconfigCredential: &store.KubeconfigCredential{},
+	}
+}
+
+func (c *KubeconfigCredential) clone() *KubeconfigCredential {
+	cp := proto.Clone(c.KubeconfigCredential)
+	return &KubeconfigCredential{
+		KubeconfigCredential: cp.(*store.KubeconfigCredential),
+	}
+}
+
+// TableName returns the table name.
+func (c *KubeconfigCredential) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "credential_static_kubeconfig_credential"
+}
+
+// SetTableName sets the table name.
+func (c *KubeconfigCredential) SetTableName(n string) {
+	c.tableName = n
+}
+
+// GetResourceType returns the resource type of the Credential
+func (c *KubeconfigCredential) GetResourceType() resource.Type {
+	return resource.Credential
+}
+
+func (c *KubeconfigCredential) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{c.PublicId},
+		"resource-type":      []string{"credential-static-kubeconfig"},
+		"op-type":            []string{op.String()},
+	}
+	if c.StoreId != "" {
+		metadata["store-id"] = []string{c.StoreId}
+	}
+	return metadata
+}
+
+func (c *KubeconfigCredential) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(KubeconfigCredential).encrypt"
+	if len(c.Kubeconfig) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "no kubeconfig defined")
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, c.KubeconfigCredential, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	keyId, err := cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("error reading cipher key id"))
+	}
+	c.KeyId = keyId
+	if err := c.hmacKubeconfig(ctx, cipher); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+func (c *KubeconfigCredential) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(KubeconfigCredential).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, c.KubeconfigCredential, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+func (c *KubeconfigCredential) hmacKubeconfig(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(KubeconfigCredential).hmacKubeconfig"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	hm, err := crypto.HmacSha256(ctx, c.Kubeconfig, cipher, []byte(c.StoreId), nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	c.KubeconfigHmac = []byte(hm)
+	return nil
+}
+
+type deletedKubeconfigCredential struct {
+	PublicId   string `gorm:"primary_key"`
+	DeleteTime *timestamp.Timestamp
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (s *deletedKubeconfigCredential) TableName() string {
+	return "credential_static_kubeconfig_credential_deleted"
+}
@@ -22,6 +22,13 @@ type options struct {
 	withLimit                int
 	withPublicId             string
 	withPrivateKeyPassphrase []byte
+	withRotationPeriod       uint32
+	withRotationGracePeriod  uint32
+	withRotator              string
+	withExclusiveCheckout    bool
+	withRotateOnCheckin      bool
+	withCaCertificate        []byte
+	withAuthorId             string
 }
 
 func getDefaultOptions() options {
@@ -58,9 +65,65 @@ func WithPublicId(name string) Option {
 	}
 }
 
+// WithRotationPeriod provides an optional number of seconds between
+// rotations of the credentials in a credential store.
+func WithRotationPeriod(secs uint32) Option {
+	return func(o *options) {
+		o.withRotationPeriod = secs
+	}
+}
+
+// WithRotationGracePeriod provides an optional number of seconds the
+// previous version of a rotated credential remains valid.
+func WithRotationGracePeriod(secs uint32) Option {
+	return func(o *options) {
+		o.withRotationGracePeriod = secs
+	}
+}
+
+// WithRotator provides an optional name of the rotator used to rotate the
+// credentials in a credential store.
+func WithRotator(name string) Option {
+	return func(o *options) {
+		o.withRotator = name
+	}
+}
+
+// WithExclusiveCheckout provides an option to only allow one session at a
+// time to use a credential in a credential store.
+func WithExclusiveCheckout(b bool) Option {
+	return func(o *options) {
+		o.withExclusiveCheckout = b
+	}
+}
+
+// WithRotateOnCheckin provides an option to rotate the credentials in a
+// credential store when they are checked in.
+func WithRotateOnCheckin(b bool) Option {
+	return func(o *options) {
+		o.withRotateOnCheckin = b
+	}
+}
+
 // WithPrivateKeyPassphrase provides an optional SSH private key passphrase to use.
 func WithPrivateKeyPassphrase(with []byte) Option {
 	return func(o *options) {
 		o.withPrivateKeyPassphrase = with
 	}
 }
+
+// WithCaCertificate provides an optional PEM encoded CA certificate bundle
+// to use with a TLS client certificate.
+func WithCaCertificate(with []byte) Option {
+	return func(o *options) {
+		o.withCaCertificate = with
+	}
+}
+
+// WithAuthorId provides an optional id of the user who created or updated a
+// credential. It is recorded as the author of the new credential version.
+func WithAuthorId(id string) Option {
+	return func(o *options) {
+		o.withAuthorId = id
+	}
+}
@@ -29,6 +29,36 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withLimit = 5
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithRotationPeriod", func(t *testing.T) {
+		opts := getOpts(WithRotationPeriod(3600))
+		testOpts := getDefaultOptions()
+		testOpts.withRotationPeriod = 3600
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithRotationGracePeriod", func(t *testing.T) {
+		opts := getOpts(WithRotationGracePeriod(60))
+		testOpts := getDefaultOptions()
+		testOpts.withRotationGracePeriod = 60
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithRotator", func(t *testing.T) {
+		opts := getOpts(WithRotator("ssh-hosts"))
+		testOpts := getDefaultOptions()
+		testOpts.withRotator = "ssh-hosts"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithExclusiveCheckout", func(t *testing.T) {
+		opts := getOpts(WithExclusiveCheckout(true))
+		testOpts := getDefaultOptions()
+		testOpts.withExclusiveCheckout = true
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithRotateOnCheckin", func(t *testing.T) {
+		opts := getOpts(WithRotateOnCheckin(true))
+		testOpts := getDefaultOptions()
+		testOpts.withRotateOnCheckin = true
+		assert.Equal(t, opts, testOpts)
+	})
 	t.Run("WithPrivateKeyPassphrase", func(t *testing.T) {
 		opts := getOpts(WithPrivateKeyPassphrase([]byte("my-pass")))
 		testOpts := getDefaultOptions()
@@ -36,4 +66,17 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withPrivateKeyPassphrase = []byte("my-pass")
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithCaCertificate", func(t *testing.T) {
+		opts := getOpts(WithCaCertificate([]byte("my-ca")))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(t, opts, testOpts)
+		testOpts.withCaCertificate = []byte("my-ca")
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithAuthorId", func(t *testing.T) {
+		opts := getOpts(WithAuthorId("u_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withAuthorId = "u_1234567890"
+		assert.Equal(t, opts, testOpts)
+	})
 }
@@ -41,13 +41,166 @@ where store.project_id = ?
   and json.key_id = ?;
 `
 
+	credStaticTlsClientCertificateRewrapQuery = `
+select distinct
+  tls.public_id,
+  tls.private_key_encrypted,
+  tls.key_id
+from credential_static_tls_client_certificate_credential tls
+  inner join credential_static_store store
+    on store.public_id = tls.store_id
+where store.project_id = ?
+  and tls.key_id = ?;
+`
+
+	credStaticKubeconfigRewrapQuery = `
+select distinct
+  kc.public_id,
+  kc.kubeconfig_encrypted,
+  kc.key_id
+from credential_static_kubeconfig_credential kc
+  inner join credential_static_store store
+    on store.public_id = kc.store_id
+where store.project_id = ?
+  and kc.key_id = ?;
+`
+
+	credStaticVersionRewrapQuery = `
+select distinct
+  ver.credential_id,
+  ver.version,
+  ver.data,
+  ver.key_id
+from credential_static_version ver
+  inner join credential_static cred
+    on cred.public_id = ver.credential_id
+  inner join credential_static_store store
+    on store.public_id = cred.store_id
+where store.project_id = ?
+  and ver.key_id = ?;
+`
+
+	rotationCredentialsCte = `
+with creds as (
+  select public_id,
+         store_id,
+         username,
+         create_time,
+         'upw' as type
+    from credential_static_username_password_credential
+   union
+  select public_id,
+         store_id,
+         username,
+         create_time,
+         'ssh' as type
+    from credential_static_ssh_private_key_credential
+)
+`
+
+	credStaticRotationDueQuery = rotationCredentialsCte + `
+  select creds.public_id,
+         creds.store_id,
+         creds.type,
+         store.project_id,
+         store.rotator,
+         store.rotation_grace_period
+    from creds
+    join credential_static_store store
+      on store.public_id = creds.store_id
+    left join credential_static_rotation rot
+      on rot.credential_id = creds.public_id
+   where store.rotator is not null
+     and rot.grace_period_end is null
+     and (
+           rot.rotation_requested
+           or
+           (
+             store.rotation_period > 0
+             and coalesce(rot.rotate_time, creds.create_time) + make_interval(secs => store.rotation_period) <= current_timestamp
+           )
+         )
+     and not exists (
+           select 1
+             from credential_static_checkout checkout
+            where checkout.credential_id = creds.public_id
+         )
+order by coalesce(rot.rotate_time, creds.create_time)
+   limit ?;
+`
+
+	credStaticRotationRetireQuery = rotationCredentialsCte + `
+  select creds.public_id,
+         creds.store_id,
+         creds.type,
+         creds.username,
+         store.rotator,
+         rot.previous_public_key
+    from credential_static_rotation rot
+    join creds
+      on creds.public_id = rot.credential_id
+    join credential_static_store store
+      on store.public_id = creds.store_id
+   where rot.grace_period_end <= current_timestamp
+order by rot.grace_period_end
+   limit ?;
+`
+
+	credStaticRotationNextRunInQuery = rotationCredentialsCte + `
+  select extract(epoch from next_run_time - now())::int as rotate_in
+    from (
+      select case
+               when rot.grace_period_end is not null then rot.grace_period_end
+               when rot.rotation_requested           then current_timestamp
+               else coalesce(rot.rotate_time, creds.create_time) + make_interval(secs => store.rotation_period)
+             end as next_run_time
+        from creds
+        join credential_static_store store
+          on store.public_id = creds.store_id
+        left join credential_static_rotation rot
+          on rot.credential_id = creds.public_id
+       where rot.grace_period_end is not null
+          or (
+               (store.rotation_period > 0 or rot.rotation_requested)
+               and not exists (
+                     select 1
+                       from credential_static_checkout checkout
+                      where checkout.credential_id = creds.public_id
+                   )
+             )
+    ) as next
+order by next_run_time
+   limit 1;
+`
+
+	upsertCredStaticRotationQuery = `
+insert into credential_static_rotation
+  (credential_id, grace_period_end, previous_public_key)
+values
+  (@credential_id, wt_add_seconds_to_now(@grace_period), @previous_public_key)
+on conflict (credential_id) do update
+  set rotate_time         = current_timestamp,
+      grace_period_end    = excluded.grace_period_end,
+      previous_public_key = excluded.previous_public_key,
+      rotation_requested  = false;
+`
+
+	retireCredStaticRotationQuery = `
+update credential_static_rotation
+   set grace_period_end    = null,
+       previous_public_key = null
+ where credential_id = ?;
+`
+
 	estimateCountCredentials = `
 select sum(reltuples::bigint) as estimate
   from pg_class
  where oid in (
   'credential_static_json_credential'::regclass,
   'credential_static_username_password_credential'::regclass,
-  'credential_static_ssh_private_key_credential'::regclass
+  'credential_static_ssh_private_key_credential'::regclass,
+  'credential_static_tls_client_certificate_credential'::regclass,
+  'credential_static_kubeconfig_credential'::regclass
  )
 `
 
@@ -74,6 +227,16 @@ ssh_creds as (
     from credential_static_ssh_private_key_credential
    where public_id in (select public_id from credentials)
 ),
+tls_creds as (
+  select *
+    from credential_static_tls_client_certificate_credential
+   where public_id in (select public_id from credentials)
+),
+kc_creds as (
+  select *
+    from credential_static_kubeconfig_credential
+   where public_id in (select public_id from credentials)
+),
 final as (
   select public_id,
          store_id,
@@ -119,6 +282,36 @@ final as (
          private_key_passphrase_hmac as hmac2,
          'ssh' as type
     from ssh_creds
+   union
+  select public_id,
+         store_id,
+         project_id,
+         name,
+         description,
+         create_time,
+         update_time,
+         version,
+         null as username,     -- Add this to make the union uniform
+         key_id,
+         private_key_hmac as hmac1,
+         null::bytea as hmac2, -- Add this to make the union uniform
+         'tls' as type
+    from tls_creds
+   union
+  select public_id,
+         store_id,
+         project_id,
+         name,
+         description,
+         create_time,
+         update_time,
+         version,
+         null as username,     -- Add this to make the union uniform
+         key_id,
+         kubeconfig_hmac as hmac1,
+         null::bytea as hmac2, -- Add this to make the union uniform
+         'kc' as type
+    from kc_creds
 )
   select *
     from final
@@ -149,6 +342,16 @@ ssh_creds as (
     from credential_static_ssh_private_key_credential
    where public_id in (select public_id from credentials)
 ),
+tls_creds as (
+  select *
+    from credential_static_tls_client_certificate_credential
+   where public_id in (select public_id from credentials)
+),
+kc_creds as (
+  select *
+    from credential_static_kubeconfig_credential
+   where public_id in (select public_id from credentials)
+),
 final as (
   select public_id,
          store_id,
@@ -194,6 +397,36 @@ final as (
          private_key_passphrase_hmac as hmac2,
          'ssh' as type
     from ssh_creds
+   union
+  select public_id,
+         store_id,
+         project_id,
+         name,
+         description,
+         create_time,
+         update_time,
+         version,
+         null as username,     -- Add this to make the union uniform
+         key_id,
+         private_key_hmac as hmac1,
+         null::bytea as hmac2, -- Add this to make the union uniform
+         'tls' as type
+    from tls_creds
+   union
+  select public_id,
+         store_id,
+         project_id,
+         name,
+         description,
+         create_time,
+         update_time,
+         version,
+         null as username,     -- Add this to make the union uniform
+         key_id,
+         kubeconfig_hmac as hmac1,
+         null::bytea as hmac2, -- Add this to make the union uniform
+         'kc' as type
+    from kc_creds
 )
   select *
     from final
@@ -224,6 +457,16 @@ ssh_creds as (
     from credential_static_ssh_private_key_credential
    where public_id in (select public_id from credentials)
 ),
+tls_creds as (
+  select *
+    from credential_static_tls_client_certificate_credential
+   where public_id in (select public_id from credentials)
+),
+kc_creds as (
+  select *
+    from credential_static_kubeconfig_credential
+   where public_id in (select public_id from credentials)
+),
 final as (
   select public_id,
          store_id,
@@ -269,6 +512,36 @@ final as (
          private_key_passphrase_hmac as hmac2,
          'ssh' as type
     from ssh_creds
+   union
+  select public_id,
+         store_id,
+         project_id,
+         name,
+         description,
+         create_time,
+         update_time,
+         version,
+         null as username,     -- Add this to make the union uniform
+         key_id,
+         private_key_hmac as hmac1,
+         null::bytea as hmac2, -- Add this to make the union uniform
+         'tls' as type
+    from tls_creds
+   union
+  select public_id,
+         store_id,
+         project_id,
+         name,
+         description,
+         create_time,
+         update_time,
+         version,
+         null as username,     -- Add this to make the union uniform
+         key_id,
+         kubeconfig_hmac as hmac1,
+         null::bytea as hmac2, -- Add this to make the union uniform
+         'kc' as type
+    from kc_creds
 )
   select *
     from final
@@ -300,6 +573,16 @@ ssh_creds as (
     from credential_static_ssh_private_key_credential
    where public_id in (select public_id from credentials)
 ),
+tls_creds as (
+  select *
+    from credential_static_tls_client_certificate_credential
+   where public_id in (select public_id from credentials)
+),
+kc_creds as (
+  select *
+    from credential_static_kubeconfig_credential
+   where public_id in (select public_id from credentials)
+),
 final as (
   select public_id,
          store_id,
@@ -345,6 +628,36 @@ final as (
          private_key_passphrase_hmac as hmac2,
          'ssh' as type
     from ssh_creds
+   union
+  select public_id,
+         store_id,
+         project_id,
+         name,
+         description,
+         create_time,
+         update_time,
+         version,
+         null as username,     -- Add this to make the union uniform
+         key_id,
+         private_key_hmac as hmac1,
+         null::bytea as hmac2, -- Add this to make the union uniform
+         'tls' as type
+    from tls_creds
+   union
+  select public_id,
+         store_id,
+         project_id,
+         name,
+         description,
+         create_time,
+         update_time,
+         version,
+         null as username,     -- Add this to make the union uniform
+         key_id,
+         kubeconfig_hmac as hmac1,
+         null::bytea as hmac2, -- Add this to make the union uniform
+         'kc' as type
+    from kc_creds
 )
   select *
     from final
@@ -26,6 +26,11 @@ func (credentialHooks) NewStore(ctx context.Context, result *credential.StoreLis
 	s.Description = result.Description
 	s.ProjectId = result.ProjectId
 	s.Version = result.Version
+	s.RotationPeriod = result.RotationPeriod
+	s.RotationGracePeriod = result.RotationGracePeriod
+	s.Rotator = result.Rotator
+	s.ExclusiveCheckout = result.ExclusiveCheckout
+	s.RotateOnCheckin = result.RotateOnCheckin
 
 	return s, nil
 }
@@ -36,7 +36,7 @@ func (r *Repository) CreateUsernamePasswordCredential(
 	ctx context.Context,
 	projectId string,
 	c *UsernamePasswordCredential,
-	_ ...Option,
+	opt ...Option,
 ) (*UsernamePasswordCredential, error) {
 	const op = "static.(Repository).CreateUsernamePasswordCredential"
 	if c == nil {
@@ -61,6 +61,8 @@ func (r *Repository) CreateUsernamePasswordCredential(
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
 	}
 
+	opts := getOpts(opt...)
+
 	c = c.clone()
 	id, err := credential.NewUsernamePasswordCredentialId(ctx)
 	if err != nil {
@@ -83,12 +85,17 @@ func (r *Repository) CreateUsernamePasswordCredential(
 
 	var newCred *UsernamePasswordCredential
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
+		func(reader db.Reader, w db.Writer) error {
 			newCred = c.clone()
 			if err := w.Create(ctx, newCred,
 				db.WithOplog(oplogWrapper, newCred.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
+			cv := allocUsernamePasswordCredential()
+			cv.PublicId = newCred.PublicId
+			if err := insertCredentialVersion(ctx, reader, w, databaseWrapper, cv, opts.withAuthorId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
 
 			return nil
 		},
@@ -124,7 +131,7 @@ func (r *Repository) CreateSshPrivateKeyCredential(
 	ctx context.Context,
 	projectId string,
 	c *SshPrivateKeyCredential,
-	_ ...Option,
+	opt ...Option,
 ) (*SshPrivateKeyCredential, error) {
 	const op = "static.(Repository).CreateSshPrivateKeyCredential"
 	if c == nil {
@@ -149,6 +156,8 @@ func (r *Repository) CreateSshPrivateKeyCredential(
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
 	}
 
+	opts := getOpts(opt...)
+
 	c = c.clone()
 	id, err := credential.NewSshPrivateKeyCredentialId(ctx)
 	if err != nil {
@@ -171,12 +180,17 @@ func (r *Repository) CreateSshPrivateKeyCredential(
 
 	var newCred *SshPrivateKeyCredential
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
+		func(reader db.Reader, w db.Writer) error {
 			newCred = c.clone()
 			if err := w.Create(ctx, newCred,
 				db.WithOplog(oplogWrapper, newCred.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
+			cv := allocSshPrivateKeyCredential()
+			cv.PublicId = newCred.PublicId
+			if err := insertCredentialVersion(ctx, reader, w, databaseWrapper, cv, opts.withAuthorId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
 
 			return nil
 		},
@@ -214,7 +228,7 @@ func (r *Repository) CreateJsonCredential(
 	ctx context.Context,
 	projectId string,
 	c *JsonCredential,
-	_ ...Option,
+	opt ...Option,
 ) (*JsonCredential, error) {
 	const op = "static.(Repository).CreateJsonCredential"
 	if c == nil {
@@ -236,6 +250,8 @@ func (r *Repository) CreateJsonCredential(
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
 	}
 
+	opts := getOpts(opt...)
+
 	c = c.clone()
 	id, err := credential.NewJsonCredentialId(ctx)
 	if err != nil {
@@ -258,12 +274,17 @@ func (r *Repository) CreateJsonCredential(
 
 	var newCred *JsonCredential
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
+		func(reader db.Reader, w db.Writer) error {
 			newCred = c.clone()
 			if err := w.Create(ctx, newCred,
 				db.WithOplog(oplogWrapper, newCred.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
+			cv := allocJsonCredential()
+			cv.PublicId = newCred.PublicId
+			if err := insertCredentialVersion(ctx, reader, w, databaseWrapper, cv, opts.withAuthorId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
 
 			return nil
 		},
@@ -282,6 +303,189 @@ func (r *Repository) CreateJsonCredential(
 	return newCred, nil
 }
 
+// CreateTlsClientCertificateCredential inserts c into the repository and
+// returns a new TlsClientCertificateCredential containing the credential's
+// PublicId. c is not changed. c must not contain a PublicId. The PublicId is
+// generated and assigned by this method. c must contain a valid StoreId.
+//
+// The private key is encrypted and a HmacSha256 of the private key is
+// calculated. Only the PrivateKeyHmac is returned, the plain-text and
+// encrypted private key is not returned.
+//
+// Both c.Name and c.Description are optional. If c.Name is set, it must be
+// unique within c.StoreId. Both c.CreateTime and c.UpdateTime are ignored.
+func (r *Repository) CreateTlsClientCertificateCredential(
+	ctx context.Context,
+	projectId string,
+	c *TlsClientCertificateCredential,
+	opt ...Option,
+) (*TlsClientCertificateCredential, error) {
+	const op = "static.(Repository).CreateTlsClientCertificateCredential"
+	if c == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential")
+	}
+	if c.TlsClientCertificateCredential == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing embedded credential")
+	}
+	if projectId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	}
+	if len(c.Certificate) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing certificate")
+	}
+	if len(c.PrivateKey) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing private key")
+	}
+	if c.StoreId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing store id")
+	}
+	if c.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	}
+
+	opts := getOpts(opt...)
+
+	c = c.clone()
+	id, err := credential.NewTlsClientCertificateCredentialId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	c.PublicId = id
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	// encrypt
+	databaseWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := c.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var newCred *TlsClientCertificateCredential
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			newCred = c.clone()
+			if err := w.Create(ctx, newCred,
+				db.WithOplog(oplogWrapper, newCred.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			cv := allocTlsClientCertificateCredential()
+			cv.PublicId = newCred.PublicId
+			if err := insertCredentialVersion(ctx, reader, w, databaseWrapper, cv, opts.withAuthorId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in store: %s: name %s already exists", c.StoreId, c.Name)))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in store: %s", c.StoreId)))
+	}
+
+	// Clear private key fields, only PrivateKeyHmac should be returned
+	newCred.PrivateKeyEncrypted = nil
+	newCred.PrivateKey = nil
+
+	return newCred, nil
+}
+
+// CreateKubeconfigCredential inserts c into the repository and returns a new
+// KubeconfigCredential containing the credential's PublicId. c is not
+// changed. c must not contain a PublicId. The PublicId is generated and
+// assigned by this method. c must contain a valid StoreId.
+//
+// The kubeconfig is encrypted and a HmacSha256 of the kubeconfig is
+// calculated. Only the KubeconfigHmac is returned, the plain-text and
+// encrypted kubeconfig is not returned.
+//
+// Both c.Name and c.Description are optional. If c.Name is set, it must be
+// unique within c.StoreId. Both c.CreateTime and c.UpdateTime are ignored.
+func (r *Repository) CreateKubeconfigCredential(
+	ctx context.Context,
+	projectId string,
+	c *KubeconfigCredential,
+	opt ...Option,
+) (*KubeconfigCredential, error) {
+	const op = "static.(Repository).CreateKubeconfigCredential"
+	if c == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential")
+	}
+	if c.KubeconfigCredential == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing embedded credential")
+	}
+	if projectId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	}
+	if len(c.Kubeconfig) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kubeconfig")
+	}
+	if c.StoreId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing store id")
+	}
+	if c.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	}
+
+	opts := getOpts(opt...)
+
+	c = c.clone()
+	id, err := credential.NewKubeconfigCredentialId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	c.PublicId = id
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	// encrypt
+	databaseWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := c.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var newCred *KubeconfigCredential
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			newCred = c.clone()
+			if err := w.Create(ctx, newCred,
+				db.WithOplog(oplogWrapper, newCred.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			cv := allocKubeconfigCredential()
+			cv.PublicId = newCred.PublicId
+			if err := insertCredentialVersion(ctx, reader, w, databaseWrapper, cv, opts.withAuthorId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in store: %s: name %s already exists", c.StoreId, c.Name)))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in store: %s", c.StoreId)))
+	}
+
+	// Clear kubeconfig fields, only KubeconfigHmac should be returned
+	newCred.KubeconfigEncrypted = nil
+	newCred.Kubeconfig = nil
+
+	return newCred, nil
+}
+
 // LookupCredential returns the Credential for the publicId. Returns
 // nil, nil if no Credential is found for the publicId.
 // TODO: This should hit a view and return the interface type...
@@ -338,6 +542,34 @@ func (r *Repository) LookupCredential(ctx context.Context, publicId string, _ ..
 		jsonCred.ObjectEncrypted = nil
 		jsonCred.Object = nil
 		cred = jsonCred
+
+	case credential.TlsClientCertificateSubtype:
+		tlsCred := allocTlsClientCertificateCredential()
+		tlsCred.PublicId = publicId
+		if err := r.reader.LookupByPublicId(ctx, tlsCred); err != nil {
+			if errors.IsNotFoundError(err) {
+				return nil, nil
+			}
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", publicId)))
+		}
+		// Clear private key fields, only PrivateKeyHmac should be returned
+		tlsCred.PrivateKeyEncrypted = nil
+		tlsCred.PrivateKey = nil
+		cred = tlsCred
+
+	case credential.KubeconfigSubtype:
+		kcCred := allocKubeconfigCredential()
+		kcCred.PublicId = publicId
+		if err := r.reader.LookupByPublicId(ctx, kcCred); err != nil {
+			if errors.IsNotFoundError(err) {
+				return nil, nil
+			}
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", publicId)))
+		}
+		// Clear kubeconfig fields, only KubeconfigHmac should be returned
+		kcCred.KubeconfigEncrypted = nil
+		kcCred.Kubeconfig = nil
+		cred = kcCred
 	}
 
 	return cred, nil
@@ -358,7 +590,7 @@ func (r *Repository) UpdateUsernamePasswordCredential(ctx context.Context,
 	c *UsernamePasswordCredential,
 	version uint32,
 	fieldMaskPaths []string,
-	_ ...Option,
+	opt ...Option,
 ) (*UsernamePasswordCredential, int, error) {
 	const op = "static.(Repository).UpdateUsernamePasswordCredential"
 	if c == nil {
@@ -379,6 +611,8 @@ func (r *Repository) UpdateUsernamePasswordCredential(ctx context.Context,
 	if c.StoreId == "" {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing store id")
 	}
+	opts := getOpts(opt...)
+
 	c = c.clone()
 
 	for _, f := range fieldMaskPaths {
@@ -405,13 +639,14 @@ func (r *Repository) UpdateUsernamePasswordCredential(ctx context.Context,
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
 	}
 
+	databaseWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+
 	for _, f := range fieldMaskPaths {
 		if strings.EqualFold(passwordField, f) {
 			// Password has been updated, re-encrypt and recalculate hmac
-			databaseWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
-			if err != nil {
-				return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
-			}
 			if err := c.encrypt(ctx, databaseWrapper); err != nil {
 				return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
 			}
@@ -430,7 +665,7 @@ func (r *Repository) UpdateUsernamePasswordCredential(ctx context.Context,
 	var rowsUpdated int
 	var returnedCredential *UsernamePasswordCredential
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
+		func(reader db.Reader, w db.Writer) error {
 			returnedCredential = c.clone()
 			var err error
 			rowsUpdated, err = w.Update(ctx, returnedCredential,
@@ -443,6 +678,13 @@ func (r *Repository) UpdateUsernamePasswordCredential(ctx context.Context,
 			if rowsUpdated > 1 {
 				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
 			}
+			if rowsUpdated == 1 {
+				cv := allocUsernamePasswordCredential()
+				cv.PublicId = returnedCredential.PublicId
+				if err := insertCredentialVersion(ctx, reader, w, databaseWrapper, cv, opts.withAuthorId); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
 			return nil
 		},
 	)
@@ -473,7 +715,7 @@ func (r *Repository) UpdateSshPrivateKeyCredential(ctx context.Context,
 	c *SshPrivateKeyCredential,
 	version uint32,
 	fieldMaskPaths []string,
-	_ ...Option,
+	opt ...Option,
 ) (*SshPrivateKeyCredential, int, error) {
 	const op = "static.(Repository).UpdateSshPrivateKeyCredential"
 	if c == nil {
@@ -494,6 +736,8 @@ func (r *Repository) UpdateSshPrivateKeyCredential(ctx context.Context,
 	if c.StoreId == "" {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing store id")
 	}
+	opts := getOpts(opt...)
+
 	c = c.clone()
 
 	for _, f := range fieldMaskPaths {
@@ -522,6 +766,11 @@ func (r *Repository) UpdateSshPrivateKeyCredential(ctx context.Context,
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
 	}
 
+	databaseWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+
 	var performedEncryption bool
 	for _, f := range fieldMaskPaths {
 		if strings.EqualFold(privateKeyField, f) || strings.EqualFold(PrivateKeyPassphraseField, f) {
@@ -529,10 +778,6 @@ func (r *Repository) UpdateSshPrivateKeyCredential(ctx context.Context,
 				// We don't need to encrypt twice so keep track
 				performedEncryption = true
 
-				databaseWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
-				if err != nil {
-					return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
-				}
 				if err := c.encrypt(ctx, databaseWrapper); err != nil {
 					return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
 				}
@@ -559,7 +804,7 @@ func (r *Repository) UpdateSshPrivateKeyCredential(ctx context.Context,
 	var rowsUpdated int
 	var returnedCredential *SshPrivateKeyCredential
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
+		func(reader db.Reader, w db.Writer) error {
 			returnedCredential = c.clone()
 			var err error
 			rowsUpdated, err = w.Update(ctx, returnedCredential,
@@ -572,6 +817,13 @@ func (r *Repository) UpdateSshPrivateKeyCredential(ctx context.Context,
 			if rowsUpdated > 1 {
 				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
 			}
+			if rowsUpdated == 1 {
+				cv := allocSshPrivateKeyCredential()
+				cv.PublicId = returnedCredential.PublicId
+				if err := insertCredentialVersion(ctx, reader, w, databaseWrapper, cv, opts.withAuthorId); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
 			return nil
 		},
 	)
@@ -606,7 +858,7 @@ func (r *Repository) UpdateJsonCredential(ctx context.Context,
 	c *JsonCredential,
 	version uint32,
 	fieldMaskPaths []string,
-	_ ...Option,
+	opt ...Option,
 ) (*JsonCredential, int, error) {
 	const op = "static.(Repository).UpdateJsonCredential"
 	if c == nil {
@@ -627,6 +879,8 @@ func (r *Repository) UpdateJsonCredential(ctx context.Context,
 	if c.StoreId == "" {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing store id")
 	}
+	opts := getOpts(opt...)
+
 	c = c.clone()
 
 	// each field in the json secret will be passed into the fieldMaskPaths as an individual path
@@ -669,12 +923,13 @@ func (r *Repository) UpdateJsonCredential(ctx context.Context,
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
 	}
 
+	databaseWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+
 	if hasSecret {
 		// Json secret has been updated, re-encrypt and recalculate hmac
-		databaseWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
-		if err != nil {
-			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
-		}
 		if err := c.encrypt(ctx, databaseWrapper); err != nil {
 			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
 		}
@@ -692,7 +947,7 @@ func (r *Repository) UpdateJsonCredential(ctx context.Context,
 	var rowsUpdated int
 	var returnedCredential *JsonCredential
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
+		func(reader db.Reader, w db.Writer) error {
 			returnedCredential = c.clone()
 			var err error
 			rowsUpdated, err = w.Update(ctx, returnedCredential,
@@ -705,6 +960,13 @@ func (r *Repository) UpdateJsonCredential(ctx context.Context,
 			if rowsUpdated > 1 {
 				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
 			}
+			if rowsUpdated == 1 {
+				cv := allocJsonCredential()
+				cv.PublicId = returnedCredential.PublicId
+				if err := insertCredentialVersion(ctx, reader, w, databaseWrapper, cv, opts.withAuthorId); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
 			return nil
 		},
 	)
@@ -719,6 +981,256 @@ func (r *Repository) UpdateJsonCredential(ctx context.Context,
 	return returnedCredential, rowsUpdated, nil
 }
 
+// UpdateTlsClientCertificateCredential updates the repository entry for
+// c.PublicId with the values in c for the fields listed in fieldMaskPaths. It
+// returns a new TlsClientCertificateCredential containing the updated values
+// and a count of the number of records updated. c is not changed.
+//
+// c must contain a valid PublicId. Only Name, Description, Certificate,
+// CaCertificate and PrivateKey can be changed. If c.Name is set to a
+// non-empty string, it must be unique within c.StoreId.
+//
+// An attribute of c will be set to NULL in the database if the attribute in c
+// is the zero value and it is included in fieldMaskPaths.
+func (r *Repository) UpdateTlsClientCertificateCredential(ctx context.Context,
+	projectId string,
+	c *TlsClientCertificateCredential,
+	version uint32,
+	fieldMaskPaths []string,
+	opt ...Option,
+) (*TlsClientCertificateCredential, int, error) {
+	const op = "static.(Repository).UpdateTlsClientCertificateCredential"
+	if c == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing credential")
+	}
+	if c.TlsClientCertificateCredential == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing embedded credential")
+	}
+	if c.PublicId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	if version == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	}
+	if projectId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	}
+	if c.StoreId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing store id")
+	}
+	opts := getOpts(opt...)
+
+	c = c.clone()
+
+	var updatePrivateKey bool
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(nameField, f):
+		case strings.EqualFold(descriptionField, f):
+		case strings.EqualFold(certificateField, f):
+		case strings.EqualFold(caCertificateField, f):
+		case strings.EqualFold(privateKeyField, f):
+			updatePrivateKey = true
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
+		}
+	}
+	dbMask, nullFields := dbw.BuildUpdatePaths(
+		map[string]any{
+			nameField:          c.Name,
+			descriptionField:   c.Description,
+			certificateField:   c.Certificate,
+			caCertificateField: c.CaCertificate,
+			privateKeyField:    c.PrivateKey,
+		},
+		fieldMaskPaths,
+		nil,
+	)
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
+	}
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+
+	if updatePrivateKey {
+		// Private key has been updated, re-encrypt and recalculate hmac
+		if err := c.encrypt(ctx, databaseWrapper); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+
+		// Set PrivateKeyHmac and PrivateKeyEncrypted masks for update.
+		dbMask = append(dbMask, "PrivateKeyHmac", "PrivateKeyEncrypted", "KeyId")
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected,
+			errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsUpdated int
+	var returnedCredential *TlsClientCertificateCredential
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			returnedCredential = c.clone()
+			var err error
+			rowsUpdated, err = w.Update(ctx, returnedCredential,
+				dbMask, nullFields,
+				db.WithOplog(oplogWrapper, returnedCredential.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+			if rowsUpdated == 1 {
+				cv := allocTlsClientCertificateCredential()
+				cv.PublicId = returnedCredential.PublicId
+				if err := insertCredentialVersion(ctx, reader, w, databaseWrapper, cv, opts.withAuthorId); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+
+	// Clear private key fields, only PrivateKeyHmac should be returned
+	returnedCredential.PrivateKeyEncrypted = nil
+	returnedCredential.PrivateKey = nil
+
+	return returnedCredential, rowsUpdated, nil
+}
+
+// UpdateKubeconfigCredential updates the repository entry for c.PublicId
+// with the values in c for the fields listed in fieldMaskPaths. It returns a
+// new KubeconfigCredential containing the updated values and a count of the
+// number of records updated. c is not changed.
+//
+// c must contain a valid PublicId. Only Name, Description and Kubeconfig can
+// be changed. If c.Name is set to a non-empty string, it must be unique
+// within c.StoreId.
+//
+// An attribute of c will be set to NULL in the database if the attribute in c
+// is the zero value and it is included in fieldMaskPaths.
+func (r *Repository) UpdateKubeconfigCredential(ctx context.Context,
+	projectId string,
+	c *KubeconfigCredential,
+	version uint32,
+	fieldMaskPaths []string,
+	opt ...Option,
+) (*KubeconfigCredential, int, error) {
+	const op = "static.(Repository).UpdateKubeconfigCredential"
+	if c == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing credential")
+	}
+	if c.KubeconfigCredential == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing embedded credential")
+	}
+	if c.PublicId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	if version == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	}
+	if projectId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	}
+	if c.StoreId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing store id")
+	}
+	opts := getOpts(opt...)
+
+	c = c.clone()
+
+	var updateKubeconfig bool
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(nameField, f):
+		case strings.EqualFold(descriptionField, f):
+		case strings.EqualFold(kubeconfigField, f):
+			updateKubeconfig = true
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
+		}
+	}
+	dbMask, nullFields := dbw.BuildUpdatePaths(
+		map[string]any{
+			nameField:        c.Name,
+			descriptionField: c.Description,
+			kubeconfigField:  c.Kubeconfig,
+		},
+		fieldMaskPaths,
+		nil,
+	)
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
+	}
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+
+	if updateKubeconfig {
+		// Kubeconfig has been updated, re-encrypt and recalculate hmac
+		if err := c.encrypt(ctx, databaseWrapper); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+
+		// Set KubeconfigHmac and KubeconfigEncrypted masks for update.
+		dbMask = append(dbMask, "KubeconfigHmac", "KubeconfigEncrypted", "KeyId")
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected,
+			errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsUpdated int
+	var returnedCredential *KubeconfigCredential
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			returnedCredential = c.clone()
+			var err error
+			rowsUpdated, err = w.Update(ctx, returnedCredential,
+				dbMask, nullFields,
+				db.WithOplog(oplogWrapper, returnedCredential.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+			if rowsUpdated == 1 {
+				cv := allocKubeconfigCredential()
+				cv.PublicId = returnedCredential.PublicId
+				if err := insertCredentialVersion(ctx, reader, w, databaseWrapper, cv, opts.withAuthorId); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+
+	// Clear kubeconfig fields, only KubeconfigHmac should be returned
+	returnedCredential.KubeconfigEncrypted = nil
+	returnedCredential.Kubeconfig = nil
+
+	return returnedCredential, rowsUpdated, nil
+}
+
 // ListCredentials returns a slice of static credentials
 // for the storeId. Supports the following options:
 //   - credential.WithLimit
@@ -875,6 +1387,16 @@ func (r *Repository) DeleteCredential(ctx context.Context, projectId, id string,
 		c.PublicId = id
 		input = c
 		md = c.oplog(oplog.OpType_OP_TYPE_DELETE)
+	case credential.TlsClientCertificateSubtype:
+		c := allocTlsClientCertificateCredential()
+		c.PublicId = id
+		input = c
+		md = c.oplog(oplog.OpType_OP_TYPE_DELETE)
+	case credential.KubeconfigSubtype:
+		c := allocKubeconfigCredential()
+		c.PublicId = id
+		input = c
+		md = c.oplog(oplog.OpType_OP_TYPE_DELETE)
 	default:
 		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "unknown type")
 	}
@@ -953,6 +1475,20 @@ func (r *Repository) ListDeletedCredentialIds(ctx context.Context, since time.Ti
 		for _, cl := range deletedSSHPrivateKeyCredentials {
 			credentialStoreIds = append(credentialStoreIds, cl.PublicId)
 		}
+		var deletedTlsClientCertificateCredentials []*deletedTlsClientCertificateCredential
+		if err := r.SearchWhere(ctx, &deletedTlsClientCertificateCredentials, "delete_time >= ?", []any{since}); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query deleted tls client certificate credentials"))
+		}
+		for _, cl := range deletedTlsClientCertificateCredentials {
+			credentialStoreIds = append(credentialStoreIds, cl.PublicId)
+		}
+		var deletedKubeconfigCredentials []*deletedKubeconfigCredential
+		if err := r.SearchWhere(ctx, &deletedKubeconfigCredentials, "delete_time >= ?", []any{since}); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query deleted kubeconfig credentials"))
+		}
+		for _, cl := range deletedKubeconfigCredentials {
+			credentialStoreIds = append(credentialStoreIds, cl.PublicId)
+		}
 		var err error
 		transactionTimestamp, err = r.Now(ctx)
 		if err != nil {
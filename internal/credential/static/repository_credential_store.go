@@ -22,7 +22,8 @@ import (
 //
 // Both cs.Name and cs.Description are optional. If cs.Name is set, it must
 // be unique within cs.ProjectId. Both cs.CreateTime and cs.UpdateTime are
-// ignored.
+// ignored. If cs.RotationPeriod is greater than zero or cs.RotateOnCheckin is
+// true, cs.Rotator must be set.
 func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialStore, _ ...Option) (*CredentialStore, error) {
 	const op = "static.(Repository).CreateCredentialStore"
 	if cs == nil {
@@ -37,6 +38,12 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 	if cs.PublicId != "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
 	}
+	if cs.RotationPeriod > 0 && cs.Rotator == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "rotation period requires a rotator")
+	}
+	if cs.RotateOnCheckin && cs.Rotator == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "rotate on checkin requires a rotator")
+	}
 
 	cs = cs.clone()
 	id, err := newCredentialStoreId(ctx)
@@ -95,8 +102,11 @@ func (r *Repository) LookupCredentialStore(ctx context.Context, publicId string,
 // new CredentialStore containing the updated values and a count of the
 // number of records updated. cs is not changed.
 //
-// cs must contain a valid PublicId. Only Name and Description can be changed. If cs.Name
-// is set to a non-empty string, it must be unique within cs.ProjectId.
+// cs must contain a valid PublicId. Only Name, Description, RotationPeriod,
+// RotationGracePeriod, Rotator, ExclusiveCheckout and RotateOnCheckin can be
+// changed. If cs.Name is set to a non-empty string, it must be unique within
+// cs.ProjectId. The Rotator cannot be unset while the RotationPeriod is
+// greater than zero or RotateOnCheckin is true.
 //
 // An attribute of cs will be set to NULL in the database if the attribute
 // in cs is the zero value and it is included in fieldMaskPaths.
@@ -123,17 +133,27 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 		switch {
 		case strings.EqualFold(nameField, f):
 		case strings.EqualFold(descriptionField, f):
+		case strings.EqualFold(RotationPeriodField, f):
+		case strings.EqualFold(RotationGracePeriodField, f):
+		case strings.EqualFold(RotatorField, f):
+		case strings.EqualFold(ExclusiveCheckoutField, f):
+		case strings.EqualFold(RotateOnCheckinField, f):
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
 		}
 	}
 	dbMask, nullFields := dbw.BuildUpdatePaths(
 		map[string]any{
-			nameField:        cs.Name,
-			descriptionField: cs.Description,
+			nameField:                cs.Name,
+			descriptionField:         cs.Description,
+			RotationPeriodField:      cs.RotationPeriod,
+			RotationGracePeriodField: cs.RotationGracePeriod,
+			RotatorField:             cs.Rotator,
+			ExclusiveCheckoutField:   cs.ExclusiveCheckout,
+			RotateOnCheckinField:     cs.RotateOnCheckin,
 		},
 		fieldMaskPaths,
-		nil,
+		[]string{RotationPeriodField, RotationGracePeriodField, ExclusiveCheckoutField, RotateOnCheckinField},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
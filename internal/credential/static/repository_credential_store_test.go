@@ -242,6 +242,23 @@ func TestRepository_UpdateCredentialStore(t *testing.T) {
 		}
 	}
 
+	changeRotation := func(period, grace uint32, rotator string) func(*CredentialStore) *CredentialStore {
+		return func(cs *CredentialStore) *CredentialStore {
+			cs.RotationPeriod = period
+			cs.RotationGracePeriod = grace
+			cs.Rotator = rotator
+			return cs
+		}
+	}
+
+	changeCheckout := func(exclusive, rotate bool) func(*CredentialStore) *CredentialStore {
+		return func(cs *CredentialStore) *CredentialStore {
+			cs.ExclusiveCheckout = exclusive
+			cs.RotateOnCheckin = rotate
+			return cs
+		}
+	}
+
 	makeNil := func() func(*CredentialStore) *CredentialStore {
 		return func(cs *CredentialStore) *CredentialStore {
 			return nil
@@ -476,6 +493,107 @@ func TestRepository_UpdateCredentialStore(t *testing.T) {
 			},
 			wantCount: 1,
 		},
+		{
+			name: "add-rotation-policy",
+			orig: &CredentialStore{
+				CredentialStore: &store.CredentialStore{
+					Name: "test-name-repo",
+				},
+			},
+			chgFn: changeRotation(3600, 300, "ssh-rotator"),
+			masks: []string{"RotationPeriod", "RotationGracePeriod", "Rotator"},
+			want: &CredentialStore{
+				CredentialStore: &store.CredentialStore{
+					Name:                "test-name-repo",
+					RotationPeriod:      3600,
+					RotationGracePeriod: 300,
+					Rotator:             "ssh-rotator",
+				},
+			},
+			wantCount: 1,
+		},
+		{
+			name: "disable-rotation-policy",
+			orig: &CredentialStore{
+				CredentialStore: &store.CredentialStore{
+					Name:                "test-name-repo",
+					RotationPeriod:      3600,
+					RotationGracePeriod: 300,
+					Rotator:             "ssh-rotator",
+				},
+			},
+			chgFn: changeRotation(0, 0, "ssh-rotator"),
+			masks: []string{"RotationPeriod", "RotationGracePeriod"},
+			want: &CredentialStore{
+				CredentialStore: &store.CredentialStore{
+					Name:    "test-name-repo",
+					Rotator: "ssh-rotator",
+				},
+			},
+			wantCount: 1,
+		},
+		{
+			name: "rotation-period-without-rotator",
+			orig: &CredentialStore{
+				CredentialStore: &store.CredentialStore{
+					Name: "test-name-repo",
+				},
+			},
+			chgFn:   changeRotation(3600, 0, ""),
+			masks:   []string{"RotationPeriod"},
+			wantErr: errors.CheckConstraint,
+		},
+		{
+			name: "enable-exclusive-checkout",
+			orig: &CredentialStore{
+				CredentialStore: &store.CredentialStore{
+					Name:    "test-name-repo",
+					Rotator: "ssh-rotator",
+				},
+			},
+			chgFn: changeCheckout(true, true),
+			masks: []string{"ExclusiveCheckout", "RotateOnCheckin"},
+			want: &CredentialStore{
+				CredentialStore: &store.CredentialStore{
+					Name:              "test-name-repo",
+					Rotator:           "ssh-rotator",
+					ExclusiveCheckout: true,
+					RotateOnCheckin:   true,
+				},
+			},
+			wantCount: 1,
+		},
+		{
+			name: "disable-exclusive-checkout",
+			orig: &CredentialStore{
+				CredentialStore: &store.CredentialStore{
+					Name:              "test-name-repo",
+					Rotator:           "ssh-rotator",
+					ExclusiveCheckout: true,
+					RotateOnCheckin:   true,
+				},
+			},
+			chgFn: changeCheckout(false, false),
+			masks: []string{"ExclusiveCheckout", "RotateOnCheckin"},
+			want: &CredentialStore{
+				CredentialStore: &store.CredentialStore{
+					Name:    "test-name-repo",
+					Rotator: "ssh-rotator",
+				},
+			},
+			wantCount: 1,
+		},
+		{
+			name: "rotate-on-checkin-without-rotator",
+			orig: &CredentialStore{
+				CredentialStore: &store.CredentialStore{
+					Name: "test-name-repo",
+				},
+			},
+			chgFn:   changeCheckout(true, true),
+			masks:   []string{"RotateOnCheckin"},
+			wantErr: errors.CheckConstraint,
+		},
 	}
 
 	for _, tt := range tests {
@@ -527,6 +645,12 @@ func TestRepository_UpdateCredentialStore(t *testing.T) {
 				assert.Equal(tt.want.Description, got.Description)
 			}
 
+			assert.Equal(tt.want.RotationPeriod, got.RotationPeriod)
+			assert.Equal(tt.want.RotationGracePeriod, got.RotationGracePeriod)
+			assert.Equal(tt.want.Rotator, got.Rotator)
+			assert.Equal(tt.want.ExclusiveCheckout, got.ExclusiveCheckout)
+			assert.Equal(tt.want.RotateOnCheckin, got.RotateOnCheckin)
+
 			if tt.wantCount > 0 {
 				assert.NoError(db.TestVerifyOplog(t, rw, got.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_UPDATE), db.WithCreateNotBefore(10*time.Second)))
 			}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"google.golang.org/protobuf/proto"
+)
+
+// ListCredentialVersions returns the versions of the static credential
+// credentialId, newest first. The encrypted attributes of each version are
+// not returned. Supports the WithLimit option.
+func (r *Repository) ListCredentialVersions(ctx context.Context, credentialId string, opt ...Option) ([]*CredentialVersion, error) {
+	const op = "static.(Repository).ListCredentialVersions"
+	if credentialId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+
+	var versions []*CredentialVersion
+	if err := r.reader.SearchWhere(ctx, &versions, "credential_id = ?", []any{credentialId}, db.WithOrder("version desc"), db.WithLimit(limit)); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", credentialId)))
+	}
+	for _, v := range versions {
+		// Clear data fields, the attributes of a version are never returned
+		v.CtData = nil
+		v.Data = nil
+	}
+	return versions, nil
+}
+
+// RollbackCredential sets the attributes of the static credential
+// credentialId back to the attributes recorded in its version toVersion.
+// version must match the current version of the credential. The rollback is
+// an update of the credential, so it creates a new version whose author is
+// set by the WithAuthorId option. It returns the updated credential and a
+// count of the number of records updated.
+func (r *Repository) RollbackCredential(ctx context.Context,
+	projectId, credentialId string,
+	toVersion, version uint32,
+	opt ...Option,
+) (credential.Static, int, error) {
+	const op = "static.(Repository).RollbackCredential"
+	switch {
+	case projectId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	case credentialId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidPublicId, op, "missing credential id")
+	case toVersion == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version to roll back to")
+	case version == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	}
+
+	cur, err := r.LookupCredential(ctx, credentialId)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if cur == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("credential %s not found", credentialId))
+	}
+
+	v := allocCredentialVersion()
+	if err := r.reader.LookupWhere(ctx, v, "credential_id = ? and version = ?", []any{credentialId, toVersion}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("version %d of credential %s not found", toVersion, credentialId))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", credentialId)))
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := v.decrypt(ctx, databaseWrapper); err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
+	unmarshal := func(m proto.Message) error {
+		if err := proto.Unmarshal(v.Data, m); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode))
+		}
+		return nil
+	}
+
+	var cred credential.Static
+	var rowsUpdated int
+	// Every attribute is included in the field mask so optional attributes
+	// which were not set in toVersion are cleared.
+	switch globals.ResourceInfoFromPrefix(credentialId).Subtype {
+	case credential.UsernamePasswordSubtype:
+		var m store.UsernamePasswordCredential
+		if err := unmarshal(&m); err != nil {
+			return nil, db.NoRowsAffected, err
+		}
+		c := allocUsernamePasswordCredential()
+		c.PublicId = credentialId
+		c.StoreId = cur.GetStoreId()
+		c.Username = m.Username
+		c.Password = m.Password
+		updated, n, err := r.UpdateUsernamePasswordCredential(ctx, projectId, c, version,
+			[]string{usernameField, passwordField}, opt...)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		cred, rowsUpdated = updated, n
+
+	case credential.SshPrivateKeySubtype:
+		var m store.SshPrivateKeyCredential
+		if err := unmarshal(&m); err != nil {
+			return nil, db.NoRowsAffected, err
+		}
+		c := allocSshPrivateKeyCredential()
+		c.PublicId = credentialId
+		c.StoreId = cur.GetStoreId()
+		c.Username = m.Username
+		c.PrivateKey = m.PrivateKey
+		c.PrivateKeyPassphrase = m.PrivateKeyPassphrase
+		updated, n, err := r.UpdateSshPrivateKeyCredential(ctx, projectId, c, version,
+			[]string{usernameField, privateKeyField, PrivateKeyPassphraseField}, opt...)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		cred, rowsUpdated = updated, n
+
+	case credential.JsonSubtype:
+		var m store.JsonCredential
+		if err := unmarshal(&m); err != nil {
+			return nil, db.NoRowsAffected, err
+		}
+		c := allocJsonCredential()
+		c.PublicId = credentialId
+		c.StoreId = cur.GetStoreId()
+		c.Object = m.Object
+		updated, n, err := r.UpdateJsonCredential(ctx, projectId, c, version,
+			[]string{objectField}, opt...)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		cred, rowsUpdated = updated, n
+
+	case credential.TlsClientCertificateSubtype:
+		var m store.TlsClientCertificateCredential
+		if err := unmarshal(&m); err != nil {
+			return nil, db.NoRowsAffected, err
+		}
+		c := allocTlsClientCertificateCredential()
+		c.PublicId = credentialId
+		c.StoreId = cur.GetStoreId()
+		c.Certificate = m.Certificate
+		c.CaCertificate = m.CaCertificate
+		c.PrivateKey = m.PrivateKey
+		updated, n, err := r.UpdateTlsClientCertificateCredential(ctx, projectId, c, version,
+			[]string{certificateField, caCertificateField, privateKeyField}, opt...)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		cred, rowsUpdated = updated, n
+
+	case credential.KubeconfigSubtype:
+		var m store.KubeconfigCredential
+		if err := unmarshal(&m); err != nil {
+			return nil, db.NoRowsAffected, err
+		}
+		c := allocKubeconfigCredential()
+		c.PublicId = credentialId
+		c.StoreId = cur.GetStoreId()
+		c.Kubeconfig = m.Kubeconfig
+		updated, n, err := r.UpdateKubeconfigCredential(ctx, projectId, c, version,
+			[]string{kubeconfigField}, opt...)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		cred, rowsUpdated = updated, n
+
+	default:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential type for: %s", credentialId))
+	}
+	return cred, rowsUpdated, nil
+}
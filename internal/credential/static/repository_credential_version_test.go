@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CredentialVersions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, prj := iam.TestScopes(t, iamRepo)
+	user := iam.TestUser(t, iamRepo, org.GetPublicId())
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+
+	kkms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kkms)
+	require.NoError(t, err)
+
+	in, err := NewUsernamePasswordCredential(cs.GetPublicId(), "user", "first")
+	require.NoError(t, err)
+	created, err := repo.CreateUsernamePasswordCredential(ctx, prj.GetPublicId(), in, WithAuthorId(user.GetPublicId()))
+	require.NoError(t, err)
+
+	upd := created.clone()
+	upd.Password = []byte("second")
+	updated, n, err := repo.UpdateUsernamePasswordCredential(ctx, prj.GetPublicId(), upd, created.GetVersion(), []string{passwordField})
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	t.Run("list", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		versions, err := repo.ListCredentialVersions(ctx, created.GetPublicId())
+		require.NoError(err)
+		require.Len(versions, 2)
+
+		assert.Equal(updated.GetVersion(), versions[0].GetVersion())
+		assert.Empty(versions[0].GetAuthorId())
+		assert.Equal(created.GetVersion(), versions[1].GetVersion())
+		assert.Equal(user.GetPublicId(), versions[1].GetAuthorId())
+		for _, v := range versions {
+			assert.Equal(created.GetPublicId(), v.GetCredentialId())
+			assert.NotNil(v.GetCreateTime())
+			assert.Empty(v.GetData())
+			assert.Empty(v.GetCtData())
+		}
+	})
+
+	t.Run("list-missing-id", func(t *testing.T) {
+		_, err := repo.ListCredentialVersions(ctx, "")
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	})
+
+	t.Run("rollback-unknown-version", func(t *testing.T) {
+		_, _, err := repo.RollbackCredential(ctx, prj.GetPublicId(), created.GetPublicId(), 1000, updated.GetVersion())
+		assert.Truef(t, errors.IsNotFoundError(err), "unexpected error: %v", err)
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, n, err := repo.RollbackCredential(ctx, prj.GetPublicId(), created.GetPublicId(), created.GetVersion(), updated.GetVersion(), WithAuthorId(user.GetPublicId()))
+		require.NoError(err)
+		assert.Equal(1, n)
+		require.NotNil(got)
+
+		rolledBack, ok := got.(*UsernamePasswordCredential)
+		require.True(ok)
+		assert.Equal(created.GetPasswordHmac(), rolledBack.GetPasswordHmac())
+		assert.Greater(rolledBack.GetVersion(), updated.GetVersion())
+
+		versions, err := repo.ListCredentialVersions(ctx, created.GetPublicId())
+		require.NoError(err)
+		require.Len(versions, 3)
+		assert.Equal(rolledBack.GetVersion(), versions[0].GetVersion())
+		assert.Equal(user.GetPublicId(), versions[0].GetAuthorId())
+	})
+
+	t.Run("rollback-wrong-version", func(t *testing.T) {
+		_, n, err := repo.RollbackCredential(ctx, prj.GetPublicId(), created.GetPublicId(), created.GetVersion(), created.GetVersion())
+		assert.NoError(t, err)
+		assert.Equal(t, 0, n)
+	})
+}
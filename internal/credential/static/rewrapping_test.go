@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/go-kms-wrapping/extras/kms/v2/migrations"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestRewrap_credStaticUsernamePasswordRewrapFn(t *testing.T) {
@@ -256,3 +257,176 @@ func TestRewrap_credStaticJsonRewrapFn(t *testing.T) {
 		assert.Equal(t, cred.GetObjectHmac(), got.GetObjectHmac())
 	})
 }
+
+func TestRewrap_credStaticTlsClientCertificateRewrapFn(t *testing.T) {
+	ctx := context.Background()
+	t.Run("errors-on-query-error", func(t *testing.T) {
+		conn, mock := db.TestSetupWithMock(t)
+		wrapper := db.TestWrapper(t)
+		mock.ExpectQuery(
+			`SELECT \* FROM "kms_schema_version" WHERE 1=1 ORDER BY "kms_schema_version"\."version" LIMIT \$1`,
+		).WillReturnRows(sqlmock.NewRows([]string{"version", "create_time"}).AddRow(migrations.Version, time.Now()))
+		mock.ExpectQuery(
+			`SELECT \* FROM "kms_oplog_schema_version" WHERE 1=1 ORDER BY "kms_oplog_schema_version"."version" LIMIT \$1`,
+		).WillReturnRows(sqlmock.NewRows([]string{"version", "create_time"}).AddRow(migrations.Version, time.Now()))
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		rw := db.New(conn)
+		mock.ExpectQuery(
+			`select distinct tls\.public_id, tls\.private_key_encrypted, tls\.key_id from credential_static_tls_client_certificate_credential tls inner join credential_static_store store on store\.public_id = tls\.store_id where store\.project_id = \$1 and tls\.key_id = \$2;`,
+		).WillReturnError(errors.New("Query error"))
+		err := credStaticTlsClientCertificateRewrapFn(ctx, "some_id", "some_scope", rw, rw, kmsCache)
+		require.Error(t, err)
+	})
+	t.Run("success", func(t *testing.T) {
+		conn, _ := db.TestSetup(t, "postgres")
+		wrapper := db.TestWrapper(t)
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		rw := db.New(conn)
+
+		_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+		cs := TestCredentialStore(t, conn, wrapper, prj.PublicId)
+		certificate, privateKey := TestTlsClientCertificate(t)
+		cred := TestTlsClientCertificateCredential(t, conn, wrapper, certificate, privateKey, cs.GetPublicId(), prj.PublicId)
+
+		// now things are stored in the db, we can rotate and rewrap
+		assert.NoError(t, kmsCache.RotateKeys(ctx, prj.PublicId))
+		assert.NoError(t, credStaticTlsClientCertificateRewrapFn(ctx, cred.GetKeyId(), prj.PublicId, rw, rw, kmsCache))
+
+		// now we pull the credential back from the db, decrypt it with the new key, and ensure things match
+		got := allocTlsClientCertificateCredential()
+		got.PublicId = cred.PublicId
+		assert.NoError(t, rw.LookupById(ctx, got))
+
+		kmsWrapper, err := kmsCache.GetWrapper(context.Background(), prj.PublicId, kms.KeyPurposeDatabase, kms.WithKeyId(got.GetKeyId()))
+		assert.NoError(t, err)
+		newKeyVersionId, err := kmsWrapper.KeyId(ctx)
+		assert.NoError(t, err)
+
+		// decrypt with the new key version and check to make sure things match
+		assert.NoError(t, got.decrypt(ctx, kmsWrapper))
+		assert.NotEmpty(t, got.GetKeyId())
+		assert.NotEqual(t, cred.GetKeyId(), got.GetKeyId())
+		assert.Equal(t, newKeyVersionId, got.GetKeyId())
+		assert.Equal(t, []byte(privateKey), got.GetPrivateKey())
+		assert.NotEmpty(t, got.GetPrivateKeyHmac())
+		assert.Equal(t, cred.GetPrivateKeyHmac(), got.GetPrivateKeyHmac())
+	})
+}
+
+func TestRewrap_credStaticKubeconfigRewrapFn(t *testing.T) {
+	ctx := context.Background()
+	t.Run("errors-on-query-error", func(t *testing.T) {
+		conn, mock := db.TestSetupWithMock(t)
+		wrapper := db.TestWrapper(t)
+		mock.ExpectQuery(
+			`SELECT \* FROM "kms_schema_version" WHERE 1=1 ORDER BY "kms_schema_version"\."version" LIMIT \$1`,
+		).WillReturnRows(sqlmock.NewRows([]string{"version", "create_time"}).AddRow(migrations.Version, time.Now()))
+		mock.ExpectQuery(
+			`SELECT \* FROM "kms_oplog_schema_version" WHERE 1=1 ORDER BY "kms_oplog_schema_version"."version" LIMIT \$1`,
+		).WillReturnRows(sqlmock.NewRows([]string{"version", "create_time"}).AddRow(migrations.Version, time.Now()))
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		rw := db.New(conn)
+		mock.ExpectQuery(
+			`select distinct kc\.public_id, kc\.kubeconfig_encrypted, kc\.key_id from credential_static_kubeconfig_credential kc inner join credential_static_store store on store\.public_id = kc\.store_id where store\.project_id = \$1 and kc\.key_id = \$2;`,
+		).WillReturnError(errors.New("Query error"))
+		err := credStaticKubeconfigRewrapFn(ctx, "some_id", "some_scope", rw, rw, kmsCache)
+		require.Error(t, err)
+	})
+	t.Run("success", func(t *testing.T) {
+		conn, _ := db.TestSetup(t, "postgres")
+		wrapper := db.TestWrapper(t)
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		rw := db.New(conn)
+
+		_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+		cs := TestCredentialStore(t, conn, wrapper, prj.PublicId)
+		kubeconfig := credential.Kubeconfig("apiVersion: v1\nkind: Config\n")
+		cred := TestKubeconfigCredential(t, conn, wrapper, kubeconfig, cs.GetPublicId(), prj.PublicId)
+
+		// now things are stored in the db, we can rotate and rewrap
+		assert.NoError(t, kmsCache.RotateKeys(ctx, prj.PublicId))
+		assert.NoError(t, credStaticKubeconfigRewrapFn(ctx, cred.GetKeyId(), prj.PublicId, rw, rw, kmsCache))
+
+		// now we pull the credential back from the db, decrypt it with the new key, and ensure things match
+		got := allocKubeconfigCredential()
+		got.PublicId = cred.PublicId
+		assert.NoError(t, rw.LookupById(ctx, got))
+
+		kmsWrapper, err := kmsCache.GetWrapper(context.Background(), prj.PublicId, kms.KeyPurposeDatabase, kms.WithKeyId(got.GetKeyId()))
+		assert.NoError(t, err)
+		newKeyVersionId, err := kmsWrapper.KeyId(ctx)
+		assert.NoError(t, err)
+
+		// decrypt with the new key version and check to make sure things match
+		assert.NoError(t, got.decrypt(ctx, kmsWrapper))
+		assert.NotEmpty(t, got.GetKeyId())
+		assert.NotEqual(t, cred.GetKeyId(), got.GetKeyId())
+		assert.Equal(t, newKeyVersionId, got.GetKeyId())
+		assert.Equal(t, []byte(kubeconfig), got.GetKubeconfig())
+		assert.NotEmpty(t, got.GetKubeconfigHmac())
+		assert.Equal(t, cred.GetKubeconfigHmac(), got.GetKubeconfigHmac())
+	})
+}
+
+func TestRewrap_credStaticVersionRewrapFn(t *testing.T) {
+	ctx := context.Background()
+	t.Run("errors-on-query-error", func(t *testing.T) {
+		conn, mock := db.TestSetupWithMock(t)
+		wrapper := db.TestWrapper(t)
+		mock.ExpectQuery(
+			`SELECT \* FROM "kms_schema_version" WHERE 1=1 ORDER BY "kms_schema_version"\."version" LIMIT \$1`,
+		).WillReturnRows(sqlmock.NewRows([]string{"version", "create_time"}).AddRow(migrations.Version, time.Now()))
+		mock.ExpectQuery(
+			`SELECT \* FROM "kms_oplog_schema_version" WHERE 1=1 ORDER BY "kms_oplog_schema_version"."version" LIMIT \$1`,
+		).WillReturnRows(sqlmock.NewRows([]string{"version", "create_time"}).AddRow(migrations.Version, time.Now()))
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		rw := db.New(conn)
+		mock.ExpectQuery(
+			`select distinct ver\.credential_id, ver\.version, ver\.data, ver\.key_id from credential_static_version ver inner join credential_static cred on cred\.public_id = ver\.credential_id inner join credential_static_store store on store\.public_id = cred\.store_id where store\.project_id = \$1 and ver\.key_id = \$2;`,
+		).WillReturnError(errors.New("Query error"))
+		err := credStaticVersionRewrapFn(ctx, "some_id", "some_scope", rw, rw, kmsCache)
+		require.Error(t, err)
+	})
+	t.Run("success", func(t *testing.T) {
+		conn, _ := db.TestSetup(t, "postgres")
+		wrapper := db.TestWrapper(t)
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		rw := db.New(conn)
+		repo, err := NewRepository(ctx, rw, rw, kmsCache)
+		require.NoError(t, err)
+
+		_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+		cs := TestCredentialStore(t, conn, wrapper, prj.PublicId)
+		in, err := NewUsernamePasswordCredential(cs.GetPublicId(), "username", "password")
+		require.NoError(t, err)
+		cred, err := repo.CreateUsernamePasswordCredential(ctx, prj.PublicId, in)
+		require.NoError(t, err)
+
+		orig := allocCredentialVersion()
+		require.NoError(t, rw.LookupWhere(ctx, orig, "credential_id = ?", []any{cred.GetPublicId()}))
+
+		// now things are stored in the db, we can rotate and rewrap
+		assert.NoError(t, kmsCache.RotateKeys(ctx, prj.PublicId))
+		assert.NoError(t, credStaticVersionRewrapFn(ctx, orig.GetKeyId(), prj.PublicId, rw, rw, kmsCache))
+
+		// now we pull the version back from the db, decrypt it with the new key, and ensure things match
+		got := allocCredentialVersion()
+		require.NoError(t, rw.LookupWhere(ctx, got, "credential_id = ?", []any{cred.GetPublicId()}))
+
+		kmsWrapper, err := kmsCache.GetWrapper(context.Background(), prj.PublicId, kms.KeyPurposeDatabase, kms.WithKeyId(got.GetKeyId()))
+		assert.NoError(t, err)
+		newKeyVersionId, err := kmsWrapper.KeyId(ctx)
+		assert.NoError(t, err)
+
+		// decrypt with the new key version and check to make sure things match
+		assert.NoError(t, got.decrypt(ctx, kmsWrapper))
+		assert.NotEmpty(t, got.GetKeyId())
+		assert.NotEqual(t, orig.GetKeyId(), got.GetKeyId())
+		assert.Equal(t, newKeyVersionId, got.GetKeyId())
+
+		var data store.UsernamePasswordCredential
+		require.NoError(t, proto.Unmarshal(got.GetData(), &data))
+		assert.Equal(t, "username", data.GetUsername())
+		assert.Equal(t, []byte("password"), data.GetPassword())
+	})
+}
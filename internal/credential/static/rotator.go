@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os/exec"
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	rotateAction = "rotate"
+	retireAction = "retire"
+
+	generatedPasswordLength = 32
+	generatedPasswordChars  = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+// A RotateRequest contains the current and the new version of a static
+// credential being rotated. Only the fields of the credential's type are
+// set.
+type RotateRequest struct {
+	CredentialId     string                 `json:"credential_id"`
+	StoreId          string                 `json:"store_id"`
+	CredentialType   globals.CredentialType `json:"credential_type"`
+	Username         string                 `json:"username"`
+	CurrentPassword  string                 `json:"current_password,omitempty"`
+	NewPassword      string                 `json:"new_password,omitempty"`
+	CurrentPublicKey string                 `json:"current_public_key,omitempty"`
+	NewPublicKey     string                 `json:"new_public_key,omitempty"`
+}
+
+// A RetireRequest identifies the previous version of a static credential
+// whose grace period has ended. PreviousPublicKey is only set for ssh
+// private key credentials.
+type RetireRequest struct {
+	CredentialId      string                 `json:"credential_id"`
+	StoreId           string                 `json:"store_id"`
+	CredentialType    globals.CredentialType `json:"credential_type"`
+	Username          string                 `json:"username"`
+	PreviousPublicKey string                 `json:"previous_public_key,omitempty"`
+}
+
+// A Rotator pushes rotated static credentials to the hosts that use them.
+type Rotator interface {
+	// Rotate installs the new version of a credential on the target
+	// host. The current version must remain valid until Retire is
+	// called for the credential.
+	Rotate(ctx context.Context, req *RotateRequest) error
+
+	// Retire invalidates the previous version of a credential on the
+	// target host.
+	Retire(ctx context.Context, req *RetireRequest) error
+}
+
+// CommandRotator is a Rotator that runs a local command. The request is
+// written to the standard input of the command as a JSON object with an
+// additional "action" field set to either "rotate" or "retire". A non-zero
+// exit status is reported as an error.
+type CommandRotator struct {
+	Command string
+	Args    []string
+}
+
+var _ Rotator = (*CommandRotator)(nil)
+
+// NewCommandRotator creates a new CommandRotator that runs command with
+// args.
+func NewCommandRotator(ctx context.Context, command string, args ...string) (*CommandRotator, error) {
+	const op = "static.NewCommandRotator"
+	if command == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing command")
+	}
+	return &CommandRotator{
+		Command: command,
+		Args:    args,
+	}, nil
+}
+
+// Rotate runs the command with the "rotate" action.
+func (r *CommandRotator) Rotate(ctx context.Context, req *RotateRequest) error {
+	const op = "static.(CommandRotator).Rotate"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing request")
+	}
+	in := struct {
+		Action string `json:"action"`
+		*RotateRequest
+	}{rotateAction, req}
+	if err := r.run(ctx, in); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// Retire runs the command with the "retire" action.
+func (r *CommandRotator) Retire(ctx context.Context, req *RetireRequest) error {
+	const op = "static.(CommandRotator).Retire"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing request")
+	}
+	in := struct {
+		Action string `json:"action"`
+		*RetireRequest
+	}{retireAction, req}
+	if err := r.run(ctx, in); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+func (r *CommandRotator) run(ctx context.Context, in any) error {
+	const op = "static.(CommandRotator).run"
+	b, err := json.Marshal(in)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encode))
+	}
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, r.Command, r.Args...)
+	cmd.Stdin = bytes.NewReader(b)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = "rotator command failed"
+		}
+		return errors.Wrap(ctx, err, op, errors.WithMsg(msg))
+	}
+	return nil
+}
+
+// generatePassword returns a random alphanumeric password.
+func generatePassword(ctx context.Context) (string, error) {
+	const op = "static.generatePassword"
+	max := big.NewInt(int64(len(generatedPasswordChars)))
+	var sb strings.Builder
+	sb.Grow(generatedPasswordLength)
+	for i := 0; i < generatedPasswordLength; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		sb.WriteByte(generatedPasswordChars[n.Int64()])
+	}
+	return sb.String(), nil
+}
+
+// generateSshKeyPair returns a new PEM encoded ed25519 private key and its
+// public key in the authorized_keys format. The private key is encrypted
+// with passphrase if passphrase is not empty.
+func generateSshKeyPair(ctx context.Context, passphrase []byte) ([]byte, string, error) {
+	const op = "static.generateSshKeyPair"
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	var block *pem.Block
+	if len(passphrase) == 0 {
+		block, err = ssh.MarshalPrivateKey(priv, "")
+	} else {
+		block, err = ssh.MarshalPrivateKeyWithPassphrase(priv, "", passphrase)
+	}
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op, errors.WithCode(errors.Encode))
+	}
+	sshPub, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	return pem.EncodeToMemory(block), authorizedKey(sshPub), nil
+}
+
+// sshPublicKey returns the public key, in the authorized_keys format, of the
+// PEM encoded privateKey. It returns an empty string if privateKey cannot be
+// parsed.
+func sshPublicKey(privateKey, passphrase []byte) string {
+	var signer ssh.Signer
+	var err error
+	if len(passphrase) == 0 {
+		signer, err = ssh.ParsePrivateKey(privateKey)
+	} else {
+		signer, err = ssh.ParsePrivateKeyWithPassphrase(privateKey, passphrase)
+	}
+	if err != nil {
+		return ""
+	}
+	return authorizedKey(signer.PublicKey())
+}
+
+func authorizedKey(k ssh.PublicKey) string {
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(k)))
+}
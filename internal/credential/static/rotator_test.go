@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestNewCommandRotator(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	got, err := NewCommandRotator(ctx, "")
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	assert.Nil(t, got)
+
+	got, err = NewCommandRotator(ctx, "rotate.sh", "-v")
+	require.NoError(t, err)
+	assert.Equal(t, &CommandRotator{Command: "rotate.sh", Args: []string{"-v"}}, got)
+}
+
+func TestCommandRotator(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("rotate", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		out := filepath.Join(t.TempDir(), "out.json")
+		r, err := NewCommandRotator(ctx, "sh", "-c", "cat > "+out)
+		require.NoError(err)
+
+		req := &RotateRequest{
+			CredentialId:    "credup_1234567890",
+			StoreId:         "csst_1234567890",
+			CredentialType:  globals.UsernamePasswordCredentialType,
+			Username:        "user",
+			CurrentPassword: "old",
+			NewPassword:     "new",
+		}
+		require.NoError(r.Rotate(ctx, req))
+
+		b, err := os.ReadFile(out)
+		require.NoError(err)
+		var got map[string]any
+		require.NoError(json.Unmarshal(b, &got))
+		assert.Equal(map[string]any{
+			"action":           "rotate",
+			"credential_id":    "credup_1234567890",
+			"store_id":         "csst_1234567890",
+			"credential_type":  "username_password",
+			"username":         "user",
+			"current_password": "old",
+			"new_password":     "new",
+		}, got)
+	})
+
+	t.Run("retire", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		out := filepath.Join(t.TempDir(), "out.json")
+		r, err := NewCommandRotator(ctx, "sh", "-c", "cat > "+out)
+		require.NoError(err)
+
+		req := &RetireRequest{
+			CredentialId:      "credspk_1234567890",
+			StoreId:           "csst_1234567890",
+			CredentialType:    globals.SshPrivateKeyCredentialType,
+			Username:          "user",
+			PreviousPublicKey: "ssh-ed25519 AAAA",
+		}
+		require.NoError(r.Retire(ctx, req))
+
+		b, err := os.ReadFile(out)
+		require.NoError(err)
+		var got map[string]any
+		require.NoError(json.Unmarshal(b, &got))
+		assert.Equal(map[string]any{
+			"action":              "retire",
+			"credential_id":       "credspk_1234567890",
+			"store_id":            "csst_1234567890",
+			"credential_type":     "ssh_private_key",
+			"username":            "user",
+			"previous_public_key": "ssh-ed25519 AAAA",
+		}, got)
+	})
+
+	t.Run("command-fails", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		r, err := NewCommandRotator(ctx, "sh", "-c", "echo host unreachable >&2; exit 1")
+		require.NoError(err)
+
+		err = r.Rotate(ctx, &RotateRequest{CredentialId: "credup_1234567890"})
+		require.Error(err)
+		assert.Contains(err.Error(), "host unreachable")
+	})
+
+	t.Run("nil-request", func(t *testing.T) {
+		assert := assert.New(t)
+		r := &CommandRotator{Command: "true"}
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), r.Rotate(ctx, nil)), "expected invalid parameter")
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), r.Retire(ctx, nil)), "expected invalid parameter")
+	})
+}
+
+func TestGeneratePassword(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	p1, err := generatePassword(ctx)
+	require.NoError(t, err)
+	p2, err := generatePassword(ctx)
+	require.NoError(t, err)
+	assert.Len(t, p1, generatedPasswordLength)
+	assert.NotEqual(t, p1, p2)
+	for _, c := range p1 {
+		assert.Contains(t, generatedPasswordChars, string(c))
+	}
+}
+
+func TestGenerateSshKeyPair(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		passphrase []byte
+	}{
+		{
+			name: "no-passphrase",
+		},
+		{
+			name:       "with-passphrase",
+			passphrase: []byte("passphrase"),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			priv, pub, err := generateSshKeyPair(ctx, tt.passphrase)
+			require.NoError(err)
+
+			if len(tt.passphrase) > 0 {
+				_, err := ssh.ParsePrivateKey(priv)
+				assert.Error(err)
+			}
+			assert.Equal(pub, sshPublicKey(priv, tt.passphrase))
+			_, _, _, _, err = ssh.ParseAuthorizedKey([]byte(pub))
+			assert.NoError(err)
+		})
+	}
+
+	assert.Empty(t, sshPublicKey([]byte("not a key"), nil))
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestTlsClientCertificateCredential_New(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kkms := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+
+	cert, privKey := TestTlsClientCertificate(t)
+	caCert, otherPrivKey := TestTlsClientCertificate(t)
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.PublicId)
+
+	type args struct {
+		certificate []byte
+		privateKey  credential.PrivateKey
+		storeId     string
+		options     []Option
+	}
+
+	tests := []struct {
+		name           string
+		args           args
+		want           *TlsClientCertificateCredential
+		wantCreateErr  bool
+		wantEncryptErr bool
+		wantAllocError bool
+	}{
+		{
+			name: "missing-private-key",
+			args: args{
+				certificate: cert,
+				storeId:     cs.PublicId,
+			},
+			want:           allocTlsClientCertificateCredential(),
+			wantEncryptErr: true,
+		},
+		{
+			name: "missing-certificate",
+			args: args{
+				privateKey: privKey,
+				storeId:    cs.PublicId,
+			},
+			want:          allocTlsClientCertificateCredential(),
+			wantCreateErr: true,
+		},
+		{
+			name: "missing-store-id",
+			args: args{
+				certificate: cert,
+				privateKey:  privKey,
+			},
+			want:          allocTlsClientCertificateCredential(),
+			wantCreateErr: true,
+		},
+		{
+			name: "bad-certificate",
+			args: args{
+				certificate: []byte("foobar"),
+				privateKey:  privKey,
+				storeId:     cs.PublicId,
+			},
+			wantAllocError: true,
+		},
+		{
+			name: "mismatched-private-key",
+			args: args{
+				certificate: cert,
+				privateKey:  otherPrivKey,
+				storeId:     cs.PublicId,
+			},
+			wantAllocError: true,
+		},
+		{
+			name: "bad-ca-certificate",
+			args: args{
+				certificate: cert,
+				privateKey:  privKey,
+				storeId:     cs.PublicId,
+				options:     []Option{WithCaCertificate([]byte("foobar"))},
+			},
+			wantAllocError: true,
+		},
+		{
+			name: "valid-no-options",
+			args: args{
+				certificate: cert,
+				privateKey:  privKey,
+				storeId:     cs.PublicId,
+			},
+			want: &TlsClientCertificateCredential{
+				TlsClientCertificateCredential: &store.TlsClientCertificateCredential{
+					Certificate: cert,
+					PrivateKey:  privKey,
+					StoreId:     cs.PublicId,
+				},
+			},
+		},
+		{
+			name: "valid-with-ca-certificate",
+			args: args{
+				certificate: cert,
+				privateKey:  privKey,
+				storeId:     cs.PublicId,
+				options:     []Option{WithCaCertificate(caCert), WithName("my-credential")},
+			},
+			want: &TlsClientCertificateCredential{
+				TlsClientCertificateCredential: &store.TlsClientCertificateCredential{
+					Certificate:   cert,
+					CaCertificate: caCert,
+					PrivateKey:    privKey,
+					StoreId:       cs.PublicId,
+					Name:          "my-credential",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			ctx := context.Background()
+
+			got, err := NewTlsClientCertificateCredential(ctx, tt.args.storeId, tt.args.certificate, tt.args.privateKey, tt.args.options...)
+			if tt.wantAllocError {
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			assert.Emptyf(got.PublicId, "PublicId set")
+
+			id, err := credential.NewTlsClientCertificateCredentialId(ctx)
+			require.NoError(err)
+
+			tt.want.PublicId = id
+			got.PublicId = id
+
+			databaseWrapper, err := kkms.GetWrapper(context.Background(), prj.PublicId, kms.KeyPurposeDatabase)
+			require.NoError(err)
+
+			err = got.encrypt(ctx, databaseWrapper)
+			if tt.wantEncryptErr {
+				require.Error(err)
+				return
+			}
+			assert.NoError(err)
+
+			err = rw.Create(context.Background(), got)
+			if tt.wantCreateErr {
+				require.Error(err)
+				return
+			}
+			assert.NoError(err)
+
+			got2 := allocTlsClientCertificateCredential()
+			got2.PublicId = id
+			assert.Equal(id, got2.GetPublicId())
+			require.NoError(rw.LookupById(ctx, got2))
+
+			err = got2.decrypt(ctx, databaseWrapper)
+			require.NoError(err)
+
+			// Timestamps and version are automatically set
+			tt.want.CreateTime = got2.CreateTime
+			tt.want.UpdateTime = got2.UpdateTime
+			tt.want.Version = got2.Version
+
+			// KeyId is allocated via kms no need to validate in this test
+			tt.want.KeyId = got2.KeyId
+			got2.PrivateKeyEncrypted = nil
+
+			// encrypt also calculates the hmac, validate it is correct
+			hm, err := crypto.HmacSha256(ctx, got.PrivateKey, databaseWrapper, []byte(got.StoreId), nil)
+			require.NoError(err)
+			tt.want.PrivateKeyHmac = []byte(hm)
+
+			assert.Empty(cmp.Diff(tt.want, got2.clone(), protocmp.Transform()))
+		})
+	}
+}
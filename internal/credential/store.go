@@ -52,6 +52,31 @@ type StoreListQueryResult struct {
 	ClientCert []byte
 	// Optional client cert key HMAC of the credential store.
 	ClientCertKeyHmac []byte
+	// Optional Vault auth method of the credential store.
+	AuthMethod string
+	// Optional mount path of the Vault auth method of the credential store.
+	AuthMountPath string
+	// Optional role of the Vault auth method of the credential store.
+	AuthRole string
+	// Optional auth secret HMAC of the credential store.
+	AuthSecretHmac []byte
+	// Optional number of seconds between rotations of the credentials in
+	// the credential store.
+	RotationPeriod uint32
+	// Optional number of seconds the previous version of a rotated credential
+	// remains valid.
+	RotationGracePeriod uint32
+	// Optional name of the rotator of the credential store.
+	Rotator string
+	// Optional flag indicating the credentials in the credential store can
+	// only be checked out by one session at a time.
+	ExclusiveCheckout bool
+	// Optional flag indicating checked in credentials are rotated.
+	RotateOnCheckin bool
+	// Optional path of the credential bundle file of the credential store.
+	Path string
+	// Optional path of the age identity file of the credential store.
+	AgeIdentityPath string
 	// The subtype of the credential store.
 	Subtype string
 }
@@ -37,8 +37,9 @@ type CredentialLibrary struct {
 
 // NewCredentialLibrary creates a new in memory CredentialLibrary
 // for a Vault backend at vaultPath assigned to storeId.
-// Name, description, method, request body, credential type, and mapping
-// override are the only valid options. All other options are ignored.
+// Name, description, method, request body, credential type, mapping
+// override, KV v2, KV v2 version, and lease TTL from session are the only
+// valid options. All other options are ignored.
 func NewCredentialLibrary(storeId string, vaultPath string, opt ...Option) (*CredentialLibrary, error) {
 	const op = "vault.NewCredentialLibrary"
 	opts := getOpts(opt...)
@@ -46,13 +47,16 @@ func NewCredentialLibrary(storeId string, vaultPath string, opt ...Option) (*Cre
 	l := &CredentialLibrary{
 		MappingOverride: opts.withMappingOverride,
 		CredentialLibrary: &store.CredentialLibrary{
-			StoreId:         storeId,
-			Name:            opts.withName,
-			Description:     opts.withDescription,
-			VaultPath:       vaultPath,
-			HttpRequestBody: opts.withRequestBody,
-			HttpMethod:      string(opts.withMethod),
-			CredentialType:  string(opts.withCredentialType),
+			StoreId:             storeId,
+			Name:                opts.withName,
+			Description:         opts.withDescription,
+			VaultPath:           vaultPath,
+			HttpRequestBody:     opts.withRequestBody,
+			HttpMethod:          string(opts.withMethod),
+			CredentialType:      string(opts.withCredentialType),
+			KvV2:                opts.withKvV2,
+			KvV2Version:         opts.withKvV2Version,
+			LeaseTtlFromSession: opts.withLeaseTtlFromSession,
 		},
 	}
 
@@ -63,6 +67,10 @@ func (l *CredentialLibrary) validate(ctx context.Context, caller errors.Op) erro
 	switch {
 	case !validMappingOverride(l.MappingOverride, l.CredentialType()):
 		return errors.New(ctx, errors.VaultInvalidMappingOverride, caller, "invalid credential type for mapping override")
+	case l.KvV2 && Method(l.HttpMethod) != MethodGet:
+		return errors.New(ctx, errors.InvalidParameter, caller, "kv v2 requires the GET http method")
+	case l.KvV2Version != 0 && !l.KvV2:
+		return errors.New(ctx, errors.InvalidParameter, caller, "kv v2 version requires kv v2")
 	}
 	return nil
 }
@@ -158,6 +166,9 @@ type listCredentialLibraryResult struct {
 	UpdateTime                *timestamp.Timestamp
 	Version                   int
 	KeyBits                   int
+	KvV2                      bool
+	KvV2Version               int
+	LeaseTtlFromSession       bool
 	Type                      string
 }
 
@@ -167,16 +178,19 @@ func (l *listCredentialLibraryResult) toLibrary(ctx context.Context) (credential
 	case "generic":
 		cl := &CredentialLibrary{
 			CredentialLibrary: &store.CredentialLibrary{
-				PublicId:       l.PublicId,
-				StoreId:        l.StoreId,
-				Name:           l.Name,
-				Description:    l.Description,
-				CreateTime:     l.CreateTime,
-				UpdateTime:     l.UpdateTime,
-				Version:        uint32(l.Version),
-				VaultPath:      l.VaultPath,
-				CredentialType: l.CredentialType,
-				HttpMethod:     l.HttpMethod,
+				PublicId:            l.PublicId,
+				StoreId:             l.StoreId,
+				Name:                l.Name,
+				Description:         l.Description,
+				CreateTime:          l.CreateTime,
+				UpdateTime:          l.UpdateTime,
+				Version:             uint32(l.Version),
+				VaultPath:           l.VaultPath,
+				CredentialType:      l.CredentialType,
+				HttpMethod:          l.HttpMethod,
+				KvV2:                l.KvV2,
+				KvV2Version:         uint32(l.KvV2Version),
+				LeaseTtlFromSession: l.LeaseTtlFromSession,
 			},
 		}
 		// Assign byte slices only if the string isn't empty
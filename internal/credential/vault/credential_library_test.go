@@ -210,6 +210,86 @@ func TestCredentialLibrary_New(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "kv-v2",
+			args: args{
+				storeId:   cs.PublicId,
+				vaultPath: "secret/data/my-secret",
+				opts: []Option{
+					WithMethod(MethodGet),
+					WithKvV2(),
+				},
+			},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:    cs.PublicId,
+					VaultPath:  "secret/data/my-secret",
+					HttpMethod: string(MethodGet),
+					KvV2:       true,
+				},
+			},
+		},
+		{
+			name: "kv-v2-with-version",
+			args: args{
+				storeId:   cs.PublicId,
+				vaultPath: "secret/data/my-secret",
+				opts: []Option{
+					WithMethod(MethodGet),
+					WithKvV2(),
+					WithKvV2Version(2),
+				},
+			},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:     cs.PublicId,
+					VaultPath:   "secret/data/my-secret",
+					HttpMethod:  string(MethodGet),
+					KvV2:        true,
+					KvV2Version: 2,
+				},
+			},
+		},
+		{
+			name: "kv-v2-with-post-method",
+			args: args{
+				storeId:   cs.PublicId,
+				vaultPath: "secret/data/my-secret",
+				opts: []Option{
+					WithMethod(MethodPost),
+					WithKvV2(),
+				},
+			},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:    cs.PublicId,
+					VaultPath:  "secret/data/my-secret",
+					HttpMethod: string(MethodPost),
+					KvV2:       true,
+				},
+			},
+			wantCreateErr: true,
+		},
+		{
+			name: "kv-v2-version-without-kv-v2",
+			args: args{
+				storeId:   cs.PublicId,
+				vaultPath: "secret/data/my-secret",
+				opts: []Option{
+					WithMethod(MethodGet),
+					WithKvV2Version(2),
+				},
+			},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:     cs.PublicId,
+					VaultPath:   "secret/data/my-secret",
+					HttpMethod:  string(MethodGet),
+					KvV2Version: 2,
+				},
+			},
+			wantCreateErr: true,
+		},
 		{
 			name: "credential-type",
 			args: args{
@@ -23,6 +23,7 @@ type CredentialStore struct {
 	clientCert  *ClientCertificate `gorm:"-"`
 	inputToken  TokenSecret        `gorm:"-"`
 	outputToken *Token             `gorm:"-"`
+	auth        *StoreAuth         `gorm:"-"`
 
 	privateClientCert *ClientCertificate `gorm:"-"`
 	privateToken      *Token             `gorm:"-"`
@@ -30,13 +31,15 @@ type CredentialStore struct {
 
 // NewCredentialStore creates a new in memory CredentialStore for a Vault
 // server at vaultAddress assigned to projectId. Name, description, CA cert,
-// client cert, namespace, TLS server name, worker filter, and TLS skip verify are the
-// only valid options. All other options are ignored.
+// client cert, namespace, TLS server name, worker filter, TLS skip verify,
+// and store auth are the only valid options. All other options are ignored.
+// If a StoreAuth is provided, token can be empty.
 func NewCredentialStore(projectId string, vaultAddress string, token TokenSecret, opt ...Option) (*CredentialStore, error) {
 	opts := getOpts(opt...)
 	cs := &CredentialStore{
 		inputToken: token,
 		clientCert: opts.withClientCert,
+		auth:       opts.withStoreAuth,
 		CredentialStore: &store.CredentialStore{
 			ProjectId:     projectId,
 			Name:          opts.withName,
@@ -65,10 +68,15 @@ func (cs *CredentialStore) clone() *CredentialStore {
 	if cs.clientCert != nil {
 		clientCertCopy = cs.clientCert.clone()
 	}
+	var authCopy *StoreAuth
+	if cs.auth != nil {
+		authCopy = cs.auth.clone()
+	}
 	cp := proto.Clone(cs.CredentialStore)
 	return &CredentialStore{
 		inputToken:      tokenCopy,
 		clientCert:      clientCertCopy,
+		auth:            authCopy,
 		CredentialStore: cp.(*store.CredentialStore),
 	}
 }
@@ -117,6 +125,28 @@ func (cs *CredentialStore) applyUpdate(new *CredentialStore, fieldMaskPaths []st
 			cp.inputToken = new.inputToken
 		case strings.EqualFold(workerFilterField, f):
 			cp.WorkerFilter = new.WorkerFilter
+		case strings.EqualFold(AuthMethodField, f):
+			if new.auth == nil {
+				cp.auth = nil
+				continue
+			}
+			if cp.auth == nil {
+				cp.auth = allocStoreAuth()
+				cp.auth.StoreId = cs.GetPublicId()
+			}
+			cp.auth.Method = new.auth.Method
+		case strings.EqualFold(AuthMountPathField, f):
+			if cp.auth != nil && new.auth != nil {
+				cp.auth.MountPath = new.auth.MountPath
+			}
+		case strings.EqualFold(AuthRoleField, f):
+			if cp.auth != nil && new.auth != nil {
+				cp.auth.Role = new.auth.Role
+			}
+		case strings.EqualFold(AuthSecretField, f):
+			if cp.auth != nil && new.auth != nil {
+				cp.auth.Secret = new.auth.Secret
+			}
 		}
 	}
 	return cp
@@ -178,6 +208,12 @@ func (cs *CredentialStore) ClientCertificate() *ClientCertificate {
 	return cs.clientCert
 }
 
+// StoreAuth returns the Vault auth method the credential store uses to log
+// in to Vault if available.
+func (cs *CredentialStore) StoreAuth() *StoreAuth {
+	return cs.auth
+}
+
 func (cs *CredentialStore) client(ctx context.Context) (vaultClient, error) {
 	const op = "vault.(CredentialStore).client"
 	clientConfig := &clientConfig{
@@ -187,6 +223,7 @@ func (cs *CredentialStore) client(ctx context.Context) (vaultClient, error) {
 		TlsServerName: cs.TlsServerName,
 		TlsSkipVerify: cs.TlsSkipVerify,
 		Namespace:     cs.Namespace,
+		Login:         len(cs.inputToken) == 0 && cs.auth != nil,
 	}
 	if cs.clientCert != nil {
 		clientConfig.ClientCert = cs.clientCert.GetCertificate()
@@ -8,9 +8,12 @@ const (
 	nameField        = "Name"
 	descriptionField = "Description"
 
-	vaultPathField       = "VaultPath"
-	httpMethodField      = "HttpMethod"
-	httpRequestBodyField = "HttpRequestBody"
+	vaultPathField           = "VaultPath"
+	httpMethodField          = "HttpMethod"
+	httpRequestBodyField     = "HttpRequestBody"
+	kvV2Field                = "KvV2"
+	kvV2VersionField         = "KvV2Version"
+	leaseTtlFromSessionField = "LeaseTtlFromSession"
 
 	usernameField = "Username"
 	keyTypeField  = "KeyType"
@@ -40,4 +43,17 @@ const (
 	// MappingOverrideField represents the field mask indicating a mapping override
 	// update has been requested.
 	MappingOverrideField = "MappingOverride"
+
+	// AuthMethodField represents the field mask indicating an update of the
+	// Vault auth method of a credential store has been requested.
+	AuthMethodField = "AuthMethod"
+	// AuthMountPathField represents the field mask indicating an update of
+	// the mount path of the Vault auth method has been requested.
+	AuthMountPathField = "AuthMountPath"
+	// AuthRoleField represents the field mask indicating an update of the
+	// role of the Vault auth method has been requested.
+	AuthRoleField = "AuthRole"
+	// AuthSecretField represents the field mask indicating an update of the
+	// secret of the Vault auth method has been requested.
+	AuthSecretField = "AuthSecret"
 )
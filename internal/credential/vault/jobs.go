@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/boundary/internal/scheduler"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 	vault "github.com/hashicorp/vault/api"
 	ua "go.uber.org/atomic"
 )
@@ -78,8 +79,10 @@ func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Read
 }
 
 // TokenRenewalJob is the recurring job that renews credential store Vault tokens that
-// are in the `current` and `maintaining` state.  The TokenRenewalJob is not thread safe,
-// an attempt to Run the job concurrently will result in an JobAlreadyRunning error.
+// are in the `current` and `maintaining` state. Credential stores with an auth method
+// log in to Vault again when their current token has expired or is about to reach its
+// max TTL. The TokenRenewalJob is not thread safe, an attempt to Run the job
+// concurrently will result in an JobAlreadyRunning error.
 type TokenRenewalJob struct {
 	reader db.Reader
 	writer db.Writer
@@ -214,6 +217,13 @@ func (r *TokenRenewalJob) renewToken(ctx context.Context, s *clientStore) error
 			return errors.Wrap(ctx, err, op, errors.WithMsg("error updating credentials to revoked after revoking token"))
 		}
 
+		if s.TokenStatus == string(CurrentToken) {
+			// A credential store with an auth method logs in again to
+			// replace its expired token.
+			if err := r.login(ctx, s, vc, databaseWrapper); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+		}
 		return nil
 	}
 	if err != nil {
@@ -225,6 +235,16 @@ func (r *TokenRenewalJob) renewToken(ctx context.Context, s *clientStore) error
 		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get vault token expiration"))
 	}
 
+	if s.TokenStatus == string(CurrentToken) && tokenExpires <= renewalWindow {
+		// The token is about to reach its max TTL. A credential store with
+		// an auth method logs in again to get a new token before the
+		// current one expires. The current token moves to the maintaining
+		// state and is still renewed below.
+		if err := r.login(ctx, s, vc, databaseWrapper); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+
 	token.expiration = tokenExpires
 	query, values := token.updateExpirationQuery()
 	numRows, err := r.writer.Exec(ctx, query, values)
@@ -238,6 +258,43 @@ func (r *TokenRenewalJob) renewToken(ctx context.Context, s *clientStore) error
 	return nil
 }
 
+// login logs in to Vault with the auth method of the credential store s and
+// inserts the token returned by Vault as the current token of s. It does
+// nothing if s does not have an auth method.
+func (r *TokenRenewalJob) login(ctx context.Context, s *clientStore, vc vaultClient, databaseWrapper wrapping.Wrapper) error {
+	const op = "vault.(TokenRenewalJob).login"
+	auth, err := lookupStoreAuth(ctx, r.reader, databaseWrapper, s.PublicId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if auth == nil {
+		return nil
+	}
+
+	loginSecret, err := vc.login(ctx, auth)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to log in to vault"))
+	}
+	tokenExpires := time.Duration(loginSecret.Auth.LeaseDuration) * time.Second
+	token, err := newToken(ctx, s.PublicId, TokenSecret(loginSecret.Auth.ClientToken), []byte(loginSecret.Auth.Accessor), tokenExpires)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err := token.encrypt(ctx, databaseWrapper); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	query, values := token.insertQuery()
+	numRows, err := r.writer.Exec(ctx, query, values)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if numRows != 1 {
+		return errors.New(ctx, errors.Unknown, op, "logged in to vault but failed to insert token")
+	}
+	event.WriteSysEvent(ctx, op, "Vault credential store logged in to vault with new token", "credential store id", s.PublicId, "auth method", auth.Method)
+	return nil
+}
+
 // NextRunIn queries the vault credential repo to determine when the next token renewal job should run.
 func (r *TokenRenewalJob) NextRunIn(ctx context.Context) (time.Duration, error) {
 	const op = "vault.(TokenRenewalJob).NextRunIn"
@@ -299,7 +356,7 @@ func (r *TokenRenewalJob) Name() string {
 
 // Description is the human readable description of the job.
 func (r *TokenRenewalJob) Description() string {
-	return "Periodically renews Vault credential store tokens that are in a maintaining or current state."
+	return "Periodically renews Vault credential store tokens that are in a maintaining or current state and logs in to Vault again for credential stores with an auth method."
 }
 
 // TokenRevocationJob is the recurring job that revokes credential store Vault tokens that
@@ -578,6 +635,26 @@ func (r *CredentialRenewalJob) renewCred(ctx context.Context, c *privateCredenti
 	var respErr *vault.ResponseError
 	// Subtract last renewal time from previous expiration time to get lease duration
 	leaseDuration := c.ExpirationTime.AsTime().Sub(c.LastRenewalTime.AsTime())
+	if c.LeaseTtlFromSession && c.SessionExpirationTime != nil {
+		// The lease must not outlive the session it was issued for, so it's
+		// renewed for at most the remaining time of the session.
+		remaining := time.Until(c.SessionExpirationTime.AsTime()).Truncate(time.Second)
+		if remaining <= 0 {
+			// The session has ended and so has the lease.
+			query, values := cred.updateStatusQuery(ExpiredCredential)
+			numRows, err := r.writer.Exec(ctx, query, values)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if numRows != 1 {
+				return errors.New(ctx, errors.Unknown, op, "credential expired but failed to update repo")
+			}
+			return nil
+		}
+		if remaining < leaseDuration {
+			leaseDuration = remaining
+		}
+	}
 	renewedCred, err := vc.renewLease(ctx, c.ExternalId, leaseDuration)
 	if ok := errors.As(err, &respErr); ok && respErr.StatusCode == http.StatusBadRequest {
 		// Vault returned a 400 when attempting a renew lease, the lease is either expired
@@ -719,8 +796,19 @@ func (r *CredentialRevocationJob) Run(ctx context.Context) error {
 }
 
 func (r *CredentialRevocationJob) revokeCred(ctx context.Context, c *privateCredential) error {
-	const op = "vault.(CredentialRenewalJob).revokeCred"
-	databaseWrapper, err := r.kms.GetWrapper(ctx, c.ProjectId, kms.KeyPurposeDatabase)
+	const op = "vault.(CredentialRevocationJob).revokeCred"
+	if err := revokeCredential(ctx, r.writer, r.kms, c); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// revokeCredential revokes the Vault lease of c and sets the status of c
+// to revoked. A lease Vault reports as already expired is treated as
+// revoked.
+func revokeCredential(ctx context.Context, w db.Writer, k *kms.Kms, c *privateCredential) error {
+	const op = "vault.revokeCredential"
+	databaseWrapper, err := k.GetWrapper(ctx, c.ProjectId, kms.KeyPurposeDatabase)
 	if err != nil {
 		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
 	}
@@ -746,7 +834,7 @@ func (r *CredentialRevocationJob) revokeCred(ctx context.Context, c *privateCred
 	}
 
 	query, values := cred.updateStatusQuery(RevokedCredential)
-	numRows, err := r.writer.Exec(ctx, query, values)
+	numRows, err := w.Exec(ctx, query, values)
 	if err != nil {
 		return errors.Wrap(ctx, err, op)
 	}
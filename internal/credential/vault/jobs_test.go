@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
@@ -25,6 +26,7 @@ import (
 	vault "github.com/hashicorp/vault/api"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const testUpdateTokenStatusExpirationQuery = `
@@ -498,6 +500,62 @@ func TestTokenRenewalJob_RunExpired(t *testing.T) {
 	require.Nil(cs)
 }
 
+func TestTokenRenewalJob_RunLogin(t *testing.T) {
+	// t.Parallel() - this was causing test failures, investigate before un-commenting
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper, scheduler.WithRunJobsInterval(time.Second))
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	v := NewTestVaultServer(t)
+	roleId, secretId := v.MountAppRole(t)
+
+	auth, err := NewStoreAuth(ctx, AppRoleAuthMethod, roleId, AuthSecret(secretId))
+	require.NoError(err)
+	in, err := NewCredentialStore(prj.GetPublicId(), v.Addr, nil, WithStoreAuth(auth))
+	require.NoError(err)
+
+	r, err := newTokenRenewalJob(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+	require.NoError(sche.RegisterJob(ctx, r))
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sche)
+	require.NoError(err)
+	cs, err := repo.CreateCredentialStore(ctx, in)
+	require.NoError(err)
+
+	// Revoke the token in Vault so the renewal fails
+	ps, err := repo.lookupClientStore(ctx, cs.GetPublicId())
+	require.NoError(err)
+	v.RevokeToken(t, string(ps.Token))
+
+	// Set the renewal time of the token so the job picks it up
+	count, err := rw.Exec(ctx, "update credential_vault_token set expiration_time = now() where token_hmac = ?", []any{ps.TokenHmac})
+	require.NoError(err)
+	require.Equal(1, count)
+
+	require.NoError(r.Run(ctx))
+	assert.Equal(1, r.numTokens)
+
+	// The revoked token should be expired and the store should have logged
+	// in again to get a new current token
+	oldToken := allocToken()
+	require.NoError(rw.LookupWhere(ctx, &oldToken, "token_hmac = ?", []any{ps.TokenHmac}))
+	assert.Equal(string(ExpiredToken), oldToken.Status)
+
+	newToken := allocToken()
+	require.NoError(rw.LookupWhere(ctx, &newToken, "store_id = ? and status = ?", []any{cs.GetPublicId(), CurrentToken}))
+	assert.NotEqual(ps.TokenHmac, newToken.TokenHmac)
+
+	ps, err = repo.lookupClientStore(ctx, cs.GetPublicId())
+	require.NoError(err)
+	assert.NotNil(v.LookupToken(t, string(ps.Token)))
+}
+
 func TestTokenRenewalJob_NextRunIn(t *testing.T) {
 	// t.Parallel() - this was causing test failures, investigate before un-commenting
 
@@ -1330,6 +1388,90 @@ func TestCredentialRenewalJob_RunExpired(t *testing.T) {
 	assert.Equal(string(ExpiredCredential), lookupCred.Status)
 }
 
+func TestCredentialRenewalJob_RunLeaseTtlFromSession(t *testing.T) {
+	// t.Parallel() - this was causing test failures, investigate before un-commenting
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	v := NewTestVaultServer(t, WithDockerNetwork(true))
+	v.MountDatabase(t)
+
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sche)
+	require.NoError(err)
+
+	_, token := v.CreateToken(t, WithPolicies([]string{"default", "boundary-controller", "database"}))
+	credStoreIn, err := NewCredentialStore(prj.GetPublicId(), v.Addr, []byte(token))
+	require.NoError(err)
+	cs, err := repo.CreateCredentialStore(ctx, credStoreIn)
+	require.NoError(err)
+
+	libPath := path.Join("database", "creds", "opened")
+	libIn, err := NewCredentialLibrary(cs.GetPublicId(), libPath, WithLeaseTtlFromSession())
+	require.NoError(err)
+	cl, err := repo.CreateCredentialLibrary(ctx, prj.GetPublicId(), libIn)
+	require.NoError(err)
+
+	at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+	hc := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	tar := tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "test", target.WithHostSources([]string{hs.GetPublicId()}))
+	newSession := func(expiration time.Time) *session.Session {
+		return session.TestSession(t, conn, wrapper, session.ComposedOf{
+			UserId:      at.GetIamUserId(),
+			HostId:      h.GetPublicId(),
+			TargetId:    tar.GetPublicId(),
+			HostSetId:   hs.GetPublicId(),
+			AuthTokenId: at.GetPublicId(),
+			ProjectId:   prj.GetPublicId(),
+			Endpoint:    "tcp://127.0.0.1:22",
+		}, session.WithExpirationTime(&timestamp.Timestamp{Timestamp: timestamppb.New(expiration)}))
+	}
+	sessionExpiration := time.Now().Add(time.Minute).Truncate(time.Second)
+	sess := newSession(sessionExpiration)
+	endedSess := newSession(time.Now().Add(2 * time.Second))
+
+	repoToken := allocToken()
+	require.NoError(rw.LookupWhere(ctx, &repoToken, "token_hmac = ?", []any{cs.outputToken.TokenHmac}))
+
+	credRenewal, err := newCredentialRenewalJob(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+
+	// Both leases last longer than their sessions.
+	_, cred := testVaultCred(t, conn, v, cl, sess, repoToken, ActiveCredential, 5*time.Minute)
+	_, endedCred := testVaultCred(t, conn, v, cl, endedSess, repoToken, ActiveCredential, 5*time.Minute)
+
+	// Sleep to move clock past the end of endedSess
+	time.Sleep(3 * time.Second)
+
+	err = credRenewal.Run(ctx)
+	require.NoError(err)
+	assert.Equal(2, credRenewal.numCreds)
+
+	// The lease is renewed for the remaining time of the session rather
+	// than its previous duration.
+	lookupCred := allocCredential()
+	lookupCred.PublicId = cred.PublicId
+	require.NoError(rw.LookupById(ctx, lookupCred))
+	assert.Equal(string(ActiveCredential), lookupCred.Status)
+	assert.Falsef(lookupCred.ExpirationTime.AsTime().After(sessionExpiration.Add(time.Second)),
+		"expected expiration time %s to not be after the session expiration %s", lookupCred.ExpirationTime.AsTime(), sessionExpiration)
+
+	// The lease of a session which has ended is not renewed.
+	lookupCred = allocCredential()
+	lookupCred.PublicId = endedCred.PublicId
+	require.NoError(rw.LookupById(ctx, lookupCred))
+	assert.Equal(string(ExpiredCredential), lookupCred.Status)
+	assert.Equal(endedCred.ExpirationTime.AsTime(), lookupCred.ExpirationTime.AsTime())
+}
+
 func TestCredentialRenewalJob_NextRunIn(t *testing.T) {
 	// t.Parallel() - this was causing test failures, investigate before un-commenting
 	ctx := context.Background()
@@ -1783,6 +1925,82 @@ func TestCredentialRevocationJob_Run(t *testing.T) {
 	assert.NoError(testDb.ValidateCredential(t, secret3))
 }
 
+func TestRepository_RevokeSessionCredentials(t *testing.T) {
+	// t.Parallel() - see TestCredentialRevocationJob_Run
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	v := NewTestVaultServer(t, WithDockerNetwork(true))
+	testDb := v.MountDatabase(t)
+
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sche)
+	require.NoError(err)
+
+	_, token := v.CreateToken(t, WithPolicies([]string{"default", "boundary-controller", "database"}))
+	credStoreIn, err := NewCredentialStore(prj.GetPublicId(), v.Addr, []byte(token))
+	require.NoError(err)
+	cs, err := repo.CreateCredentialStore(ctx, credStoreIn)
+	require.NoError(err)
+
+	libIn, err := NewCredentialLibrary(cs.GetPublicId(), path.Join("database", "creds", "opened"))
+	require.NoError(err)
+	cl, err := repo.CreateCredentialLibrary(ctx, prj.GetPublicId(), libIn)
+	require.NoError(err)
+
+	at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+	hc := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	tar := tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "test", target.WithHostSources([]string{hs.GetPublicId()}))
+	newSession := func() *session.Session {
+		return session.TestSession(t, conn, wrapper, session.ComposedOf{
+			UserId:      at.GetIamUserId(),
+			HostId:      h.GetPublicId(),
+			TargetId:    tar.GetPublicId(),
+			HostSetId:   hs.GetPublicId(),
+			AuthTokenId: at.GetPublicId(),
+			ProjectId:   prj.GetPublicId(),
+			Endpoint:    "tcp://127.0.0.1:22",
+		})
+	}
+	sess, otherSess := newSession(), newSession()
+
+	repoToken := allocToken()
+	require.NoError(rw.LookupWhere(ctx, &repoToken, "token_hmac = ?", []any{cs.outputToken.TokenHmac}))
+
+	activeSecret, activeCred := testVaultCred(t, conn, v, cl, sess, repoToken, ActiveCredential, 5*time.Minute)
+	revokeSecret, revokeCred := testVaultCred(t, conn, v, cl, sess, repoToken, RevokeCredential, 5*time.Minute)
+	otherSecret, otherCred := testVaultCred(t, conn, v, cl, otherSess, repoToken, RevokeCredential, 5*time.Minute)
+
+	assert.Error(repo.RevokeSessionCredentials(ctx, ""))
+	require.NoError(repo.RevokeSessionCredentials(ctx, sess.GetPublicId()))
+
+	assertStatus := func(c *Credential, want CredentialStatus) {
+		t.Helper()
+		lookupCred := allocCredential()
+		lookupCred.PublicId = c.PublicId
+		require.NoError(rw.LookupById(ctx, lookupCred))
+		assert.Equal(string(want), lookupCred.Status)
+	}
+
+	// Only the credential of the session set for revocation is revoked
+	assertStatus(revokeCred, RevokedCredential)
+	assert.Error(testDb.ValidateCredential(t, revokeSecret))
+
+	assertStatus(activeCred, ActiveCredential)
+	assert.NoError(testDb.ValidateCredential(t, activeSecret))
+
+	assertStatus(otherCred, RevokeCredential)
+	assert.NoError(testDb.ValidateCredential(t, otherSecret))
+}
+
 func TestCredentialRevocationJob_RunDeleted(t *testing.T) {
 	// t.Parallel() - this was causing test failures, investigate before un-commenting
 	ctx := context.Background()
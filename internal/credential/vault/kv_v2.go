@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	vault "github.com/hashicorp/vault/api"
+)
+
+// kvV2Metadata is the version metadata Vault returns with a KV version 2
+// secret.
+type kvV2Metadata struct {
+	Version     uint32
+	CreatedTime time.Time
+}
+
+// unwrapKvV2 removes the data envelope from s, a Vault KV version 2
+// secret. It returns the data stored in the secret and the version
+// metadata of the secret.
+//
+// A Vault KV version 2 secret has the form:
+//
+//	{
+//	  "data": {
+//	    "data": {"username": "user", "password": "pass"},
+//	    "metadata": {"version": 2, "created_time": "2018-03-22T02:24:06.945319214Z", ...}
+//	  }
+//	}
+//
+// An error is returned if s is not a Vault KV version 2 secret or if the
+// version of the secret has been deleted or destroyed.
+func unwrapKvV2(ctx context.Context, s *vault.Secret) (map[string]any, *kvV2Metadata, error) {
+	const op = "vault.unwrapKvV2"
+	if s == nil || s.Data == nil {
+		return nil, nil, errors.E(ctx, errors.WithCode(errors.VaultEmptySecret), errors.WithOp(op))
+	}
+
+	m, ok := s.Data["metadata"].(map[string]any)
+	if !ok {
+		return nil, nil, errors.New(ctx, errors.VaultCredentialRequest, op, "secret is not a kv v2 secret: missing metadata")
+	}
+	md := &kvV2Metadata{}
+	switch v := m["version"].(type) {
+	case json.Number:
+		n, err := strconv.ParseUint(v.String(), 10, 32)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.VaultCredentialRequest), errors.WithMsg("invalid kv v2 secret version"))
+		}
+		md.Version = uint32(n)
+	case float64:
+		md.Version = uint32(v)
+	default:
+		return nil, nil, errors.New(ctx, errors.VaultCredentialRequest, op, "secret is not a kv v2 secret: missing version")
+	}
+	if ct, ok := m["created_time"].(string); ok && ct != "" {
+		t, err := time.Parse(time.RFC3339Nano, ct)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.VaultCredentialRequest), errors.WithMsg("invalid kv v2 secret created time"))
+		}
+		md.CreatedTime = t
+	}
+
+	d, ok := s.Data["data"].(map[string]any)
+	if !ok {
+		// Vault returns a nil data field for a version which has been
+		// deleted or destroyed.
+		if s.Data["data"] == nil {
+			return nil, nil, errors.New(ctx, errors.VaultEmptySecret, op, fmt.Sprintf("kv v2 secret version %d has been deleted or destroyed", md.Version))
+		}
+		return nil, nil, errors.New(ctx, errors.VaultCredentialRequest, op, "secret is not a kv v2 secret: invalid data")
+	}
+	return d, md, nil
+}
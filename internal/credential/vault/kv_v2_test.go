@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	vault "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_unwrapKvV2(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	createdTime := time.Date(2018, 3, 22, 2, 24, 6, 945319214, time.UTC)
+	tests := []struct {
+		name     string
+		secret   *vault.Secret
+		wantData map[string]any
+		wantMd   *kvV2Metadata
+		wantErr  errors.Code
+	}{
+		{
+			name:    "nil-secret",
+			wantErr: errors.VaultEmptySecret,
+		},
+		{
+			name:    "nil-data",
+			secret:  &vault.Secret{},
+			wantErr: errors.VaultEmptySecret,
+		},
+		{
+			name: "valid",
+			secret: &vault.Secret{
+				Data: map[string]any{
+					"data": map[string]any{"username": "user", "password": "pass"},
+					"metadata": map[string]any{
+						"version":       json.Number("2"),
+						"created_time":  "2018-03-22T02:24:06.945319214Z",
+						"deletion_time": "",
+						"destroyed":     false,
+					},
+				},
+			},
+			wantData: map[string]any{"username": "user", "password": "pass"},
+			wantMd:   &kvV2Metadata{Version: 2, CreatedTime: createdTime},
+		},
+		{
+			name: "valid-float-version",
+			secret: &vault.Secret{
+				Data: map[string]any{
+					"data":     map[string]any{"username": "user"},
+					"metadata": map[string]any{"version": float64(3)},
+				},
+			},
+			wantData: map[string]any{"username": "user"},
+			wantMd:   &kvV2Metadata{Version: 3},
+		},
+		{
+			name: "kv-v1-secret",
+			secret: &vault.Secret{
+				Data: map[string]any{"username": "user", "password": "pass"},
+			},
+			wantErr: errors.VaultCredentialRequest,
+		},
+		{
+			name: "missing-version",
+			secret: &vault.Secret{
+				Data: map[string]any{
+					"data":     map[string]any{"username": "user"},
+					"metadata": map[string]any{"created_time": "2018-03-22T02:24:06.945319214Z"},
+				},
+			},
+			wantErr: errors.VaultCredentialRequest,
+		},
+		{
+			name: "invalid-created-time",
+			secret: &vault.Secret{
+				Data: map[string]any{
+					"data":     map[string]any{"username": "user"},
+					"metadata": map[string]any{"version": json.Number("1"), "created_time": "yesterday"},
+				},
+			},
+			wantErr: errors.VaultCredentialRequest,
+		},
+		{
+			name: "deleted-version",
+			secret: &vault.Secret{
+				Data: map[string]any{
+					"data": nil,
+					"metadata": map[string]any{
+						"version":       json.Number("1"),
+						"created_time":  "2018-03-22T02:24:06.945319214Z",
+						"deletion_time": "2018-03-22T02:36:43.986212308Z",
+					},
+				},
+			},
+			wantErr: errors.VaultEmptySecret,
+		},
+		{
+			name: "invalid-data",
+			secret: &vault.Secret{
+				Data: map[string]any{
+					"data":     "user:pass",
+					"metadata": map[string]any{"version": json.Number("1")},
+				},
+			},
+			wantErr: errors.VaultCredentialRequest,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			gotData, gotMd, err := unwrapKvV2(ctx, tt.secret)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(gotData)
+				assert.Nil(gotMd)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantData, gotData)
+			assert.Equal(tt.wantMd, gotMd)
+		})
+	}
+}
@@ -21,6 +21,10 @@ func validMappingOverride(m MappingOverride, ct globals.CredentialType) bool {
 		return ct == globals.UsernamePasswordCredentialType
 	case *SshPrivateKeyOverride:
 		return ct == globals.SshPrivateKeyCredentialType
+	case *TlsClientCertificateOverride:
+		return ct == globals.TlsClientCertificateCredentialType
+	case *KubeconfigOverride:
+		return ct == globals.KubeconfigCredentialType
 	default:
 		return false // an unknown mapping override type is never valid
 	}
@@ -168,3 +172,130 @@ func (o *SshPrivateKeyOverride) TableName() string {
 func (o *SshPrivateKeyOverride) SetTableName(n string) {
 	o.tableName = n
 }
+
+// A TlsClientCertificateOverride contains optional values for overriding the
+// default mappings used to map a Vault secret to a TlsClientCertificate
+// credential type for the credential library that owns it.
+type TlsClientCertificateOverride struct {
+	*store.TlsClientCertificateOverride
+	tableName string `gorm:"-"`
+}
+
+var _ MappingOverride = (*TlsClientCertificateOverride)(nil)
+
+// NewTlsClientCertificateOverride creates a new in memory
+// TlsClientCertificateOverride. WithOverrideCertificateAttribute,
+// WithOverridePrivateKeyAttribute and WithOverrideCaCertificateAttribute are
+// the only valid options. All other options are ignored.
+func NewTlsClientCertificateOverride(opt ...Option) *TlsClientCertificateOverride {
+	opts := getOpts(opt...)
+	o := &TlsClientCertificateOverride{
+		TlsClientCertificateOverride: &store.TlsClientCertificateOverride{
+			CertificateAttribute:   sanitize.String(opts.withOverrideCertificateAttribute),
+			PrivateKeyAttribute:    sanitize.String(opts.withOverridePrivateKeyAttribute),
+			CaCertificateAttribute: sanitize.String(opts.withOverrideCaCertificateAttribute),
+		},
+	}
+	return o
+}
+
+func allocTlsClientCertificateOverride() *TlsClientCertificateOverride {
+	return &TlsClientCertificateOverride{
+		TlsClientCertificateOverride: &store.TlsClientCertificateOverride{},
+	}
+}
+
+func (o *TlsClientCertificateOverride) clone() MappingOverride {
+	cp := proto.Clone(o.TlsClientCertificateOverride)
+	return &TlsClientCertificateOverride{
+		TlsClientCertificateOverride: cp.(*store.TlsClientCertificateOverride),
+	}
+}
+
+func (o *TlsClientCertificateOverride) setLibraryId(i string) {
+	o.LibraryId = i
+}
+
+func (o *TlsClientCertificateOverride) sanitize() {
+	if sentinel.Is(o.CertificateAttribute) {
+		o.CertificateAttribute = ""
+	}
+	if sentinel.Is(o.PrivateKeyAttribute) {
+		o.PrivateKeyAttribute = ""
+	}
+	if sentinel.Is(o.CaCertificateAttribute) {
+		o.CaCertificateAttribute = ""
+	}
+}
+
+// TableName returns the table name.
+func (o *TlsClientCertificateOverride) TableName() string {
+	if o.tableName != "" {
+		return o.tableName
+	}
+	return "credential_vault_library_tls_client_cert_mapping_override"
+}
+
+// SetTableName sets the table name.
+func (o *TlsClientCertificateOverride) SetTableName(n string) {
+	o.tableName = n
+}
+
+// A KubeconfigOverride contains optional values for overriding the default
+// mappings used to map a Vault secret to a Kubeconfig credential type for the
+// credential library that owns it.
+type KubeconfigOverride struct {
+	*store.KubeconfigOverride
+	tableName string `gorm:"-"`
+}
+
+var _ MappingOverride = (*KubeconfigOverride)(nil)
+
+// NewKubeconfigOverride creates a new in memory KubeconfigOverride.
+// WithOverrideKubeconfigAttribute is the only valid option. All other
+// options are ignored.
+func NewKubeconfigOverride(opt ...Option) *KubeconfigOverride {
+	opts := getOpts(opt...)
+	o := &KubeconfigOverride{
+		KubeconfigOverride: &store.KubeconfigOverride{
+			KubeconfigAttribute: sanitize.String(opts.withOverrideKubeconfigAttribute),
+		},
+	}
+	return o
+}
+
+func allocKubeconfigOverride() *KubeconfigOverride {
+	return &KubeconfigOverride{
+		KubeconfigOverride: &store.KubeconfigOverride{},
+	}
+}
+
+func (o *KubeconfigOverride) clone() MappingOverride {
+	cp := proto.Clone(o.KubeconfigOverride)
+	return &KubeconfigOverride{
+		KubeconfigOverride: cp.(*store.KubeconfigOverride),
+	}
+}
+
+func (o *KubeconfigOverride) setLibraryId(i string) {
+	o.LibraryId = i
+}
+
+func (o *KubeconfigOverride) sanitize() {
+	if sentinel.Is(o.KubeconfigAttribute) {
+		o.KubeconfigAttribute = ""
+	}
+}
+
+// TableName returns the table name.
+func (o *KubeconfigOverride) TableName() string {
+	if o.tableName != "" {
+		return o.tableName
+	}
+	return "credential_vault_library_kubeconfig_mapping_override"
+}
+
+// SetTableName sets the table name.
+func (o *KubeconfigOverride) SetTableName(n string) {
+	o.tableName = n
+}
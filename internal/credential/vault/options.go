@@ -19,23 +19,31 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withName           string
-	withDescription    string
-	withLimit          int
-	withCACert         []byte
-	withNamespace      string
-	withTlsServerName  string
-	withTlsSkipVerify  bool
-	withWorkerFilter   string
-	withClientCert     *ClientCertificate
-	withMethod         Method
-	withRequestBody    []byte
-	withCredentialType globals.CredentialType
+	withName                string
+	withDescription         string
+	withLimit               int
+	withCACert              []byte
+	withNamespace           string
+	withTlsServerName       string
+	withTlsSkipVerify       bool
+	withWorkerFilter        string
+	withClientCert          *ClientCertificate
+	withStoreAuth           *StoreAuth
+	withAuthMountPath       string
+	withMethod              Method
+	withRequestBody         []byte
+	withCredentialType      globals.CredentialType
+	withKvV2                bool
+	withKvV2Version         uint32
+	withLeaseTtlFromSession bool
 
 	withOverrideUsernameAttribute             string
 	withOverridePasswordAttribute             string
 	withOverridePrivateKeyAttribute           string
 	withOverridePrivateKeyPassphraseAttribute string
+	withOverrideCertificateAttribute          string
+	withOverrideCaCertificateAttribute        string
+	withOverrideKubeconfigAttribute           string
 	withMappingOverride                       MappingOverride
 
 	withKeyType                   string
@@ -122,6 +130,22 @@ func WithClientCert(clientCert *ClientCertificate) Option {
 	}
 }
 
+// WithStoreAuth provides an optional StoreAuth the credential store uses
+// to log in to Vault instead of using a token.
+func WithStoreAuth(auth *StoreAuth) Option {
+	return func(o *options) {
+		o.withStoreAuth = auth
+	}
+}
+
+// WithAuthMountPath provides an optional path the Vault auth method of a
+// StoreAuth is mounted at.
+func WithAuthMountPath(p string) Option {
+	return func(o *options) {
+		o.withAuthMountPath = p
+	}
+}
+
 // WithMethod provides an optional Method to use for communicating with
 // Vault.
 func WithMethod(m Method) Option {
@@ -146,6 +170,32 @@ func WithCredentialType(t globals.CredentialType) Option {
 	}
 }
 
+// WithKvV2 indicates the Vault path of a credential library is a Vault KV
+// version 2 secret.
+func WithKvV2() Option {
+	return func(o *options) {
+		o.withKvV2 = true
+	}
+}
+
+// WithKvV2Version provides an optional version of a Vault KV version 2
+// secret for a credential library to request. If not set, the latest
+// version of the secret is requested.
+func WithKvV2Version(v uint32) Option {
+	return func(o *options) {
+		o.withKvV2Version = v
+	}
+}
+
+// WithLeaseTtlFromSession indicates a credential library requests a lease
+// TTL equal to the remaining time of the session a credential is issued
+// for.
+func WithLeaseTtlFromSession() Option {
+	return func(o *options) {
+		o.withLeaseTtlFromSession = true
+	}
+}
+
 // WithOverrideUsernameAttribute provides the name of an attribute in the
 // Data field of a Vault api.Secret that maps to a username value.
 func WithOverrideUsernameAttribute(s string) Option {
@@ -178,6 +228,30 @@ func WithOverridePrivateKeyPassphraseAttribute(s string) Option {
 	}
 }
 
+// WithOverrideCertificateAttribute provides the name of an attribute in the
+// Data field of a Vault api.Secret that maps to a client certificate value.
+func WithOverrideCertificateAttribute(s string) Option {
+	return func(o *options) {
+		o.withOverrideCertificateAttribute = s
+	}
+}
+
+// WithOverrideCaCertificateAttribute provides the name of an attribute in the
+// Data field of a Vault api.Secret that maps to a CA certificate value.
+func WithOverrideCaCertificateAttribute(s string) Option {
+	return func(o *options) {
+		o.withOverrideCaCertificateAttribute = s
+	}
+}
+
+// WithOverrideKubeconfigAttribute provides the name of an attribute in the
+// Data field of a Vault api.Secret that maps to a kubeconfig value.
+func WithOverrideKubeconfigAttribute(s string) Option {
+	return func(o *options) {
+		o.withOverrideKubeconfigAttribute = s
+	}
+}
+
 // WithMappingOverride provides an optional mapping override to use for
 // mapping the Data fields of a Vault api.Secret to a credential.
 func WithMappingOverride(m MappingOverride) Option {
@@ -76,6 +76,21 @@ func Test_GetOpts(t *testing.T) {
 		assert.Equal(t, cert, opts.withClientCert.Certificate)
 		assert.Equal(t, key, opts.withClientCert.CertificateKey)
 	})
+	t.Run("WithStoreAuth", func(t *testing.T) {
+		testOpts := getDefaultOptions()
+		assert.Nil(t, testOpts.withStoreAuth)
+		auth, err := NewStoreAuth(context.Background(), AppRoleAuthMethod, "role-id", AuthSecret("secret-id"))
+		require.NoError(t, err)
+		opts := getOpts(WithStoreAuth(auth))
+		require.NotNil(t, opts.withStoreAuth)
+		assert.Equal(t, auth, opts.withStoreAuth)
+	})
+	t.Run("WithAuthMountPath", func(t *testing.T) {
+		opts := getOpts(WithAuthMountPath("approle-boundary"))
+		testOpts := getDefaultOptions()
+		testOpts.withAuthMountPath = "approle-boundary"
+		assert.Equal(t, opts, testOpts)
+	})
 	t.Run("WithMethod_Get", func(t *testing.T) {
 		opts := getOpts(WithMethod(MethodGet))
 		testOpts := getDefaultOptions()
@@ -100,6 +115,24 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withCredentialType = globals.UsernamePasswordCredentialType
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithKvV2", func(t *testing.T) {
+		opts := getOpts(WithKvV2())
+		testOpts := getDefaultOptions()
+		testOpts.withKvV2 = true
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithKvV2Version", func(t *testing.T) {
+		opts := getOpts(WithKvV2Version(3))
+		testOpts := getDefaultOptions()
+		testOpts.withKvV2Version = 3
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithLeaseTtlFromSession", func(t *testing.T) {
+		opts := getOpts(WithLeaseTtlFromSession())
+		testOpts := getDefaultOptions()
+		testOpts.withLeaseTtlFromSession = true
+		assert.Equal(t, opts, testOpts)
+	})
 	t.Run("WithOverrideUsernameAttribute", func(t *testing.T) {
 		opts := getOpts(WithOverrideUsernameAttribute("test"))
 		testOpts := getDefaultOptions()
@@ -125,6 +158,27 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withOverridePrivateKeyPassphraseAttribute = "test"
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithOverrideCertificateAttribute", func(t *testing.T) {
+		opts := getOpts(WithOverrideCertificateAttribute("test"))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(t, opts, testOpts)
+		testOpts.withOverrideCertificateAttribute = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithOverrideCaCertificateAttribute", func(t *testing.T) {
+		opts := getOpts(WithOverrideCaCertificateAttribute("test"))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(t, opts, testOpts)
+		testOpts.withOverrideCaCertificateAttribute = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithOverrideKubeconfigAttribute", func(t *testing.T) {
+		opts := getOpts(WithOverrideKubeconfigAttribute("test"))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(t, opts, testOpts)
+		testOpts.withOverrideKubeconfigAttribute = "test"
+		assert.Equal(t, opts, testOpts)
+	})
 	t.Run("WithMappingOverride", func(t *testing.T) {
 		opts := getOpts(WithMappingOverride(unknownMapper(1)))
 		testOpts := getDefaultOptions()
@@ -48,6 +48,9 @@ type privateCredential struct {
 	CtClientKey          []byte
 	ClientKeyHmac        []byte
 	ClientKeyId          string
+
+	LeaseTtlFromSession   bool
+	SessionExpirationTime *timestamp.Timestamp
 }
 
 func (pc *privateCredential) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
@@ -16,14 +16,18 @@ import (
 	"encoding/json"
 	"encoding/pem"
 	"fmt"
+	"net"
 	"regexp"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
-	"github.com/hashicorp/boundary/internal/credential/vault/internal/sshprivatekey"
-	"github.com/hashicorp/boundary/internal/credential/vault/internal/usernamepassword"
+	"github.com/hashicorp/boundary/internal/credential/internal/kubeconfig"
+	"github.com/hashicorp/boundary/internal/credential/internal/sshprivatekey"
+	"github.com/hashicorp/boundary/internal/credential/internal/tlsclientcertificate"
+	"github.com/hashicorp/boundary/internal/credential/internal/usernamepassword"
 	"github.com/hashicorp/boundary/internal/db/sentinel"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -40,11 +44,14 @@ import (
 
 var _ credential.Dynamic = (*baseCred)(nil)
 
+var _ credential.VersionedSecret = (*baseCred)(nil)
+
 type baseCred struct {
 	*Credential
 
 	lib        issuingCredentialLibrary
 	secretData map[string]any
+	kvMetadata *kvV2Metadata
 }
 
 func (bc *baseCred) Secret() credential.SecretData { return bc.secretData }
@@ -54,6 +61,25 @@ func (bc *baseCred) getExpiration() time.Duration  { return bc.expiration }
 func (bc *baseCred) getCredential() *Credential    { return bc.Credential }
 func (bc *baseCred) isRevokable() bool             { return bc.ExternalId != sentinel.ExternalIdNone }
 
+// SecretVersion returns the version of the Vault KV version 2 secret. It
+// returns 0 if the credential was not retrieved from a KV v2 library.
+func (bc *baseCred) SecretVersion() uint32 {
+	if bc.kvMetadata == nil {
+		return 0
+	}
+	return bc.kvMetadata.Version
+}
+
+// SecretCreateTime returns the time the version of the Vault KV version 2
+// secret was created. It returns the zero time if the credential was not
+// retrieved from a KV v2 library.
+func (bc *baseCred) SecretCreateTime() time.Time {
+	if bc.kvMetadata == nil {
+		return time.Time{}
+	}
+	return bc.kvMetadata.CreatedTime
+}
+
 // convert converts bc to a specific credential type if bc is not
 // UnspecifiedType.
 func convert(ctx context.Context, bc *baseCred) (dynamicCred, error) {
@@ -62,6 +88,10 @@ func convert(ctx context.Context, bc *baseCred) (dynamicCred, error) {
 		return baseToUsrPass(ctx, bc)
 	case globals.SshPrivateKeyCredentialType:
 		return baseToSshPriKey(ctx, bc)
+	case globals.TlsClientCertificateCredentialType:
+		return baseToTlsClientCert(ctx, bc)
+	case globals.KubeconfigCredentialType:
+		return baseToKubeconfig(ctx, bc)
 	}
 	return bc, nil
 }
@@ -162,6 +192,96 @@ func baseToSshPriKey(ctx context.Context, bc *baseCred) (*sshPrivateKeyCred, err
 	}, nil
 }
 
+var _ credential.TlsClientCertificate = (*tlsClientCertCred)(nil)
+
+type tlsClientCertCred struct {
+	*baseCred
+	certificate   []byte
+	privateKey    credential.PrivateKey
+	caCertificate []byte
+}
+
+func (c *tlsClientCertCred) Certificate() []byte               { return c.certificate }
+func (c *tlsClientCertCred) PrivateKey() credential.PrivateKey { return c.privateKey }
+func (c *tlsClientCertCred) CaCertificate() []byte             { return c.caCertificate }
+
+func baseToTlsClientCert(ctx context.Context, bc *baseCred) (*tlsClientCertCred, error) {
+	switch {
+	case bc == nil:
+		return nil, errors.E(ctx, errors.WithCode(errors.InvalidParameter), errors.WithMsg("nil baseCred"))
+	case bc.lib == nil:
+		return nil, errors.E(ctx, errors.WithCode(errors.InvalidParameter), errors.WithMsg("nil baseCred.lib"))
+	case bc.Library().CredentialType() != globals.TlsClientCertificateCredentialType:
+		return nil, errors.E(ctx, errors.WithCode(errors.InvalidParameter), errors.WithMsg("invalid credential type"))
+	}
+
+	lib, ok := bc.lib.(*genericIssuingCredentialLibrary)
+	if !ok {
+		return nil, errors.E(ctx, errors.WithCode(errors.InvalidParameter), errors.WithMsg("baseCred.lib is not of type genericIssuingCredentialLibrary"))
+	}
+
+	cAttr, pkAttr, caAttr := lib.CertificateAttribute, lib.PrivateKeyAttribute, lib.CaCertificateAttribute
+	if cAttr == "" {
+		cAttr = "certificate"
+	}
+	if pkAttr == "" {
+		pkAttr = "private_key"
+	}
+	if caAttr == "" {
+		caAttr = "ca_certificate"
+	}
+	cert, pk, ca := tlsclientcertificate.Extract(bc.secretData, cAttr, pkAttr, caAttr)
+	if cert == nil || pk == nil {
+		return nil, errors.E(ctx, errors.WithCode(errors.VaultInvalidCredentialMapping))
+	}
+
+	return &tlsClientCertCred{
+		baseCred:      bc,
+		certificate:   cert,
+		privateKey:    pk,
+		caCertificate: ca,
+	}, nil
+}
+
+var _ credential.KubeconfigCredential = (*kubeconfigCred)(nil)
+
+type kubeconfigCred struct {
+	*baseCred
+	kubeconfig credential.Kubeconfig
+}
+
+func (c *kubeconfigCred) Kubeconfig() credential.Kubeconfig { return c.kubeconfig }
+
+func baseToKubeconfig(ctx context.Context, bc *baseCred) (*kubeconfigCred, error) {
+	switch {
+	case bc == nil:
+		return nil, errors.E(ctx, errors.WithCode(errors.InvalidParameter), errors.WithMsg("nil baseCred"))
+	case bc.lib == nil:
+		return nil, errors.E(ctx, errors.WithCode(errors.InvalidParameter), errors.WithMsg("nil baseCred.lib"))
+	case bc.Library().CredentialType() != globals.KubeconfigCredentialType:
+		return nil, errors.E(ctx, errors.WithCode(errors.InvalidParameter), errors.WithMsg("invalid credential type"))
+	}
+
+	lib, ok := bc.lib.(*genericIssuingCredentialLibrary)
+	if !ok {
+		return nil, errors.E(ctx, errors.WithCode(errors.InvalidParameter), errors.WithMsg("baseCred.lib is not of type genericIssuingCredentialLibrary"))
+	}
+
+	kcAttr := lib.KubeconfigAttribute
+	if kcAttr == "" {
+		kcAttr = "kubeconfig"
+	}
+	kc := kubeconfig.Extract(bc.secretData, kcAttr)
+	if kc == nil {
+		return nil, errors.E(ctx, errors.WithCode(errors.VaultInvalidCredentialMapping))
+	}
+
+	return &kubeconfigCred{
+		baseCred:   bc,
+		kubeconfig: kc,
+	}, nil
+}
+
 type sshCertCred struct {
 	*sshPrivateKeyCred
 	certificate []byte
@@ -210,8 +330,14 @@ type genericIssuingCredentialLibrary struct {
 	PasswordAttribute             string
 	PrivateKeyAttribute           string
 	PrivateKeyPassphraseAttribute string
+	CertificateAttribute          string
+	CaCertificateAttribute        string
+	KubeconfigAttribute           string
 	Purpose                       credential.Purpose
 	AdditionalValidPrincipals     string
+	KvV2                          bool
+	KvV2Version                   uint32
+	LeaseTtlFromSession           bool
 }
 
 func (pl *genericIssuingCredentialLibrary) clone() *genericIssuingCredentialLibrary {
@@ -225,6 +351,9 @@ func (pl *genericIssuingCredentialLibrary) clone() *genericIssuingCredentialLibr
 		PasswordAttribute:             pl.PasswordAttribute,
 		PrivateKeyAttribute:           pl.PrivateKeyAttribute,
 		PrivateKeyPassphraseAttribute: pl.PrivateKeyPassphraseAttribute,
+		CertificateAttribute:          pl.CertificateAttribute,
+		CaCertificateAttribute:        pl.CaCertificateAttribute,
+		KubeconfigAttribute:           pl.KubeconfigAttribute,
 		Name:                          pl.Name,
 		Description:                   pl.Description,
 		CreateTime:                    proto.Clone(pl.CreateTime).(*timestamp.Timestamp),
@@ -249,6 +378,9 @@ func (pl *genericIssuingCredentialLibrary) clone() *genericIssuingCredentialLibr
 		CtClientKey:                   append(pl.CtClientKey[:0:0], pl.CtClientKey...),
 		ClientKeyId:                   pl.ClientKeyId,
 		Purpose:                       pl.Purpose,
+		KvV2:                          pl.KvV2,
+		KvV2Version:                   pl.KvV2Version,
+		LeaseTtlFromSession:           pl.LeaseTtlFromSession,
 	}
 }
 
@@ -306,9 +438,12 @@ type dynamicCred interface {
 }
 
 // retrieveCredential retrieves a dynamic credential from Vault for the
-// given sessionId.
+// given sessionId. If pl.LeaseTtlFromSession is true, the lease of the
+// secret is renewed with an increment equal to the remaining time of the
+// session.
 //
-// Supported options: credential.WithTemplateData
+// Supported options: credential.WithTemplateData,
+// credential.WithSessionExpiration
 func (pl *genericIssuingCredentialLibrary) retrieveCredential(ctx context.Context, op errors.Op, opt ...credential.Option) (dynamicCred, error) {
 	opts, err := credential.GetOpts(opt...)
 	if err != nil {
@@ -322,6 +457,14 @@ func (pl *genericIssuingCredentialLibrary) retrieveCredential(ctx context.Contex
 		return nil, errors.Wrap(ctx, err, op)
 	}
 
+	var sessionTtl time.Duration
+	if pl.LeaseTtlFromSession && !opts.WithSessionExpiration.IsZero() {
+		sessionTtl = time.Until(opts.WithSessionExpiration)
+		if sessionTtl <= 0 {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "session has expired")
+		}
+	}
+
 	client, err := pl.client(ctx)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -358,7 +501,12 @@ func (pl *genericIssuingCredentialLibrary) retrieveCredential(ctx context.Contex
 
 	switch Method(pl.HttpMethod) {
 	case MethodGet:
-		secret, reqErr = client.get(ctx, path)
+		switch {
+		case pl.KvV2 && pl.KvV2Version > 0:
+			secret, reqErr = client.getVersion(ctx, path, pl.KvV2Version)
+		default:
+			secret, reqErr = client.get(ctx, path)
+		}
 	case MethodPost:
 		secret, reqErr = client.post(ctx, path, []byte(body))
 	default:
@@ -374,7 +522,26 @@ func (pl *genericIssuingCredentialLibrary) retrieveCredential(ctx context.Contex
 		return nil, errors.E(ctx, errors.WithCode(errors.VaultEmptySecret), errors.WithOp(op))
 	}
 
+	secretData := secret.Data
+	var kvMetadata *kvV2Metadata
+	if pl.KvV2 {
+		secretData, kvMetadata, err = unwrapKvV2(ctx, secret)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+
 	leaseDuration := time.Duration(secret.LeaseDuration) * time.Second
+	if sessionTtl > 0 && secret.Renewable && secret.LeaseID != "" {
+		renewed, err := client.renewLease(ctx, secret.LeaseID, sessionTtl)
+		if err != nil {
+			// Best effort revoke of the lease since the credential is
+			// never persisted and would otherwise outlive the session.
+			_ = client.revokeLease(ctx, secret.LeaseID)
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to set lease ttl to remaining session time"))
+		}
+		leaseDuration = time.Duration(renewed.LeaseDuration) * time.Second
+	}
 	cred, err := newCredential(ctx, pl.GetPublicId(), secret.LeaseID, pl.TokenHmac, leaseDuration)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -385,7 +552,8 @@ func (pl *genericIssuingCredentialLibrary) retrieveCredential(ctx context.Contex
 	dCred := &baseCred{
 		Credential: cred,
 		lib:        pl,
-		secretData: secret.Data,
+		secretData: secretData,
+		kvMetadata: kvMetadata,
 	}
 	return convert(ctx, dCred)
 }
@@ -491,6 +659,9 @@ type privateCredentialLibraryAllTypes struct {
 	PasswordAttribute             string
 	PrivateKeyAttribute           string
 	PrivateKeyPassphraseAttribute string
+	CertificateAttribute          string
+	CaCertificateAttribute        string
+	KubeconfigAttribute           string
 	Purpose                       credential.Purpose `gorm:"-"`
 	KeyType                       string
 	KeyBits                       int
@@ -501,6 +672,9 @@ type privateCredentialLibraryAllTypes struct {
 	Extensions                    []byte
 	CredLibType                   string
 	AdditionalValidPrincipals     string
+	KvV2                          bool
+	KvV2Version                   uint32
+	LeaseTtlFromSession           bool
 }
 
 func (pl *privateCredentialLibraryAllTypes) GetPublicId() string { return pl.PublicId }
@@ -554,6 +728,9 @@ func (pl *privateCredentialLibraryAllTypes) clone() *privateCredentialLibraryAll
 		PasswordAttribute:             pl.PasswordAttribute,
 		PrivateKeyAttribute:           pl.PrivateKeyAttribute,
 		PrivateKeyPassphraseAttribute: pl.PrivateKeyPassphraseAttribute,
+		CertificateAttribute:          pl.CertificateAttribute,
+		CaCertificateAttribute:        pl.CaCertificateAttribute,
+		KubeconfigAttribute:           pl.KubeconfigAttribute,
 		Name:                          pl.Name,
 		Description:                   pl.Description,
 		CreateTime:                    proto.Clone(pl.CreateTime).(*timestamp.Timestamp),
@@ -587,6 +764,9 @@ func (pl *privateCredentialLibraryAllTypes) clone() *privateCredentialLibraryAll
 		Extensions:                    pl.Extensions,
 		CredLibType:                   pl.CredLibType,
 		AdditionalValidPrincipals:     pl.AdditionalValidPrincipals,
+		KvV2:                          pl.KvV2,
+		KvV2Version:                   pl.KvV2Version,
+		LeaseTtlFromSession:           pl.LeaseTtlFromSession,
 	}
 }
 
@@ -637,6 +817,9 @@ func (pl *privateCredentialLibraryAllTypes) toTypedIssuingCredentialLibrary() is
 			PasswordAttribute:             pl.PasswordAttribute,
 			PrivateKeyAttribute:           pl.PrivateKeyAttribute,
 			PrivateKeyPassphraseAttribute: pl.PrivateKeyPassphraseAttribute,
+			CertificateAttribute:          pl.CertificateAttribute,
+			CaCertificateAttribute:        pl.CaCertificateAttribute,
+			KubeconfigAttribute:           pl.KubeconfigAttribute,
 			Name:                          pl.Name,
 			Description:                   pl.Description,
 			CreateTime:                    pl.CreateTime,
@@ -662,6 +845,9 @@ func (pl *privateCredentialLibraryAllTypes) toTypedIssuingCredentialLibrary() is
 			ClientKeyId:                   pl.ClientKeyId,
 			Purpose:                       pl.Purpose,
 			AdditionalValidPrincipals:     pl.AdditionalValidPrincipals,
+			KvV2:                          pl.KvV2,
+			KvV2Version:                   pl.KvV2Version,
+			LeaseTtlFromSession:           pl.LeaseTtlFromSession,
 		}
 	}
 }
@@ -882,6 +1068,148 @@ type sshCertVaultBody struct {
 	Extensions      map[string]string `json:"extensions,omitempty"`       // this will be loaded directly from lib
 }
 
+// templateSshCertMap decodes the critical options or extensions of an SSH
+// certificate library and templates their values. Empty values, such as the
+// value of the permit-pty extension, are left as is.
+func templateSshCertMap(ctx context.Context, raw []byte, data template.Data) (map[string]string, error) {
+	const op = "vault.templateSshCertMap"
+	if raw == nil {
+		return nil, nil
+	}
+	var m map[string]string
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	for k, v := range m {
+		if v == "" {
+			continue
+		}
+		tplate, err := template.New(ctx, v)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to parse template of %q", k)))
+		}
+		if m[k], err = tplate.Generate(ctx, data); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to generate value of %q", k)))
+		}
+		if m[k] != v {
+			if err := checkTemplatedSshCertValue(ctx, k, m[k]); err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+		}
+	}
+	return m, nil
+}
+
+// forceCommandRunes are the characters a templated force-command may
+// contain. Shell metacharacters are excluded since the command is run by
+// the user's shell on the target.
+const forceCommandRunes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789 _.,:/@=+-"
+
+// checkTemplatedSshCertValue returns an error if the templated value of a
+// critical option or extension could change its meaning. Templated values
+// can contain account attributes which are controlled by the user or their
+// identity provider.
+func checkTemplatedSshCertValue(ctx context.Context, name, value string) error {
+	const op = "vault.checkTemplatedSshCertValue"
+	if strings.IndexFunc(value, unicode.IsControl) >= 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("templated value of %q contains a control character", name))
+	}
+	switch name {
+	case "force-command":
+		if i := strings.IndexFunc(value, func(r rune) bool { return !strings.ContainsRune(forceCommandRunes, r) }); i >= 0 {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("templated value of %q contains %q which is not allowed", name, value[i:i+1]))
+		}
+	case "source-address":
+		for _, addr := range strings.Split(value, ",") {
+			if _, _, err := net.ParseCIDR(addr); err == nil {
+				continue
+			}
+			if net.ParseIP(addr) == nil {
+				return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("templated value of %q contains %q which is not an address", name, addr))
+			}
+		}
+	}
+	return nil
+}
+
+// checkPrincipal returns an error if p is not a single valid principal.
+// Vault takes the valid principals of a certificate as a comma separated
+// list, so a principal containing a comma would add principals.
+func checkPrincipal(ctx context.Context, p string) error {
+	const op = "vault.checkPrincipal"
+	switch {
+	case p == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "empty principal")
+	case strings.IndexFunc(p, func(r rune) bool { return r == ',' || unicode.IsSpace(r) || unicode.IsControl(r) }) >= 0:
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("principal %q contains a comma, whitespace or a control character", p))
+	}
+	return nil
+}
+
+// validPrincipals templates the username and the additional valid
+// principals of the library and returns the valid principals of the
+// certificate, starting with the username. Each additional valid principal is
+// templated separately and generates one principal, except for a principal
+// which consists of a single join action, such as the groups of the user,
+// which generates one principal per joined value.
+func (lib *sshCertIssuingCredentialLibrary) validPrincipals(ctx context.Context, data template.Data) ([]string, error) {
+	const op = "vault.(sshCertIssuingCredentialLibrary).validPrincipals"
+	tplate, err := template.New(ctx, lib.Username)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	username, err := tplate.Generate(ctx, data)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := checkPrincipal(ctx, username); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("invalid username"))
+	}
+	principals := []string{username}
+	if lib.AdditionalValidPrincipals == "" {
+		return principals, nil
+	}
+	for _, raw := range SplitPrincipals(lib.AdditionalValidPrincipals) {
+		tplate, err := template.New(ctx, raw)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		generated, err := tplate.GenerateList(ctx, data)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for _, p := range generated {
+			if err := checkPrincipal(ctx, p); err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("invalid additional valid principal"))
+			}
+			principals = append(principals, p)
+		}
+	}
+	return principals, nil
+}
+
+// SplitPrincipals splits the stored additional valid principals of an SSH
+// certificate library on commas. Commas inside template actions, such as the
+// separator of a join, do not split the principal.
+func SplitPrincipals(principals string) []string {
+	var ret []string
+	var start, depth int
+	for i := 0; i < len(principals); i++ {
+		switch {
+		case strings.HasPrefix(principals[i:], "{{"):
+			depth++
+			i++
+		case strings.HasPrefix(principals[i:], "}}") && depth > 0:
+			depth--
+			i++
+		case principals[i] == ',' && depth == 0:
+			ret = append(ret, principals[start:i])
+			start = i + 1
+		}
+	}
+	return append(ret, principals[start:])
+}
+
 var vaultPathRegexp = regexp.MustCompile(`^.+\/(sign|issue)\/[^\/\\\s]+$`)
 
 // retrieveCredential retrieves a dynamic connection credential from Vault
@@ -907,20 +1235,16 @@ func (lib *sshCertIssuingCredentialLibrary) retrieveCredential(ctx context.Conte
 	}
 
 	// build as much of the payload as we can, since sign/issue share many attributes
-	// Template the username
-	tplate, err := template.New(ctx, lib.Username)
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
-	}
-	username, err := tplate.Generate(ctx, opts.WithTemplateData)
+	principals, err := lib.validPrincipals(ctx, opts.WithTemplateData)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	username := principals[0]
 
 	// Template the key ID if it's not empty
 	keyId := lib.KeyId
 	if keyId != "" {
-		tplate, err = template.New(ctx, lib.KeyId)
+		tplate, err := template.New(ctx, lib.KeyId)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
@@ -930,27 +1254,17 @@ func (lib *sshCertIssuingCredentialLibrary) retrieveCredential(ctx context.Conte
 		}
 	}
 
-	var criticalOptions map[string]string
-	if lib.CriticalOptions != nil {
-		if json.Unmarshal(lib.CriticalOptions, &criticalOptions) != nil {
-			return nil, errors.Wrap(ctx, err, op)
-		}
-	}
-
-	var extensions map[string]string
-	if lib.Extensions != nil {
-		if json.Unmarshal(lib.Extensions, &extensions) != nil {
-			return nil, errors.Wrap(ctx, err, op)
-		}
+	criticalOptions, err := templateSshCertMap(ctx, lib.CriticalOptions, opts.WithTemplateData)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to template critical options"))
 	}
-
-	validPrincipals := username
-	if lib.AdditionalValidPrincipals != "" {
-		validPrincipals = fmt.Sprintf("%s,%s", username, lib.AdditionalValidPrincipals)
+	extensions, err := templateSshCertMap(ctx, lib.Extensions, opts.WithTemplateData)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to template extensions"))
 	}
 
 	payload := sshCertVaultBody{
-		ValidPrincipals: validPrincipals,
+		ValidPrincipals: strings.Join(principals, ","),
 		CertType:        "user",
 		CriticalOptions: criticalOptions,
 		Extensions:      extensions,
@@ -1374,6 +1374,35 @@ func TestRepository_sshCertIssuingCredentialLibrary_retrieveCredential(t *testin
 			vaulthPath: "ssh/issue/boundary",
 			opts:       []Option{WithKeyType(KeyTypeEd25519), WithAdditionalValidPrincipals([]string{"test-principal"})},
 		},
+		{
+			name:     "vault issue ed25519 cert with template additional valid principals",
+			username: "{{ .Account.LoginName }}",
+			expected: map[string]any{
+				"valid_principals": []string{"alice", "admins", "dba", "test-principal"},
+			},
+			vaulthPath: "ssh/issue/boundary",
+			opts:       []Option{WithKeyType(KeyTypeEd25519), WithAdditionalValidPrincipals([]string{`{{ join .Account.MemberOfGroups "," }}`, "test-principal"})},
+			retOpts:    []credential.Option{credential.WithTemplateData(template.Data{Account: template.Account{LoginName: util.Pointer("alice"), MemberOfGroups: []string{"admins", "dba"}}})},
+		},
+		{
+			name:     "vault issue ed25519 cert with template critical options",
+			username: "username-11-the-claims-strike-back",
+			expected: map[string]any{
+				"critical_options": map[string]string{"force-command": "/bin/login-as alice"},
+			},
+			vaulthPath: "ssh/issue/boundary",
+			opts:       []Option{WithKeyType(KeyTypeEd25519), WithCriticalOptions(`{ "force-command": "/bin/login-as {{ .Account.TokenClaims.preferred_username }}" }`), WithExtensions(`{ "permit-pty": "" }`)},
+			retOpts:    []credential.Option{credential.WithTemplateData(template.Data{Account: template.Account{TokenClaims: map[string]any{"preferred_username": "alice"}}})},
+		},
+		{
+			name:     "vault issue ed25519 cert with missing claim",
+			username: "username-12",
+			expected: map[string]any{
+				"error": "unable to template critical options",
+			},
+			vaulthPath: "ssh/issue/boundary",
+			opts:       []Option{WithKeyType(KeyTypeEd25519), WithCriticalOptions(`{ "force-command": "/bin/login-as {{ .Account.TokenClaims.preferred_username }}" }`)},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1434,6 +1463,9 @@ func TestRepository_sshCertIssuingCredentialLibrary_retrieveCredential(t *testin
 			if vp, ok := tt.expected["valid_principals"]; ok {
 				require.Equal(vp, cert.ValidPrincipals)
 			}
+			if co, ok := tt.expected["critical_options"]; ok {
+				require.Equal(co, cert.CriticalOptions)
+			}
 
 			// ensure credential matches expected SshCertificate
 			_, ok = cred.(credential.SshCertificate)
@@ -1441,3 +1473,176 @@ func TestRepository_sshCertIssuingCredentialLibrary_retrieveCredential(t *testin
 		})
 	}
 }
+
+func TestSplitPrincipals(t *testing.T) {
+	tests := []struct {
+		name       string
+		principals string
+		want       []string
+	}{
+		{
+			name:       "single",
+			principals: "alice",
+			want:       []string{"alice"},
+		},
+		{
+			name:       "multiple",
+			principals: "alice,bob",
+			want:       []string{"alice", "bob"},
+		},
+		{
+			name:       "template",
+			principals: `{{ join .Account.MemberOfGroups "," }},bob`,
+			want:       []string{`{{ join .Account.MemberOfGroups "," }}`, "bob"},
+		},
+		{
+			name:       "templates",
+			principals: `ops-{{ .User.Name }},{{ join .Account.MemberOfGroups "," }}`,
+			want:       []string{"ops-{{ .User.Name }}", `{{ join .Account.MemberOfGroups "," }}`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SplitPrincipals(tt.principals))
+		})
+	}
+}
+
+func TestSshCertIssuingCredentialLibrary_validPrincipals(t *testing.T) {
+	ctx := context.Background()
+	data := template.Data{
+		User: template.User{Name: util.Pointer("alice")},
+		Account: template.Account{
+			LoginName:      util.Pointer("alice,root"),
+			MemberOfGroups: []string{"admins", "dba"},
+			TokenClaims: map[string]any{
+				"preferred_username": "alice,root",
+				"groups":             []any{"admins", "root dba"},
+				"newline":            "alice\nroot",
+			},
+		},
+	}
+
+	tests := []struct {
+		name                      string
+		username                  string
+		additionalValidPrincipals string
+		want                      []string
+		wantErrContains           string
+	}{
+		{
+			name:     "username",
+			username: "{{ .User.Name }}",
+			want:     []string{"alice"},
+		},
+		{
+			name:                      "additional",
+			username:                  "{{ .User.Name }}",
+			additionalValidPrincipals: `ops-{{ .User.Name }},{{ join .Account.MemberOfGroups "," }},bob`,
+			want:                      []string{"alice", "ops-alice", "admins", "dba", "bob"},
+		},
+		{
+			name:            "malicious-username",
+			username:        "{{ .Account.LoginName }}",
+			wantErrContains: "invalid username",
+		},
+		{
+			name:                      "malicious-claim",
+			username:                  "{{ .User.Name }}",
+			additionalValidPrincipals: "{{ .Account.TokenClaims.preferred_username }}",
+			wantErrContains:           "invalid additional valid principal",
+		},
+		{
+			name:                      "malicious-claim-with-text",
+			username:                  "{{ .User.Name }}",
+			additionalValidPrincipals: "ops-{{ .Account.TokenClaims.preferred_username }}",
+			wantErrContains:           "invalid additional valid principal",
+		},
+		{
+			name:                      "whitespace-in-joined-value",
+			username:                  "{{ .User.Name }}",
+			additionalValidPrincipals: `{{ join .Account.TokenClaims.groups "," }}`,
+			wantErrContains:           "invalid additional valid principal",
+		},
+		{
+			name:                      "control-character",
+			username:                  "{{ .User.Name }}",
+			additionalValidPrincipals: "{{ .Account.TokenClaims.newline }}",
+			wantErrContains:           "invalid additional valid principal",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			lib := &sshCertIssuingCredentialLibrary{
+				Username:                  tt.username,
+				AdditionalValidPrincipals: tt.additionalValidPrincipals,
+			}
+			got, err := lib.validPrincipals(ctx, data)
+			if tt.wantErrContains != "" {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+				assert.ErrorContains(err, tt.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
+
+func TestTemplateSshCertMap(t *testing.T) {
+	ctx := context.Background()
+	data := template.Data{
+		User: template.User{Name: util.Pointer("alice")},
+		Account: template.Account{
+			TokenClaims: map[string]any{
+				"preferred_username": "alice",
+				"injected":           "alice; rm -rf /",
+				"address":            "10.0.0.1/32",
+				"addresses":          "10.0.0.1/32,any",
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		raw             string
+		want            map[string]string
+		wantErrContains string
+	}{
+		{
+			name: "templated",
+			raw:  `{"force-command": "/bin/login-as {{ .Account.TokenClaims.preferred_username }}", "source-address": "{{ .Account.TokenClaims.address }}"}`,
+			want: map[string]string{"force-command": "/bin/login-as alice", "source-address": "10.0.0.1/32"},
+		},
+		{
+			name: "literal-not-checked",
+			raw:  `{"force-command": "sh -c 'echo hi'", "permit-pty": ""}`,
+			want: map[string]string{"force-command": "sh -c 'echo hi'", "permit-pty": ""},
+		},
+		{
+			name:            "force-command-injection",
+			raw:             `{"force-command": "/bin/login-as {{ .Account.TokenClaims.injected }}"}`,
+			wantErrContains: `templated value of "force-command" contains ";"`,
+		},
+		{
+			name:            "source-address-injection",
+			raw:             `{"source-address": "{{ .Account.TokenClaims.addresses }}"}`,
+			wantErrContains: `templated value of "source-address" contains "any"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := templateSshCertMap(ctx, []byte(tt.raw), data)
+			if tt.wantErrContains != "" {
+				require.Error(err)
+				assert.ErrorContains(err, tt.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
@@ -90,6 +90,39 @@ delete from credential_vault_client_certificate
  where store_id = ?;
 `
 
+	upsertStoreAuthQuery = `
+with auth as (
+  insert into credential_vault_store_auth
+    (store_id, method, mount_path, role)
+  values
+    (@store_id, @method, @mount_path, @role)
+  on conflict (store_id) do update
+    set method     = excluded.method,
+        mount_path = excluded.mount_path,
+        role       = excluded.role
+  returning store_id
+),
+removed_secret as (
+  delete from credential_vault_store_auth_secret
+   where store_id = @store_id
+     and @secret::bytea is null
+)
+insert into credential_vault_store_auth_secret
+  (store_id, secret, secret_hmac, key_id)
+select store_id, @secret, @secret_hmac, @key_id
+  from auth
+ where @secret::bytea is not null
+on conflict (store_id) do update
+  set secret      = excluded.secret,
+      secret_hmac = excluded.secret_hmac,
+      key_id      = excluded.key_id;
+`
+
+	deleteStoreAuthQuery = `
+delete from credential_vault_store_auth
+ where store_id = ?;
+`
+
 	selectLibrariesQuery = `
 select *
   from credential_vault_library_issue_credentials
@@ -238,6 +271,9 @@ final as (
          null as critical_options,             -- Add to make union uniform
          null as extensions,                   -- Add to make union uniform
          null as additional_valid_principals,  -- Add to make union uniform
+         kv_v2,
+         kv_v2_version,
+         lease_ttl_from_session,
          'generic' as type
     from generic_libs
    union
@@ -261,6 +297,9 @@ final as (
          critical_options,
          extensions,
          additional_valid_principals,
+         null as kv_v2,                        -- Add to make union uniform
+         null as kv_v2_version,                -- Add to make union uniform
+         null as lease_ttl_from_session,       -- Add to make union uniform
          'ssh' as type
     from ssh_cert_libs
 )
@@ -309,6 +348,9 @@ final as (
          null as critical_options,             -- Add to make union uniform
          null as extensions,                   -- Add to make union uniform
          null as additional_valid_principals,  -- Add to make union uniform
+         kv_v2,
+         kv_v2_version,
+         lease_ttl_from_session,
          'generic' as type
     from generic_libs
    union
@@ -332,6 +374,9 @@ final as (
          critical_options,
          extensions,
          additional_valid_principals,
+         null as kv_v2,                        -- Add to make union uniform
+         null as kv_v2_version,                -- Add to make union uniform
+         null as lease_ttl_from_session,       -- Add to make union uniform
          'ssh' as type
     from ssh_cert_libs
 )
@@ -380,6 +425,9 @@ final as (
          null as critical_options,             -- Add to make union uniform
          null as extensions,                   -- Add to make union uniform
          null as additional_valid_principals,  -- Add to make union uniform
+         kv_v2,
+         kv_v2_version,
+         lease_ttl_from_session,
          'generic' as type
     from generic_libs
    union
@@ -403,6 +451,9 @@ final as (
          critical_options,
          extensions,
          additional_valid_principals,
+         null as kv_v2,                        -- Add to make union uniform
+         null as kv_v2_version,                -- Add to make union uniform
+         null as lease_ttl_from_session,       -- Add to make union uniform
          'ssh' as type
     from ssh_cert_libs
 )
@@ -452,6 +503,9 @@ final as (
          null as critical_options,             -- Add to make union uniform
          null as extensions,                   -- Add to make union uniform
          null as additional_valid_principals,  -- Add to make union uniform
+         kv_v2,
+         kv_v2_version,
+         lease_ttl_from_session,
          'generic' as type
     from generic_libs
    union
@@ -475,6 +529,9 @@ final as (
          critical_options,
          extensions,
          additional_valid_principals,
+         null as kv_v2,                        -- Add to make union uniform
+         null as kv_v2_version,                -- Add to make union uniform
+         null as lease_ttl_from_session,       -- Add to make union uniform
          'ssh' as type
     from ssh_cert_libs
 )
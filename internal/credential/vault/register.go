@@ -43,5 +43,14 @@ func (credentialHooks) NewStore(ctx context.Context, result *credential.StoreLis
 		s.clientCert.CertificateKeyHmac = result.ClientCertKeyHmac
 	}
 
+	if result.AuthMethod != "" {
+		s.auth = allocStoreAuth()
+		s.auth.StoreId = result.PublicId
+		s.auth.Method = result.AuthMethod
+		s.auth.MountPath = result.AuthMountPath
+		s.auth.Role = result.AuthRole
+		s.auth.SecretHmac = result.AuthSecretHmac
+	}
+
 	return s, nil
 }
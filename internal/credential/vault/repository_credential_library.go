@@ -122,14 +122,18 @@ func (r *Repository) CreateCredentialLibrary(ctx context.Context, projectId stri
 // number of records updated. l is not changed.
 //
 // l must contain a valid PublicId. Only Name, Description, VaultPath,
-// HttpMethod, HttpRequestBody, and MappingOverride can be updated. If
-// l.Name is set to a non-empty string, it must be unique within l.StoreId.
+// HttpMethod, HttpRequestBody, KvV2, KvV2Version, LeaseTtlFromSession, and
+// MappingOverride can be updated. If l.Name is set to a non-empty string,
+// it must be unique within l.StoreId.
 //
 // An attribute of l will be set to NULL in the database if the attribute
 // in l is the zero value and it is included in fieldMaskPaths except for
-// HttpMethod.  If HttpMethod is in the fieldMaskPath but l.HttpMethod
-// is not set it will be set to the value "GET".  If storage has a value
-// for HttpRequestBody when l.HttpMethod is set to GET the update will fail.
+// HttpMethod, KvV2, KvV2Version, and LeaseTtlFromSession.  If HttpMethod
+// is in the fieldMaskPath but l.HttpMethod is not set it will be set to
+// the value "GET".  KvV2, KvV2Version, and LeaseTtlFromSession are set to
+// their zero values. If storage has a value for HttpRequestBody when
+// l.HttpMethod is set to GET the update will fail. If storage has KvV2 set
+// to true when l.HttpMethod is set to POST the update will fail.
 func (r *Repository) UpdateCredentialLibrary(ctx context.Context, projectId string, l *CredentialLibrary, version uint32, fieldMaskPaths []string, _ ...Option) (*CredentialLibrary, int, error) {
 	const op = "vault.(Repository).UpdateCredentialLibrary"
 	if l == nil {
@@ -157,6 +161,9 @@ func (r *Repository) UpdateCredentialLibrary(ctx context.Context, projectId stri
 		case strings.EqualFold(vaultPathField, f):
 		case strings.EqualFold(httpMethodField, f):
 		case strings.EqualFold(httpRequestBodyField, f):
+		case strings.EqualFold(kvV2Field, f):
+		case strings.EqualFold(kvV2VersionField, f):
+		case strings.EqualFold(leaseTtlFromSessionField, f):
 		case strings.EqualFold(MappingOverrideField, f):
 			updateMappingOverride = true
 		default:
@@ -166,15 +173,22 @@ func (r *Repository) UpdateCredentialLibrary(ctx context.Context, projectId stri
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbw.BuildUpdatePaths(
 		map[string]any{
-			nameField:            l.Name,
-			descriptionField:     l.Description,
-			vaultPathField:       l.VaultPath,
-			httpMethodField:      l.HttpMethod,
-			httpRequestBodyField: l.HttpRequestBody,
-			MappingOverrideField: l.MappingOverride,
+			nameField:                l.Name,
+			descriptionField:         l.Description,
+			vaultPathField:           l.VaultPath,
+			httpMethodField:          l.HttpMethod,
+			httpRequestBodyField:     l.HttpRequestBody,
+			kvV2Field:                l.KvV2,
+			kvV2VersionField:         l.KvV2Version,
+			MappingOverrideField:     l.MappingOverride,
+			leaseTtlFromSessionField: l.LeaseTtlFromSession,
 		},
 		fieldMaskPaths,
-		nil,
+		[]string{
+			kvV2Field,
+			kvV2VersionField,
+			leaseTtlFromSessionField,
+		},
 	)
 
 	if strutil.StrListContains(nullFields, httpMethodField) {
@@ -189,6 +203,14 @@ func (r *Repository) UpdateCredentialLibrary(ctx context.Context, projectId stri
 		l.HttpMethod = string(MethodGet)
 	}
 
+	if strutil.StrListContains(dbMask, kvV2Field) && !l.KvV2 && !strutil.StrListContains(dbMask, kvV2VersionField) {
+		// A KV v2 version can only be set when KvV2 is true. Therefore,
+		// if KvV2 is being set to false: add the KvV2Version field to
+		// dbMask and reset the value so the latest version is requested.
+		dbMask = append(dbMask, kvV2VersionField)
+		l.KvV2Version = 0
+	}
+
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
 	}
@@ -367,6 +389,12 @@ type listLookupLibrary struct {
 	PasswordAttribute             string
 	PrivateKeyAttribute           string
 	PrivateKeyPassphraseAttribute string
+	CertificateAttribute          string
+	CaCertificateAttribute        string
+	KubeconfigAttribute           string
+	KvV2                          bool
+	KvV2Version                   uint32
+	LeaseTtlFromSession           bool
 }
 
 func allocListLookupLibrary() *listLookupLibrary {
@@ -386,6 +414,9 @@ func (pl *listLookupLibrary) toCredentialLibrary() *CredentialLibrary {
 	cl.HttpMethod = pl.HttpMethod
 	cl.HttpRequestBody = pl.HttpRequestBody
 	cl.CredentialLibrary.CredentialType = pl.CredentialType
+	cl.KvV2 = pl.KvV2
+	cl.KvV2Version = pl.KvV2Version
+	cl.LeaseTtlFromSession = pl.LeaseTtlFromSession
 
 	switch pl.CredentialType {
 	case string(globals.UsernamePasswordCredentialType):
@@ -407,6 +438,24 @@ func (pl *listLookupLibrary) toCredentialLibrary() *CredentialLibrary {
 			pk.sanitize()
 			cl.MappingOverride = pk
 		}
+	case string(globals.TlsClientCertificateCredentialType):
+		if pl.CertificateAttribute != "" || pl.PrivateKeyAttribute != "" || pl.CaCertificateAttribute != "" {
+			tc := allocTlsClientCertificateOverride()
+			tc.LibraryId = pl.PublicId
+			tc.CertificateAttribute = pl.CertificateAttribute
+			tc.PrivateKeyAttribute = pl.PrivateKeyAttribute
+			tc.CaCertificateAttribute = pl.CaCertificateAttribute
+			tc.sanitize()
+			cl.MappingOverride = tc
+		}
+	case string(globals.KubeconfigCredentialType):
+		if pl.KubeconfigAttribute != "" {
+			kc := allocKubeconfigOverride()
+			kc.LibraryId = pl.PublicId
+			kc.KubeconfigAttribute = pl.KubeconfigAttribute
+			kc.sanitize()
+			cl.MappingOverride = kc
+		}
 	}
 	return cl
 }
@@ -23,9 +23,14 @@ import (
 // CredentialStore containing the credential store's PublicId. cs is not
 // changed. cs must not contain a PublicId. The PublicId is generated and
 // assigned by this method. cs must contain a valid ProjectId, VaultAddress,
-// and Vault token. The Vault token must be renewable, periodic, and
-// orphan. CreateCredentialStore calls the /auth/token/renew-self and
-// /auth/token/lookup-self Vault endpoints.
+// and either a Vault token or a StoreAuth. The Vault token must be
+// renewable, periodic, and orphan. CreateCredentialStore calls the
+// /auth/token/renew-self and /auth/token/lookup-self Vault endpoints.
+//
+// If cs contains a StoreAuth, CreateCredentialStore logs in to Vault with
+// it and uses the token returned by Vault. The token must be renewable but
+// it does not need to be periodic or orphan since the credential store logs
+// in again when the token can no longer be renewed.
 //
 // Both cs.Name and cs.Description are optional. If cs.Name is set, it must
 // be unique within cs.ProjectId. Both cs.CreateTime and cs.UpdateTime are
@@ -53,8 +58,11 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 	if cs.ProjectId == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
 	}
-	if len(cs.inputToken) == 0 {
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "no vault token")
+	if len(cs.inputToken) == 0 && cs.auth == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no vault token or auth method")
+	}
+	if len(cs.inputToken) != 0 && cs.auth != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "vault token and auth method are mutually exclusive")
 	}
 	if cs.VaultAddress == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "no vault address")
@@ -67,6 +75,14 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 	}
 
 	cs = cs.clone()
+	if cs.auth != nil {
+		if cs.auth.MountPath == "" {
+			cs.auth.MountPath = cs.auth.Method
+		}
+		if err := cs.auth.validate(ctx); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
 
 	id, err := newCredentialStoreId(ctx)
 	if err != nil {
@@ -76,16 +92,30 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 	if cs.clientCert != nil {
 		cs.clientCert.StoreId = id
 	}
+	if cs.auth != nil {
+		cs.auth.StoreId = id
+	}
 
 	client, err := cs.client(ctx)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create vault client"))
 	}
+	if cs.auth != nil {
+		loginSecret, err := client.login(ctx, cs.auth)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to log in to vault"))
+		}
+		cs.inputToken = TokenSecret(loginSecret.Auth.ClientToken)
+	}
 	tokenLookup, err := client.lookupToken(ctx)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup vault token"))
 	}
-	if err := validateTokenLookup(ctx, op, tokenLookup); err != nil {
+	validateLookup := validateTokenLookup
+	if cs.auth != nil {
+		validateLookup = validateLoginTokenLookup
+	}
+	if err := validateLookup(ctx, op, tokenLookup); err != nil {
 		return nil, err
 	}
 
@@ -142,6 +172,11 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 			return nil, errors.Wrap(ctx, err, op)
 		}
 	}
+	if cs.auth != nil {
+		if err := cs.auth.encrypt(ctx, databaseWrapper); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
 
 	var newToken *Token
 	var newClientCertificate *ClientCertificate
@@ -193,6 +228,20 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 				newCredentialStore.clientCert = newClientCertificate
 
 			}
+
+			// insert auth method (if exists)
+			if cs.auth != nil {
+				query, values := cs.auth.upsertQuery()
+				rows, err := w.Exec(ctx, query, values)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to insert auth method"))
+				}
+				if rows > 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 auth method would have been created")
+				}
+				newCredentialStore.auth.Secret = nil
+				newCredentialStore.auth.CtSecret = nil
+			}
 			metadata := cs.oplog(oplog.OpType_OP_TYPE_CREATE)
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
@@ -250,6 +299,28 @@ func validateTokenLookup(ctx context.Context, op errors.Op, s *vault.Secret) err
 	return nil
 }
 
+// validateLoginTokenLookup validates a token a credential store obtained by
+// logging in to Vault. Unlike validateTokenLookup, the token does not need
+// to be periodic or orphan since the credential store logs in again when
+// the token can no longer be renewed.
+func validateLoginTokenLookup(ctx context.Context, op errors.Op, s *vault.Secret) error {
+	if s.Data == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "vault secret is not a token lookup")
+	}
+
+	if s.Data["renewable"] == nil {
+		return errors.E(ctx, errors.WithCode(errors.VaultTokenNotRenewable), errors.WithOp(op))
+	}
+	renewable, err := parseutil.ParseBool(s.Data["renewable"])
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if !renewable {
+		return errors.E(ctx, errors.WithCode(errors.VaultTokenNotRenewable), errors.WithOp(op))
+	}
+	return nil
+}
+
 // LookupCredentialStore returns the CredentialStore for publicId. Returns
 // nil, nil if no CredentialStore is found for publicId.
 func (r *Repository) LookupCredentialStore(ctx context.Context, publicId string, _ ...Option) (*CredentialStore, error) {
@@ -286,6 +357,10 @@ type listLookupStore struct {
 	TokenStatus       string
 	ClientCert        []byte
 	ClientCertKeyHmac []byte
+	AuthMethod        string
+	AuthMountPath     string
+	AuthRole          string
+	AuthSecretHmac    []byte
 }
 
 func allocListLookupStore() *listLookupStore {
@@ -319,6 +394,16 @@ func (ps *listLookupStore) toCredentialStore() *CredentialStore {
 		cert.CertificateKeyHmac = ps.ClientCertKeyHmac
 		cs.clientCert = cert
 	}
+
+	if ps.AuthMethod != "" {
+		auth := allocStoreAuth()
+		auth.StoreId = ps.PublicId
+		auth.Method = ps.AuthMethod
+		auth.MountPath = ps.AuthMountPath
+		auth.Role = ps.AuthRole
+		auth.SecretHmac = ps.AuthSecretHmac
+		cs.auth = auth
+	}
 	return cs
 }
 
@@ -362,7 +447,7 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 	}
 	cs = cs.clone()
 
-	var validateToken, updateToken bool
+	var validateToken, updateToken, updateAuth bool
 	for _, f := range fieldMaskPaths {
 		switch {
 		case strings.EqualFold(nameField, f):
@@ -381,6 +466,14 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 				updateToken = true
 				validateToken = true
 			}
+		case strings.EqualFold(AuthMethodField, f):
+			updateAuth = true
+		case strings.EqualFold(AuthMountPathField, f):
+			updateAuth = true
+		case strings.EqualFold(AuthRoleField, f):
+			updateAuth = true
+		case strings.EqualFold(AuthSecretField, f):
+			updateAuth = true
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
 		}
@@ -417,7 +510,7 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 	if len(certNullFields) != 0 && len(certNullFields) != 2 {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "attempting to unset a required field on a client cert")
 	}
-	if len(append(dbMask, certDbMask...)) == 0 && len(append(nullFields, certNullFields...)) == 0 {
+	if len(append(dbMask, certDbMask...)) == 0 && len(append(nullFields, certNullFields...)) == 0 && !updateAuth {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
 	}
 
@@ -464,8 +557,30 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 	if err != nil {
 		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("can't recreate client certificate for vault client creation"))
 	}
+	if origStore.auth, err = lookupStoreAuth(ctx, r.reader, databaseWrapper, cs.GetPublicId()); err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
 	updatedStore := origStore.applyUpdate(cs, fieldMaskPaths)
 
+	if updatedStore.auth != nil {
+		if updateToken {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "vault token and auth method are mutually exclusive")
+		}
+		if updatedStore.auth.MountPath == "" {
+			updatedStore.auth.MountPath = updatedStore.auth.Method
+		}
+		if err := updatedStore.auth.validate(ctx); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		if updateAuth {
+			if err := updatedStore.auth.encrypt(ctx, databaseWrapper); err != nil {
+				return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+			}
+		}
+	} else if updateAuth && origStore.auth == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no auth method")
+	}
+
 	if len(certDbMask) > 0 && updatedStore.clientCert != nil {
 		if err := updatedStore.clientCert.encrypt(ctx, databaseWrapper); err != nil {
 			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
@@ -477,12 +592,27 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 	if err != nil {
 		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get client for updated store"))
 	}
+	if updatedStore.auth != nil && (updateAuth || validateToken) {
+		// The credential store logs in again whenever its auth method or
+		// its Vault address changes.
+		loginSecret, err := client.login(ctx, updatedStore.auth)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to log in to vault"))
+		}
+		cs.inputToken = TokenSecret(loginSecret.Auth.ClientToken)
+		updateToken = true
+		validateToken = true
+	}
 	if validateToken {
 		tokenLookup, err := client.lookupToken(ctx)
 		if err != nil {
 			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("cannot lookup token for updated store"))
 		}
-		if err := validateTokenLookup(ctx, op, tokenLookup); err != nil {
+		validateLookup := validateTokenLookup
+		if updatedStore.auth != nil {
+			validateLookup = validateLoginTokenLookup
+		}
+		if err := validateLookup(ctx, op, tokenLookup); err != nil {
 			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
 		}
 
@@ -600,6 +730,29 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 				}
 			}
 
+			switch {
+			case updateAuth && updatedStore.auth == nil:
+				deleteAuth := allocStoreAuth()
+				deleteAuth.StoreId = cs.GetPublicId()
+				query, values := deleteAuth.deleteQuery()
+				rows, err := w.Exec(ctx, query, values)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete auth method"))
+				}
+				if rows > 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 auth method would have been deleted")
+				}
+			case updateAuth:
+				query, values := updatedStore.auth.upsertQuery()
+				rows, err := w.Exec(ctx, query, values)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to upsert auth method"))
+				}
+				if rows > 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 auth method would have been upserted")
+				}
+			}
+
 			if updateToken {
 				query, values := token.insertQuery()
 				rows, err := w.Exec(ctx, query, values)
@@ -231,6 +231,180 @@ func TestRepository_CreateCredentialStoreNonResource(t *testing.T) {
 	}
 }
 
+func TestRepository_CreateCredentialStore_StoreAuth(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	ctx := context.Background()
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kms, sche)
+	require.NoError(t, err)
+	require.NoError(t, RegisterJobs(ctx, sche, rw, rw, kms))
+
+	v := NewTestVaultServer(t)
+	roleId, secretId := v.MountAppRole(t)
+	jwt := v.MountJWT(t)
+	_, token := v.CreateToken(t)
+
+	tests := []struct {
+		name    string
+		auth    func(t *testing.T) *StoreAuth
+		token   string
+		wantErr errors.Code
+	}{
+		{
+			name: "approle",
+			auth: func(t *testing.T) *StoreAuth {
+				a, err := NewStoreAuth(ctx, AppRoleAuthMethod, roleId, AuthSecret(secretId))
+				require.NoError(t, err)
+				return a
+			},
+		},
+		{
+			name: "jwt",
+			auth: func(t *testing.T) *StoreAuth {
+				a, err := NewStoreAuth(ctx, JwtAuthMethod, "boundary", AuthSecret(jwt))
+				require.NoError(t, err)
+				return a
+			},
+		},
+		{
+			name: "approle-invalid-secret",
+			auth: func(t *testing.T) *StoreAuth {
+				a, err := NewStoreAuth(ctx, AppRoleAuthMethod, roleId, AuthSecret("invalid"))
+				require.NoError(t, err)
+				return a
+			},
+			wantErr: errors.Unknown,
+		},
+		{
+			name: "token-and-auth",
+			auth: func(t *testing.T) *StoreAuth {
+				a, err := NewStoreAuth(ctx, AppRoleAuthMethod, roleId, AuthSecret(secretId))
+				require.NoError(t, err)
+				return a
+			},
+			token:   token,
+			wantErr: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+			in, err := NewCredentialStore(prj.GetPublicId(), v.Addr, []byte(tt.token), WithStoreAuth(tt.auth(t)))
+			require.NoError(err)
+			got, err := repo.CreateCredentialStore(ctx, in)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			require.NotNil(got.StoreAuth())
+			assert.Empty(got.StoreAuth().Secret)
+			assert.Empty(got.StoreAuth().CtSecret)
+			assert.Equal(string(in.StoreAuth().Method), got.StoreAuth().Method)
+
+			outToken := allocToken()
+			require.NoError(rw.LookupWhere(ctx, &outToken, "store_id = ?", []any{got.PublicId}))
+			assert.Equal(string(CurrentToken), outToken.Status)
+
+			auth, err := lookupStoreAuth(ctx, rw, wrapper, got.PublicId)
+			require.NoError(err)
+			require.NotNil(auth)
+			assert.Equal(in.StoreAuth().Secret, auth.Secret)
+			assert.NotEmpty(auth.SecretHmac)
+
+			lookup, err := repo.LookupCredentialStore(ctx, got.PublicId)
+			require.NoError(err)
+			require.NotNil(lookup.StoreAuth())
+			assert.Equal(got.StoreAuth().Role, lookup.StoreAuth().Role)
+			assert.Equal(auth.SecretHmac, lookup.StoreAuth().SecretHmac)
+		})
+	}
+}
+
+func TestRepository_UpdateCredentialStore_StoreAuth(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kms, sche)
+	require.NoError(err)
+	require.NoError(RegisterJobs(ctx, sche, rw, rw, kms))
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	v := NewTestVaultServer(t)
+	roleId, secretId := v.MountAppRole(t)
+	_, token := v.CreateToken(t)
+
+	in, err := NewCredentialStore(prj.GetPublicId(), v.Addr, []byte(token))
+	require.NoError(err)
+	orig, err := repo.CreateCredentialStore(ctx, in)
+	require.NoError(err)
+	require.Nil(orig.StoreAuth())
+	origToken := allocToken()
+	require.NoError(rw.LookupWhere(ctx, &origToken, "store_id = ? and status = ?", []any{orig.PublicId, CurrentToken}))
+
+	// Updating only the role of a store without an auth method fails.
+	upd, err := NewCredentialStore(prj.GetPublicId(), "", nil, WithStoreAuth(&StoreAuth{Role: roleId}))
+	require.NoError(err)
+	upd.PublicId = orig.PublicId
+	got, _, err := repo.UpdateCredentialStore(ctx, upd, orig.Version, []string{AuthRoleField})
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+	assert.Nil(got)
+
+	// Adding an auth method logs in and replaces the current token.
+	auth, err := NewStoreAuth(ctx, AppRoleAuthMethod, roleId, AuthSecret(secretId))
+	require.NoError(err)
+	upd, err = NewCredentialStore(prj.GetPublicId(), "", nil, WithStoreAuth(auth))
+	require.NoError(err)
+	upd.PublicId = orig.PublicId
+	got, count, err := repo.UpdateCredentialStore(ctx, upd, orig.Version, []string{AuthMethodField, AuthRoleField, AuthSecretField})
+	require.NoError(err)
+	assert.Equal(1, count)
+	require.NotNil(got.StoreAuth())
+	assert.Equal(string(AppRoleAuthMethod), got.StoreAuth().Method)
+	assert.Equal("approle", got.StoreAuth().MountPath)
+	assert.NotEqual(origToken.TokenHmac, got.Token().TokenHmac)
+
+	maintaining := allocToken()
+	require.NoError(rw.LookupWhere(ctx, &maintaining, "token_hmac = ?", []any{origToken.TokenHmac}))
+	assert.Equal(string(MaintainingToken), maintaining.Status)
+
+	// Updating the token of a store with an auth method fails.
+	_, token2 := v.CreateToken(t)
+	upd, err = NewCredentialStore(prj.GetPublicId(), "", []byte(token2))
+	require.NoError(err)
+	upd.PublicId = orig.PublicId
+	_, _, err = repo.UpdateCredentialStore(ctx, upd, got.Version, []string{"Token"})
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+
+	// Removing the auth method keeps the current token.
+	upd, err = NewCredentialStore(prj.GetPublicId(), "", nil)
+	require.NoError(err)
+	upd.PublicId = orig.PublicId
+	got2, count, err := repo.UpdateCredentialStore(ctx, upd, got.Version, []string{AuthMethodField})
+	require.NoError(err)
+	assert.Equal(1, count)
+	assert.Nil(got2.StoreAuth())
+	assert.Equal(got.Token().TokenHmac, got2.Token().TokenHmac)
+
+	lookup, err := lookupStoreAuth(ctx, rw, wrapper, orig.PublicId)
+	require.NoError(err)
+	assert.Nil(lookup)
+}
+
 func TestRepository_LookupCredentialStore(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -161,3 +161,31 @@ func (r *Repository) Revoke(ctx context.Context, sessionId string) error {
 	)
 	return err
 }
+
+// RevokeSessionCredentials revokes the Vault leases of all dynamic
+// credentials issued for sessionId which have been set for revocation. It
+// is called when a session is terminated so leases do not outlive the
+// session until the next run of the credential revocation job. A
+// credential that fails to be revoked is written as an error event for the
+// session and is left for the credential revocation job to retry.
+func (r *Repository) RevokeSessionCredentials(ctx context.Context, sessionId string) error {
+	const op = "vault.(Repository).RevokeSessionCredentials"
+	if sessionId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	}
+
+	var creds []*privateCredential
+	if err := r.reader.SearchWhere(ctx, &creds, "session_id = ? and status = ?", []any{sessionId, RevokeCredential}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	for _, c := range creds {
+		if err := revokeCredential(ctx, r.writer, r.kms, c); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error revoking session credential",
+				"session_id", sessionId,
+				"credential_id", c.PublicId,
+				"credential_library_id", c.LibraryId,
+			))
+		}
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/authtoken"
@@ -505,6 +506,212 @@ func TestRepository_IssueCredentials(t *testing.T) {
 	}
 }
 
+func TestRepository_IssueCredentials_KvV2(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	v := vault.NewTestVaultServer(t)
+	v.AddKVPolicy(t)
+
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	kms := kms.TestKms(t, conn, wrapper)
+
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	repo, err := vault.NewRepository(ctx, rw, rw, kms, sche)
+	require.NoError(t, err)
+	require.NotNil(t, repo)
+
+	_, token := v.CreateToken(t, vault.WithPolicies([]string{"default", "boundary-controller", "secret"}))
+
+	// Create two versions of the same secret
+	v.CreateKVSecret(t, "my-up-secret", []byte(`{"data":{"username":"user","password":"pass-v1"}}`))
+	v.CreateKVSecret(t, "my-up-secret", []byte(`{"data":{"username":"user","password":"pass-v2"}}`))
+
+	credStoreIn, err := vault.NewCredentialStore(prj.GetPublicId(), v.Addr, []byte(token))
+	require.NoError(t, err)
+	credStore, err := repo.CreateCredentialStore(ctx, credStoreIn)
+	require.NoError(t, err)
+
+	createLib := func(t *testing.T, opt ...vault.Option) string {
+		t.Helper()
+		libIn, err := vault.NewCredentialLibrary(credStore.GetPublicId(), path.Join("secret", "data", "my-up-secret"), opt...)
+		require.NoError(t, err)
+		lib, err := repo.CreateCredentialLibrary(ctx, prj.GetPublicId(), libIn)
+		require.NoError(t, err)
+		return lib.GetPublicId()
+	}
+
+	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
+	hc := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	tar := tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "test", target.WithHostSources([]string{hs.GetPublicId()}))
+
+	tests := []struct {
+		name         string
+		opts         []vault.Option
+		wantPassword string
+		wantVersion  uint32
+		wantSecret   map[string]any
+		wantErr      errors.Code
+	}{
+		{
+			name:         "latest",
+			opts:         []vault.Option{vault.WithKvV2(), vault.WithCredentialType(globals.UsernamePasswordCredentialType)},
+			wantPassword: "pass-v2",
+			wantVersion:  2,
+		},
+		{
+			name:         "pinned-version",
+			opts:         []vault.Option{vault.WithKvV2(), vault.WithKvV2Version(1), vault.WithCredentialType(globals.UsernamePasswordCredentialType)},
+			wantPassword: "pass-v1",
+			wantVersion:  1,
+		},
+		{
+			name:        "unspecified-type",
+			opts:        []vault.Option{vault.WithKvV2(), vault.WithKvV2Version(1)},
+			wantVersion: 1,
+			wantSecret:  map[string]any{"username": "user", "password": "pass-v1"},
+		},
+		{
+			name:    "missing-version",
+			opts:    []vault.Option{vault.WithKvV2(), vault.WithKvV2Version(10)},
+			wantErr: errors.VaultEmptySecret,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			requests := []credential.Request{
+				{
+					SourceId: createLib(t, tt.opts...),
+					Purpose:  credential.BrokeredPurpose,
+				},
+			}
+			sess := session.TestSession(t, conn, wrapper, session.ComposedOf{
+				UserId:      at.GetIamUserId(),
+				HostId:      h.GetPublicId(),
+				TargetId:    tar.GetPublicId(),
+				HostSetId:   hs.GetPublicId(),
+				AuthTokenId: at.GetPublicId(),
+				ProjectId:   prj.GetPublicId(),
+				Endpoint:    "tcp://127.0.0.1:22",
+				DynamicCredentials: []*session.DynamicCredential{
+					{LibraryId: requests[0].SourceId, CredentialPurpose: string(requests[0].Purpose)},
+				},
+			})
+			got, err := repo.Issue(ctx, sess.GetPublicId(), requests)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.Len(got, 1)
+
+			vs, ok := got[0].(credential.VersionedSecret)
+			require.True(ok)
+			assert.Equal(tt.wantVersion, vs.SecretVersion())
+			assert.False(vs.SecretCreateTime().IsZero())
+
+			if tt.wantPassword != "" {
+				upc, ok := got[0].(credential.UsernamePassword)
+				require.True(ok)
+				assert.Equal("user", upc.Username())
+				assert.Equal(tt.wantPassword, string(upc.Password()))
+			}
+			if tt.wantSecret != nil {
+				assert.Equal(tt.wantSecret, got[0].Secret())
+			}
+		})
+	}
+}
+
+func TestRepository_IssueCredentials_LeaseTtlFromSession(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	v := vault.NewTestVaultServer(t, vault.WithDockerNetwork(true))
+	v.MountDatabase(t)
+
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	kms := kms.TestKms(t, conn, wrapper)
+
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	repo, err := vault.NewRepository(ctx, rw, rw, kms, sche)
+	require.NoError(t, err)
+	require.NotNil(t, repo)
+
+	_, token := v.CreateToken(t, vault.WithPolicies([]string{"default", "boundary-controller", "database"}))
+	credStoreIn, err := vault.NewCredentialStore(prj.GetPublicId(), v.Addr, []byte(token))
+	require.NoError(t, err)
+	credStore, err := repo.CreateCredentialStore(ctx, credStoreIn)
+	require.NoError(t, err)
+
+	libIn, err := vault.NewCredentialLibrary(credStore.GetPublicId(), path.Join("database", "creds", "opened"), vault.WithLeaseTtlFromSession())
+	require.NoError(t, err)
+	lib, err := repo.CreateCredentialLibrary(ctx, prj.GetPublicId(), libIn)
+	require.NoError(t, err)
+	assert.True(t, lib.GetLeaseTtlFromSession())
+
+	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
+	hc := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	tar := tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "test", target.WithHostSources([]string{hs.GetPublicId()}))
+
+	requests := []credential.Request{
+		{
+			SourceId: lib.GetPublicId(),
+			Purpose:  credential.BrokeredPurpose,
+		},
+	}
+	newSession := func(t *testing.T) *session.Session {
+		t.Helper()
+		return session.TestSession(t, conn, wrapper, session.ComposedOf{
+			UserId:      at.GetIamUserId(),
+			HostId:      h.GetPublicId(),
+			TargetId:    tar.GetPublicId(),
+			HostSetId:   hs.GetPublicId(),
+			AuthTokenId: at.GetPublicId(),
+			ProjectId:   prj.GetPublicId(),
+			Endpoint:    "tcp://127.0.0.1:22",
+			DynamicCredentials: []*session.DynamicCredential{
+				{LibraryId: requests[0].SourceId, CredentialPurpose: string(requests[0].Purpose)},
+			},
+		})
+	}
+
+	t.Run("remaining-session-time", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		sess := newSession(t)
+		got, err := repo.Issue(ctx, sess.GetPublicId(), requests, credential.WithSessionExpiration(time.Now().Add(10*time.Minute)))
+		require.NoError(err)
+		require.Len(got, 1)
+
+		dbCred := lookupDbCred(t, ctx, rw, got[0])
+		require.NotNil(dbCred)
+		ttl := dbCred.ExpirationTime.AsTime().Sub(dbCred.LastRenewalTime.AsTime())
+		assert.InDelta((10 * time.Minute).Seconds(), ttl.Seconds(), 10)
+	})
+	t.Run("expired-session", func(t *testing.T) {
+		assert := assert.New(t)
+		sess := newSession(t)
+		got, err := repo.Issue(ctx, sess.GetPublicId(), requests, credential.WithSessionExpiration(time.Now().Add(-time.Minute)))
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+		assert.Nil(got)
+	})
+}
+
 func TestRepository_Revoke(t *testing.T) {
 	t.Parallel()
 	assert, require := assert.New(t), require.New(t)
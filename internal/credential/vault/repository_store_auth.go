@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+)
+
+// lookupStoreAuth returns the decrypted StoreAuth of the credential store
+// storeId. It returns nil, nil if the credential store does not have a
+// StoreAuth.
+func lookupStoreAuth(ctx context.Context, r db.Reader, cipher wrapping.Wrapper, storeId string) (*StoreAuth, error) {
+	const op = "vault.lookupStoreAuth"
+	if storeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no store id")
+	}
+	auth := allocStoreAuth()
+	if err := r.LookupWhere(ctx, auth, "store_id = ?", []any{storeId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", storeId)))
+	}
+	secret := allocStoreAuthSecret()
+	switch err := r.LookupWhere(ctx, secret, "store_id = ?", []any{storeId}); {
+	case err == nil:
+		auth.CtSecret, auth.SecretHmac, auth.KeyId = secret.CtSecret, secret.SecretHmac, secret.KeyId
+	case !errors.IsNotFoundError(err):
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed to look up secret for: %s", storeId)))
+	}
+	if err := auth.decrypt(ctx, cipher); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return auth, nil
+}
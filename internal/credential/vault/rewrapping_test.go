@@ -135,3 +135,67 @@ func TestRewrap_credVaultTokenRewrapFn(t *testing.T) {
 		assert.Equal(t, token.GetTokenHmac(), got.GetTokenHmac())
 	})
 }
+
+func TestRewrap_credVaultStoreAuthSecretRewrapFn(t *testing.T) {
+	ctx := context.Background()
+	t.Run("errors-on-query-error", func(t *testing.T) {
+		conn, mock := db.TestSetupWithMock(t)
+		wrapper := db.TestWrapper(t)
+		mock.ExpectQuery(
+			`SELECT \* FROM "kms_schema_version" WHERE 1=1 ORDER BY "kms_schema_version"\."version" LIMIT \$1`,
+		).WillReturnRows(sqlmock.NewRows([]string{"version", "create_time"}).AddRow(migrations.Version, time.Now()))
+		mock.ExpectQuery(
+			`SELECT \* FROM "kms_oplog_schema_version" WHERE 1=1 ORDER BY "kms_oplog_schema_version"."version" LIMIT \$1`,
+		).WillReturnRows(sqlmock.NewRows([]string{"version", "create_time"}).AddRow(migrations.Version, time.Now()))
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		rw := db.New(conn)
+		mock.ExpectQuery(
+			`SELECT \* FROM "credential_vault_store_auth_secret" WHERE key_id=\$1`,
+		).WillReturnError(errors.New("Query error"))
+		err := credVaultStoreAuthSecretRewrapFn(ctx, "some_id", "some_scope", rw, rw, kmsCache)
+		require.Error(t, err)
+	})
+	t.Run("success", func(t *testing.T) {
+		conn, _ := db.TestSetup(t, "postgres")
+		wrapper := db.TestWrapper(t)
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		rw := db.New(conn)
+
+		_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+		cs := TestCredentialStore(t, conn, wrapper, prj.PublicId, "https://vault.consul.service", "token", "accessor")
+		auth, err := NewStoreAuth(ctx, AppRoleAuthMethod, "role-id", AuthSecret("secret-id"))
+		require.NoError(t, err)
+		auth.StoreId = cs.PublicId
+
+		kmsWrapper, err := kmsCache.GetWrapper(context.Background(), prj.PublicId, kms.KeyPurposeDatabase)
+		require.NoError(t, err)
+		require.NoError(t, auth.encrypt(ctx, kmsWrapper))
+		query, values := auth.upsertQuery()
+		_, err = rw.Exec(ctx, query, values)
+		require.NoError(t, err)
+
+		// now things are stored in the db, we can rotate and rewrap
+		assert.NoError(t, kmsCache.RotateKeys(ctx, prj.PublicId))
+		assert.NoError(t, credVaultStoreAuthSecretRewrapFn(ctx, auth.KeyId, prj.PublicId, rw, rw, kmsCache))
+
+		// now we pull the secret back from the db, decrypt it with the new key, and ensure things match
+		got := allocStoreAuthSecret()
+		assert.NoError(t, rw.LookupWhere(ctx, got, "store_id = ?", []any{cs.PublicId}))
+
+		kmsWrapper2, err := kmsCache.GetWrapper(context.Background(), prj.PublicId, kms.KeyPurposeDatabase, kms.WithKeyId(got.KeyId))
+		assert.NoError(t, err)
+		newKeyVersionId, err := kmsWrapper2.KeyId(ctx)
+		assert.NoError(t, err)
+
+		gotAuth, err := lookupStoreAuth(ctx, rw, kmsWrapper2, cs.PublicId)
+		require.NoError(t, err)
+		require.NotNil(t, gotAuth)
+
+		// decrypt with the new key version and check to make sure things match
+		assert.NotEmpty(t, gotAuth.KeyId)
+		assert.NotEqual(t, auth.KeyId, gotAuth.KeyId)
+		assert.Equal(t, newKeyVersionId, gotAuth.KeyId)
+		assert.Equal(t, "secret-id", string(gotAuth.Secret))
+		assert.Equal(t, auth.SecretHmac, gotAuth.SecretHmac)
+	})
+}
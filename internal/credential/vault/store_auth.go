@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+)
+
+// An AuthMethod is a Vault auth method a credential store can use to log
+// in to Vault and obtain its own token.
+type AuthMethod string
+
+const (
+	// AppRoleAuthMethod logs in to Vault with an AppRole role_id and
+	// secret_id. See
+	// https://developer.hashicorp.com/vault/docs/auth/approle.
+	AppRoleAuthMethod AuthMethod = "approle"
+
+	// JwtAuthMethod logs in to Vault with a JWT and the name of a role
+	// configured on a JWT auth method. See
+	// https://developer.hashicorp.com/vault/docs/auth/jwt.
+	JwtAuthMethod AuthMethod = "jwt"
+
+	// KubernetesAuthMethod logs in to Vault with the Kubernetes service
+	// account token of the controller and the name of a role configured on a
+	// Kubernetes auth method. See
+	// https://developer.hashicorp.com/vault/docs/auth/kubernetes.
+	KubernetesAuthMethod AuthMethod = "kubernetes"
+)
+
+// kubernetesServiceAccountTokenPath is the file the Kubernetes service
+// account token is read from when a StoreAuth using KubernetesAuthMethod
+// does not have a secret.
+var kubernetesServiceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+func (m AuthMethod) isValid() bool {
+	switch m {
+	case AppRoleAuthMethod, JwtAuthMethod, KubernetesAuthMethod:
+		return true
+	}
+	return false
+}
+
+// AuthSecret equals a Vault AppRole secret_id or a JWT. This type provides a
+// wrapper so the secret isn't inadvertently leaked into a log or error.
+type AuthSecret []byte
+
+// redactedAuthSecret is the redacted string or json for a Vault auth secret.
+const redactedAuthSecret = "[REDACTED: Vault auth_secret]"
+
+// String will redact the AuthSecret.
+func (s AuthSecret) String() string {
+	return redactedAuthSecret
+}
+
+// GoString will redact the AuthSecret.
+func (s AuthSecret) GoString() string {
+	return redactedAuthSecret
+}
+
+// MarshalJSON will redact the AuthSecret.
+func (s AuthSecret) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]byte(redactedAuthSecret))
+}
+
+// A StoreAuth contains the Vault auth method and the credentials a
+// credential store uses to log in to Vault. It is owned by a credential
+// store. A credential store with a StoreAuth logs in to Vault when it is
+// created and logs in again whenever its token can no longer be renewed.
+//
+// Role is the AppRole role_id for AppRoleAuthMethod and the name of the
+// role to log in with for JwtAuthMethod and KubernetesAuthMethod. Secret is
+// the AppRole secret_id for AppRoleAuthMethod and the JWT for
+// JwtAuthMethod. For KubernetesAuthMethod, Secret is optional and the
+// service account token of the controller is used if it is not set.
+type StoreAuth struct {
+	StoreId    string               `gorm:"primary_key"`
+	CreateTime *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	UpdateTime *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	Method     string
+	MountPath  string
+	Role       string
+	Secret     AuthSecret `gorm:"-"`
+	CtSecret   []byte     `gorm:"-"`
+	SecretHmac []byte     `gorm:"-"`
+	KeyId      string     `gorm:"-"`
+}
+
+// NewStoreAuth creates a new in memory StoreAuth for method. The mount path
+// defaults to the name of method. WithAuthMountPath is the only valid
+// option. All other options are ignored.
+func NewStoreAuth(ctx context.Context, method AuthMethod, role string, secret AuthSecret, opt ...Option) (*StoreAuth, error) {
+	const op = "vault.NewStoreAuth"
+	opts := getOpts(opt...)
+	mountPath := strings.Trim(opts.withAuthMountPath, "/")
+	if mountPath == "" {
+		mountPath = string(method)
+	}
+
+	var secretCopy AuthSecret
+	if len(secret) > 0 {
+		secretCopy = make(AuthSecret, len(secret))
+		copy(secretCopy, secret)
+	}
+	a := &StoreAuth{
+		Method:    string(method),
+		MountPath: mountPath,
+		Role:      role,
+		Secret:    secretCopy,
+	}
+	if err := a.validate(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return a, nil
+}
+
+func (a *StoreAuth) validate(ctx context.Context) error {
+	const op = "vault.(StoreAuth).validate"
+	switch {
+	case !AuthMethod(a.Method).isValid():
+		return errors.New(ctx, errors.InvalidParameter, op, "unknown auth method")
+	case strings.Trim(a.MountPath, "/") == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "no mount path")
+	case a.Role == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "no role")
+	case len(a.Secret) == 0 && AuthMethod(a.Method) != KubernetesAuthMethod:
+		return errors.New(ctx, errors.InvalidParameter, op, "no secret")
+	}
+	return nil
+}
+
+func allocStoreAuth() *StoreAuth {
+	return &StoreAuth{}
+}
+
+func (a *StoreAuth) clone() *StoreAuth {
+	cp := *a
+	if a.Secret != nil {
+		cp.Secret = make(AuthSecret, len(a.Secret))
+		copy(cp.Secret, a.Secret)
+	}
+	if a.CtSecret != nil {
+		cp.CtSecret = make([]byte, len(a.CtSecret))
+		copy(cp.CtSecret, a.CtSecret)
+	}
+	if a.SecretHmac != nil {
+		cp.SecretHmac = make([]byte, len(a.SecretHmac))
+		copy(cp.SecretHmac, a.SecretHmac)
+	}
+	return &cp
+}
+
+// TableName returns the table name.
+func (a *StoreAuth) TableName() string {
+	return "credential_vault_store_auth"
+}
+
+// storeAuthSecret is the encrypted secret of a StoreAuth. It is stored
+// separately from the StoreAuth since a StoreAuth using
+// KubernetesAuthMethod may not have a secret.
+type storeAuthSecret struct {
+	StoreId    string               `gorm:"primary_key"`
+	CreateTime *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	UpdateTime *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	CtSecret   []byte               `gorm:"column:secret"`
+	SecretHmac []byte
+	KeyId      string
+}
+
+func allocStoreAuthSecret() *storeAuthSecret {
+	return &storeAuthSecret{}
+}
+
+// TableName returns the table name.
+func (s *storeAuthSecret) TableName() string {
+	return "credential_vault_store_auth_secret"
+}
+
+// authSecret is used to encrypt and decrypt the secret of a StoreAuth.
+type authSecret struct {
+	Secret   []byte `wrapping:"pt,secret_data"`
+	CtSecret []byte `wrapping:"ct,secret_data"`
+}
+
+func (a *StoreAuth) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "vault.(StoreAuth).encrypt"
+	if len(a.Secret) == 0 {
+		a.CtSecret, a.SecretHmac, a.KeyId = nil, nil, ""
+		return nil
+	}
+	sv := &authSecret{
+		Secret: a.Secret,
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, sv, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	a.CtSecret = sv.CtSecret
+	keyId, err := cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("error reading cipher key id"))
+	}
+	a.KeyId = keyId
+	hm, err := crypto.HmacSha256(ctx, a.Secret, cipher, []byte(a.StoreId), nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	a.SecretHmac = []byte(hm)
+	return nil
+}
+
+func (a *StoreAuth) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "vault.(StoreAuth).decrypt"
+	if len(a.CtSecret) == 0 {
+		return nil
+	}
+	sv := &authSecret{
+		CtSecret: a.CtSecret,
+	}
+	if err := structwrapping.UnwrapStruct(ctx, cipher, sv, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	a.Secret = sv.Secret
+	return nil
+}
+
+func (a *StoreAuth) upsertQuery() (query string, queryValues []any) {
+	query = upsertStoreAuthQuery
+	var keyId any
+	if a.KeyId != "" {
+		keyId = a.KeyId
+	}
+	queryValues = []any{
+		sql.Named("store_id", a.StoreId),
+		sql.Named("method", a.Method),
+		sql.Named("mount_path", a.MountPath),
+		sql.Named("role", a.Role),
+		sql.Named("secret", a.CtSecret),
+		sql.Named("secret_hmac", a.SecretHmac),
+		sql.Named("key_id", keyId),
+	}
+	return
+}
+
+func (a *StoreAuth) deleteQuery() (query string, queryValues []any) {
+	query = deleteStoreAuthQuery
+	queryValues = []any{
+		a.StoreId,
+	}
+	return
+}
+
+// loginPath returns the path of the Vault login endpoint for a.
+func (a *StoreAuth) loginPath() string {
+	return "auth/" + a.MountPath + "/login"
+}
+
+// loginData returns the body of the request to the Vault login endpoint
+// for a.
+func (a *StoreAuth) loginData(ctx context.Context) (map[string]any, error) {
+	const op = "vault.(StoreAuth).loginData"
+	switch AuthMethod(a.Method) {
+	case AppRoleAuthMethod:
+		return map[string]any{
+			"role_id":   a.Role,
+			"secret_id": string(a.Secret),
+		}, nil
+	case JwtAuthMethod:
+		return map[string]any{
+			"role": a.Role,
+			"jwt":  string(a.Secret),
+		}, nil
+	case KubernetesAuthMethod:
+		jwt := a.Secret
+		if len(jwt) == 0 {
+			var err error
+			if jwt, err = os.ReadFile(kubernetesServiceAccountTokenPath); err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to read kubernetes service account token"))
+			}
+		}
+		return map[string]any{
+			"role": a.Role,
+			"jwt":  string(jwt),
+		}, nil
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "unknown auth method")
+	}
+}
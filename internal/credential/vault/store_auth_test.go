@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreAuth_New(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	type args struct {
+		method AuthMethod
+		role   string
+		secret AuthSecret
+		opts   []Option
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    *StoreAuth
+		wantErr errors.Code
+	}{
+		{
+			name: "approle",
+			args: args{
+				method: AppRoleAuthMethod,
+				role:   "role-id",
+				secret: AuthSecret("secret-id"),
+			},
+			want: &StoreAuth{
+				Method:    "approle",
+				MountPath: "approle",
+				Role:      "role-id",
+				Secret:    AuthSecret("secret-id"),
+			},
+		},
+		{
+			name: "jwt-with-mount-path",
+			args: args{
+				method: JwtAuthMethod,
+				role:   "boundary",
+				secret: AuthSecret("a.b.c"),
+				opts:   []Option{WithAuthMountPath("/jwt-boundary/")},
+			},
+			want: &StoreAuth{
+				Method:    "jwt",
+				MountPath: "jwt-boundary",
+				Role:      "boundary",
+				Secret:    AuthSecret("a.b.c"),
+			},
+		},
+		{
+			name: "kubernetes-no-secret",
+			args: args{
+				method: KubernetesAuthMethod,
+				role:   "boundary",
+			},
+			want: &StoreAuth{
+				Method:    "kubernetes",
+				MountPath: "kubernetes",
+				Role:      "boundary",
+			},
+		},
+		{
+			name: "unknown-method",
+			args: args{
+				method: AuthMethod("userpass"),
+				role:   "boundary",
+				secret: AuthSecret("secret"),
+			},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "no-role",
+			args: args{
+				method: AppRoleAuthMethod,
+				secret: AuthSecret("secret-id"),
+			},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "approle-no-secret",
+			args: args{
+				method: AppRoleAuthMethod,
+				role:   "role-id",
+			},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "jwt-no-secret",
+			args: args{
+				method: JwtAuthMethod,
+				role:   "boundary",
+			},
+			wantErr: errors.InvalidParameter,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewStoreAuth(ctx, tt.args.method, tt.args.role, tt.args.secret, tt.args.opts...)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
+
+func TestStoreAuth_Encrypt(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	wrapper := db.TestWrapper(t)
+
+	auth, err := NewStoreAuth(ctx, AppRoleAuthMethod, "role-id", AuthSecret("secret-id"))
+	require.NoError(err)
+	auth.StoreId = "csvlt_1234567890"
+
+	require.NoError(auth.encrypt(ctx, wrapper))
+	assert.NotEmpty(auth.CtSecret)
+	assert.NotEmpty(auth.SecretHmac)
+	assert.NotEmpty(auth.KeyId)
+	assert.NotEqual([]byte(auth.Secret), auth.CtSecret)
+
+	got := auth.clone()
+	got.Secret = nil
+	require.NoError(got.decrypt(ctx, wrapper))
+	assert.Equal(AuthSecret("secret-id"), got.Secret)
+
+	// Encrypting an auth method without a secret clears the encrypted
+	// fields.
+	auth.Secret = nil
+	require.NoError(auth.encrypt(ctx, wrapper))
+	assert.Empty(auth.CtSecret)
+	assert.Empty(auth.SecretHmac)
+	assert.Empty(auth.KeyId)
+}
+
+func TestStoreAuth_loginData(t *testing.T) {
+	ctx := context.Background()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("service-account-jwt"), 0o600))
+	orig := kubernetesServiceAccountTokenPath
+	kubernetesServiceAccountTokenPath = tokenFile
+	t.Cleanup(func() { kubernetesServiceAccountTokenPath = orig })
+
+	tests := []struct {
+		name     string
+		auth     *StoreAuth
+		wantPath string
+		want     map[string]any
+		wantErr  bool
+	}{
+		{
+			name:     "approle",
+			auth:     &StoreAuth{Method: "approle", MountPath: "approle", Role: "role-id", Secret: AuthSecret("secret-id")},
+			wantPath: "auth/approle/login",
+			want:     map[string]any{"role_id": "role-id", "secret_id": "secret-id"},
+		},
+		{
+			name:     "jwt",
+			auth:     &StoreAuth{Method: "jwt", MountPath: "jwt-boundary", Role: "boundary", Secret: AuthSecret("a.b.c")},
+			wantPath: "auth/jwt-boundary/login",
+			want:     map[string]any{"role": "boundary", "jwt": "a.b.c"},
+		},
+		{
+			name:     "kubernetes-with-secret",
+			auth:     &StoreAuth{Method: "kubernetes", MountPath: "kubernetes", Role: "boundary", Secret: AuthSecret("a.b.c")},
+			wantPath: "auth/kubernetes/login",
+			want:     map[string]any{"role": "boundary", "jwt": "a.b.c"},
+		},
+		{
+			name:     "kubernetes-service-account-token",
+			auth:     &StoreAuth{Method: "kubernetes", MountPath: "kubernetes", Role: "boundary"},
+			wantPath: "auth/kubernetes/login",
+			want:     map[string]any{"role": "boundary", "jwt": "service-account-jwt"},
+		},
+		{
+			name:    "unknown-method",
+			auth:    &StoreAuth{Method: "userpass", MountPath: "userpass", Role: "boundary"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := tt.auth.loginData(ctx)
+			if tt.wantErr {
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+			assert.Equal(tt.wantPath, tt.auth.loginPath())
+		})
+	}
+}
+
+func TestAuthSecret_Redacted(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	secret := AuthSecret("super-secret")
+
+	assert.Equal(redactedAuthSecret, secret.String())
+	assert.Equal(redactedAuthSecret, fmt.Sprintf("%#v", secret))
+	assert.NotContains(fmt.Sprintf("%v", secret), "super-secret")
+
+	b, err := json.Marshal(secret)
+	require.NoError(err)
+	assert.NotContains(string(b), "super-secret")
+}
@@ -21,6 +21,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/common"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -1017,6 +1019,101 @@ func (v *TestVaultServer) CreateKVSecret(t testing.TB, p string, data []byte) *v
 	return cred
 }
 
+// MountAppRole enables the Vault AppRole auth method on v and creates a
+// role on it. Tokens issued for the role have the standard set of policies
+// attached to tokens created with v.CreateToken. It returns the role_id and
+// a secret_id of the role.
+//
+// The default mount path is approle and the default role name is boundary.
+// WithTestMountPath, WithTestRoleName, and WithPolicies are the test
+// options supported.
+func (v *TestVaultServer) MountAppRole(t testing.TB, opt ...TestOption) (roleId, secretId string) {
+	t.Helper()
+	require := require.New(t)
+	opts := getTestOpts(t, opt...)
+	vc := v.client(t).cl
+
+	mountPath := opts.mountPath
+	if mountPath == "" {
+		mountPath = "approle/"
+	}
+	require.NoError(vc.Sys().EnableAuthWithOptions(mountPath, &vault.EnableAuthOptions{Type: "approle"}))
+
+	rolePath := path.Join("auth", mountPath, "role", opts.roleName)
+	_, err := vc.Logical().Write(rolePath, map[string]any{
+		"token_policies": opts.policies,
+	})
+	require.NoError(err)
+
+	s, err := vc.Logical().Read(path.Join(rolePath, "role-id"))
+	require.NoError(err)
+	require.NotNil(s)
+	roleId, ok := s.Data["role_id"].(string)
+	require.True(ok)
+
+	s, err = vc.Logical().Write(path.Join(rolePath, "secret-id"), nil)
+	require.NoError(err)
+	require.NotNil(s)
+	secretId, ok = s.Data["secret_id"].(string)
+	require.True(ok)
+	return roleId, secretId
+}
+
+// MountJWT enables the Vault JWT auth method on v, configures it to
+// validate JWTs signed by a key generated for the test, and creates a role
+// on it. Tokens issued for the role have the standard set of policies
+// attached to tokens created with v.CreateToken. It returns a JWT which
+// can be used to log in with the role.
+//
+// The default mount path is jwt and the default role name is boundary.
+// WithTestMountPath, WithTestRoleName, and WithPolicies are the test
+// options supported.
+func (v *TestVaultServer) MountJWT(t testing.TB, opt ...TestOption) string {
+	t.Helper()
+	require := require.New(t)
+	opts := getTestOpts(t, opt...)
+	vc := v.client(t).cl
+
+	mountPath := opts.mountPath
+	if mountPath == "" {
+		mountPath = "jwt/"
+	}
+	require.NoError(vc.Sys().EnableAuthWithOptions(mountPath, &vault.EnableAuthOptions{Type: "jwt"}))
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	pubDer, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(err)
+	pubPem := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDer})
+
+	_, err = vc.Logical().Write(path.Join("auth", mountPath, "config"), map[string]any{
+		"jwt_validation_pubkeys": []string{string(pubPem)},
+	})
+	require.NoError(err)
+
+	const audience = "boundary"
+	_, err = vc.Logical().Write(path.Join("auth", mountPath, "role", opts.roleName), map[string]any{
+		"role_type":       "jwt",
+		"bound_audiences": []string{audience},
+		"user_claim":      "sub",
+		"token_policies":  opts.policies,
+	})
+	require.NoError(err)
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: key}, nil)
+	require.NoError(err)
+	now := time.Now()
+	token, err := jwt.Signed(signer).Claims(jwt.Claims{
+		Subject:   t.Name(),
+		Audience:  jwt.Audience{audience},
+		IssuedAt:  jwt.NewNumericDate(now),
+		NotBefore: jwt.NewNumericDate(now.Add(-time.Minute)),
+		Expiry:    jwt.NewNumericDate(now.Add(time.Hour)),
+	}).CompactSerialize()
+	require.NoError(err)
+	return token
+}
+
 // TestVaultServer is a vault server running in a docker container suitable
 // for testing.
 type TestVaultServer struct {
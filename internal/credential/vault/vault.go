@@ -8,6 +8,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -26,8 +27,10 @@ type vaultClient interface {
 	lookupToken(context.Context) (*vault.Secret, error)
 	swapToken(context.Context, TokenSecret) (old TokenSecret)
 	get(context.Context, string) (*vault.Secret, error)
+	getVersion(context.Context, string, uint32) (*vault.Secret, error)
 	post(context.Context, string, []byte) (*vault.Secret, error)
 	capabilities(context.Context, []string) (pathCapabilities, error)
+	login(context.Context, *StoreAuth) (*vault.Secret, error)
 }
 
 var vaultClientFactoryFn = vaultClientFactory
@@ -50,10 +53,14 @@ type clientConfig struct {
 	TlsServerName string `json:"tls_server_name"`
 	TlsSkipVerify bool   `json:"tls_skip_verify"`
 	Namespace     string `json:"namespace"`
+
+	// Login is set if the client is used to log in to Vault, in which case
+	// a token is not required.
+	Login bool `json:"login"`
 }
 
 func (c *clientConfig) isValid() bool {
-	if c == nil || c.Addr == "" || len(c.Token) == 0 {
+	if c == nil || c.Addr == "" || (len(c.Token) == 0 && !c.Login) {
 		return false
 	}
 	return true
@@ -110,7 +117,9 @@ func newClient(ctx context.Context, c *clientConfig) (*client, error) {
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	vClient.SetToken(string(c.Token))
+	if len(c.Token) > 0 {
+		vClient.SetToken(string(c.Token))
+	}
 
 	if c.Namespace != "" {
 		vClient.SetNamespace(c.Namespace)
@@ -211,6 +220,36 @@ func (c *client) swapToken(ctx context.Context, new TokenSecret) (old TokenSecre
 	return
 }
 
+// login calls the login endpoint of the Vault auth method of a and returns
+// the vault.Secret response. The token in the Vault client is replaced with
+// the token returned by Vault. See
+// https://developer.hashicorp.com/vault/api-docs/auth/approle#login-with-approle,
+// https://developer.hashicorp.com/vault/api-docs/auth/jwt#jwt-login, and
+// https://developer.hashicorp.com/vault/api-docs/auth/kubernetes#login.
+func (c *client) login(ctx context.Context, a *StoreAuth) (*vault.Secret, error) {
+	const op = "vault.(client).login"
+	if a == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no auth")
+	}
+	data, err := a.loginData(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	// The login endpoints do not require a token and an invalid token must
+	// not be sent with the request.
+	c.cl.ClearToken()
+	s, err := c.cl.Logical().WriteWithContext(ctx, a.loginPath(), data)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Unknown), errors.WithMsg(fmt.Sprintf("vault: %s", c.cl.Address())))
+	}
+	if s == nil || s.Auth == nil || s.Auth.ClientToken == "" {
+		return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("no token returned from login: vault: %s", c.cl.Address()))
+	}
+	c.cl.SetToken(s.Auth.ClientToken)
+	c.token = TokenSecret(s.Auth.ClientToken)
+	return s, nil
+}
+
 func (c *client) get(ctx context.Context, path string) (*vault.Secret, error) {
 	const op = "vault.(client).get"
 	s, err := c.cl.Logical().Read(path)
@@ -220,6 +259,18 @@ func (c *client) get(ctx context.Context, path string) (*vault.Secret, error) {
 	return s, nil
 }
 
+// getVersion reads version of the Vault KV version 2 secret at path.
+func (c *client) getVersion(ctx context.Context, path string, version uint32) (*vault.Secret, error) {
+	const op = "vault.(client).getVersion"
+	s, err := c.cl.Logical().ReadWithData(path, map[string][]string{
+		"version": {strconv.FormatUint(uint64(version), 10)},
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.VaultCredentialRequest), errors.WithMsg(fmt.Sprintf("vault: %s", c.cl.Address())))
+	}
+	return s, nil
+}
+
 func (c *client) post(ctx context.Context, path string, data []byte) (*vault.Secret, error) {
 	const op = "vault.(client).post"
 
@@ -6,6 +6,7 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -36,6 +37,7 @@ type workerServiceServer struct {
 	workerAuthRepoFn    common.WorkerAuthRepoStorageFactory
 	sessionRepoFn       session.RepositoryFactory
 	connectionRepoFn    common.ConnectionRepoFactory
+	vaultCredRepoFn     common.VaultCredentialRepoFactory
 	downstreams         common.Downstreamers
 	updateTimes         *sync.Map
 	kms                 *kms.Kms
@@ -71,6 +73,7 @@ func NewWorkerServiceServer(
 	workerAuthRepoFn common.WorkerAuthRepoStorageFactory,
 	sessionRepoFn session.RepositoryFactory,
 	connectionRepoFn common.ConnectionRepoFactory,
+	vaultCredRepoFn common.VaultCredentialRepoFactory,
 	downstreams common.Downstreamers,
 	updateTimes *sync.Map,
 	kms *kms.Kms,
@@ -82,6 +85,7 @@ func NewWorkerServiceServer(
 		workerAuthRepoFn:    workerAuthRepoFn,
 		sessionRepoFn:       sessionRepoFn,
 		connectionRepoFn:    connectionRepoFn,
+		vaultCredRepoFn:     vaultCredRepoFn,
 		downstreams:         downstreams,
 		updateTimes:         updateTimes,
 		kms:                 kms,
@@ -153,6 +157,20 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 		event.WriteError(ctx, op, err, event.WithInfoMsg("error storing worker status"))
 		return &pbs.StatusResponse{}, status.Errorf(codes.Internal, "Error storing worker status: %v", err)
 	}
+	if reqLoad := req.GetWorkerLoad(); reqLoad != nil {
+		// The load is only used to order workers when authorizing sessions, so
+		// failing to store it shouldn't fail the status update.
+		load := &server.WorkerLoad{
+			WorkerId:               wrk.GetPublicId(),
+			ProxiedConnectionCount: reqLoad.GetProxiedConnectionCount(),
+			CpuUtilization:         reqLoad.GetCpuUtilization(),
+			MemoryBytes:            reqLoad.GetMemoryBytes(),
+			StatusLatencyMs:        reqLoad.GetStatusLatencyMs(),
+		}
+		if err := serverRepo.UpsertWorkerLoad(ctx, load); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error storing worker load"))
+		}
+	}
 	controllers, err := serverRepo.ListControllers(ctx, server.WithLiveness(time.Duration(ws.livenessTimeToStale.Load())))
 	if err != nil {
 		event.WriteError(ctx, op, err, event.WithInfoMsg("error getting current controllers"))
@@ -209,6 +227,9 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 		AuthorizedWorkers:           authorizedWorkerList,
 		AuthorizedDownstreamWorkers: authorizedDownstreams,
 	}
+	if dd := wrk.GetDrainDeadline(); dd != nil {
+		ret.DrainDeadline = dd.GetTimestamp()
+	}
 
 	stateReport := make([]*session.StateReport, 0, len(req.GetJobs()))
 	var monitoredSessionIds []string
@@ -521,12 +542,19 @@ func (ws *workerServiceServer) LookupSession(ctx context.Context, req *pbs.Looku
 		HostId:          sessionInfo.HostId,
 		HostSetId:       sessionInfo.HostSetId,
 		TargetId:        sessionInfo.TargetId,
+		ProjectId:       sessionInfo.ProjectId,
 		UserId:          sessionInfo.UserId,
 		Credentials:     workerCreds,
 	}
 	if resp.ConnectionsLeft != -1 {
 		resp.ConnectionsLeft -= int32(authzSummary.CurrentConnectionCount)
 	}
+	if l := sessionInfo.TrafficLimit; l != nil {
+		resp.ConnectionBandwidthLimit = l.ConnectionBandwidthLimit
+		resp.SessionBandwidthLimit = l.SessionBandwidthLimit
+		resp.ConnectionByteQuota = l.ConnectionByteQuota
+		resp.SessionByteQuota = l.SessionByteQuota
+	}
 
 	return resp, nil
 }
@@ -721,6 +749,7 @@ func (ws *workerServiceServer) CloseConnection(ctx context.Context, req *pbs.Clo
 	}
 
 	closeData := make([]*pbs.CloseConnectionResponseData, 0, numCloses)
+	var terminatedSessionIds []string
 	for _, v := range closeInfos {
 		if v.Connection == nil {
 			return nil, status.Errorf(codes.Internal, "No connection found while closing one of the connection IDs: %v", closeIds)
@@ -728,15 +757,41 @@ func (ws *workerServiceServer) CloseConnection(ctx context.Context, req *pbs.Clo
 		if len(v.ConnectionStates) == 0 {
 			return nil, status.Errorf(codes.Internal, "No connection states found while closing one of the connection IDs: %v", closeIds)
 		}
+		if v.SessionTerminated && !slices.Contains(terminatedSessionIds, v.Connection.SessionId) {
+			terminatedSessionIds = append(terminatedSessionIds, v.Connection.SessionId)
+		}
 		closeData = append(closeData, &pbs.CloseConnectionResponseData{
 			ConnectionId: v.Connection.GetPublicId(),
 			Status:       v.ConnectionStates[0].Status.ProtoVal(),
 		})
 	}
 
+	ws.revokeTerminatedSessionCredentials(ctx, terminatedSessionIds)
+
 	ret := &pbs.CloseConnectionResponse{
 		CloseResponseData: closeData,
 	}
 
 	return ret, nil
 }
+
+// revokeTerminatedSessionCredentials revokes the Vault credentials of the
+// sessions terminated by closing their last connection. Failures are
+// written as error events for the session and do not fail the request, the
+// credentials are left for the credential revocation job to retry.
+func (ws *workerServiceServer) revokeTerminatedSessionCredentials(ctx context.Context, sessionIds []string) {
+	const op = "workers.(workerServiceServer).revokeTerminatedSessionCredentials"
+	if len(sessionIds) == 0 {
+		return
+	}
+	credRepo, err := ws.vaultCredRepoFn()
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error getting vault credential repo"))
+		return
+	}
+	for _, id := range sessionIds {
+		if err := credRepo.RevokeSessionCredentials(ctx, id); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error revoking session credentials", "session_id", id))
+		}
+	}
+}
@@ -158,7 +158,7 @@ func TestLookupSession(t *testing.T) {
 	err = repo.AddSessionCredentials(ctx, sessWithCreds.ProjectId, sessWithCreds.GetPublicId(), workerCreds)
 	require.NoError(t, err)
 
-	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connectionRepoFn, nil, new(sync.Map), kms, new(atomic.Int64), fce)
+	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connectionRepoFn, nil, nil, new(sync.Map), kms, new(atomic.Int64), fce)
 	require.NotNil(t, s)
 
 	oldFn := connectionRouteFn
@@ -219,6 +219,7 @@ func TestLookupSession(t *testing.T) {
 				HostId:          sess.HostId,
 				HostSetId:       sess.HostSetId,
 				TargetId:        sess.TargetId,
+				ProjectId:       sess.ProjectId,
 				UserId:          sess.UserId,
 				Status:          pbs.SESSIONSTATUS_SESSIONSTATUS_PENDING,
 			},
@@ -242,6 +243,7 @@ func TestLookupSession(t *testing.T) {
 				HostId:          sess.HostId,
 				HostSetId:       sess.HostSetId,
 				TargetId:        sess.TargetId,
+				ProjectId:       sess.ProjectId,
 				UserId:          sess.UserId,
 				Status:          pbs.SESSIONSTATUS_SESSIONSTATUS_PENDING,
 			},
@@ -265,6 +267,7 @@ func TestLookupSession(t *testing.T) {
 				HostId:          sessWithCreds.HostId,
 				HostSetId:       sessWithCreds.HostSetId,
 				TargetId:        sessWithCreds.TargetId,
+				ProjectId:       sessWithCreds.ProjectId,
 				UserId:          sessWithCreds.UserId,
 				Status:          pbs.SESSIONSTATUS_SESSIONSTATUS_PENDING,
 				Credentials:     creds,
@@ -358,7 +361,7 @@ func TestAuthorizeConnection(t *testing.T) {
 	repo, err := sessionRepoFn()
 	require.NoError(t, err)
 
-	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connectionRepoFn, nil, new(sync.Map), kmsCache, new(atomic.Int64), fce)
+	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connectionRepoFn, nil, nil, new(sync.Map), kmsCache, new(atomic.Int64), fce)
 	require.NotNil(t, s)
 
 	cases := []struct {
@@ -502,7 +505,7 @@ func TestCancelSession(t *testing.T) {
 		ProjectId:   prj.GetPublicId(),
 		Endpoint:    "tcp://127.0.0.1:22",
 	})
-	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connectionRepoFn, nil, new(sync.Map), kms, new(atomic.Int64), fce)
+	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connectionRepoFn, nil, nil, new(sync.Map), kms, new(atomic.Int64), fce)
 	require.NotNil(t, s)
 	cases := []struct {
 		name       string
@@ -617,7 +620,7 @@ func TestHcpbWorkers(t *testing.T) {
 	_, err = serverRepo.UpsertWorkerStatus(ctx, server.NewWorker(scope.Global.String(), server.WithAddress("unrelated_tag.pki.1")), server.WithKeyId(keyId))
 	require.NoError(err)
 
-	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connectionRepoFn, nil, new(sync.Map), kmsCache, &liveDur, fce)
+	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connectionRepoFn, nil, nil, new(sync.Map), kmsCache, &liveDur, fce)
 	require.NotNil(t, s)
 
 	res, err := s.ListHcpbWorkers(ctx, &pbs.ListHcpbWorkersRequest{})
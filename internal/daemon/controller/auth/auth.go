@@ -5,7 +5,9 @@ package auth
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/binary"
+	"encoding/json"
 	stderrors "errors"
 	"fmt"
 	"hash"
@@ -18,9 +20,11 @@ import (
 	"github.com/hashicorp/boundary/api/recovery"
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/cert"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/auth/saml"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -112,12 +116,20 @@ type verifier struct {
 	passwordAuthRepoFn common.PasswordAuthRepoFactory
 	oidcAuthRepoFn     common.OidcAuthRepoFactory
 	ldapAuthRepoFn     common.LdapAuthRepoFactory
+	samlAuthRepoFn     common.SamlAuthRepoFactory
+	certAuthRepoFn     common.CertAuthRepoFactory
 	kms                *kms.Kms
 	requestInfo        *authpb.RequestInfo
 	res                *perms.Resource
 	act                action.Type
 	ctx                context.Context
 	acl                perms.ACL
+
+	// The encoded LDAP groups and OIDC claims of the account, decoded by
+	// TemplateData.
+	accountGroups         string
+	accountTokenClaims    string
+	accountUserinfoClaims string
 }
 
 // TODO (jefferai 10/2022): NewVerifierContextWithAccounts performs the function
@@ -137,6 +149,8 @@ func NewVerifierContextWithAccounts(ctx context.Context,
 	passwordAuthRepoFn common.PasswordAuthRepoFactory,
 	oidcAuthRepoFn common.OidcAuthRepoFactory,
 	ldapAuthRepoFn common.LdapAuthRepoFactory,
+	samlAuthRepoFn common.SamlAuthRepoFactory,
+	certAuthRepoFn common.CertAuthRepoFactory,
 	kms *kms.Kms,
 	requestInfo *authpb.RequestInfo,
 ) context.Context {
@@ -147,6 +161,8 @@ func NewVerifierContextWithAccounts(ctx context.Context,
 		passwordAuthRepoFn: passwordAuthRepoFn,
 		oidcAuthRepoFn:     oidcAuthRepoFn,
 		ldapAuthRepoFn:     ldapAuthRepoFn,
+		samlAuthRepoFn:     samlAuthRepoFn,
+		certAuthRepoFn:     certAuthRepoFn,
 		kms:                kms,
 		requestInfo:        requestInfo,
 	})
@@ -163,7 +179,7 @@ func NewVerifierContext(ctx context.Context,
 	kms *kms.Kms,
 	requestInfo *authpb.RequestInfo,
 ) context.Context {
-	return NewVerifierContextWithAccounts(ctx, iamRepoFn, authTokenRepoFn, serversRepoFn, nil, nil, nil, kms, requestInfo)
+	return NewVerifierContextWithAccounts(ctx, iamRepoFn, authTokenRepoFn, serversRepoFn, nil, nil, nil, nil, nil, kms, requestInfo)
 }
 
 // Verify takes in a context that has expected parameters as values and runs an
@@ -560,7 +576,7 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 	userData.User.Email = util.Pointer(u.Email)
 	userData.User.FullName = util.Pointer(u.FullName)
 
-	if userData.Account.Id != nil && *userData.Account.Id != "" && v.passwordAuthRepoFn != nil && v.oidcAuthRepoFn != nil && v.ldapAuthRepoFn != nil {
+	if userData.Account.Id != nil && *userData.Account.Id != "" && v.passwordAuthRepoFn != nil && v.oidcAuthRepoFn != nil && v.ldapAuthRepoFn != nil && v.samlAuthRepoFn != nil && v.certAuthRepoFn != nil {
 		const domain = "auth"
 		var acct auth.Account
 		var err error
@@ -586,6 +602,20 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 				return
 			}
 			acct, err = repo.LookupAccount(ctx, *userData.Account.Id)
+		case saml.Subtype:
+			repo, repoErr := v.samlAuthRepoFn()
+			if repoErr != nil {
+				retErr = errors.Wrap(ctx, repoErr, op, errors.WithMsg("failed to get saml auth repo"))
+				return
+			}
+			acct, err = repo.LookupAccount(ctx, *userData.Account.Id)
+		case cert.Subtype:
+			repo, repoErr := v.certAuthRepoFn()
+			if repoErr != nil {
+				retErr = errors.Wrap(ctx, repoErr, op, errors.WithMsg("failed to get cert auth repo"))
+				return
+			}
+			acct, err = repo.LookupAccount(ctx, *userData.Account.Id)
 		default:
 			retErr = errors.Wrap(ctx, err, op, errors.WithMsg("unrecognized account id type"))
 			return
@@ -602,6 +632,16 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 		userData.Account.Email = util.Pointer(acct.GetEmail())
 		userData.Account.LoginName = util.Pointer(acct.GetLoginName())
 		userData.Account.Subject = util.Pointer(acct.GetSubject())
+		// The groups and claims are only used by templates, so they are
+		// decoded on demand by TemplateData.
+		switch a := acct.(type) {
+		case *ldap.Account:
+			userData.Account.Dn = util.Pointer(a.GetDn())
+			v.accountGroups = a.GetMemberOfGroups()
+		case *oidc.Account:
+			v.accountTokenClaims = a.GetTokenClaims()
+			v.accountUserinfoClaims = a.GetUserinfoClaims()
+		}
 	}
 
 	// Look up scope details to return. We can skip a lookup when using the
@@ -687,6 +727,30 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 	return
 }
 
+// TemplateData returns UserData with the LDAP groups and OIDC claims of the
+// account decoded. They are only needed to generate templates, so they are
+// not decoded for every request. An attribute which cannot be decoded is
+// left unset, so a template referencing it fails, and the error is logged.
+func (r *VerifyResults) TemplateData(ctx context.Context) template.Data {
+	const op = "auth.(VerifyResults).TemplateData"
+	data := r.UserData
+	if r.v == nil {
+		return data
+	}
+	decode := func(raw string, target any, what string) {
+		if raw == "" {
+			return
+		}
+		if err := json.Unmarshal([]byte(raw), target); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg(fmt.Sprintf("error decoding account %s", what)))
+		}
+	}
+	decode(r.v.accountGroups, &data.Account.MemberOfGroups, "ldap groups")
+	decode(r.v.accountTokenClaims, &data.Account.TokenClaims, "oidc token claims")
+	decode(r.v.accountUserinfoClaims, &data.Account.UserinfoClaims, "oidc userinfo claims")
+	return data
+}
+
 // FetchActionSetForId returns the allowed actions for a given ID using the
 // current set of ACLs and all other parameters the same (user, etc.)
 func (r *VerifyResults) FetchActionSetForId(ctx context.Context, id string, availableActions action.ActionSet, opt ...Option) action.ActionSet {
@@ -770,6 +834,29 @@ func (r *VerifyResults) ACL() perms.ACL {
 	return r.v.acl
 }
 
+// ClientCertificates returns the TLS client certificate chain the caller
+// presented to the controller's API listener, leaf first. It returns nil if no
+// certificate was presented.
+func (r *VerifyResults) ClientCertificates(ctx context.Context) ([]*x509.Certificate, error) {
+	const op = "auth.(VerifyResults).ClientCertificates"
+	if r.v == nil || r.v.requestInfo == nil {
+		return nil, nil
+	}
+	raw := r.v.requestInfo.GetClientCertificates()
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	chain := make([]*x509.Certificate, 0, len(raw))
+	for _, der := range raw {
+		c, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse client certificate"))
+		}
+		chain = append(chain, c)
+	}
+	return chain, nil
+}
+
 // GetTokenFromRequest pulls the token from either the Authorization header or
 // split cookies and parses it. If it cannot be parsed successfully, the issue
 // is logged and we return blank, so logic will continue as the anonymous user.
@@ -23,6 +23,8 @@ import (
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/tests/api"
+	"github.com/hashicorp/boundary/internal/util"
+	"github.com/hashicorp/boundary/internal/util/template"
 	"github.com/hashicorp/eventlogger/filters/encrypt"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
@@ -343,3 +345,41 @@ func TestGrantsHash(t *testing.T) {
 	assert.False(t, bytes.Equal(hash1, hash3))
 	assert.False(t, bytes.Equal(hash1, hash3))
 }
+
+func TestVerifyResults_TemplateData(t *testing.T) {
+	ctx := context.Background()
+	userData := template.Data{User: template.User{Id: util.Pointer("u_1234567890")}}
+
+	t.Run("decoded", func(t *testing.T) {
+		assert := assert.New(t)
+		r := &VerifyResults{
+			UserData: userData,
+			v: &verifier{
+				accountGroups:         `["admins","dba"]`,
+				accountTokenClaims:    `{"preferred_username":"alice"}`,
+				accountUserinfoClaims: `{"groups":["eng"]}`,
+			},
+		}
+		got := r.TemplateData(ctx)
+		assert.Equal("u_1234567890", *got.User.Id)
+		assert.Equal([]string{"admins", "dba"}, got.Account.MemberOfGroups)
+		assert.Equal(map[string]any{"preferred_username": "alice"}, got.Account.TokenClaims)
+		assert.Equal(map[string]any{"groups": []any{"eng"}}, got.Account.UserinfoClaims)
+		// UserData is not changed
+		assert.Nil(r.UserData.Account.MemberOfGroups)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		assert := assert.New(t)
+		r := &VerifyResults{
+			UserData: userData,
+			v: &verifier{
+				accountGroups:      `not json`,
+				accountTokenClaims: `{"preferred_username":"alice"}`,
+			},
+		}
+		got := r.TemplateData(ctx)
+		assert.Nil(got.Account.MemberOfGroups)
+		assert.Equal(map[string]any{"preferred_username": "alice"}, got.Account.TokenClaims)
+	})
+}
@@ -7,11 +7,14 @@ import (
 	"github.com/hashicorp/boundary/internal/alias"
 	"github.com/hashicorp/boundary/internal/alias/target"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/cert"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/auth/saml"
 	"github.com/hashicorp/boundary/internal/billing"
 	"github.com/hashicorp/boundary/internal/credential"
+	credfile "github.com/hashicorp/boundary/internal/credential/file"
 	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/host"
@@ -19,6 +22,7 @@ import (
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/plugin"
+	"github.com/hashicorp/boundary/internal/scim"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/session"
 	pluginstorage "github.com/hashicorp/boundary/internal/storage/plugin"
@@ -28,11 +32,15 @@ type (
 	AuthTokenRepoFactory           = oidc.AuthTokenRepoFactory
 	VaultCredentialRepoFactory     = func() (*vault.Repository, error)
 	StaticCredentialRepoFactory    = func() (*credstatic.Repository, error)
+	FileCredentialRepoFactory      = func() (*credfile.Repository, error)
 	CredentialStoreRepoFactory     func() (*credential.StoreRepository, error)
+	CredentialIssuanceRepoFactory  func() (*credential.IssuanceRepository, error)
 	HostCatalogRepoFactory         func() (*host.CatalogRepository, error)
 	IamRepoFactory                 = iam.IamRepoFactory
 	OidcAuthRepoFactory            = oidc.OidcRepoFactory
 	LdapAuthRepoFactory            = ldap.RepoFactory
+	SamlAuthRepoFactory            = saml.SamlRepoFactory
+	CertAuthRepoFactory            = cert.RepoFactory
 	PasswordAuthRepoFactory        func() (*password.Repository, error)
 	AuthMethodRepoFactory          func() (*auth.AuthMethodRepository, error)
 	ServersRepoFactory             func() (*server.Repository, error)
@@ -45,6 +53,7 @@ type (
 	BillingRepoFactory             func() (*billing.Repository, error)
 	AliasRepoFactory               func() (*alias.Repository, error)
 	TargetAliasRepoFactory         func() (*target.Repository, error)
+	ScimRepoFactory                func() (*scim.Repository, error)
 )
 
 // Downstreamers provides at least a minimum interface that must be met by a
@@ -14,15 +14,18 @@ import (
 	"github.com/hashicorp/boundary/internal/alias"
 	talias "github.com/hashicorp/boundary/internal/alias/target"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/cert"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/auth/saml"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/billing"
 	"github.com/hashicorp/boundary/internal/census"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/cmd/config"
 	"github.com/hashicorp/boundary/internal/credential"
+	credfile "github.com/hashicorp/boundary/internal/credential/file"
 	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/daemon/cluster"
@@ -47,6 +50,7 @@ import (
 	"github.com/hashicorp/boundary/internal/scheduler"
 	"github.com/hashicorp/boundary/internal/scheduler/cleaner"
 	"github.com/hashicorp/boundary/internal/scheduler/job"
+	"github.com/hashicorp/boundary/internal/scim"
 	"github.com/hashicorp/boundary/internal/server"
 	serversjob "github.com/hashicorp/boundary/internal/server/job"
 	"github.com/hashicorp/boundary/internal/session"
@@ -135,11 +139,15 @@ type Controller struct {
 	AuthTokenRepoFn           common.AuthTokenRepoFactory
 	VaultCredentialRepoFn     common.VaultCredentialRepoFactory
 	StaticCredentialRepoFn    common.StaticCredentialRepoFactory
+	FileCredentialRepoFn      common.FileCredentialRepoFactory
 	CredentialStoreRepoFn     common.CredentialStoreRepoFactory
+	CredentialIssuanceRepoFn  common.CredentialIssuanceRepoFactory
 	HostCatalogRepoFn         common.HostCatalogRepoFactory
 	IamRepoFn                 common.IamRepoFactory
 	OidcRepoFn                common.OidcAuthRepoFactory
 	LdapRepoFn                common.LdapAuthRepoFactory
+	SamlRepoFn                common.SamlAuthRepoFactory
+	CertRepoFn                common.CertAuthRepoFactory
 	PasswordAuthRepoFn        common.PasswordAuthRepoFactory
 	AuthMethodRepoFn          common.AuthMethodRepoFactory
 	ServersRepoFn             common.ServersRepoFactory
@@ -154,6 +162,7 @@ type Controller struct {
 	BillingRepoFn             common.BillingRepoFactory
 	AliasRepoFn               common.AliasRepoFactory
 	TargetAliasRepoFn         common.TargetAliasRepoFactory
+	ScimRepoFn                common.ScimRepoFactory
 
 	scheduler *scheduler.Scheduler
 
@@ -418,9 +427,17 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.StaticCredentialRepoFn = func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(ctx, dbase, dbase, c.kms)
 	}
+	c.FileCredentialRepoFn = func() (*credfile.Repository, error) {
+		return credfile.NewRepository(ctx, dbase, dbase, c.kms,
+			credfile.WithBundleWrapper(c.conf.CredentialFileKms),
+			credfile.WithAllowedDirectories(c.conf.RawConfig.Controller.CredentialFileAllowedDirectories...))
+	}
 	c.CredentialStoreRepoFn = func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(ctx, dbase, dbase)
 	}
+	c.CredentialIssuanceRepoFn = func() (*credential.IssuanceRepository, error) {
+		return credential.NewIssuanceRepository(ctx, dbase, dbase)
+	}
 	c.HostCatalogRepoFn = func() (*host.CatalogRepository, error) {
 		return host.NewCatalogRepository(ctx, dbase, dbase)
 	}
@@ -433,6 +450,12 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.LdapRepoFn = func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, dbase, dbase, c.kms)
 	}
+	c.SamlRepoFn = func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, dbase, dbase, c.kms)
+	}
+	c.CertRepoFn = func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, dbase, dbase, c.kms)
+	}
 	c.PasswordAuthRepoFn = func() (*password.Repository, error) {
 		return password.NewRepository(ctx, dbase, dbase, c.kms)
 	}
@@ -463,6 +486,9 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.TargetAliasRepoFn = func() (*talias.Repository, error) {
 		return talias.NewRepository(ctx, dbase, dbase, c.kms)
 	}
+	c.ScimRepoFn = func() (*scim.Repository, error) {
+		return scim.NewRepository(ctx, dbase, dbase)
+	}
 
 	// Check that credentials are available at startup, to avoid some harmless
 	// but nasty-looking errors
@@ -600,12 +626,26 @@ func (c *Controller) registerJobs() error {
 	if err := vault.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms); err != nil {
 		return err
 	}
+	rotators := make(map[string]credstatic.Rotator, len(c.conf.RawConfig.Controller.CredentialRotators))
+	for _, r := range c.conf.RawConfig.Controller.CredentialRotators {
+		rotator, err := credstatic.NewCommandRotator(c.baseContext, r.Command, r.Args...)
+		if err != nil {
+			return err
+		}
+		rotators[r.Name] = rotator
+	}
+	if err := credstatic.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, rotators); err != nil {
+		return err
+	}
 	if err := pluginhost.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, c.conf.HostPlugins); err != nil {
 		return err
 	}
 	if err := session.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, c.workerStatusGracePeriod); err != nil {
 		return err
 	}
+	if err := ldap.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms); err != nil {
+		return err
+	}
 	var serverJobOpts []serversjob.Option
 	if c.conf.TestOverrideWorkerAuthCaCertificateLifetime > 0 {
 		serverJobOpts = append(serverJobOpts,
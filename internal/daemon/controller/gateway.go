@@ -76,6 +76,8 @@ func newGrpcServer(
 	passwordAuthRepoFn common.PasswordAuthRepoFactory,
 	oidcAuthRepoFn common.OidcAuthRepoFactory,
 	ldapAuthRepoFn common.LdapAuthRepoFactory,
+	samlAuthRepoFn common.SamlAuthRepoFactory,
+	certAuthRepoFn common.CertAuthRepoFactory,
 	aliasRepoFn common.AliasRepoFactory,
 	kms *kms.Kms,
 	eventer *event.Eventer,
@@ -85,7 +87,7 @@ func newGrpcServer(
 	if err != nil {
 		return nil, "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate gateway ticket"))
 	}
-	unaryCtxInterceptor, err := requestCtxUnaryInterceptor(ctx, iamRepoFn, authTokenRepoFn, serversRepoFn, passwordAuthRepoFn, oidcAuthRepoFn, ldapAuthRepoFn, kms, ticket, eventer)
+	unaryCtxInterceptor, err := requestCtxUnaryInterceptor(ctx, iamRepoFn, authTokenRepoFn, serversRepoFn, passwordAuthRepoFn, oidcAuthRepoFn, ldapAuthRepoFn, samlAuthRepoFn, certAuthRepoFn, kms, ticket, eventer)
 	if err != nil {
 		return nil, "", err
 	}
@@ -98,6 +100,8 @@ func newGrpcServer(
 		passwordAuthRepoFn,
 		oidcAuthRepoFn,
 		ldapAuthRepoFn,
+		samlAuthRepoFn,
+		certAuthRepoFn,
 		kms,
 		ticket,
 		eventer,
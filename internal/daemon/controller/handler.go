@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"net/textproto"
 	"os"
@@ -37,6 +38,7 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/managed_groups"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/policies"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/roles"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/scim"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/scopes"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/session_recordings"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/sessions"
@@ -79,6 +81,13 @@ func createMuxWithEndpoints(c *Controller, props HandlerProperties) (http.Handle
 	mux := http.NewServeMux()
 	mux.Handle("/v1/", ratelimit.Handler(c.baseContext, c.getRateLimiter, grpcGwMux))
 	mux.Handle(uiPath, handleUi(c))
+	if scimConf := c.conf.RawConfig.Controller.Scim; scimConf != nil {
+		scimHandler, err := scim.NewHandler(c.baseContext, scimConf.ScopeId, scimConf.BearerToken, c.IamRepoFn, c.AuthTokenRepoFn, c.ScimRepoFn)
+		if err != nil {
+			return nil, nil, err
+		}
+		mux.Handle(scim.PathPrefix, scimHandler)
+	}
 
 	isUiRequest := func(req *http.Request) bool {
 		_, p := mux.Handler(req)
@@ -168,7 +177,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 		services.RegisterHostServiceServer(s, hs)
 	}
 	if _, ok := currentServices[services.AccountService_ServiceDesc.ServiceName]; !ok {
-		accts, err := accounts.NewService(c.baseContext, c.PasswordAuthRepoFn, c.OidcRepoFn, c.LdapRepoFn, c.conf.RawConfig.Controller.MaxPageSize)
+		accts, err := accounts.NewService(c.baseContext, c.PasswordAuthRepoFn, c.OidcRepoFn, c.LdapRepoFn, c.SamlRepoFn, c.CertRepoFn, c.conf.RawConfig.Controller.MaxPageSize)
 		if err != nil {
 			return fmt.Errorf("failed to create account handler service: %w", err)
 		}
@@ -183,6 +192,8 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			c.IamRepoFn,
 			c.AuthTokenRepoFn,
 			c.LdapRepoFn,
+			c.SamlRepoFn,
+			c.CertRepoFn,
 			c.AuthMethodRepoFn,
 			c.conf.RawConfig.Controller.MaxPageSize,
 		)
@@ -206,7 +217,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 		services.RegisterScopeServiceServer(s, os)
 	}
 	if _, ok := currentServices[services.UserService_ServiceDesc.ServiceName]; !ok {
-		us, err := users.NewService(c.baseContext, c.IamRepoFn, c.conf.RawConfig.Controller.MaxPageSize)
+		us, err := users.NewService(c.baseContext, c.IamRepoFn, c.AuthTokenRepoFn, c.conf.RawConfig.Controller.MaxPageSize)
 		if err != nil {
 			return fmt.Errorf("failed to create user handler service: %w", err)
 		}
@@ -262,9 +273,12 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			c.StaticHostRepoFn,
 			c.VaultCredentialRepoFn,
 			c.StaticCredentialRepoFn,
+			c.FileCredentialRepoFn,
 			c.TargetAliasRepoFn,
 			c.downstreamWorkers,
 			c.workerStatusGracePeriod,
+			c.conf.RawConfig.Controller.WorkerRoutingStrategy,
+			uint32(c.conf.RawConfig.Controller.MaxActiveSessionsPerUser),
 			c.conf.RawConfig.Controller.MaxPageSize,
 			c.ControllerExtension,
 		)
@@ -295,7 +309,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 		services.RegisterSessionServiceServer(s, ss)
 	}
 	if _, ok := currentServices[services.ManagedGroupService_ServiceDesc.ServiceName]; !ok {
-		mgs, err := managed_groups.NewService(c.baseContext, c.OidcRepoFn, c.LdapRepoFn, c.conf.RawConfig.Controller.MaxPageSize)
+		mgs, err := managed_groups.NewService(c.baseContext, c.OidcRepoFn, c.LdapRepoFn, c.SamlRepoFn, c.conf.RawConfig.Controller.MaxPageSize)
 		if err != nil {
 			return fmt.Errorf("failed to create managed groups handler service: %w", err)
 		}
@@ -307,6 +321,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			c.IamRepoFn,
 			c.VaultCredentialRepoFn,
 			c.StaticCredentialRepoFn,
+			c.FileCredentialRepoFn,
 			c.CredentialStoreRepoFn,
 			c.conf.RawConfig.Controller.MaxPageSize,
 		)
@@ -320,6 +335,8 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			c.baseContext,
 			c.IamRepoFn,
 			c.VaultCredentialRepoFn,
+			c.FileCredentialRepoFn,
+			c.CredentialIssuanceRepoFn,
 			c.conf.RawConfig.Controller.MaxPageSize,
 		)
 		if err != nil {
@@ -352,6 +369,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			c.baseContext,
 			c.IamRepoFn,
 			c.StaticCredentialRepoFn,
+			c.CredentialIssuanceRepoFn,
 			c.conf.RawConfig.Controller.MaxPageSize,
 		)
 		if err != nil {
@@ -489,9 +507,22 @@ func wrapHandlerWithCommonFuncs(h http.Handler, c *Controller, props HandlerProp
 			DisableAuthzFailures: disableAuthzFailures,
 		}
 
-		requestInfo.PublicId, requestInfo.EncryptedToken, requestInfo.TokenFormat = auth.GetTokenFromRequest(ctx, c.kms, r)
+		// The SCIM endpoint authenticates with its own bearer token rather
+		// than a Boundary auth token.
+		if !strings.HasPrefix(r.URL.Path, scim.PathPrefix) {
+			requestInfo.PublicId, requestInfo.EncryptedToken, requestInfo.TokenFormat = auth.GetTokenFromRequest(ctx, c.kms, r)
+		}
 		ctx = context.WithValue(ctx, globals.ContextAuthTokenPublicIdKey, requestInfo.PublicId)
 
+		// Forward any TLS client certificate chain for authenticate requests so
+		// that cert auth methods can verify it. It's limited to those requests
+		// to keep the request info header small.
+		if r.TLS != nil && strings.HasSuffix(r.URL.Path, ":authenticate") {
+			for _, pc := range r.TLS.PeerCertificates {
+				requestInfo.ClientCertificates = append(requestInfo.ClientCertificates, pc.Raw)
+			}
+		}
+
 		if info, ok := event.RequestInfoFromContext(ctx); ok {
 			// piggyback some eventing fields with the auth info proto message
 			requestInfo.EventId = info.EventId
@@ -650,12 +681,12 @@ func wrapHandlerWithCallbackInterceptor(h http.Handler, c *Controller) http.Hand
 
 		req.URL.Path = strings.TrimSuffix(req.URL.Path, ":callback")
 
-		// How we get the parameters changes based on the method. Right now only
-		// GET is supported with query args, but this can support POST with JSON
-		// or URL-encoded args. In those cases, the MIME type would have to be
-		// checked; for URL-encoded it'd use ParseForm like Get, and for JSON
-		// you'd use a json.RawMessage for Attributes consisting of the body. Or
-		// something very similar to that.
+		// How we get the parameters changes based on the method. GET is
+		// supported with query args and POST with URL-encoded args, which is
+		// how SAML identity providers deliver their responses. Both are parsed
+		// with ParseForm. POST with JSON would use a json.RawMessage for
+		// Attributes consisting of the body, or something very similar to
+		// that.
 		var useForm bool
 		switch req.Method {
 		case http.MethodGet:
@@ -667,6 +698,19 @@ func wrapHandlerWithCallbackInterceptor(h http.Handler, c *Controller) http.Hand
 				return
 			}
 			useForm = true
+		case http.MethodPost:
+			mediaType, _, err := mime.ParseMediaType(req.Header.Get("content-type"))
+			if err != nil || mediaType != "application/x-www-form-urlencoded" {
+				break
+			}
+			if err := req.ParseForm(); err != nil {
+				if logCallbackErrors && c != nil {
+					event.WriteError(ctx, op, err, event.WithInfoMsg("callback error"))
+				}
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			useForm = true
 		}
 
 		attrs := &cmdAttrs{
@@ -717,8 +761,8 @@ func wrapHandlerWithCallbackInterceptor(h http.Handler, c *Controller) http.Hand
 			}
 
 			// If there is any existing body, close it as we're going to replace
-			// it. It shouldn't be populated in this code path, but you never
-			// know.
+			// it. For URL-encoded POSTs it has already been consumed by
+			// ParseForm.
 			if req.Body != nil {
 				if err := req.Body.Close(); err != nil {
 					if logCallbackErrors && c != nil {
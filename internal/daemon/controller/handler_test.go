@@ -140,6 +140,9 @@ func TestHandleImplementedPaths(t *testing.T) {
 			"v1/users",
 			"v1/users/someid",
 			"v1/billing:monthly-active-users",
+			"v1/credentials/someid:list-versions",
+			"v1/credentials/someid:list-issuances",
+			"v1/credential-libraries/someid:list-issuances",
 		},
 		"POST": {
 			// Creation end points
@@ -160,7 +163,11 @@ func TestHandleImplementedPaths(t *testing.T) {
 			// custom methods
 			"v1/accounts/someid:set-password",
 			"v1/accounts/someid:change-password",
+			"v1/accounts/someid:enroll-totp",
+			"v1/accounts/someid:verify-totp",
+			"v1/accounts/someid:remove-totp",
 			"v1/auth-methods/someid:authenticate",
+			"v1/credentials/someid:rollback",
 			"v1/groups/someid:add-members",
 			"v1/groups/someid:set-members",
 			"v1/groups/someid:remove-members",
@@ -184,6 +191,8 @@ func TestHandleImplementedPaths(t *testing.T) {
 			"v1/users/someid:add-accounts",
 			"v1/users/someid:set-accounts",
 			"v1/users/someid:remove-accounts",
+			"v1/users/someid:revoke-auth-tokens",
+			"v1/workers/someid:drain",
 		},
 		"DELETE": {
 			"v1/accounts/someid",
@@ -289,6 +298,7 @@ func TestCallbackInterceptor(t *testing.T) {
 		name     string
 		path     string
 		args     url.Values
+		post     bool
 		wantJson *cmdAttrs
 	}{
 		{
@@ -327,19 +337,46 @@ func TestCallbackInterceptor(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "callback, with form post",
+			path: "v1/auth-methods/amsaml_1234567890:authenticate:callback",
+			args: url.Values{
+				"SAMLResponse": []string{"fooBar"},
+				"RelayState":   []string{"barFoo"},
+			},
+			post: true,
+			wantJson: &cmdAttrs{
+				Command: "callback",
+				Attributes: map[string]any{
+					"RelayState":   "barFoo",
+					"SAMLResponse": "fooBar",
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			require := require.New(t)
 
-			req, err := http.NewRequest(http.MethodGet,
-				fmt.Sprintf("http://%s/%s", listener.Addr().String(), tc.path),
-				nil)
-			require.NoError(err)
+			var req *http.Request
+			var err error
+			switch {
+			case tc.post:
+				req, err = http.NewRequest(http.MethodPost,
+					fmt.Sprintf("http://%s/%s", listener.Addr().String(), tc.path),
+					strings.NewReader(tc.args.Encode()))
+				require.NoError(err)
+				req.Header.Set("content-type", "application/x-www-form-urlencoded")
+			default:
+				req, err = http.NewRequest(http.MethodGet,
+					fmt.Sprintf("http://%s/%s", listener.Addr().String(), tc.path),
+					nil)
+				require.NoError(err)
 
-			if tc.args != nil {
-				req.URL.RawQuery = tc.args.Encode()
+				if tc.args != nil {
+					req.URL.RawQuery = tc.args.Encode()
+				}
 			}
 
 			resp, err := http.DefaultClient.Do(req)
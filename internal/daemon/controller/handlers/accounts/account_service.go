@@ -12,11 +12,15 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/cert"
+	certstore "github.com/hashicorp/boundary/internal/auth/cert/store"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	oidcstore "github.com/hashicorp/boundary/internal/auth/oidc/store"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	pwstore "github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/auth/saml"
+	samlstore "github.com/hashicorp/boundary/internal/auth/saml/store"
 	requestauth "github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
@@ -49,6 +53,7 @@ const (
 	loginNameKey         = "login_name"
 	newPasswordField     = "new_password"
 	currentPasswordField = "current_password"
+	codeField            = "code"
 
 	// oidc field names
 	issuerField     = "attributes.issuer"
@@ -63,12 +68,26 @@ const (
 	dnAttrField       = "attributes.dn"
 	memberOfAttrField = "attributes.member_of_groups"
 
+	// saml field names
+	samlIssuerField     = "attributes.issuer"
+	samlSubjectField    = "attributes.subject"
+	samlFullNameField   = "attributes.full_name"
+	samlEmailField      = "attributes.email"
+	samlAttributesField = "attributes.attributes"
+
+	// cert field names
+	certSubjectField  = "attributes.subject"
+	certFullNameField = "attributes.full_name"
+	certEmailField    = "attributes.email"
+
 	domain = "auth"
 )
 
 var (
 	pwMaskManager   handlers.MaskManager
 	oidcMaskManager handlers.MaskManager
+	samlMaskManager handlers.MaskManager
+	certMaskManager handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -80,6 +99,9 @@ var (
 			action.Delete,
 			action.SetPassword,
 			action.ChangePassword,
+			action.EnrollTotp,
+			action.VerifyTotp,
+			action.RemoveTotp,
 		),
 		oidc.Subtype: action.NewActionSet(
 			action.NoOp,
@@ -93,6 +115,18 @@ var (
 			action.Update,
 			action.Delete,
 		),
+		saml.Subtype: action.NewActionSet(
+			action.NoOp,
+			action.Read,
+			action.Update,
+			action.Delete,
+		),
+		cert.Subtype: action.NewActionSet(
+			action.NoOp,
+			action.Read,
+			action.Update,
+			action.Delete,
+		),
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -111,6 +145,12 @@ func init() {
 	if oidcMaskManager, err = handlers.NewMaskManager(context.Background(), handlers.MaskDestination{&oidcstore.Account{}}, handlers.MaskSource{&pb.Account{}, &pb.OidcAccountAttributes{}}); err != nil {
 		panic(err)
 	}
+	if samlMaskManager, err = handlers.NewMaskManager(context.Background(), handlers.MaskDestination{&samlstore.Account{}}, handlers.MaskSource{&pb.Account{}, &pb.SamlAccountAttributes{}}); err != nil {
+		panic(err)
+	}
+	if certMaskManager, err = handlers.NewMaskManager(context.Background(), handlers.MaskDestination{&certstore.Account{}}, handlers.MaskSource{&pb.Account{}, &pb.CertAccountAttributes{}}); err != nil {
+		panic(err)
+	}
 
 	// TODO: refactor to remove IdActions and CollectionActions package variables
 	action.RegisterResource(resource.Account, action.Union(maps.Values(IdActions)...), CollectionActions)
@@ -123,13 +163,15 @@ type Service struct {
 	pwRepoFn    common.PasswordAuthRepoFactory
 	oidcRepoFn  common.OidcAuthRepoFactory
 	ldapRepoFn  common.LdapAuthRepoFactory
+	samlRepoFn  common.SamlAuthRepoFactory
+	certRepoFn  common.CertAuthRepoFactory
 	maxPageSize uint
 }
 
 var _ pbs.AccountServiceServer = (*Service)(nil)
 
 // NewService returns a account service which handles account related requests to boundary.
-func NewService(ctx context.Context, pwRepo common.PasswordAuthRepoFactory, oidcRepo common.OidcAuthRepoFactory, ldapRepo common.LdapAuthRepoFactory, maxPageSize uint) (Service, error) {
+func NewService(ctx context.Context, pwRepo common.PasswordAuthRepoFactory, oidcRepo common.OidcAuthRepoFactory, ldapRepo common.LdapAuthRepoFactory, samlRepo common.SamlAuthRepoFactory, certRepo common.CertAuthRepoFactory, maxPageSize uint) (Service, error) {
 	const op = "accounts.NewService"
 	switch {
 	case pwRepo == nil:
@@ -138,11 +180,15 @@ func NewService(ctx context.Context, pwRepo common.PasswordAuthRepoFactory, oidc
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing oidc repository")
 	case ldapRepo == nil:
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing ldap repository")
+	case samlRepo == nil:
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing saml repository")
+	case certRepo == nil:
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing cert repository")
 	}
 	if maxPageSize == 0 {
 		maxPageSize = uint(globals.DefaultMaxPageSize)
 	}
-	return Service{pwRepoFn: pwRepo, oidcRepoFn: oidcRepo, ldapRepoFn: ldapRepo, maxPageSize: maxPageSize}, nil
+	return Service{pwRepoFn: pwRepo, oidcRepoFn: oidcRepo, ldapRepoFn: ldapRepo, samlRepoFn: samlRepo, certRepoFn: certRepo, maxPageSize: maxPageSize}, nil
 }
 
 // ListAccounts implements the interface pbs.AccountServiceServer.
@@ -283,6 +329,84 @@ func (s Service) ListAccounts(ctx context.Context, req *pbs.ListAccountsRequest)
 				return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "unexpected list token subtype: %T", st)
 			}
 		}
+	case saml.Subtype:
+		repo, err := s.samlRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if req.GetListToken() == "" {
+			sortBy = "created_time"
+			listResp, err = saml.ListAccounts(ctx, grantsHash, pageSize, filterItemFn, repo, authMethodId)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			listToken, err := handlers.ParseListToken(ctx, req.GetListToken(), resource.Account, grantsHash)
+			if err != nil {
+				return nil, err
+			}
+			switch st := listToken.Subtype.(type) {
+			case *listtoken.PaginationToken:
+				sortBy = "created_time"
+				listResp, err = saml.ListAccountsPage(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, authMethodId)
+				if err != nil {
+					return nil, err
+				}
+			case *listtoken.StartRefreshToken:
+				sortBy = "updated_time"
+				listResp, err = saml.ListAccountsRefresh(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, authMethodId)
+				if err != nil {
+					return nil, err
+				}
+			case *listtoken.RefreshToken:
+				sortBy = "updated_time"
+				listResp, err = saml.ListAccountsRefreshPage(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, authMethodId)
+				if err != nil {
+					return nil, err
+				}
+			default:
+				return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "unexpected list token subtype: %T", st)
+			}
+		}
+	case cert.Subtype:
+		repo, err := s.certRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if req.GetListToken() == "" {
+			sortBy = "created_time"
+			listResp, err = cert.ListAccounts(ctx, grantsHash, pageSize, filterItemFn, repo, authMethodId)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			listToken, err := handlers.ParseListToken(ctx, req.GetListToken(), resource.Account, grantsHash)
+			if err != nil {
+				return nil, err
+			}
+			switch st := listToken.Subtype.(type) {
+			case *listtoken.PaginationToken:
+				sortBy = "created_time"
+				listResp, err = cert.ListAccountsPage(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, authMethodId)
+				if err != nil {
+					return nil, err
+				}
+			case *listtoken.StartRefreshToken:
+				sortBy = "updated_time"
+				listResp, err = cert.ListAccountsRefresh(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, authMethodId)
+				if err != nil {
+					return nil, err
+				}
+			case *listtoken.RefreshToken:
+				sortBy = "updated_time"
+				listResp, err = cert.ListAccountsRefreshPage(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, authMethodId)
+				if err != nil {
+					return nil, err
+				}
+			default:
+				return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "unexpected list token subtype: %T", st)
+			}
+		}
 	case password.Subtype:
 		repo, err := s.pwRepoFn()
 		if err != nil {
@@ -575,6 +699,133 @@ func (s Service) SetPassword(ctx context.Context, req *pbs.SetPasswordRequest) (
 	return &pbs.SetPasswordResponse{Item: item}, nil
 }
 
+// EnrollTotp implements the interface pbs.AccountServiceServer.
+func (s Service) EnrollTotp(ctx context.Context, req *pbs.EnrollTotpRequest) (*pbs.EnrollTotpResponse, error) {
+	const op = "accounts.(Service).EnrollTotp"
+
+	if err := validateTotpRequest(req.GetId()); err != nil {
+		return nil, err
+	}
+
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.EnrollTotp)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	enrollment, err := repo.EnrollTotp(ctx, authResults.Scope.GetId(), req.GetId())
+	if err != nil {
+		switch {
+		case errors.IsNotFoundError(err):
+			return nil, handlers.NotFoundErrorf("Account not found.")
+		case errors.Match(errors.T(errors.TotpAlreadyEnrolled), err):
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "Account is already enrolled in TOTP.")
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.EnrollTotpResponse{
+		Secret:        enrollment.Secret,
+		Url:           enrollment.Url,
+		RecoveryCodes: enrollment.RecoveryCodes,
+	}, nil
+}
+
+// VerifyTotp implements the interface pbs.AccountServiceServer.
+func (s Service) VerifyTotp(ctx context.Context, req *pbs.VerifyTotpRequest) (*pbs.VerifyTotpResponse, error) {
+	const op = "accounts.(Service).VerifyTotp"
+
+	if err := validateTotpRequest(req.GetId()); err != nil {
+		return nil, err
+	}
+	if req.GetCode() == "" {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{codeField: "This is a required field."})
+	}
+
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.VerifyTotp)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := repo.VerifyTotp(ctx, authResults.Scope.GetId(), req.GetId(), req.GetCode()); err != nil {
+		switch {
+		case errors.IsNotFoundError(err):
+			return nil, handlers.NotFoundErrorf("Account has no pending TOTP enrollment.")
+		case errors.Match(errors.T(errors.TotpAlreadyEnrolled), err):
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "TOTP enrollment has already been verified.")
+		case errors.Match(errors.T(errors.TotpInvalid), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{codeField: "Invalid TOTP code."})
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	item, err := s.totpResponseItem(ctx, req.GetId(), &authResults)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.VerifyTotpResponse{Item: item}, nil
+}
+
+// RemoveTotp implements the interface pbs.AccountServiceServer.
+func (s Service) RemoveTotp(ctx context.Context, req *pbs.RemoveTotpRequest) (*pbs.RemoveTotpResponse, error) {
+	const op = "accounts.(Service).RemoveTotp"
+
+	if err := validateTotpRequest(req.GetId()); err != nil {
+		return nil, err
+	}
+
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.RemoveTotp)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	rows, err := repo.DeleteTotp(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if rows == 0 {
+		return nil, handlers.NotFoundErrorf("Account is not enrolled in TOTP.")
+	}
+
+	item, err := s.totpResponseItem(ctx, req.GetId(), &authResults)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.RemoveTotpResponse{Item: item}, nil
+}
+
+// totpResponseItem returns the account with the given id as the item of the
+// response to a TOTP request.
+func (s Service) totpResponseItem(ctx context.Context, id string, authResults *requestauth.VerifyResults) (*pb.Account, error) {
+	const op = "accounts.(Service).totpResponseItem"
+	acct, _, err := s.getFromRepo(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, acct.GetPublicId(), IdActions[globals.ResourceInfoFromPrefix(acct.GetPublicId()).Subtype]).Strings()))
+	}
+	return toProto(ctx, acct, outputOpts...)
+}
+
 // getFromRepo returns the account and, if available, managed groups the account
 // belongs to within the auth method
 func (s Service) getFromRepo(ctx context.Context, id string) (auth.Account, []string, error) {
@@ -637,6 +888,39 @@ func (s Service) getFromRepo(ctx context.Context, id string) (auth.Account, []st
 			mgIds = append(mgIds, mg.GetManagedGroupId())
 		}
 		acct = a
+	case saml.Subtype:
+		repo, err := s.samlRepoFn()
+		if err != nil {
+			return nil, nil, err
+		}
+		a, err := repo.LookupAccount(ctx, id)
+		if err != nil {
+			if errors.IsNotFoundError(err) {
+				return nil, nil, handlers.NotFoundErrorf("Account %q doesn't exist.", id)
+			}
+			return nil, nil, err
+		}
+		mgs, err := repo.ListManagedGroupMembershipsByMember(ctx, a.GetPublicId())
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, mg := range mgs {
+			mgIds = append(mgIds, mg.GetManagedGroupId())
+		}
+		acct = a
+	case cert.Subtype:
+		repo, err := s.certRepoFn()
+		if err != nil {
+			return nil, nil, err
+		}
+		a, err := repo.LookupAccount(ctx, id)
+		if err != nil {
+			if errors.IsNotFoundError(err) {
+				return nil, nil, handlers.NotFoundErrorf("Account %q doesn't exist.", id)
+			}
+			return nil, nil, err
+		}
+		acct = a
 	default:
 		return nil, nil, handlers.NotFoundErrorf("Unrecognized id.")
 	}
@@ -671,6 +955,11 @@ func (s Service) createPwInRepo(ctx context.Context, am auth.AuthMethod, item *p
 	}
 	out, err := repo.CreateAccount(ctx, am.GetScopeId(), a, createOpts...)
 	if err != nil {
+		switch {
+		case errors.Match(errors.T(errors.PasswordTooWeak), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"attributes.password": "Password does not meet the complexity requirements."})
+		}
 		return nil, errors.Wrap(ctx, err, op)
 	}
 	if out == nil {
@@ -749,6 +1038,72 @@ func (s Service) createLdapInRepo(ctx context.Context, am auth.AuthMethod, item
 	return out, nil
 }
 
+func (s Service) createSamlInRepo(ctx context.Context, am auth.AuthMethod, item *pb.Account) (*saml.Account, error) {
+	const op = "accounts.(Service).createSamlInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing item")
+	}
+	var opts []saml.Option
+	if item.GetName() != nil {
+		opts = append(opts, saml.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, saml.WithDescription(item.GetDescription().GetValue()))
+	}
+	attrs := item.GetSamlAccountAttributes()
+	if attrs.GetIssuer() != "" {
+		opts = append(opts, saml.WithIssuer(attrs.GetIssuer()))
+	}
+	a, err := saml.NewAccount(ctx, am.GetPublicId(), attrs.GetSubject(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build account for creation: %v.", err)
+	}
+	repo, err := s.samlRepoFn()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := repo.CreateAccount(ctx, am.GetScopeId(), a)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create account"))
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create account but no error returned from repository.")
+	}
+	return out, nil
+}
+
+func (s Service) createCertInRepo(ctx context.Context, am auth.AuthMethod, item *pb.Account) (*cert.Account, error) {
+	const op = "accounts.(Service).createCertInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing item")
+	}
+	var opts []cert.Option
+	if item.GetName() != nil {
+		opts = append(opts, cert.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, cert.WithDescription(item.GetDescription().GetValue()))
+	}
+	a, err := cert.NewAccount(ctx, am.GetPublicId(), item.GetCertAccountAttributes().GetSubject(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build account for creation: %v.", err)
+	}
+	repo, err := s.certRepoFn()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := repo.CreateAccount(ctx, am.GetScopeId(), a)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create account"))
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create account but no error returned from repository.")
+	}
+	return out, nil
+}
+
 func (s Service) createInRepo(ctx context.Context, am auth.AuthMethod, item *pb.Account) (auth.Account, error) {
 	const op = "accounts.(Service).createInRepo"
 	if item == nil {
@@ -783,6 +1138,24 @@ func (s Service) createInRepo(ctx context.Context, am auth.AuthMethod, item *pb.
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create ldap account but no error returned from repository.")
 		}
 		out = am
+	case saml.Subtype:
+		am, err := s.createSamlInRepo(ctx, am, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if am == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create account but no error returned from repository.")
+		}
+		out = am
+	case cert.Subtype:
+		am, err := s.createCertInRepo(ctx, am, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if am == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create account but no error returned from repository.")
+		}
+		out = am
 	}
 
 	return out, nil
@@ -855,6 +1228,74 @@ func (s Service) updateOidcInRepo(ctx context.Context, scopeId, amId, id string,
 	return out, nil
 }
 
+func (s Service) updateSamlInRepo(ctx context.Context, scopeId, amId, id string, mask []string, item *pb.Account) (*saml.Account, error) {
+	const op = "accounts.(Service).updateSamlInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil account.")
+	}
+	u := saml.AllocAccount()
+	u.PublicId = id
+	if item.GetName() != nil {
+		u.Name = item.GetName().GetValue()
+	}
+	if item.GetDescription() != nil {
+		u.Description = item.GetDescription().GetValue()
+	}
+
+	version := item.GetVersion()
+
+	dbMask := samlMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.samlRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.UpdateAccount(ctx, scopeId, u, version, dbMask)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update account"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Account %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
+func (s Service) updateCertInRepo(ctx context.Context, scopeId, amId, id string, mask []string, item *pb.Account) (*cert.Account, error) {
+	const op = "accounts.(Service).updateCertInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil account.")
+	}
+	u := cert.AllocAccount()
+	u.PublicId = id
+	if item.GetName() != nil {
+		u.Name = item.GetName().GetValue()
+	}
+	if item.GetDescription() != nil {
+		u.Description = item.GetDescription().GetValue()
+	}
+
+	version := item.GetVersion()
+
+	dbMask := certMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.certRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.UpdateAccount(ctx, scopeId, u, version, dbMask)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update account"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Account %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
 func (s Service) updateLdapInRepo(ctx context.Context, scopeId, amId, id string, mask []string, item *pb.Account) (*ldap.Account, error) {
 	const op = "accounts.(Service).updateLdapInRepo"
 	switch {
@@ -943,6 +1384,24 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, authMethodId string,
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update account but no error returned from repository.")
 		}
 		out = a
+	case saml.Subtype:
+		a, err := s.updateSamlInRepo(ctx, scopeId, authMethodId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if a == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update account but no error returned from repository.")
+		}
+		out = a
+	case cert.Subtype:
+		a, err := s.updateCertInRepo(ctx, scopeId, authMethodId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if a == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update account but no error returned from repository.")
+		}
+		out = a
 	}
 	return out, nil
 }
@@ -970,6 +1429,18 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 			return false, iErr
 		}
 		rows, err = repo.DeleteAccount(ctx, id)
+	case saml.Subtype:
+		repo, iErr := s.samlRepoFn()
+		if iErr != nil {
+			return false, iErr
+		}
+		rows, err = repo.DeleteAccount(ctx, scopeId, id)
+	case cert.Subtype:
+		repo, iErr := s.certRepoFn()
+		if iErr != nil {
+			return false, iErr
+		}
+		rows, err = repo.DeleteAccount(ctx, scopeId, id)
 	}
 	if err != nil {
 		if errors.IsNotFoundError(err) {
@@ -997,6 +1468,14 @@ func (s Service) changePasswordInRepo(ctx context.Context, scopeId, id string, v
 		case errors.Match(errors.T(errors.PasswordsEqual), err):
 			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
 				map[string]string{"new_password": "New password equal to current password."})
+		case errors.Match(errors.T(errors.PasswordTooWeak), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"new_password": "Password does not meet the complexity requirements."})
+		case errors.Match(errors.T(errors.PasswordReused), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"new_password": "Password was used previously."})
+		case errors.Match(errors.T(errors.AccountLocked), err):
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.PermissionDenied, "Account is locked.")
 		}
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -1021,6 +1500,12 @@ func (s Service) setPasswordInRepo(ctx context.Context, scopeId, id string, vers
 		case errors.Match(errors.T(errors.PasswordTooShort), err):
 			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
 				map[string]string{"password": "Password is too short."})
+		case errors.Match(errors.T(errors.PasswordTooWeak), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"password": "Password does not meet the complexity requirements."})
+		case errors.Match(errors.T(errors.PasswordReused), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"password": "Password was used previously."})
 		}
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -1044,6 +1529,16 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 		res.Error = err
 		return nil, res
 	}
+	samlRepo, err := s.samlRepoFn()
+	if err != nil {
+		res.Error = err
+		return nil, res
+	}
+	certRepo, err := s.certRepoFn()
+	if err != nil {
+		res.Error = err
+		return nil, res
+	}
 
 	var parentId string
 	opts := []requestauth.Option{requestauth.WithType(resource.Account), requestauth.WithAction(a)}
@@ -1085,6 +1580,28 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 				return nil, res
 			}
 			parentId = acct.GetAuthMethodId()
+		case saml.Subtype:
+			acct, err := samlRepo.LookupAccount(ctx, id)
+			if err != nil {
+				res.Error = err
+				return nil, res
+			}
+			if acct == nil {
+				res.Error = handlers.NotFoundError()
+				return nil, res
+			}
+			parentId = acct.GetAuthMethodId()
+		case cert.Subtype:
+			acct, err := certRepo.LookupAccount(ctx, id)
+			if err != nil {
+				res.Error = err
+				return nil, res
+			}
+			if acct == nil {
+				res.Error = handlers.NotFoundError()
+				return nil, res
+			}
+			parentId = acct.GetAuthMethodId()
 		}
 		opts = append(opts, requestauth.WithId(id))
 	}
@@ -1124,6 +1641,28 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 			return nil, res
 		}
 		authMeth = am
+	case saml.Subtype:
+		am, err := samlRepo.LookupAuthMethod(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return nil, res
+		}
+		if am == nil {
+			res.Error = handlers.NotFoundError()
+			return nil, res
+		}
+		authMeth = am
+	case cert.Subtype:
+		am, err := certRepo.LookupAuthMethod(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return nil, res
+		}
+		if am == nil {
+			res.Error = handlers.NotFoundError()
+			return nil, res
+		}
+		authMeth = am
 	}
 	opts = append(opts, requestauth.WithScopeId(authMeth.GetScopeId()), requestauth.WithPin(parentId))
 	return authMeth, requestauth.Verify(ctx, opts...)
@@ -1241,6 +1780,53 @@ func toProto(ctx context.Context, in auth.Account, opt ...handlers.Option) (*pb.
 			attrs.LdapAccountAttributes.MemberOfGroups = decodedGroups
 		}
 		out.Attrs = attrs
+	case *saml.Account:
+		if outputFields.Has(globals.TypeField) {
+			out.Type = saml.Subtype.String()
+		}
+		if !outputFields.Has(globals.AttributesField) {
+			break
+		}
+		attrs := &pb.Account_SamlAccountAttributes{
+			SamlAccountAttributes: &pb.SamlAccountAttributes{
+				Issuer:   i.GetIssuer(),
+				Subject:  i.GetSubject(),
+				FullName: i.GetFullName(),
+				Email:    i.GetEmail(),
+			},
+		}
+		if i.GetAttributes() != "" {
+			values, err := i.AttributeValues(ctx)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error unmarshaling stored assertion attributes"))
+			}
+			m := make(map[string]any, len(values))
+			for k, v := range values {
+				vals := make([]any, 0, len(v))
+				for _, vv := range v {
+					vals = append(vals, vv)
+				}
+				m[k] = vals
+			}
+			if attrs.SamlAccountAttributes.Attributes, err = structpb.NewStruct(m); err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error converting stored assertion attributes to protobuf struct"))
+			}
+		}
+		out.Attrs = attrs
+	case *cert.Account:
+		if outputFields.Has(globals.TypeField) {
+			out.Type = cert.Subtype.String()
+		}
+		if !outputFields.Has(globals.AttributesField) {
+			break
+		}
+		out.Attrs = &pb.Account_CertAccountAttributes{
+			CertAccountAttributes: &pb.CertAccountAttributes{
+				Subject:  i.GetSubject(),
+				FullName: i.GetFullName(),
+				Email:    i.GetEmail(),
+			},
+		}
 	}
 	return &out, nil
 }
@@ -1279,7 +1865,7 @@ func validateGetRequest(ctx context.Context, req *pbs.GetAccountRequest) error {
 	if req == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
 	}
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.PasswordAccountPreviousPrefix, globals.PasswordAccountPrefix, globals.OidcAccountPrefix, globals.LdapAccountPrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.PasswordAccountPreviousPrefix, globals.PasswordAccountPrefix, globals.OidcAccountPrefix, globals.LdapAccountPrefix, globals.SamlAccountPrefix, globals.CertAccountPrefix)
 }
 
 func validateCreateRequest(ctx context.Context, req *pbs.CreateAccountRequest) error {
@@ -1359,6 +1945,47 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateAccountRequest) e
 					badFields[memberOfAttrField] = "This is a read only field."
 				}
 			}
+		case saml.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != saml.Subtype.String() {
+				badFields[typeField] = "Doesn't match the parent resource's type."
+			}
+			attrs := req.GetItem().GetSamlAccountAttributes()
+			switch {
+			case attrs == nil:
+				badFields["attributes"] = "This is a required field."
+			default:
+				if attrs.GetSubject() == "" {
+					badFields[samlSubjectField] = "This is a required field for this type."
+				}
+				if attrs.GetFullName() != "" {
+					badFields[samlFullNameField] = "This is a read only field."
+				}
+				if attrs.GetEmail() != "" {
+					badFields[samlEmailField] = "This is a read only field."
+				}
+				if attrs.GetAttributes() != nil {
+					badFields[samlAttributesField] = "This is a read only field."
+				}
+			}
+		case cert.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != cert.Subtype.String() {
+				badFields[typeField] = "Doesn't match the parent resource's type."
+			}
+			attrs := req.GetItem().GetCertAccountAttributes()
+			switch {
+			case attrs == nil:
+				badFields["attributes"] = "This is a required field."
+			default:
+				if attrs.GetSubject() == "" {
+					badFields[certSubjectField] = "This is a required field for this type."
+				}
+				if attrs.GetFullName() != "" {
+					badFields[certFullNameField] = "This is a read only field."
+				}
+				if attrs.GetEmail() != "" {
+					badFields[certEmailField] = "This is a read only field."
+				}
+			}
 		default:
 			badFields[authMethodIdField] = "Unknown auth method type from ID."
 		}
@@ -1413,9 +2040,41 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateAccountRequest) e
 			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), memberOfAttrField) {
 				badFields[memberOfAttrField] = "Field cannot be updated."
 			}
+		case saml.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != saml.Subtype.String() {
+				badFields[typeField] = "Cannot modify the resource type."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), samlSubjectField) {
+				badFields[samlSubjectField] = "Field cannot be updated."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), samlIssuerField) {
+				badFields[samlIssuerField] = "Field cannot be updated."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), samlFullNameField) {
+				badFields[samlFullNameField] = "Field is read only."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), samlEmailField) {
+				badFields[samlEmailField] = "Field is read only."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), samlAttributesField) {
+				badFields[samlAttributesField] = "Field is read only."
+			}
+		case cert.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != cert.Subtype.String() {
+				badFields[typeField] = "Cannot modify the resource type."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), certSubjectField) {
+				badFields[certSubjectField] = "Field cannot be updated."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), certFullNameField) {
+				badFields[certFullNameField] = "Field is read only."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), certEmailField) {
+				badFields[certEmailField] = "Field is read only."
+			}
 		}
 		return badFields
-	}, globals.PasswordAccountPreviousPrefix, globals.PasswordAccountPrefix, globals.OidcAccountPrefix, globals.LdapAccountPrefix)
+	}, globals.PasswordAccountPreviousPrefix, globals.PasswordAccountPrefix, globals.OidcAccountPrefix, globals.LdapAccountPrefix, globals.SamlAccountPrefix, globals.CertAccountPrefix)
 }
 
 func validateDeleteRequest(ctx context.Context, req *pbs.DeleteAccountRequest) error {
@@ -1423,7 +2082,7 @@ func validateDeleteRequest(ctx context.Context, req *pbs.DeleteAccountRequest) e
 	if req == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
 	}
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.PasswordAccountPreviousPrefix, globals.PasswordAccountPrefix, globals.OidcAccountPrefix, globals.LdapAccountPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.PasswordAccountPreviousPrefix, globals.PasswordAccountPrefix, globals.OidcAccountPrefix, globals.LdapAccountPrefix, globals.SamlAccountPrefix, globals.CertAccountPrefix)
 }
 
 func validateListRequest(ctx context.Context, req *pbs.ListAccountsRequest) error {
@@ -1432,7 +2091,7 @@ func validateListRequest(ctx context.Context, req *pbs.ListAccountsRequest) erro
 		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
 	}
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), globals.PasswordAuthMethodPrefix, globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), globals.PasswordAuthMethodPrefix, globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix, globals.SamlAuthMethodPrefix, globals.CertAuthMethodPrefix) {
 		badFields[authMethodIdField] = "Invalid formatted identifier."
 	}
 	if _, err := handlers.NewFilter(ctx, req.GetFilter()); err != nil {
@@ -1468,6 +2127,13 @@ func validateChangePasswordRequest(ctx context.Context, req *pbs.ChangePasswordR
 	return nil
 }
 
+func validateTotpRequest(id string) error {
+	if !handlers.ValidId(handlers.Id(id), globals.PasswordAccountPreviousPrefix, globals.PasswordAccountPrefix) {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{idField: "Improperly formatted identifier."})
+	}
+	return nil
+}
+
 func validateSetPasswordRequest(ctx context.Context, req *pbs.SetPasswordRequest) error {
 	const op = "accounts.validateSetPasswordRequest"
 	if req == nil {
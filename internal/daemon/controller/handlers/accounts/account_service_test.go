@@ -14,12 +14,14 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth/cert"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	ldapstore "github.com/hashicorp/boundary/internal/auth/ldap/store"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	oidcstore "github.com/hashicorp/boundary/internal/auth/oidc/store"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	pwstore "github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/auth/saml"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	requestauth "github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
@@ -55,6 +57,9 @@ var (
 		action.Delete.String(),
 		action.SetPassword.String(),
 		action.ChangePassword.String(),
+		action.EnrollTotp.String(),
+		action.VerifyTotp.String(),
+		action.RemoveTotp.String(),
 	}
 	oidcAuthorizedActions = []string{
 		action.NoOp.String(),
@@ -85,6 +90,12 @@ func TestNewService(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
 	cases := []struct {
 		name     string
@@ -114,7 +125,7 @@ func TestNewService(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			_, err := accounts.NewService(ctx, tc.pwRepo, tc.oidcRepo, ldapRepoFn, 1000)
+			_, err := accounts.NewService(ctx, tc.pwRepo, tc.oidcRepo, ldapRepoFn, samlRepoFn, certRepoFn, 1000)
 			if tc.wantErr {
 				assert.Error(t, err)
 			} else {
@@ -142,8 +153,14 @@ func TestGet(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
-	s, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, 1000)
+	s, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, 1000)
 	require.NoError(t, err, "Couldn't create new auth token service.")
 
 	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -337,6 +354,12 @@ func TestListPassword(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kms)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kms)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kms)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
 	ams := password.TestAuthMethods(t, conn, o.GetPublicId(), 3)
@@ -463,7 +486,7 @@ func TestListPassword(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, 1000)
+			s, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, 1000)
 			require.NoError(err, "Couldn't create new user service.")
 
 			// Test non-anon first
@@ -522,6 +545,12 @@ func TestListOidc(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
 	databaseWrapper, err := kmsCache.GetWrapper(ctx, o.PublicId, kms.KeyPurposeDatabase)
@@ -663,7 +692,7 @@ func TestListOidc(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, 1000)
+			s, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, 1000)
 			require.NoError(err, "Couldn't create new user service.")
 
 			got, gErr := s.ListAccounts(requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), tc.req)
@@ -721,6 +750,12 @@ func TestListLdap(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
 	databaseWrapper, err := kmsCache.GetWrapper(ctx, o.PublicId, kms.KeyPurposeDatabase)
@@ -857,7 +892,7 @@ func TestListLdap(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, 1000)
+			s, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, 1000)
 			require.NoError(err, "Couldn't create new user service.")
 
 			got, gErr := s.ListAccounts(requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), tc.req)
@@ -922,6 +957,12 @@ func TestListPagination(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	tokenRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, rw, rw, kmsCache)
 	}
@@ -929,7 +970,7 @@ func TestListPagination(t *testing.T) {
 		return server.NewRepository(ctx, rw, rw, kmsCache)
 	}
 
-	s, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, 1000)
+	s, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, 1000)
 	require.NoError(t, err)
 
 	iamRepo := iam.TestRepo(t, conn, wrap)
@@ -1953,6 +1994,12 @@ func TestDelete(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
 	am1 := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
@@ -1972,7 +2019,7 @@ func TestDelete(t *testing.T) {
 	ldapAm := ldap.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, []string{"ldaps://ldap1"})
 	ldapAcct := ldap.TestAccount(t, conn, ldapAm, "test-account")
 
-	s, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, 1000)
+	s, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new user service.")
 
 	cases := []struct {
@@ -2076,12 +2123,18 @@ func TestDelete_twice(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kms)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kms)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kms)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
 	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
 	ac := password.TestAccount(t, conn, am.GetPublicId(), "name1")
 
-	s, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, 1000)
+	s, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, 1000)
 	require.NoError(err, "Error when getting new user service")
 	req := &pbs.DeleteAccountRequest{
 		Id: ac.GetPublicId(),
@@ -2111,8 +2164,14 @@ func TestCreatePassword(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kms)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kms)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kms)
+	}
 
-	s, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, 1000)
+	s, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new account service.")
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -2365,8 +2424,14 @@ func TestCreateOidc(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
-	s, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, 1000)
+	s, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new account service.")
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -2609,8 +2674,14 @@ func TestCreateLdap(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
-	s, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, 1000)
+	s, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new account service.")
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -2895,10 +2966,16 @@ func TestUpdatePassword(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kms)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kms)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kms)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
 	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
-	tested, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, 1000)
+	tested, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new accounts service.")
 
 	defaultScopeInfo := &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType(), ParentScopeId: scope.Global.String()}
@@ -3292,6 +3369,12 @@ func TestUpdateOidc(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
 
@@ -3304,7 +3387,7 @@ func TestUpdateOidc(t *testing.T) {
 		oidc.WithSigningAlgs(oidc.RS256),
 		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]))
 
-	tested, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, 1000)
+	tested, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	defaultScopeInfo := &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType(), ParentScopeId: scope.Global.String()}
@@ -3689,6 +3772,12 @@ func TestUpdateLdap(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
 
@@ -3696,7 +3785,7 @@ func TestUpdateLdap(t *testing.T) {
 	require.NoError(t, err)
 	am := ldap.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, []string{"ldaps://ldap"})
 
-	tested, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, 1000)
+	tested, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	defaultScopeInfo := &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType(), ParentScopeId: scope.Global.String()}
@@ -4070,9 +4159,15 @@ func TestSetPassword(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kms)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kms)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kms)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
-	tested, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, 1000)
+	tested, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	createAccount := func(t *testing.T, pw string) *pb.Account {
@@ -4219,9 +4314,15 @@ func TestChangePassword(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kms)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kms)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kms)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
-	tested, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, 1000)
+	tested, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	createAccount := func(t *testing.T, pw string) *pb.Account {
@@ -12,9 +12,11 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/cert"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/auth/saml"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	requestauth "github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
@@ -90,6 +92,8 @@ type Service struct {
 	iamRepoFn   common.IamRepoFactory
 	atRepoFn    common.AuthTokenRepoFactory
 	ldapRepoFn  common.LdapAuthRepoFactory
+	samlRepoFn  common.SamlAuthRepoFactory
+	certRepoFn  common.CertAuthRepoFactory
 	amRepoFn    common.AuthMethodRepoFactory
 	maxPageSize uint
 }
@@ -105,6 +109,8 @@ func NewService(
 	iamRepoFn common.IamRepoFactory,
 	atRepoFn common.AuthTokenRepoFactory,
 	ldapRepoFn common.LdapAuthRepoFactory,
+	samlRepoFn common.SamlAuthRepoFactory,
+	certRepoFn common.CertAuthRepoFactory,
 	amRepoFn common.AuthMethodRepoFactory,
 	maxPageSize uint,
 	opt ...handlers.Option,
@@ -122,6 +128,12 @@ func NewService(
 	if ldapRepoFn == nil {
 		return Service{}, fmt.Errorf("nil ldap repository provided")
 	}
+	if samlRepoFn == nil {
+		return Service{}, fmt.Errorf("nil saml repository provided")
+	}
+	if certRepoFn == nil {
+		return Service{}, fmt.Errorf("nil cert repository provided")
+	}
 	if iamRepoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository")
 	}
@@ -141,6 +153,8 @@ func NewService(
 		iamRepoFn:   iamRepoFn,
 		atRepoFn:    atRepoFn,
 		ldapRepoFn:  ldapRepoFn,
+		samlRepoFn:  samlRepoFn,
+		certRepoFn:  certRepoFn,
 		amRepoFn:    amRepoFn,
 		maxPageSize: maxPageSize,
 	}
@@ -288,6 +302,12 @@ func (s Service) ListAuthMethods(ctx context.Context, req *pbs.ListAuthMethodsRe
 		if err != nil {
 			continue
 		}
+		if err := s.addPwPolicy(ctx, pbItem); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if err := s.addTokenPolicy(ctx, item.GetPublicId(), handlers.GetOpts(outputOpts...).WithOutputFields, pbItem); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
 		finalItems = append(finalItems, pbItem)
 	}
 	respType := "delta"
@@ -354,6 +374,12 @@ func (s Service) GetAuthMethod(ctx context.Context, req *pbs.GetAuthMethodReques
 	if err != nil {
 		return nil, err
 	}
+	if err := s.addPwPolicy(ctx, item); err != nil {
+		return nil, err
+	}
+	if err := s.addTokenPolicy(ctx, am.GetPublicId(), outputFields, item); err != nil {
+		return nil, err
+	}
 
 	return &pbs.GetAuthMethodResponse{Item: item}, nil
 }
@@ -373,6 +399,9 @@ func (s Service) CreateAuthMethod(ctx context.Context, req *pbs.CreateAuthMethod
 	if err != nil {
 		return nil, err
 	}
+	if err := s.createTokenPolicy(ctx, am, req.GetItem()); err != nil {
+		return nil, err
+	}
 
 	outputFields, ok := requests.OutputFields(ctx)
 	if !ok {
@@ -399,6 +428,12 @@ func (s Service) CreateAuthMethod(ctx context.Context, req *pbs.CreateAuthMethod
 	if err != nil {
 		return nil, err
 	}
+	if err := s.addPwPolicy(ctx, item); err != nil {
+		return nil, err
+	}
+	if err := s.addTokenPolicy(ctx, am.GetPublicId(), outputFields, item); err != nil {
+		return nil, err
+	}
 
 	return &pbs.CreateAuthMethodResponse{Item: item, Uri: fmt.Sprintf("auth-methods/%s", item.GetId())}, nil
 }
@@ -414,7 +449,7 @@ func (s Service) UpdateAuthMethod(ctx context.Context, req *pbs.UpdateAuthMethod
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	am, dryRun, err := s.updateInRepo(ctx, authResults.Scope.GetId(), req)
+	am, dryRun, err := s.updateWithTokenPolicy(ctx, authResults.Scope.GetId(), req)
 	if err != nil {
 		switch {
 		case errors.Match(errors.T(errors.InvalidParameter), err):
@@ -449,6 +484,12 @@ func (s Service) UpdateAuthMethod(ctx context.Context, req *pbs.UpdateAuthMethod
 	if err != nil {
 		return nil, err
 	}
+	if err := s.addPwPolicy(ctx, item); err != nil {
+		return nil, err
+	}
+	if err := s.addTokenPolicy(ctx, am.GetPublicId(), outputFields, item); err != nil {
+		return nil, err
+	}
 
 	if item.GetOidcAuthMethodsAttributes() != nil && dryRun {
 		item.GetOidcAuthMethodsAttributes().DryRun = true
@@ -543,6 +584,14 @@ func (s Service) Authenticate(ctx context.Context, req *pbs.AuthenticateRequest)
 		if err := validateAuthenticateLdapRequest(req); err != nil {
 			return nil, err
 		}
+	case saml.Subtype:
+		if err := validateAuthenticateSamlRequest(req); err != nil {
+			return nil, err
+		}
+	case cert.Subtype:
+		if err := validateAuthenticateCertRequest(req); err != nil {
+			return nil, err
+		}
 	}
 
 	authResults := s.authResult(ctx, req.GetAuthMethodId(), action.Authenticate)
@@ -558,6 +607,10 @@ func (s Service) Authenticate(ctx context.Context, req *pbs.AuthenticateRequest)
 		return s.authenticateOidc(ctx, req, &authResults)
 	case ldap.Subtype:
 		return s.authenticateLdap(ctx, req, &authResults)
+	case saml.Subtype:
+		return s.authenticateSaml(ctx, req, &authResults)
+	case cert.Subtype:
+		return s.authenticateCert(ctx, req, &authResults)
 	}
 	return nil, errors.New(ctx, errors.Internal, op, "Invalid auth method subtype not caught in validation function.")
 }
@@ -587,6 +640,20 @@ func (s Service) getFromRepo(ctx context.Context, id string) (auth.AuthMethod, e
 		}
 		am, lookupErr = repo.LookupAuthMethod(ctx, id)
 
+	case saml.Subtype:
+		repo, err := s.samlRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		am, lookupErr = repo.LookupAuthMethod(ctx, id)
+
+	case cert.Subtype:
+		repo, err := s.certRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		am, lookupErr = repo.LookupAuthMethod(ctx, id)
+
 	default:
 		return nil, handlers.NotFoundErrorf("Unrecognized id.")
 	}
@@ -635,6 +702,24 @@ func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Auth
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create auth method but no error returned from repository.")
 		}
 		out = am
+	case saml.Subtype.String():
+		am, err := s.createSamlInRepo(ctx, scopeId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if am == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create auth method but no error returned from repository.")
+		}
+		out = am
+	case cert.Subtype.String():
+		am, err := s.createCertInRepo(ctx, scopeId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if am == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create auth method but no error returned from repository.")
+		}
+		out = am
 	}
 	return out, nil
 }
@@ -684,6 +769,42 @@ func (s Service) updateInRepo(ctx context.Context, scopeId string, req *pbs.Upda
 			return nil, false, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update auth method but no error returned from repository.")
 		}
 		am = lam
+
+	case saml.Subtype:
+		sam, err := s.updateSamlInRepo(ctx, scopeId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+		if err != nil {
+			_, apiErr := err.(*handlers.ApiError)
+			switch {
+			case apiErr:
+				return nil, false, err
+			case errors.Match(errors.T(errors.InvalidParameter), err):
+				return nil, false, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, err.Error())
+			default:
+				return nil, false, errors.Wrap(ctx, err, op)
+			}
+		}
+		if sam == nil {
+			return nil, false, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update auth method but no error returned from repository.")
+		}
+		am = sam
+
+	case cert.Subtype:
+		cam, err := s.updateCertInRepo(ctx, scopeId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+		if err != nil {
+			_, apiErr := err.(*handlers.ApiError)
+			switch {
+			case apiErr:
+				return nil, false, err
+			case errors.Match(errors.T(errors.InvalidParameter), err):
+				return nil, false, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, err.Error())
+			default:
+				return nil, false, errors.Wrap(ctx, err, op)
+			}
+		}
+		if cam == nil {
+			return nil, false, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update auth method but no error returned from repository.")
+		}
+		am = cam
 	}
 
 	return am, dryRun, nil
@@ -713,6 +834,18 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 			return false, errors.Wrap(ctx, err, op)
 		}
 		rows, dErr = repo.DeleteAuthMethod(ctx, id)
+	case saml.Subtype:
+		repo, err := s.samlRepoFn()
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+		rows, dErr = repo.DeleteAuthMethod(ctx, id)
+	case cert.Subtype:
+		repo, err := s.certRepoFn()
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+		rows, dErr = repo.DeleteAuthMethod(ctx, id)
 	default:
 		return false, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid auth method subtype: %q", globals.ResourceInfoFromPrefix(id).Subtype.String()))
 	}
@@ -758,6 +891,54 @@ func (s Service) changeStateInRepo(ctx context.Context, req *pbs.ChangeStateRequ
 			return nil, err
 		}
 
+		return am, nil
+
+	case saml.Subtype:
+		repo, err := s.samlRepoFn()
+		if err != nil {
+			return nil, err
+		}
+
+		attrs := req.GetSamlChangeStateAttributes()
+		var am *saml.AuthMethod
+		switch oidcStateMap[attrs.GetState()] {
+		case inactiveState:
+			am, err = repo.MakeInactive(ctx, req.GetId(), req.GetVersion())
+		case privateState:
+			am, err = repo.MakePrivate(ctx, req.GetId(), req.GetVersion())
+		case publicState:
+			am, err = repo.MakePublic(ctx, req.GetId(), req.GetVersion())
+		default:
+			err = errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unrecognized state %q", attrs.GetState()))
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return am, nil
+
+	case cert.Subtype:
+		repo, err := s.certRepoFn()
+		if err != nil {
+			return nil, err
+		}
+
+		attrs := req.GetCertChangeStateAttributes()
+		var am *cert.AuthMethod
+		switch oidcStateMap[attrs.GetState()] {
+		case inactiveState:
+			am, err = repo.MakeInactive(ctx, req.GetId(), req.GetVersion())
+		case privateState:
+			am, err = repo.MakePrivate(ctx, req.GetId(), req.GetVersion())
+		case publicState:
+			am, err = repo.MakePublic(ctx, req.GetId(), req.GetVersion())
+		default:
+			err = errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unrecognized state %q", attrs.GetState()))
+		}
+		if err != nil {
+			return nil, err
+		}
+
 		return am, nil
 	}
 
@@ -838,6 +1019,38 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) reque
 				return res
 			}
 			authMeth = am
+		case saml.Subtype:
+			repo, err := s.samlRepoFn()
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			am, err := repo.LookupAuthMethod(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if am == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			authMeth = am
+		case cert.Subtype:
+			repo, err := s.certRepoFn()
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			am, err := repo.LookupAuthMethod(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if am == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			authMeth = am
 		default:
 			res.Error = errors.New(ctx, errors.InvalidPublicId, op, "unrecognized auth method type")
 			return res
@@ -1001,6 +1214,34 @@ func toAuthMethodProto(ctx context.Context, in auth.AuthMethod, opt ...handlers.
 		out.Attrs = &pb.AuthMethod_LdapAuthMethodsAttributes{
 			LdapAuthMethodsAttributes: attrs,
 		}
+	case *saml.AuthMethod:
+		if outputFields.Has(globals.TypeField) {
+			out.Type = saml.Subtype.String()
+		}
+		if !outputFields.Has(globals.AttributesField) {
+			break
+		}
+		attrs, err := toSamlAuthMethodProto(ctx, i)
+		if err != nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build saml auth method attributes: %v.", err)
+		}
+		out.Attrs = &pb.AuthMethod_SamlAuthMethodAttributes{
+			SamlAuthMethodAttributes: attrs,
+		}
+	case *cert.AuthMethod:
+		if outputFields.Has(globals.TypeField) {
+			out.Type = cert.Subtype.String()
+		}
+		if !outputFields.Has(globals.AttributesField) {
+			break
+		}
+		attrs, err := toCertAuthMethodProto(ctx, i)
+		if err != nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build cert auth method attributes: %v.", err)
+		}
+		out.Attrs = &pb.AuthMethod_CertAuthMethodAttributes{
+			CertAuthMethodAttributes: attrs,
+		}
 	}
 	return &out, nil
 }
@@ -1029,7 +1270,7 @@ func validateGetRequest(ctx context.Context, req *pbs.GetAuthMethodRequest) erro
 	if req == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "Missing request")
 	}
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.PasswordAuthMethodPrefix, globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.PasswordAuthMethodPrefix, globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix, globals.SamlAuthMethodPrefix, globals.CertAuthMethodPrefix)
 }
 
 func validateCreateRequest(ctx context.Context, req *pbs.CreateAuthMethodRequest) error {
@@ -1144,6 +1385,19 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateAuthMethodRequest
 				badFields[urlsField] = "At least one URL is required"
 			}
 			validateLdapAttributes(ctx, req.GetItem().GetLdapAuthMethodsAttributes(), badFields)
+		case saml.Subtype.String():
+			attrs := req.GetItem().GetSamlAuthMethodAttributes()
+			if attrs == nil {
+				// SAML attributes are required when creating a SAML auth method.
+				badFields[attributesField] = "Attributes are required for creating a SAML auth method."
+			} else {
+				if strings.TrimSpace(attrs.GetApiUrlPrefix().GetValue()) == "" {
+					badFields[apiUrlPrefixField] = "This is a required field."
+				}
+				validateSamlAttributes(ctx, attrs, badFields)
+			}
+		case cert.Subtype.String():
+			validateCertAttributes(ctx, req.GetItem().GetCertAuthMethodAttributes(), badFields)
 		default:
 			badFields[typeField] = fmt.Sprintf("This is a required field and must be %q.", password.Subtype.String())
 		}
@@ -1289,11 +1543,26 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateAuthMethodRequest
 				badFields[typeField] = "Cannot modify the resource type."
 			}
 			validateLdapAttributes(ctx, req.GetItem().GetLdapAuthMethodsAttributes(), badFields)
+		case saml.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != saml.Subtype.String() {
+				badFields[typeField] = "Cannot modify the resource type."
+			}
+			attrs := req.GetItem().GetSamlAuthMethodAttributes()
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), apiUrlPrefixField) &&
+				strings.TrimSpace(attrs.GetApiUrlPrefix().GetValue()) == "" {
+				badFields[apiUrlPrefixField] = "This field should not be set to empty."
+			}
+			validateSamlAttributes(ctx, attrs, badFields)
+		case cert.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != cert.Subtype.String() {
+				badFields[typeField] = "Cannot modify the resource type."
+			}
+			validateCertAttributes(ctx, req.GetItem().GetCertAuthMethodAttributes(), badFields)
 		default:
 			badFields["id"] = "Incorrectly formatted identifier."
 		}
 		return badFields
-	}, globals.PasswordAuthMethodPrefix, globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix)
+	}, globals.PasswordAuthMethodPrefix, globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix, globals.SamlAuthMethodPrefix, globals.CertAuthMethodPrefix)
 }
 
 func validateDeleteRequest(ctx context.Context, req *pbs.DeleteAuthMethodRequest) error {
@@ -1301,7 +1570,7 @@ func validateDeleteRequest(ctx context.Context, req *pbs.DeleteAuthMethodRequest
 	if req == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "Missing request")
 	}
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.PasswordAuthMethodPrefix, globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.PasswordAuthMethodPrefix, globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix, globals.SamlAuthMethodPrefix, globals.CertAuthMethodPrefix)
 }
 
 func validateListRequest(ctx context.Context, req *pbs.ListAuthMethodsRequest) error {
@@ -1328,15 +1597,28 @@ func validateChangeStateRequest(ctx context.Context, req *pbs.ChangeStateRequest
 	if req == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "Missing request")
 	}
-	if st := globals.ResourceInfoFromPrefix(req.GetId()).Subtype; st != oidc.Subtype {
-		return handlers.NotFoundErrorf("This endpoint is only available for the %q Auth Method type.", oidc.Subtype.String())
+	var attrs interface{ GetState() string }
+	switch globals.ResourceInfoFromPrefix(req.GetId()).Subtype {
+	case oidc.Subtype:
+		if a := req.GetOidcChangeStateAttributes(); a != nil {
+			attrs = a
+		}
+	case saml.Subtype:
+		if a := req.GetSamlChangeStateAttributes(); a != nil {
+			attrs = a
+		}
+	case cert.Subtype:
+		if a := req.GetCertChangeStateAttributes(); a != nil {
+			attrs = a
+		}
+	default:
+		return handlers.NotFoundErrorf("This endpoint is only available for the %q, %q and %q Auth Method types.", oidc.Subtype.String(), saml.Subtype.String(), cert.Subtype.String())
 	}
 	badFields := make(map[string]string)
 	if req.GetVersion() == 0 {
 		badFields[versionField] = "Resource version is required."
 	}
 
-	attrs := req.GetOidcChangeStateAttributes()
 	if attrs == nil {
 		badFields[attributesField] = "Attributes are required when changing an auth method."
 	} else {
@@ -1365,7 +1647,7 @@ func validateAuthenticateRequest(ctx context.Context, req *pbs.AuthenticateReque
 	} else {
 		st := globals.ResourceInfoFromPrefix(req.GetAuthMethodId()).Subtype
 		switch st {
-		case password.Subtype, oidc.Subtype, ldap.Subtype:
+		case password.Subtype, oidc.Subtype, ldap.Subtype, saml.Subtype, cert.Subtype:
 		default:
 			badFields[authMethodIdField] = "Unknown auth method type."
 		}
@@ -1513,6 +1795,39 @@ func transformAuthenticateRequestAttributes(ctx context.Context, msg proto.Messa
 		authRequest.Attrs = &pbs.AuthenticateRequest_LdapLoginAttributes{
 			LdapLoginAttributes: newAttrs,
 		}
+	case saml.Subtype:
+		switch authRequest.GetCommand() {
+		case startCommand:
+			newAttrs := &pbs.SamlStartAttributes{}
+			if err := handlers.StructToProto(attrs, newAttrs); err != nil {
+				return err
+			}
+			authRequest.Attrs = &pbs.AuthenticateRequest_SamlStartAttributes{
+				SamlStartAttributes: newAttrs,
+			}
+		case callbackCommand:
+			newAttrs := &pb.SamlAuthMethodAuthenticateCallbackRequest{}
+			if err := handlers.StructToProto(attrs, newAttrs, handlers.WithDiscardUnknownFields(true)); err != nil {
+				return err
+			}
+			authRequest.Attrs = &pbs.AuthenticateRequest_SamlAuthMethodAuthenticateCallbackRequest{
+				SamlAuthMethodAuthenticateCallbackRequest: newAttrs,
+			}
+		case tokenCommand:
+			newAttrs := &pb.SamlAuthMethodAuthenticateTokenRequest{}
+			if err := handlers.StructToProto(attrs, newAttrs); err != nil {
+				return err
+			}
+			authRequest.Attrs = &pbs.AuthenticateRequest_SamlAuthMethodAuthenticateTokenRequest{
+				SamlAuthMethodAuthenticateTokenRequest: newAttrs,
+			}
+		default:
+			return fmt.Errorf("%s: unknown command %q", op, authRequest.GetCommand())
+		}
+	case cert.Subtype:
+		// Cert auth methods authenticate with the TLS client certificate
+		// presented to the listener and take no attributes.
+		authRequest.Attrs = nil
 	default:
 		return &subtypes.UnknownSubtypeIDError{
 			ID: authRequest.GetAuthMethodId(),
@@ -1557,6 +1872,21 @@ func transformAuthenticateResponseAttributes(ctx context.Context, msg proto.Mess
 		if err != nil {
 			return err
 		}
+	case *pbs.AuthenticateResponse_SamlAuthMethodAuthenticateStartResponse:
+		newAttrs, err = handlers.ProtoToStruct(ctx, attrs.SamlAuthMethodAuthenticateStartResponse)
+		if err != nil {
+			return err
+		}
+	case *pbs.AuthenticateResponse_SamlAuthMethodAuthenticateCallbackResponse:
+		newAttrs, err = handlers.ProtoToStruct(ctx, attrs.SamlAuthMethodAuthenticateCallbackResponse)
+		if err != nil {
+			return err
+		}
+	case *pbs.AuthenticateResponse_SamlAuthMethodAuthenticateTokenResponse:
+		newAttrs, err = handlers.ProtoToStruct(ctx, attrs.SamlAuthMethodAuthenticateTokenResponse)
+		if err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("%s: unknown attributes type %T", op, attrs)
 	}
@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package authmethods
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/cert"
+	certstore "github.com/hashicorp/boundary/internal/auth/cert/store"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/types/action"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var certMaskManager handlers.MaskManager
+
+func init() {
+	var err error
+	if certMaskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{
+			&certstore.AuthMethod{},
+		},
+		handlers.MaskSource{&pb.AuthMethod{}, &pb.CertAuthMethodAttributes{}},
+	); err != nil {
+		panic(err)
+	}
+
+	IdActions[cert.Subtype] = action.NewActionSet(
+		action.NoOp,
+		action.Read,
+		action.Update,
+		action.Delete,
+		action.ChangeState,
+		action.Authenticate,
+	)
+}
+
+const (
+	caCertificatesField = "attributes.ca_certificates"
+	accountFieldField   = "attributes.account_field"
+)
+
+// createCertInRepo creates a cert auth method in a repo and returns the result.
+// This method should never return a nil AuthMethod without returning an error.
+func (s Service) createCertInRepo(ctx context.Context, scopeId string, item *pb.AuthMethod) (*cert.AuthMethod, error) {
+	u, err := toStorageCertAuthMethod(ctx, scopeId, item)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := s.certRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateAuthMethod(ctx, u)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create auth method: %w", err)
+	}
+	return out, nil
+}
+
+func (s Service) updateCertInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.AuthMethod) (*cert.AuthMethod, error) {
+	const op = "authmethod_service.(Service).updateCertInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil auth method.")
+	}
+	u, err := toStorageCertAuthMethod(ctx, scopeId, item)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	u.PublicId = id
+
+	version := item.GetVersion()
+	dbMask := certMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+
+	repo, err := s.certRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.UpdateAuthMethod(ctx, u, version, dbMask)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update auth method"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("AuthMethod %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
+// authenticateCert authenticates the caller with the TLS client certificate
+// chain it presented to the controller's API listener.
+func (s Service) authenticateCert(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
+	const op = "authmethod_service.(Service).authenticateCert"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil request.")
+	}
+	if authResults == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil auth results.")
+	}
+	chain, err := authResults.ClientCertificates(ctx)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+	}
+	if len(chain) == 0 {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "No client certificate was presented.")
+	}
+
+	certRepo, err := s.certRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	certFn := func() (cert.Authenticator, error) {
+		return certRepo, nil
+	}
+
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	iamFn := func() (cert.LookupUser, error) {
+		return iamRepo, nil
+	}
+
+	atRepo, err := s.atRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	atFn := func() (cert.AuthTokenCreator, error) {
+		return atRepo, nil
+	}
+
+	rawTk, err := cert.Authenticate(ctx, certFn, iamFn, atFn, req.GetAuthMethodId(), chain)
+	if err != nil {
+		// let's not send back too much info about the error
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+	}
+	tk, err := s.ConvertInternalAuthTokenToApiAuthToken(
+		ctx,
+		rawTk,
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	return s.convertToAuthenticateResponse(ctx, req, authResults, tk)
+}
+
+func validateAuthenticateCertRequest(req *pbs.AuthenticateRequest) error {
+	badFields := make(map[string]string)
+
+	if req.GetCommand() == "" {
+		req.Command = loginCommand
+	}
+	if req.Command != loginCommand {
+		badFields[commandField] = "Invalid command for this auth method type."
+	}
+	tokenType := req.GetType()
+	if tokenType == "" {
+		// Fall back to deprecated field if type is not set
+		tokenType = req.GetTokenType() //nolint:all
+	}
+	tType := strings.ToLower(strings.TrimSpace(tokenType))
+	if tType != "" && tType != "token" && tType != "cookie" {
+		badFields[tokenTypeField] = `The only accepted types are "token" and "cookie".`
+	}
+
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Invalid fields provided in request.", badFields)
+	}
+	return nil
+}
+
+func validateCertAttributes(ctx context.Context, attrs *pb.CertAuthMethodAttributes, badFields map[string]string) {
+	if attrs == nil {
+		return
+	}
+	if attrs.GetState() != "" {
+		badFields[stateField] = "Field is read only."
+	}
+	if len(attrs.GetCaCertificates()) > 0 {
+		if _, err := cert.ParseCertificates(ctx, attrs.GetCaCertificates()...); err != nil {
+			badFields[caCertificatesField] = fmt.Sprintf("Cannot parse CA certificates. %v", err.Error())
+		}
+	}
+	if f := attrs.GetAccountField().GetValue(); f != "" {
+		if err := cert.AccountField(f).IsValid(ctx); err != nil {
+			badFields[accountFieldField] = fmt.Sprintf("%q is not a valid account field.", f)
+		}
+	}
+}
+
+func toCertAuthMethodProto(ctx context.Context, in *cert.AuthMethod) (*pb.CertAuthMethodAttributes, error) {
+	const op = "authmethod_service.toCertAuthMethodProto"
+	attrs := &pb.CertAuthMethodAttributes{
+		State: in.GetOperationalState(),
+	}
+	if in.GetAccountField() != "" {
+		attrs.AccountField = wrapperspb.String(in.GetAccountField())
+	}
+	if in.GetCaCertificates() != "" {
+		certs, err := cert.ParseCertificates(ctx, in.GetCaCertificates())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for _, c := range certs {
+			p, err := cert.EncodeCertificates(ctx, c)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			attrs.CaCertificates = append(attrs.CaCertificates, p)
+		}
+	}
+	return attrs, nil
+}
+
+func toStorageCertAuthMethod(ctx context.Context, scopeId string, in *pb.AuthMethod) (*cert.AuthMethod, error) {
+	const op = "authmethod_service.toStorageCertAuthMethod"
+	if in == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil auth method.")
+	}
+	attrs := in.GetCertAuthMethodAttributes()
+
+	var opts []cert.Option
+	if in.GetName() != nil {
+		opts = append(opts, cert.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, cert.WithDescription(in.GetDescription().GetValue()))
+	}
+	if len(attrs.GetCaCertificates()) > 0 {
+		certs, err := cert.ParseCertificates(ctx, attrs.GetCaCertificates()...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		opts = append(opts, cert.WithCaCertificates(certs...))
+	}
+	if f := strings.TrimSpace(attrs.GetAccountField().GetValue()); f != "" {
+		opts = append(opts, cert.WithAccountField(cert.AccountField(f)))
+	}
+
+	u, err := cert.NewAuthMethod(ctx, scopeId, opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method: %v.", err)
+	}
+	return u, nil
+}
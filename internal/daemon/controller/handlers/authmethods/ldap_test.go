@@ -16,9 +16,11 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/boundary/globals"
 	am "github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/cert"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/auth/saml"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
@@ -61,6 +63,12 @@ func Test_UpdateLdap(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kms)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kms)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kms)
+	}
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(ctx, rw, rw, kms)
 	}
@@ -73,7 +81,7 @@ func Test_UpdateLdap(t *testing.T) {
 	iamRepo := iam.TestRepo(t, conn, wrapper)
 
 	o, _ := iam.TestScopes(t, iamRepo)
-	tested, err := authmethods.NewService(ctx, kms, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn, ldapRepoFn, authMethodRepoFn, 1000)
+	tested, err := authmethods.NewService(ctx, kms, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, authMethodRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	defaultScopeInfo := &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType(), ParentScopeId: scope.Global.String()}
@@ -912,6 +920,12 @@ func TestAuthenticate_Ldap(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(testCtx, testRw, testRw, testKms)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(testCtx, testRw, testRw, testKms)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(testCtx, testRw, testRw, testKms)
+	}
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(testCtx, testRw, testRw, testKms)
 	}
@@ -1100,7 +1114,7 @@ func TestAuthenticate_Ldap(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := authmethods.NewService(testCtx, testKms, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn, ldapRepoFn, authMethodRepoFn, 1000)
+			s, err := authmethods.NewService(testCtx, testKms, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, authMethodRepoFn, 1000)
 			require.NoError(err)
 
 			resp, err := s.Authenticate(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), tc.request)
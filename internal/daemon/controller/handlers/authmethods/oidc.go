@@ -173,6 +173,9 @@ func (s Service) authenticateOidcStart(ctx context.Context, req *pbs.Authenticat
 
 	var opts []oidc.Option
 	attrs := req.GetOidcStartAttributes()
+	if attrs.GetDeviceAuthorization() {
+		return s.authenticateOidcDeviceStart(ctx, req)
+	}
 	if attrs.GetCachedRoundtripPayload() != "" {
 		opts = append(opts, oidc.WithRoundtripPayload(attrs.GetCachedRoundtripPayload()))
 	}
@@ -196,6 +199,39 @@ func (s Service) authenticateOidcStart(ctx context.Context, req *pbs.Authenticat
 	}, nil
 }
 
+// authenticateOidcDeviceStart starts an OIDC authentication attempt using the
+// device authorization grant. Instead of an authentication URL, the response
+// contains the user code and verification URI the user needs to complete the
+// authorization.
+func (s Service) authenticateOidcDeviceStart(ctx context.Context, req *pbs.AuthenticateRequest) (*pbs.AuthenticateResponse, error) {
+	const op = "authmethod_service.(Service).authenticateOidcDeviceStart"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil request.")
+	}
+
+	deviceAuth, tokenId, err := oidc.StartDeviceAuth(ctx, s.oidcRepoFn, req.GetAuthMethodId())
+	if err != nil {
+		// this event.WriteError(...) may cause a dup error to be emitted...
+		// it should be removed if that's the case.
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error starting the oidc device authorization flow"))
+		return nil, errors.New(ctx, errors.Internal, op, "Error starting the OIDC device authorization flow. See the controller's log for more information.")
+	}
+
+	return &pbs.AuthenticateResponse{
+		Command: req.GetCommand(),
+		Attrs: &pbs.AuthenticateResponse_OidcAuthMethodAuthenticateStartResponse{
+			OidcAuthMethodAuthenticateStartResponse: &pb.OidcAuthMethodAuthenticateStartResponse{
+				TokenId:                 tokenId,
+				UserCode:                deviceAuth.UserCode,
+				VerificationUri:         deviceAuth.VerificationUri,
+				VerificationUriComplete: deviceAuth.VerificationUriComplete,
+				Interval:                deviceAuth.Interval,
+				ExpiresIn:               deviceAuth.ExpiresIn,
+			},
+		},
+	}, nil
+}
+
 // authenticateOidcCallback behaves differently than other service methods.
 // Because of the way it this is called by the end user, it should only return
 // an error if we are unable to lookup the auth method or the request
@@ -292,7 +328,8 @@ func (s Service) authenticateOidcToken(ctx context.Context, req *pbs.Authenticat
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "Empty token ID in request attributes.")
 	}
 
-	token, err := oidc.TokenRequest(ctx, s.kms, s.atRepoFn, req.GetAuthMethodId(), attrs.TokenId)
+	token, interval, err := oidc.TokenRequest(ctx, s.kms, s.atRepoFn, req.GetAuthMethodId(), attrs.TokenId,
+		oidc.WithDeviceAuthRepositories(s.oidcRepoFn, oidc.IamRepoFactory(s.iamRepoFn)))
 	if err != nil {
 		switch {
 		case errors.Match(errors.T(errors.Forbidden), err):
@@ -314,7 +351,8 @@ func (s Service) authenticateOidcToken(ctx context.Context, req *pbs.Authenticat
 			Command: req.Command,
 			Attrs: &pbs.AuthenticateResponse_OidcAuthMethodAuthenticateTokenResponse{
 				OidcAuthMethodAuthenticateTokenResponse: &pb.OidcAuthMethodAuthenticateTokenResponse{
-					Status: "unknown",
+					Status:   "unknown",
+					Interval: interval,
 				},
 			},
 		}, nil
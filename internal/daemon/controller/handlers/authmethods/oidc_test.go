@@ -21,9 +21,11 @@ import (
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
 
 	am "github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/cert"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/auth/saml"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
@@ -62,6 +64,8 @@ type setup struct {
 	iamRepoFn                   common.IamRepoFactory
 	oidcRepoFn                  common.OidcAuthRepoFactory
 	ldapRepoFn                  common.LdapAuthRepoFactory
+	samlRepoFn                  common.SamlAuthRepoFactory
+	certRepoFn                  common.CertAuthRepoFactory
 	pwRepoFn                    common.PasswordAuthRepoFactory
 	atRepoFn                    common.AuthTokenRepoFactory
 	authMethodRepoFn            common.AuthMethodRepoFactory
@@ -101,6 +105,12 @@ func getSetup(t *testing.T) setup {
 	ret.ldapRepoFn = func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, ret.rw, ret.rw, ret.kmsCache)
 	}
+	ret.samlRepoFn = func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, ret.rw, ret.rw, ret.kmsCache)
+	}
+	ret.certRepoFn = func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, ret.rw, ret.rw, ret.kmsCache)
+	}
 	ret.pwRepoFn = func() (*password.Repository, error) {
 		return password.NewRepository(ctx, ret.rw, ret.rw, ret.kmsCache)
 	}
@@ -124,6 +134,8 @@ func getSetup(t *testing.T) setup {
 		ret.iamRepoFn,
 		ret.atRepoFn,
 		ret.ldapRepoFn,
+		ret.samlRepoFn,
+		ret.certRepoFn,
 		ret.authMethodRepoFn,
 		uint(ret.maxPageSize),
 	)
@@ -176,6 +188,12 @@ func TestList_FilterNonPublic(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(ctx, rw, rw, kmsCache)
 	}
@@ -215,7 +233,7 @@ func TestList_FilterNonPublic(t *testing.T) {
 			oidc.WithIssuer(oidc.TestConvertToUrls(t, fmt.Sprintf("https://alice%d.com", i))[0]), oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://api.com")[0]))
 	}
 
-	s, err := authmethods.NewService(ctx, kmsCache, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn, ldapRepoFn, authMethodRepoFn, 1000)
+	s, err := authmethods.NewService(ctx, kmsCache, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, authMethodRepoFn, 1000)
 	require.NoError(t, err, "Couldn't create new auth_method service.")
 
 	req := &pbs.ListAuthMethodsRequest{
@@ -284,6 +302,12 @@ func TestUpdate_OIDC(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kms)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kms)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kms)
+	}
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(ctx, rw, rw, kms)
 	}
@@ -296,7 +320,7 @@ func TestUpdate_OIDC(t *testing.T) {
 	iamRepo := iam.TestRepo(t, conn, wrapper)
 
 	o, _ := iam.TestScopes(t, iamRepo)
-	tested, err := authmethods.NewService(ctx, kms, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn, ldapRepoFn, authMethodRepoFn, 1000)
+	tested, err := authmethods.NewService(ctx, kms, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, authMethodRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	defaultScopeInfo := &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType(), ParentScopeId: scope.Global.String()}
@@ -1156,6 +1180,12 @@ func TestUpdate_OIDCDryRun(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(ctx, rw, rw, kmsCache)
 	}
@@ -1218,7 +1248,7 @@ func TestUpdate_OIDCDryRun(t *testing.T) {
 		},
 	}
 
-	tested, err := authmethods.NewService(ctx, kmsCache, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn, ldapRepoFn, authMethodRepoFn, 1000)
+	tested, err := authmethods.NewService(ctx, kmsCache, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, authMethodRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 	cases := []struct {
 		name    string
@@ -1359,6 +1389,12 @@ func TestChangeState_OIDC(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(ctx, rw, rw, kmsCache)
 	}
@@ -1391,7 +1427,7 @@ func TestChangeState_OIDC(t *testing.T) {
 	mismatchedAM := oidc.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, "inactive", "different_client_id", oidc.ClientSecret(tpClientSecret),
 		oidc.WithIssuer(oidc.TestConvertToUrls(t, tp.Addr())[0]), oidc.WithSigningAlgs(oidc.EdDSA), oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://example.callback:58")[0]), oidc.WithCertificates(tpCert...))
 
-	s, err := authmethods.NewService(ctx, kmsCache, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn, ldapRepoFn, authMethodRepoFn, 1000)
+	s, err := authmethods.NewService(ctx, kmsCache, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, authMethodRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	wantTemplate := &pb.AuthMethod{
@@ -25,9 +25,50 @@ const (
 	// password field names
 	loginNameField = "login_name"
 	passwordField  = "password"
+	totpCodeField  = "attributes.totp_code"
+	newPwField     = "attributes.new_password"
 	loginCommand   = "login"
+
+	// enrollTotpCommand creates a pending TOTP enrollment for an account
+	// which must use TOTP but is not enrolled yet. The enrollment is
+	// completed by the login command with a code for it.
+	enrollTotpCommand = "enroll-totp"
 )
 
+// pwPolicyFields maps the update mask paths of the password policy
+// attributes to a function setting the corresponding Policy field. The
+// policy is stored separately from the auth method so these paths are not
+// handled by pwMaskManager.
+var pwPolicyFields = map[string]func(*password.Policy, *pb.PasswordAuthMethodAttributes){
+	"attributes.min_uppercase": func(p *password.Policy, a *pb.PasswordAuthMethodAttributes) {
+		p.MinUppercase = a.GetMinUppercase()
+	},
+	"attributes.min_lowercase": func(p *password.Policy, a *pb.PasswordAuthMethodAttributes) {
+		p.MinLowercase = a.GetMinLowercase()
+	},
+	"attributes.min_digits": func(p *password.Policy, a *pb.PasswordAuthMethodAttributes) {
+		p.MinDigits = a.GetMinDigits()
+	},
+	"attributes.min_symbols": func(p *password.Policy, a *pb.PasswordAuthMethodAttributes) {
+		p.MinSymbols = a.GetMinSymbols()
+	},
+	"attributes.password_history_count": func(p *password.Policy, a *pb.PasswordAuthMethodAttributes) {
+		p.HistoryCount = a.GetPasswordHistoryCount()
+	},
+	"attributes.max_password_age_days": func(p *password.Policy, a *pb.PasswordAuthMethodAttributes) {
+		p.MaxAgeDays = a.GetMaxPasswordAgeDays()
+	},
+	"attributes.max_failed_attempts": func(p *password.Policy, a *pb.PasswordAuthMethodAttributes) {
+		p.MaxFailedAttempts = a.GetMaxFailedAttempts()
+	},
+	"attributes.lockout_duration_seconds": func(p *password.Policy, a *pb.PasswordAuthMethodAttributes) {
+		p.LockoutDurationSeconds = a.GetLockoutDurationSeconds()
+	},
+	"attributes.totp_required": func(p *password.Policy, a *pb.PasswordAuthMethodAttributes) {
+		p.TotpRequired = a.GetTotpRequired()
+	},
+}
+
 var pwMaskManager handlers.MaskManager
 
 func init() {
@@ -56,7 +97,18 @@ func (s Service) createPwInRepo(ctx context.Context, scopeId string, item *pb.Au
 	if err != nil {
 		return nil, err
 	}
-	out, err := repo.CreateAuthMethod(ctx, u)
+	var opts []password.Option
+	if attrs := item.GetPasswordAuthMethodAttributes(); attrs != nil {
+		// The id is set by CreateAuthMethod.
+		p := &password.Policy{}
+		for _, set := range pwPolicyFields {
+			set(p, attrs)
+		}
+		if (*p != password.Policy{}) {
+			opts = append(opts, password.WithPolicy(p))
+		}
+	}
+	out, err := repo.CreateAuthMethod(ctx, u, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create auth method: %w", err)
 	}
@@ -72,8 +124,16 @@ func (s Service) updatePwInRepo(ctx context.Context, scopeId, id string, mask []
 	version := item.GetVersion()
 	u.PublicId = id
 
-	dbMask := pwMaskManager.Translate(mask)
-	if len(dbMask) == 0 {
+	var amMask, policyMask []string
+	for _, path := range mask {
+		if _, ok := pwPolicyFields[path]; ok {
+			policyMask = append(policyMask, path)
+			continue
+		}
+		amMask = append(amMask, path)
+	}
+	dbMask := pwMaskManager.Translate(amMask)
+	if len(dbMask) == 0 && len(policyMask) == 0 {
 		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
 	}
 
@@ -81,7 +141,18 @@ func (s Service) updatePwInRepo(ctx context.Context, scopeId, id string, mask []
 	if err != nil {
 		return nil, err
 	}
-	out, rowsUpdated, err := repo.UpdateAuthMethod(ctx, u, version, dbMask)
+	var opts []password.Option
+	if len(policyMask) > 0 {
+		p, err := repo.GetPolicy(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get password policy: %w", err)
+		}
+		for _, path := range policyMask {
+			pwPolicyFields[path](p, item.GetPasswordAuthMethodAttributes())
+		}
+		opts = append(opts, password.WithPolicy(p))
+	}
+	out, rowsUpdated, err := repo.UpdateAuthMethod(ctx, u, version, dbMask, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to update auth method: %w", err)
 	}
@@ -91,16 +162,46 @@ func (s Service) updatePwInRepo(ctx context.Context, scopeId, id string, mask []
 	return out, nil
 }
 
+// addPwPolicy sets the password policy attributes of item, which must be a
+// password auth method returned by toAuthMethodProto.
+func (s Service) addPwPolicy(ctx context.Context, item *pb.AuthMethod) error {
+	attrs := item.GetPasswordAuthMethodAttributes()
+	if attrs == nil {
+		return nil
+	}
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return err
+	}
+	p, err := repo.GetPolicy(ctx, item.GetId())
+	if err != nil {
+		return err
+	}
+	attrs.MinUppercase = p.MinUppercase
+	attrs.MinLowercase = p.MinLowercase
+	attrs.MinDigits = p.MinDigits
+	attrs.MinSymbols = p.MinSymbols
+	attrs.PasswordHistoryCount = p.HistoryCount
+	attrs.MaxPasswordAgeDays = p.MaxAgeDays
+	attrs.MaxFailedAttempts = p.MaxFailedAttempts
+	attrs.LockoutDurationSeconds = p.LockoutDurationSeconds
+	attrs.TotpRequired = p.TotpRequired
+	return nil
+}
+
 func (s Service) authenticatePassword(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
 	reqAttrs := req.GetPasswordLoginAttributes()
-	tok, err := s.authenticateWithPwRepo(ctx, authResults.Scope.GetId(), req.GetAuthMethodId(), reqAttrs.LoginName, reqAttrs.Password)
+	if req.GetCommand() == enrollTotpCommand {
+		return s.enrollTotpWithPwRepo(ctx, req, authResults.Scope.GetId(), reqAttrs.LoginName, reqAttrs.Password)
+	}
+	tok, err := s.authenticateWithPwRepo(ctx, authResults.Scope.GetId(), req.GetAuthMethodId(), reqAttrs.LoginName, reqAttrs.Password, reqAttrs.TotpCode, reqAttrs.NewPassword)
 	if err != nil {
 		return nil, err
 	}
 	return s.convertToAuthenticateResponse(ctx, req, authResults, tok)
 }
 
-func (s Service) authenticateWithPwRepo(ctx context.Context, scopeId, authMethodId, loginName, pw string) (*pba.AuthToken, error) {
+func (s Service) authenticateWithPwRepo(ctx context.Context, scopeId, authMethodId, loginName, pw, totpCode, newPw string) (*pba.AuthToken, error) {
 	const op = "authmethods.(Service).authenticateWithPwRepo"
 	iamRepo, err := s.iamRepoFn()
 	if err != nil {
@@ -115,8 +216,30 @@ func (s Service) authenticateWithPwRepo(ctx context.Context, scopeId, authMethod
 		return nil, err
 	}
 
-	acct, err := pwRepo.Authenticate(ctx, scopeId, authMethodId, loginName, pw)
+	acct, err := pwRepo.Authenticate(ctx, scopeId, authMethodId, loginName, pw, password.WithTotpCode(totpCode), password.WithNewPassword(newPw))
 	if err != nil {
+		switch {
+		case errors.Match(errors.T(errors.TotpRequired), err):
+			// Reported as a missing field so that clients can prompt for
+			// the code and retry.
+			return nil, handlers.InvalidArgumentErrorf("A TOTP code is required.", map[string]string{totpCodeField: "This is a required field."})
+		case errors.Match(errors.T(errors.TotpNotEnrolled), err):
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, fmt.Sprintf("Account must be enrolled in TOTP before it can authenticate. Use the %q command to enroll.", enrollTotpCommand))
+		case errors.Match(errors.T(errors.AccountLocked), err):
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Account is locked due to too many failed authentication attempts.")
+		case errors.Match(errors.T(errors.PasswordExpired), err):
+			// Reported as a missing field so that clients can prompt for a
+			// new password and retry.
+			return nil, handlers.InvalidArgumentErrorf("Password has expired and must be changed.", map[string]string{newPwField: "This is a required field."})
+		case errors.Match(errors.T(errors.PasswordTooShort), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{newPwField: "Password is too short."})
+		case errors.Match(errors.T(errors.PasswordsEqual), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{newPwField: "New password equal to current password."})
+		case errors.Match(errors.T(errors.PasswordTooWeak), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{newPwField: "Password does not meet the complexity requirements."})
+		case errors.Match(errors.T(errors.PasswordReused), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{newPwField: "Password was used previously."})
+		}
 		return nil, err
 	}
 	if acct == nil {
@@ -143,6 +266,43 @@ func (s Service) authenticateWithPwRepo(ctx context.Context, scopeId, authMethod
 	)
 }
 
+// enrollTotpWithPwRepo creates a pending TOTP enrollment for the account
+// of loginName and returns the enrollment in the response attributes.
+func (s Service) enrollTotpWithPwRepo(ctx context.Context, req *pbs.AuthenticateRequest, scopeId, loginName, pw string) (*pbs.AuthenticateResponse, error) {
+	const op = "authmethods.(Service).enrollTotpWithPwRepo"
+	pwRepo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	enrollment, err := pwRepo.EnrollTotpWithPassword(ctx, scopeId, req.GetAuthMethodId(), loginName, pw)
+	if err != nil {
+		switch {
+		case errors.Match(errors.T(errors.TotpAlreadyEnrolled), err):
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "Account is already enrolled in TOTP.")
+		case errors.Match(errors.T(errors.AccountLocked), err):
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Account is locked due to too many failed authentication attempts.")
+		}
+		return nil, err
+	}
+	if enrollment == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+	}
+	attrs, err := handlers.ProtoToStruct(ctx, &pbs.EnrollTotpResponse{
+		Secret:        enrollment.Secret,
+		Url:           enrollment.Url,
+		RecoveryCodes: enrollment.RecoveryCodes,
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.AuthenticateResponse{
+		Command: req.GetCommand(),
+		Attrs: &pbs.AuthenticateResponse_Attributes{
+			Attributes: attrs,
+		},
+	}, nil
+}
+
 func validateAuthenticatePasswordRequest(req *pbs.AuthenticateRequest) error {
 	badFields := make(map[string]string)
 
@@ -161,7 +321,7 @@ func validateAuthenticatePasswordRequest(req *pbs.AuthenticateRequest) error {
 			// TODO: Eventually, require a command. For now, fall back to "login" for backwards compat.
 			req.Command = loginCommand
 		}
-		if req.Command != loginCommand {
+		if req.Command != loginCommand && req.Command != enrollTotpCommand {
 			badFields[commandField] = "Invalid command for this auth method type."
 		}
 		tokenType := req.GetType()
@@ -15,9 +15,11 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/boundary/globals"
 	am "github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/cert"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/auth/saml"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
@@ -57,6 +59,12 @@ func TestUpdate_Password(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kms)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kms)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kms)
+	}
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(ctx, rw, rw, kms)
 	}
@@ -69,7 +77,7 @@ func TestUpdate_Password(t *testing.T) {
 	iamRepo := iam.TestRepo(t, conn, wrapper)
 
 	o, _ := iam.TestScopes(t, iamRepo)
-	tested, err := authmethods.NewService(ctx, kms, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn, ldapRepoFn, authMethodRepoFn, 1000)
+	tested, err := authmethods.NewService(ctx, kms, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, authMethodRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	defaultScopeInfo := &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType(), ParentScopeId: scope.Global.String()}
@@ -508,6 +516,12 @@ func TestAuthenticate_Password(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kms)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kms)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kms)
+	}
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(ctx, rw, rw, kms)
 	}
@@ -659,7 +673,7 @@ func TestAuthenticate_Password(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := authmethods.NewService(ctx, kms, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn, ldapRepoFn, authMethodRepoFn, 1000)
+			s, err := authmethods.NewService(ctx, kms, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, authMethodRepoFn, 1000)
 			require.NoError(err)
 
 			resp, err := s.Authenticate(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), tc.request)
@@ -701,6 +715,176 @@ func TestAuthenticate_Password(t *testing.T) {
 	}
 }
 
+// testPasswordAuthenticate returns an authmethods.Service, a context for
+// calling it and a password auth method, which is the primary auth method of
+// its scope, with an account using testLoginName and testPassword.
+func testPasswordAuthenticate(t *testing.T) (authmethods.Service, context.Context, *password.AuthMethod, *password.Account, *db.DB) {
+	t.Helper()
+	ctx := context.TODO()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrapper), nil
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kms)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kms)
+	}
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(ctx, rw, rw, kms)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kms)
+	}
+	authMethodRepoFn := func() (*am.AuthMethodRepository, error) {
+		return am.NewAuthMethodRepository(ctx, rw, rw, kms)
+	}
+	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+	iam.TestSetPrimaryAuthMethod(t, iam.TestRepo(t, conn, wrapper), o, am.PublicId)
+	acct, err := password.NewAccount(ctx, am.GetPublicId(), password.WithLoginName(testLoginName))
+	require.NoError(t, err)
+	pwRepo, err := pwRepoFn()
+	require.NoError(t, err)
+	acct, err = pwRepo.CreateAccount(ctx, o.GetPublicId(), acct, password.WithPassword(testPassword))
+	require.NoError(t, err)
+
+	s, err := authmethods.NewService(ctx, kms, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, authMethodRepoFn, 1000)
+	require.NoError(t, err)
+	return s, auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), am, acct, conn
+}
+
+// testPasswordAuthenticateRequest returns a request to authenticate with the
+// password auth method amId using command and attrs.
+func testPasswordAuthenticateRequest(amId, command string, attrs *pbs.PasswordLoginAttributes) *pbs.AuthenticateRequest {
+	if attrs.LoginName == "" {
+		attrs.LoginName = testLoginName
+	}
+	return &pbs.AuthenticateRequest{
+		AuthMethodId: amId,
+		Command:      command,
+		Type:         "token",
+		Attrs: &pbs.AuthenticateRequest_PasswordLoginAttributes{
+			PasswordLoginAttributes: attrs,
+		},
+	}
+}
+
+func TestAuthenticate_Password_EnrollTotp(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	s, authCtx, am, acct, _ := testPasswordAuthenticate(t)
+
+	// Require TOTP for the auth method.
+	got, err := s.GetAuthMethod(authCtx, &pbs.GetAuthMethodRequest{Id: am.GetPublicId()})
+	require.NoError(err)
+	_, err = s.UpdateAuthMethod(authCtx, &pbs.UpdateAuthMethodRequest{
+		Id: am.GetPublicId(),
+		Item: &pb.AuthMethod{
+			Version: got.GetItem().GetVersion(),
+			Attrs: &pb.AuthMethod_PasswordAuthMethodAttributes{
+				PasswordAuthMethodAttributes: &pb.PasswordAuthMethodAttributes{
+					TotpRequired: true,
+				},
+			},
+		},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"attributes.totp_required"}},
+	})
+	require.NoError(err)
+
+	request := func(command, pw, code string) *pbs.AuthenticateRequest {
+		return testPasswordAuthenticateRequest(am.GetPublicId(), command, &pbs.PasswordLoginAttributes{Password: pw, TotpCode: code})
+	}
+
+	_, err = s.Authenticate(authCtx, request("login", testPassword, ""))
+	require.Error(err)
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.Unauthenticated)), "Got %#v", err)
+	assert.Contains(err.Error(), `Use the "enroll-totp" command to enroll.`)
+
+	_, err = s.Authenticate(authCtx, request("enroll-totp", "wrong", ""))
+	require.Error(err)
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.Unauthenticated)), "Got %#v", err)
+
+	resp, err := s.Authenticate(authCtx, request("enroll-totp", testPassword, ""))
+	require.NoError(err)
+	assert.Equal("enroll-totp", resp.GetCommand())
+	assert.Nil(resp.GetAuthTokenResponse())
+	secret := resp.GetAttributes().GetFields()["secret"].GetStringValue()
+	require.NotEmpty(secret)
+	assert.Contains(resp.GetAttributes().GetFields()["url"].GetStringValue(), "otpauth://totp/")
+	assert.NotEmpty(resp.GetAttributes().GetFields()["recovery_codes"].GetListValue().GetValues())
+
+	resp, err = s.Authenticate(authCtx, request("login", testPassword, password.TestTotpCode(t, secret)))
+	require.NoError(err)
+	assert.NotEmpty(resp.GetAuthTokenResponse().GetToken())
+	assert.Equal(acct.GetPublicId(), resp.GetAuthTokenResponse().GetAccountId())
+
+	_, err = s.Authenticate(authCtx, request("enroll-totp", testPassword, ""))
+	require.Error(err)
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.FailedPrecondition)), "Got %#v", err)
+}
+
+func TestAuthenticate_Password_Expired(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	s, authCtx, am, acct, conn := testPasswordAuthenticate(t)
+
+	got, err := s.GetAuthMethod(authCtx, &pbs.GetAuthMethodRequest{Id: am.GetPublicId()})
+	require.NoError(err)
+	_, err = s.UpdateAuthMethod(authCtx, &pbs.UpdateAuthMethodRequest{
+		Id: am.GetPublicId(),
+		Item: &pb.AuthMethod{
+			Version: got.GetItem().GetVersion(),
+			Attrs: &pb.AuthMethod_PasswordAuthMethodAttributes{
+				PasswordAuthMethodAttributes: &pb.PasswordAuthMethodAttributes{
+					MaxPasswordAgeDays: 1,
+				},
+			},
+		},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"attributes.max_password_age_days"}},
+	})
+	require.NoError(err)
+	_, err = db.New(conn).Exec(context.Background(), `
+insert into auth_password_account_status
+       (password_account_id, password_change_time)
+values (?, now() - interval '2 days')
+    on conflict (password_account_id) do update
+   set password_change_time = excluded.password_change_time`, []any{acct.GetPublicId()})
+	require.NoError(err)
+
+	_, err = s.Authenticate(authCtx, testPasswordAuthenticateRequest(am.GetPublicId(), "login", &pbs.PasswordLoginAttributes{Password: testPassword}))
+	require.Error(err)
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "Got %#v", err)
+	assert.Contains(err.Error(), "Password has expired and must be changed.")
+	assert.Contains(err.Error(), `{name: "attributes.new_password", desc: "This is a required field."}`)
+
+	_, err = s.Authenticate(authCtx, testPasswordAuthenticateRequest(am.GetPublicId(), "login", &pbs.PasswordLoginAttributes{Password: testPassword, NewPassword: testPassword}))
+	require.Error(err)
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "Got %#v", err)
+	assert.Contains(err.Error(), `{name: "attributes.new_password", desc: "New password equal to current password."}`)
+
+	resp, err := s.Authenticate(authCtx, testPasswordAuthenticateRequest(am.GetPublicId(), "login", &pbs.PasswordLoginAttributes{Password: testPassword, NewPassword: "new-" + testPassword}))
+	require.NoError(err)
+	assert.NotEmpty(resp.GetAuthTokenResponse().GetToken())
+	assert.Equal(acct.GetPublicId(), resp.GetAuthTokenResponse().GetAccountId())
+
+	_, err = s.Authenticate(authCtx, testPasswordAuthenticateRequest(am.GetPublicId(), "login", &pbs.PasswordLoginAttributes{Password: testPassword}))
+	require.Error(err)
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.Unauthenticated)), "Got %#v", err)
+	resp, err = s.Authenticate(authCtx, testPasswordAuthenticateRequest(am.GetPublicId(), "login", &pbs.PasswordLoginAttributes{Password: "new-" + testPassword}))
+	require.NoError(err)
+	assert.NotEmpty(resp.GetAuthTokenResponse().GetToken())
+}
+
 func TestAuthenticate_AuthAccountConnectedToIamUser_Password(t *testing.T) {
 	ctx := context.TODO()
 	assert, require := assert.New(t), require.New(t)
@@ -719,6 +903,12 @@ func TestAuthenticate_AuthAccountConnectedToIamUser_Password(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kms)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kms)
+	}
+	certRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kms)
+	}
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(ctx, rw, rw, kms)
 	}
@@ -745,7 +935,7 @@ func TestAuthenticate_AuthAccountConnectedToIamUser_Password(t *testing.T) {
 	iamUser, err := iamRepo.LookupUserWithLogin(context.Background(), acct.GetPublicId())
 	require.NoError(err)
 
-	s, err := authmethods.NewService(ctx, kms, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn, ldapRepoFn, authMethodRepoFn, 1000)
+	s, err := authmethods.NewService(ctx, kms, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn, ldapRepoFn, samlRepoFn, certRepoFn, authMethodRepoFn, 1000)
 	require.NoError(err)
 	resp, err := s.Authenticate(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.AuthenticateRequest{
 		AuthMethodId: am.GetPublicId(),
@@ -0,0 +1,488 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package authmethods
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/saml"
+	samlstore "github.com/hashicorp/boundary/internal/auth/saml/store"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/types/action"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var samlMaskManager handlers.MaskManager
+
+func init() {
+	var err error
+	if samlMaskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{
+			&samlstore.AuthMethod{},
+		},
+		handlers.MaskSource{&pb.AuthMethod{}, &pb.SamlAuthMethodAttributes{}},
+	); err != nil {
+		panic(err)
+	}
+
+	IdActions[saml.Subtype] = action.NewActionSet(
+		action.NoOp,
+		action.Read,
+		action.Update,
+		action.Delete,
+		action.ChangeState,
+		action.Authenticate,
+	)
+}
+
+const (
+	idpEntityIdField     = "attributes.idp_entity_id"
+	idpSsoUrlField       = "attributes.idp_sso_url"
+	idpCertificatesField = "attributes.idp_certificates"
+	acsUrlField          = "attributes.acs_url"
+	spCertificateField   = "attributes.sp_certificate"
+	spMetadataField      = "attributes.sp_metadata"
+	samlResponseField    = "attributes.SAMLResponse"
+	relayStateField      = "attributes.RelayState"
+)
+
+// createSamlInRepo creates a saml auth method in a repo and returns the result.
+// This method should never return a nil AuthMethod without returning an error.
+func (s Service) createSamlInRepo(ctx context.Context, scopeId string, item *pb.AuthMethod) (*saml.AuthMethod, error) {
+	u, err := toStorageSamlAuthMethod(ctx, scopeId, item)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := s.samlRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateAuthMethod(ctx, u)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create auth method: %w", err)
+	}
+	return out, nil
+}
+
+func (s Service) updateSamlInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.AuthMethod) (*saml.AuthMethod, error) {
+	const op = "authmethod_service.(Service).updateSamlInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil auth method.")
+	}
+	u, err := toStorageSamlAuthMethod(ctx, scopeId, item)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	u.PublicId = id
+
+	version := item.GetVersion()
+	dbMask := samlMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+
+	repo, err := s.samlRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.UpdateAuthMethod(ctx, u, version, dbMask)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update auth method"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("AuthMethod %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
+func (s Service) authenticateSaml(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
+	const op = "authmethod_service.(Service).authenticateSaml"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil request.")
+	}
+	if authResults == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil auth results.")
+	}
+	switch req.GetCommand() {
+	case startCommand:
+		return s.authenticateSamlStart(ctx, req)
+	case callbackCommand:
+		return s.authenticateSamlCallback(ctx, req)
+	case tokenCommand:
+		return s.authenticateSamlToken(ctx, req, authResults)
+	}
+
+	return &pbs.AuthenticateResponse{Command: req.GetCommand()}, nil
+}
+
+func (s Service) authenticateSamlStart(ctx context.Context, req *pbs.AuthenticateRequest) (*pbs.AuthenticateResponse, error) {
+	const op = "authmethod_service.(Service).authenticateSamlStart"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil request.")
+	}
+
+	var opts []saml.Option
+	attrs := req.GetSamlStartAttributes()
+	if attrs.GetCachedRoundtripPayload() != "" {
+		opts = append(opts, saml.WithRoundtripPayload(attrs.GetCachedRoundtripPayload()))
+	}
+
+	authUrl, tokenId, err := saml.StartAuth(ctx, s.samlRepoFn, req.GetAuthMethodId(), opts...)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error starting the saml authentication flow"))
+		return nil, errors.New(ctx, errors.Internal, op, "Error generating parameters for starting the SAML flow. See the controller's log for more information.")
+	}
+
+	return &pbs.AuthenticateResponse{
+		Command: req.GetCommand(),
+		Attrs: &pbs.AuthenticateResponse_SamlAuthMethodAuthenticateStartResponse{
+			SamlAuthMethodAuthenticateStartResponse: &pb.SamlAuthMethodAuthenticateStartResponse{
+				AuthUrl: authUrl.String(),
+				TokenId: tokenId,
+			},
+		},
+	}, nil
+}
+
+// authenticateSamlCallback behaves like authenticateOidcCallback: it only
+// returns an error if the auth method cannot be looked up.  All other errors
+// are returned through the response as a finalRedirectUrl to an endpoint that
+// can properly show the error details.
+func (s Service) authenticateSamlCallback(ctx context.Context, req *pbs.AuthenticateRequest) (*pbs.AuthenticateResponse, error) {
+	const op = "authmethod_service.(Service).authenticateSamlCallback"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil request.")
+	}
+
+	repo, err := s.samlRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	am, err := repo.LookupAuthMethod(ctx, req.GetAuthMethodId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("Auth method %s not found.", req.GetAuthMethodId()))
+	}
+	if am.GetApiUrl() == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Auth method doesn't have API URL defined.")
+	}
+
+	errRedirectBase := fmt.Sprintf(saml.AuthenticationErrorsEndpoint, am.GetApiUrl())
+	errResponse := func(err error) (*pbs.AuthenticateResponse, error) {
+		u := make(url.Values)
+		pbErr := handlers.ToApiError(err)
+		out, err := handlers.JSONMarshaler().Marshal(pbErr)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to marshal the error for callback"))
+		}
+		u.Add("error", string(out))
+		errRedirect := fmt.Sprintf("%s?%s", errRedirectBase, u.Encode())
+		return &pbs.AuthenticateResponse{
+			Command: callbackCommand,
+			Attrs: &pbs.AuthenticateResponse_SamlAuthMethodAuthenticateCallbackResponse{
+				SamlAuthMethodAuthenticateCallbackResponse: &pb.SamlAuthMethodAuthenticateCallbackResponse{
+					FinalRedirectUrl: errRedirect,
+				},
+			},
+		}, nil
+	}
+
+	attrs := req.GetSamlAuthMethodAuthenticateCallbackRequest()
+	finalRedirectUrl, err := saml.Callback(
+		ctx,
+		s.samlRepoFn,
+		saml.IamRepoFactory(s.iamRepoFn),
+		saml.AuthTokenRepoFactory(s.atRepoFn),
+		am,
+		attrs.GetSamlResponse(),
+		attrs.GetRelayState())
+	if err != nil {
+		return errResponse(errors.New(ctx, errors.InvalidParameter, op, "Callback validation failed.", errors.WithWrap(err)))
+	}
+
+	return &pbs.AuthenticateResponse{
+		Command: req.GetCommand(),
+		Attrs: &pbs.AuthenticateResponse_SamlAuthMethodAuthenticateCallbackResponse{
+			SamlAuthMethodAuthenticateCallbackResponse: &pb.SamlAuthMethodAuthenticateCallbackResponse{
+				FinalRedirectUrl: finalRedirectUrl,
+			},
+		},
+	}, nil
+}
+
+func (s Service) authenticateSamlToken(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
+	const op = "authmethod_service.(Service).authenticateSamlToken"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil request.")
+	}
+	if authResults == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil auth results.")
+	}
+	attrs := req.GetSamlAuthMethodAuthenticateTokenRequest()
+	if attrs == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil request attributes.")
+	}
+	if attrs.GetTokenId() == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Empty token ID in request attributes.")
+	}
+
+	token, err := saml.TokenRequest(ctx, s.samlRepoFn, saml.AuthTokenRepoFactory(s.atRepoFn), req.GetAuthMethodId(), attrs.GetTokenId())
+	if err != nil {
+		switch {
+		case errors.Match(errors.T(errors.Forbidden), err):
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("Forbidden."))
+		case errors.Match(errors.T(errors.AuthAttemptExpired), err):
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("Forbidden."))
+		default:
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error generating parameters for token request"))
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("Error generating parameters for token request. See the controller's log for more information."))
+		}
+	}
+	if token == nil {
+		return &pbs.AuthenticateResponse{
+			Command: req.GetCommand(),
+			Attrs: &pbs.AuthenticateResponse_SamlAuthMethodAuthenticateTokenResponse{
+				SamlAuthMethodAuthenticateTokenResponse: &pb.SamlAuthMethodAuthenticateTokenResponse{
+					Status: "unknown",
+				},
+			},
+		}, nil
+	}
+
+	responseToken, err := s.ConvertInternalAuthTokenToApiAuthToken(ctx, token)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Internal, op, "Error converting response to proper format.", errors.WithWrap(err))
+	}
+	return s.convertToAuthenticateResponse(ctx, req, authResults, responseToken)
+}
+
+func validateAuthenticateSamlRequest(req *pbs.AuthenticateRequest) error {
+	badFields := make(map[string]string)
+
+	switch req.GetCommand() {
+	case startCommand:
+		if attrs := req.GetSamlStartAttributes(); attrs != nil {
+			// Ensure we pay no attention to cache information provided by the client
+			attrs.CachedRoundtripPayload = ""
+
+			if payload := attrs.GetRoundtripPayload(); payload != nil {
+				m, err := json.Marshal(payload.AsMap())
+				if err != nil {
+					// We don't know what's in this payload so we swallow the
+					// error, as it could be something sensitive.
+					badFields[roundtripPayloadAttributesField] = "Unable to marshal given value as JSON."
+				} else {
+					// Cache for later
+					attrs.CachedRoundtripPayload = string(m)
+				}
+			}
+		}
+	case callbackCommand:
+		attrs := req.GetSamlAuthMethodAuthenticateCallbackRequest()
+		if attrs == nil {
+			badFields["attributes"] = "Attributes field not supplied request"
+			return handlers.InvalidArgumentErrorf("This is a required field.", badFields)
+		}
+		if attrs.GetSamlResponse() == "" {
+			badFields[samlResponseField] = "SAMLResponse field not supplied in callback request."
+		}
+		if attrs.GetRelayState() == "" {
+			badFields[relayStateField] = "RelayState field not supplied in callback request."
+		}
+	case tokenCommand:
+		tokenType := req.GetType()
+		if tokenType == "" {
+			// Fall back to deprecated field if type is not set
+			tokenType = req.GetTokenType() //nolint:all
+		}
+		tType := strings.ToLower(strings.TrimSpace(tokenType))
+		if tType != "" && tType != "token" && tType != "cookie" {
+			badFields[tokenTypeField] = `The only accepted types are "token" and "cookie".`
+		}
+	default:
+		badFields[commandField] = "Invalid command for this auth method type."
+	}
+
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Invalid fields provided in request.", badFields)
+	}
+	return nil
+}
+
+func validateSamlAttributes(ctx context.Context, attrs *pb.SamlAuthMethodAttributes, badFields map[string]string) {
+	if attrs == nil {
+		return
+	}
+	if attrs.GetState() != "" {
+		badFields[stateField] = "Field is read only."
+	}
+	if attrs.GetAcsUrl() != "" {
+		badFields[acsUrlField] = "Field is read only."
+	}
+	if attrs.GetSpCertificate() != "" {
+		badFields[spCertificateField] = "Field is read only."
+	}
+	if attrs.GetSpMetadata() != "" {
+		badFields[spMetadataField] = "Field is read only."
+	}
+	if u := attrs.GetApiUrlPrefix().GetValue(); u != "" {
+		if cu, err := url.Parse(u); err != nil || (cu.Scheme != "http" && cu.Scheme != "https") || cu.Host == "" {
+			badFields[apiUrlPrefixField] = fmt.Sprintf("%q cannot be parsed as a url.", u)
+		}
+	}
+	if u := attrs.GetIdpSsoUrl().GetValue(); u != "" {
+		if su, err := url.Parse(u); err != nil || (su.Scheme != "http" && su.Scheme != "https") || su.Host == "" {
+			badFields[idpSsoUrlField] = fmt.Sprintf("%q cannot be parsed as a url.", u)
+		}
+	}
+	if len(attrs.GetIdpCertificates()) > 0 {
+		if _, err := saml.ParseCertificates(ctx, attrs.GetIdpCertificates()...); err != nil {
+			badFields[idpCertificatesField] = fmt.Sprintf("Cannot parse certificates. %v", err.Error())
+		}
+	}
+	if len(attrs.GetAccountAttributeMaps()) > 0 {
+		aam, err := saml.ParseAccountAttributeMaps(ctx, attrs.GetAccountAttributeMaps()...)
+		if err != nil {
+			badFields[accountAttributesMapField] = fmt.Sprintf("Contains invalid map %q", err.Error())
+		} else {
+			foundTo := make(map[string]bool, len(aam))
+			for _, m := range aam {
+				if foundTo[m.To] {
+					badFields[accountAttributesMapField] = fmt.Sprintf("%s=%s contains invalid map - multiple maps to the same Boundary to-attribute %s", m.From, m.To, m.To)
+				}
+				foundTo[m.To] = true
+			}
+		}
+	}
+}
+
+func toSamlAuthMethodProto(ctx context.Context, in *saml.AuthMethod) (*pb.SamlAuthMethodAttributes, error) {
+	const op = "authmethod_service.toSamlAuthMethodProto"
+	attrs := &pb.SamlAuthMethodAttributes{
+		State:                in.GetOperationalState(),
+		SignAuthnRequests:    in.GetSignAuthnRequests(),
+		AccountAttributeMaps: in.GetAccountAttributeMaps(),
+		SpCertificate:        in.GetSpCertificate(),
+	}
+	if in.GetApiUrl() != "" {
+		attrs.ApiUrlPrefix = wrapperspb.String(in.GetApiUrl())
+		attrs.AcsUrl = in.AcsUrl()
+	}
+	if in.GetIdpEntityId() != "" {
+		attrs.IdpEntityId = wrapperspb.String(in.GetIdpEntityId())
+	}
+	if in.GetIdpSsoUrl() != "" {
+		attrs.IdpSsoUrl = wrapperspb.String(in.GetIdpSsoUrl())
+	}
+	if in.GetSpEntityId() != "" {
+		attrs.SpEntityId = wrapperspb.String(in.GetSpEntityId())
+	}
+	if in.GetNameIdFormat() != "" {
+		attrs.NameIdFormat = wrapperspb.String(in.GetNameIdFormat())
+	}
+	if in.GetIdpCertificates() != "" {
+		certs, err := saml.ParseCertificates(ctx, in.GetIdpCertificates())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for _, c := range certs {
+			p, err := saml.EncodeCertificates(ctx, c)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			attrs.IdpCertificates = append(attrs.IdpCertificates, p)
+		}
+	}
+	if in.GetApiUrl() != "" && in.GetSpCertificate() != "" {
+		md, err := in.Metadata(ctx)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		attrs.SpMetadata = md
+	}
+	return attrs, nil
+}
+
+func toStorageSamlAuthMethod(ctx context.Context, scopeId string, in *pb.AuthMethod) (*saml.AuthMethod, error) {
+	const op = "authmethod_service.toStorageSamlAuthMethod"
+	if in == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil auth method.")
+	}
+	attrs := in.GetSamlAuthMethodAttributes()
+
+	var opts []saml.Option
+	if in.GetName() != nil {
+		opts = append(opts, saml.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, saml.WithDescription(in.GetDescription().GetValue()))
+	}
+	if apiUrl := strings.TrimSpace(attrs.GetApiUrlPrefix().GetValue()); apiUrl != "" {
+		apiU, err := url.Parse(apiUrl)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("cannot parse api_url_prefix"), errors.WithCode(errors.InvalidParameter))
+		}
+		opts = append(opts, saml.WithApiUrl(apiU))
+	}
+	if id := strings.TrimSpace(attrs.GetIdpEntityId().GetValue()); id != "" {
+		opts = append(opts, saml.WithIdpEntityId(id))
+	}
+	if ssoUrl := strings.TrimSpace(attrs.GetIdpSsoUrl().GetValue()); ssoUrl != "" {
+		ssoU, err := url.Parse(ssoUrl)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("cannot parse idp_sso_url"), errors.WithCode(errors.InvalidParameter))
+		}
+		opts = append(opts, saml.WithIdpSsoUrl(ssoU))
+	}
+	if len(attrs.GetIdpCertificates()) > 0 {
+		certs, err := saml.ParseCertificates(ctx, attrs.GetIdpCertificates()...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		opts = append(opts, saml.WithIdpCertificates(certs...))
+	}
+	if id := strings.TrimSpace(attrs.GetSpEntityId().GetValue()); id != "" {
+		opts = append(opts, saml.WithSpEntityId(id))
+	}
+	if attrs.GetSignAuthnRequests() {
+		opts = append(opts, saml.WithSignAuthnRequests(true))
+	}
+	if f := strings.TrimSpace(attrs.GetNameIdFormat().GetValue()); f != "" {
+		opts = append(opts, saml.WithNameIdFormat(f))
+	}
+	if len(attrs.GetAccountAttributeMaps()) > 0 {
+		aam, err := saml.ParseAccountAttributeMaps(ctx, attrs.GetAccountAttributeMaps()...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		attributeMap := make(map[string]saml.AccountToAttribute, len(aam))
+		for _, m := range aam {
+			to, err := saml.ConvertToAccountToAttribute(ctx, m.To)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			attributeMap[m.From] = to
+		}
+		opts = append(opts, saml.WithAccountAttributeMap(attributeMap))
+	}
+
+	u, err := saml.NewAuthMethod(ctx, scopeId, opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method: %v.", err)
+	}
+	return u, nil
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package authmethods
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/perms"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
+	"google.golang.org/protobuf/proto"
+)
+
+// tokenPolicyFields maps the update mask paths of the auth token policy
+// fields to a function setting the corresponding TokenPolicy field. The
+// policy applies to every auth method subtype and is stored separately from
+// the auth method, so these paths are removed before the subtype update.
+var tokenPolicyFields = map[string]func(*authtoken.TokenPolicy, *pb.AuthMethod){
+	globals.AuthTokenTimeToLiveSecondsField: func(p *authtoken.TokenPolicy, am *pb.AuthMethod) {
+		p.TimeToLiveSeconds = am.GetAuthTokenTimeToLiveSeconds()
+	},
+	globals.AuthTokenTimeToStaleSecondsField: func(p *authtoken.TokenPolicy, am *pb.AuthMethod) {
+		p.TimeToStaleSeconds = am.GetAuthTokenTimeToStaleSeconds()
+	},
+	globals.MaxAuthTokensPerUserField: func(p *authtoken.TokenPolicy, am *pb.AuthMethod) {
+		p.MaxTokensPerUser = am.GetMaxAuthTokensPerUser()
+	},
+}
+
+// createTokenPolicy stores the auth token policy fields of item for the
+// newly created auth method am. Nothing is stored if item does not set any
+// of them.
+func (s Service) createTokenPolicy(ctx context.Context, am auth.AuthMethod, item *pb.AuthMethod) error {
+	p, err := authtoken.NewTokenPolicy(ctx, am.GetPublicId())
+	if err != nil {
+		return err
+	}
+	for _, set := range tokenPolicyFields {
+		set(p, item)
+	}
+	if (*p == authtoken.TokenPolicy{AuthMethodId: am.GetPublicId()}) {
+		return nil
+	}
+	repo, err := s.atRepoFn()
+	if err != nil {
+		return err
+	}
+	if _, err := repo.SetTokenPolicy(ctx, p); err != nil {
+		return fmt.Errorf("unable to set auth token policy: %w", err)
+	}
+	return nil
+}
+
+// updateWithTokenPolicy updates the auth method and its auth token policy
+// as described by req.
+func (s Service) updateWithTokenPolicy(ctx context.Context, scopeId string, req *pbs.UpdateAuthMethodRequest) (auth.AuthMethod, bool, error) {
+	var amMask, policyMask []string
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		if _, ok := tokenPolicyFields[path]; ok {
+			policyMask = append(policyMask, path)
+			continue
+		}
+		amMask = append(amMask, path)
+	}
+	if len(policyMask) == 0 {
+		return s.updateInRepo(ctx, scopeId, req)
+	}
+
+	var am auth.AuthMethod
+	var dryRun bool
+	switch {
+	case len(amMask) > 0:
+		amReq := proto.Clone(req).(*pbs.UpdateAuthMethodRequest)
+		amReq.GetUpdateMask().Paths = amMask
+		var err error
+		if am, dryRun, err = s.updateInRepo(ctx, scopeId, amReq); err != nil {
+			return nil, false, err
+		}
+	default:
+		// Only the policy is being updated, which does not change the auth
+		// method, but the version must still match.
+		var err error
+		if am, err = s.getFromRepo(ctx, req.GetId()); err != nil {
+			return nil, false, err
+		}
+		if am.GetVersion() != req.GetItem().GetVersion() {
+			return nil, false, handlers.NotFoundErrorf("AuthMethod %q doesn't exist or incorrect version provided.", req.GetId())
+		}
+	}
+	if dryRun {
+		return am, dryRun, nil
+	}
+
+	repo, err := s.atRepoFn()
+	if err != nil {
+		return nil, false, err
+	}
+	p, err := repo.GetTokenPolicy(ctx, req.GetId())
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to get auth token policy: %w", err)
+	}
+	for _, path := range policyMask {
+		tokenPolicyFields[path](p, req.GetItem())
+	}
+	if _, err := repo.SetTokenPolicy(ctx, p); err != nil {
+		return nil, false, fmt.Errorf("unable to set auth token policy: %w", err)
+	}
+	return am, dryRun, nil
+}
+
+// addTokenPolicy sets the auth token policy fields of item, the proto of
+// the auth method with the given id, that are included in outputFields.
+func (s Service) addTokenPolicy(ctx context.Context, id string, outputFields *perms.OutputFields, item *pb.AuthMethod) error {
+	if !outputFields.Has(globals.AuthTokenTimeToLiveSecondsField) &&
+		!outputFields.Has(globals.AuthTokenTimeToStaleSecondsField) &&
+		!outputFields.Has(globals.MaxAuthTokensPerUserField) {
+		return nil
+	}
+	repo, err := s.atRepoFn()
+	if err != nil {
+		return err
+	}
+	p, err := repo.GetTokenPolicy(ctx, id)
+	if err != nil {
+		return err
+	}
+	if outputFields.Has(globals.AuthTokenTimeToLiveSecondsField) {
+		item.AuthTokenTimeToLiveSeconds = p.TimeToLiveSeconds
+	}
+	if outputFields.Has(globals.AuthTokenTimeToStaleSecondsField) {
+		item.AuthTokenTimeToStaleSeconds = p.TimeToStaleSeconds
+	}
+	if outputFields.Has(globals.MaxAuthTokensPerUserField) {
+		item.MaxAuthTokensPerUser = p.MaxTokensPerUser
+	}
+	return nil
+}
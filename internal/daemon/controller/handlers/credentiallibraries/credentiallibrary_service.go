@@ -11,6 +11,8 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/file"
+	filestore "github.com/hashicorp/boundary/internal/credential/file/store"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/credential/vault/store"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -26,6 +28,7 @@ import (
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentiallibraries"
+	credpb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentials"
 	"github.com/hashicorp/boundary/version"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"google.golang.org/grpc/codes"
@@ -37,8 +40,12 @@ import (
 const (
 	attributesPathField        = "attributes"
 	vaultPathField             = "attributes.path"
+	filePathField              = "attributes.path"
 	httpMethodField            = "attributes.http_method"
 	httpRequestBodyField       = "attributes.http_request_body"
+	kvV2Field                  = "attributes.kv_v2"
+	kvV2VersionField           = "attributes.kv_v2_version"
+	leaseTtlFromSessionField   = "attributes.lease_ttl_from_session"
 	credentialMappingPathField = "credential_mapping_overrides"
 	sshCertUsernameField       = "attributes.username"
 	keyTypeField               = "attributes.key_type"
@@ -50,15 +57,19 @@ const (
 
 // Credential mapping override attributes
 const (
-	usernameAttribute     string = "username_attribute"
-	passwordAttribute     string = "password_attribute"
-	privateKeyAttribute   string = "private_key_attribute"
-	pkPassphraseAttribute string = "private_key_passphrase_attribute"
+	usernameAttribute      string = "username_attribute"
+	passwordAttribute      string = "password_attribute"
+	privateKeyAttribute    string = "private_key_attribute"
+	pkPassphraseAttribute  string = "private_key_passphrase_attribute"
+	certificateAttribute   string = "certificate_attribute"
+	caCertificateAttribute string = "ca_certificate_attribute"
+	kubeconfigAttribute    string = "kubeconfig_attribute"
 )
 
 var (
 	maskManager        handlers.MaskManager
 	sshCertMaskManager handlers.MaskManager
+	fileMaskManager    handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -67,6 +78,7 @@ var (
 		action.Read,
 		action.Update,
 		action.Delete,
+		action.ListIssuances,
 	)
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -79,6 +91,8 @@ var (
 	validCredentialTypesVaultGeneric = []globals.CredentialType{
 		globals.UsernamePasswordCredentialType,
 		globals.SshPrivateKeyCredentialType,
+		globals.TlsClientCertificateCredentialType,
+		globals.KubeconfigCredentialType,
 		globals.UnspecifiedCredentialType,
 	}
 
@@ -105,6 +119,13 @@ func init() {
 	); err != nil {
 		panic(err)
 	}
+	if fileMaskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{&filestore.CredentialLibrary{}},
+		handlers.MaskSource{&pb.CredentialLibrary{}, &pb.FileCredentialLibraryAttributes{}},
+	); err != nil {
+		panic(err)
+	}
 
 	// TODO: refactor to remove IdActionsMap and CollectionActions package variables
 	action.RegisterResource(resource.CredentialLibrary, IdActions, CollectionActions)
@@ -114,9 +135,11 @@ func init() {
 type Service struct {
 	pbs.UnsafeCredentialLibraryServiceServer
 
-	iamRepoFn   common.IamRepoFactory
-	repoFn      common.VaultCredentialRepoFactory
-	maxPageSize uint
+	iamRepoFn      common.IamRepoFactory
+	repoFn         common.VaultCredentialRepoFactory
+	fileRepoFn     common.FileCredentialRepoFactory
+	issuanceRepoFn common.CredentialIssuanceRepoFactory
+	maxPageSize    uint
 }
 
 var _ pbs.CredentialLibraryServiceServer = (*Service)(nil)
@@ -126,6 +149,8 @@ func NewService(
 	ctx context.Context,
 	iamRepoFn common.IamRepoFactory,
 	repoFn common.VaultCredentialRepoFactory,
+	fileRepoFn common.FileCredentialRepoFactory,
+	issuanceRepoFn common.CredentialIssuanceRepoFactory,
 	maxPageSize uint,
 ) (Service, error) {
 	const op = "credentiallibraries.NewService"
@@ -135,13 +160,21 @@ func NewService(
 	if repoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing vault credential repository")
 	}
+	if fileRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing file credential repository")
+	}
+	if issuanceRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing credential issuance repository")
+	}
 	if maxPageSize == 0 {
 		maxPageSize = uint(globals.DefaultMaxPageSize)
 	}
 	return Service{
-		iamRepoFn:   iamRepoFn,
-		repoFn:      repoFn,
-		maxPageSize: maxPageSize,
+		iamRepoFn:      iamRepoFn,
+		repoFn:         repoFn,
+		fileRepoFn:     fileRepoFn,
+		issuanceRepoFn: issuanceRepoFn,
+		maxPageSize:    maxPageSize,
 	}, nil
 }
 
@@ -194,9 +227,20 @@ func (s Service) ListCredentialLibraries(ctx context.Context, req *pbs.ListCrede
 			return true, nil
 		}
 	}
-	repo, err := s.repoFn()
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
+	var repo credential.LibraryService
+	switch globals.ResourceInfoFromPrefix(req.GetCredentialStoreId()).Subtype {
+	case file.Subtype:
+		fileRepo, err := s.fileRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo = fileRepo
+	default:
+		vaultRepo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo = vaultRepo
 	}
 	grantsHash, err := authResults.GrantsHash(ctx)
 	if err != nil {
@@ -364,6 +408,19 @@ func (s Service) UpdateCredentialLibrary(ctx context.Context, req *pbs.UpdateCre
 	var currentCredentialType globals.CredentialType
 	var mo vault.MappingOverride
 	switch globals.ResourceInfoFromPrefix(req.GetId()).Subtype {
+	case file.Subtype:
+		fileRepo, err := s.fileRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		cur, err := fileRepo.LookupCredentialLibrary(ctx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		if cur == nil {
+			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist.", req.GetId())
+		}
+		currentCredentialType = cur.CredentialType()
 	case vault.SSHCertificateLibrarySubtype:
 		cur, err := repo.LookupSSHCertificateCredentialLibrary(ctx, req.Id)
 		if err != nil {
@@ -429,6 +486,111 @@ func (s Service) DeleteCredentialLibrary(ctx context.Context, req *pbs.DeleteCre
 	return nil, nil
 }
 
+// ListCredentialLibraryIssuances implements the interface pbs.CredentialLibraryServiceServer.
+func (s Service) ListCredentialLibraryIssuances(ctx context.Context, req *pbs.ListCredentialLibraryIssuancesRequest) (*pbs.ListCredentialLibraryIssuancesResponse, error) {
+	const op = "credentiallibraries.(Service).ListCredentialLibraryIssuances"
+	if err := validateListIssuancesRequest(ctx, req); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ListIssuances)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	pageSize := int(s.maxPageSize)
+	// Use the requested page size only if it is smaller than
+	// the configured max.
+	if req.GetPageSize() != 0 && uint(req.GetPageSize()) < s.maxPageSize {
+		pageSize = int(req.GetPageSize())
+	}
+	var filterItemFn func(ctx context.Context, item *credential.Issuance) (bool, error)
+	switch {
+	case req.GetFilter() != "":
+		// Only use a filter if we need to
+		filter, err := handlers.NewFilter(ctx, req.GetFilter())
+		if err != nil {
+			return nil, err
+		}
+		filterItemFn = func(ctx context.Context, item *credential.Issuance) (bool, error) {
+			return filter.Match(toIssuanceProto(item)), nil
+		}
+	default:
+		filterItemFn = func(ctx context.Context, item *credential.Issuance) (bool, error) {
+			return true, nil
+		}
+	}
+	repo, err := s.issuanceRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	grantsHash, err := authResults.GrantsHash(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var listResp *pagination.ListResponse[*credential.Issuance]
+	var sortBy string
+	if req.GetListToken() == "" {
+		sortBy = "created_time"
+		listResp, err = credential.ListIssuances(ctx, grantsHash, pageSize, filterItemFn, repo, req.GetId())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		listToken, err := handlers.ParseListToken(ctx, req.GetListToken(), resource.Session, grantsHash)
+		if err != nil {
+			return nil, err
+		}
+		switch st := listToken.Subtype.(type) {
+		case *listtoken.PaginationToken:
+			sortBy = "created_time"
+			listResp, err = credential.ListIssuancesPage(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, req.GetId())
+			if err != nil {
+				return nil, err
+			}
+		case *listtoken.StartRefreshToken:
+			sortBy = "created_time"
+			listResp, err = credential.ListIssuancesRefresh(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, req.GetId())
+			if err != nil {
+				return nil, err
+			}
+		case *listtoken.RefreshToken:
+			sortBy = "created_time"
+			listResp, err = credential.ListIssuancesRefreshPage(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, req.GetId())
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "unexpected list token subtype: %T", st)
+		}
+	}
+
+	finalItems := make([]*credpb.CredentialIssuance, 0, len(listResp.Items))
+	for _, item := range listResp.Items {
+		finalItems = append(finalItems, toIssuanceProto(item))
+	}
+	respType := "delta"
+	if listResp.CompleteListing {
+		respType = "complete"
+	}
+	resp := &pbs.ListCredentialLibraryIssuancesResponse{
+		Items:        finalItems,
+		EstItemCount: uint32(listResp.EstimatedItemCount),
+		RemovedIds:   listResp.DeletedIds,
+		ResponseType: respType,
+		SortBy:       sortBy,
+		SortDir:      "desc",
+	}
+
+	if listResp.ListToken != nil {
+		resp.ListToken, err = handlers.MarshalListToken(ctx, listResp.ListToken, pbs.ResourceType_RESOURCE_TYPE_SESSION)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (credential.Library, error) {
 	const op = "credentiallibraries.(Service).getFromRepo"
 	repo, err := s.repoFn()
@@ -454,6 +616,19 @@ func (s Service) getFromRepo(ctx context.Context, id string) (credential.Library
 			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("ssh certificate credential library %q not found", id))
 		}
 		return cs, err
+	case file.Subtype:
+		fileRepo, err := s.fileRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cs, err := fileRepo.LookupCredentialLibrary(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if cs == nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("file credential library %q not found", id))
+		}
+		return cs, err
 	}
 	return nil, errors.New(ctx, errors.InvalidParameter, op, "unrecognized credential library subtype")
 }
@@ -479,6 +654,23 @@ func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Cred
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create ssh certificate credential library but no error returned from repository.")
 		}
 		out = rl
+	case file.Subtype.String():
+		cl, err := toStorageFileLibrary(ctx, item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.fileRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		rl, err := repo.CreateCredentialLibrary(ctx, scopeId, cl)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create file credential library"))
+		}
+		if rl == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create file credential library but no error returned from repository.")
+		}
+		out = rl
 	default:
 		cl, err := toStorageVaultLibrary(ctx, item.GetCredentialStoreId(), item)
 		if err != nil {
@@ -514,6 +706,33 @@ func (s Service) updateInRepo(
 	item := proto.Clone(in).(*pb.CredentialLibrary)
 	item.CredentialType = string(currentCredentialType)
 
+	if globals.ResourceInfoFromPrefix(id).Subtype == file.Subtype {
+		// The mapping overrides of a file credential library are stored
+		// as individual fields, so each mapping mask translates directly.
+		dbMasks = append(dbMasks, fileMaskManager.Translate(masks)...)
+		dbMasks = append(dbMasks, getFileMappingMasks(masks)...)
+		if len(dbMasks) == 0 {
+			return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+		cl, err := toStorageFileLibrary(ctx, item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cl.PublicId = id
+		repo, err := s.fileRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, rowsUpdated, err := repo.UpdateCredentialLibrary(ctx, projId, cl, item.GetVersion(), dbMasks)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential library"))
+		}
+		if rowsUpdated == 0 {
+			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist or incorrect version provided.", id)
+		}
+		return out, nil
+	}
+
 	mapping, update := getMappingUpdates(currentCredentialType, currentMapping, item.GetCredentialMappingOverrides().AsMap(), masks)
 	if update {
 		// got mapping update, append mapping override db field mask
@@ -586,6 +805,12 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 	switch globals.ResourceInfoFromPrefix(id).Subtype {
 	case vault.SSHCertificateLibrarySubtype:
 		rows, err = repo.DeleteSSHCertificateCredentialLibrary(ctx, scopeId, id)
+	case file.Subtype:
+		fileRepo, ferr := s.fileRepoFn()
+		if ferr != nil {
+			return false, ferr
+		}
+		rows, err = fileRepo.DeleteCredentialLibrary(ctx, scopeId, id)
 	default:
 		rows, err = repo.DeleteCredentialLibrary(ctx, scopeId, id)
 	}
@@ -606,6 +831,11 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 		res.Error = err
 		return res
 	}
+	fileRepo, err := s.fileRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
 
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.CredentialLibrary), auth.WithAction(a)}
@@ -638,6 +868,17 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 				return res
 			}
 			parentId = cl.GetStoreId()
+		case file.Subtype:
+			cl, err := fileRepo.LookupCredentialLibrary(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if cl == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			parentId = cl.GetStoreId()
 		default:
 			res.Error = errors.New(ctx, errors.InvalidParameter, op, "unrecognized credential library subtype from id")
 			return res
@@ -663,6 +904,17 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 			return res
 		}
 		opts = append(opts, auth.WithScopeId(cs.GetProjectId()))
+	case file.Subtype:
+		cs, err := fileRepo.LookupCredentialStore(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if cs == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		opts = append(opts, auth.WithScopeId(cs.GetProjectId()))
 	default:
 		res.Error = errors.New(ctx, errors.InvalidParameter, op, "unrecognized credential store subtype from id")
 		return res
@@ -770,6 +1022,22 @@ func toProto(ctx context.Context, in credential.Library, opt ...handlers.Option)
 					if mapping.PrivateKeyPassphraseAttribute != "" {
 						m[pkPassphraseAttribute] = mapping.PrivateKeyPassphraseAttribute
 					}
+
+				case *vault.TlsClientCertificateOverride:
+					if mapping.CertificateAttribute != "" {
+						m[certificateAttribute] = mapping.CertificateAttribute
+					}
+					if mapping.PrivateKeyAttribute != "" {
+						m[privateKeyAttribute] = mapping.PrivateKeyAttribute
+					}
+					if mapping.CaCertificateAttribute != "" {
+						m[caCertificateAttribute] = mapping.CaCertificateAttribute
+					}
+
+				case *vault.KubeconfigOverride:
+					if mapping.KubeconfigAttribute != "" {
+						m[kubeconfigAttribute] = mapping.KubeconfigAttribute
+					}
 				}
 				if len(m) > 0 {
 					mp, err := structpb.NewStruct(m)
@@ -790,6 +1058,15 @@ func toProto(ctx context.Context, in credential.Library, opt ...handlers.Option)
 			if vaultIn.GetHttpRequestBody() != nil {
 				attrs.HttpRequestBody = wrapperspb.String(string(vaultIn.GetHttpRequestBody()))
 			}
+			if vaultIn.GetKvV2() {
+				attrs.KvV2 = wrapperspb.Bool(true)
+				if vaultIn.GetKvV2Version() > 0 {
+					attrs.KvV2Version = wrapperspb.UInt32(vaultIn.GetKvV2Version())
+				}
+			}
+			if vaultIn.GetLeaseTtlFromSession() {
+				attrs.LeaseTtlFromSession = wrapperspb.Bool(true)
+			}
 			out.Attrs = &pb.CredentialLibrary_VaultGenericCredentialLibraryAttributes{
 				VaultGenericCredentialLibraryAttributes: attrs,
 			}
@@ -831,7 +1108,7 @@ func toProto(ctx context.Context, in credential.Library, opt ...handlers.Option)
 				attrs.Extensions = e
 			}
 			if vaultIn.GetAdditionalValidPrincipals() != "" {
-				avp := strings.Split(vaultIn.GetAdditionalValidPrincipals(), ",")
+				avp := vault.SplitPrincipals(vaultIn.GetAdditionalValidPrincipals())
 				attrs.AdditionalValidPrincipals = make([]*wrapperspb.StringValue, len(avp))
 				for i, p := range avp {
 					attrs.AdditionalValidPrincipals[i] = &wrapperspb.StringValue{Value: p}
@@ -842,10 +1119,107 @@ func toProto(ctx context.Context, in credential.Library, opt ...handlers.Option)
 			}
 		}
 
+	case file.Subtype:
+		fileIn, ok := in.(*file.CredentialLibrary)
+		if !ok {
+			return nil, errors.New(ctx, errors.Internal, op, "unable to cast to file credential library")
+		}
+
+		if outputFields.Has(globals.CredentialTypeField) && fileIn.CredentialType() != globals.UnspecifiedCredentialType {
+			out.CredentialType = fileIn.GetCredentialType()
+			if outputFields.Has(globals.CredentialMappingOverridesField) {
+				m := make(map[string]any)
+				for k, v := range map[string]string{
+					usernameAttribute:      fileIn.GetUsernameAttribute(),
+					passwordAttribute:      fileIn.GetPasswordAttribute(),
+					privateKeyAttribute:    fileIn.GetPrivateKeyAttribute(),
+					pkPassphraseAttribute:  fileIn.GetPrivateKeyPassphraseAttribute(),
+					certificateAttribute:   fileIn.GetCertificateAttribute(),
+					caCertificateAttribute: fileIn.GetCaCertificateAttribute(),
+					kubeconfigAttribute:    fileIn.GetKubeconfigAttribute(),
+				} {
+					if v != "" {
+						m[k] = v
+					}
+				}
+				if len(m) > 0 {
+					mp, err := structpb.NewStruct(m)
+					if err != nil {
+						return nil, errors.New(ctx, errors.Internal, op, "creating proto struct for mapping override")
+					}
+					out.CredentialMappingOverrides = mp
+				}
+			}
+		}
+		if outputFields.Has(globals.AttributesField) {
+			out.Attrs = &pb.CredentialLibrary_FileCredentialLibraryAttributes{
+				FileCredentialLibraryAttributes: &pb.FileCredentialLibraryAttributes{
+					Path: wrapperspb.String(fileIn.GetPath()),
+				},
+			}
+		}
 	}
 	return &out, nil
 }
 
+func toIssuanceProto(in *credential.Issuance) *credpb.CredentialIssuance {
+	return &credpb.CredentialIssuance{
+		Id:                  in.GetPublicId(),
+		SessionId:           in.SessionId,
+		UserId:              in.UserId,
+		TargetId:            in.TargetId,
+		ScopeId:             in.ProjectId,
+		CredentialLibraryId: in.CredentialLibraryId,
+		CredentialId:        in.CredentialId,
+		CredentialVersion:   in.CredentialVersion,
+		Purpose:             in.Purpose.String(),
+		CreatedTime:         in.GetCreateTime().GetTimestamp(),
+	}
+}
+
+func toStorageFileLibrary(ctx context.Context, storeId string, in *pb.CredentialLibrary) (out *file.CredentialLibrary, err error) {
+	const op = "credentiallibraries.toStorageFileLibrary"
+	var opts []file.Option
+	if in.GetName() != nil {
+		opts = append(opts, file.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, file.WithDescription(in.GetDescription().GetValue()))
+	}
+	if ct := in.GetCredentialType(); ct != "" {
+		opts = append(opts, file.WithCredentialType(globals.CredentialType(ct)))
+	}
+
+	overrides := in.CredentialMappingOverrides.AsMap()
+	if v, ok := overrides[usernameAttribute].(string); ok {
+		opts = append(opts, file.WithOverrideUsernameAttribute(v))
+	}
+	if v, ok := overrides[passwordAttribute].(string); ok {
+		opts = append(opts, file.WithOverridePasswordAttribute(v))
+	}
+	if v, ok := overrides[privateKeyAttribute].(string); ok {
+		opts = append(opts, file.WithOverridePrivateKeyAttribute(v))
+	}
+	if v, ok := overrides[pkPassphraseAttribute].(string); ok {
+		opts = append(opts, file.WithOverridePrivateKeyPassphraseAttribute(v))
+	}
+	if v, ok := overrides[certificateAttribute].(string); ok {
+		opts = append(opts, file.WithOverrideCertificateAttribute(v))
+	}
+	if v, ok := overrides[caCertificateAttribute].(string); ok {
+		opts = append(opts, file.WithOverrideCaCertificateAttribute(v))
+	}
+	if v, ok := overrides[kubeconfigAttribute].(string); ok {
+		opts = append(opts, file.WithOverrideKubeconfigAttribute(v))
+	}
+
+	cl, err := file.NewCredentialLibrary(storeId, in.GetFileCredentialLibraryAttributes().GetPath().GetValue(), opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build credential library"))
+	}
+	return cl, nil
+}
+
 func toStorageVaultLibrary(ctx context.Context, storeId string, in *pb.CredentialLibrary) (out *vault.CredentialLibrary, err error) {
 	const op = "credentiallibraries.toStorageVaultLibrary"
 	var opts []vault.Option
@@ -867,6 +1241,15 @@ func toStorageVaultLibrary(ctx context.Context, storeId string, in *pb.Credentia
 	if attrs.GetHttpRequestBody() != nil {
 		opts = append(opts, vault.WithRequestBody([]byte(attrs.GetHttpRequestBody().GetValue())))
 	}
+	if attrs.GetKvV2().GetValue() {
+		opts = append(opts, vault.WithKvV2())
+	}
+	if attrs.GetKvV2Version() != nil {
+		opts = append(opts, vault.WithKvV2Version(attrs.GetKvV2Version().GetValue()))
+	}
+	if attrs.GetLeaseTtlFromSession().GetValue() {
+		opts = append(opts, vault.WithLeaseTtlFromSession())
+	}
 
 	credentialType := globals.CredentialType(in.GetCredentialType())
 	switch credentialType {
@@ -900,6 +1283,34 @@ func toStorageVaultLibrary(ctx context.Context, storeId string, in *pb.Credentia
 		if len(mapOpts) > 0 {
 			opts = append(opts, vault.WithMappingOverride(vault.NewSshPrivateKeyOverride(mapOpts...)))
 		}
+
+	case globals.TlsClientCertificateCredentialType:
+		opts = append(opts, vault.WithCredentialType(credentialType))
+		overrides := in.CredentialMappingOverrides.AsMap()
+		var mapOpts []vault.Option
+		if cert := overrides[certificateAttribute]; cert != nil {
+			mapOpts = append(mapOpts, vault.WithOverrideCertificateAttribute(cert.(string)))
+		}
+		if pk := overrides[privateKeyAttribute]; pk != nil {
+			mapOpts = append(mapOpts, vault.WithOverridePrivateKeyAttribute(pk.(string)))
+		}
+		if ca := overrides[caCertificateAttribute]; ca != nil {
+			mapOpts = append(mapOpts, vault.WithOverrideCaCertificateAttribute(ca.(string)))
+		}
+		if len(mapOpts) > 0 {
+			opts = append(opts, vault.WithMappingOverride(vault.NewTlsClientCertificateOverride(mapOpts...)))
+		}
+
+	case globals.KubeconfigCredentialType:
+		opts = append(opts, vault.WithCredentialType(credentialType))
+		overrides := in.CredentialMappingOverrides.AsMap()
+		var mapOpts []vault.Option
+		if kc := overrides[kubeconfigAttribute]; kc != nil {
+			mapOpts = append(mapOpts, vault.WithOverrideKubeconfigAttribute(kc.(string)))
+		}
+		if len(mapOpts) > 0 {
+			opts = append(opts, vault.WithMappingOverride(vault.NewKubeconfigOverride(mapOpts...)))
+		}
 	}
 
 	cs, err := vault.NewCredentialLibrary(storeId, attrs.GetPath().GetValue(), opts...)
@@ -972,6 +1383,8 @@ func validateGetRequest(req *pbs.GetCredentialLibraryRequest) error {
 	switch globals.ResourceInfoFromPrefix(req.GetId()).Subtype {
 	case vault.SSHCertificateLibrarySubtype:
 		prefix = globals.VaultSshCertificateCredentialLibraryPrefix
+	case file.Subtype:
+		prefix = globals.FileCredentialLibraryPrefix
 	default:
 		prefix = globals.VaultCredentialLibraryPrefix
 	}
@@ -1053,6 +1466,12 @@ func validateCreateRequest(req *pbs.CreateCredentialLibraryRequest) error {
 				if b := attrs.GetHttpRequestBody(); b != nil && strings.ToUpper(attrs.GetHttpMethod().GetValue()) != "POST" {
 					badFields[httpRequestBodyField] = fmt.Sprintf("Field can only be set if %q is set to the value 'POST'.", httpMethodField)
 				}
+				if attrs.GetKvV2().GetValue() && attrs.GetHttpMethod() != nil && strings.ToUpper(attrs.GetHttpMethod().GetValue()) != "GET" {
+					badFields[kvV2Field] = fmt.Sprintf("Field can only be set if %q is set to the value 'GET'.", httpMethodField)
+				}
+				if v := attrs.GetKvV2Version(); v != nil && v.GetValue() > 0 && !attrs.GetKvV2().GetValue() {
+					badFields[kvV2VersionField] = fmt.Sprintf("Field can only be set if %q is set to true.", kvV2Field)
+				}
 				validateMapping(badFields, globals.CredentialType(req.GetItem().GetCredentialType()), req.GetItem().CredentialMappingOverrides.AsMap())
 			case vault.SSHCertificateLibrarySubtype.String():
 				if req.GetItem().GetCredentialType() != "" {
@@ -1082,6 +1501,30 @@ func validateCreateRequest(req *pbs.CreateCredentialLibraryRequest) error {
 				}
 				validateKeyBits(badFields, attrs.GetKeyBits().GetValue(), attrs.GetKeyType().GetValue())
 			}
+		case file.Subtype:
+			if t := req.GetItem().GetType(); t != "" && t != file.Subtype.String() {
+				badFields[globals.CredentialStoreIdField] = fmt.Sprintf("Type must be %q", file.Subtype.String())
+			}
+			req.GetItem().Type = file.Subtype.String()
+			isValidCred := false
+			ct := req.GetItem().GetCredentialType()
+			for _, t := range validCredentialTypesVaultGeneric {
+				if ct == "" || ct == string(t) {
+					isValidCred = true
+					break
+				}
+			}
+			if !isValidCred {
+				badFields[globals.CredentialTypeField] = fmt.Sprintf("Unknown credential type %q", ct)
+			}
+			attrs := req.GetItem().GetFileCredentialLibraryAttributes()
+			if attrs == nil {
+				badFields[attributesPathField] = "This is a required field."
+			}
+			if attrs.GetPath().GetValue() == "" {
+				badFields[filePathField] = "This is a required field."
+			}
+			validateMapping(badFields, globals.CredentialType(ct), req.GetItem().CredentialMappingOverrides.AsMap())
 		default:
 			badFields[globals.CredentialStoreIdField] = "This field must be a valid credential store id."
 		}
@@ -1097,6 +1540,8 @@ func validateUpdateRequest(req *pbs.UpdateCredentialLibraryRequest, currentCrede
 		prefix = globals.VaultCredentialLibraryPrefix
 	case vault.SSHCertificateLibrarySubtype:
 		prefix = globals.VaultSshCertificateCredentialLibraryPrefix
+	case file.Subtype:
+		prefix = globals.FileCredentialLibraryPrefix
 	}
 	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
@@ -1123,6 +1568,12 @@ func validateUpdateRequest(req *pbs.UpdateCredentialLibraryRequest, currentCrede
 				if b := attrs.GetHttpRequestBody(); b != nil && strings.ToUpper(attrs.GetHttpMethod().GetValue()) == "GET" {
 					badFields[httpRequestBodyField] = fmt.Sprintf("Field can only be set if %q is set to the value 'POST'.", httpMethodField)
 				}
+				if attrs.GetKvV2().GetValue() && strings.ToUpper(attrs.GetHttpMethod().GetValue()) == "POST" {
+					badFields[kvV2Field] = fmt.Sprintf("Field can only be set if %q is set to the value 'GET'.", httpMethodField)
+				}
+				if v := attrs.GetKvV2Version(); handlers.MaskContains(req.GetUpdateMask().GetPaths(), kvV2Field) && !attrs.GetKvV2().GetValue() && v.GetValue() > 0 {
+					badFields[kvV2VersionField] = fmt.Sprintf("Field can only be set if %q is set to true.", kvV2Field)
+				}
 				validateMapping(badFields, currentCredentialType, req.GetItem().CredentialMappingOverrides.AsMap())
 			}
 		case vault.SSHCertificateLibrarySubtype:
@@ -1145,18 +1596,43 @@ func validateUpdateRequest(req *pbs.UpdateCredentialLibraryRequest, currentCrede
 				}
 				validateKeyBits(badFields, attrs.GetKeyBits().GetValue(), attrs.GetKeyType().GetValue())
 			}
+		case file.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != file.Subtype.String() {
+				badFields[globals.TypeField] = "Cannot modify resource type."
+			}
+			if req.GetItem().GetCredentialType() != "" && req.GetItem().GetCredentialType() != string(currentCredentialType) {
+				badFields[globals.CredentialTypeField] = "Cannot modify credential type."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), filePathField) && req.GetItem().GetFileCredentialLibraryAttributes().GetPath().GetValue() == "" {
+				badFields[filePathField] = "This is a required field and cannot be set to empty."
+			}
+			validateMapping(badFields, currentCredentialType, req.GetItem().CredentialMappingOverrides.AsMap())
 		}
 		return badFields
 	}, prefix)
 }
 
 func validateDeleteRequest(req *pbs.DeleteCredentialLibraryRequest) error {
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialLibraryPrefix, globals.VaultSshCertificateCredentialLibraryPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialLibraryPrefix, globals.VaultSshCertificateCredentialLibraryPrefix, globals.FileCredentialLibraryPrefix)
+}
+
+func validateListIssuancesRequest(ctx context.Context, req *pbs.ListCredentialLibraryIssuancesRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.VaultCredentialLibraryPrefix, globals.VaultSshCertificateCredentialLibraryPrefix, globals.FileCredentialLibraryPrefix) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	if _, err := handlers.NewFilter(ctx, req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
 }
 
 func validateListRequest(ctx context.Context, req *pbs.ListCredentialLibrariesRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetCredentialStoreId()), globals.VaultCredentialStorePrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetCredentialStoreId()), globals.VaultCredentialStorePrefix, globals.FileCredentialStorePrefix) {
 		badFields[globals.CredentialStoreIdField] = "This field must be a valid credential store id."
 	}
 	if _, err := handlers.NewFilter(ctx, req.GetFilter()); err != nil {
@@ -1183,6 +1659,12 @@ func validateMapping(badFields map[string]string, credentialType globals.Credent
 		validFields[usernameAttribute] = true
 		validFields[privateKeyAttribute] = true
 		validFields[pkPassphraseAttribute] = true
+	case globals.TlsClientCertificateCredentialType:
+		validFields[certificateAttribute] = true
+		validFields[privateKeyAttribute] = true
+		validFields[caCertificateAttribute] = true
+	case globals.KubeconfigCredentialType:
+		validFields[kubeconfigAttribute] = true
 	default:
 		badFields[globals.CredentialTypeField] = fmt.Sprintf("Unknown credential type %q", credentialType)
 		return
@@ -1231,6 +1713,41 @@ func getMapUpdate(field string, apiMasks []string) bool {
 	return false
 }
 
+// fileMappingFields maps the credential mapping override attributes to the
+// fields of a file credential library.
+var fileMappingFields = map[string]string{
+	usernameAttribute:      "UsernameAttribute",
+	passwordAttribute:      "PasswordAttribute",
+	privateKeyAttribute:    "PrivateKeyAttribute",
+	pkPassphraseAttribute:  "PrivateKeyPassphraseAttribute",
+	certificateAttribute:   "CertificateAttribute",
+	caCertificateAttribute: "CaCertificateAttribute",
+	kubeconfigAttribute:    "KubeconfigAttribute",
+}
+
+// getFileMappingMasks returns the file credential library fields for the
+// credential mapping override masks in apiMasks. The top level mask clears
+// every mapping override.
+func getFileMappingMasks(apiMasks []string) []string {
+	var ret []string
+	for _, m := range apiMasks {
+		if m == credentialMappingPathField {
+			ret = ret[:0]
+			for _, f := range fileMappingFields {
+				ret = append(ret, f)
+			}
+			return ret
+		}
+		credMappingPrefix := fmt.Sprintf("%v.", credentialMappingPathField)
+		if s := strings.SplitN(m, credMappingPrefix, 2); len(s) == 2 {
+			if f, ok := fileMappingFields[s[1]]; ok {
+				ret = append(ret, f)
+			}
+		}
+	}
+	return ret
+}
+
 func getMappingUpdates(credentialType globals.CredentialType, current vault.MappingOverride, new map[string]any, apiMasks []string) (map[string]any, bool) {
 	ret := make(map[string]any)
 	masks := make(map[string]bool)
@@ -1301,6 +1818,48 @@ func getMappingUpdates(credentialType globals.CredentialType, current vault.Mapp
 		default:
 			ret[pkPassphraseAttribute] = currentpPass
 		}
+
+	case globals.TlsClientCertificateCredentialType:
+		var currentCert, currentPk, currentCa any
+		if overrides, ok := current.(*vault.TlsClientCertificateOverride); ok {
+			currentCert = overrides.CertificateAttribute
+			currentPk = overrides.PrivateKeyAttribute
+			currentCa = overrides.CaCertificateAttribute
+		}
+
+		switch {
+		case masks[certificateAttribute]:
+			ret[certificateAttribute] = new[certificateAttribute]
+		default:
+			ret[certificateAttribute] = currentCert
+		}
+
+		switch {
+		case masks[privateKeyAttribute]:
+			ret[privateKeyAttribute] = new[privateKeyAttribute]
+		default:
+			ret[privateKeyAttribute] = currentPk
+		}
+
+		switch {
+		case masks[caCertificateAttribute]:
+			ret[caCertificateAttribute] = new[caCertificateAttribute]
+		default:
+			ret[caCertificateAttribute] = currentCa
+		}
+
+	case globals.KubeconfigCredentialType:
+		var currentKc any
+		if overrides, ok := current.(*vault.KubeconfigOverride); ok {
+			currentKc = overrides.KubeconfigAttribute
+		}
+
+		switch {
+		case masks[kubeconfigAttribute]:
+			ret[kubeconfigAttribute] = new[kubeconfigAttribute]
+		default:
+			ret[kubeconfigAttribute] = currentKc
+		}
 	}
 
 	return ret, true
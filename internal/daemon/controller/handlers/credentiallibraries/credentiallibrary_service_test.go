@@ -17,6 +17,8 @@ import (
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/file"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/credential/vault/store"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -44,7 +46,7 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete"}
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "list-issuances"}
 
 func vaultCredentialLibraryToProto(credLib *vault.CredentialLibrary, project *iam.Scope) *pb.CredentialLibrary {
 	return &pb.CredentialLibrary{
@@ -101,6 +103,12 @@ func TestList(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
+	fileRepoFn := func() (*file.Repository, error) {
+		return file.NewRepository(ctx, rw, rw, kms)
+	}
+	issuanceRepoFn := func() (*credential.IssuanceRepository, error) {
+		return credential.NewIssuanceRepository(ctx, rw, rw)
+	}
 
 	_, prjNoLibs := iam.TestScopes(t, iamRepo)
 	storeNoLibs := vault.TestCredentialStores(t, conn, wrapper, prjNoLibs.GetPublicId(), 1)[0]
@@ -202,7 +210,7 @@ func TestList(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, repoFn, fileRepoFn, issuanceRepoFn, 1000)
 			require.NoError(t, err)
 			// Test non-anonymous listing
 			got, gErr := s.ListCredentialLibraries(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
@@ -261,6 +269,12 @@ func TestList_Attributes(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
+	fileRepoFn := func() (*file.Repository, error) {
+		return file.NewRepository(ctx, rw, rw, kms)
+	}
+	issuanceRepoFn := func() (*credential.IssuanceRepository, error) {
+		return credential.NewIssuanceRepository(ctx, rw, rw)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 
@@ -321,7 +335,7 @@ func TestList_Attributes(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, repoFn, fileRepoFn, issuanceRepoFn, 1000)
 			require.NoError(t, err)
 			// Test non-anonymous listing
 			got, gErr := s.ListCredentialLibraries(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
@@ -379,6 +393,12 @@ func TestCreate(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
+	fileRepoFn := func() (*file.Repository, error) {
+		return file.NewRepository(ctx, rw, rw, kms)
+	}
+	issuanceRepoFn := func() (*credential.IssuanceRepository, error) {
+		return credential.NewIssuanceRepository(ctx, rw, rw)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	store := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
@@ -522,6 +542,37 @@ func TestCreate(t *testing.T) {
 			res: nil,
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name: "KV v2 With POST Method",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Type:              vault.GenericLibrarySubtype.String(),
+				Attrs: &pb.CredentialLibrary_VaultGenericCredentialLibraryAttributes{
+					VaultGenericCredentialLibraryAttributes: &pb.VaultCredentialLibraryAttributes{
+						Path:       wrapperspb.String("secret/data/something"),
+						HttpMethod: wrapperspb.String("POST"),
+						KvV2:       wrapperspb.Bool(true),
+					},
+				},
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "KV v2 Version Without KV v2",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Type:              vault.GenericLibrarySubtype.String(),
+				Attrs: &pb.CredentialLibrary_VaultGenericCredentialLibraryAttributes{
+					VaultGenericCredentialLibraryAttributes: &pb.VaultCredentialLibraryAttributes{
+						Path:        wrapperspb.String("secret/data/something"),
+						KvV2Version: wrapperspb.UInt32(2),
+					},
+				},
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name: "Invalid credential type",
 			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
@@ -632,6 +683,42 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Create a valid vault CredentialLibrary with KV v2",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Type:              vault.GenericLibrarySubtype.String(),
+				Attrs: &pb.CredentialLibrary_VaultGenericCredentialLibraryAttributes{
+					VaultGenericCredentialLibraryAttributes: &pb.VaultCredentialLibraryAttributes{
+						Path:        wrapperspb.String("secret/data/something"),
+						KvV2:        wrapperspb.Bool(true),
+						KvV2Version: wrapperspb.UInt32(2),
+					},
+				},
+			}},
+			idPrefix: globals.VaultCredentialLibraryPrefix + "_",
+			res: &pbs.CreateCredentialLibraryResponse{
+				Uri: fmt.Sprintf("credential-libraries/%s_", globals.VaultCredentialLibraryPrefix),
+				Item: &pb.CredentialLibrary{
+					Id:                store.GetPublicId(),
+					CredentialStoreId: store.GetPublicId(),
+					CreatedTime:       store.GetCreateTime().GetTimestamp(),
+					UpdatedTime:       store.GetUpdateTime().GetTimestamp(),
+					Scope:             &scopepb.ScopeInfo{Id: prj.GetPublicId(), Type: prj.GetType(), ParentScopeId: prj.GetParentId()},
+					Version:           1,
+					Type:              vault.GenericLibrarySubtype.String(),
+					Attrs: &pb.CredentialLibrary_VaultGenericCredentialLibraryAttributes{
+						VaultGenericCredentialLibraryAttributes: &pb.VaultCredentialLibraryAttributes{
+							Path:        wrapperspb.String("secret/data/something"),
+							HttpMethod:  wrapperspb.String("GET"),
+							KvV2:        wrapperspb.Bool(true),
+							KvV2Version: wrapperspb.UInt32(2),
+						},
+					},
+					AuthorizedActions: testAuthorizedActions,
+				},
+			},
+		},
 		{
 			name: "Create a valid vault CredentialLibrary username_password type",
 			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
@@ -893,7 +980,7 @@ func TestCreate(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, repoFn, fileRepoFn, issuanceRepoFn, 1000)
 			require.NoError(err)
 			got, gErr := s.CreateCredentialLibrary(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
 			if tc.wantErr || tc.err != nil {
@@ -950,12 +1037,18 @@ func TestGet(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
+	fileRepoFn := func() (*file.Repository, error) {
+		return file.NewRepository(ctx, rw, rw, kms)
+	}
+	issuanceRepoFn := func() (*credential.IssuanceRepository, error) {
+		return credential.NewIssuanceRepository(ctx, rw, rw)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 
 	store := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
 	unspecifiedLib := vault.TestCredentialLibraries(t, conn, wrapper, store.GetPublicId(), 1)[0]
-	s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, repoFn, fileRepoFn, issuanceRepoFn, 1000)
 	require.NoError(t, err)
 	repo, err := repoFn()
 	require.NoError(t, err)
@@ -1161,13 +1254,19 @@ func TestDelete(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
+	fileRepoFn := func() (*file.Repository, error) {
+		return file.NewRepository(ctx, rw, rw, kms)
+	}
+	issuanceRepoFn := func() (*credential.IssuanceRepository, error) {
+		return credential.NewIssuanceRepository(ctx, rw, rw)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 
 	store := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
 	vl := vault.TestCredentialLibraries(t, conn, wrapper, store.GetPublicId(), 1)[0]
 	vl2 := vault.TestSSHCertificateCredentialLibraries(t, conn, wrapper, store.GetPublicId(), 1)[0]
-	s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, repoFn, fileRepoFn, issuanceRepoFn, 1000)
 	require.NoError(t, err)
 	cases := []struct {
 		name string
@@ -1226,11 +1325,17 @@ func TestUpdate(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(testCtx, rw, rw, kms, sche)
 	}
+	fileRepoFn := func() (*file.Repository, error) {
+		return file.NewRepository(testCtx, rw, rw, kms)
+	}
+	issuanceRepoFn := func() (*credential.IssuanceRepository, error) {
+		return credential.NewIssuanceRepository(testCtx, rw, rw)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
 
-	s, err := NewService(testCtx, iamRepoFn, repoFn, 1000)
+	s, err := NewService(testCtx, iamRepoFn, repoFn, fileRepoFn, issuanceRepoFn, 1000)
 	require.NoError(t, err)
 	cs := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 2)
 	store, diffStore := cs[0], cs[1]
@@ -1304,6 +1409,62 @@ func TestUpdate(t *testing.T) {
 				return out
 			},
 		},
+		{
+			name: "update kv v2 and version",
+			req: &pbs.UpdateCredentialLibraryRequest{
+				UpdateMask: fieldmask(kvV2Field, kvV2VersionField),
+				Item: &pb.CredentialLibrary{
+					Attrs: &pb.CredentialLibrary_VaultGenericCredentialLibraryAttributes{
+						VaultGenericCredentialLibraryAttributes: &pb.VaultCredentialLibraryAttributes{
+							KvV2:        wrapperspb.Bool(true),
+							KvV2Version: wrapperspb.UInt32(3),
+						},
+					},
+				},
+			},
+			res: func(in *pb.CredentialLibrary) *pb.CredentialLibrary {
+				out := proto.Clone(in).(*pb.CredentialLibrary)
+				out.GetVaultGenericCredentialLibraryAttributes().KvV2 = wrapperspb.Bool(true)
+				out.GetVaultGenericCredentialLibraryAttributes().KvV2Version = wrapperspb.UInt32(3)
+				return out
+			},
+		},
+		{
+			name: "update lease ttl from session",
+			req: &pbs.UpdateCredentialLibraryRequest{
+				UpdateMask: fieldmask(leaseTtlFromSessionField),
+				Item: &pb.CredentialLibrary{
+					Attrs: &pb.CredentialLibrary_VaultGenericCredentialLibraryAttributes{
+						VaultGenericCredentialLibraryAttributes: &pb.VaultCredentialLibraryAttributes{
+							LeaseTtlFromSession: wrapperspb.Bool(true),
+						},
+					},
+				},
+			},
+			res: func(in *pb.CredentialLibrary) *pb.CredentialLibrary {
+				out := proto.Clone(in).(*pb.CredentialLibrary)
+				out.GetVaultGenericCredentialLibraryAttributes().LeaseTtlFromSession = wrapperspb.Bool(true)
+				return out
+			},
+		},
+		{
+			name: "unset kv v2 resets version",
+			opts: []vault.Option{vault.WithKvV2(), vault.WithKvV2Version(3)},
+			req: &pbs.UpdateCredentialLibraryRequest{
+				UpdateMask: fieldmask(kvV2Field),
+				Item: &pb.CredentialLibrary{
+					Attrs: &pb.CredentialLibrary_VaultGenericCredentialLibraryAttributes{
+						VaultGenericCredentialLibraryAttributes: &pb.VaultCredentialLibraryAttributes{},
+					},
+				},
+			},
+			res: func(in *pb.CredentialLibrary) *pb.CredentialLibrary {
+				out := proto.Clone(in).(*pb.CredentialLibrary)
+				out.GetVaultGenericCredentialLibraryAttributes().KvV2 = nil
+				out.GetVaultGenericCredentialLibraryAttributes().KvV2Version = nil
+				return out
+			},
+		},
 		{
 			name: "update request body and method",
 			req: &pbs.UpdateCredentialLibraryRequest{
@@ -2004,6 +2165,12 @@ func TestCreate_SSHCertificateCredentialLibrary(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
+	fileRepoFn := func() (*file.Repository, error) {
+		return file.NewRepository(ctx, rw, rw, kms)
+	}
+	issuanceRepoFn := func() (*credential.IssuanceRepository, error) {
+		return credential.NewIssuanceRepository(ctx, rw, rw)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	store := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
@@ -2279,7 +2446,7 @@ func TestCreate_SSHCertificateCredentialLibrary(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, repoFn, fileRepoFn, issuanceRepoFn, 1000)
 			require.NoError(err)
 			got, gErr := s.CreateCredentialLibrary(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
 			if tc.wantErr || tc.err != nil {
@@ -2321,6 +2488,164 @@ func TestCreate_SSHCertificateCredentialLibrary(t *testing.T) {
 	}
 }
 
+func TestCreate_FileCredentialLibrary(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	fileRepoFn := func() (*file.Repository, error) {
+		return file.NewRepository(ctx, rw, rw, kms)
+	}
+	issuanceRepoFn := func() (*credential.IssuanceRepository, error) {
+		return credential.NewIssuanceRepository(ctx, rw, rw)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	store := file.TestCredentialStore(t, conn, prj.GetPublicId(), "/etc/boundary/bundle.yaml")
+
+	cases := []struct {
+		name     string
+		req      *pbs.CreateCredentialLibraryRequest
+		res      *pbs.CreateCredentialLibraryResponse
+		idPrefix string
+		err      error
+	}{
+		{
+			name: "missing path",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Type:              file.Subtype.String(),
+				Attrs: &pb.CredentialLibrary_FileCredentialLibraryAttributes{
+					FileCredentialLibraryAttributes: &pb.FileCredentialLibraryAttributes{},
+				},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "wrong type",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Type:              vault.GenericLibrarySubtype.String(),
+				Attrs: &pb.CredentialLibrary_FileCredentialLibraryAttributes{
+					FileCredentialLibraryAttributes: &pb.FileCredentialLibraryAttributes{
+						Path: wrapperspb.String("prod/db"),
+					},
+				},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "invalid mapping override",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Type:              file.Subtype.String(),
+				CredentialType:    string(globals.UsernamePasswordCredentialType),
+				CredentialMappingOverrides: func() *structpb.Struct {
+					v := map[string]any{
+						kubeconfigAttribute: "kc",
+					}
+					ret, err := structpb.NewStruct(v)
+					require.NoError(t, err)
+					return ret
+				}(),
+				Attrs: &pb.CredentialLibrary_FileCredentialLibraryAttributes{
+					FileCredentialLibraryAttributes: &pb.FileCredentialLibraryAttributes{
+						Path: wrapperspb.String("prod/db"),
+					},
+				},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "valid username password library",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Type:              file.Subtype.String(),
+				CredentialType:    string(globals.UsernamePasswordCredentialType),
+				CredentialMappingOverrides: func() *structpb.Struct {
+					v := map[string]any{
+						usernameAttribute: "user",
+						passwordAttribute: "pass",
+					}
+					ret, err := structpb.NewStruct(v)
+					require.NoError(t, err)
+					return ret
+				}(),
+				Attrs: &pb.CredentialLibrary_FileCredentialLibraryAttributes{
+					FileCredentialLibraryAttributes: &pb.FileCredentialLibraryAttributes{
+						Path: wrapperspb.String("prod/{{ .User.Name }}"),
+					},
+				},
+			}},
+			idPrefix: globals.FileCredentialLibraryPrefix + "_",
+			res: &pbs.CreateCredentialLibraryResponse{
+				Uri: fmt.Sprintf("credential-libraries/%s_", globals.FileCredentialLibraryPrefix),
+				Item: &pb.CredentialLibrary{
+					Id:                store.GetPublicId(),
+					CredentialStoreId: store.GetPublicId(),
+					Scope:             &scopepb.ScopeInfo{Id: prj.GetPublicId(), Type: prj.GetType(), ParentScopeId: prj.GetParentId()},
+					Version:           1,
+					Type:              file.Subtype.String(),
+					CredentialType:    string(globals.UsernamePasswordCredentialType),
+					CredentialMappingOverrides: func() *structpb.Struct {
+						v := map[string]any{
+							usernameAttribute: "user",
+							passwordAttribute: "pass",
+						}
+						ret, err := structpb.NewStruct(v)
+						require.NoError(t, err)
+						return ret
+					}(),
+					Attrs: &pb.CredentialLibrary_FileCredentialLibraryAttributes{
+						FileCredentialLibraryAttributes: &pb.FileCredentialLibraryAttributes{
+							Path: wrapperspb.String("prod/{{ .User.Name }}"),
+						},
+					},
+					AuthorizedActions: testAuthorizedActions,
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := NewService(ctx, iamRepoFn, repoFn, fileRepoFn, issuanceRepoFn, 1000)
+			require.NoError(err)
+			got, gErr := s.CreateCredentialLibrary(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "CreateCredentialLibrary(...) got error %v, wanted %v", gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Contains(got.GetUri(), tc.res.Uri)
+			assert.True(strings.HasPrefix(got.GetItem().GetId(), tc.idPrefix))
+
+			// Clear all values which are hard to compare against.
+			got.Uri, tc.res.Uri = "", ""
+			got.Item.Id, tc.res.Item.Id = "", ""
+			got.Item.CreatedTime, got.Item.UpdatedTime = nil, nil
+			assert.Empty(cmp.Diff(
+				got,
+				tc.res,
+				protocmp.Transform(),
+				protocmp.SortRepeatedFields(got),
+			), "CreateCredentialLibrary(%q) got response %q, wanted %q", tc.req, got, tc.res)
+		})
+	}
+}
+
 func TestUpdate_SSHCertificateCredentialLibrary(t *testing.T) {
 	testCtx := context.Background()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -2336,11 +2661,17 @@ func TestUpdate_SSHCertificateCredentialLibrary(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(testCtx, rw, rw, kms, sche)
 	}
+	fileRepoFn := func() (*file.Repository, error) {
+		return file.NewRepository(testCtx, rw, rw, kms)
+	}
+	issuanceRepoFn := func() (*credential.IssuanceRepository, error) {
+		return credential.NewIssuanceRepository(testCtx, rw, rw)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
 
-	s, err := NewService(testCtx, iamRepoFn, repoFn, 1000)
+	s, err := NewService(testCtx, iamRepoFn, repoFn, fileRepoFn, issuanceRepoFn, 1000)
 	require.NoError(t, err)
 	cs := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 2)
 	store, diffStore := cs[0], cs[1]
@@ -2735,6 +3066,12 @@ func TestListPagination(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
+	fileRepoFn := func() (*file.Repository, error) {
+		return file.NewRepository(ctx, rw, rw, kms)
+	}
+	issuanceRepoFn := func() (*credential.IssuanceRepository, error) {
+		return credential.NewIssuanceRepository(ctx, rw, rw)
+	}
 	tokenRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, rw, rw, kms)
 	}
@@ -2790,7 +3127,7 @@ func TestListPagination(t *testing.T) {
 	requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
 	ctx = auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kms, &requestInfo)
 
-	s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, repoFn, fileRepoFn, issuanceRepoFn, 1000)
 	require.NoError(err)
 	// Start paginating, recursively
 	req := &pbs.ListCredentialLibrariesRequest{
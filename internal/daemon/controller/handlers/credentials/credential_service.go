@@ -5,9 +5,11 @@ package credentials
 
 import (
 	"context"
+	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 
 	"github.com/hashicorp/boundary/globals"
@@ -40,6 +42,9 @@ const (
 	privateKeyField           = "attributes.private_key"
 	privateKeyPassphraseField = "attributes.private_key_passphrase"
 	objectField               = "attributes.object"
+	certificateField          = "attributes.certificate"
+	caCertificateField        = "attributes.ca_certificate"
+	kubeconfigField           = "attributes.kubeconfig"
 	domain                    = "credential"
 )
 
@@ -47,6 +52,8 @@ var (
 	upMaskManager   handlers.MaskManager
 	spkMaskManager  handlers.MaskManager
 	jsonMaskManager handlers.MaskManager
+	tlsMaskManager  handlers.MaskManager
+	kcMaskManager   handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -55,6 +62,9 @@ var (
 		action.Read,
 		action.Update,
 		action.Delete,
+		action.ListVersions,
+		action.Rollback,
+		action.ListIssuances,
 	)
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -88,6 +98,20 @@ func init() {
 	); err != nil {
 		panic(err)
 	}
+	if tlsMaskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{&store.TlsClientCertificateCredential{}},
+		handlers.MaskSource{&pb.Credential{}, &pb.TlsClientCertificateAttributes{}},
+	); err != nil {
+		panic(err)
+	}
+	if kcMaskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{&store.KubeconfigCredential{}},
+		handlers.MaskSource{&pb.Credential{}, &pb.KubeconfigAttributes{}},
+	); err != nil {
+		panic(err)
+	}
 
 	// TODO: refactor to remove IdActionsMap and CollectionActions package variables
 	action.RegisterResource(resource.Credential, IdActions, CollectionActions)
@@ -97,9 +121,10 @@ func init() {
 type Service struct {
 	pbs.UnsafeCredentialServiceServer
 
-	iamRepoFn   common.IamRepoFactory
-	repoFn      common.StaticCredentialRepoFactory
-	maxPageSize uint
+	iamRepoFn      common.IamRepoFactory
+	repoFn         common.StaticCredentialRepoFactory
+	issuanceRepoFn common.CredentialIssuanceRepoFactory
+	maxPageSize    uint
 }
 
 var _ pbs.CredentialServiceServer = (*Service)(nil)
@@ -109,6 +134,7 @@ func NewService(
 	ctx context.Context,
 	iamRepo common.IamRepoFactory,
 	repo common.StaticCredentialRepoFactory,
+	issuanceRepo common.CredentialIssuanceRepoFactory,
 	maxPageSize uint,
 ) (Service, error) {
 	const op = "credentials.NewService"
@@ -118,10 +144,13 @@ func NewService(
 	if repo == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static credential repository")
 	}
+	if issuanceRepo == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing credential issuance repository")
+	}
 	if maxPageSize == 0 {
 		maxPageSize = uint(globals.DefaultMaxPageSize)
 	}
-	return Service{iamRepoFn: iamRepo, repoFn: repo, maxPageSize: maxPageSize}, nil
+	return Service{iamRepoFn: iamRepo, repoFn: repo, issuanceRepoFn: issuanceRepo, maxPageSize: maxPageSize}, nil
 }
 
 // ListCredentials implements the interface pbs.CredentialServiceServer
@@ -302,7 +331,7 @@ func (s Service) CreateCredential(ctx context.Context, req *pbs.CreateCredential
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	cl, err := s.createInRepo(ctx, authResults.Scope.GetId(), req.GetItem())
+	cl, err := s.createInRepo(ctx, authResults.Scope.GetId(), req.GetItem(), static.WithAuthorId(authResults.UserId))
 	if err != nil {
 		return nil, err
 	}
@@ -349,7 +378,7 @@ func (s Service) UpdateCredential(ctx context.Context, req *pbs.UpdateCredential
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	c, err := s.updateInRepo(ctx, authResults.Scope.GetId(), storeId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+	c, err := s.updateInRepo(ctx, authResults.Scope.GetId(), storeId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem(), static.WithAuthorId(authResults.UserId))
 	if err != nil {
 		return nil, err
 	}
@@ -392,6 +421,190 @@ func (s Service) DeleteCredential(ctx context.Context, req *pbs.DeleteCredential
 	return nil, nil
 }
 
+// ListCredentialVersions implements the interface pbs.CredentialServiceServer.
+func (s Service) ListCredentialVersions(ctx context.Context, req *pbs.ListCredentialVersionsRequest) (*pbs.ListCredentialVersionsResponse, error) {
+	const op = "credentials.(Service).ListCredentialVersions"
+
+	if err := validateListVersionsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ListVersions)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	versions, err := repo.ListCredentialVersions(ctx, req.GetId(), static.WithLimit(-1))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to list credential versions"))
+	}
+
+	items := make([]*pbs.CredentialVersion, 0, len(versions))
+	for _, v := range versions {
+		items = append(items, &pbs.CredentialVersion{
+			Version:     v.GetVersion(),
+			AuthorId:    v.GetAuthorId(),
+			CreatedTime: v.GetCreateTime().GetTimestamp(),
+		})
+	}
+	return &pbs.ListCredentialVersionsResponse{Items: items}, nil
+}
+
+// RollbackCredential implements the interface pbs.CredentialServiceServer.
+func (s Service) RollbackCredential(ctx context.Context, req *pbs.RollbackCredentialRequest) (*pbs.RollbackCredentialResponse, error) {
+	const op = "credentials.(Service).RollbackCredential"
+
+	if err := validateRollbackRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Rollback)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	c, rowsUpdated, err := repo.RollbackCredential(ctx, authResults.Scope.GetId(), req.GetId(), req.GetToVersion(), req.GetVersion(), static.WithAuthorId(authResults.UserId))
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, handlers.NotFoundErrorf("Version %d of credential %q doesn't exist.", req.GetToVersion(), req.GetId())
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to roll back credential"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Credential %q doesn't exist or incorrect version provided.", req.GetId())
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, c.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(c, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.RollbackCredentialResponse{Item: item}, nil
+}
+
+// ListCredentialIssuances implements the interface pbs.CredentialServiceServer.
+func (s Service) ListCredentialIssuances(ctx context.Context, req *pbs.ListCredentialIssuancesRequest) (*pbs.ListCredentialIssuancesResponse, error) {
+	const op = "credentials.(Service).ListCredentialIssuances"
+	if err := validateListIssuancesRequest(ctx, req); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ListIssuances)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	pageSize := int(s.maxPageSize)
+	// Use the requested page size only if it is smaller than
+	// the configured max.
+	if req.GetPageSize() != 0 && uint(req.GetPageSize()) < s.maxPageSize {
+		pageSize = int(req.GetPageSize())
+	}
+	var filterItemFn func(ctx context.Context, item *credential.Issuance) (bool, error)
+	switch {
+	case req.GetFilter() != "":
+		// Only use a filter if we need to
+		filter, err := handlers.NewFilter(ctx, req.GetFilter())
+		if err != nil {
+			return nil, err
+		}
+		filterItemFn = func(ctx context.Context, item *credential.Issuance) (bool, error) {
+			return filter.Match(toIssuanceProto(item)), nil
+		}
+	default:
+		filterItemFn = func(ctx context.Context, item *credential.Issuance) (bool, error) {
+			return true, nil
+		}
+	}
+	repo, err := s.issuanceRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	grantsHash, err := authResults.GrantsHash(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var listResp *pagination.ListResponse[*credential.Issuance]
+	var sortBy string
+	if req.GetListToken() == "" {
+		sortBy = "created_time"
+		listResp, err = credential.ListIssuances(ctx, grantsHash, pageSize, filterItemFn, repo, req.GetId())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		listToken, err := handlers.ParseListToken(ctx, req.GetListToken(), resource.Session, grantsHash)
+		if err != nil {
+			return nil, err
+		}
+		switch st := listToken.Subtype.(type) {
+		case *listtoken.PaginationToken:
+			sortBy = "created_time"
+			listResp, err = credential.ListIssuancesPage(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, req.GetId())
+			if err != nil {
+				return nil, err
+			}
+		case *listtoken.StartRefreshToken:
+			sortBy = "created_time"
+			listResp, err = credential.ListIssuancesRefresh(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, req.GetId())
+			if err != nil {
+				return nil, err
+			}
+		case *listtoken.RefreshToken:
+			sortBy = "created_time"
+			listResp, err = credential.ListIssuancesRefreshPage(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, req.GetId())
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "unexpected list token subtype: %T", st)
+		}
+	}
+
+	finalItems := make([]*pb.CredentialIssuance, 0, len(listResp.Items))
+	for _, item := range listResp.Items {
+		finalItems = append(finalItems, toIssuanceProto(item))
+	}
+	respType := "delta"
+	if listResp.CompleteListing {
+		respType = "complete"
+	}
+	resp := &pbs.ListCredentialIssuancesResponse{
+		Items:        finalItems,
+		EstItemCount: uint32(listResp.EstimatedItemCount),
+		RemovedIds:   listResp.DeletedIds,
+		ResponseType: respType,
+		SortBy:       sortBy,
+		SortDir:      "desc",
+	}
+
+	if listResp.ListToken != nil {
+		resp.ListToken, err = handlers.MarshalListToken(ctx, listResp.ListToken, pbs.ResourceType_RESOURCE_TYPE_SESSION)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (credential.Static, error) {
 	const op = "credentials.(Service).getFromRepo"
 	repo, err := s.repoFn()
@@ -408,7 +621,7 @@ func (s Service) getFromRepo(ctx context.Context, id string) (credential.Static,
 	return cred, err
 }
 
-func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Credential) (credential.Static, error) {
+func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Credential, opt ...static.Option) (credential.Static, error) {
 	const op = "credentials.(Service).createInRepo"
 	switch item.GetType() {
 	case credential.UsernamePasswordSubtype.String():
@@ -420,7 +633,7 @@ func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Cred
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
-		out, err := repo.CreateUsernamePasswordCredential(ctx, scopeId, cred)
+		out, err := repo.CreateUsernamePasswordCredential(ctx, scopeId, cred, opt...)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential"))
 		}
@@ -437,7 +650,7 @@ func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Cred
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
-		out, err := repo.CreateSshPrivateKeyCredential(ctx, scopeId, cred)
+		out, err := repo.CreateSshPrivateKeyCredential(ctx, scopeId, cred, opt...)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential"))
 		}
@@ -454,7 +667,41 @@ func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Cred
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
-		out, err := repo.CreateJsonCredential(ctx, scopeId, cred)
+		out, err := repo.CreateJsonCredential(ctx, scopeId, cred, opt...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential"))
+		}
+		if out == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential but no error returned from repository.")
+		}
+		return out, nil
+	case credential.TlsClientCertificateSubtype.String():
+		cred, err := toTlsClientCertificateStorageCredential(ctx, item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, err := repo.CreateTlsClientCertificateCredential(ctx, scopeId, cred, opt...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential"))
+		}
+		if out == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential but no error returned from repository.")
+		}
+		return out, nil
+	case credential.KubeconfigSubtype.String():
+		cred, err := toKubeconfigStorageCredential(ctx, item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, err := repo.CreateKubeconfigCredential(ctx, scopeId, cred, opt...)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential"))
 		}
@@ -472,6 +719,7 @@ func (s Service) updateInRepo(
 	scopeId, storeId, id string,
 	masks []string,
 	in *pb.Credential,
+	opt ...static.Option,
 ) (credential.Static, error) {
 	const op = "credentials.(Service).updateInRepo"
 
@@ -494,7 +742,7 @@ func (s Service) updateInRepo(
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
-		out, rowsUpdated, err := repo.UpdateUsernamePasswordCredential(ctx, scopeId, cred, item.GetVersion(), dbMasks)
+		out, rowsUpdated, err := repo.UpdateUsernamePasswordCredential(ctx, scopeId, cred, item.GetVersion(), dbMasks, opt...)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential"))
 		}
@@ -528,7 +776,7 @@ func (s Service) updateInRepo(
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
-		out, rowsUpdated, err := repo.UpdateSshPrivateKeyCredential(ctx, scopeId, cred, item.GetVersion(), dbMasks)
+		out, rowsUpdated, err := repo.UpdateSshPrivateKeyCredential(ctx, scopeId, cred, item.GetVersion(), dbMasks, opt...)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential"))
 		}
@@ -552,7 +800,55 @@ func (s Service) updateInRepo(
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
-		out, rowsUpdated, err := repo.UpdateJsonCredential(ctx, scopeId, cred, item.GetVersion(), dbMasks)
+		out, rowsUpdated, err := repo.UpdateJsonCredential(ctx, scopeId, cred, item.GetVersion(), dbMasks, opt...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential"))
+		}
+		if rowsUpdated == 0 {
+			return nil, handlers.NotFoundErrorf("Credential %q doesn't exist or incorrect version provided.", id)
+		}
+		return out, nil
+
+	case credential.TlsClientCertificateSubtype:
+		dbMasks = append(dbMasks, tlsMaskManager.Translate(masks)...)
+		if len(dbMasks) == 0 {
+			return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+
+		cred, err := toTlsClientCertificateStorageCredential(ctx, storeId, in)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to convert to tls client certificate storage credential"))
+		}
+		cred.PublicId = id
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, rowsUpdated, err := repo.UpdateTlsClientCertificateCredential(ctx, scopeId, cred, item.GetVersion(), dbMasks, opt...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential"))
+		}
+		if rowsUpdated == 0 {
+			return nil, handlers.NotFoundErrorf("Credential %q doesn't exist or incorrect version provided.", id)
+		}
+		return out, nil
+
+	case credential.KubeconfigSubtype:
+		dbMasks = append(dbMasks, kcMaskManager.Translate(masks)...)
+		if len(dbMasks) == 0 {
+			return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+
+		cred, err := toKubeconfigStorageCredential(ctx, storeId, in)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to convert to kubeconfig storage credential"))
+		}
+		cred.PublicId = id
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, rowsUpdated, err := repo.UpdateKubeconfigCredential(ctx, scopeId, cred, item.GetVersion(), dbMasks, opt...)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential"))
 		}
@@ -684,6 +980,10 @@ func toProto(in credential.Static, opt ...handlers.Option) (*pb.Credential, erro
 			out.Type = credential.SshPrivateKeySubtype.String()
 		case *static.JsonCredential:
 			out.Type = credential.JsonSubtype.String()
+		case *static.TlsClientCertificateCredential:
+			out.Type = credential.TlsClientCertificateSubtype.String()
+		case *static.KubeconfigCredential:
+			out.Type = credential.KubeconfigSubtype.String()
 		}
 	}
 	if outputFields.Has(globals.DescriptionField) && in.GetDescription() != "" {
@@ -736,10 +1036,46 @@ func toProto(in credential.Static, opt ...handlers.Option) (*pb.Credential, erro
 				},
 			}
 		}
+	case *static.TlsClientCertificateCredential:
+		if outputFields.Has(globals.AttributesField) {
+			attrs := &pb.TlsClientCertificateAttributes{
+				Certificate:    wrapperspb.String(string(cred.GetCertificate())),
+				PrivateKeyHmac: base64.RawURLEncoding.EncodeToString(cred.GetPrivateKeyHmac()),
+			}
+			if len(cred.GetCaCertificate()) > 0 {
+				attrs.CaCertificate = wrapperspb.String(string(cred.GetCaCertificate()))
+			}
+			out.Attrs = &pb.Credential_TlsClientCertificateAttributes{
+				TlsClientCertificateAttributes: attrs,
+			}
+		}
+	case *static.KubeconfigCredential:
+		if outputFields.Has(globals.AttributesField) {
+			out.Attrs = &pb.Credential_KubeconfigAttributes{
+				KubeconfigAttributes: &pb.KubeconfigAttributes{
+					KubeconfigHmac: base64.RawURLEncoding.EncodeToString(cred.GetKubeconfigHmac()),
+				},
+			}
+		}
 	}
 	return &out, nil
 }
 
+func toIssuanceProto(in *credential.Issuance) *pb.CredentialIssuance {
+	return &pb.CredentialIssuance{
+		Id:                  in.GetPublicId(),
+		SessionId:           in.SessionId,
+		UserId:              in.UserId,
+		TargetId:            in.TargetId,
+		ScopeId:             in.ProjectId,
+		CredentialLibraryId: in.CredentialLibraryId,
+		CredentialId:        in.CredentialId,
+		CredentialVersion:   in.CredentialVersion,
+		Purpose:             in.Purpose.String(),
+		CreatedTime:         in.GetCreateTime().GetTimestamp(),
+	}
+}
+
 func toUsernamePasswordStorageCredential(ctx context.Context, storeId string, in *pb.Credential) (out *static.UsernamePasswordCredential, err error) {
 	const op = "credentials.toUsernamePasswordStorageCredential"
 	var opts []static.Option
@@ -822,6 +1158,108 @@ func toJsonStorageCredential(ctx context.Context, storeId string, in *pb.Credent
 	return cs, err
 }
 
+func toTlsClientCertificateStorageCredential(ctx context.Context, storeId string, in *pb.Credential) (out *static.TlsClientCertificateCredential, err error) {
+	const op = "credentials.toTlsClientCertificateStorageCredential"
+	var opts []static.Option
+	if in.GetName() != nil {
+		opts = append(opts, static.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, static.WithDescription(in.GetDescription().GetValue()))
+	}
+
+	attrs := in.GetTlsClientCertificateAttributes()
+	if attrs.GetCaCertificate() != nil {
+		opts = append(opts, static.WithCaCertificate([]byte(attrs.GetCaCertificate().GetValue())))
+	}
+	cs, err := static.NewTlsClientCertificateCredential(
+		ctx,
+		storeId,
+		[]byte(attrs.GetCertificate().GetValue()),
+		credential.PrivateKey(attrs.GetPrivateKey().GetValue()),
+		opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build credential"))
+	}
+
+	return cs, err
+}
+
+func toKubeconfigStorageCredential(ctx context.Context, storeId string, in *pb.Credential) (out *static.KubeconfigCredential, err error) {
+	const op = "credentials.toKubeconfigStorageCredential"
+	var opts []static.Option
+	if in.GetName() != nil {
+		opts = append(opts, static.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, static.WithDescription(in.GetDescription().GetValue()))
+	}
+
+	attrs := in.GetKubeconfigAttributes()
+	cs, err := static.NewKubeconfigCredential(
+		ctx,
+		storeId,
+		credential.Kubeconfig(attrs.GetKubeconfig().GetValue()),
+		opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build credential"))
+	}
+
+	return cs, err
+}
+
+// validateTlsClientCertificate appends to badFields if the certificate,
+// private key or CA certificate can not be parsed or if the private key does
+// not match the certificate. Only the values present in fields are checked.
+func validateTlsClientCertificate(badFields map[string]string, attrs *pb.TlsClientCertificateAttributes, fields map[string]bool) {
+	cert, key := attrs.GetCertificate().GetValue(), attrs.GetPrivateKey().GetValue()
+	if fields[certificateField] {
+		if cert == "" {
+			badFields[certificateField] = "This is a required field and cannot be set to empty."
+		} else if err := parseCertificates(cert); err != nil {
+			badFields[certificateField] = fmt.Sprintf("Unable to parse given certificate value: %v.", err)
+		}
+	}
+	if fields[privateKeyField] && key == "" {
+		badFields[privateKeyField] = "This is a required field and cannot be set to empty."
+	}
+	if fields[certificateField] && fields[privateKeyField] && cert != "" && key != "" && badFields[certificateField] == "" {
+		if _, err := tls.X509KeyPair([]byte(cert), []byte(key)); err != nil {
+			badFields[privateKeyField] = fmt.Sprintf("Unable to use given private key value with the certificate: %v.", err)
+		}
+	}
+	if ca := attrs.GetCaCertificate().GetValue(); fields[caCertificateField] && ca != "" {
+		if err := parseCertificates(ca); err != nil {
+			badFields[caCertificateField] = fmt.Sprintf("Unable to parse given certificate value: %v.", err)
+		}
+	}
+}
+
+// parseCertificates returns an error if s does not contain only PEM encoded
+// X.509 certificates.
+func parseCertificates(s string) error {
+	b := []byte(s)
+	var found bool
+	for {
+		var block *pem.Block
+		block, b = pem.Decode(b)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("unexpected PEM block type %q", block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return err
+		}
+		found = true
+	}
+	if !found {
+		return fmt.Errorf("no PEM encoded certificate found")
+	}
+	return nil
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //   - The path passed in is correctly formatted
@@ -835,6 +1273,8 @@ func validateGetRequest(req *pbs.GetCredentialRequest) error {
 		globals.UsernamePasswordCredentialPreviousPrefix,
 		globals.SshPrivateKeyCredentialPrefix,
 		globals.JsonCredentialPrefix,
+		globals.TlsClientCertificateCredentialPrefix,
+		globals.KubeconfigCredentialPrefix,
 	)
 }
 
@@ -891,6 +1331,18 @@ func validateCreateRequest(req *pbs.CreateCredentialRequest) error {
 				badFields[objectField] = "Unable to parse given json value"
 			}
 
+		case credential.TlsClientCertificateSubtype.String():
+			validateTlsClientCertificate(badFields, req.GetItem().GetTlsClientCertificateAttributes(), map[string]bool{
+				certificateField:   true,
+				privateKeyField:    true,
+				caCertificateField: true,
+			})
+
+		case credential.KubeconfigSubtype.String():
+			if req.GetItem().GetKubeconfigAttributes().GetKubeconfig().GetValue() == "" {
+				badFields[kubeconfigField] = "Field required for creating a kubeconfig credential."
+			}
+
 		default:
 			badFields[globals.TypeField] = fmt.Sprintf("Unsupported credential type %q", req.Item.GetType())
 		}
@@ -954,6 +1406,20 @@ func validateUpdateRequest(req *pbs.UpdateCredentialRequest) error {
 				}
 			}
 
+		case credential.TlsClientCertificateSubtype:
+			paths := req.GetUpdateMask().GetPaths()
+			validateTlsClientCertificate(badFields, req.GetItem().GetTlsClientCertificateAttributes(), map[string]bool{
+				certificateField:   handlers.MaskContains(paths, certificateField),
+				privateKeyField:    handlers.MaskContains(paths, privateKeyField),
+				caCertificateField: handlers.MaskContains(paths, caCertificateField),
+			})
+
+		case credential.KubeconfigSubtype:
+			attrs := req.GetItem().GetKubeconfigAttributes()
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), kubeconfigField) && attrs.GetKubeconfig().GetValue() == "" {
+				badFields[kubeconfigField] = "This is a required field and cannot be set to empty."
+			}
+
 		default:
 			badFields[globals.IdField] = "Unknown credential type."
 		}
@@ -964,6 +1430,8 @@ func validateUpdateRequest(req *pbs.UpdateCredentialRequest) error {
 		globals.UsernamePasswordCredentialPreviousPrefix,
 		globals.SshPrivateKeyCredentialPrefix,
 		globals.JsonCredentialPrefix,
+		globals.TlsClientCertificateCredentialPrefix,
+		globals.KubeconfigCredentialPrefix,
 	)
 }
 
@@ -975,9 +1443,72 @@ func validateDeleteRequest(req *pbs.DeleteCredentialRequest) error {
 		globals.UsernamePasswordCredentialPreviousPrefix,
 		globals.SshPrivateKeyCredentialPrefix,
 		globals.JsonCredentialPrefix,
+		globals.TlsClientCertificateCredentialPrefix,
+		globals.KubeconfigCredentialPrefix,
+	)
+}
+
+func validateListVersionsRequest(req *pbs.ListCredentialVersionsRequest) error {
+	return handlers.ValidateGetRequest(
+		handlers.NoopValidatorFn,
+		req,
+		globals.UsernamePasswordCredentialPrefix,
+		globals.UsernamePasswordCredentialPreviousPrefix,
+		globals.SshPrivateKeyCredentialPrefix,
+		globals.JsonCredentialPrefix,
+		globals.TlsClientCertificateCredentialPrefix,
+		globals.KubeconfigCredentialPrefix,
 	)
 }
 
+func validateRollbackRequest(req *pbs.RollbackCredentialRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()),
+		globals.UsernamePasswordCredentialPrefix,
+		globals.UsernamePasswordCredentialPreviousPrefix,
+		globals.SshPrivateKeyCredentialPrefix,
+		globals.JsonCredentialPrefix,
+		globals.TlsClientCertificateCredentialPrefix,
+		globals.KubeconfigCredentialPrefix,
+	) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	if req.GetVersion() == 0 {
+		badFields[globals.VersionField] = "Required field."
+	}
+	switch {
+	case req.GetToVersion() == 0:
+		badFields["to_version"] = "Required field."
+	case req.GetToVersion() >= req.GetVersion() && req.GetVersion() != 0:
+		badFields["to_version"] = "Must be an earlier version than the current version."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
+func validateListIssuancesRequest(ctx context.Context, req *pbs.ListCredentialIssuancesRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()),
+		globals.UsernamePasswordCredentialPrefix,
+		globals.UsernamePasswordCredentialPreviousPrefix,
+		globals.SshPrivateKeyCredentialPrefix,
+		globals.JsonCredentialPrefix,
+		globals.TlsClientCertificateCredentialPrefix,
+		globals.KubeconfigCredentialPrefix,
+	) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	if _, err := handlers.NewFilter(ctx, req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
 func validateListRequest(ctx context.Context, req *pbs.ListCredentialsRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetCredentialStoreId()), globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix) {
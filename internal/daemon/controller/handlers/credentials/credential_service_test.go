@@ -48,7 +48,7 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete"}
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "list-versions", "rollback", "list-issuances"}
 
 func staticJsonCredentialToProto(cred *static.JsonCredential, prj *iam.Scope, hmac string) *pb.Credential {
 	return &pb.Credential{
@@ -120,6 +120,9 @@ func TestList(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kkms)
 	}
+	issuanceRepoFn := func() (*credential.IssuanceRepository, error) {
+		return credential.NewIssuanceRepository(ctx, rw, rw)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	store := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
@@ -265,7 +268,7 @@ func TestList(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			s, err := NewService(ctx, iamRepoFn, staticRepoFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, staticRepoFn, issuanceRepoFn, 1000)
 			require.NoError(t, err, "Couldn't create new host set service.")
 
 			// Test non-anonymous listing
@@ -319,13 +322,16 @@ func TestGet(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(context.Background(), rw, rw, kkms)
 	}
+	issuanceRepoFn := func() (*credential.IssuanceRepository, error) {
+		return credential.NewIssuanceRepository(context.Background(), rw, rw)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	databaseWrapper, err := kkms.GetWrapper(context.Background(), prj.GetPublicId(), kms.KeyPurposeDatabase)
 	require.NoError(t, err)
 
 	store := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
-	s, err := NewService(ctx, iamRepoFn, staticRepoFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, staticRepoFn, issuanceRepoFn, 1000)
 	require.NoError(t, err)
 
 	upCred := static.TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", store.GetPublicId(), prj.GetPublicId())
@@ -526,11 +532,14 @@ func TestDelete(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(context.Background(), rw, rw, kms)
 	}
+	issuanceRepoFn := func() (*credential.IssuanceRepository, error) {
+		return credential.NewIssuanceRepository(context.Background(), rw, rw)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 
 	store := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
-	s, err := NewService(ctx, iamRepoFn, staticRepoFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, staticRepoFn, issuanceRepoFn, 1000)
 	require.NoError(t, err)
 
 	upCred := static.TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", store.GetPublicId(), prj.GetPublicId())
@@ -600,6 +609,9 @@ func TestCreate(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(context.Background(), rw, rw, kkms)
 	}
+	issuanceRepoFn := func() (*credential.IssuanceRepository, error) {
+		return credential.NewIssuanceRepository(context.Background(), rw, rw)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	store := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
@@ -857,7 +869,7 @@ func TestCreate(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, repoFn, issuanceRepoFn, 1000)
 			require.NoError(err, "Error when getting new credential store service.")
 
 			got, gErr := s.CreateCredential(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
@@ -973,10 +985,13 @@ func TestUpdate(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(context.Background(), rw, rw, kkms)
 	}
+	issuanceRepoFn := func() (*credential.IssuanceRepository, error) {
+		return credential.NewIssuanceRepository(context.Background(), rw, rw)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
-	s, err := NewService(ctx, iamRepoFn, staticRepoFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, staticRepoFn, issuanceRepoFn, 1000)
 	require.NoError(t, err)
 
 	fieldmask := func(paths ...string) *fieldmaskpb.FieldMask {
@@ -1502,6 +1517,9 @@ func TestListPagination(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kmsRepo)
 	}
+	issuanceRepoFn := func() (*credential.IssuanceRepository, error) {
+		return credential.NewIssuanceRepository(ctx, rw, rw)
+	}
 	tokenRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, rw, rw, kmsRepo)
 	}
@@ -1568,7 +1586,7 @@ func TestListPagination(t *testing.T) {
 	requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
 	ctx = auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kmsRepo, &requestInfo)
 
-	s, err := NewService(ctx, iamRepoFn, staticRepoFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, staticRepoFn, issuanceRepoFn, 1000)
 	require.NoError(err)
 
 	// Start paginating, recursively
@@ -8,9 +8,13 @@ import (
 	"encoding/base64"
 	"encoding/pem"
 	"fmt"
+	"path/filepath"
+	"strings"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/file"
+	filestore "github.com/hashicorp/boundary/internal/credential/file/store"
 	"github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/credential/vault/store"
@@ -45,10 +49,48 @@ const (
 	clientCertField        = "attributes.client_certificate"
 	clientCertKeyField     = "attributes.certificate_key"
 	domain                 = "credential"
+
+	vaultAuthMethodField     = "attributes.auth_method"
+	vaultAuthMountPathField  = "attributes.auth_mount_path"
+	vaultAuthRoleField       = "attributes.auth_role"
+	vaultAuthSecretField     = "attributes.auth_secret"
+	vaultAuthSecretHmacField = "attributes.auth_secret_hmac"
+
+	staticRotationPeriodField      = "attributes.rotation_period"
+	staticRotationGracePeriodField = "attributes.rotation_grace_period"
+	staticRotatorField             = "attributes.rotator"
+	staticExclusiveCheckoutField   = "attributes.exclusive_checkout"
+	staticRotateOnCheckinField     = "attributes.rotate_on_checkin"
+
+	filePathField            = "attributes.path"
+	fileAgeIdentityPathField = "attributes.age_identity_path"
 )
 
+// vaultStoreAuthFields maps the update mask paths of the Vault auth method
+// fields to the field names used by the vault repository. The auth method
+// is stored separately from the credential store so these paths are not
+// handled by the mask manager.
+var vaultStoreAuthFields = map[string]string{
+	vaultAuthMethodField:    vault.AuthMethodField,
+	vaultAuthMountPathField: vault.AuthMountPathField,
+	vaultAuthRoleField:      vault.AuthRoleField,
+	vaultAuthSecretField:    vault.AuthSecretField,
+}
+
+// staticStoreRotationFields maps the update mask paths of the static
+// credential store rotation policy fields to the field names used by the
+// static repository.
+var staticStoreRotationFields = map[string]string{
+	staticRotationPeriodField:      static.RotationPeriodField,
+	staticRotationGracePeriodField: static.RotationGracePeriodField,
+	staticRotatorField:             static.RotatorField,
+	staticExclusiveCheckoutField:   static.ExclusiveCheckoutField,
+	staticRotateOnCheckinField:     static.RotateOnCheckinField,
+}
+
 var (
-	maskManager handlers.MaskManager
+	maskManager     handlers.MaskManager
+	fileMaskManager handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -72,6 +114,9 @@ var (
 	staticCollectionTypeMap = map[resource.Type]action.ActionSet{
 		resource.Credential: credentials.CollectionActions,
 	}
+	fileCollectionTypeMap = map[resource.Type]action.ActionSet{
+		resource.CredentialLibrary: credentiallibraries.CollectionActions,
+	}
 	validateVaultWorkerFilterFn = vaultWorkerFilterUnsupported
 	vaultWorkerFilterToProto    = false
 )
@@ -89,6 +134,13 @@ func init() {
 	); err != nil {
 		panic(err)
 	}
+	if fileMaskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{&filestore.CredentialStore{}},
+		handlers.MaskSource{&pb.CredentialStore{}, &pb.FileCredentialStoreAttributes{}},
+	); err != nil {
+		panic(err)
+	}
 
 	// TODO: refactor to remove IdActionsMap and CollectionActions package variables
 	action.RegisterResource(resource.CredentialStore, IdActions, CollectionActions)
@@ -101,6 +153,7 @@ type Service struct {
 	iamRepoFn    common.IamRepoFactory
 	vaultRepoFn  common.VaultCredentialRepoFactory
 	staticRepoFn common.StaticCredentialRepoFactory
+	fileRepoFn   common.FileCredentialRepoFactory
 	storeRepoFn  common.CredentialStoreRepoFactory
 	maxPageSize  uint
 }
@@ -113,6 +166,7 @@ func NewService(
 	iamRepo common.IamRepoFactory,
 	vaultRepo common.VaultCredentialRepoFactory,
 	staticRepo common.StaticCredentialRepoFactory,
+	fileRepo common.FileCredentialRepoFactory,
 	storeRepoFn common.CredentialStoreRepoFactory,
 	maxPageSize uint,
 ) (Service, error) {
@@ -126,6 +180,9 @@ func NewService(
 	if staticRepo == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static credential repository")
 	}
+	if fileRepo == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing file credential repository")
+	}
 	if storeRepoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing credential store repo")
 	}
@@ -136,6 +193,7 @@ func NewService(
 		iamRepoFn:    iamRepo,
 		vaultRepoFn:  vaultRepo,
 		staticRepoFn: staticRepo,
+		fileRepoFn:   fileRepo,
 		storeRepoFn:  storeRepoFn,
 		maxPageSize:  maxPageSize,
 	}, nil
@@ -476,6 +534,19 @@ func (s Service) getFromRepo(ctx context.Context, id string) (credential.Store,
 		if cs != nil {
 			return cs, nil
 		}
+
+	case file.Subtype:
+		repo, err := s.fileRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cs, err := repo.LookupCredentialStore(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if cs != nil {
+			return cs, nil
+		}
 	}
 
 	return nil, handlers.NotFoundErrorf("credential store %q not found", id)
@@ -515,6 +586,21 @@ func (s Service) createInRepo(ctx context.Context, projId string, item *pb.Crede
 		}
 		return out, nil
 
+	case file.Subtype.String():
+		cs, err := toStorageFileStore(ctx, projId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.fileRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, err := repo.CreateCredentialStore(ctx, cs)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+		}
+		return out, nil
+
 	default:
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential store, unknown type.")
 	}
@@ -527,6 +613,17 @@ func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []str
 	var rowsUpdated int
 
 	dbMask := maskManager.Translate(mask)
+	if globals.ResourceInfoFromPrefix(id).Subtype == file.Subtype {
+		dbMask = fileMaskManager.Translate(mask)
+	}
+	for _, path := range mask {
+		if f, ok := vaultStoreAuthFields[path]; ok {
+			dbMask = append(dbMask, f)
+		}
+		if f, ok := staticStoreRotationFields[path]; ok {
+			dbMask = append(dbMask, f)
+		}
+	}
 	if len(dbMask) == 0 {
 		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
 	}
@@ -563,6 +660,22 @@ func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []str
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
 		}
+
+	case file.Subtype:
+		cs, err := toStorageFileStore(ctx, projId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cs.PublicId = id
+
+		repo, err := s.fileRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, rowsUpdated, err = repo.UpdateCredentialStore(ctx, cs, item.GetVersion(), dbMask)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
+		}
 	}
 	if rowsUpdated == 0 {
 		return nil, handlers.NotFoundErrorf("Credential Store %q doesn't exist or incorrect version provided.", id)
@@ -600,6 +713,19 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 			}
 			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete credential store"))
 		}
+
+	case file.Subtype:
+		repo, err := s.fileRepoFn()
+		if err != nil {
+			return false, err
+		}
+		rows, err = repo.DeleteCredentialStore(ctx, id)
+		if err != nil {
+			if errors.IsNotFoundError(err) {
+				return false, nil
+			}
+			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete credential store"))
+		}
 	}
 	return rows > 0, nil
 }
@@ -621,6 +747,11 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 		res.Error = err
 		return res
 	}
+	fileRepo, err := s.fileRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
 
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.CredentialStore), auth.WithAction(a)}
@@ -662,6 +793,18 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 				return res
 			}
 			parentId = cs.GetProjectId()
+
+		case file.Subtype:
+			cs, err := fileRepo.LookupCredentialStore(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if cs == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			parentId = cs.GetProjectId()
 		}
 		opts = append(opts, auth.WithId(id))
 	}
@@ -784,10 +927,58 @@ func toProto(ctx context.Context, in credential.Store, opt ...handlers.Option) (
 				}
 				attrs.ClientCertificateKeyHmac = base64.RawURLEncoding.EncodeToString(cc.GetCertificateKeyHmac())
 			}
+			if auth := vaultIn.StoreAuth(); auth != nil {
+				attrs.AuthMethod = wrapperspb.String(auth.Method)
+				attrs.AuthMountPath = wrapperspb.String(auth.MountPath)
+				attrs.AuthRole = wrapperspb.String(auth.Role)
+				if len(auth.SecretHmac) != 0 {
+					attrs.AuthSecretHmac = base64.RawURLEncoding.EncodeToString(auth.SecretHmac)
+				}
+			}
 
 			out.Attrs = &pb.CredentialStore_VaultCredentialStoreAttributes{
 				VaultCredentialStoreAttributes: attrs,
 			}
+		case static.Subtype:
+			staticIn, ok := in.(*static.CredentialStore)
+			if !ok {
+				return nil, errors.New(ctx, errors.Internal, op, "unable to cast to static credential store")
+			}
+			if staticIn.GetRotationPeriod() == 0 && staticIn.GetRotator() == "" && !staticIn.GetExclusiveCheckout() {
+				// Static credential stores without a rotation or
+				// checkout policy have no attributes.
+				break
+			}
+			attrs := &pb.StaticCredentialStoreAttributes{
+				RotationPeriod:      wrapperspb.UInt32(staticIn.GetRotationPeriod()),
+				RotationGracePeriod: wrapperspb.UInt32(staticIn.GetRotationGracePeriod()),
+			}
+			if staticIn.GetRotator() != "" {
+				attrs.Rotator = wrapperspb.String(staticIn.GetRotator())
+			}
+			if staticIn.GetExclusiveCheckout() {
+				attrs.ExclusiveCheckout = wrapperspb.Bool(true)
+			}
+			if staticIn.GetRotateOnCheckin() {
+				attrs.RotateOnCheckin = wrapperspb.Bool(true)
+			}
+			out.Attrs = &pb.CredentialStore_StaticCredentialStoreAttributes{
+				StaticCredentialStoreAttributes: attrs,
+			}
+		case file.Subtype:
+			fileIn, ok := in.(*file.CredentialStore)
+			if !ok {
+				return nil, errors.New(ctx, errors.Internal, op, "unable to cast to file credential store")
+			}
+			attrs := &pb.FileCredentialStoreAttributes{
+				Path: wrapperspb.String(fileIn.GetPath()),
+			}
+			if fileIn.GetAgeIdentityPath() != "" {
+				attrs.AgeIdentityPath = wrapperspb.String(fileIn.GetAgeIdentityPath())
+			}
+			out.Attrs = &pb.CredentialStore_FileCredentialStoreAttributes{
+				FileCredentialStoreAttributes: attrs,
+			}
 		}
 	}
 	return &out, nil
@@ -802,6 +993,23 @@ func toStorageStaticStore(ctx context.Context, scopeId string, in *pb.Credential
 	if in.GetDescription() != nil {
 		opts = append(opts, static.WithDescription(in.GetDescription().GetValue()))
 	}
+	if attrs := in.GetStaticCredentialStoreAttributes(); attrs != nil {
+		if attrs.GetRotationPeriod() != nil {
+			opts = append(opts, static.WithRotationPeriod(attrs.GetRotationPeriod().GetValue()))
+		}
+		if attrs.GetRotationGracePeriod() != nil {
+			opts = append(opts, static.WithRotationGracePeriod(attrs.GetRotationGracePeriod().GetValue()))
+		}
+		if attrs.GetRotator() != nil {
+			opts = append(opts, static.WithRotator(attrs.GetRotator().GetValue()))
+		}
+		if attrs.GetExclusiveCheckout() != nil {
+			opts = append(opts, static.WithExclusiveCheckout(attrs.GetExclusiveCheckout().GetValue()))
+		}
+		if attrs.GetRotateOnCheckin() != nil {
+			opts = append(opts, static.WithRotateOnCheckin(attrs.GetRotateOnCheckin().GetValue()))
+		}
+	}
 
 	cs, err := static.NewCredentialStore(scopeId, opts...)
 	if err != nil {
@@ -810,6 +1018,27 @@ func toStorageStaticStore(ctx context.Context, scopeId string, in *pb.Credential
 	return cs, err
 }
 
+func toStorageFileStore(ctx context.Context, scopeId string, in *pb.CredentialStore) (out *file.CredentialStore, err error) {
+	const op = "credentialstores.toStorageFileStore"
+	var opts []file.Option
+	if in.GetName() != nil {
+		opts = append(opts, file.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, file.WithDescription(in.GetDescription().GetValue()))
+	}
+	attrs := in.GetFileCredentialStoreAttributes()
+	if attrs.GetAgeIdentityPath() != nil {
+		opts = append(opts, file.WithAgeIdentityPath(attrs.GetAgeIdentityPath().GetValue()))
+	}
+
+	cs, err := file.NewCredentialStore(scopeId, attrs.GetPath().GetValue(), opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build credential store for creation"))
+	}
+	return cs, err
+}
+
 func toStorageVaultStore(ctx context.Context, scopeId string, in *pb.CredentialStore) (out *vault.CredentialStore, err error) {
 	const op = "credentialstores.toStorageVaultStore"
 	var opts []vault.Option
@@ -858,6 +1087,16 @@ func toStorageVaultStore(ctx context.Context, scopeId string, in *pb.CredentialS
 		}
 		opts = append(opts, vault.WithClientCert(cc))
 	}
+	if attrs.GetAuthMethod() != nil || attrs.GetAuthMountPath() != nil || attrs.GetAuthRole() != nil || attrs.GetAuthSecret() != nil {
+		// The auth method is validated by the repository since an update
+		// may only contain some of its fields.
+		opts = append(opts, vault.WithStoreAuth(&vault.StoreAuth{
+			Method:    attrs.GetAuthMethod().GetValue(),
+			MountPath: strings.Trim(attrs.GetAuthMountPath().GetValue(), "/"),
+			Role:      attrs.GetAuthRole().GetValue(),
+			Secret:    vault.AuthSecret(attrs.GetAuthSecret().GetValue()),
+		}))
+	}
 
 	cs, err := vault.NewCredentialStore(scopeId, attrs.GetAddress().GetValue(), []byte(attrs.GetToken().GetValue()), opts...)
 	if err != nil {
@@ -872,7 +1111,7 @@ func toStorageVaultStore(ctx context.Context, scopeId string, in *pb.CredentialS
 //   - All required parameters are set
 //   - There are no conflicting parameters provided
 func validateGetRequest(req *pbs.GetCredentialStoreRequest) error {
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix, globals.FileCredentialStorePrefix)
 }
 
 func validateCreateRequest(ctx context.Context, req *pbs.CreateCredentialStoreRequest) error {
@@ -891,12 +1130,23 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateCredentialStoreRe
 			if attrs.GetAddress().GetValue() == "" {
 				badFields[globals.AttributesAddressField] = "Field required for creating a vault credential store."
 			}
-			if attrs.GetToken().GetValue() == "" {
-				badFields[vaultTokenField] = "Field required for creating a vault credential store."
+			switch {
+			case attrs.GetToken().GetValue() == "" && attrs.GetAuthMethod().GetValue() == "":
+				badFields[vaultTokenField] = "Either this field or the auth method is required for creating a vault credential store."
+			case attrs.GetToken().GetValue() != "" && attrs.GetAuthMethod().GetValue() != "":
+				badFields[vaultTokenField] = "This field cannot be set along with the auth method."
 			}
 			if attrs.GetTokenHmac() != "" {
 				badFields[vaultTokenHmacField] = "This is a read only field."
 			}
+			if attrs.GetAuthMethod().GetValue() != "" {
+				validateVaultStoreAuth(attrs, badFields)
+			} else if attrs.GetAuthMountPath() != nil || attrs.GetAuthRole() != nil || attrs.GetAuthSecret() != nil {
+				badFields[vaultAuthMethodField] = "Field required when setting the auth method fields."
+			}
+			if attrs.GetAuthSecretHmac() != "" {
+				badFields[vaultAuthSecretHmacField] = "This is a read only field."
+			}
 			if attrs.GetWorkerFilter().GetValue() != "" {
 				err := validateVaultWorkerFilterFn(attrs.WorkerFilter.GetValue())
 				if err != nil {
@@ -920,7 +1170,19 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateCredentialStoreRe
 				badFields[clientCertField] = "Cannot set a client certificate without a private key."
 			}
 		case static.Subtype.String():
-			// No additional validation required for static credential store
+			attrs := req.GetItem().GetStaticCredentialStoreAttributes()
+			if attrs.GetRotationPeriod().GetValue() > 0 && attrs.GetRotator().GetValue() == "" {
+				badFields[staticRotatorField] = "Field required when the rotation period is set."
+			}
+			if attrs.GetRotateOnCheckin().GetValue() && attrs.GetRotator().GetValue() == "" {
+				badFields[staticRotatorField] = "Field required when rotate on checkin is set."
+			}
+		case file.Subtype.String():
+			attrs := req.GetItem().GetFileCredentialStoreAttributes()
+			if attrs == nil {
+				badFields[globals.AttributesField] = "Attributes are required for creating a file credential store"
+			}
+			validateFileStorePaths(attrs, true, badFields)
 		default:
 			badFields[globals.TypeField] = "This is a required field and must be a known credential store type."
 		}
@@ -949,6 +1211,13 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateCredentialStoreRe
 				if attrs.GetTokenHmac() != "" {
 					badFields[vaultTokenHmacField] = "This is a read only field."
 				}
+				if attrs.GetAuthSecretHmac() != "" {
+					badFields[vaultAuthSecretHmacField] = "This is a read only field."
+				}
+				if handlers.MaskContains(req.GetUpdateMask().GetPaths(), vaultAuthMethodField) &&
+					attrs.GetAuthMethod().GetValue() != "" {
+					validateVaultStoreAuth(attrs, badFields)
+				}
 				if attrs.WorkerFilter.GetValue() != "" {
 					err := validateVaultWorkerFilterFn(attrs.WorkerFilter.GetValue())
 					if err != nil {
@@ -967,13 +1236,71 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateCredentialStoreRe
 					badFields[clientCertField] = fmt.Sprintf("Invalid values: %q", err.Error())
 				}
 			}
+		case static.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != static.Subtype.String() {
+				badFields["type"] = "Cannot modify resource type."
+			}
+			attrs := req.GetItem().GetStaticCredentialStoreAttributes()
+			paths := req.GetUpdateMask().GetPaths()
+			if handlers.MaskContains(paths, staticRotationPeriodField) &&
+				attrs.GetRotationPeriod().GetValue() > 0 &&
+				handlers.MaskContains(paths, staticRotatorField) &&
+				attrs.GetRotator().GetValue() == "" {
+				badFields[staticRotatorField] = "Field required when the rotation period is set."
+			}
+			if handlers.MaskContains(paths, staticRotateOnCheckinField) &&
+				attrs.GetRotateOnCheckin().GetValue() &&
+				handlers.MaskContains(paths, staticRotatorField) &&
+				attrs.GetRotator().GetValue() == "" {
+				badFields[staticRotatorField] = "Field required when rotate on checkin is set."
+			}
+		case file.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != file.Subtype.String() {
+				badFields["type"] = "Cannot modify resource type."
+			}
+			validateFileStorePaths(req.GetItem().GetFileCredentialStoreAttributes(),
+				handlers.MaskContains(req.GetUpdateMask().GetPaths(), filePathField), badFields)
 		}
 		return badFields
-	}, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix)
+	}, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix, globals.FileCredentialStorePrefix)
+}
+
+// validateVaultStoreAuth validates the Vault auth method fields of attrs
+// when the auth method is set.
+func validateVaultStoreAuth(attrs *pb.VaultCredentialStoreAttributes, badFields map[string]string) {
+	method := vault.AuthMethod(attrs.GetAuthMethod().GetValue())
+	switch method {
+	case vault.AppRoleAuthMethod, vault.JwtAuthMethod, vault.KubernetesAuthMethod:
+	default:
+		badFields[vaultAuthMethodField] = fmt.Sprintf("Unknown auth method %q, must be one of %q, %q, or %q.",
+			method, vault.AppRoleAuthMethod, vault.JwtAuthMethod, vault.KubernetesAuthMethod)
+		return
+	}
+	if attrs.GetAuthRole().GetValue() == "" {
+		badFields[vaultAuthRoleField] = "Field required when setting the auth method."
+	}
+	if attrs.GetAuthSecret().GetValue() == "" && method != vault.KubernetesAuthMethod {
+		badFields[vaultAuthSecretField] = fmt.Sprintf("Field required for the %q auth method.", method)
+	}
+}
+
+// validateFileStorePaths validates the paths of the file credential store
+// attributes. The bundle path is only validated if requirePath is true.
+func validateFileStorePaths(attrs *pb.FileCredentialStoreAttributes, requirePath bool, badFields map[string]string) {
+	switch p := attrs.GetPath().GetValue(); {
+	case !requirePath:
+	case p == "":
+		badFields[filePathField] = "This is a required field."
+	case !filepath.IsAbs(p):
+		badFields[filePathField] = "Must be an absolute path."
+	}
+	if attrs.GetAgeIdentityPath() != nil && !filepath.IsAbs(attrs.GetAgeIdentityPath().GetValue()) {
+		badFields[fileAgeIdentityPathField] = "Must be an absolute path."
+	}
 }
 
 func validateDeleteRequest(req *pbs.DeleteCredentialStoreRequest) error {
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix, globals.FileCredentialStorePrefix)
 }
 
 func validateListRequest(ctx context.Context, req *pbs.ListCredentialStoresRequest) error {
@@ -1000,6 +1327,9 @@ func calculateAuthorizedCollectionActions(ctx context.Context, authResults auth.
 
 	case static.Subtype:
 		collectionActions, err = auth.CalculateAuthorizedCollectionActions(ctx, authResults, staticCollectionTypeMap, authResults.Scope.Id, id)
+
+	case file.Subtype:
+		collectionActions, err = auth.CalculateAuthorizedCollectionActions(ctx, authResults, fileCollectionTypeMap, authResults.Scope.Id, id)
 	}
 	if err != nil {
 		return nil, err
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/file"
 	"github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/static/store"
 	"github.com/hashicorp/boundary/internal/credential/vault"
@@ -66,6 +67,14 @@ var (
 			},
 		},
 	}
+	testAuthorizedFileCollectionActions = map[string]*structpb.ListValue{
+		"credential-libraries": {
+			Values: []*structpb.Value{
+				structpb.NewStringValue("create"),
+				structpb.NewStringValue("list"),
+			},
+		},
+	}
 )
 
 func vaultCredentialStoreToProto(cs *vault.CredentialStore, prj *iam.Scope) *pb.CredentialStore {
@@ -125,6 +134,9 @@ func TestList(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	fileRepoFn := func() (*file.Repository, error) {
+		return file.NewRepository(ctx, rw, rw, kms)
+	}
 	credStoreRepoFn := func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(context.Background(), rw, rw)
 	}
@@ -278,7 +290,7 @@ func TestList(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreRepoFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, fileRepoFn, credStoreRepoFn, 1000)
 			require.NoError(t, err, "Couldn't create new host set service.")
 
 			// Test non-anonymous listing
@@ -338,6 +350,9 @@ func TestCreateVault(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	fileRepoFn := func() (*file.Repository, error) {
+		return file.NewRepository(ctx, rw, rw, kms)
+	}
 	credStoreServiceFn := func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(context.Background(), rw, rw)
 	}
@@ -364,6 +379,7 @@ func TestCreateVault(t *testing.T) {
 		_, token := v.CreateToken(t)
 		return token
 	}
+	roleId, secretId := v.MountAppRole(t)
 
 	cases := []struct {
 		name     string
@@ -373,6 +389,86 @@ func TestCreateVault(t *testing.T) {
 		err      error
 		wantErr  bool
 	}{
+		{
+			name: "Token and auth method",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    vault.Subtype.String(),
+				Attrs: &pb.CredentialStore_VaultCredentialStoreAttributes{
+					VaultCredentialStoreAttributes: &pb.VaultCredentialStoreAttributes{
+						Address:    wrapperspb.String(v.Addr),
+						Token:      wrapperspb.String(newToken()),
+						AuthMethod: wrapperspb.String("approle"),
+						AuthRole:   wrapperspb.String(roleId),
+						AuthSecret: wrapperspb.String(secretId),
+					},
+				},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Unknown auth method",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    vault.Subtype.String(),
+				Attrs: &pb.CredentialStore_VaultCredentialStoreAttributes{
+					VaultCredentialStoreAttributes: &pb.VaultCredentialStoreAttributes{
+						Address:    wrapperspb.String(v.Addr),
+						AuthMethod: wrapperspb.String("userpass"),
+						AuthRole:   wrapperspb.String(roleId),
+						AuthSecret: wrapperspb.String(secretId),
+					},
+				},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Approle auth method without secret",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    vault.Subtype.String(),
+				Attrs: &pb.CredentialStore_VaultCredentialStoreAttributes{
+					VaultCredentialStoreAttributes: &pb.VaultCredentialStoreAttributes{
+						Address:    wrapperspb.String(v.Addr),
+						AuthMethod: wrapperspb.String("approle"),
+						AuthRole:   wrapperspb.String(roleId),
+					},
+				},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Auth role without auth method",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    vault.Subtype.String(),
+				Attrs: &pb.CredentialStore_VaultCredentialStoreAttributes{
+					VaultCredentialStoreAttributes: &pb.VaultCredentialStoreAttributes{
+						Address:  wrapperspb.String(v.Addr),
+						Token:    wrapperspb.String(newToken()),
+						AuthRole: wrapperspb.String(roleId),
+					},
+				},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Read only auth secret hmac",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    vault.Subtype.String(),
+				Attrs: &pb.CredentialStore_VaultCredentialStoreAttributes{
+					VaultCredentialStoreAttributes: &pb.VaultCredentialStoreAttributes{
+						Address:        wrapperspb.String(v.Addr),
+						AuthMethod:     wrapperspb.String("approle"),
+						AuthRole:       wrapperspb.String(roleId),
+						AuthSecret:     wrapperspb.String(secretId),
+						AuthSecretHmac: "hmac",
+					},
+				},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name: "missing ca certificate",
 			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
@@ -605,6 +701,50 @@ func TestCreateVault(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Create a valid vault CredentialStore with an approle auth method",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    vault.Subtype.String(),
+				Attrs: &pb.CredentialStore_VaultCredentialStoreAttributes{
+					VaultCredentialStoreAttributes: &pb.VaultCredentialStoreAttributes{
+						Address:              wrapperspb.String(v.Addr),
+						CaCert:               wrapperspb.String(string(v.CaCert)),
+						ClientCertificate:    wrapperspb.String(string(v.ClientCert)),
+						ClientCertificateKey: wrapperspb.String(string(v.ClientKey)),
+						AuthMethod:           wrapperspb.String("approle"),
+						AuthRole:             wrapperspb.String(roleId),
+						AuthSecret:           wrapperspb.String(secretId),
+					},
+				},
+			}},
+			idPrefix: globals.VaultCredentialStorePrefix + "_",
+			res: &pbs.CreateCredentialStoreResponse{
+				Uri: fmt.Sprintf("credential-stores/%s_", globals.VaultCredentialStorePrefix),
+				Item: &pb.CredentialStore{
+					ScopeId: prj.GetPublicId(),
+					Scope:   &scopepb.ScopeInfo{Id: prj.GetPublicId(), Type: prj.GetType(), ParentScopeId: prj.GetParentId()},
+					Version: 1,
+					Type:    vault.Subtype.String(),
+					Attrs: &pb.CredentialStore_VaultCredentialStoreAttributes{
+						VaultCredentialStoreAttributes: &pb.VaultCredentialStoreAttributes{
+							CaCert:                   wrapperspb.String(string(v.CaCert)),
+							Address:                  wrapperspb.String(v.Addr),
+							TokenHmac:                "<hmac>",
+							TokenStatus:              "current",
+							ClientCertificate:        wrapperspb.String(string(v.ClientCert)),
+							ClientCertificateKeyHmac: "<hmac>",
+							AuthMethod:               wrapperspb.String("approle"),
+							AuthMountPath:            wrapperspb.String("approle"),
+							AuthRole:                 wrapperspb.String(roleId),
+							AuthSecretHmac:           "<hmac>",
+						},
+					},
+					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedCollectionActions: testAuthorizedVaultCollectionActions,
+				},
+			},
+		},
 		{
 			name: "Create a valid vault CredentialStore",
 			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
@@ -652,7 +792,7 @@ func TestCreateVault(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, fileRepoFn, credStoreServiceFn, 1000)
 			require.NoError(err, "Error when getting new credential store service.")
 			defer cleanup(s)
 
@@ -702,6 +842,10 @@ func TestCreateVault(t *testing.T) {
 					assert.NotEqual(tc.req.Item.GetVaultCredentialStoreAttributes().ClientCertificateKeyHmac, got.Item.GetVaultCredentialStoreAttributes().ClientCertificateKeyHmac)
 					cmpOptions = append(cmpOptions, protocmp.IgnoreFields(&pb.VaultCredentialStoreAttributes{}, "client_certificate_key_hmac"))
 				}
+				if got.Item.GetVaultCredentialStoreAttributes().AuthSecretHmac != "" {
+					assert.NotEqual(tc.req.Item.GetVaultCredentialStoreAttributes().GetAuthSecret().GetValue(), got.Item.GetVaultCredentialStoreAttributes().AuthSecretHmac)
+					cmpOptions = append(cmpOptions, protocmp.IgnoreFields(&pb.VaultCredentialStoreAttributes{}, "auth_secret_hmac"))
+				}
 			}
 			assert.Empty(cmp.Diff(got, tc.res, cmpOptions...), "CreateCredentialStore(%q) got response %q, wanted %q", tc.req, got, tc.res)
 		})
@@ -728,6 +872,9 @@ func TestCreateStatic(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	fileRepoFn := func() (*file.Repository, error) {
+		return file.NewRepository(ctx, rw, rw, kms)
+	}
 	credStoreServiceFn := func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(context.Background(), rw, rw)
 	}
@@ -833,12 +980,109 @@ func TestCreateStatic(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Rotation period without rotator",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    static.Subtype.String(),
+				Attrs: &pb.CredentialStore_StaticCredentialStoreAttributes{
+					StaticCredentialStoreAttributes: &pb.StaticCredentialStoreAttributes{
+						RotationPeriod: wrapperspb.UInt32(3600),
+					},
+				},
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Create a valid static CredentialStore with a rotation policy",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    static.Subtype.String(),
+				Attrs: &pb.CredentialStore_StaticCredentialStoreAttributes{
+					StaticCredentialStoreAttributes: &pb.StaticCredentialStoreAttributes{
+						RotationPeriod:      wrapperspb.UInt32(3600),
+						RotationGracePeriod: wrapperspb.UInt32(300),
+						Rotator:             wrapperspb.String("linux"),
+					},
+				},
+			}},
+			idPrefix: globals.StaticCredentialStorePrefix + "_",
+			res: &pbs.CreateCredentialStoreResponse{
+				Uri: fmt.Sprintf("credential-stores/%s_", globals.StaticCredentialStorePrefix),
+				Item: &pb.CredentialStore{
+					ScopeId:                     prj.GetPublicId(),
+					Scope:                       &scopepb.ScopeInfo{Id: prj.GetPublicId(), Type: prj.GetType(), ParentScopeId: prj.GetParentId()},
+					Version:                     1,
+					Type:                        static.Subtype.String(),
+					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedCollectionActions: testAuthorizedStaticCollectionActions,
+					Attrs: &pb.CredentialStore_StaticCredentialStoreAttributes{
+						StaticCredentialStoreAttributes: &pb.StaticCredentialStoreAttributes{
+							RotationPeriod:      wrapperspb.UInt32(3600),
+							RotationGracePeriod: wrapperspb.UInt32(300),
+							Rotator:             wrapperspb.String("linux"),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Rotate on checkin without rotator",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    static.Subtype.String(),
+				Attrs: &pb.CredentialStore_StaticCredentialStoreAttributes{
+					StaticCredentialStoreAttributes: &pb.StaticCredentialStoreAttributes{
+						ExclusiveCheckout: wrapperspb.Bool(true),
+						RotateOnCheckin:   wrapperspb.Bool(true),
+					},
+				},
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Create a valid static CredentialStore with exclusive checkout",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    static.Subtype.String(),
+				Attrs: &pb.CredentialStore_StaticCredentialStoreAttributes{
+					StaticCredentialStoreAttributes: &pb.StaticCredentialStoreAttributes{
+						Rotator:           wrapperspb.String("linux"),
+						ExclusiveCheckout: wrapperspb.Bool(true),
+						RotateOnCheckin:   wrapperspb.Bool(true),
+					},
+				},
+			}},
+			idPrefix: globals.StaticCredentialStorePrefix + "_",
+			res: &pbs.CreateCredentialStoreResponse{
+				Uri: fmt.Sprintf("credential-stores/%s_", globals.StaticCredentialStorePrefix),
+				Item: &pb.CredentialStore{
+					ScopeId:                     prj.GetPublicId(),
+					Scope:                       &scopepb.ScopeInfo{Id: prj.GetPublicId(), Type: prj.GetType(), ParentScopeId: prj.GetParentId()},
+					Version:                     1,
+					Type:                        static.Subtype.String(),
+					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedCollectionActions: testAuthorizedStaticCollectionActions,
+					Attrs: &pb.CredentialStore_StaticCredentialStoreAttributes{
+						StaticCredentialStoreAttributes: &pb.StaticCredentialStoreAttributes{
+							RotationPeriod:      wrapperspb.UInt32(0),
+							RotationGracePeriod: wrapperspb.UInt32(0),
+							Rotator:             wrapperspb.String("linux"),
+							ExclusiveCheckout:   wrapperspb.Bool(true),
+							RotateOnCheckin:     wrapperspb.Bool(true),
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, fileRepoFn, credStoreServiceFn, 1000)
 			require.NoError(err, "Error when getting new credential store service.")
 			defer cleanup(s)
 
@@ -885,6 +1129,161 @@ func TestCreateStatic(t *testing.T) {
 	}
 }
 
+func TestCreateFile(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	vaultRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	staticRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(ctx, rw, rw, kms)
+	}
+	fileRepoFn := func() (*file.Repository, error) {
+		return file.NewRepository(ctx, rw, rw, kms, file.WithAllowedDirectories("/etc/boundary"))
+	}
+	credStoreServiceFn := func() (*credential.StoreRepository, error) {
+		return credential.NewStoreRepository(context.Background(), rw, rw)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+
+	cleanup := func(s Service) {
+		ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
+		r, err := s.ListCredentialStores(ctx, &pbs.ListCredentialStoresRequest{ScopeId: prj.GetPublicId()})
+		require.NoError(t, err)
+		for _, i := range r.GetItems() {
+			_, err := s.DeleteCredentialStore(ctx, &pbs.DeleteCredentialStoreRequest{Id: i.GetId()})
+			require.NoError(t, err)
+		}
+	}
+
+	cases := []struct {
+		name     string
+		req      *pbs.CreateCredentialStoreRequest
+		res      *pbs.CreateCredentialStoreResponse
+		idPrefix string
+		err      error
+	}{
+		{
+			name: "Must specify path",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    file.Subtype.String(),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Path must be absolute",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    file.Subtype.String(),
+				Attrs: &pb.CredentialStore_FileCredentialStoreAttributes{
+					FileCredentialStoreAttributes: &pb.FileCredentialStoreAttributes{
+						Path: wrapperspb.String("bundle.yaml"),
+					},
+				},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Age identity path must be absolute",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    file.Subtype.String(),
+				Attrs: &pb.CredentialStore_FileCredentialStoreAttributes{
+					FileCredentialStoreAttributes: &pb.FileCredentialStoreAttributes{
+						Path:            wrapperspb.String("/etc/boundary/bundle.yaml.age"),
+						AgeIdentityPath: wrapperspb.String("identity.txt"),
+					},
+				},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Path must be within an allowed directory",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    file.Subtype.String(),
+				Attrs: &pb.CredentialStore_FileCredentialStoreAttributes{
+					FileCredentialStoreAttributes: &pb.FileCredentialStoreAttributes{
+						Path: wrapperspb.String("/etc/boundary/../shadow"),
+					},
+				},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Create a valid file CredentialStore",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId:     prj.GetPublicId(),
+				Name:        &wrapperspb.StringValue{Value: "name"},
+				Description: &wrapperspb.StringValue{Value: "desc"},
+				Type:        file.Subtype.String(),
+				Attrs: &pb.CredentialStore_FileCredentialStoreAttributes{
+					FileCredentialStoreAttributes: &pb.FileCredentialStoreAttributes{
+						Path:            wrapperspb.String("/etc/boundary/bundle.yaml.age"),
+						AgeIdentityPath: wrapperspb.String("/etc/boundary/identity.txt"),
+					},
+				},
+			}},
+			idPrefix: globals.FileCredentialStorePrefix + "_",
+			res: &pbs.CreateCredentialStoreResponse{
+				Uri: fmt.Sprintf("credential-stores/%s_", globals.FileCredentialStorePrefix),
+				Item: &pb.CredentialStore{
+					ScopeId:                     prj.GetPublicId(),
+					Scope:                       &scopepb.ScopeInfo{Id: prj.GetPublicId(), Type: prj.GetType(), ParentScopeId: prj.GetParentId()},
+					Version:                     1,
+					Type:                        file.Subtype.String(),
+					AuthorizedActions:           testAuthorizedActions,
+					Name:                        &wrapperspb.StringValue{Value: "name"},
+					Description:                 &wrapperspb.StringValue{Value: "desc"},
+					AuthorizedCollectionActions: testAuthorizedFileCollectionActions,
+					Attrs: &pb.CredentialStore_FileCredentialStoreAttributes{
+						FileCredentialStoreAttributes: &pb.FileCredentialStoreAttributes{
+							Path:            wrapperspb.String("/etc/boundary/bundle.yaml.age"),
+							AgeIdentityPath: wrapperspb.String("/etc/boundary/identity.txt"),
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, fileRepoFn, credStoreServiceFn, 1000)
+			require.NoError(err, "Error when getting new credential store service.")
+			defer cleanup(s)
+
+			got, gErr := s.CreateCredentialStore(auth.DisabledAuthTestContext(iamRepoFn, tc.req.GetItem().GetScopeId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "CreateCredentialStore(...) got error %v, wanted %v", gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Contains(got.GetUri(), tc.res.Uri)
+			assert.True(strings.HasPrefix(got.GetItem().GetId(), tc.idPrefix))
+			assert.Empty(cmp.Diff(got, tc.res,
+				protocmp.Transform(),
+				protocmp.SortRepeatedFields(got),
+				protocmp.IgnoreFields(&pbs.CreateCredentialStoreResponse{}, "uri"),
+				protocmp.IgnoreFields(&pb.CredentialStore{}, "id", "created_time", "updated_time"),
+			), "CreateCredentialStore(%q) got response %q, wanted %q", tc.req, got, tc.res)
+		})
+	}
+}
+
 func TestGet(t *testing.T) {
 	ctx := context.Background()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -903,6 +1302,9 @@ func TestGet(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	fileRepoFn := func() (*file.Repository, error) {
+		return file.NewRepository(ctx, rw, rw, kms)
+	}
 	credStoreServiceFn := func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(context.Background(), rw, rw)
 	}
@@ -912,7 +1314,7 @@ func TestGet(t *testing.T) {
 	vaultStore := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
 	staticStore := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
 	staticStorePrev := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId(), static.WithPublicId(fmt.Sprintf("%s_1234567890", globals.StaticCredentialStorePreviousPrefix)))
-	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, fileRepoFn, credStoreServiceFn, 1000)
 	require.NoError(t, err)
 
 	cases := []struct {
@@ -1050,6 +1452,9 @@ func TestDelete(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	fileRepoFn := func() (*file.Repository, error) {
+		return file.NewRepository(ctx, rw, rw, kms)
+	}
 	credStoreServiceFn := func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(context.Background(), rw, rw)
 	}
@@ -1058,7 +1463,7 @@ func TestDelete(t *testing.T) {
 
 	vaultStore := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 2)[0]
 	staticStore := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
-	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, fileRepoFn, credStoreServiceFn, 1000)
 	require.NoError(t, err)
 
 	cases := []struct {
@@ -1128,6 +1533,9 @@ func TestUpdateVault(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(testCtx, rw, rw, kms)
 	}
+	fileRepoFn := func() (*file.Repository, error) {
+		return file.NewRepository(testCtx, rw, rw, kms)
+	}
 	credStoreServiceFn := func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(context.Background(), rw, rw)
 	}
@@ -1135,7 +1543,7 @@ func TestUpdateVault(t *testing.T) {
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
 
-	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, fileRepoFn, credStoreServiceFn, 1000)
 	require.NoError(t, err)
 
 	fieldmask := func(paths ...string) *fieldmaskpb.FieldMask {
@@ -1475,6 +1883,9 @@ func TestUpdateStatic(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(testCtx, rw, rw, kms)
 	}
+	fileRepoFn := func() (*file.Repository, error) {
+		return file.NewRepository(testCtx, rw, rw, kms)
+	}
 	credStoreServiceFn := func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(context.Background(), rw, rw)
 	}
@@ -1482,7 +1893,7 @@ func TestUpdateStatic(t *testing.T) {
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
 
-	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, fileRepoFn, credStoreServiceFn, 1000)
 	require.NoError(t, err)
 
 	fieldmask := func(paths ...string) *fieldmaskpb.FieldMask {
@@ -1548,6 +1959,56 @@ func TestUpdateStatic(t *testing.T) {
 				return out
 			},
 		},
+		{
+			name: "rotation-policy",
+			req: &pbs.UpdateCredentialStoreRequest{
+				UpdateMask: fieldmask("attributes.rotation_period", "attributes.rotation_grace_period", "attributes.rotator"),
+				Item: &pb.CredentialStore{
+					Attrs: &pb.CredentialStore_StaticCredentialStoreAttributes{
+						StaticCredentialStoreAttributes: &pb.StaticCredentialStoreAttributes{
+							RotationPeriod:      wrapperspb.UInt32(3600),
+							RotationGracePeriod: wrapperspb.UInt32(300),
+							Rotator:             wrapperspb.String("linux"),
+						},
+					},
+				},
+			},
+			res: func(in *pb.CredentialStore) *pb.CredentialStore {
+				out := proto.Clone(in).(*pb.CredentialStore)
+				out.Attrs = &pb.CredentialStore_StaticCredentialStoreAttributes{
+					StaticCredentialStoreAttributes: &pb.StaticCredentialStoreAttributes{
+						RotationPeriod:      wrapperspb.UInt32(3600),
+						RotationGracePeriod: wrapperspb.UInt32(300),
+						Rotator:             wrapperspb.String("linux"),
+					},
+				}
+				return out
+			},
+		},
+		{
+			name: "exclusive-checkout",
+			req: &pbs.UpdateCredentialStoreRequest{
+				UpdateMask: fieldmask("attributes.exclusive_checkout"),
+				Item: &pb.CredentialStore{
+					Attrs: &pb.CredentialStore_StaticCredentialStoreAttributes{
+						StaticCredentialStoreAttributes: &pb.StaticCredentialStoreAttributes{
+							ExclusiveCheckout: wrapperspb.Bool(true),
+						},
+					},
+				},
+			},
+			res: func(in *pb.CredentialStore) *pb.CredentialStore {
+				out := proto.Clone(in).(*pb.CredentialStore)
+				out.Attrs = &pb.CredentialStore_StaticCredentialStoreAttributes{
+					StaticCredentialStoreAttributes: &pb.StaticCredentialStoreAttributes{
+						RotationPeriod:      wrapperspb.UInt32(0),
+						RotationGracePeriod: wrapperspb.UInt32(0),
+						ExclusiveCheckout:   wrapperspb.Bool(true),
+					},
+				}
+				return out
+			},
+		},
 	}
 
 	for _, tc := range successCases {
@@ -1673,6 +2134,9 @@ func TestListPagination(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	fileRepoFn := func() (*file.Repository, error) {
+		return file.NewRepository(ctx, rw, rw, kms)
+	}
 	tokenRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, rw, rw, kms)
 	}
@@ -1726,7 +2190,7 @@ func TestListPagination(t *testing.T) {
 	requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
 	ctx = auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kms, &requestInfo)
 
-	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, fileRepoFn, credStoreServiceFn, 1000)
 	require.NoError(err)
 
 	// Start paginating, recursively
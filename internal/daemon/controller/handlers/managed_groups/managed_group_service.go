@@ -14,6 +14,8 @@ import (
 	ldapstore "github.com/hashicorp/boundary/internal/auth/ldap/store"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	oidcstore "github.com/hashicorp/boundary/internal/auth/oidc/store"
+	"github.com/hashicorp/boundary/internal/auth/saml"
+	samlstore "github.com/hashicorp/boundary/internal/auth/saml/store"
 	requestauth "github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
@@ -44,6 +46,7 @@ const (
 var (
 	oidcMaskManager handlers.MaskManager
 	ldapMaskManager handlers.MaskManager
+	samlMaskManager handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -60,6 +63,12 @@ var (
 			action.Update,
 			action.Delete,
 		),
+		saml.Subtype: action.NewActionSet(
+			action.NoOp,
+			action.Read,
+			action.Update,
+			action.Delete,
+		),
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -86,6 +95,13 @@ func init() {
 	); err != nil {
 		panic(err)
 	}
+	if samlMaskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{&samlstore.ManagedGroup{}},
+		handlers.MaskSource{&pb.ManagedGroup{}, &pb.SamlManagedGroupAttributes{}},
+	); err != nil {
+		panic(err)
+	}
 
 	// TODO: refactor to remove IdActions and CollectionActions package variables
 	action.RegisterResource(resource.ManagedGroup, action.Union(maps.Values(IdActions)...), CollectionActions)
@@ -97,24 +113,27 @@ type Service struct {
 
 	oidcRepoFn  common.OidcAuthRepoFactory
 	ldapRepoFn  common.LdapAuthRepoFactory
+	samlRepoFn  common.SamlAuthRepoFactory
 	maxPageSize uint
 }
 
 var _ pbs.ManagedGroupServiceServer = (*Service)(nil)
 
 // NewService returns a managed group service which handles managed group related requests to boundary.
-func NewService(ctx context.Context, oidcRepo common.OidcAuthRepoFactory, ldapRepo common.LdapAuthRepoFactory, maxPageSize uint) (Service, error) {
+func NewService(ctx context.Context, oidcRepo common.OidcAuthRepoFactory, ldapRepo common.LdapAuthRepoFactory, samlRepo common.SamlAuthRepoFactory, maxPageSize uint) (Service, error) {
 	const op = "managed_groups.NewService"
 	switch {
 	case oidcRepo == nil:
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing oidc repository provided")
 	case ldapRepo == nil:
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing ldap repository provided")
+	case samlRepo == nil:
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing saml repository provided")
 	}
 	if maxPageSize == 0 {
 		maxPageSize = uint(globals.DefaultMaxPageSize)
 	}
-	return Service{oidcRepoFn: oidcRepo, ldapRepoFn: ldapRepo, maxPageSize: maxPageSize}, nil
+	return Service{oidcRepoFn: oidcRepo, ldapRepoFn: ldapRepo, samlRepoFn: samlRepo, maxPageSize: maxPageSize}, nil
 }
 
 // ListManagedGroups implements the interface pbs.ManagedGroupsServiceServer.
@@ -255,6 +274,45 @@ func (s Service) ListManagedGroups(ctx context.Context, req *pbs.ListManagedGrou
 				return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "unexpected list token subtype: %T", st)
 			}
 		}
+	case saml.Subtype:
+		repo, err := s.samlRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if req.GetListToken() == "" {
+			sortBy = "created_time"
+			listResp, err = saml.ListManagedGroups(ctx, grantsHash, pageSize, filterItemFn, repo, authMethodId)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			listToken, err := handlers.ParseListToken(ctx, req.GetListToken(), resource.ManagedGroup, grantsHash)
+			if err != nil {
+				return nil, err
+			}
+			switch st := listToken.Subtype.(type) {
+			case *listtoken.PaginationToken:
+				sortBy = "created_time"
+				listResp, err = saml.ListManagedGroupsPage(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, authMethodId)
+				if err != nil {
+					return nil, err
+				}
+			case *listtoken.StartRefreshToken:
+				sortBy = "updated_time"
+				listResp, err = saml.ListManagedGroupsRefresh(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, authMethodId)
+				if err != nil {
+					return nil, err
+				}
+			case *listtoken.RefreshToken:
+				sortBy = "updated_time"
+				listResp, err = saml.ListManagedGroupsRefreshPage(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, authMethodId)
+				if err != nil {
+					return nil, err
+				}
+			default:
+				return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "unexpected list token subtype: %T", st)
+			}
+		}
 	default:
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "unknown auth method type for id: %s", authMethodId)
 	}
@@ -480,6 +538,29 @@ func (s Service) getFromRepo(ctx context.Context, id string) (auth.ManagedGroup,
 			}
 		}
 		out = mg
+	case saml.Subtype:
+		repo, err := s.samlRepoFn()
+		if err != nil {
+			return nil, nil, err
+		}
+		mg, err := repo.LookupManagedGroup(ctx, id)
+		if err != nil {
+			if errors.IsNotFoundError(err) {
+				return nil, nil, handlers.NotFoundErrorf("ManagedGroup %q doesn't exist.", id)
+			}
+			return nil, nil, err
+		}
+		ids, err := repo.ListManagedGroupMembershipsByGroup(ctx, mg.GetPublicId())
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(ids) > 0 {
+			memberIds = make([]string, len(ids))
+			for i, v := range ids {
+				memberIds[i] = v.MemberId
+			}
+		}
+		out = mg
 	default:
 		return nil, nil, handlers.NotFoundErrorf("Unrecognized id.")
 	}
@@ -518,6 +599,38 @@ func (s Service) createOidcInRepo(ctx context.Context, am auth.AuthMethod, item
 	return out, nil
 }
 
+func (s Service) createSamlInRepo(ctx context.Context, am auth.AuthMethod, item *pb.ManagedGroup) (*saml.ManagedGroup, error) {
+	const op = "managed_groups.(Service).createSamlInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing item")
+	}
+	var opts []saml.Option
+	if item.GetName() != nil {
+		opts = append(opts, saml.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, saml.WithDescription(item.GetDescription().GetValue()))
+	}
+	attrs := item.GetSamlManagedGroupAttributes()
+	mg, err := saml.NewManagedGroup(ctx, am.GetPublicId(), attrs.GetFilter(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build managed group for creation: %v.", err)
+	}
+	repo, err := s.samlRepoFn()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := repo.CreateManagedGroup(ctx, am.GetScopeId(), mg)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create managed group"))
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create managed group but no error returned from repository.")
+	}
+	return out, nil
+}
+
 func (s Service) createLdapInRepo(ctx context.Context, am auth.AuthMethod, item *pb.ManagedGroup) (*ldap.ManagedGroup, error) {
 	const op = "managed_groups.(Service).createLdapInRepo"
 	if item == nil {
@@ -575,6 +688,15 @@ func (s Service) createInRepo(ctx context.Context, am auth.AuthMethod, item *pb.
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create ldap managed group but no error returned from repository.")
 		}
 		out = am
+	case saml.Subtype:
+		am, err := s.createSamlInRepo(ctx, am, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if am == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create managed group but no error returned from repository.")
+		}
+		out = am
 	}
 	return out, nil
 }
@@ -615,6 +737,42 @@ func (s Service) updateOidcInRepo(ctx context.Context, scopeId, amId, id string,
 	return out, nil
 }
 
+func (s Service) updateSamlInRepo(ctx context.Context, scopeId, amId, id string, mask []string, item *pb.ManagedGroup) (*saml.ManagedGroup, error) {
+	const op = "managed_groups.(Service).updateSamlInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil managed group.")
+	}
+	mg := saml.AllocManagedGroup()
+	mg.PublicId = id
+	if item.GetName() != nil {
+		mg.Name = item.GetName().GetValue()
+	}
+	if item.GetDescription() != nil {
+		mg.Description = item.GetDescription().GetValue()
+	}
+	// Set this regardless; it'll only take effect if the masks contain the value
+	mg.Filter = item.GetSamlManagedGroupAttributes().GetFilter()
+
+	version := item.GetVersion()
+
+	dbMask := samlMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.samlRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.UpdateManagedGroup(ctx, scopeId, mg, version, dbMask)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update managed group"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Managed Group %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
 func (s Service) updateLdapInRepo(ctx context.Context, scopeId, amId, id string, mask []string, item *pb.ManagedGroup) (*ldap.ManagedGroup, error) {
 	const op = "managed_groups.(Service).updateLdapInRepo"
 	if item == nil {
@@ -677,6 +835,15 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, authMethodId string,
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update managed group but no error returned from repository.")
 		}
 		out = mg
+	case saml.Subtype:
+		mg, err := s.updateSamlInRepo(ctx, scopeId, authMethodId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if mg == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update managed group but no error returned from repository.")
+		}
+		out = mg
 	}
 	return out, nil
 }
@@ -698,6 +865,12 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 			return false, iErr
 		}
 		rows, err = repo.DeleteManagedGroup(ctx, scopeId, id)
+	case saml.Subtype:
+		repo, iErr := s.samlRepoFn()
+		if iErr != nil {
+			return false, iErr
+		}
+		rows, err = repo.DeleteManagedGroup(ctx, scopeId, id)
 	}
 	if err != nil {
 		if errors.IsNotFoundError(err) {
@@ -721,6 +894,11 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 		res.Error = err
 		return nil, res
 	}
+	samlRepo, err := s.samlRepoFn()
+	if err != nil {
+		res.Error = err
+		return nil, res
+	}
 
 	var parentId string
 	opts := []requestauth.Option{requestauth.WithType(resource.ManagedGroup), requestauth.WithAction(a)}
@@ -751,6 +929,17 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 				return nil, res
 			}
 			parentId = grp.GetAuthMethodId()
+		case saml.Subtype:
+			grp, err := samlRepo.LookupManagedGroup(ctx, id)
+			if err != nil {
+				res.Error = err
+				return nil, res
+			}
+			if grp == nil {
+				res.Error = handlers.NotFoundError()
+				return nil, res
+			}
+			parentId = grp.GetAuthMethodId()
 		default:
 			res.Error = errors.New(ctx, errors.InvalidPublicId, op, "unrecognized managed group subtype")
 			return nil, res
@@ -784,6 +973,18 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 		}
 		authMeth = am
 		opts = append(opts, requestauth.WithScopeId(am.GetScopeId()))
+	case saml.Subtype:
+		am, err := samlRepo.LookupAuthMethod(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return nil, res
+		}
+		if am == nil {
+			res.Error = handlers.NotFoundError()
+			return nil, res
+		}
+		authMeth = am
+		opts = append(opts, requestauth.WithScopeId(am.GetScopeId()))
 	default:
 		res.Error = errors.New(ctx, errors.InvalidPublicId, op, "unrecognized auth method subtype")
 		return nil, res
@@ -844,6 +1045,19 @@ func toProto(ctx context.Context, in auth.ManagedGroup, opt ...handlers.Option)
 		out.Attrs = &pb.ManagedGroup_OidcManagedGroupAttributes{
 			OidcManagedGroupAttributes: attrs,
 		}
+	case *saml.ManagedGroup:
+		if outputFields.Has(globals.TypeField) {
+			out.Type = saml.Subtype.String()
+		}
+		if !outputFields.Has(globals.AttributesField) {
+			break
+		}
+		attrs := &pb.SamlManagedGroupAttributes{
+			Filter: i.GetFilter(),
+		}
+		out.Attrs = &pb.ManagedGroup_SamlManagedGroupAttributes{
+			SamlManagedGroupAttributes: attrs,
+		}
 	case *ldap.ManagedGroup:
 		if outputFields.Has(globals.TypeField) {
 			out.Type = ldap.Subtype.String()
@@ -877,7 +1091,7 @@ func validateGetRequest(ctx context.Context, req *pbs.GetManagedGroupRequest) er
 	if req == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
 	}
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.OidcManagedGroupPrefix, globals.LdapManagedGroupPrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.OidcManagedGroupPrefix, globals.LdapManagedGroupPrefix, globals.SamlManagedGroupPrefix)
 }
 
 func validateCreateRequest(ctx context.Context, req *pbs.CreateManagedGroupRequest) error {
@@ -919,6 +1133,22 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateManagedGroupReque
 					badFields[attrGroupNamesField] = "This field is required."
 				}
 			}
+		case saml.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != saml.Subtype.String() {
+				badFields[globals.TypeField] = "Doesn't match the parent resource's type."
+			}
+			attrs := req.GetItem().GetSamlManagedGroupAttributes()
+			if attrs == nil {
+				badFields[globals.AttributesField] = "Attribute fields is required."
+			} else {
+				if attrs.Filter == "" {
+					badFields[attrFilterField] = "This field is required."
+				} else {
+					if _, err := bexpr.CreateEvaluator(attrs.Filter); err != nil {
+						badFields[attrFilterField] = fmt.Sprintf("Error evaluating submitted filter expression: %v.", err)
+					}
+				}
+			}
 		default:
 			badFields[globals.AuthMethodIdField] = "Unknown auth method type from ID."
 		}
@@ -963,11 +1193,30 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateManagedGroupReque
 					badFields[attrFilterField] = "Field cannot be empty."
 				}
 			}
+		case saml.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != saml.Subtype.String() {
+				badFields[globals.TypeField] = "Cannot modify the resource type."
+			}
+			attrs := req.GetItem().GetSamlManagedGroupAttributes()
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), attrFilterField) {
+				switch {
+				case attrs == nil:
+					badFields["attributes"] = "Attributes field not supplied request"
+				default:
+					if attrs.Filter == "" {
+						badFields[attrFilterField] = "Field cannot be empty."
+					} else {
+						if _, err := bexpr.CreateEvaluator(attrs.Filter); err != nil {
+							badFields[attrFilterField] = fmt.Sprintf("Error evaluating submitted filter expression: %v.", err)
+						}
+					}
+				}
+			}
 		default:
 			badFields[globals.IdField] = "Unrecognized resource type."
 		}
 		return badFields
-	}, globals.OidcManagedGroupPrefix, globals.LdapManagedGroupPrefix)
+	}, globals.OidcManagedGroupPrefix, globals.LdapManagedGroupPrefix, globals.SamlManagedGroupPrefix)
 }
 
 func validateDeleteRequest(ctx context.Context, req *pbs.DeleteManagedGroupRequest) error {
@@ -975,7 +1224,7 @@ func validateDeleteRequest(ctx context.Context, req *pbs.DeleteManagedGroupReque
 	if req == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
 	}
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.OidcManagedGroupPrefix, globals.LdapManagedGroupPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.OidcManagedGroupPrefix, globals.LdapManagedGroupPrefix, globals.SamlManagedGroupPrefix)
 }
 
 func validateListRequest(ctx context.Context, req *pbs.ListManagedGroupsRequest) error {
@@ -984,7 +1233,7 @@ func validateListRequest(ctx context.Context, req *pbs.ListManagedGroupsRequest)
 		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
 	}
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix, globals.SamlAuthMethodPrefix) {
 		badFields[globals.AuthMethodIdField] = "Invalid formatted identifier."
 	}
 	if _, err := handlers.NewFilter(ctx, req.GetFilter()); err != nil {
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	oidcstore "github.com/hashicorp/boundary/internal/auth/oidc/store"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/auth/saml"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
@@ -72,35 +73,49 @@ func TestNewService(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
 	cases := []struct {
 		name            string
 		oidcRepo        common.OidcAuthRepoFactory
 		ldapRepo        common.LdapAuthRepoFactory
+		samlRepo        common.SamlAuthRepoFactory
 		wantErr         bool
 		wantErrContains string
 	}{
 		{
 			name:            "nil-oidc-repo",
 			ldapRepo:        ldapRepoFn,
+			samlRepo:        samlRepoFn,
 			wantErr:         true,
 			wantErrContains: "missing oidc repository",
 		},
 		{
 			name:            "missing-ldap-repo",
 			oidcRepo:        oidcRepoFn,
+			samlRepo:        samlRepoFn,
 			wantErr:         true,
 			wantErrContains: "missing ldap repository",
 		},
+		{
+			name:            "missing-saml-repo",
+			oidcRepo:        oidcRepoFn,
+			ldapRepo:        ldapRepoFn,
+			wantErr:         true,
+			wantErrContains: "missing saml repository",
+		},
 		{
 			name:     "success",
 			oidcRepo: oidcRepoFn,
 			ldapRepo: ldapRepoFn,
+			samlRepo: samlRepoFn,
 		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			_, err := managed_groups.NewService(ctx, tc.oidcRepo, tc.ldapRepo, 1000)
+			_, err := managed_groups.NewService(ctx, tc.oidcRepo, tc.ldapRepo, tc.samlRepo, 1000)
 			if tc.wantErr {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), tc.wantErrContains)
@@ -126,8 +141,11 @@ func TestGet(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
-	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, 1000)
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, samlRepoFn, 1000)
 	require.NoError(t, err, "Couldn't create new managed groups service.")
 
 	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -288,6 +306,9 @@ func TestListOidc(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
 	databaseWrapper, err := kmsCache.GetWrapper(ctx, o.PublicId, kms.KeyPurposeDatabase)
@@ -426,7 +447,7 @@ func TestListOidc(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, 1000)
+			s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, samlRepoFn, 1000)
 			require.NoError(err, "Couldn't create new managed group service.")
 
 			got, gErr := s.ListManagedGroups(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), tc.req)
@@ -481,6 +502,9 @@ func TestListLdap(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
 	databaseWrapper, err := kmsCache.GetWrapper(ctx, o.PublicId, kms.KeyPurposeDatabase)
@@ -620,7 +644,7 @@ func TestListLdap(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, 1000)
+			s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, samlRepoFn, 1000)
 			require.NoError(err, "Couldn't create new managed group service.")
 
 			got, gErr := s.ListManagedGroups(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), tc.req)
@@ -684,6 +708,9 @@ func TestListPagination(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
 	tokenRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, rw, rw, kmsCache)
@@ -692,7 +719,7 @@ func TestListPagination(t *testing.T) {
 		return server.NewRepository(ctx, rw, rw, kmsCache)
 	}
 
-	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, 1000)
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, samlRepoFn, 1000)
 	require.NoError(t, err)
 
 	iamRepo := iam.TestRepo(t, conn, wrap)
@@ -1356,6 +1383,9 @@ func TestDelete(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
 
@@ -1373,7 +1403,7 @@ func TestDelete(t *testing.T) {
 	ldapAm := ldap.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, []string{"ldaps://ldap1"})
 	ldapMg := ldap.TestManagedGroup(t, conn, ldapAm, []string{"admin", "users"})
 
-	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, 1000)
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, samlRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new user service.")
 
 	cases := []struct {
@@ -1453,6 +1483,9 @@ func TestDelete_twice(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
 
@@ -1468,7 +1501,7 @@ func TestDelete_twice(t *testing.T) {
 	)
 	oidcMg := oidc.TestManagedGroup(t, conn, oidcAm, oidc.TestFakeManagedGroupFilter)
 
-	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, 1000)
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, samlRepoFn, 1000)
 	require.NoError(err, "Error when getting new user service")
 	req := &pbs.DeleteManagedGroupRequest{
 		Id: oidcMg.GetPublicId(),
@@ -1495,8 +1528,11 @@ func TestCreateOidc(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
-	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, 1000)
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, samlRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new managed group service.")
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -1707,8 +1743,11 @@ func TestCreateLdap(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
-	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, 1000)
+	s, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, samlRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new managed group service.")
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -1920,6 +1959,9 @@ func TestUpdateOidc(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
 
@@ -1932,7 +1974,7 @@ func TestUpdateOidc(t *testing.T) {
 		oidc.WithSigningAlgs(oidc.RS256),
 		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]))
 
-	tested, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, 1000)
+	tested, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, samlRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new managed_groups service.")
 
 	defaultScopeInfo := &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType(), ParentScopeId: scope.Global.String()}
@@ -2315,6 +2357,9 @@ func TestUpdateLdap(t *testing.T) {
 	ldapRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	samlRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
 
@@ -2322,7 +2367,7 @@ func TestUpdateLdap(t *testing.T) {
 	require.NoError(t, err)
 	am := ldap.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, []string{"ldaps://ldap1"})
 
-	tested, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, 1000)
+	tested, err := managed_groups.NewService(ctx, oidcRepoFn, ldapRepoFn, samlRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new managed_groups service.")
 
 	testGroups := []string{"test", "admin"}
@@ -1282,7 +1282,8 @@ func validateAddRolePrincipalsRequest(req *pbs.AddRolePrincipalsRequest) error {
 		if !handlers.ValidId(handlers.Id(id), globals.GroupPrefix) &&
 			!handlers.ValidId(handlers.Id(id), globals.UserPrefix) &&
 			!handlers.ValidId(handlers.Id(id), globals.OidcManagedGroupPrefix) &&
-			!handlers.ValidId(handlers.Id(id), globals.LdapManagedGroupPrefix) {
+			!handlers.ValidId(handlers.Id(id), globals.LdapManagedGroupPrefix) &&
+			!handlers.ValidId(handlers.Id(id), globals.SamlManagedGroupPrefix) {
 			badFields["principal_ids"] = "Must only have valid user, group, and/or managed group ids."
 			break
 		}
@@ -1309,7 +1310,8 @@ func validateSetRolePrincipalsRequest(req *pbs.SetRolePrincipalsRequest) error {
 		if !handlers.ValidId(handlers.Id(id), globals.GroupPrefix) &&
 			!handlers.ValidId(handlers.Id(id), globals.UserPrefix) &&
 			!handlers.ValidId(handlers.Id(id), globals.OidcManagedGroupPrefix) &&
-			!handlers.ValidId(handlers.Id(id), globals.LdapManagedGroupPrefix) {
+			!handlers.ValidId(handlers.Id(id), globals.LdapManagedGroupPrefix) &&
+			!handlers.ValidId(handlers.Id(id), globals.SamlManagedGroupPrefix) {
 			badFields["principal_ids"] = "Must only have valid user, group, and/or managed group ids."
 			break
 		}
@@ -1339,7 +1341,8 @@ func validateRemoveRolePrincipalsRequest(req *pbs.RemoveRolePrincipalsRequest) e
 		if !handlers.ValidId(handlers.Id(id), globals.GroupPrefix) &&
 			!handlers.ValidId(handlers.Id(id), globals.UserPrefix) &&
 			!handlers.ValidId(handlers.Id(id), globals.OidcManagedGroupPrefix) &&
-			!handlers.ValidId(handlers.Id(id), globals.LdapManagedGroupPrefix) {
+			!handlers.ValidId(handlers.Id(id), globals.LdapManagedGroupPrefix) &&
+			!handlers.ValidId(handlers.Id(id), globals.SamlManagedGroupPrefix) {
 			badFields["principal_ids"] = "Must only have valid user, group, and/or managed group ids."
 			break
 		}
@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/scim"
+)
+
+func (h *handler) listGroups(w http.ResponseWriter, r *http.Request) {
+	const op = "scim.(handler).listGroups"
+	ctx := r.Context()
+	startIndex, count, err := pagination(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+	opts := []scim.Option{scim.WithOffset(startIndex - 1), scim.WithLimit(max(count, 1))}
+	if f := r.URL.Query().Get("filter"); f != "" {
+		attr, value, err := parseFilter(f)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalidFilter", err.Error())
+			return
+		}
+		switch strings.ToLower(attr) {
+		case "displayname":
+			opts = append(opts, scim.WithName(value))
+		case "externalid":
+			opts = append(opts, scim.WithExternalId(value))
+		default:
+			writeError(w, http.StatusBadRequest, "invalidFilter", "filtering is only supported on displayName and externalId")
+			return
+		}
+	}
+
+	repo, err := h.scimRepoFn()
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	groups, total, err := repo.ListGroups(ctx, h.scopeId, opts...)
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	resp := listResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		Resources:    []any{},
+	}
+	if count > 0 {
+		for _, g := range groups {
+			resp.Resources = append(resp.Resources, toGroupResource(r, g))
+		}
+	}
+	resp.ItemsPerPage = len(resp.Resources)
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *handler) getGroup(w http.ResponseWriter, r *http.Request) {
+	const op = "scim.(handler).getGroup"
+	ctx := r.Context()
+	g, err := h.lookupGroup(ctx, r.PathValue("id"))
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toGroupResource(r, g))
+}
+
+func (h *handler) createGroup(w http.ResponseWriter, r *http.Request) {
+	const op = "scim.(handler).createGroup"
+	ctx := r.Context()
+	var req groupResource
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+	if req.DisplayName == "" {
+		writeError(w, http.StatusBadRequest, "invalidValue", "displayName is required")
+		return
+	}
+
+	iamRepo, err := h.iamRepoFn()
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	g, err := iam.NewGroup(ctx, h.scopeId, iam.WithName(req.DisplayName))
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	g, err = iamRepo.CreateGroup(ctx, g)
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	if err := h.setGroupAttributes(ctx, g.GetPublicId(), g.GetVersion(), nil, &req); err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	created, err := h.lookupGroup(ctx, g.GetPublicId())
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	w.Header().Set("Location", location(r, "Groups", created.PublicId))
+	writeJSON(w, http.StatusCreated, toGroupResource(r, created))
+}
+
+func (h *handler) replaceGroup(w http.ResponseWriter, r *http.Request) {
+	const op = "scim.(handler).replaceGroup"
+	ctx := r.Context()
+	var req groupResource
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+	current, err := h.lookupGroup(ctx, r.PathValue("id"))
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	h.updateGroup(w, r, current, &req)
+}
+
+func (h *handler) patchGroup(w http.ResponseWriter, r *http.Request) {
+	const op = "scim.(handler).patchGroup"
+	ctx := r.Context()
+	var req patchRequest
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+	current, err := h.lookupGroup(ctx, r.PathValue("id"))
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	res := toGroupResource(r, current)
+	if err := applyGroupPatch(res, req.Operations); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+	h.updateGroup(w, r, current, res)
+}
+
+// updateGroup replaces the attributes and members of current with those of
+// req and writes the updated group to w.
+func (h *handler) updateGroup(w http.ResponseWriter, r *http.Request, current *scim.ProvisionedGroup, req *groupResource) {
+	const op = "scim.(handler).updateGroup"
+	ctx := r.Context()
+	if req.DisplayName == "" {
+		writeError(w, http.StatusBadRequest, "invalidValue", "displayName is required")
+		return
+	}
+
+	version := current.Version
+	if req.DisplayName != current.Name {
+		iamRepo, err := h.iamRepoFn()
+		if err != nil {
+			writeDomainError(ctx, w, op, err)
+			return
+		}
+		g, err := iam.NewGroup(ctx, h.scopeId, iam.WithName(req.DisplayName))
+		if err != nil {
+			writeDomainError(ctx, w, op, err)
+			return
+		}
+		g.PublicId = current.PublicId
+		g, _, _, err = iamRepo.UpdateGroup(ctx, g, version, []string{"Name"})
+		if err != nil {
+			writeDomainError(ctx, w, op, err)
+			return
+		}
+		version = g.GetVersion()
+	}
+	if err := h.setGroupAttributes(ctx, current.PublicId, version, current.MemberIds, req); err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	updated, err := h.lookupGroup(ctx, current.PublicId)
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toGroupResource(r, updated))
+}
+
+func (h *handler) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	const op = "scim.(handler).deleteGroup"
+	ctx := r.Context()
+	g, err := h.lookupGroup(ctx, r.PathValue("id"))
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	iamRepo, err := h.iamRepoFn()
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	if _, err := iamRepo.DeleteGroup(ctx, g.PublicId); err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// lookupGroup returns the group id in the scope of the handler or a
+// RecordNotFound error.
+func (h *handler) lookupGroup(ctx context.Context, id string) (*scim.ProvisionedGroup, error) {
+	const op = "scim.(handler).lookupGroup"
+	repo, err := h.scimRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	g, err := repo.LookupProvisionedGroup(ctx, h.scopeId, id)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if g == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "group "+id+" not found")
+	}
+	return g, nil
+}
+
+// setGroupAttributes stores the SCIM attributes of req for the group id
+// and sets its members to those of req if they differ from memberIds.
+func (h *handler) setGroupAttributes(ctx context.Context, id string, version uint32, memberIds []string, req *groupResource) error {
+	const op = "scim.(handler).setGroupAttributes"
+	repo, err := h.scimRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if _, err := repo.SetGroup(ctx, &scim.Group{IamGroupId: id, ExternalId: req.ExternalId}); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	var newIds []string
+	for _, m := range req.Members {
+		if m.Value != "" && !slices.Contains(newIds, m.Value) {
+			newIds = append(newIds, m.Value)
+		}
+	}
+	current := slices.Clone(memberIds)
+	slices.Sort(current)
+	slices.Sort(newIds)
+	if slices.Equal(current, newIds) {
+		return nil
+	}
+	iamRepo, err := h.iamRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if _, _, err := iamRepo.SetGroupMembers(ctx, id, version, newIds); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package scim provides the SCIM 2.0 (RFC 7643, RFC 7644) provisioning
+// endpoint of the controller. Users and groups of a single scope are exposed
+// as SCIM Users and Groups so an identity provider can create, update and
+// deprovision them. Requests are authenticated with a static bearer token
+// from the controller configuration rather than with Boundary auth tokens.
+package scim
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+)
+
+const (
+	// PathPrefix is the path under which the SCIM endpoint is served.
+	PathPrefix = "/scim/v2/"
+
+	contentType = "application/scim+json"
+
+	schemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	schemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	schemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+
+	defaultCount = 100
+	maxCount     = 1000
+)
+
+type handler struct {
+	scopeId     string
+	bearerToken []byte
+	iamRepoFn   common.IamRepoFactory
+	atRepoFn    common.AuthTokenRepoFactory
+	scimRepoFn  common.ScimRepoFactory
+}
+
+// NewHandler returns an http.Handler serving the SCIM endpoint for the users
+// and groups in scopeId under PathPrefix. Requests must present bearerToken
+// in their Authorization header.
+func NewHandler(ctx context.Context, scopeId, bearerToken string, iamRepoFn common.IamRepoFactory, atRepoFn common.AuthTokenRepoFactory, scimRepoFn common.ScimRepoFactory) (http.Handler, error) {
+	const op = "scim.NewHandler"
+	switch {
+	case scopeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case bearerToken == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing bearer token")
+	case iamRepoFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository")
+	case atRepoFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth token repository")
+	case scimRepoFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scim repository")
+	}
+	h := &handler{
+		scopeId:     scopeId,
+		bearerToken: []byte(bearerToken),
+		iamRepoFn:   iamRepoFn,
+		atRepoFn:    atRepoFn,
+		scimRepoFn:  scimRepoFn,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+PathPrefix+"ServiceProviderConfig", h.serviceProviderConfig)
+	mux.HandleFunc("GET "+PathPrefix+"Users", h.listUsers)
+	mux.HandleFunc("POST "+PathPrefix+"Users", h.createUser)
+	mux.HandleFunc("GET "+PathPrefix+"Users/{id}", h.getUser)
+	mux.HandleFunc("PUT "+PathPrefix+"Users/{id}", h.replaceUser)
+	mux.HandleFunc("PATCH "+PathPrefix+"Users/{id}", h.patchUser)
+	mux.HandleFunc("DELETE "+PathPrefix+"Users/{id}", h.deleteUser)
+	mux.HandleFunc("GET "+PathPrefix+"Groups", h.listGroups)
+	mux.HandleFunc("POST "+PathPrefix+"Groups", h.createGroup)
+	mux.HandleFunc("GET "+PathPrefix+"Groups/{id}", h.getGroup)
+	mux.HandleFunc("PUT "+PathPrefix+"Groups/{id}", h.replaceGroup)
+	mux.HandleFunc("PATCH "+PathPrefix+"Groups/{id}", h.patchGroup)
+	mux.HandleFunc("DELETE "+PathPrefix+"Groups/{id}", h.deleteGroup)
+	mux.HandleFunc(PathPrefix, func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "", "resource not found")
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !h.authorized(r) {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+			writeError(w, http.StatusUnauthorized, "", "invalid bearer token")
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}), nil
+}
+
+func (h *handler) authorized(r *http.Request) bool {
+	authz := r.Header.Get("Authorization")
+	scheme, token, ok := strings.Cut(authz, " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimSpace(token)), h.bearerToken) == 1
+}
+
+func (h *handler) serviceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	supported := func(b bool) map[string]any { return map[string]any{"supported": b} }
+	writeJSON(w, http.StatusOK, map[string]any{
+		"schemas":        []string{schemaServiceProviderConfig},
+		"patch":          supported(true),
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": maxCount},
+		"changePassword": supported(false),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []map[string]any{
+			{
+				"type":        "oauthbearertoken",
+				"name":        "OAuth Bearer Token",
+				"description": "Authentication with the bearer token from the controller configuration",
+			},
+		},
+	})
+}
+
+// decode reads the JSON body of r into v, honoring the maximum request size
+// of the controller listener.
+func decode(r *http.Request, v any) error {
+	body := io.Reader(r.Body)
+	if max, ok := r.Context().Value(globals.ContextMaxRequestSizeTypeKey).(int64); ok && max > 0 {
+		body = io.LimitReader(r.Body, max)
+	}
+	if err := json.NewDecoder(body).Decode(v); err != nil {
+		return fmt.Errorf("unable to decode request body: %w", err)
+	}
+	return nil
+}
+
+// pagination returns the 1 based start index and the number of resources
+// requested by r.
+func pagination(r *http.Request) (int, int, error) {
+	startIndex, count := 1, defaultCount
+	q := r.URL.Query()
+	if v := q.Get("startIndex"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid startIndex %q", v)
+		}
+		if i > 1 {
+			startIndex = i
+		}
+	}
+	if v := q.Get("count"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid count %q", v)
+		}
+		count = max(i, 0)
+	}
+	return startIndex, min(count, maxCount), nil
+}
+
+// parseFilter parses the equality filters supported by the endpoint, of the
+// form `attribute eq "value"`, and returns the attribute and the value.
+func parseFilter(filter string) (string, string, error) {
+	parts := strings.SplitN(strings.TrimSpace(filter), " ", 3)
+	if len(parts) != 3 || !strings.EqualFold(parts[1], "eq") {
+		return "", "", fmt.Errorf("unsupported filter %q", filter)
+	}
+	value, err := strconv.Unquote(strings.TrimSpace(parts[2]))
+	if err != nil {
+		return "", "", fmt.Errorf("invalid filter value in %q", filter)
+	}
+	return parts[0], value, nil
+}
+
+func location(r *http.Request, resourceType, id string) string {
+	scheme := "https"
+	if r.TLS == nil {
+		scheme = "http"
+	}
+	return fmt.Sprintf("%s://%s%s%s/%s", scheme, r.Host, PathPrefix, resourceType, id)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+type scimError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+func writeError(w http.ResponseWriter, status int, scimType, detail string) {
+	writeJSON(w, status, scimError{
+		Schemas:  []string{schemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
+
+// writeDomainError maps err returned by a repository to a SCIM error
+// response.
+func writeDomainError(ctx context.Context, w http.ResponseWriter, op event.Op, err error) {
+	switch {
+	case errors.Match(errors.T(errors.NotUnique), err):
+		writeError(w, http.StatusConflict, "uniqueness", err.Error())
+	case errors.Match(errors.T(errors.RecordNotFound), err):
+		writeError(w, http.StatusNotFound, "", err.Error())
+	case errors.Match(errors.T(errors.InvalidParameter), err),
+		errors.Match(errors.T(errors.InvalidFieldMask), err),
+		errors.Match(errors.T(errors.NotSpecificIntegrity), err),
+		errors.Match(errors.T(errors.NotNull), err),
+		errors.Match(errors.T(errors.CheckConstraint), err):
+		writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+	default:
+		event.WriteError(ctx, op, err)
+		writeError(w, http.StatusInternalServerError, "", "internal error")
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/scim"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHandler_Authorization(t *testing.T) {
+	ctx := context.Background()
+	iamRepoFn := func() (*iam.Repository, error) { return nil, nil }
+	atRepoFn := func() (*authtoken.Repository, error) { return nil, nil }
+	scimRepoFn := func() (*scim.Repository, error) { return nil, nil }
+
+	_, err := NewHandler(ctx, "", "secret", iamRepoFn, atRepoFn, scimRepoFn)
+	require.Error(t, err)
+	_, err = NewHandler(ctx, "o_1234567890", "", iamRepoFn, atRepoFn, scimRepoFn)
+	require.Error(t, err)
+
+	h, err := NewHandler(ctx, "o_1234567890", "secret", iamRepoFn, atRepoFn, scimRepoFn)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		authz      string
+		path       string
+		wantStatus int
+	}{
+		{name: "missing-token", path: PathPrefix + "ServiceProviderConfig", wantStatus: http.StatusUnauthorized},
+		{name: "wrong-token", authz: "Bearer nope", path: PathPrefix + "ServiceProviderConfig", wantStatus: http.StatusUnauthorized},
+		{name: "wrong-scheme", authz: "Basic secret", path: PathPrefix + "ServiceProviderConfig", wantStatus: http.StatusUnauthorized},
+		{name: "valid", authz: "Bearer secret", path: PathPrefix + "ServiceProviderConfig", wantStatus: http.StatusOK},
+		{name: "unknown-resource", authz: "bearer secret", path: PathPrefix + "Schemas", wantStatus: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.authz != "" {
+				req.Header.Set("Authorization", tt.authz)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			assert.Equal(tt.wantStatus, rec.Code)
+			assert.Equal(contentType, rec.Header().Get("Content-Type"))
+			if tt.wantStatus != http.StatusOK {
+				var got scimError
+				require.NoError(json.NewDecoder(rec.Body).Decode(&got))
+				assert.Equal([]string{schemaError}, got.Schemas)
+			}
+		})
+	}
+}
+
+func TestParseFilter(t *testing.T) {
+	attr, value, err := parseFilter(`userName eq "alice@example.com"`)
+	require.NoError(t, err)
+	assert.Equal(t, "userName", attr)
+	assert.Equal(t, "alice@example.com", value)
+
+	_, _, err = parseFilter(`userName sw "alice"`)
+	assert.Error(t, err)
+	_, _, err = parseFilter(`userName eq alice`)
+	assert.Error(t, err)
+}
+
+func TestApplyUserPatch(t *testing.T) {
+	active := true
+	u := &userResource{UserName: "alice", DisplayName: "Alice", ExternalId: "ext", Active: &active}
+	err := applyUserPatch(u, []patchOperation{
+		{Op: "Replace", Path: "active", Value: json.RawMessage(`"False"`)},
+		{Op: "replace", Value: json.RawMessage(`{"userName": "alice2", "name.givenName": "Alice"}`)},
+		{Op: "remove", Path: "displayName"},
+	})
+	require.NoError(t, err)
+	assert.False(t, *u.Active)
+	assert.Equal(t, "alice2", u.UserName)
+	assert.Empty(t, u.DisplayName)
+	assert.Equal(t, "ext", u.ExternalId)
+
+	assert.Error(t, applyUserPatch(u, []patchOperation{{Op: "move", Path: "active"}}))
+}
+
+func TestApplyGroupPatch(t *testing.T) {
+	g := &groupResource{DisplayName: "eng", Members: []member{{Value: "u_1"}, {Value: "u_2"}}}
+	err := applyGroupPatch(g, []patchOperation{
+		{Op: "add", Path: "members", Value: json.RawMessage(`[{"value": "u_2"}, {"value": "u_3"}]`)},
+		{Op: "remove", Path: `members[value eq "u_1"]`},
+		{Op: "replace", Path: "displayName", Value: json.RawMessage(`"engineering"`)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "engineering", g.DisplayName)
+	assert.Equal(t, []member{{Value: "u_2"}, {Value: "u_3"}}, g.Members)
+
+	err = applyGroupPatch(g, []patchOperation{
+		{Op: "remove", Path: "members", Value: json.RawMessage(`[{"value": "u_2"}]`)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []member{{Value: "u_3"}}, g.Members)
+
+	err = applyGroupPatch(g, []patchOperation{{Op: "replace", Path: "members", Value: json.RawMessage(`[]`)}})
+	require.NoError(t, err)
+	assert.Empty(t, g.Members)
+}
@@ -0,0 +1,314 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/scim"
+)
+
+type meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+	Version      string `json:"version,omitempty"`
+}
+
+type userResource struct {
+	Schemas     []string `json:"schemas"`
+	Id          string   `json:"id,omitempty"`
+	ExternalId  string   `json:"externalId,omitempty"`
+	UserName    string   `json:"userName"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Active      *bool    `json:"active,omitempty"`
+	Meta        *meta    `json:"meta,omitempty"`
+}
+
+type member struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+type groupResource struct {
+	Schemas     []string `json:"schemas"`
+	Id          string   `json:"id,omitempty"`
+	ExternalId  string   `json:"externalId,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Members     []member `json:"members"`
+	Meta        *meta    `json:"meta,omitempty"`
+}
+
+type listResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+type patchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []patchOperation `json:"Operations"`
+}
+
+func formatTime(t interface{ AsTime() time.Time }) string {
+	return t.AsTime().UTC().Format(time.RFC3339)
+}
+
+func toUserResource(r *http.Request, u *scim.ProvisionedUser) *userResource {
+	active := u.Active
+	res := &userResource{
+		Schemas:     []string{schemaUser},
+		Id:          u.PublicId,
+		ExternalId:  u.ExternalId,
+		UserName:    u.Name,
+		DisplayName: u.Description,
+		Active:      &active,
+		Meta: &meta{
+			ResourceType: "User",
+			Location:     location(r, "Users", u.PublicId),
+			Version:      fmt.Sprintf(`W/"%d"`, u.Version),
+		},
+	}
+	if u.CreateTime != nil {
+		res.Meta.Created = formatTime(u.CreateTime)
+	}
+	if u.UpdateTime != nil {
+		res.Meta.LastModified = formatTime(u.UpdateTime)
+	}
+	return res
+}
+
+func toGroupResource(r *http.Request, g *scim.ProvisionedGroup) *groupResource {
+	res := &groupResource{
+		Schemas:     []string{schemaGroup},
+		Id:          g.PublicId,
+		ExternalId:  g.ExternalId,
+		DisplayName: g.Name,
+		Members:     []member{},
+		Meta: &meta{
+			ResourceType: "Group",
+			Location:     location(r, "Groups", g.PublicId),
+			Version:      fmt.Sprintf(`W/"%d"`, g.Version),
+		},
+	}
+	for _, id := range g.MemberIds {
+		res.Members = append(res.Members, member{Value: id, Ref: location(r, "Users", id)})
+	}
+	if g.CreateTime != nil {
+		res.Meta.Created = formatTime(g.CreateTime)
+	}
+	if g.UpdateTime != nil {
+		res.Meta.LastModified = formatTime(g.UpdateTime)
+	}
+	return res
+}
+
+// parseBool accepts both JSON booleans and the string forms some identity
+// providers send for boolean attributes.
+func parseBool(raw json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(raw, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return false, fmt.Errorf("invalid boolean value %s", raw)
+	}
+	return strconv.ParseBool(s)
+}
+
+func parseString(raw json.RawMessage) (string, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return "", fmt.Errorf("invalid string value %s", raw)
+	}
+	return s, nil
+}
+
+// applyUserPatch applies the operations of a PatchOp request to u.
+func applyUserPatch(u *userResource, ops []patchOperation) error {
+	for _, o := range ops {
+		op := strings.ToLower(o.Op)
+		switch op {
+		case "add", "replace", "remove":
+		default:
+			return fmt.Errorf("unsupported patch operation %q", o.Op)
+		}
+		if o.Path == "" {
+			if op == "remove" {
+				return fmt.Errorf("remove operation requires a path")
+			}
+			var values map[string]json.RawMessage
+			if err := json.Unmarshal(o.Value, &values); err != nil {
+				return fmt.Errorf("invalid patch value %s", o.Value)
+			}
+			for k, v := range values {
+				if err := setUserAttribute(u, k, v); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if op == "remove" {
+			if err := setUserAttribute(u, o.Path, nil); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := setUserAttribute(u, o.Path, o.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setUserAttribute sets attribute attr of u to value. A nil value removes
+// the attribute.
+func setUserAttribute(u *userResource, attr string, value json.RawMessage) error {
+	switch strings.ToLower(attr) {
+	case "active":
+		active := true
+		if value != nil {
+			var err error
+			if active, err = parseBool(value); err != nil {
+				return err
+			}
+		}
+		u.Active = &active
+		return nil
+	case "username", "displayname", "externalid":
+	default:
+		// Attributes not mapped onto Boundary users, such as emails or
+		// name, are ignored.
+		return nil
+	}
+	var s string
+	if value != nil {
+		var err error
+		if s, err = parseString(value); err != nil {
+			return err
+		}
+	}
+	switch strings.ToLower(attr) {
+	case "username":
+		u.UserName = s
+	case "displayname":
+		u.DisplayName = s
+	case "externalid":
+		u.ExternalId = s
+	}
+	return nil
+}
+
+// applyGroupPatch applies the operations of a PatchOp request to g.
+func applyGroupPatch(g *groupResource, ops []patchOperation) error {
+	for _, o := range ops {
+		op := strings.ToLower(o.Op)
+		switch op {
+		case "add", "replace", "remove":
+		default:
+			return fmt.Errorf("unsupported patch operation %q", o.Op)
+		}
+		if o.Path == "" {
+			if op == "remove" {
+				return fmt.Errorf("remove operation requires a path")
+			}
+			var values map[string]json.RawMessage
+			if err := json.Unmarshal(o.Value, &values); err != nil {
+				return fmt.Errorf("invalid patch value %s", o.Value)
+			}
+			for k, v := range values {
+				if err := patchGroupAttribute(g, op, k, v); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if err := patchGroupAttribute(g, op, o.Path, o.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func patchGroupAttribute(g *groupResource, op, path string, value json.RawMessage) error {
+	attr, filter, _ := strings.Cut(path, "[")
+	switch strings.ToLower(attr) {
+	case "members":
+	case "displayname", "externalid":
+		var s string
+		if op != "remove" {
+			var err error
+			if s, err = parseString(value); err != nil {
+				return err
+			}
+		}
+		if strings.EqualFold(attr, "displayname") {
+			g.DisplayName = s
+		} else {
+			g.ExternalId = s
+		}
+		return nil
+	default:
+		return nil
+	}
+
+	if filter != "" {
+		// members[value eq "id"]
+		if op != "remove" {
+			return fmt.Errorf("unsupported patch path %q", path)
+		}
+		a, id, err := parseFilter(strings.TrimSuffix(filter, "]"))
+		if err != nil || !strings.EqualFold(a, "value") {
+			return fmt.Errorf("unsupported patch path %q", path)
+		}
+		g.Members = slices.DeleteFunc(g.Members, func(m member) bool { return m.Value == id })
+		return nil
+	}
+
+	var members []member
+	if value != nil && op != "remove" {
+		if err := json.Unmarshal(value, &members); err != nil {
+			return fmt.Errorf("invalid members value %s", value)
+		}
+	}
+	switch op {
+	case "replace":
+		g.Members = members
+	case "add":
+		for _, m := range members {
+			if !slices.ContainsFunc(g.Members, func(c member) bool { return c.Value == m.Value }) {
+				g.Members = append(g.Members, m)
+			}
+		}
+	case "remove":
+		if value == nil {
+			g.Members = nil
+			return nil
+		}
+		if err := json.Unmarshal(value, &members); err != nil {
+			return fmt.Errorf("invalid members value %s", value)
+		}
+		for _, m := range members {
+			g.Members = slices.DeleteFunc(g.Members, func(c member) bool { return c.Value == m.Value })
+		}
+	}
+	return nil
+}
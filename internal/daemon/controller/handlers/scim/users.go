@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/scim"
+)
+
+func (h *handler) listUsers(w http.ResponseWriter, r *http.Request) {
+	const op = "scim.(handler).listUsers"
+	ctx := r.Context()
+	startIndex, count, err := pagination(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+	opts := []scim.Option{scim.WithOffset(startIndex - 1), scim.WithLimit(max(count, 1))}
+	if f := r.URL.Query().Get("filter"); f != "" {
+		attr, value, err := parseFilter(f)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalidFilter", err.Error())
+			return
+		}
+		switch strings.ToLower(attr) {
+		case "username":
+			opts = append(opts, scim.WithName(value))
+		case "externalid":
+			opts = append(opts, scim.WithExternalId(value))
+		default:
+			writeError(w, http.StatusBadRequest, "invalidFilter", "filtering is only supported on userName and externalId")
+			return
+		}
+	}
+
+	repo, err := h.scimRepoFn()
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	users, total, err := repo.ListUsers(ctx, h.scopeId, opts...)
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	resp := listResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		Resources:    []any{},
+	}
+	if count > 0 {
+		for _, u := range users {
+			resp.Resources = append(resp.Resources, toUserResource(r, u))
+		}
+	}
+	resp.ItemsPerPage = len(resp.Resources)
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *handler) getUser(w http.ResponseWriter, r *http.Request) {
+	const op = "scim.(handler).getUser"
+	ctx := r.Context()
+	u, err := h.lookupUser(ctx, r.PathValue("id"))
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toUserResource(r, u))
+}
+
+func (h *handler) createUser(w http.ResponseWriter, r *http.Request) {
+	const op = "scim.(handler).createUser"
+	ctx := r.Context()
+	var req userResource
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+	if req.UserName == "" {
+		writeError(w, http.StatusBadRequest, "invalidValue", "userName is required")
+		return
+	}
+
+	iamRepo, err := h.iamRepoFn()
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	u, err := iam.NewUser(ctx, h.scopeId, iam.WithName(req.UserName), iam.WithDescription(req.DisplayName))
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	u, err = iamRepo.CreateUser(ctx, u)
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	if err := h.setUserAttributes(ctx, u.GetPublicId(), true, &req); err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	created, err := h.lookupUser(ctx, u.GetPublicId())
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	w.Header().Set("Location", location(r, "Users", created.PublicId))
+	writeJSON(w, http.StatusCreated, toUserResource(r, created))
+}
+
+func (h *handler) replaceUser(w http.ResponseWriter, r *http.Request) {
+	const op = "scim.(handler).replaceUser"
+	ctx := r.Context()
+	var req userResource
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+	current, err := h.lookupUser(ctx, r.PathValue("id"))
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	h.updateUser(w, r, current, &req)
+}
+
+func (h *handler) patchUser(w http.ResponseWriter, r *http.Request) {
+	const op = "scim.(handler).patchUser"
+	ctx := r.Context()
+	var req patchRequest
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+	current, err := h.lookupUser(ctx, r.PathValue("id"))
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	res := toUserResource(r, current)
+	if err := applyUserPatch(res, req.Operations); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+	h.updateUser(w, r, current, res)
+}
+
+// updateUser replaces the attributes of current with those of req and
+// writes the updated user to w.
+func (h *handler) updateUser(w http.ResponseWriter, r *http.Request, current *scim.ProvisionedUser, req *userResource) {
+	const op = "scim.(handler).updateUser"
+	ctx := r.Context()
+	if req.UserName == "" {
+		writeError(w, http.StatusBadRequest, "invalidValue", "userName is required")
+		return
+	}
+
+	var mask []string
+	if req.UserName != current.Name {
+		mask = append(mask, "Name")
+	}
+	if req.DisplayName != current.Description {
+		mask = append(mask, "Description")
+	}
+	if len(mask) > 0 {
+		iamRepo, err := h.iamRepoFn()
+		if err != nil {
+			writeDomainError(ctx, w, op, err)
+			return
+		}
+		u, err := iam.NewUser(ctx, h.scopeId, iam.WithName(req.UserName), iam.WithDescription(req.DisplayName))
+		if err != nil {
+			writeDomainError(ctx, w, op, err)
+			return
+		}
+		u.PublicId = current.PublicId
+		if _, _, _, err := iamRepo.UpdateUser(ctx, u, current.Version, mask); err != nil {
+			writeDomainError(ctx, w, op, err)
+			return
+		}
+	}
+	if err := h.setUserAttributes(ctx, current.PublicId, current.Active, req); err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	updated, err := h.lookupUser(ctx, current.PublicId)
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toUserResource(r, updated))
+}
+
+func (h *handler) deleteUser(w http.ResponseWriter, r *http.Request) {
+	const op = "scim.(handler).deleteUser"
+	ctx := r.Context()
+	u, err := h.lookupUser(ctx, r.PathValue("id"))
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	if err := h.deprovision(ctx, u.PublicId); err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	iamRepo, err := h.iamRepoFn()
+	if err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	if _, err := iamRepo.DeleteUser(ctx, u.PublicId); err != nil {
+		writeDomainError(ctx, w, op, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// lookupUser returns the user id in the scope of the handler or a
+// RecordNotFound error.
+func (h *handler) lookupUser(ctx context.Context, id string) (*scim.ProvisionedUser, error) {
+	const op = "scim.(handler).lookupUser"
+	repo, err := h.scimRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	u, err := repo.LookupProvisionedUser(ctx, h.scopeId, id)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if u == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "user "+id+" not found")
+	}
+	return u, nil
+}
+
+// setUserAttributes stores the SCIM attributes of req for the user id. If
+// the user was active and req deactivates it, the user is deprovisioned.
+func (h *handler) setUserAttributes(ctx context.Context, id string, wasActive bool, req *userResource) error {
+	const op = "scim.(handler).setUserAttributes"
+	repo, err := h.scimRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	active := req.Active == nil || *req.Active
+	if _, err := repo.SetUser(ctx, &scim.User{IamUserId: id, ExternalId: req.ExternalId, Active: active}); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if wasActive && !active {
+		if err := h.deprovision(ctx, id); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	return nil
+}
+
+// deprovision revokes the auth tokens of the user id. Deleting the auth
+// tokens also cancels the sessions created with them.
+func (h *handler) deprovision(ctx context.Context, id string) error {
+	const op = "scim.(handler).deprovision"
+	atRepo, err := h.atRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if _, err := atRepo.DeleteUserAuthTokens(ctx, id); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
@@ -127,11 +128,49 @@ func dynamicToSessionCredential(ctx context.Context, cred credential.Dynamic) (*
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for credential"))
 			}
 
+		case credential.TlsClientCertificate:
+			credData, err = handlers.ProtoToStruct(
+				ctx,
+				&pb.TlsClientCertificateCredential{
+					Certificate:   string(c.Certificate()),
+					PrivateKey:    string(c.PrivateKey()),
+					CaCertificate: string(c.CaCertificate()),
+				},
+			)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for credential"))
+			}
+
+		case credential.KubeconfigCredential:
+			credData, err = handlers.ProtoToStruct(
+				ctx,
+				&pb.KubeconfigCredential{
+					Kubeconfig: string(c.Kubeconfig()),
+				},
+			)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for credential"))
+			}
+
 		default:
 			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential %T", c))
 		}
 	}
 
+	var secretMetadata *structpb.Struct
+	if vs, ok := cred.(credential.VersionedSecret); ok && vs.SecretVersion() > 0 {
+		md := map[string]any{
+			"version": float64(vs.SecretVersion()),
+		}
+		if ct := vs.SecretCreateTime(); !ct.IsZero() {
+			md["created_time"] = ct.Format(time.RFC3339Nano)
+		}
+		secretMetadata, err = structpb.NewStruct(md)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for secret metadata"))
+		}
+	}
+
 	return &pb.SessionCredential{
 		CredentialSource: &pb.CredentialSource{
 			Id:                l.GetPublicId(),
@@ -142,8 +181,9 @@ func dynamicToSessionCredential(ctx context.Context, cred credential.Dynamic) (*
 			CredentialType:    credType,
 		},
 		Secret: &pb.SessionSecret{
-			Raw:     base64.StdEncoding.EncodeToString(jSecret),
-			Decoded: sSecret,
+			Raw:      base64.StdEncoding.EncodeToString(jSecret),
+			Decoded:  sSecret,
+			Metadata: secretMetadata,
 		},
 		Credential: credData,
 	}, nil
@@ -249,6 +289,44 @@ func staticToSessionCredential(ctx context.Context, cred credential.Static) (*pb
 		}
 		secret = object
 
+	case *credstatic.TlsClientCertificateCredential:
+		var err error
+		credType = string(globals.TlsClientCertificateCredentialType)
+		credData, err = handlers.ProtoToStruct(
+			ctx,
+			&pb.TlsClientCertificateCredential{
+				Certificate:   string(c.GetCertificate()),
+				PrivateKey:    string(c.GetPrivateKey()),
+				CaCertificate: string(c.GetCaCertificate()),
+			},
+		)
+		secret = map[string]any{
+			"certificate": string(c.GetCertificate()),
+			"private_key": string(c.GetPrivateKey()),
+		}
+		if len(c.GetCaCertificate()) > 0 {
+			secret["ca_certificate"] = string(c.GetCaCertificate())
+		}
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for tls client certificate credential"))
+		}
+
+	case *credstatic.KubeconfigCredential:
+		var err error
+		credType = string(globals.KubeconfigCredentialType)
+		credData, err = handlers.ProtoToStruct(
+			ctx,
+			&pb.KubeconfigCredential{
+				Kubeconfig: string(c.GetKubeconfig()),
+			},
+		)
+		secret = map[string]any{
+			"kubeconfig": string(c.GetKubeconfig()),
+		}
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for kubeconfig credential"))
+		}
+
 	default:
 		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential %T", c))
 	}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targets
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	pbapi "github.com/hashicorp/boundary/internal/gen/controller/api"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"google.golang.org/grpc/codes"
+)
+
+// sessionPolicyFields maps the update mask paths of the session policy fields
+// to a function setting the corresponding SessionPolicy field. Like the
+// traffic policy, the session policy is stored separately from the target.
+var sessionPolicyFields = map[string]func(*target.SessionPolicy, *pb.Target){
+	globals.MaxActiveSessionsField: func(p *target.SessionPolicy, t *pb.Target) {
+		p.MaxActiveSessions = t.GetMaxActiveSessions()
+	},
+	globals.MaxActiveSessionsPerUserField: func(p *target.SessionPolicy, t *pb.Target) {
+		p.MaxActiveSessionsPerUser = t.GetMaxActiveSessionsPerUser()
+	},
+}
+
+// createSessionPolicy stores the session policy fields of item for the newly
+// created target t. Nothing is stored if item does not set any of them.
+func (s Service) createSessionPolicy(ctx context.Context, t target.Target, item *pb.Target) error {
+	p, err := target.NewSessionPolicy(ctx, t.GetPublicId())
+	if err != nil {
+		return err
+	}
+	for _, set := range sessionPolicyFields {
+		set(p, item)
+	}
+	if p.IsUnlimited() {
+		return nil
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return err
+	}
+	if _, err := repo.SetSessionPolicy(ctx, p); err != nil {
+		return fmt.Errorf("unable to set session policy: %w", err)
+	}
+	return nil
+}
+
+// updatedSessionPolicy returns the session policy of the target with the
+// given id updated as described by mask, which must only contain session
+// policy fields. The returned policy is not stored.
+func updatedSessionPolicy(ctx context.Context, repo *target.Repository, id string, mask []string, item *pb.Target) (*target.SessionPolicy, error) {
+	p, err := repo.GetSessionPolicy(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get session policy: %w", err)
+	}
+	for _, path := range mask {
+		sessionPolicyFields[path](p, item)
+	}
+	return p, nil
+}
+
+// addSessionPolicy sets the session policy fields of item, the proto of the
+// target with the given id, that are included in outputFields.
+func (s Service) addSessionPolicy(ctx context.Context, id string, outputFields *perms.OutputFields, item *pb.Target) error {
+	if !outputFields.Has(globals.MaxActiveSessionsField) &&
+		!outputFields.Has(globals.MaxActiveSessionsPerUserField) {
+		return nil
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return err
+	}
+	p, err := repo.GetSessionPolicy(ctx, id)
+	if err != nil {
+		return err
+	}
+	if outputFields.Has(globals.MaxActiveSessionsField) {
+		item.MaxActiveSessions = p.MaxActiveSessions
+	}
+	if outputFields.Has(globals.MaxActiveSessionsPerUserField) {
+		item.MaxActiveSessionsPerUser = p.MaxActiveSessionsPerUser
+	}
+	return nil
+}
+
+// activeSessionLimits returns the limits on the active sessions checked when
+// creating a session to the target with the given id.
+func (s Service) activeSessionLimits(ctx context.Context, repo *target.Repository, id string) (session.ActiveSessionLimits, error) {
+	p, err := repo.GetSessionPolicy(ctx, id)
+	if err != nil {
+		return session.ActiveSessionLimits{}, err
+	}
+	return session.ActiveSessionLimits{
+		PerUser:       s.maxActiveSessionsPerUser,
+		PerTarget:     p.MaxActiveSessions,
+		PerUserTarget: p.MaxActiveSessionsPerUser,
+	}, nil
+}
+
+// sessionLimitExceededError returns the API error for a session that was not
+// authorized because of err, a SessionLimitExceeded error. The refusal is
+// also written to the audit log with the limit which was reached.
+func sessionLimitExceededError(ctx context.Context, err error) error {
+	const op = "targets.sessionLimitExceededError"
+	msg := domainErrMsg(err)
+	apiErr := &handlers.ApiError{
+		Status: http.StatusTooManyRequests,
+		Inner: &pbapi.Error{
+			Kind:    codes.ResourceExhausted.String(),
+			Message: fmt.Sprintf("Active session limit reached: %s.", msg),
+		},
+	}
+	if err := event.WriteAudit(ctx, op, event.WithResponse(&event.Response{StatusCode: http.StatusTooManyRequests, Details: apiErr.Inner})); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to write session limit audit event"))
+	}
+	return apiErr
+}
+
+// credentialCheckedOutError returns the API error for a session that was not
+// authorized because of err, a CredentialCheckedOut error.
+func credentialCheckedOutError(err error) error {
+	msg := domainErrMsg(err)
+	return handlers.ConflictErrorf(fmt.Sprintf("Credential checked out: %s.", msg))
+}
+
+// domainErrMsg returns the first non-empty message of the domain errors in
+// the chain of err. Wrapping an error often adds no message of its own, so
+// the outermost domain error cannot be used. err.Error() is returned if no
+// domain error has a message.
+func domainErrMsg(err error) string {
+	var domainErr *errors.Err
+	for e := err; errors.As(e, &domainErr); e = domainErr.Wrapped {
+		if domainErr.Msg != "" {
+			return domainErr.Msg
+		}
+	}
+	return err.Error()
+}
@@ -10,6 +10,7 @@ import (
 	"math/rand"
 	"net"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 	"sync/atomic"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/boundary/internal/alias"
 	talias "github.com/hashicorp/boundary/internal/alias/target"
 	"github.com/hashicorp/boundary/internal/credential"
+	credfile "github.com/hashicorp/boundary/internal/credential/file"
 	wl "github.com/hashicorp/boundary/internal/daemon/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
@@ -128,11 +130,16 @@ type Service struct {
 	staticHostRepoFn        common.StaticRepoFactory
 	vaultCredRepoFn         common.VaultCredentialRepoFactory
 	staticCredRepoFn        common.StaticCredentialRepoFactory
+	fileCredRepoFn          common.FileCredentialRepoFactory
 	downstreams             common.Downstreamers
 	kmsCache                *kms.Kms
 	workerStatusGracePeriod *atomic.Int64
-	maxPageSize             uint
-	controllerExt           intglobals.ControllerExtension
+	workerRoutingStrategy   server.WorkerRoutingStrategy
+	// maxActiveSessionsPerUser limits the active sessions of each user to
+	// all targets. If zero, the sessions are not limited.
+	maxActiveSessionsPerUser uint32
+	maxPageSize              uint
+	controllerExt            intglobals.ControllerExtension
 }
 
 var _ pbs.TargetServiceServer = (*Service)(nil)
@@ -149,9 +156,12 @@ func NewService(
 	staticHostRepoFn common.StaticRepoFactory,
 	vaultCredRepoFn common.VaultCredentialRepoFactory,
 	staticCredRepoFn common.StaticCredentialRepoFactory,
+	fileCredRepoFn common.FileCredentialRepoFactory,
 	aliasRepoFn common.TargetAliasRepoFactory,
 	downstreams common.Downstreamers,
 	workerStatusGracePeriod *atomic.Int64,
+	workerRoutingStrategy server.WorkerRoutingStrategy,
+	maxActiveSessionsPerUser uint32,
 	maxPageSize uint,
 	controllerExt intglobals.ControllerExtension,
 ) (Service, error) {
@@ -175,27 +185,34 @@ func NewService(
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing vault credential repository")
 	case staticCredRepoFn == nil:
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static credential repository")
+	case fileCredRepoFn == nil:
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing file credential repository")
 	case aliasRepoFn == nil:
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing target alias repository")
+	case !workerRoutingStrategy.Valid():
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown worker routing strategy %q", workerRoutingStrategy))
 	}
 	if maxPageSize == 0 {
 		maxPageSize = uint(globals.DefaultMaxPageSize)
 	}
 	return Service{
-		repoFn:                  repoFn,
-		iamRepoFn:               iamRepoFn,
-		serversRepoFn:           serversRepoFn,
-		sessionRepoFn:           sessionRepoFn,
-		pluginHostRepoFn:        pluginHostRepoFn,
-		staticHostRepoFn:        staticHostRepoFn,
-		vaultCredRepoFn:         vaultCredRepoFn,
-		staticCredRepoFn:        staticCredRepoFn,
-		aliasRepoFn:             aliasRepoFn,
-		downstreams:             downstreams,
-		kmsCache:                kmsCache,
-		workerStatusGracePeriod: workerStatusGracePeriod,
-		maxPageSize:             maxPageSize,
-		controllerExt:           controllerExt,
+		repoFn:                   repoFn,
+		iamRepoFn:                iamRepoFn,
+		serversRepoFn:            serversRepoFn,
+		sessionRepoFn:            sessionRepoFn,
+		pluginHostRepoFn:         pluginHostRepoFn,
+		staticHostRepoFn:         staticHostRepoFn,
+		vaultCredRepoFn:          vaultCredRepoFn,
+		staticCredRepoFn:         staticCredRepoFn,
+		fileCredRepoFn:           fileCredRepoFn,
+		aliasRepoFn:              aliasRepoFn,
+		downstreams:              downstreams,
+		kmsCache:                 kmsCache,
+		workerStatusGracePeriod:  workerStatusGracePeriod,
+		workerRoutingStrategy:    workerRoutingStrategy,
+		maxActiveSessionsPerUser: maxActiveSessionsPerUser,
+		maxPageSize:              maxPageSize,
+		controllerExt:            controllerExt,
 	}, nil
 }
 
@@ -323,11 +340,18 @@ func (s Service) ListTargets(ctx context.Context, req *pbs.ListTargetsRequest) (
 
 	finalItems := make([]*pb.Target, 0, len(listResp.Items))
 	for _, item := range listResp.Items {
-		item, err := toProto(ctx, item, newOutputOpts(ctx, item, authResults, authzScopes)...)
+		outputOpts := newOutputOpts(ctx, item, authResults, authzScopes)
+		pbItem, err := toProto(ctx, item, outputOpts...)
 		if err != nil {
 			return nil, err
 		}
-		finalItems = append(finalItems, item)
+		if err := s.addTrafficPolicy(ctx, item.GetPublicId(), handlers.GetOpts(outputOpts...).WithOutputFields, pbItem); err != nil {
+			return nil, err
+		}
+		if err := s.addSessionPolicy(ctx, item.GetPublicId(), handlers.GetOpts(outputOpts...).WithOutputFields, pbItem); err != nil {
+			return nil, err
+		}
+		finalItems = append(finalItems, pbItem)
 	}
 	respType := "delta"
 	if listResp.CompleteListing {
@@ -388,6 +412,12 @@ func (s Service) GetTarget(ctx context.Context, req *pbs.GetTargetRequest) (*pbs
 	if err != nil {
 		return nil, err
 	}
+	if err := s.addTrafficPolicy(ctx, t.GetPublicId(), outputFields, item); err != nil {
+		return nil, err
+	}
+	if err := s.addSessionPolicy(ctx, t.GetPublicId(), outputFields, item); err != nil {
+		return nil, err
+	}
 
 	return &pbs.GetTargetResponse{Item: item}, nil
 }
@@ -414,6 +444,12 @@ func (s Service) CreateTarget(ctx context.Context, req *pbs.CreateTargetRequest)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.createTrafficPolicy(ctx, t, req.GetItem()); err != nil {
+		return nil, err
+	}
+	if err := s.createSessionPolicy(ctx, t, req.GetItem()); err != nil {
+		return nil, err
+	}
 
 	outputFields, ok := requests.OutputFields(ctx)
 	if !ok {
@@ -435,6 +471,12 @@ func (s Service) CreateTarget(ctx context.Context, req *pbs.CreateTargetRequest)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.addTrafficPolicy(ctx, t.GetPublicId(), outputFields, item); err != nil {
+		return nil, err
+	}
+	if err := s.addSessionPolicy(ctx, t.GetPublicId(), outputFields, item); err != nil {
+		return nil, err
+	}
 
 	return &pbs.CreateTargetResponse{Item: item, Uri: fmt.Sprintf("targets/%s", item.GetId())}, nil
 }
@@ -450,7 +492,7 @@ func (s Service) UpdateTarget(ctx context.Context, req *pbs.UpdateTargetRequest)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	t, ts, cl, err := s.updateInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+	t, ts, cl, err := s.updateWithPolicies(ctx, authResults.Scope.GetId(), req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
 	if err != nil {
 		return nil, err
 	}
@@ -475,6 +517,12 @@ func (s Service) UpdateTarget(ctx context.Context, req *pbs.UpdateTargetRequest)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.addTrafficPolicy(ctx, t.GetPublicId(), outputFields, item); err != nil {
+		return nil, err
+	}
+	if err := s.addSessionPolicy(ctx, t.GetPublicId(), outputFields, item); err != nil {
+		return nil, err
+	}
 
 	return &pbs.UpdateTargetResponse{Item: item}, nil
 }
@@ -987,6 +1035,8 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	if err != nil {
 		return nil, err
 	}
+	// Draining workers finish their existing connections but take no new sessions
+	selectedWorkers = slices.DeleteFunc(selectedWorkers, (*server.Worker).Draining)
 
 	if len(selectedWorkers) == 0 {
 		return nil, handlers.ApiErrorWithCodeAndMessage(
@@ -999,22 +1049,36 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		return nil, err
 	}
 
-	// Randomize the workers
+	// Randomize the workers, then order them by load if configured to
 	rand.Shuffle(len(selectedWorkers), func(i, j int) {
 		selectedWorkers[i], selectedWorkers[j] = selectedWorkers[j], selectedWorkers[i]
 	})
+	if s.workerRoutingStrategy == server.LeastLoadedWorkerRouting {
+		loads, err := serversRepo.ListWorkerLoads(ctx, wl.WorkerList(selectedWorkers).PublicIds())
+		if err != nil {
+			return nil, err
+		}
+		server.SortWorkersByLoad(selectedWorkers, loads)
+	}
 
 	var vaultReqs []credential.Request
+	var fileReqs []credential.Request
 	var staticIds []string
 	var dynCreds []*session.DynamicCredential
 	var staticCreds []*session.StaticCredential
 	for _, cs := range credSources {
 		switch cs.Type() {
 		case target.LibraryCredentialSourceType:
-			vaultReqs = append(vaultReqs, credential.Request{
+			req := credential.Request{
 				SourceId: cs.Id(),
 				Purpose:  cs.CredentialPurpose(),
-			})
+			}
+			switch globals.ResourceInfoFromPrefix(cs.Id()).Subtype {
+			case credfile.Subtype:
+				fileReqs = append(fileReqs, req)
+			default:
+				vaultReqs = append(vaultReqs, req)
+			}
 			dynCreds = append(dynCreds, session.NewDynamicCredential(cs.Id(), cs.CredentialPurpose()))
 		case target.StaticCredentialSourceType:
 			staticIds = append(staticIds, cs.Id())
@@ -1022,6 +1086,15 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		}
 	}
 
+	trafficLimit, err := s.sessionTrafficLimit(ctx, repo, t.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+	sessionLimits, err := s.activeSessionLimits(ctx, repo, t.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+
 	expTime := timestamppb.Now()
 	expTime.Seconds += int64(t.GetSessionMaxSeconds())
 	sessionComposition := session.ComposedOf{
@@ -1039,6 +1112,7 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		IngressWorkerFilter: t.GetIngressWorkerFilter(),
 		DynamicCredentials:  dynCreds,
 		StaticCredentials:   staticCreds,
+		TrafficLimit:        trafficLimit,
 	}
 	if protoWorker != nil {
 		sessionComposition.ProtocolWorkerId = protoWorker.GetPublicId()
@@ -1051,8 +1125,14 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	if err != nil {
 		return nil, err
 	}
-	sess, err = sessionRepo.CreateSession(ctx, wrapper, sess, wl.WorkerList(selectedWorkers).Addresses())
+	sess, err = sessionRepo.CreateSession(ctx, wrapper, sess, wl.WorkerList(selectedWorkers).Addresses(), session.WithActiveSessionLimits(sessionLimits))
 	if err != nil {
+		if errors.Match(errors.T(errors.SessionLimitExceeded), err) {
+			return nil, sessionLimitExceededError(ctx, err)
+		}
+		if errors.Match(errors.T(errors.CredentialCheckedOut), err) {
+			return nil, credentialCheckedOutError(err)
+		}
 		return nil, err
 	}
 	defer func() {
@@ -1077,12 +1157,15 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 
 	var dynamic []credential.Dynamic
 	var staticCredsById map[string]credential.Static
+	templateData := authResults.TemplateData(ctx)
 	if len(vaultReqs) > 0 {
 		credRepo, err := s.vaultCredRepoFn()
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
-		dynamic, err = credRepo.Issue(ctx, sess.GetPublicId(), vaultReqs, credential.WithTemplateData(authResults.UserData))
+		dynamic, err = credRepo.Issue(ctx, sess.GetPublicId(), vaultReqs,
+			credential.WithTemplateData(templateData),
+			credential.WithSessionExpiration(sess.ExpirationTime.AsTime()))
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
@@ -1100,6 +1183,18 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		}()
 	}
 
+	if len(fileReqs) > 0 {
+		credRepo, err := s.fileCredRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		fileCreds, err := credRepo.Issue(ctx, sess.GetPublicId(), fileReqs, credential.WithTemplateData(templateData))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		dynamic = append(dynamic, fileCreds...)
+	}
+
 	if len(staticIds) > 0 {
 		credRepo, err := s.staticCredRepoFn()
 		if err != nil {
@@ -1339,7 +1434,10 @@ func (s Service) createInRepo(ctx context.Context, item *pb.Target) (target.Targ
 	return out, hs, cl, nil
 }
 
-func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.Target) (target.Target, []target.HostSource, []target.CredentialSource, error) {
+// updateInRepo updates the target as described by mask and item. policyOpts
+// are passed to UpdateTarget to set the policies of the target in the same
+// transaction, in which case mask may be empty.
+func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.Target, policyOpts ...target.Option) (target.Target, []target.HostSource, []target.CredentialSource, error) {
 	const op = "targets.(Service).updateInRepo"
 	var dbMask []string
 	var opts []target.Option
@@ -1393,13 +1491,14 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 		return nil, nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update target"))
 	}
 	dbMask = append(dbMask, maskManager.Translate(mask)...)
-	if len(dbMask) == 0 {
+	if len(dbMask) == 0 && len(policyOpts) == 0 {
 		return nil, nil, nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid paths provided in the update mask."})
 	}
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	opts = append(opts, policyOpts...)
 	out, rowsUpdated, err := repo.UpdateTarget(ctx, u, version, dbMask, opts...)
 	if err != nil {
 		return nil, nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update target"))
@@ -2098,6 +2197,7 @@ func validateAddCredentialSourcesRequest(req *pbs.AddTargetCredentialSourcesRequ
 	for _, cl := range req.GetBrokeredCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.FileCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
@@ -2109,6 +2209,7 @@ func validateAddCredentialSourcesRequest(req *pbs.AddTargetCredentialSourcesRequ
 	for _, cl := range req.GetInjectedApplicationCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.FileCredentialLibraryPrefix,
 			globals.VaultSshCertificateCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
@@ -2134,6 +2235,7 @@ func validateSetCredentialSourcesRequest(req *pbs.SetTargetCredentialSourcesRequ
 	for _, cl := range req.GetBrokeredCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.FileCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
@@ -2145,6 +2247,7 @@ func validateSetCredentialSourcesRequest(req *pbs.SetTargetCredentialSourcesRequ
 	for _, cl := range req.GetInjectedApplicationCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.FileCredentialLibraryPrefix,
 			globals.VaultSshCertificateCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
@@ -2174,6 +2277,7 @@ func validateRemoveCredentialSourcesRequest(req *pbs.RemoveTargetCredentialSourc
 	for _, cl := range req.GetBrokeredCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.FileCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
@@ -2185,6 +2289,7 @@ func validateRemoveCredentialSourcesRequest(req *pbs.RemoveTargetCredentialSourc
 	for _, cl := range req.GetInjectedApplicationCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.FileCredentialLibraryPrefix,
 			globals.VaultSshCertificateCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
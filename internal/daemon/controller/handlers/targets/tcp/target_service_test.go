@@ -21,11 +21,14 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/boundary/globals"
 	talias "github.com/hashicorp/boundary/internal/alias/target"
+	"github.com/hashicorp/boundary/internal/auth/cert"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/auth/saml"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/credential"
+	credfile "github.com/hashicorp/boundary/internal/credential/file"
 	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -117,10 +120,13 @@ func testService(t *testing.T, ctx context.Context, conn *db.DB, kms *kms.Kms, w
 	staticCredRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(context.Background(), rw, rw, kms)
 	}
+	fileCredRepoFn := func() (*credfile.Repository, error) {
+		return credfile.NewRepository(context.Background(), rw, rw, kms)
+	}
 	targetAliasRepoFn := func() (*talias.Repository, error) {
 		return talias.NewRepository(ctx, rw, rw, kms)
 	}
-	return targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, targetAliasRepoFn, nil, statusGracePeriod, 1000, nil)
+	return targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, fileCredRepoFn, targetAliasRepoFn, nil, statusGracePeriod, server.RandomWorkerRouting, 0, 1000, nil)
 }
 
 func TestGet(t *testing.T) {
@@ -2009,6 +2015,164 @@ func TestUpdate_BadVersion(t *testing.T) {
 	assert.True(t, errors.Is(err, handlers.NotFoundError()), "Got %v, wanted not found error.", err)
 }
 
+// testPolicyService returns a target service and a context authorized to
+// manage targets in the returned project.
+func testPolicyService(t *testing.T) (targets.Service, context.Context, *iam.Scope) {
+	t.Helper()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	tokenRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kms)
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kms)
+	}
+
+	org, proj := iam.TestScopes(t, iamRepo)
+	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
+	r := iam.TestRole(t, conn, proj.GetPublicId())
+	_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+	_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), "ids=*;type=*;actions=*")
+
+	tested, err := testService(t, context.Background(), conn, kms, wrapper)
+	require.NoError(t, err, "Failed to create a new target service.")
+
+	requestInfo := authpb.RequestInfo{
+		TokenFormat: uint32(auth.AuthTokenTypeBearer),
+		PublicId:    at.GetPublicId(),
+		Token:       at.GetToken(),
+	}
+	requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
+	ctx = auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kms, &requestInfo)
+	return tested, ctx, proj
+}
+
+func TestTrafficPolicy(t *testing.T) {
+	t.Parallel()
+	tested, ctx, proj := testPolicyService(t)
+
+	created, err := tested.CreateTarget(ctx, &pbs.CreateTargetRequest{Item: &pb.Target{
+		ScopeId: proj.GetPublicId(),
+		Name:    wrapperspb.String("limited"),
+		Type:    tcp.Subtype.String(),
+		Attrs: &pb.Target_TcpTargetAttributes{
+			TcpTargetAttributes: &pb.TcpTargetAttributes{
+				DefaultPort: wrapperspb.UInt32(22),
+			},
+		},
+		ConnectionBandwidthLimit: 1024,
+		SessionByteQuota:         1 << 30,
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1024), created.GetItem().GetConnectionBandwidthLimit())
+	assert.Equal(t, uint64(1<<30), created.GetItem().GetSessionByteQuota())
+
+	_, err = tested.UpdateTarget(ctx, &pbs.UpdateTargetRequest{
+		Id: created.GetItem().GetId(),
+		Item: &pb.Target{
+			ConnectionByteQuota: 100,
+			Version:             created.GetItem().GetVersion() + 1,
+		},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{globals.ConnectionByteQuotaField}},
+	})
+	assert.True(t, errors.Is(err, handlers.NotFoundError()), "Got %v, wanted not found error.", err)
+
+	updated, err := tested.UpdateTarget(ctx, &pbs.UpdateTargetRequest{
+		Id: created.GetItem().GetId(),
+		Item: &pb.Target{
+			Description:         wrapperspb.String("updated"),
+			ConnectionByteQuota: 100,
+			SessionByteQuota:    0,
+			Version:             created.GetItem().GetVersion(),
+		},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{globals.DescriptionField, globals.ConnectionByteQuotaField, globals.SessionByteQuotaField}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "updated", updated.GetItem().GetDescription().GetValue())
+	assert.Equal(t, created.GetItem().GetVersion()+1, updated.GetItem().GetVersion())
+	assert.Equal(t, uint64(1024), updated.GetItem().GetConnectionBandwidthLimit())
+	assert.Equal(t, uint64(100), updated.GetItem().GetConnectionByteQuota())
+	assert.Equal(t, uint64(0), updated.GetItem().GetSessionByteQuota())
+
+	// Updating only the policy increments the version of the target.
+	updated, err = tested.UpdateTarget(ctx, &pbs.UpdateTargetRequest{
+		Id: created.GetItem().GetId(),
+		Item: &pb.Target{
+			SessionBandwidthLimit: 2048,
+			Version:               updated.GetItem().GetVersion(),
+		},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{globals.SessionBandwidthLimitField}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, created.GetItem().GetVersion()+2, updated.GetItem().GetVersion())
+	assert.Equal(t, uint64(2048), updated.GetItem().GetSessionBandwidthLimit())
+
+	got, err := tested.GetTarget(ctx, &pbs.GetTargetRequest{Id: created.GetItem().GetId()})
+	require.NoError(t, err)
+	assert.Equal(t, updated.GetItem().GetVersion(), got.GetItem().GetVersion())
+	assert.Equal(t, uint64(1024), got.GetItem().GetConnectionBandwidthLimit())
+	assert.Equal(t, uint64(2048), got.GetItem().GetSessionBandwidthLimit())
+	assert.Equal(t, uint64(100), got.GetItem().GetConnectionByteQuota())
+}
+
+func TestSessionPolicy(t *testing.T) {
+	t.Parallel()
+	tested, ctx, proj := testPolicyService(t)
+
+	created, err := tested.CreateTarget(ctx, &pbs.CreateTargetRequest{Item: &pb.Target{
+		ScopeId: proj.GetPublicId(),
+		Name:    wrapperspb.String("session limited"),
+		Type:    tcp.Subtype.String(),
+		Attrs: &pb.Target_TcpTargetAttributes{
+			TcpTargetAttributes: &pb.TcpTargetAttributes{
+				DefaultPort: wrapperspb.UInt32(22),
+			},
+		},
+		MaxActiveSessions: 10,
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(10), created.GetItem().GetMaxActiveSessions())
+	assert.Equal(t, uint32(0), created.GetItem().GetMaxActiveSessionsPerUser())
+
+	_, err = tested.UpdateTarget(ctx, &pbs.UpdateTargetRequest{
+		Id: created.GetItem().GetId(),
+		Item: &pb.Target{
+			MaxActiveSessionsPerUser: 2,
+			Version:                  created.GetItem().GetVersion() + 1,
+		},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{globals.MaxActiveSessionsPerUserField}},
+	})
+	assert.True(t, errors.Is(err, handlers.NotFoundError()), "Got %v, wanted not found error.", err)
+
+	updated, err := tested.UpdateTarget(ctx, &pbs.UpdateTargetRequest{
+		Id: created.GetItem().GetId(),
+		Item: &pb.Target{
+			MaxActiveSessionsPerUser: 2,
+			Version:                  created.GetItem().GetVersion(),
+		},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{globals.MaxActiveSessionsPerUserField}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, created.GetItem().GetVersion()+1, updated.GetItem().GetVersion())
+	assert.Equal(t, uint32(10), updated.GetItem().GetMaxActiveSessions())
+	assert.Equal(t, uint32(2), updated.GetItem().GetMaxActiveSessionsPerUser())
+
+	got, err := tested.GetTarget(ctx, &pbs.GetTargetRequest{Id: created.GetItem().GetId()})
+	require.NoError(t, err)
+	assert.Equal(t, updated.GetItem().GetVersion(), got.GetItem().GetVersion())
+	assert.Equal(t, uint32(10), got.GetItem().GetMaxActiveSessions())
+	assert.Equal(t, uint32(2), got.GetItem().GetMaxActiveSessionsPerUser())
+}
+
 func TestAddTargetHostSources(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -3114,6 +3278,12 @@ func TestAuthorizeSession(t *testing.T) {
 	staticCredRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(ctx, rw, rw, kms)
 	}
+	fileCredRepoFn := func() (*credfile.Repository, error) {
+		return credfile.NewRepository(ctx, rw, rw, kms)
+	}
+	issuanceRepoFn := func() (*credential.IssuanceRepository, error) {
+		return credential.NewIssuanceRepository(ctx, rw, rw)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, rw, rw, kms)
 	}
@@ -3126,6 +3296,12 @@ func TestAuthorizeSession(t *testing.T) {
 	ldapAuthRepoFn := func() (*ldap.Repository, error) {
 		return ldap.NewRepository(ctx, rw, rw, kms)
 	}
+	samlAuthRepoFn := func() (*saml.Repository, error) {
+		return saml.NewRepository(ctx, rw, rw, kms)
+	}
+	certAuthRepoFn := func() (*cert.Repository, error) {
+		return cert.NewRepository(ctx, rw, rw, kms)
+	}
 	targetAliasRepoFn := func() (*talias.Repository, error) {
 		return talias.NewRepository(ctx, rw, rw, kms)
 	}
@@ -3177,6 +3353,8 @@ func TestAuthorizeSession(t *testing.T) {
 		passwordAuthRepoFn,
 		oidcAuthRepoFn,
 		ldapAuthRepoFn,
+		samlAuthRepoFn,
+		certAuthRepoFn,
 		kms,
 		&authpb.RequestInfo{
 			Token:       at.GetToken(),
@@ -3212,7 +3390,7 @@ func TestAuthorizeSession(t *testing.T) {
 	sec, tok := v.CreateToken(t, vault.WithPolicies([]string{"default", "boundary-controller", "pki"}))
 
 	vaultStore := vault.TestCredentialStore(t, conn, wrapper, proj.GetPublicId(), v.Addr, tok, sec.Auth.Accessor)
-	credService, err := credentiallibraries.NewService(ctx, iamRepoFn, vaultCredRepoFn, 1000)
+	credService, err := credentiallibraries.NewService(ctx, iamRepoFn, vaultCredRepoFn, fileCredRepoFn, issuanceRepoFn, 1000)
 	require.NoError(t, err)
 	clsResp, err := credService.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &credlibpb.CredentialLibrary{
 		CredentialStoreId: vaultStore.GetPublicId(),
@@ -3268,7 +3446,7 @@ func TestAuthorizeSession(t *testing.T) {
 
 	statusGracePeriod := new(atomic.Int64)
 	statusGracePeriod.Store(int64(server.DefaultLiveness))
-	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, targetAliasRepoFn, nil, statusGracePeriod, 1000, nil)
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, fileCredRepoFn, targetAliasRepoFn, nil, statusGracePeriod, server.RandomWorkerRouting, 0, 1000, nil)
 	require.NoError(t, err)
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -3430,6 +3608,12 @@ func TestAuthorizeSessionTypedCredentials(t *testing.T) {
 	staticCredRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(ctx, rw, rw, kms)
 	}
+	fileCredRepoFn := func() (*credfile.Repository, error) {
+		return credfile.NewRepository(ctx, rw, rw, kms)
+	}
+	issuanceRepoFn := func() (*credential.IssuanceRepository, error) {
+		return credential.NewIssuanceRepository(ctx, rw, rw)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, rw, rw, kms)
 	}
@@ -3459,7 +3643,7 @@ func TestAuthorizeSessionTypedCredentials(t *testing.T) {
 
 	statusGracePeriod := new(atomic.Int64)
 	statusGracePeriod.Store(int64(server.DefaultLiveness))
-	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, targetAliasRepoFn, nil, statusGracePeriod, 1000, nil)
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, fileCredRepoFn, targetAliasRepoFn, nil, statusGracePeriod, server.RandomWorkerRouting, 0, 1000, nil)
 	require.NoError(t, err)
 
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
@@ -3472,7 +3656,7 @@ func TestAuthorizeSessionTypedCredentials(t *testing.T) {
 	sec, tok := v.CreateToken(t, vault.WithPolicies([]string{"default", "boundary-controller", "secret"}))
 
 	vaultStore := vault.TestCredentialStore(t, conn, wrapper, proj.GetPublicId(), v.Addr, tok, sec.Auth.Accessor)
-	credLibService, err := credentiallibraries.NewService(ctx, iamRepoFn, vaultCredRepoFn, 1000)
+	credLibService, err := credentiallibraries.NewService(ctx, iamRepoFn, vaultCredRepoFn, fileCredRepoFn, issuanceRepoFn, 1000)
 	require.NoError(t, err)
 
 	// Create secret in vault with default username and password fields
@@ -3532,7 +3716,7 @@ func TestAuthorizeSessionTypedCredentials(t *testing.T) {
 	require.NoError(t, err)
 
 	staticStore := credstatic.TestCredentialStore(t, conn, wrapper, proj.GetPublicId())
-	credService, err := credentials.NewService(ctx, iamRepoFn, staticCredRepoFn, 1000)
+	credService, err := credentials.NewService(ctx, iamRepoFn, staticCredRepoFn, issuanceRepoFn, 1000)
 	require.NoError(t, err)
 	upCredResp, err := credService.CreateCredential(ctx, &pbs.CreateCredentialRequest{Item: &credpb.Credential{
 		CredentialStoreId: staticStore.GetPublicId(),
@@ -4035,6 +4219,12 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	staticCredRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(ctx, rw, rw, kms)
 	}
+	fileCredRepoFn := func() (*credfile.Repository, error) {
+		return credfile.NewRepository(ctx, rw, rw, kms)
+	}
+	issuanceRepoFn := func() (*credential.IssuanceRepository, error) {
+		return credential.NewIssuanceRepository(ctx, rw, rw)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, rw, rw, kms)
 	}
@@ -4045,7 +4235,7 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 
 	statusGracePeriod := new(atomic.Int64)
 	statusGracePeriod.Store(int64(server.DefaultLiveness))
-	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, targetAliasRepoFn, nil, statusGracePeriod, 1000, nil)
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, fileCredRepoFn, targetAliasRepoFn, nil, statusGracePeriod, server.RandomWorkerRouting, 0, 1000, nil)
 	require.NoError(t, err)
 
 	// Authorized user gets full permissions
@@ -4131,7 +4321,7 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	}
 
 	libraryExists := func(tar target.Target) (version uint32) {
-		credService, err := credentiallibraries.NewService(ctx, iamRepoFn, vaultCredRepoFn, 1000)
+		credService, err := credentiallibraries.NewService(ctx, iamRepoFn, vaultCredRepoFn, fileCredRepoFn, issuanceRepoFn, 1000)
 		require.NoError(t, err)
 		clsResp, err := credService.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &credlibpb.CredentialLibrary{
 			CredentialStoreId: store.GetPublicId(),
@@ -4156,7 +4346,7 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	}
 
 	misConfiguredlibraryExists := func(tar target.Target) (version uint32) {
-		credService, err := credentiallibraries.NewService(ctx, iamRepoFn, vaultCredRepoFn, 1000)
+		credService, err := credentiallibraries.NewService(ctx, iamRepoFn, vaultCredRepoFn, fileCredRepoFn, issuanceRepoFn, 1000)
 		require.NoError(t, err)
 		clsResp, err := credService.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &credlibpb.CredentialLibrary{
 			CredentialStoreId: store.GetPublicId(),
@@ -4181,7 +4371,7 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	}
 
 	expiredTokenLibrary := func(tar target.Target) (version uint32) {
-		credService, err := credentiallibraries.NewService(ctx, iamRepoFn, vaultCredRepoFn, 1000)
+		credService, err := credentiallibraries.NewService(ctx, iamRepoFn, vaultCredRepoFn, fileCredRepoFn, issuanceRepoFn, 1000)
 		require.NoError(t, err)
 		clsResp, err := credService.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &credlibpb.CredentialLibrary{
 			CredentialStoreId: expiredStore.GetPublicId(),
@@ -4205,6 +4395,34 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 		return tr.GetItem().GetVersion()
 	}
 
+	sessionLimitReached := func(tar target.Target) (version uint32) {
+		repo, err := repoFn()
+		require.NoError(t, err)
+		p, err := target.NewSessionPolicy(ctx, tar.GetPublicId())
+		require.NoError(t, err)
+		p.MaxActiveSessions = 1
+		_, err = repo.SetSessionPolicy(ctx, p)
+		require.NoError(t, err)
+		_, err = s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{Id: tar.GetPublicId()})
+		require.NoError(t, err)
+		return tar.GetVersion()
+	}
+
+	credentialCheckedOut := func(tar target.Target) (version uint32) {
+		cs := credstatic.TestCredentialStore(t, conn, wrapper, proj.GetPublicId(), credstatic.WithExclusiveCheckout(true))
+		cred := credstatic.TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.GetPublicId(), proj.GetPublicId())
+		tr, err := s.AddTargetCredentialSources(ctx,
+			&pbs.AddTargetCredentialSourcesRequest{
+				Id:                          tar.GetPublicId(),
+				BrokeredCredentialSourceIds: []string{cred.GetPublicId()},
+				Version:                     tar.GetVersion(),
+			})
+		require.NoError(t, err)
+		_, err = s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{Id: tar.GetPublicId()})
+		require.NoError(t, err)
+		return tr.GetItem().GetVersion()
+	}
+
 	cases := []struct {
 		name            string
 		setup           []func(target.Target) uint32
@@ -4268,6 +4486,18 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 			useTargetId:     true,
 			wantErrContains: "vault.newClient: invalid configuration",
 		},
+		{
+			name:            "session limit reached",
+			setup:           []func(tcpTarget target.Target) uint32{workerExists, hostExists, sessionLimitReached},
+			useTargetId:     true,
+			wantErrContains: "Active session limit reached: target ttcp_",
+		},
+		{
+			name:            "credential checked out",
+			setup:           []func(tcpTarget target.Target) uint32{workerExists, hostExists, credentialCheckedOut},
+			useTargetId:     true,
+			wantErrContains: "Credential checked out: credentials credup_",
+		},
 	}
 	for i, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+)
+
+// trafficPolicyFields maps the update mask paths of the traffic policy fields
+// to a function setting the corresponding TrafficPolicy field. The policy
+// applies to every target subtype and is stored separately from the target,
+// so these paths are removed before the subtype update.
+var trafficPolicyFields = map[string]func(*target.TrafficPolicy, *pb.Target){
+	globals.ConnectionBandwidthLimitField: func(p *target.TrafficPolicy, t *pb.Target) {
+		p.ConnectionBandwidthLimit = t.GetConnectionBandwidthLimit()
+	},
+	globals.SessionBandwidthLimitField: func(p *target.TrafficPolicy, t *pb.Target) {
+		p.SessionBandwidthLimit = t.GetSessionBandwidthLimit()
+	},
+	globals.ConnectionByteQuotaField: func(p *target.TrafficPolicy, t *pb.Target) {
+		p.ConnectionByteQuota = t.GetConnectionByteQuota()
+	},
+	globals.SessionByteQuotaField: func(p *target.TrafficPolicy, t *pb.Target) {
+		p.SessionByteQuota = t.GetSessionByteQuota()
+	},
+}
+
+// createTrafficPolicy stores the traffic policy fields of item for the newly
+// created target t. Nothing is stored if item does not set any of them.
+func (s Service) createTrafficPolicy(ctx context.Context, t target.Target, item *pb.Target) error {
+	p, err := target.NewTrafficPolicy(ctx, t.GetPublicId())
+	if err != nil {
+		return err
+	}
+	for _, set := range trafficPolicyFields {
+		set(p, item)
+	}
+	if p.IsUnlimited() {
+		return nil
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return err
+	}
+	if _, err := repo.SetTrafficPolicy(ctx, p); err != nil {
+		return fmt.Errorf("unable to set traffic policy: %w", err)
+	}
+	return nil
+}
+
+// updatedTrafficPolicy returns the traffic policy of the target with the
+// given id updated as described by mask, which must only contain traffic
+// policy fields. The returned policy is not stored.
+func updatedTrafficPolicy(ctx context.Context, repo *target.Repository, id string, mask []string, item *pb.Target) (*target.TrafficPolicy, error) {
+	p, err := repo.GetTrafficPolicy(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get traffic policy: %w", err)
+	}
+	for _, path := range mask {
+		trafficPolicyFields[path](p, item)
+	}
+	return p, nil
+}
+
+// updateWithPolicies updates the target, its traffic policy and its session
+// policy as described by mask and item in one transaction.
+func (s Service) updateWithPolicies(ctx context.Context, scopeId, id string, mask []string, item *pb.Target) (target.Target, []target.HostSource, []target.CredentialSource, error) {
+	var targetMask, trafficMask, sessionMask []string
+	for _, path := range mask {
+		if _, ok := trafficPolicyFields[path]; ok {
+			trafficMask = append(trafficMask, path)
+			continue
+		}
+		if _, ok := sessionPolicyFields[path]; ok {
+			sessionMask = append(sessionMask, path)
+			continue
+		}
+		targetMask = append(targetMask, path)
+	}
+	if len(trafficMask) == 0 && len(sessionMask) == 0 {
+		return s.updateInRepo(ctx, scopeId, id, mask, item)
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var policyOpts []target.Option
+	if len(trafficMask) > 0 {
+		p, err := updatedTrafficPolicy(ctx, repo, id, trafficMask, item)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		policyOpts = append(policyOpts, target.WithTrafficPolicy(p))
+	}
+	if len(sessionMask) > 0 {
+		p, err := updatedSessionPolicy(ctx, repo, id, sessionMask, item)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		policyOpts = append(policyOpts, target.WithSessionPolicy(p))
+	}
+	return s.updateInRepo(ctx, scopeId, id, targetMask, item, policyOpts...)
+}
+
+// addTrafficPolicy sets the traffic policy fields of item, the proto of the
+// target with the given id, that are included in outputFields.
+func (s Service) addTrafficPolicy(ctx context.Context, id string, outputFields *perms.OutputFields, item *pb.Target) error {
+	if !outputFields.Has(globals.ConnectionBandwidthLimitField) &&
+		!outputFields.Has(globals.SessionBandwidthLimitField) &&
+		!outputFields.Has(globals.ConnectionByteQuotaField) &&
+		!outputFields.Has(globals.SessionByteQuotaField) {
+		return nil
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return err
+	}
+	p, err := repo.GetTrafficPolicy(ctx, id)
+	if err != nil {
+		return err
+	}
+	if outputFields.Has(globals.ConnectionBandwidthLimitField) {
+		item.ConnectionBandwidthLimit = p.ConnectionBandwidthLimit
+	}
+	if outputFields.Has(globals.SessionBandwidthLimitField) {
+		item.SessionBandwidthLimit = p.SessionBandwidthLimit
+	}
+	if outputFields.Has(globals.ConnectionByteQuotaField) {
+		item.ConnectionByteQuota = p.ConnectionByteQuota
+	}
+	if outputFields.Has(globals.SessionByteQuotaField) {
+		item.SessionByteQuota = p.SessionByteQuota
+	}
+	return nil
+}
+
+// sessionTrafficLimit returns the traffic limit for a session to the target
+// with the given id, or nil if the target does not limit traffic.
+func (s Service) sessionTrafficLimit(ctx context.Context, repo *target.Repository, id string) (*session.TrafficLimit, error) {
+	p, err := repo.GetTrafficPolicy(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if p.IsUnlimited() {
+		return nil, nil
+	}
+	return &session.TrafficLimit{
+		ConnectionBandwidthLimit: p.ConnectionBandwidthLimit,
+		SessionBandwidthLimit:    p.SessionBandwidthLimit,
+		ConnectionByteQuota:      p.ConnectionByteQuota,
+		SessionByteQuota:         p.SessionByteQuota,
+	}, nil
+}
@@ -43,6 +43,7 @@ var (
 		action.AddAccounts,
 		action.SetAccounts,
 		action.RemoveAccounts,
+		action.RevokeAuthTokens,
 	)
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -71,21 +72,25 @@ type Service struct {
 	pbs.UnsafeUserServiceServer
 
 	repoFn      common.IamRepoFactory
+	atRepoFn    common.AuthTokenRepoFactory
 	maxPageSize uint
 }
 
 var _ pbs.UserServiceServer = (*Service)(nil)
 
 // NewService returns a user service which handles user related requests to boundary.
-func NewService(ctx context.Context, repo common.IamRepoFactory, maxPageSize uint) (Service, error) {
+func NewService(ctx context.Context, repo common.IamRepoFactory, atRepo common.AuthTokenRepoFactory, maxPageSize uint) (Service, error) {
 	const op = "users.NewService"
 	if repo == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository")
 	}
+	if atRepo == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing auth token repository")
+	}
 	if maxPageSize == 0 {
 		maxPageSize = uint(globals.DefaultMaxPageSize)
 	}
-	return Service{repoFn: repo, maxPageSize: maxPageSize}, nil
+	return Service{repoFn: repo, atRepoFn: atRepo, maxPageSize: maxPageSize}, nil
 }
 
 // ListUsers implements the interface pbs.UserServiceServer.
@@ -354,6 +359,27 @@ func (s Service) DeleteUser(ctx context.Context, req *pbs.DeleteUserRequest) (*p
 	return nil, nil
 }
 
+// RevokeUserAuthTokens implements the interface pbs.UserServiceServer.
+func (s Service) RevokeUserAuthTokens(ctx context.Context, req *pbs.RevokeUserAuthTokensRequest) (*pbs.RevokeUserAuthTokensResponse, error) {
+	const op = "users.(Service).RevokeUserAuthTokens"
+	if err := validateRevokeAuthTokensRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.RevokeAuthTokens)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.atRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	count, err := repo.DeleteUserAuthTokens(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to revoke auth tokens"))
+	}
+	return &pbs.RevokeUserAuthTokensResponse{RevokedCount: uint32(count)}, nil
+}
+
 // AddUserAccounts implements the interface pbs.GroupServiceServer.
 func (s Service) AddUserAccounts(ctx context.Context, req *pbs.AddUserAccountsRequest) (*pbs.AddUserAccountsResponse, error) {
 	const op = "users.(Service).AddUserAccounts"
@@ -751,6 +777,17 @@ func validateDeleteRequest(req *pbs.DeleteUserRequest) error {
 	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.UserPrefix)
 }
 
+func validateRevokeAuthTokensRequest(req *pbs.RevokeUserAuthTokensRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.UserPrefix) {
+		badFields["id"] = "Incorrectly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
 func validateListRequest(ctx context.Context, req *pbs.ListUsersRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) &&
@@ -784,6 +821,8 @@ func validateAddUserAccountsRequest(req *pbs.AddUserAccountsRequest) error {
 			globals.PasswordAccountPrefix,
 			globals.OidcAccountPrefix,
 			globals.LdapAccountPrefix,
+			globals.SamlAccountPrefix,
+			globals.CertAccountPrefix,
 		) {
 			badFields["account_ids"] = "Values must be valid account ids."
 			break
@@ -810,6 +849,8 @@ func validateSetUserAccountsRequest(req *pbs.SetUserAccountsRequest) error {
 			globals.PasswordAccountPrefix,
 			globals.OidcAccountPrefix,
 			globals.LdapAccountPrefix,
+			globals.SamlAccountPrefix,
+			globals.CertAccountPrefix,
 		) {
 			badFields["account_ids"] = "Values must be valid account ids."
 			break
@@ -839,6 +880,8 @@ func validateRemoveUserAccountsRequest(req *pbs.RemoveUserAccountsRequest) error
 			globals.PasswordAccountPrefix,
 			globals.OidcAccountPrefix,
 			globals.LdapAccountPrefix,
+			globals.SamlAccountPrefix,
+			globals.CertAccountPrefix,
 		) {
 			badFields["account_ids"] = "Values must be valid account ids."
 			break
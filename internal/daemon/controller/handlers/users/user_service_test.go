@@ -42,9 +42,9 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-accounts", "set-accounts", "remove-accounts"}
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-accounts", "set-accounts", "remove-accounts", "revoke-auth-tokens"}
 
-func createDefaultUserAndRepo(t *testing.T, withAccts bool) (*iam.User, []string, func() (*iam.Repository, error)) {
+func createDefaultUserAndRepo(t *testing.T, withAccts bool) (*iam.User, []string, func() (*iam.Repository, error), func() (*authtoken.Repository, error)) {
 	t.Helper()
 	conn, _ := db.TestSetup(t, "postgres")
 	wrap := db.TestWrapper(t)
@@ -52,16 +52,20 @@ func createDefaultUserAndRepo(t *testing.T, withAccts bool) (*iam.User, []string
 	repoFn := func() (*iam.Repository, error) {
 		return repo, nil
 	}
+	rw := db.New(conn)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(context.Background(), rw, rw, kmsCache)
+	}
 	o, _ := iam.TestScopes(t, repo)
 	u := iam.TestUser(t, repo, o.GetPublicId(), iam.WithDescription("default"), iam.WithName("default"))
 
 	switch withAccts {
 	case false:
-		return u, nil, repoFn
+		return u, nil, repoFn, atRepoFn
 	default:
 		require := require.New(t)
 		ctx := context.Background()
-		kmsCache := kms.TestKms(t, conn, wrap)
 		databaseWrap, err := kmsCache.GetWrapper(ctx, o.PublicId, kms.KeyPurposeDatabase)
 		require.NoError(err)
 		primaryAm := oidc.TestAuthMethod(t, conn, databaseWrap, o.PublicId, oidc.ActivePublicState, "alice-rp", "fido",
@@ -84,12 +88,12 @@ func createDefaultUserAndRepo(t *testing.T, withAccts bool) (*iam.User, []string
 		// reload the user with their accounts
 		u, accts, err := repo.LookupUser(ctx, u.PublicId)
 		require.NoError(err)
-		return u, accts, repoFn
+		return u, accts, repoFn, atRepoFn
 	}
 }
 
 func TestGet(t *testing.T) {
-	u, uAccts, repoFn := createDefaultUserAndRepo(t, true)
+	u, uAccts, repoFn, atRepoFn := createDefaultUserAndRepo(t, true)
 
 	toMerge := &pbs.GetUserRequest{
 		Id: u.GetPublicId(),
@@ -149,7 +153,7 @@ func TestGet(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.GetUserRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := users.NewService(context.Background(), repoFn, 1000)
+			s, err := users.NewService(context.Background(), repoFn, atRepoFn, 1000)
 			require.NoError(err, "Couldn't create new user service.")
 
 			got, gErr := s.GetUser(auth.DisabledAuthTestContext(repoFn, u.GetScopeId()), req)
@@ -196,7 +200,11 @@ func TestList(t *testing.T) {
 	secondaryAm := password.TestAuthMethods(t, conn, oWithUsers.PublicId, 1)
 	require.Len(t, secondaryAm, 1)
 
-	s, err := users.NewService(context.Background(), repoFn, 1000)
+	rw := db.New(conn)
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	s, err := users.NewService(context.Background(), repoFn, atRepoFn, 1000)
 	require.NoError(t, err)
 
 	var wantUsers []*pb.User
@@ -478,7 +486,7 @@ func TestListPagination(t *testing.T) {
 	}
 	slices.Reverse(allUsers)
 
-	a, err := users.NewService(ctx, iamRepoFn, 1000)
+	a, err := users.NewService(ctx, iamRepoFn, tokenRepoFn, 1000)
 	require.NoError(t, err, "Couldn't create new user service.")
 
 	// Run analyze to update postgres estimates
@@ -752,9 +760,9 @@ func TestListPagination(t *testing.T) {
 }
 
 func TestDelete(t *testing.T) {
-	u, _, repoFn := createDefaultUserAndRepo(t, false)
+	u, _, repoFn, atRepoFn := createDefaultUserAndRepo(t, false)
 
-	s, err := users.NewService(context.Background(), repoFn, 1000)
+	s, err := users.NewService(context.Background(), repoFn, atRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new user service.")
 
 	cases := []struct {
@@ -799,9 +807,9 @@ func TestDelete(t *testing.T) {
 
 func TestDelete_twice(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
-	u, _, repoFn := createDefaultUserAndRepo(t, false)
+	u, _, repoFn, atRepoFn := createDefaultUserAndRepo(t, false)
 
-	s, err := users.NewService(context.Background(), repoFn, 1000)
+	s, err := users.NewService(context.Background(), repoFn, atRepoFn, 1000)
 	require.NoError(err, "Error when getting new user service")
 	req := &pbs.DeleteUserRequest{
 		Id: u.GetPublicId(),
@@ -814,8 +822,83 @@ func TestDelete_twice(t *testing.T) {
 	assert.True(errors.Is(gErr, handlers.ApiErrorWithCode(codes.NotFound)), "Expected permission denied for the second delete.")
 }
 
+func TestRevokeAuthTokens(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	repoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	rw := db.New(conn)
+	atRepo, err := authtoken.NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return atRepo, nil
+	}
+	o, _ := iam.TestScopes(t, iamRepo)
+
+	at := authtoken.TestAuthToken(t, conn, kmsCache, o.GetPublicId())
+	u, _, err := iamRepo.LookupUser(ctx, at.GetIamUserId())
+	require.NoError(t, err)
+	_, err = atRepo.CreateAuthToken(ctx, u, at.GetAuthAccountId())
+	require.NoError(t, err)
+	otherAt := authtoken.TestAuthToken(t, conn, kmsCache, o.GetPublicId())
+
+	s, err := users.NewService(ctx, repoFn, atRepoFn, 1000)
+	require.NoError(t, err, "Error when getting new user service.")
+
+	cases := []struct {
+		name string
+		req  *pbs.RevokeUserAuthTokensRequest
+		res  *pbs.RevokeUserAuthTokensResponse
+		err  error
+	}{
+		{
+			name: "Bad User Id formatting",
+			req: &pbs.RevokeUserAuthTokensRequest{
+				Id: "bad_format",
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Revoke all auth tokens of the user",
+			req: &pbs.RevokeUserAuthTokensRequest{
+				Id: u.GetPublicId(),
+			},
+			res: &pbs.RevokeUserAuthTokensResponse{RevokedCount: 2},
+		},
+		{
+			name: "Revoke again",
+			req: &pbs.RevokeUserAuthTokensRequest{
+				Id: u.GetPublicId(),
+			},
+			res: &pbs.RevokeUserAuthTokensResponse{RevokedCount: 0},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.RevokeUserAuthTokens(auth.DisabledAuthTestContext(repoFn, u.GetScopeId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "RevokeUserAuthTokens(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Empty(cmp.Diff(tc.res, got, protocmp.Transform()), "RevokeUserAuthTokens(%q) got response %q, wanted %q", tc.req, got, tc.res)
+		})
+	}
+
+	// The auth tokens of other users are not revoked.
+	got, err := atRepo.LookupAuthToken(ctx, otherAt.GetPublicId())
+	require.NoError(t, err)
+	assert.NotNil(t, got)
+}
+
 func TestCreate(t *testing.T) {
-	defaultUser, _, repoFn := createDefaultUserAndRepo(t, false)
+	defaultUser, _, repoFn, atRepoFn := createDefaultUserAndRepo(t, false)
 	defaultCreated := defaultUser.GetCreateTime().GetTimestamp().AsTime()
 
 	cases := []struct {
@@ -893,7 +976,7 @@ func TestCreate(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := users.NewService(context.Background(), repoFn, 1000)
+			s, err := users.NewService(context.Background(), repoFn, atRepoFn, 1000)
 			require.NoError(err, "Error when getting new user service.")
 
 			got, gErr := s.CreateUser(auth.DisabledAuthTestContext(repoFn, tc.req.GetItem().GetScopeId()), tc.req)
@@ -928,8 +1011,8 @@ func TestCreate(t *testing.T) {
 }
 
 func TestUpdate(t *testing.T) {
-	u, _, repoFn := createDefaultUserAndRepo(t, false)
-	tested, err := users.NewService(context.Background(), repoFn, 1000)
+	u, _, repoFn, atRepoFn := createDefaultUserAndRepo(t, false)
+	tested, err := users.NewService(context.Background(), repoFn, atRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new user service.")
 
 	created := u.GetCreateTime().GetTimestamp().AsTime()
@@ -1213,7 +1296,11 @@ func TestAddAccount(t *testing.T) {
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
-	s, err := users.NewService(ctx, repoFn, 1000)
+	rw := db.New(conn)
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	s, err := users.NewService(ctx, repoFn, atRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new user service.")
 
 	o, _ := iam.TestScopes(t, iamRepo)
@@ -1373,7 +1460,11 @@ func TestSetAccount(t *testing.T) {
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
-	s, err := users.NewService(ctx, repoFn, 1000)
+	rw := db.New(conn)
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	s, err := users.NewService(ctx, repoFn, atRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new user service.")
 
 	o, _ := iam.TestScopes(t, iamRepo)
@@ -1535,7 +1626,11 @@ func TestRemoveAccount(t *testing.T) {
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
-	s, err := users.NewService(ctx, repoFn, 1000)
+	rw := db.New(conn)
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	s, err := users.NewService(ctx, repoFn, atRepoFn, 1000)
 	require.NoError(t, err, "Error when getting new user service.")
 
 	o, _ := iam.TestScopes(t, iamRepo)
@@ -10,6 +10,7 @@ import (
 	stderrors "errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	wl "github.com/hashicorp/boundary/internal/daemon/common"
@@ -55,6 +56,7 @@ var (
 		action.AddWorkerTags,
 		action.SetWorkerTags,
 		action.RemoveWorkerTags,
+		action.Drain,
 	)
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -527,6 +529,48 @@ func (s Service) RemoveWorkerTags(ctx context.Context, req *pbs.RemoveWorkerTags
 	return &pbs.RemoveWorkerTagsResponse{Item: item}, nil
 }
 
+// DrainWorker puts the worker into drain mode. The worker is sent the drain
+// deadline with its next status response.
+func (s Service) DrainWorker(ctx context.Context, req *pbs.DrainWorkerRequest) (*pbs.DrainWorkerResponse, error) {
+	const op = "workers.(Service).DrainWorker"
+
+	if err := validateDrainRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Drain)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	timeout := server.DefaultDrainTimeout
+	if req.GetDrainTimeoutSeconds() > 0 {
+		timeout = time.Duration(req.GetDrainTimeoutSeconds()) * time.Second
+	}
+	w, err := s.drainInRepo(ctx, req.GetId(), req.GetVersion(), time.Now().Add(timeout))
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, w.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := s.toProto(ctx, w, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.DrainWorkerResponse{Item: item}, nil
+}
+
 // ReadCertificateAuthority will list the next and current certificates for the worker certificate authority
 func (s Service) ReadCertificateAuthority(ctx context.Context, req *pbs.ReadCertificateAuthorityRequest) (*pbs.ReadCertificateAuthorityResponse, error) {
 	const op = "workers.(Service).ReadCertificateAuthority"
@@ -749,6 +793,22 @@ func (s Service) removeTagsInRepo(ctx context.Context, workerId string, workerVe
 	return w, nil
 }
 
+func (s Service) drainInRepo(ctx context.Context, workerId string, workerVersion uint32, deadline time.Time) (*server.Worker, error) {
+	const op = "workers.(Service).drainInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	w, rowsUpdated, err := repo.DrainWorker(ctx, workerId, workerVersion, deadline)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to drain worker"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Worker %q doesn't exist or incorrect version provided.", workerId)
+	}
+	return w, nil
+}
+
 func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
 	res := auth.VerifyResults{}
 	repo, err := s.repoFn()
@@ -895,6 +955,9 @@ func (s Service) toProto(ctx context.Context, in *server.Worker, opt ...handlers
 	if outputFields.Has(globals.LastStatusTimeField) {
 		out.LastStatusTime = in.GetLastStatusTime().GetTimestamp()
 	}
+	if outputFields.Has(globals.DrainDeadlineField) && in.Worker != nil && in.Worker.GetDrainDeadline() != nil {
+		out.DrainDeadline = in.Worker.GetDrainDeadline().GetTimestamp()
+	}
 	if outputFields.Has(globals.ActiveConnectionCountField) {
 		out.ActiveConnectionCount = &wrapperspb.UInt32Value{Value: in.ActiveConnectionCount()}
 	}
@@ -1181,6 +1244,20 @@ func validateRemoveTagsRequest(req *pbs.RemoveWorkerTagsRequest) error {
 	return nil
 }
 
+func validateDrainRequest(req *pbs.DrainWorkerRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.WorkerPrefix) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	if req.GetVersion() == 0 {
+		badFields[globals.VersionField] = "Required field."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
 func validateReadCaRequest(req *pbs.ReadCertificateAuthorityRequest) error {
 	badFields := map[string]string{}
 	if req.GetScopeId() != scope.Global.String() {
@@ -9,6 +9,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -44,7 +45,7 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-worker-tags", "set-worker-tags", "remove-worker-tags"}
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-worker-tags", "set-worker-tags", "remove-worker-tags", "drain"}
 
 func structListValue(t *testing.T, ss ...string) *structpb.ListValue {
 	t.Helper()
@@ -2454,6 +2455,86 @@ func TestService_RemoveWorkerTags(t *testing.T) {
 	}
 }
 
+func TestService_DrainWorker(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	_, proj := iam.TestScopes(t, iamRepo)
+	rw := db.New(conn)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, testKms)
+	}
+	workerAuthRepo, err := server.NewRepositoryStorage(ctx, rw, rw, testKms)
+	require.NoError(err)
+	workerAuthRepoFn := func() (*server.WorkerAuthRepositoryStorage, error) {
+		return workerAuthRepo, nil
+	}
+	s, err := NewService(context.Background(), repoFn, iamRepoFn, workerAuthRepoFn, nil)
+	require.NoError(err)
+	worker := server.TestKmsWorker(t, conn, wrapper)
+
+	tests := []struct {
+		name            string
+		req             *pbs.DrainWorkerRequest
+		wantTimeout     time.Duration
+		wantErrContains string
+	}{
+		{
+			name:            "bad-id",
+			req:             &pbs.DrainWorkerRequest{Id: "bad_id", Version: worker.Version},
+			wantErrContains: "Incorrectly formatted identifier.",
+		},
+		{
+			name:            "nil-version",
+			req:             &pbs.DrainWorkerRequest{Id: worker.PublicId},
+			wantErrContains: "Required field.",
+		},
+		{
+			name:            "bad-version",
+			req:             &pbs.DrainWorkerRequest{Id: worker.PublicId, Version: worker.Version + 10},
+			wantErrContains: "doesn't exist or incorrect version provided.",
+		},
+		{
+			name: "default-timeout",
+			req: func() *pbs.DrainWorkerRequest {
+				worker := server.TestKmsWorker(t, conn, wrapper)
+				return &pbs.DrainWorkerRequest{Id: worker.PublicId, Version: worker.Version}
+			}(),
+			wantTimeout: server.DefaultDrainTimeout,
+		},
+		{
+			name: "custom-timeout",
+			req: func() *pbs.DrainWorkerRequest {
+				worker := server.TestKmsWorker(t, conn, wrapper)
+				return &pbs.DrainWorkerRequest{Id: worker.PublicId, Version: worker.Version, DrainTimeoutSeconds: 60}
+			}(),
+			wantTimeout: time.Minute,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			start := time.Now()
+			got, err := s.DrainWorker(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
+			if len(tc.wantErrContains) > 0 {
+				assert.Nil(got)
+				require.Error(err)
+				assert.Contains(err.Error(), tc.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.req.Version+1, got.GetItem().GetVersion())
+			deadline := got.GetItem().GetDrainDeadline().AsTime()
+			assert.WithinDuration(start.Add(tc.wantTimeout), deadline, 5*time.Second)
+		})
+	}
+}
+
 func TestReadCertificateAuthority(t *testing.T) {
 	require, assert := require.New(t), assert.New(t)
 	ctx := context.Background()
@@ -69,6 +69,8 @@ func requestCtxUnaryInterceptor(
 	passwordAuthRepoFn common.PasswordAuthRepoFactory,
 	oidcAuthRepoFn common.OidcAuthRepoFactory,
 	ldapAuthRepoFn common.LdapAuthRepoFactory,
+	samlAuthRepoFn common.SamlAuthRepoFactory,
+	certAuthRepoFn common.CertAuthRepoFactory,
 	kms *kms.Kms,
 	ticket string,
 	eventer *event.Eventer,
@@ -101,6 +103,8 @@ func requestCtxUnaryInterceptor(
 			passwordAuthRepoFn,
 			oidcAuthRepoFn,
 			ldapAuthRepoFn,
+			samlAuthRepoFn,
+			certAuthRepoFn,
 			kms,
 			ticket,
 			eventer,
@@ -124,6 +128,8 @@ func requestCtxStreamInterceptor(
 	passwordAuthRepoFn common.PasswordAuthRepoFactory,
 	oidcAuthRepoFn common.OidcAuthRepoFactory,
 	ldapAuthRepoFn common.LdapAuthRepoFactory,
+	samlAuthRepoFn common.SamlAuthRepoFactory,
+	certAuthRepoFn common.CertAuthRepoFactory,
 	kms *kms.Kms,
 	ticket string,
 	eventer *event.Eventer,
@@ -155,6 +161,8 @@ func requestCtxStreamInterceptor(
 			passwordAuthRepoFn,
 			oidcAuthRepoFn,
 			ldapAuthRepoFn,
+			samlAuthRepoFn,
+			certAuthRepoFn,
 			kms,
 			ticket,
 			eventer,
@@ -211,6 +219,8 @@ func sharedRequestInterceptorLogic(
 	passwordAuthRepoFn common.PasswordAuthRepoFactory,
 	oidcAuthRepoFn common.OidcAuthRepoFactory,
 	ldapAuthRepoFn common.LdapAuthRepoFactory,
+	samlAuthRepoFn common.SamlAuthRepoFactory,
+	certAuthRepoFn common.CertAuthRepoFactory,
 	kms *kms.Kms,
 	ticket string,
 	eventer *event.Eventer,
@@ -243,7 +253,7 @@ func sharedRequestInterceptorLogic(
 		return nil, errors.New(interceptorCtx, errors.Internal, op, "Invalid context (bad ticket)")
 	}
 
-	interceptorCtx = auth.NewVerifierContextWithAccounts(interceptorCtx, iamRepoFn, authTokenRepoFn, serversRepoFn, passwordAuthRepoFn, oidcAuthRepoFn, ldapAuthRepoFn, kms, &requestInfo)
+	interceptorCtx = auth.NewVerifierContextWithAccounts(interceptorCtx, iamRepoFn, authTokenRepoFn, serversRepoFn, passwordAuthRepoFn, oidcAuthRepoFn, ldapAuthRepoFn, samlAuthRepoFn, certAuthRepoFn, kms, &requestInfo)
 
 	// Add general request information to the context. The information from
 	// the auth verifier context is pretty specifically curated to
@@ -63,7 +63,7 @@ func Test_newRateLimiterConfig(t *testing.T) {
 			ratelimit.DefaultLimiterMaxQuotas(),
 			false,
 			&rateLimiterConfig{
-				maxSize:  336168,
+				maxSize:  354177,
 				configs:  nil,
 				disabled: false,
 				limits:   defaultLimits,
@@ -46,6 +46,7 @@ func registerControllerServerCoordinationService(ctx context.Context, c *Control
 		c.WorkerAuthRepoStorageFn,
 		c.SessionRepoFn,
 		c.ConnectionRepoFn,
+		c.VaultCredentialRepoFn,
 		c.downstreamWorkers,
 		c.workerStatusUpdateTimes,
 		c.kms,
@@ -73,6 +74,7 @@ func registerControllerSessionService(ctx context.Context, c *Controller, server
 		c.WorkerAuthRepoStorageFn,
 		c.SessionRepoFn,
 		c.ConnectionRepoFn,
+		c.VaultCredentialRepoFn,
 		c.downstreamWorkers,
 		c.workerStatusUpdateTimes,
 		c.kms,
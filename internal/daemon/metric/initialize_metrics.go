@@ -25,6 +25,8 @@ const (
 	LabelHttpPath       = "path"
 	LabelHttpMethod     = "method"
 	LabelHttpCode       = "code"
+	LabelTargetId       = "target_id"
+	LabelProjectId      = "project_id"
 
 	invalidPathValue = "invalid"
 )
@@ -32,6 +34,11 @@ const (
 var (
 	ListGrpcLabels = []string{LabelGrpcService, LabelGrpcMethod, LabelGrpcCode}
 	ListHttpLabels = []string{LabelHttpPath, LabelHttpMethod, LabelHttpCode}
+
+	// ListProxySessionLabels are the labels which can optionally be attached
+	// to the worker proxy session metrics. None are attached by default as
+	// each one multiplies the number of reported series.
+	ListProxySessionLabels = []string{LabelTargetId, LabelProjectId}
 )
 
 /* The following methods are used to initialize Prometheus histogram vectors for gRPC connections. */
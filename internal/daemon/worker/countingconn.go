@@ -6,6 +6,8 @@ package worker
 import (
 	"net"
 	"sync"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/internal/metric"
 )
 
 // countingConn is a `net.Conn` implementation that records the bytes that go
@@ -15,6 +17,9 @@ import (
 type countingConn struct {
 	net.Conn
 
+	// metrics, if set, is also reported the bytes read and written.
+	metrics *metric.ProxyConnection
+
 	bytesRead    int64
 	bytesWritten int64
 	// Use mutex for counters as net.Conn methods may be called concurrently
@@ -43,6 +48,9 @@ func (c *countingConn) Read(in []byte) (int, error) {
 	c.mu.Lock()
 	c.bytesRead += int64(n)
 	c.mu.Unlock()
+	if c.metrics != nil {
+		c.metrics.AddReceived(n)
+	}
 	return n, err
 }
 
@@ -53,5 +61,8 @@ func (c *countingConn) Write(in []byte) (int, error) {
 	c.mu.Lock()
 	c.bytesWritten += int64(n)
 	c.mu.Unlock()
+	if c.metrics != nil {
+		c.metrics.AddSent(n)
+	}
 	return n, err
 }
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/server"
+)
+
+// drainPollInterval is how often a draining worker checks whether its proxied
+// connections have closed.
+var drainPollInterval = 250 * time.Millisecond
+
+// Drain puts the worker into the "draining" operational state. Controllers stop
+// routing new sessions to a draining worker, while existing connections are
+// left to finish. Once there are no proxied connections left, or the deadline
+// has passed and the remaining connections have been terminated, the worker
+// asks the server to shut it down. Calling Drain on a worker which is already
+// draining does nothing.
+func (w *Worker) Drain(deadline time.Time) {
+	const op = "worker.(Worker).Drain"
+	if !w.draining.CompareAndSwap(false, true) {
+		return
+	}
+	event.WriteSysEvent(w.baseContext, op, "worker entering drain mode", "deadline", deadline.String())
+	w.operationalState.Store(server.DrainingOperationalState)
+	go w.waitForDrain(deadline)
+}
+
+// Draining reports whether the worker has started draining.
+func (w *Worker) Draining() bool {
+	return w.draining.Load()
+}
+
+// waitForDrain blocks until the worker's proxied connections have closed or
+// the deadline has passed, and then triggers a server side shutdown.
+func (w *Worker) waitForDrain(deadline time.Time) {
+	const op = "worker.(Worker).waitForDrain"
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for proxy.ProxyState.CurrentProxiedConnections() > 0 {
+		if !time.Now().Before(deadline) {
+			event.WriteSysEvent(w.baseContext, op, "worker drain deadline passed, terminating remaining connections")
+			if w.sessionManager != nil {
+				w.cleanupConnections(w.baseContext, true, w.sessionManager)
+			}
+			break
+		}
+		select {
+		case <-w.baseContext.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+	event.WriteSysEvent(w.baseContext, op, "worker has drained, shutting down")
+
+	select {
+	case w.conf.ServerSideShutdownCh <- struct{}{}:
+	case <-w.baseContext.Done():
+	}
+}
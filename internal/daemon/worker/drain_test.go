@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorker_Drain(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w := &Worker{
+		baseContext:      ctx,
+		conf:             &Config{Server: &base.Server{ServerSideShutdownCh: make(chan struct{})}},
+		operationalState: new(atomic.Value),
+	}
+	w.operationalState.Store(server.ActiveOperationalState)
+	require.False(t, w.Draining())
+
+	w.Drain(time.Now().Add(time.Hour))
+	assert.True(t, w.Draining())
+	assert.Equal(t, server.DrainingOperationalState, w.operationalState.Load())
+
+	// Without any proxied connections the worker asks to be shut down
+	select {
+	case <-w.conf.ServerSideShutdownCh:
+	case <-time.After(10 * time.Second):
+		t.Fatal("worker did not request shutdown after draining")
+	}
+
+	// Draining again is a no-op
+	w.Drain(time.Now())
+	select {
+	case <-w.conf.ServerSideShutdownCh:
+		t.Fatal("worker requested shutdown twice")
+	case <-time.After(2 * drainPollInterval):
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/daemon/common"
@@ -23,6 +24,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/event"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	serverSession "github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/util"
 	"github.com/hashicorp/boundary/sdk/pbs/proxy"
 	"github.com/hashicorp/boundary/sdk/wspb"
@@ -71,6 +73,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 		return nil, fmt.Errorf("%s: missing listener config", op)
 	}
 	return func(wr http.ResponseWriter, r *http.Request) {
+		acceptTime := time.Now()
 		ctx := r.Context()
 		if r.TLS == nil {
 			event.WriteError(ctx, op, stderrors.New("no request tls information found"))
@@ -119,6 +122,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 
 		sess := sessionManager.Get(sessionId)
 		if sess == nil {
+			w.proxyMetrics.AuthorizationFailed(metric.AuthorizationFailureSessionNotFound)
 			event.WriteError(ctx, op, stderrors.New("session not found locally"), event.WithInfo("session_id", sessionId))
 			wr.WriteHeader(http.StatusInternalServerError)
 			return
@@ -148,6 +152,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			return
 		}
 		if len(handshake.GetTofuToken()) != 20 {
+			w.proxyMetrics.AuthorizationFailed(metric.AuthorizationFailureInvalidHandshake)
 			event.WriteError(ctx, op, stderrors.New("invalid tofu token"))
 			if err = conn.Close(websocket.StatusUnsupportedData, "invalid tofu token"); err != nil {
 				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
@@ -171,6 +176,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 
 		if sess.GetTofuToken() != "" {
 			if subtle.ConstantTimeCompare([]byte(sess.GetTofuToken()), []byte(handshake.GetTofuToken())) != 1 {
+				w.proxyMetrics.AuthorizationFailed(metric.AuthorizationFailureTofuMismatch)
 				event.WriteError(ctx, op, stderrors.New("WARNING: mismatched tofu token"), event.WithInfo("session_id", sessionId))
 				if err = conn.Close(websocket.StatusPolicyViolation, "tofu token not allowed"); err != nil {
 					event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
@@ -179,6 +185,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			}
 		} else {
 			if sess.GetStatus() != pbs.SESSIONSTATUS_SESSIONSTATUS_PENDING {
+				w.proxyMetrics.AuthorizationFailed(metric.AuthorizationFailureActivation)
 				event.WriteError(ctx, op, stderrors.New("no tofu token but not in correct session state"), event.WithInfo("session_id", sessionId))
 				if err = conn.Close(websocket.StatusInternalError, "refusing to activate session"); err != nil {
 					event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
@@ -188,6 +195,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			if handshake.Command == proxy.HANDSHAKECOMMAND_HANDSHAKECOMMAND_UNSPECIFIED {
 				err = sess.RequestActivate(ctx, handshake.GetTofuToken())
 				if err != nil {
+					w.proxyMetrics.AuthorizationFailed(metric.AuthorizationFailureActivation)
 					event.WriteError(ctx, op, err, event.WithInfoMsg("unable to validate session"))
 					if err = conn.Close(websocket.StatusInternalError, "unable to activate session"); err != nil {
 						event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
@@ -211,6 +219,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 		var connsLeft int32
 		acResp, connsLeft, err = sess.RequestAuthorizeConnection(ctx, workerId, connCancel)
 		if err != nil {
+			w.proxyMetrics.AuthorizationFailed(metric.AuthorizationFailureConnection)
 			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to authorize connection"))
 			if err = conn.Close(websocket.StatusInternalError, "unable to authorize connection"); err != nil {
 				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
@@ -221,7 +230,11 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 
 		// Wrapping the client websocket with a `net.Conn` implementation that
 		// records the bytes that go across Read() and Write().
-		cc := &countingConn{Conn: websocket.NetConn(connCtx, conn, websocket.MessageBinary)}
+		cc := &countingConn{
+			Conn:    websocket.NetConn(connCtx, conn, websocket.MessageBinary),
+			metrics: w.proxyMetrics.NewConnection(sess),
+		}
+		defer cc.metrics.Closed()
 		err = sess.ApplyConnectionCounterCallbacks(acResp.GetConnectionId(), cc.BytesRead, cc.BytesWritten)
 		if err != nil {
 			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to set counter callbacks for session connection"))
@@ -240,6 +253,9 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 					BytesDown: cc.BytesWritten(),
 				},
 			}
+			if sess.GetTraffic().QuotaExceeded(acResp.GetConnectionId()) {
+				ccd[acResp.GetConnectionId()].ClosedReason = serverSession.ConnectionQuotaExceeded
+			}
 			if sessionManager.RequestCloseConnections(ctx, ccd) {
 				event.WriteSysEvent(ctx, op, "connection closed", "session_id", sessionId, "connection_id", acResp.GetConnectionId())
 			}
@@ -295,7 +311,8 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "error getting decryption function")
 			event.WriteError(ctx, op, err)
 		}
-		runProxy, err := handleProxyFn(ctx, ctx, decryptFn, cc, pDialer, acResp.GetConnectionId(), protocolCtx, w.recorderManager)
+		dataCtx := proxyHandlers.NewSessionTrafficContext(ctx, sess.GetTraffic())
+		runProxy, err := handleProxyFn(ctx, dataCtx, decryptFn, cc, pDialer, acResp.GetConnectionId(), protocolCtx, w.recorderManager)
 		if err != nil {
 			conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to setup proxying")
 			event.WriteError(ctx, op, err)
@@ -322,6 +339,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			return
 		}
 
+		cc.metrics.Started(acceptTime)
 		runProxy()
 	}, nil
 }
@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package metric
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/metric"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	proxySessionSubsystem = "worker_proxy"
+
+	labelReason = "reason"
+)
+
+// The reasons recorded when the worker refuses to proxy a connection. They are
+// kept to a small fixed set so the authorization failure series stay bounded.
+const (
+	AuthorizationFailureSessionNotFound  = "session_not_found"
+	AuthorizationFailureInvalidHandshake = "invalid_handshake"
+	AuthorizationFailureTofuMismatch     = "tofu_mismatch"
+	AuthorizationFailureActivation       = "activation_failed"
+	AuthorizationFailureConnection       = "connection_denied"
+)
+
+// ProxySession is the information about a session used to label the proxy
+// session metrics.
+type ProxySession interface {
+	GetId() string
+	GetTargetId() string
+	GetProjectId() string
+}
+
+// ProxySessionCollectors reports on the sessions and connections proxied by a
+// worker. The labels attached to its series are picked by the operator, which
+// is why, unlike the other collectors of this package, they are built when the
+// worker starts instead of being package level variables.
+type ProxySessionCollectors struct {
+	labels []string
+
+	activeSessions        *prometheus.GaugeVec
+	activeConnections     *prometheus.GaugeVec
+	bytesReceived         *prometheus.CounterVec
+	bytesSent             *prometheus.CounterVec
+	connectionSetup       *prometheus.HistogramVec
+	authorizationFailures *prometheus.CounterVec
+
+	// sessionConns tracks the number of open connections per session id so
+	// a session is only counted as active while one of its connections is
+	// proxied by this worker. It is protected by lock.
+	lock         sync.Mutex
+	sessionConns map[string]int
+}
+
+// InitializeProxySessionCollectors creates the proxy session collectors with
+// the provided labels and registers them onto `r` if it is not nil. Each label
+// must be one of metric.ListProxySessionLabels.
+func InitializeProxySessionCollectors(r prometheus.Registerer, labels []string) (*ProxySessionCollectors, error) {
+	const op = "metric.InitializeProxySessionCollectors"
+	for i, l := range labels {
+		if !slices.Contains(metric.ListProxySessionLabels, l) {
+			return nil, fmt.Errorf("%s: unsupported proxy session metric label %q", op, l)
+		}
+		if slices.Contains(labels[:i], l) {
+			return nil, fmt.Errorf("%s: duplicate proxy session metric label %q", op, l)
+		}
+	}
+
+	c := &ProxySessionCollectors{
+		labels:       slices.Clone(labels),
+		sessionConns: make(map[string]int),
+		activeSessions: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: globals.MetricNamespace,
+				Subsystem: proxySessionSubsystem,
+				Name:      "active_sessions",
+				Help:      "Count of sessions with at least one connection proxied by the worker.",
+			},
+			labels,
+		),
+		activeConnections: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: globals.MetricNamespace,
+				Subsystem: proxySessionSubsystem,
+				Name:      "active_connections",
+				Help:      "Count of session connections proxied by the worker.",
+			},
+			labels,
+		),
+		bytesReceived: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: globals.MetricNamespace,
+				Subsystem: proxySessionSubsystem,
+				Name:      "received_bytes_total",
+				Help:      "Count of bytes received from clients on proxied session connections.",
+			},
+			labels,
+		),
+		bytesSent: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: globals.MetricNamespace,
+				Subsystem: proxySessionSubsystem,
+				Name:      "sent_bytes_total",
+				Help:      "Count of bytes sent to clients on proxied session connections.",
+			},
+			labels,
+		),
+		connectionSetup: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: globals.MetricNamespace,
+				Subsystem: proxySessionSubsystem,
+				Name:      "connection_setup_duration_seconds",
+				Help:      "Histogram of the time taken between accepting a session connection and starting to proxy it.",
+				Buckets:   prometheus.DefBuckets,
+			},
+			labels,
+		),
+		authorizationFailures: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: globals.MetricNamespace,
+				Subsystem: proxySessionSubsystem,
+				Name:      "authorization_failures_total",
+				Help:      "Count of session connections the worker refused to proxy, by reason.",
+			},
+			[]string{labelReason},
+		),
+	}
+
+	if r == nil {
+		return c, nil
+	}
+	for _, col := range []prometheus.Collector{
+		c.activeSessions, c.activeConnections, c.bytesReceived,
+		c.bytesSent, c.connectionSetup, c.authorizationFailures,
+	} {
+		if err := r.Register(col); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return c, nil
+}
+
+// labelValues returns the values of the configured labels for s.
+func (c *ProxySessionCollectors) labelValues(s ProxySession) prometheus.Labels {
+	l := make(prometheus.Labels, len(c.labels))
+	for _, name := range c.labels {
+		switch name {
+		case metric.LabelTargetId:
+			l[name] = s.GetTargetId()
+		case metric.LabelProjectId:
+			l[name] = s.GetProjectId()
+		}
+	}
+	return l
+}
+
+// AuthorizationFailed records that a connection was refused for reason.
+func (c *ProxySessionCollectors) AuthorizationFailed(reason string) {
+	c.authorizationFailures.With(prometheus.Labels{labelReason: reason}).Inc()
+}
+
+// ProxyConnection reports on a single connection of a proxied session.
+type ProxyConnection struct {
+	c         *ProxySessionCollectors
+	sessionId string
+	labels    prometheus.Labels
+	received  prometheus.Counter
+	sent      prometheus.Counter
+
+	start   sync.Once
+	stop    sync.Once
+	started bool
+}
+
+// NewConnection returns the ProxyConnection used to report on a connection of
+// s.
+func (c *ProxySessionCollectors) NewConnection(s ProxySession) *ProxyConnection {
+	l := c.labelValues(s)
+	return &ProxyConnection{
+		c:         c,
+		sessionId: s.GetId(),
+		labels:    l,
+		received:  c.bytesReceived.With(l),
+		sent:      c.bytesSent.With(l),
+	}
+}
+
+// AddReceived records n bytes received from the client.
+func (p *ProxyConnection) AddReceived(n int) {
+	p.received.Add(float64(n))
+}
+
+// AddSent records n bytes sent to the client.
+func (p *ProxyConnection) AddSent(n int) {
+	p.sent.Add(float64(n))
+}
+
+// Started records that the connection accepted at acceptTime is now being
+// proxied. Only the first call has an effect.
+func (p *ProxyConnection) Started(acceptTime time.Time) {
+	p.start.Do(func() {
+		p.started = true
+		p.c.connectionSetup.With(p.labels).Observe(time.Since(acceptTime).Seconds())
+		p.c.activeConnections.With(p.labels).Inc()
+
+		p.c.lock.Lock()
+		defer p.c.lock.Unlock()
+		p.c.sessionConns[p.sessionId]++
+		if p.c.sessionConns[p.sessionId] == 1 {
+			p.c.activeSessions.With(p.labels).Inc()
+		}
+	})
+}
+
+// Closed records that the connection is no longer proxied. Only the first call
+// has an effect and it is a no-op if Started was not called before it.
+func (p *ProxyConnection) Closed() {
+	// Prevent a later call to Started from counting the connection as active.
+	p.start.Do(func() {})
+	p.stop.Do(func() {
+		if !p.started {
+			return
+		}
+		p.c.activeConnections.With(p.labels).Dec()
+
+		p.c.lock.Lock()
+		defer p.c.lock.Unlock()
+		p.c.sessionConns[p.sessionId]--
+		if p.c.sessionConns[p.sessionId] <= 0 {
+			delete(p.c.sessionConns, p.sessionId)
+			p.c.activeSessions.With(p.labels).Dec()
+		}
+	})
+}
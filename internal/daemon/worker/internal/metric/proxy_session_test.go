@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package metric
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testProxySession struct {
+	id, targetId, projectId string
+}
+
+func (s testProxySession) GetId() string        { return s.id }
+func (s testProxySession) GetTargetId() string  { return s.targetId }
+func (s testProxySession) GetProjectId() string { return s.projectId }
+
+func TestInitializeProxySessionCollectors(t *testing.T) {
+	t.Run("nil registerer", func(t *testing.T) {
+		c, err := InitializeProxySessionCollectors(nil, nil)
+		require.NoError(t, err)
+		require.NotNil(t, c)
+	})
+	t.Run("registers collectors", func(t *testing.T) {
+		r := prometheus.NewRegistry()
+		c, err := InitializeProxySessionCollectors(r, []string{"project_id"})
+		require.NoError(t, err)
+		c.AuthorizationFailed(AuthorizationFailureTofuMismatch)
+
+		f, err := r.Gather()
+		require.NoError(t, err)
+		require.Greater(t, len(f), 0)
+	})
+	t.Run("unsupported label", func(t *testing.T) {
+		_, err := InitializeProxySessionCollectors(nil, []string{"user_id"})
+		require.ErrorContains(t, err, `unsupported proxy session metric label "user_id"`)
+	})
+	t.Run("duplicate label", func(t *testing.T) {
+		_, err := InitializeProxySessionCollectors(nil, []string{"target_id", "target_id"})
+		require.ErrorContains(t, err, `duplicate proxy session metric label "target_id"`)
+	})
+	t.Run("already registered", func(t *testing.T) {
+		r := prometheus.NewRegistry()
+		_, err := InitializeProxySessionCollectors(r, nil)
+		require.NoError(t, err)
+		_, err = InitializeProxySessionCollectors(r, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestProxyConnection(t *testing.T) {
+	c, err := InitializeProxySessionCollectors(prometheus.NewRegistry(), []string{"target_id"})
+	require.NoError(t, err)
+
+	s1 := testProxySession{id: "s_1", targetId: "ttcp_1", projectId: "p_1"}
+	s2 := testProxySession{id: "s_2", targetId: "ttcp_1", projectId: "p_1"}
+	l := prometheus.Labels{"target_id": "ttcp_1"}
+
+	conn1 := c.NewConnection(s1)
+	conn2 := c.NewConnection(s1)
+	conn3 := c.NewConnection(s2)
+	conn1.Started(time.Now())
+	conn2.Started(time.Now())
+	conn3.Started(time.Now())
+	// Starting a connection again is a no-op.
+	conn1.Started(time.Now())
+
+	assert.Equal(t, float64(3), testutil.ToFloat64(c.activeConnections.With(l)))
+	assert.Equal(t, float64(2), testutil.ToFloat64(c.activeSessions.With(l)))
+	assert.Equal(t, 1, testutil.CollectAndCount(c.connectionSetup))
+
+	conn1.AddReceived(10)
+	conn2.AddReceived(5)
+	conn3.AddSent(20)
+	assert.Equal(t, float64(15), testutil.ToFloat64(c.bytesReceived.With(l)))
+	assert.Equal(t, float64(20), testutil.ToFloat64(c.bytesSent.With(l)))
+
+	conn1.Closed()
+	// Closing a connection again is a no-op.
+	conn1.Closed()
+	assert.Equal(t, float64(2), testutil.ToFloat64(c.activeConnections.With(l)))
+	assert.Equal(t, float64(2), testutil.ToFloat64(c.activeSessions.With(l)))
+
+	conn2.Closed()
+	conn3.Closed()
+	assert.Equal(t, float64(0), testutil.ToFloat64(c.activeConnections.With(l)))
+	assert.Equal(t, float64(0), testutil.ToFloat64(c.activeSessions.With(l)))
+
+	// A connection closed before being started is never counted as active.
+	conn4 := c.NewConnection(s1)
+	conn4.Closed()
+	conn4.Started(time.Now())
+	assert.Equal(t, float64(0), testutil.ToFloat64(c.activeConnections.With(l)))
+	assert.Equal(t, float64(0), testutil.ToFloat64(c.activeSessions.With(l)))
+}
+
+func TestProxySessionCollectorsAuthorizationFailed(t *testing.T) {
+	c, err := InitializeProxySessionCollectors(prometheus.NewRegistry(), nil)
+	require.NoError(t, err)
+
+	c.AuthorizationFailed(AuthorizationFailureSessionNotFound)
+	c.AuthorizationFailed(AuthorizationFailureSessionNotFound)
+	c.AuthorizationFailed(AuthorizationFailureConnection)
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(c.authorizationFailures.With(prometheus.Labels{labelReason: AuthorizationFailureSessionNotFound})))
+	assert.Equal(t, float64(1), testutil.ToFloat64(c.authorizationFailures.With(prometheus.Labels{labelReason: AuthorizationFailureConnection})))
+	assert.Equal(t, 2, testutil.CollectAndCount(c.authorizationFailures))
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"runtime/metrics"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+)
+
+// The runtime metrics used to measure the load of the worker process.
+const (
+	cpuTotalMetric      = "/cpu/classes/total:cpu-seconds"
+	cpuIdleMetric       = "/cpu/classes/idle:cpu-seconds"
+	memoryTotalMetric   = "/memory/classes/total:bytes"
+	memoryReleaseMetric = "/memory/classes/heap/released:bytes"
+)
+
+// loadSampler measures the load of the worker reported to controllers in
+// status requests. The zero value is ready to use. It is not safe for
+// concurrent use and is only used while holding the worker's statusLock.
+type loadSampler struct {
+	lastCpuTotal  float64
+	lastCpuIdle   float64
+	statusLatency time.Duration
+}
+
+// recordStatusLatency records the round trip time of a successful status
+// request, which is reported with the next one.
+func (l *loadSampler) recordStatusLatency(d time.Duration) {
+	l.statusLatency = d
+}
+
+// sample returns the current load of the worker. The CPU utilization is
+// measured since the previous call to sample.
+func (l *loadSampler) sample() *pbs.WorkerLoad {
+	samples := []metrics.Sample{
+		{Name: cpuTotalMetric},
+		{Name: cpuIdleMetric},
+		{Name: memoryTotalMetric},
+		{Name: memoryReleaseMetric},
+	}
+	metrics.Read(samples)
+
+	load := &pbs.WorkerLoad{
+		ProxiedConnectionCount: uint64(max(proxy.ProxyState.CurrentProxiedConnections(), 0)),
+		StatusLatencyMs:        uint64(l.statusLatency.Milliseconds()),
+	}
+
+	if samples[0].Value.Kind() == metrics.KindFloat64 && samples[1].Value.Kind() == metrics.KindFloat64 {
+		total, idle := samples[0].Value.Float64(), samples[1].Value.Float64()
+		if dTotal := total - l.lastCpuTotal; dTotal > 0 {
+			busy := 1 - (idle-l.lastCpuIdle)/dTotal
+			load.CpuUtilization = min(max(busy*100, 0), 100)
+		}
+		l.lastCpuTotal, l.lastCpuIdle = total, idle
+	}
+
+	if samples[2].Value.Kind() == metrics.KindUint64 && samples[3].Value.Kind() == metrics.KindUint64 {
+		total, released := samples[2].Value.Uint64(), samples[3].Value.Uint64()
+		if total > released {
+			load.MemoryBytes = total - released
+		}
+	}
+
+	return load
+}
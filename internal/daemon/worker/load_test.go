@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadSampler(t *testing.T) {
+	var l loadSampler
+
+	load := l.sample()
+	assert.Zero(t, load.GetStatusLatencyMs())
+	assert.GreaterOrEqual(t, load.GetCpuUtilization(), float64(0))
+	assert.LessOrEqual(t, load.GetCpuUtilization(), float64(100))
+	assert.NotZero(t, load.GetMemoryBytes())
+
+	l.recordStatusLatency(250 * time.Millisecond)
+	load = l.sample()
+	assert.Equal(t, uint64(250), load.GetStatusLatencyMs())
+	assert.GreaterOrEqual(t, load.GetCpuUtilization(), float64(0))
+	assert.LessOrEqual(t, load.GetCpuUtilization(), float64(100))
+}
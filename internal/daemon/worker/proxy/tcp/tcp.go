@@ -27,7 +27,7 @@ func init() {
 // handleProxy returns a ProxyConnFn which starts the copy between the
 // connections and blocks until an error (EOF on happy path) is received on
 // either connection.
-func handleProxy(controlCtx context.Context, _ context.Context, _ proxy.DecryptFn, conn net.Conn, out *proxy.ProxyDialer, connId string, _ *anypb.Any, _ proxy.RecordingManager) (proxy.ProxyConnFn, error) {
+func handleProxy(controlCtx context.Context, dataCtx context.Context, _ proxy.DecryptFn, conn net.Conn, out *proxy.ProxyDialer, connId string, _ *anypb.Any, _ proxy.RecordingManager) (proxy.ProxyConnFn, error) {
 	const op = "tcp.HandleProxy"
 	switch {
 	case conn == nil:
@@ -41,6 +41,9 @@ func handleProxy(controlCtx context.Context, _ context.Context, _ proxy.DecryptF
 	if err != nil {
 		return nil, err
 	}
+	if st, ok := proxy.SessionTrafficFromContext(dataCtx); ok {
+		conn = st.Limit(dataCtx, connId, conn)
+	}
 
 	return func() {
 		connWg := new(sync.WaitGroup)
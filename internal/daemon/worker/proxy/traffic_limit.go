@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package proxy
+
+import (
+	"context"
+	stderrors "errors"
+	"net"
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/time/rate"
+)
+
+// maxTrafficBurst is the largest number of bytes read or written at once by
+// a bandwidth limited connection.
+const maxTrafficBurst = 64 * 1024
+
+// ErrQuotaExceeded is returned by the reads and writes of a connection limited
+// by a SessionTraffic once one of its byte quotas is used up.
+var ErrQuotaExceeded = stderrors.New("byte quota exceeded")
+
+// TrafficLimit contains the bandwidth limits, in bytes per second, and the
+// byte quotas of a session. Traffic in both directions counts towards the
+// limits. A zero value for any setting means unlimited.
+type TrafficLimit struct {
+	ConnectionBandwidthLimit uint64
+	SessionBandwidthLimit    uint64
+	ConnectionByteQuota      uint64
+	SessionByteQuota         uint64
+}
+
+// SessionTraffic enforces a TrafficLimit on the connections of a session
+// proxied by this worker. The session limits are shared by all connections
+// passed to Limit. It is safe for concurrent use.
+type SessionTraffic struct {
+	limit   TrafficLimit
+	limiter *rate.Limiter
+	bytes   atomic.Uint64
+
+	mu       sync.Mutex
+	exceeded map[string]bool
+}
+
+// NewSessionTraffic returns a SessionTraffic enforcing limit.
+func NewSessionTraffic(limit TrafficLimit) *SessionTraffic {
+	return &SessionTraffic{
+		limit:    limit,
+		limiter:  newTrafficLimiter(limit.SessionBandwidthLimit),
+		exceeded: make(map[string]bool),
+	}
+}
+
+// Limit returns conn, the connection with the given id, wrapped so that its
+// reads and writes are subject to the limits of s. Reads and writes wait on
+// ctx for bandwidth to become available and fail with ErrQuotaExceeded once a
+// byte quota is used up.
+func (s *SessionTraffic) Limit(ctx context.Context, connId string, conn net.Conn) net.Conn {
+	return &limitedConn{
+		Conn:    conn,
+		ctx:     ctx,
+		connId:  connId,
+		session: s,
+		limiter: newTrafficLimiter(s.limit.ConnectionBandwidthLimit),
+	}
+}
+
+// QuotaExceeded reports whether the connection with the given id used up one
+// of the byte quotas of s.
+func (s *SessionTraffic) QuotaExceeded(connId string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.exceeded[connId]
+}
+
+func (s *SessionTraffic) setQuotaExceeded(connId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.exceeded[connId] = true
+}
+
+type sessionTrafficKey struct{}
+
+// NewSessionTrafficContext returns a copy of ctx carrying s. Proxy handlers
+// limit the connections they proxy with the SessionTraffic of their data
+// context.
+func NewSessionTrafficContext(ctx context.Context, s *SessionTraffic) context.Context {
+	return context.WithValue(ctx, sessionTrafficKey{}, s)
+}
+
+// SessionTrafficFromContext returns the SessionTraffic carried by ctx, if any.
+func SessionTrafficFromContext(ctx context.Context) (*SessionTraffic, bool) {
+	s, ok := ctx.Value(sessionTrafficKey{}).(*SessionTraffic)
+	return s, ok && s != nil
+}
+
+// newTrafficLimiter returns a limiter allowing bytesPerSecond, or nil if
+// bytesPerSecond is zero.
+func newTrafficLimiter(bytesPerSecond uint64) *rate.Limiter {
+	if bytesPerSecond == 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(bytesPerSecond), int(min(bytesPerSecond, maxTrafficBurst)))
+}
+
+// limitedConn is a net.Conn whose reads and writes are limited by the
+// connection and session limits of a SessionTraffic.
+type limitedConn struct {
+	net.Conn
+
+	ctx     context.Context
+	connId  string
+	session *SessionTraffic
+	limiter *rate.Limiter
+	bytes   atomic.Uint64
+}
+
+// Read reads at most as many bytes as the remaining byte quotas allow and
+// then waits until the bandwidth limits allow the bytes read.
+func (c *limitedConn) Read(b []byte) (int, error) {
+	if len(b) == 0 {
+		return c.Conn.Read(b)
+	}
+	n := c.allowance(len(b))
+	if n == 0 {
+		return 0, c.quotaExceeded()
+	}
+	n, err := c.Conn.Read(b[:n])
+	c.add(n)
+	if werr := c.wait(n); werr != nil && err == nil {
+		err = werr
+	}
+	return n, err
+}
+
+// Write writes b in chunks, waiting for the bandwidth limits to allow each
+// chunk before writing it. If a byte quota is used up before all of b is
+// written, ErrQuotaExceeded is returned.
+func (c *limitedConn) Write(b []byte) (int, error) {
+	var written int
+	for written < len(b) {
+		n := c.allowance(len(b) - written)
+		if n == 0 {
+			return written, c.quotaExceeded()
+		}
+		if err := c.wait(n); err != nil {
+			return written, err
+		}
+		n, err := c.Conn.Write(b[written : written+n])
+		c.add(n)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// allowance returns how many of n bytes c can transfer at once.
+func (c *limitedConn) allowance(n int) int {
+	for _, l := range []*rate.Limiter{c.limiter, c.session.limiter} {
+		if l != nil {
+			n = min(n, l.Burst())
+		}
+	}
+	n = remaining(n, c.session.limit.ConnectionByteQuota, c.bytes.Load())
+	n = remaining(n, c.session.limit.SessionByteQuota, c.session.bytes.Load())
+	return n
+}
+
+// remaining returns the smaller of n and the bytes left of quota after used
+// bytes. A zero quota is unlimited.
+func remaining(n int, quota, used uint64) int {
+	switch {
+	case quota == 0:
+		return n
+	case used >= quota:
+		return 0
+	case quota-used < uint64(n):
+		return int(quota - used)
+	default:
+		return n
+	}
+}
+
+func (c *limitedConn) add(n int) {
+	if n <= 0 {
+		return
+	}
+	c.bytes.Add(uint64(n))
+	c.session.bytes.Add(uint64(n))
+}
+
+func (c *limitedConn) wait(n int) error {
+	if n <= 0 {
+		return nil
+	}
+	for _, l := range []*rate.Limiter{c.limiter, c.session.limiter} {
+		if l == nil {
+			continue
+		}
+		if err := l.WaitN(c.ctx, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *limitedConn) quotaExceeded() error {
+	c.session.setQuotaExceeded(c.connId)
+	return ErrQuotaExceeded
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package proxy
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemaining(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		n     int
+		quota uint64
+		used  uint64
+		want  int
+	}{
+		{name: "unlimited", n: 10, quota: 0, used: 100, want: 10},
+		{name: "below-quota", n: 10, quota: 100, used: 10, want: 10},
+		{name: "partial", n: 10, quota: 100, used: 95, want: 5},
+		{name: "used-up", n: 10, quota: 100, used: 100, want: 0},
+		{name: "over-quota", n: 10, quota: 100, used: 150, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, remaining(tt.n, tt.quota, tt.used))
+		})
+	}
+}
+
+func TestSessionTrafficContext(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	_, ok := SessionTrafficFromContext(ctx)
+	assert.False(t, ok)
+
+	_, ok = SessionTrafficFromContext(NewSessionTrafficContext(ctx, nil))
+	assert.False(t, ok)
+
+	st := NewSessionTraffic(TrafficLimit{SessionByteQuota: 10})
+	got, ok := SessionTrafficFromContext(NewSessionTrafficContext(ctx, st))
+	require.True(t, ok)
+	assert.Same(t, st, got)
+}
+
+func TestSessionTraffic_Quota(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("connection-write", func(t *testing.T) {
+		st := NewSessionTraffic(TrafficLimit{ConnectionByteQuota: 5})
+		client, server := net.Pipe()
+		t.Cleanup(func() { _ = client.Close(); _ = server.Close() })
+		conn := st.Limit(ctx, "c1", server)
+
+		go func() { _, _ = io.Copy(io.Discard, client) }()
+		n, err := conn.Write([]byte("0123456789"))
+		assert.ErrorIs(t, err, ErrQuotaExceeded)
+		assert.Equal(t, 5, n)
+		assert.True(t, st.QuotaExceeded("c1"))
+		assert.False(t, st.QuotaExceeded("c2"))
+	})
+
+	t.Run("connection-read", func(t *testing.T) {
+		st := NewSessionTraffic(TrafficLimit{ConnectionByteQuota: 4})
+		client, server := net.Pipe()
+		t.Cleanup(func() { _ = client.Close(); _ = server.Close() })
+		conn := st.Limit(ctx, "c1", server)
+
+		go func() { _, _ = client.Write([]byte("0123456789")) }()
+		buf := make([]byte, 10)
+		n, err := conn.Read(buf)
+		require.NoError(t, err)
+		assert.Equal(t, "0123", string(buf[:n]))
+		_, err = conn.Read(buf)
+		assert.ErrorIs(t, err, ErrQuotaExceeded)
+		assert.True(t, st.QuotaExceeded("c1"))
+	})
+
+	t.Run("session-shared", func(t *testing.T) {
+		st := NewSessionTraffic(TrafficLimit{SessionByteQuota: 8})
+		client1, server1 := net.Pipe()
+		client2, server2 := net.Pipe()
+		t.Cleanup(func() {
+			_ = client1.Close()
+			_ = server1.Close()
+			_ = client2.Close()
+			_ = server2.Close()
+		})
+		conn1 := st.Limit(ctx, "c1", server1)
+		conn2 := st.Limit(ctx, "c2", server2)
+		go func() { _, _ = io.Copy(io.Discard, client1) }()
+		go func() { _, _ = io.Copy(io.Discard, client2) }()
+
+		n, err := conn1.Write([]byte("012345"))
+		require.NoError(t, err)
+		assert.Equal(t, 6, n)
+		n, err = conn2.Write([]byte("012345"))
+		assert.ErrorIs(t, err, ErrQuotaExceeded)
+		assert.Equal(t, 2, n)
+		assert.False(t, st.QuotaExceeded("c1"))
+		assert.True(t, st.QuotaExceeded("c2"))
+	})
+}
+
+func TestSessionTraffic_Bandwidth(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// The limiter starts with a full bucket of one second worth of bytes, so
+	// writing two seconds worth of bytes takes at least a second.
+	st := NewSessionTraffic(TrafficLimit{ConnectionBandwidthLimit: 1024})
+	client, server := net.Pipe()
+	t.Cleanup(func() { _ = client.Close(); _ = server.Close() })
+	conn := st.Limit(ctx, "c1", server)
+	go func() { _, _ = io.Copy(io.Discard, client) }()
+
+	start := time.Now()
+	n, err := conn.Write(make([]byte, 2048))
+	require.NoError(t, err)
+	assert.Equal(t, 2048, n)
+	assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	conn = st.Limit(cancelCtx, "c2", server)
+	_, err = conn.Write(make([]byte, 2048))
+	assert.ErrorIs(t, err, context.Canceled)
+}
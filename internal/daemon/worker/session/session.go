@@ -12,6 +12,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
 	"github.com/hashicorp/boundary/internal/event"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/session"
@@ -48,6 +49,9 @@ type ConnectionCloseData struct {
 	SessionId string
 	BytesUp   int64
 	BytesDown int64
+	// ClosedReason is the reason the connection was closed. If it is not set
+	// the reason is unknown.
+	ClosedReason session.ClosedReason
 }
 
 // Session is the local representation of a session.  After initial loading
@@ -77,6 +81,12 @@ type Session interface {
 	GetCertificate() *x509.Certificate
 	GetPrivateKey() []byte
 	GetId() string
+	GetTargetId() string
+	GetProjectId() string
+
+	// GetTraffic returns the SessionTraffic enforcing the bandwidth limits and
+	// byte quotas of the session on the connections proxied by this worker.
+	GetTraffic() *proxy.SessionTraffic
 
 	// CancelOpenLocalConnections closes the local connections in this session
 	//based on the connection's state by calling the connections context cancel
@@ -132,6 +142,7 @@ type sess struct {
 	cert        *x509.Certificate
 	sessionId   string
 	tofuToken   string
+	traffic     *proxy.SessionTraffic
 }
 
 func newSess(client pbs.SessionServiceClient, resp *pbs.LookupSessionResponse) (*sess, error) {
@@ -154,6 +165,12 @@ func newSess(client pbs.SessionServiceClient, resp *pbs.LookupSessionResponse) (
 		status:      resp.GetStatus(),
 		cert:        parsedCert,
 		sessionId:   resp.GetAuthorization().GetSessionId(),
+		traffic: proxy.NewSessionTraffic(proxy.TrafficLimit{
+			ConnectionBandwidthLimit: resp.GetConnectionBandwidthLimit(),
+			SessionBandwidthLimit:    resp.GetSessionBandwidthLimit(),
+			ConnectionByteQuota:      resp.GetConnectionByteQuota(),
+			SessionByteQuota:         resp.GetSessionByteQuota(),
+		}),
 	}
 	return s, nil
 }
@@ -233,6 +250,10 @@ func (s *sess) GetConnectionLimit() int32 {
 	return s.resp.GetConnectionLimit()
 }
 
+func (s *sess) GetTraffic() *proxy.SessionTraffic {
+	return s.traffic
+}
+
 func (s *sess) GetEndpoint() string {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
@@ -295,6 +316,18 @@ func (s *sess) GetId() string {
 	return s.sessionId
 }
 
+func (s *sess) GetTargetId() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetTargetId()
+}
+
+func (s *sess) GetProjectId() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetProjectId()
+}
+
 func (s *sess) RequestCancel(ctx context.Context) error {
 	st, err := cancel(ctx, s.client, s.GetId())
 	if err != nil {
@@ -550,9 +583,13 @@ func closeConnections(ctx context.Context, sessClient pbs.SessionServiceClient,
 func makeCloseConnectionRequest(closeInfo map[string]*ConnectionCloseData) *pbs.CloseConnectionRequest {
 	closeData := make([]*pbs.CloseConnectionRequestData, 0, len(closeInfo))
 	for connId, data := range closeInfo {
+		reason := data.ClosedReason
+		if reason == "" {
+			reason = session.UnknownReason
+		}
 		closeData = append(closeData, &pbs.CloseConnectionRequestData{
 			ConnectionId: connId,
-			Reason:       session.UnknownReason.String(),
+			Reason:       reason.String(),
 			BytesUp:      data.BytesUp,
 			BytesDown:    data.BytesDown,
 		})
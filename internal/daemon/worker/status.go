@@ -199,6 +199,7 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context, sessionManager sess
 	}
 	versionInfo := version.Get()
 	connectionState := w.downstreamConnManager.Connected()
+	statusStart := time.Now()
 	result, err := client.Status(statusCtx, &pbs.StatusRequest{
 		Jobs: activeJobs,
 		WorkerStatus: &pb.ServerWorkerStatus{
@@ -214,6 +215,7 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context, sessionManager sess
 		ConnectedUnmappedWorkerKeyIdentifiers: connectionState.UnmappedKeyIds(),
 		ConnectedWorkerPublicIds:              connectionState.WorkerIds(),
 		UpdateTags:                            w.updateTags.Load(),
+		WorkerLoad:                            w.load.sample(),
 	})
 	if err != nil {
 		event.WriteError(cancelCtx, op, err, event.WithInfoMsg("error making status request to controller"))
@@ -282,6 +284,11 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context, sessionManager sess
 	}
 
 	w.updateTags.Store(false)
+	w.load.recordStatusLatency(time.Since(statusStart))
+
+	if dd := result.GetDrainDeadline(); dd != nil {
+		w.Drain(dd.AsTime())
+	}
 
 	if authorized := result.GetAuthorizedDownstreamWorkers(); authorized != nil {
 		connectionState.DisconnectMissingWorkers(authorized.GetWorkerPublicIds())
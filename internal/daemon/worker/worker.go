@@ -136,12 +136,19 @@ type Worker struct {
 
 	recorderManager recorderManager
 
+	// proxyMetrics reports on the sessions and connections proxied by the
+	// worker.
+	proxyMetrics *metric.ProxySessionCollectors
+
 	everAuthenticated       *ua.Uint32
 	lastStatusSuccess       *atomic.Value
 	workerStartTime         time.Time
 	operationalState        *atomic.Value
 	upstreamConnectionState *atomic.Value
 
+	// draining is set once the worker has started draining. See Drain.
+	draining atomic.Bool
+
 	controllerMultihopConn *atomic.Value
 
 	controllerUpstreamMsgConn atomic.Pointer[handlers.UpstreamMessageServiceClientProducer]
@@ -187,6 +194,9 @@ type Worker struct {
 	TestOverrideAuthRotationPeriod time.Duration
 
 	statusLock sync.Mutex
+	// load measures the load reported in status requests. It is protected by
+	// statusLock.
+	load loadSampler
 
 	downstreamConnManager *cluster.DownstreamManager
 }
@@ -236,6 +246,12 @@ func New(ctx context.Context, conf *Config) (*Worker, error) {
 		conf.RawConfig.Worker = new(config.Worker)
 	}
 
+	var err error
+	w.proxyMetrics, err = metric.InitializeProxySessionCollectors(conf.PrometheusRegisterer, conf.RawConfig.Worker.ProxyMetricLabels)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing proxy session metrics: %w", err)
+	}
+
 	if w.conf.RawConfig.Worker.RecordingStoragePath != "" && recordingStorageFactory != nil {
 		pluginLogger, err := event.NewHclogLogger(ctx, w.conf.Server.Eventer)
 		if err != nil {
@@ -0,0 +1,134 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- auth_password_policy holds the password policy for a password auth method.
+  -- A password auth method without a row in this table has no policy beyond
+  -- the min_password_length set on the auth method itself. A value of 0 in any
+  -- column disables that part of the policy.
+  create table auth_password_policy (
+    password_method_id wt_public_id primary key
+      references auth_password_method (public_id)
+      on delete cascade
+      on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    min_uppercase int not null default 0
+      constraint min_uppercase_must_not_be_negative
+        check(min_uppercase >= 0),
+    min_lowercase int not null default 0
+      constraint min_lowercase_must_not_be_negative
+        check(min_lowercase >= 0),
+    min_digits int not null default 0
+      constraint min_digits_must_not_be_negative
+        check(min_digits >= 0),
+    min_symbols int not null default 0
+      constraint min_symbols_must_not_be_negative
+        check(min_symbols >= 0),
+    history_count int not null default 0
+      constraint history_count_must_not_be_negative
+        check(history_count >= 0),
+    max_age_days int not null default 0
+      constraint max_age_days_must_not_be_negative
+        check(max_age_days >= 0),
+    max_failed_attempts int not null default 0
+      constraint max_failed_attempts_must_not_be_negative
+        check(max_failed_attempts >= 0),
+    lockout_duration_seconds int not null default 0
+      constraint lockout_duration_seconds_must_not_be_negative
+        check(lockout_duration_seconds >= 0)
+  );
+  comment on table auth_password_policy is
+    'auth_password_policy holds the complexity, history, expiration and lockout settings for a password auth method.';
+
+  create trigger update_time_column before update on auth_password_policy
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_password_policy
+    for each row execute procedure immutable_columns('password_method_id', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_password_policy
+    for each row execute procedure default_create_time();
+
+  -- auth_password_account_status tracks failed authentication attempts and the
+  -- time of the last password change for a password account.
+  create table auth_password_account_status (
+    password_account_id wt_public_id primary key
+      references auth_password_account (public_id)
+      on delete cascade
+      on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    failed_attempts int not null default 0
+      constraint failed_attempts_must_not_be_negative
+        check(failed_attempts >= 0),
+    last_failed_time timestamp with time zone,
+    locked_until timestamp with time zone,
+    password_change_time timestamp with time zone
+  );
+  comment on table auth_password_account_status is
+    'auth_password_account_status tracks failed authentication attempts, lockouts and password changes for a password account.';
+
+  create trigger update_time_column before update on auth_password_account_status
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_password_account_status
+    for each row execute procedure immutable_columns('password_account_id', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_password_account_status
+    for each row execute procedure default_create_time();
+
+  -- Existing credentials were set at the time they were created.
+  insert into auth_password_account_status
+    (password_account_id, password_change_time)
+  select password_account_id, create_time
+    from auth_password_argon2_cred;
+
+  -- auth_password_argon2_cred_history holds the previous credentials of a
+  -- password account. It is used to prevent a password from being reused. The
+  -- columns match auth_password_argon2_cred so a credential can be moved into
+  -- this table when it is replaced. create_time is the time the credential was
+  -- replaced.
+  create table auth_password_argon2_cred_history (
+    private_id wt_private_id primary key,
+    password_account_id wt_public_id not null
+      references auth_password_account (public_id)
+      on delete cascade
+      on update cascade,
+    password_conf_id wt_private_id not null,
+    password_method_id wt_public_id not null,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    salt bytea not null
+      constraint salt_must_not_be_empty
+        check(length(salt) > 0),
+    derived_key bytea not null
+      constraint derived_key_must_not_be_empty
+        check(length(derived_key) > 0),
+    key_id kms_private_id
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    foreign key (password_method_id, password_conf_id)
+      references auth_password_argon2_conf (password_method_id, private_id)
+      on delete cascade
+      on update cascade
+  );
+  comment on table auth_password_argon2_cred_history is
+    'auth_password_argon2_cred_history holds the previous argon2 credentials of a password account.';
+
+  create index auth_password_argon2_cred_history_account_create_time_ix
+    on auth_password_argon2_cred_history (password_account_id, create_time desc);
+
+  create trigger update_time_column before update on auth_password_argon2_cred_history
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_password_argon2_cred_history
+    for each row execute procedure immutable_columns('private_id', 'password_account_id', 'password_conf_id', 'password_method_id', 'create_time', 'derived_key');
+
+  create trigger default_create_time_column before insert on auth_password_argon2_cred_history
+    for each row execute procedure default_create_time();
+
+commit;
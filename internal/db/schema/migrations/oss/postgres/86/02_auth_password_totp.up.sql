@@ -0,0 +1,74 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  alter table auth_password_policy
+    add column totp_required boolean not null default false;
+
+  -- auth_password_totp holds the time-based one-time password (TOTP) secret of
+  -- a password account. The enrollment is pending until verify_time is set by
+  -- providing a valid code generated from the secret. Only verified
+  -- enrollments are used as a second factor during authentication.
+  -- failed_attempts counts the invalid codes provided for a verified
+  -- enrollment since a valid one. It is independent of the lockout of the
+  -- password policy, and TOTP codes are refused once it reaches a fixed limit
+  -- until a recovery code is used.
+  create table auth_password_totp (
+    password_account_id wt_public_id primary key
+      references auth_password_account (public_id)
+      on delete cascade
+      on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    ct_secret bytea not null
+      constraint ct_secret_must_not_be_empty
+        check(length(ct_secret) > 0),
+    key_id kms_private_id
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    verify_time timestamp with time zone,
+    last_used_step bigint not null default 0
+      constraint last_used_step_must_not_be_negative
+        check(last_used_step >= 0),
+    failed_attempts int not null default 0
+      constraint failed_attempts_must_not_be_negative
+        check(failed_attempts >= 0)
+  );
+  comment on table auth_password_totp is
+    'auth_password_totp holds the encrypted TOTP secret of a password account.';
+
+  create trigger update_time_column before update on auth_password_totp
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_password_totp
+    for each row execute procedure immutable_columns('password_account_id', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_password_totp
+    for each row execute procedure default_create_time();
+
+  -- auth_password_totp_recovery_code holds the hashed single use recovery
+  -- codes of a TOTP enrollment. A recovery code is deleted when it is used.
+  create table auth_password_totp_recovery_code (
+    password_account_id wt_public_id not null
+      references auth_password_totp (password_account_id)
+      on delete cascade
+      on update cascade,
+    code_hash bytea not null
+      constraint code_hash_must_not_be_empty
+        check(length(code_hash) > 0),
+    create_time wt_timestamp,
+    primary key (password_account_id, code_hash)
+  );
+  comment on table auth_password_totp_recovery_code is
+    'auth_password_totp_recovery_code holds the hashed recovery codes of a TOTP enrollment.';
+
+  create trigger immutable_columns before update on auth_password_totp_recovery_code
+    for each row execute procedure immutable_columns('password_account_id', 'code_hash', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_password_totp_recovery_code
+    for each row execute procedure default_create_time();
+
+commit;
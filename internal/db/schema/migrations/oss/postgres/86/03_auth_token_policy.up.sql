@@ -0,0 +1,61 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- auth_token_policy holds the auth token settings for an auth method. An auth
+  -- method without a row in this table uses the auth token time to live and
+  -- time to stale durations from the controller configuration and does not
+  -- limit the number of auth tokens per user. A value of 0 in any column falls
+  -- back to the same default.
+  create table auth_token_policy (
+    auth_method_id wt_public_id primary key
+      references auth_method (public_id)
+      on delete cascade
+      on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    time_to_live_seconds int not null default 0
+      constraint time_to_live_seconds_must_not_be_negative
+        check(time_to_live_seconds >= 0),
+    time_to_stale_seconds int not null default 0
+      constraint time_to_stale_seconds_must_not_be_negative
+        check(time_to_stale_seconds >= 0),
+    max_tokens_per_user int not null default 0
+      constraint max_tokens_per_user_must_not_be_negative
+        check(max_tokens_per_user >= 0)
+  );
+  comment on table auth_token_policy is
+    'auth_token_policy holds the lifetime, idle timeout and per user limit of auth tokens issued by an auth method.';
+
+  create trigger update_time_column before update on auth_token_policy
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_token_policy
+    for each row execute procedure immutable_columns('auth_method_id', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_token_policy
+    for each row execute procedure default_create_time();
+
+  -- Replaces the view from 2/05_authtoken.up.sql to add the idle timeout of the
+  -- auth method, which is checked each time an auth token is validated.
+  create or replace view auth_token_account as
+        select at.public_id,
+               at.token,
+               at.auth_account_id,
+               at.create_time,
+               at.update_time,
+               at.approximate_last_access_time,
+               at.expiration_time,
+               aa.scope_id,
+               aa.iam_user_id,
+               aa.auth_method_id,
+               at.status,
+               coalesce(atp.time_to_stale_seconds, 0) as time_to_stale_seconds
+          from auth_token as at
+    inner join auth_account as aa
+            on at.auth_account_id = aa.public_id
+     left join auth_token_policy as atp
+            on aa.auth_method_id = atp.auth_method_id;
+
+commit;
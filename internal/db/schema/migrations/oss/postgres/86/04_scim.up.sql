@@ -0,0 +1,80 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- scim_user holds the SCIM attributes of users provisioned through the SCIM
+  -- endpoint of the controller which are not part of iam_user.
+  create table scim_user (
+    iam_user_id wt_user_id primary key
+      references iam_user (public_id)
+      on delete cascade
+      on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    external_id text
+      constraint external_id_must_not_be_empty
+        check(length(trim(external_id)) > 0),
+    active boolean not null default true
+  );
+  comment on table scim_user is
+    'scim_user holds the external id and active state of users provisioned through SCIM.';
+
+  create trigger update_time_column before update on scim_user
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on scim_user
+    for each row execute procedure immutable_columns('iam_user_id', 'create_time');
+
+  create trigger default_create_time_column before insert on scim_user
+    for each row execute procedure default_create_time();
+
+  -- scim_group holds the SCIM attributes of groups provisioned through the
+  -- SCIM endpoint of the controller which are not part of iam_group.
+  create table scim_group (
+    iam_group_id wt_public_id primary key
+      references iam_group (public_id)
+      on delete cascade
+      on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    external_id text
+      constraint external_id_must_not_be_empty
+        check(length(trim(external_id)) > 0)
+  );
+  comment on table scim_group is
+    'scim_group holds the external id of groups provisioned through SCIM.';
+
+  create trigger update_time_column before update on scim_group
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on scim_group
+    for each row execute procedure immutable_columns('iam_group_id', 'create_time');
+
+  create trigger default_create_time_column before insert on scim_group
+    for each row execute procedure default_create_time();
+
+  -- scim_user_deprovisioned prevents auth tokens from being issued to users
+  -- that have been deactivated through SCIM.
+  create function scim_user_deprovisioned() returns trigger
+  as $$
+  begin
+    perform
+       from auth_account aa
+       join scim_user su
+         on su.iam_user_id = aa.iam_user_id
+      where aa.public_id = new.auth_account_id
+        and su.active = false;
+    if found then
+      raise exception 'user has been deprovisioned';
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function scim_user_deprovisioned is
+    'scim_user_deprovisioned is a before insert trigger function for the auth_token table that rejects auth tokens for users deactivated through SCIM.';
+
+  create trigger scim_user_deprovisioned before insert on auth_token
+    for each row execute procedure scim_user_deprovisioned();
+
+commit;
@@ -0,0 +1,566 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table auth_saml_method_state_enm (
+    name text primary key
+      constraint name_only_predefined_saml_method_states_allowed
+        check (name in ('inactive', 'active-private', 'active-public'))
+  );
+  comment on table auth_saml_method_state_enm is
+    'auth_saml_method_state_enm entries enumerate the valid auth saml method states';
+
+  insert into auth_saml_method_state_enm(name)
+    values
+      ('inactive'),
+      ('active-private'),
+      ('active-public');
+
+  create table auth_saml_method (
+    public_id wt_public_id primary key,
+    scope_id wt_scope_id not null,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    state text not null
+      constraint auth_saml_method_state_enm_fkey
+        references auth_saml_method_state_enm(name)
+        on delete restrict
+        on update cascade,
+    api_url wt_url, -- an address prefix at which the boundary api is reachable.
+    idp_entity_id text
+      constraint idp_entity_id_must_not_be_empty
+        check(length(trim(idp_entity_id)) > 0),
+    idp_sso_url wt_url,
+    idp_certificates text
+      constraint idp_certificates_must_not_be_empty
+        check(length(trim(idp_certificates)) > 0),
+    sp_entity_id text
+      constraint sp_entity_id_must_not_be_empty
+        check(length(trim(sp_entity_id)) > 0),
+    sp_certificate text not null
+      constraint sp_certificate_must_not_be_empty
+        check(length(trim(sp_certificate)) > 0),
+    sp_private_key bytea not null -- encrypted private key of the sp certificate.
+      constraint sp_private_key_must_not_be_empty
+        check(length(sp_private_key) > 0),
+    key_id kms_private_id not null -- key used to encrypt entries via wrapping wrapper.
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    sign_authn_requests bool not null default false,
+    name_id_format text
+      constraint name_id_format_must_not_be_empty
+        check(length(trim(name_id_format)) > 0),
+    constraint auth_method_fkey
+      foreign key (scope_id, public_id)
+        references auth_method (scope_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_saml_method_scope_id_name_uq
+      unique(scope_id, name),
+    constraint auth_saml_method_scope_id_public_id_uq
+      unique(scope_id, public_id)
+  );
+  comment on table auth_saml_method is
+    'auth_saml_method entries are the current saml auth methods configured for existing scopes.';
+
+  create trigger insert_auth_method_subtype before insert on auth_saml_method
+    for each row execute procedure insert_auth_method_subtype();
+
+  create trigger delete_auth_method_subtype after delete on auth_saml_method
+    for each row execute procedure delete_auth_method_subtype();
+
+  create trigger update_auth_method_subtype before update on auth_saml_method
+    for each row execute procedure update_auth_method_subtype();
+
+  create trigger update_auth_method_table_update_time before update on auth_saml_method
+    for each row execute procedure update_auth_method_table_update_time();
+
+  create trigger update_auth_method_table_is_active_public_state before update on auth_saml_method
+    for each row execute procedure update_auth_method_table_is_active_public_state();
+
+  create trigger update_time_column before update on auth_saml_method
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_saml_method
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_saml_method
+    for each row execute procedure default_create_time();
+
+  create trigger update_version_column after update on auth_saml_method
+    for each row execute procedure update_version_column();
+
+  -- active_auth_saml_method_must_be_complete prevents incomplete saml auth
+  -- methods from transitioning out of the inactive state.
+  create function active_auth_saml_method_must_be_complete() returns trigger
+  as $$
+  begin
+    if new.state != 'inactive' then
+      if new.api_url is null or new.idp_entity_id is null or new.idp_sso_url is null or new.idp_certificates is null then
+        raise exception 'an incomplete saml auth method must remain inactive';
+      end if;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function active_auth_saml_method_must_be_complete is
+    'active_auth_saml_method_must_be_complete is a before insert or update trigger function for the auth_saml_method table '
+    'that rejects incomplete saml auth methods which are not inactive.';
+
+  create trigger active_auth_saml_method_must_be_complete before insert or update on auth_saml_method
+    for each row execute procedure active_auth_saml_method_must_be_complete();
+
+  create index auth_saml_method_create_time_public_id_idx
+      on auth_saml_method (create_time desc, public_id desc);
+  create index auth_saml_method_update_time_public_id_idx
+      on auth_saml_method (update_time desc, public_id desc);
+
+  -- auth_saml_account_attribute_map entries are the optional attribute maps
+  -- from SAML attributes to the standard claims of sub, name and email.  There
+  -- can be 0 or more for each parent saml auth method.
+  create table auth_saml_account_attribute_map (
+    create_time wt_timestamp,
+    saml_method_id wt_public_id
+      constraint auth_saml_method_fkey
+        references auth_saml_method(public_id)
+        on delete cascade
+        on update cascade,
+    from_attribute text not null
+      constraint from_attribute_must_not_be_empty
+        check(length(trim(from_attribute)) > 0)
+      constraint from_attribute_must_be_less_than_1024_chars
+        check(length(trim(from_attribute)) < 1024),
+    to_attribute text not null
+      constraint to_attribute_valid_values
+        check (to_attribute in ('sub', 'name', 'email')), -- intentionally case-sensitive matching
+    primary key(saml_method_id, to_attribute)
+  );
+  comment on table auth_saml_account_attribute_map is
+    'auth_saml_account_attribute_map entries are the optional attribute maps from SAML attributes to the standard claims of sub, name and email. '
+    'There can be 0 or more for each parent saml auth method.';
+
+  create trigger default_create_time_column before insert on auth_saml_account_attribute_map
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_saml_account_attribute_map
+    for each row execute procedure immutable_columns('saml_method_id', 'from_attribute', 'to_attribute', 'create_time');
+
+  create table auth_saml_account (
+    public_id wt_public_id primary key,
+    auth_method_id wt_public_id not null,
+    -- The scope_id type is not wt_scope_id because the domain check is
+    -- executed before the insert trigger which retrieves the scope_id causing
+    -- an insert to fail.
+    scope_id text not null,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    issuer text not null -- entity id of the identity provider which asserted the account.
+      constraint issuer_must_not_be_empty
+        check(length(trim(issuer)) > 0),
+    subject text not null -- case-sensitive string that maps to the NameID of an assertion.
+      constraint subject_must_not_be_empty
+        check(length(trim(subject)) > 0)
+      constraint subject_must_be_less_than_1024_chars
+        check(length(trim(subject)) < 1024),
+    full_name wt_full_name,
+    email wt_email,
+    attributes text
+      constraint attributes_must_not_be_empty
+        check(length(trim(attributes)) > 0),
+    constraint auth_saml_method_fkey
+      foreign key (scope_id, auth_method_id)
+        references auth_saml_method (scope_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_account_fkey
+      foreign key (scope_id, auth_method_id, public_id)
+        references auth_account (scope_id, auth_method_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_saml_account_auth_method_id_name_uq
+      unique(auth_method_id, name),
+    -- ###############################################################
+    -- any change to this constraints name must be aligned with the
+    -- acctUpsertQuery const in internal/auth/saml/query.go
+    -- ###############################################################
+    constraint auth_saml_account_auth_method_id_issuer_subject_uq
+      unique(auth_method_id, issuer, subject),
+    constraint auth_saml_account_auth_method_id_public_id_uq
+      unique(auth_method_id, public_id)
+  );
+  comment on table auth_saml_account is
+    'auth_saml_account entries are subtypes of auth_account and represent a saml account.';
+
+  -- insert_auth_saml_account_subtype is a before insert trigger on
+  -- auth_saml_account which inserts the base auth_account. Conflicts are
+  -- ignored since accounts are upserted on every authentication.
+  create function insert_auth_saml_account_subtype() returns trigger
+  as $$
+  begin
+    select auth_method.scope_id
+      into new.scope_id
+      from auth_method
+     where auth_method.public_id = new.auth_method_id;
+
+    insert into auth_account
+      (public_id, auth_method_id, scope_id)
+    values
+      (new.public_id, new.auth_method_id, new.scope_id)
+    on conflict do nothing;
+
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger insert_auth_saml_account_subtype before insert on auth_saml_account
+    for each row execute procedure insert_auth_saml_account_subtype();
+
+  create trigger delete_auth_account_subtype after delete on auth_saml_account
+    for each row execute procedure delete_auth_account_subtype();
+
+  create trigger update_time_column before update on auth_saml_account
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_saml_account
+    for each row execute procedure immutable_columns('public_id', 'auth_method_id', 'scope_id', 'create_time', 'issuer', 'subject');
+
+  create trigger default_create_time_column before insert on auth_saml_account
+    for each row execute procedure default_create_time();
+
+  create trigger update_version_column after update on auth_saml_account
+    for each row execute procedure update_version_column();
+
+  create index auth_saml_account_create_time_public_id_idx
+      on auth_saml_account (create_time desc, public_id desc);
+  create index auth_saml_account_update_time_public_id_idx
+      on auth_saml_account (update_time desc, public_id desc);
+
+  create table auth_saml_managed_group (
+    public_id wt_public_id primary key,
+    auth_method_id wt_public_id not null,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    filter wt_bexprfilter not null,
+    constraint auth_saml_method_fkey
+      foreign key (auth_method_id)
+        references auth_saml_method (public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_managed_group_fkey
+      foreign key (auth_method_id, public_id)
+        references auth_managed_group (auth_method_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_saml_managed_group_auth_method_id_name_uq
+      unique(auth_method_id, name)
+  );
+  comment on table auth_saml_managed_group is
+    'auth_saml_managed_group entries are subtypes of auth_managed_group and represent a saml managed group.';
+
+  create trigger immutable_columns before update on auth_saml_managed_group
+    for each row execute procedure immutable_columns('public_id', 'auth_method_id', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_saml_managed_group
+    for each row execute procedure default_create_time();
+
+  create trigger update_time_column before update on auth_saml_managed_group
+    for each row execute procedure update_time_column();
+
+  create trigger update_version_column after update on auth_saml_managed_group
+    for each row execute procedure update_version_column();
+
+  create trigger insert_managed_group_subtype before insert on auth_saml_managed_group
+    for each row execute procedure insert_managed_group_subtype();
+
+  create trigger delete_managed_group_subtype after delete on auth_saml_managed_group
+    for each row execute procedure delete_managed_group_subtype();
+
+  create index auth_saml_managed_group_create_time_public_id_idx
+      on auth_saml_managed_group (create_time desc, public_id desc);
+  create index auth_saml_managed_group_update_time_public_id_idx
+      on auth_saml_managed_group (update_time desc, public_id desc);
+
+  create table auth_saml_managed_group_member_account (
+    create_time wt_timestamp,
+    managed_group_id wt_public_id
+      references auth_saml_managed_group(public_id)
+      on delete cascade
+      on update cascade,
+    member_id wt_public_id
+      references auth_saml_account(public_id)
+      on delete cascade
+      on update cascade,
+    primary key (managed_group_id, member_id)
+  );
+  comment on table auth_saml_managed_group_member_account is
+    'auth_saml_managed_group_member_account is the join table for managed saml groups and accounts.';
+
+  create function auth_immutable_managed_saml_group_member_account() returns trigger
+  as $$
+  begin
+    raise exception 'managed saml group members are immutable';
+  end;
+  $$ language plpgsql;
+
+  create trigger default_create_time_column before insert on auth_saml_managed_group_member_account
+    for each row execute procedure default_create_time();
+
+  create trigger auth_immutable_managed_saml_group_member_account before update on auth_saml_managed_group_member_account
+    for each row execute procedure auth_immutable_managed_saml_group_member_account();
+
+  -- auth_saml_request entries are in-flight authentication attempts. The
+  -- public_id is sent to the identity provider as the id of the AuthnRequest
+  -- and as the RelayState.
+  create table auth_saml_request (
+    public_id wt_public_id primary key,
+    create_time wt_timestamp,
+    auth_method_id wt_public_id not null
+      constraint auth_saml_method_fkey
+        references auth_saml_method (public_id)
+        on delete cascade
+        on update cascade,
+    token_request_id wt_public_id not null
+      constraint auth_saml_request_token_request_id_uq
+        unique,
+    token_id text not null
+      constraint token_id_must_not_be_empty
+        check(length(trim(token_id)) > 0)
+      constraint auth_saml_request_token_id_uq
+        unique,
+    final_redirect_url wt_url not null,
+    expiration_time wt_timestamp not null
+  );
+  comment on table auth_saml_request is
+    'auth_saml_request entries are the in-flight authentication attempts of saml auth methods.';
+
+  create trigger default_create_time_column before insert on auth_saml_request
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_saml_request
+    for each row execute procedure immutable_columns('public_id', 'create_time', 'auth_method_id', 'token_request_id', 'token_id', 'final_redirect_url', 'expiration_time');
+
+  create index auth_saml_request_expiration_time_idx
+      on auth_saml_request (expiration_time);
+
+  create view saml_auth_method_with_value_obj as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.state,
+    am.api_url,
+    am.idp_entity_id,
+    am.idp_sso_url,
+    am.idp_certificates,
+    am.sp_entity_id,
+    am.sp_certificate,
+    am.sp_private_key,
+    am.key_id,
+    am.sign_authn_requests,
+    am.name_id_format,
+    -- the string_agg(..) column will be null if there are no associated value objects
+    string_agg(distinct concat_ws('=', aam.from_attribute, aam.to_attribute), '|') as account_attribute_maps
+  from
+    auth_saml_method am
+    left outer join iam_scope                       s   on am.public_id = s.primary_auth_method_id
+    left outer join auth_saml_account_attribute_map aam on am.public_id = aam.saml_method_id
+  group by am.public_id, is_primary_auth_method; -- there can be only one public_id + is_primary_auth_method, so group by isn't a problem.
+  comment on view saml_auth_method_with_value_obj is
+    'saml auth method with its associated value objects (account attribute maps) as columns with | delimited values';
+
+  create table auth_saml_method_deleted (
+    public_id wt_public_id primary key,
+    delete_time wt_timestamp not null
+  );
+  comment on table auth_saml_method_deleted is
+    'auth_saml_method_deleted holds the ID and delete_time of every deleted SAML auth method. '
+    'It is automatically trimmed of records older than 30 days by a job.';
+
+  create trigger insert_deleted_id after delete on auth_saml_method
+    for each row execute function insert_deleted_id('auth_saml_method_deleted');
+
+  create index auth_saml_method_deleted_delete_time_idx on auth_saml_method_deleted (delete_time);
+
+  create table auth_saml_account_deleted (
+    public_id wt_public_id primary key,
+    delete_time wt_timestamp not null
+  );
+  comment on table auth_saml_account_deleted is
+    'auth_saml_account_deleted holds the ID and delete_time of every deleted SAML account. '
+    'It is automatically trimmed of records older than 30 days by a job.';
+
+  create trigger insert_deleted_id after delete on auth_saml_account
+    for each row execute function insert_deleted_id('auth_saml_account_deleted');
+
+  create index auth_saml_account_deleted_delete_time_idx on auth_saml_account_deleted (delete_time);
+
+  create table auth_saml_managed_group_deleted (
+    public_id wt_public_id primary key,
+    delete_time wt_timestamp not null
+  );
+  comment on table auth_saml_managed_group_deleted is
+    'auth_saml_managed_group_deleted holds the ID and delete_time of every deleted SAML managed group. '
+    'It is automatically trimmed of records older than 30 days by a job.';
+
+  create trigger insert_deleted_id after delete on auth_saml_managed_group
+    for each row execute function insert_deleted_id('auth_saml_managed_group_deleted');
+
+  create index auth_saml_managed_group_deleted_delete_time_idx on auth_saml_managed_group_deleted (delete_time);
+
+  insert into oplog_ticket (name, version)
+  values
+    ('auth_saml_method', 1),
+    ('auth_saml_account', 1),
+    ('auth_saml_managed_group', 1);
+
+  -- Replaces the view defined in 65/01_ldap.up.sql to include saml managed
+  -- groups.
+  drop view auth_managed_group_member_account;
+  create view auth_managed_group_member_account as
+  select
+    oidc.create_time,
+    oidc.managed_group_id,
+    oidc.member_id
+  from
+    auth_oidc_managed_group_member_account oidc
+  union
+  select
+    ldap.create_time,
+    ldap.managed_group_id,
+    ldap.member_id
+  from
+    auth_ldap_managed_group_member_account ldap
+  union
+  select
+    saml.create_time,
+    saml.managed_group_id,
+    saml.member_id
+  from
+    auth_saml_managed_group_member_account saml;
+  comment on view auth_managed_group_member_account is
+    'auth_managed_group_member_account is the join view for managed groups and accounts of every auth method subtype';
+
+  -- Replaces the view defined in 65/02_wh_user_dimension_ldap.up.sql to
+  -- include saml accounts and auth methods.
+  drop view whx_user_dimension_source;
+  create view whx_user_dimension_source as
+       select -- id is the first column in the target view
+              u.public_id                       as user_id,
+              coalesce(u.name, 'None')          as user_name,
+              coalesce(u.description, 'None')   as user_description,
+              coalesce(aa.public_id, 'None')    as auth_account_id,
+              case
+                   when apa.public_id is not null then 'password auth account'
+                   when ala.public_id is not null then 'ldap auth account'
+                   when aoa.public_id is not null then 'oidc auth account'
+                   when asa.public_id is not null then 'saml auth account'
+                   else 'None'
+                   end                          as auth_account_type,
+              case
+                   when apa.public_id is not null then coalesce(apa.name, 'None')
+                   when ala.public_id is not null then coalesce(ala.name, 'None')
+                   when aoa.public_id is not null then coalesce(aoa.name, 'None')
+                   when asa.public_id is not null then coalesce(asa.name, 'None')
+                   else 'None'
+                   end                          as auth_account_name,
+              case
+                   when apa.public_id is not null then coalesce(apa.description, 'None')
+                   when ala.public_id is not null then coalesce(ala.description, 'None')
+                   when aoa.public_id is not null then coalesce(aoa.description, 'None')
+                   when asa.public_id is not null then coalesce(asa.description, 'None')
+                   else 'None'
+                   end                          as auth_account_description,
+              case
+                  when apa.public_id is not null then 'Not Applicable'
+                  when ala.public_id is not null then ala.login_name
+                  when aoa.public_id is not null then aoa.subject
+                  when asa.public_id is not null then asa.subject
+                  else 'None'
+                  end                           as auth_account_external_id,
+              case
+                  when apa.public_id is not null then 'Not Applicable'
+                  when  ala.public_id is not null
+                    and ala.full_name is not null then ala.full_name
+                  when  aoa.public_id is not null
+                    and aoa.full_name is not null then aoa.full_name
+                  when  asa.public_id is not null
+                    and asa.full_name is not null then asa.full_name
+                  else 'None'
+                  end                           as auth_account_full_name,
+              case
+                  when apa.public_id is not null then 'Not Applicable'
+                  when  ala.public_id is not null
+                    and ala.email is not null then ala.email
+                  when  aoa.public_id is not null
+                    and aoa.email is not null then aoa.email
+                  when  asa.public_id is not null
+                    and asa.email is not null then asa.email
+                  else 'None'
+                  end                           as auth_account_email,
+              coalesce(am.public_id, 'None')    as auth_method_id,
+              case
+                   when apa.public_id is not null then 'password auth method'
+                   when ala.public_id is not null then 'ldap auth method'
+                   when aoa.public_id is not null then 'oidc auth method'
+                   when asa.public_id is not null then 'saml auth method'
+                   else 'None'
+                   end                          as auth_method_type,
+              case
+                   when apm.public_id is not null then coalesce(apm.name, 'None')
+                   when alm.public_id is not null then coalesce(alm.name, 'None')
+                   when aom.public_id is not null then coalesce(aom.name, 'None')
+                   when asm.public_id is not null then coalesce(asm.name, 'None')
+                   else 'None'
+                   end                          as auth_method_name,
+              case
+                   when apm.public_id is not null then coalesce(apm.description, 'None')
+                   when alm.public_id is not null then coalesce(alm.description, 'None')
+                   when aom.public_id is not null then coalesce(aom.description, 'None')
+                   when asm.public_id is not null then coalesce(asm.description, 'None')
+                   else 'None'
+                   end                          as auth_method_description,
+              case
+                  when apa.public_id is not null then 'Not Applicable'
+                  when alm.public_id is not null then 'Not Applicable'
+                  when asm.public_id is not null then coalesce(asm.idp_entity_id, 'None')
+                  when aom.public_id is null then 'None'
+                  else aom.issuer
+                  end                           as auth_method_external_id,
+              org.public_id                     as user_organization_id,
+              coalesce(org.name, 'None')        as user_organization_name,
+              coalesce(org.description, 'None') as user_organization_description
+         from iam_user as u
+    left join auth_account as aa on           u.public_id       = aa.iam_user_id
+    left join auth_method as am on            aa.auth_method_id = am.public_id
+    left join auth_password_account as apa on aa.public_id      = apa.public_id
+    left join auth_password_method as apm on  am.public_id      = apm.public_id
+    left join auth_oidc_account as aoa on     aa.public_id      = aoa.public_id
+    left join auth_oidc_method as aom on      am.public_id      = aom.public_id
+    left join auth_ldap_account as ala on     aa.public_id      = ala.public_id
+    left join auth_ldap_method as alm on      am.public_id      = alm.public_id
+    left join auth_saml_account as asa on     aa.public_id      = asa.public_id
+    left join auth_saml_method as asm on      am.public_id      = asm.public_id
+         join iam_scope as org on             u.scope_id        = org.public_id
+  ;
+
+commit;
@@ -0,0 +1,377 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table auth_cert_method_state_enm (
+    name text primary key
+      constraint name_only_predefined_cert_method_states_allowed
+        check (name in ('inactive', 'active-private', 'active-public'))
+  );
+  comment on table auth_cert_method_state_enm is
+    'auth_cert_method_state_enm entries enumerate the valid auth cert method states';
+
+  insert into auth_cert_method_state_enm(name)
+    values
+      ('inactive'),
+      ('active-private'),
+      ('active-public');
+
+  create table auth_cert_method_account_field_enm (
+    name text primary key
+      constraint name_only_predefined_cert_method_account_fields_allowed
+        check (name in ('subject-cn', 'subject-dn', 'san-email', 'san-dns', 'san-uri'))
+  );
+  comment on table auth_cert_method_account_field_enm is
+    'auth_cert_method_account_field_enm entries enumerate the fields of a client certificate which can be mapped to an account';
+
+  insert into auth_cert_method_account_field_enm(name)
+    values
+      ('subject-cn'),
+      ('subject-dn'),
+      ('san-email'),
+      ('san-dns'),
+      ('san-uri');
+
+  create table auth_cert_method (
+    public_id wt_public_id primary key,
+    scope_id wt_scope_id not null,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    state text not null
+      constraint auth_cert_method_state_enm_fkey
+        references auth_cert_method_state_enm(name)
+        on delete restrict
+        on update cascade,
+    ca_certificates text -- pem encoded ca certificates which issue the client certificates.
+      constraint ca_certificates_must_not_be_empty
+        check(length(trim(ca_certificates)) > 0),
+    account_field text not null default 'subject-cn'
+      constraint auth_cert_method_account_field_enm_fkey
+        references auth_cert_method_account_field_enm(name)
+        on delete restrict
+        on update cascade,
+    constraint auth_method_fkey
+      foreign key (scope_id, public_id)
+        references auth_method (scope_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_cert_method_scope_id_name_uq
+      unique(scope_id, name),
+    constraint auth_cert_method_scope_id_public_id_uq
+      unique(scope_id, public_id)
+  );
+  comment on table auth_cert_method is
+    'auth_cert_method entries are the current client certificate auth methods configured for existing scopes.';
+
+  create trigger insert_auth_method_subtype before insert on auth_cert_method
+    for each row execute procedure insert_auth_method_subtype();
+
+  create trigger delete_auth_method_subtype after delete on auth_cert_method
+    for each row execute procedure delete_auth_method_subtype();
+
+  create trigger update_auth_method_subtype before update on auth_cert_method
+    for each row execute procedure update_auth_method_subtype();
+
+  create trigger update_auth_method_table_update_time before update on auth_cert_method
+    for each row execute procedure update_auth_method_table_update_time();
+
+  create trigger update_auth_method_table_is_active_public_state before update on auth_cert_method
+    for each row execute procedure update_auth_method_table_is_active_public_state();
+
+  create trigger update_time_column before update on auth_cert_method
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_cert_method
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_cert_method
+    for each row execute procedure default_create_time();
+
+  create trigger update_version_column after update on auth_cert_method
+    for each row execute procedure update_version_column();
+
+  -- active_auth_cert_method_must_be_complete prevents cert auth methods
+  -- without ca certificates from transitioning out of the inactive state.
+  create function active_auth_cert_method_must_be_complete() returns trigger
+  as $$
+  begin
+    if new.state != 'inactive' and new.ca_certificates is null then
+      raise exception 'an incomplete cert auth method must remain inactive';
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function active_auth_cert_method_must_be_complete is
+    'active_auth_cert_method_must_be_complete is a before insert or update trigger function for the auth_cert_method table '
+    'that rejects incomplete cert auth methods which are not inactive.';
+
+  create trigger active_auth_cert_method_must_be_complete before insert or update on auth_cert_method
+    for each row execute procedure active_auth_cert_method_must_be_complete();
+
+  create index auth_cert_method_create_time_public_id_idx
+      on auth_cert_method (create_time desc, public_id desc);
+  create index auth_cert_method_update_time_public_id_idx
+      on auth_cert_method (update_time desc, public_id desc);
+
+  create table auth_cert_account (
+    public_id wt_public_id primary key,
+    auth_method_id wt_public_id not null,
+    -- The scope_id type is not wt_scope_id because the domain check is
+    -- executed before the insert trigger which retrieves the scope_id causing
+    -- an insert to fail.
+    scope_id text not null,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    subject text not null -- case-sensitive value of the auth method's account_field.
+      constraint subject_must_not_be_empty
+        check(length(trim(subject)) > 0)
+      constraint subject_must_be_less_than_1024_chars
+        check(length(trim(subject)) < 1024),
+    full_name wt_full_name,
+    email wt_email,
+    constraint auth_cert_method_fkey
+      foreign key (scope_id, auth_method_id)
+        references auth_cert_method (scope_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_account_fkey
+      foreign key (scope_id, auth_method_id, public_id)
+        references auth_account (scope_id, auth_method_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_cert_account_auth_method_id_name_uq
+      unique(auth_method_id, name),
+    -- ###############################################################
+    -- any change to this constraints name must be aligned with the
+    -- acctUpsertQuery const in internal/auth/cert/query.go
+    -- ###############################################################
+    constraint auth_cert_account_auth_method_id_subject_uq
+      unique(auth_method_id, subject),
+    constraint auth_cert_account_auth_method_id_public_id_uq
+      unique(auth_method_id, public_id)
+  );
+  comment on table auth_cert_account is
+    'auth_cert_account entries are subtypes of auth_account and represent a client certificate account.';
+
+  -- insert_auth_cert_account_subtype is a before insert trigger on
+  -- auth_cert_account which inserts the base auth_account. Conflicts are
+  -- ignored since accounts are upserted on every authentication.
+  create function insert_auth_cert_account_subtype() returns trigger
+  as $$
+  begin
+    select auth_method.scope_id
+      into new.scope_id
+      from auth_method
+     where auth_method.public_id = new.auth_method_id;
+
+    insert into auth_account
+      (public_id, auth_method_id, scope_id)
+    values
+      (new.public_id, new.auth_method_id, new.scope_id)
+    on conflict do nothing;
+
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger insert_auth_cert_account_subtype before insert on auth_cert_account
+    for each row execute procedure insert_auth_cert_account_subtype();
+
+  create trigger delete_auth_account_subtype after delete on auth_cert_account
+    for each row execute procedure delete_auth_account_subtype();
+
+  create trigger update_time_column before update on auth_cert_account
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_cert_account
+    for each row execute procedure immutable_columns('public_id', 'auth_method_id', 'scope_id', 'create_time', 'subject');
+
+  create trigger default_create_time_column before insert on auth_cert_account
+    for each row execute procedure default_create_time();
+
+  create trigger update_version_column after update on auth_cert_account
+    for each row execute procedure update_version_column();
+
+  create index auth_cert_account_create_time_public_id_idx
+      on auth_cert_account (create_time desc, public_id desc);
+  create index auth_cert_account_update_time_public_id_idx
+      on auth_cert_account (update_time desc, public_id desc);
+
+  create view cert_auth_method_with_is_primary as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.state,
+    am.ca_certificates,
+    am.account_field
+  from
+    auth_cert_method am
+    left outer join iam_scope s on am.public_id = s.primary_auth_method_id;
+  comment on view cert_auth_method_with_is_primary is
+    'cert auth method with an is_primary_auth_method bool';
+
+  create table auth_cert_method_deleted (
+    public_id wt_public_id primary key,
+    delete_time wt_timestamp not null
+  );
+  comment on table auth_cert_method_deleted is
+    'auth_cert_method_deleted holds the ID and delete_time of every deleted cert auth method. '
+    'It is automatically trimmed of records older than 30 days by a job.';
+
+  create trigger insert_deleted_id after delete on auth_cert_method
+    for each row execute function insert_deleted_id('auth_cert_method_deleted');
+
+  create index auth_cert_method_deleted_delete_time_idx on auth_cert_method_deleted (delete_time);
+
+  create table auth_cert_account_deleted (
+    public_id wt_public_id primary key,
+    delete_time wt_timestamp not null
+  );
+  comment on table auth_cert_account_deleted is
+    'auth_cert_account_deleted holds the ID and delete_time of every deleted cert account. '
+    'It is automatically trimmed of records older than 30 days by a job.';
+
+  create trigger insert_deleted_id after delete on auth_cert_account
+    for each row execute function insert_deleted_id('auth_cert_account_deleted');
+
+  create index auth_cert_account_deleted_delete_time_idx on auth_cert_account_deleted (delete_time);
+
+  insert into oplog_ticket (name, version)
+  values
+    ('auth_cert_method', 1),
+    ('auth_cert_account', 1);
+
+  -- Replaces the view defined in 86/05_auth_saml.up.sql to include cert
+  -- accounts and auth methods.
+  drop view whx_user_dimension_source;
+  create view whx_user_dimension_source as
+       select -- id is the first column in the target view
+              u.public_id                       as user_id,
+              coalesce(u.name, 'None')          as user_name,
+              coalesce(u.description, 'None')   as user_description,
+              coalesce(aa.public_id, 'None')    as auth_account_id,
+              case
+                   when apa.public_id is not null then 'password auth account'
+                   when ala.public_id is not null then 'ldap auth account'
+                   when aoa.public_id is not null then 'oidc auth account'
+                   when asa.public_id is not null then 'saml auth account'
+                   when aca.public_id is not null then 'cert auth account'
+                   else 'None'
+                   end                          as auth_account_type,
+              case
+                   when apa.public_id is not null then coalesce(apa.name, 'None')
+                   when ala.public_id is not null then coalesce(ala.name, 'None')
+                   when aoa.public_id is not null then coalesce(aoa.name, 'None')
+                   when asa.public_id is not null then coalesce(asa.name, 'None')
+                   when aca.public_id is not null then coalesce(aca.name, 'None')
+                   else 'None'
+                   end                          as auth_account_name,
+              case
+                   when apa.public_id is not null then coalesce(apa.description, 'None')
+                   when ala.public_id is not null then coalesce(ala.description, 'None')
+                   when aoa.public_id is not null then coalesce(aoa.description, 'None')
+                   when asa.public_id is not null then coalesce(asa.description, 'None')
+                   when aca.public_id is not null then coalesce(aca.description, 'None')
+                   else 'None'
+                   end                          as auth_account_description,
+              case
+                  when apa.public_id is not null then 'Not Applicable'
+                  when ala.public_id is not null then ala.login_name
+                  when aoa.public_id is not null then aoa.subject
+                  when asa.public_id is not null then asa.subject
+                  when aca.public_id is not null then aca.subject
+                  else 'None'
+                  end                           as auth_account_external_id,
+              case
+                  when apa.public_id is not null then 'Not Applicable'
+                  when  ala.public_id is not null
+                    and ala.full_name is not null then ala.full_name
+                  when  aoa.public_id is not null
+                    and aoa.full_name is not null then aoa.full_name
+                  when  asa.public_id is not null
+                    and asa.full_name is not null then asa.full_name
+                  when  aca.public_id is not null
+                    and aca.full_name is not null then aca.full_name
+                  else 'None'
+                  end                           as auth_account_full_name,
+              case
+                  when apa.public_id is not null then 'Not Applicable'
+                  when  ala.public_id is not null
+                    and ala.email is not null then ala.email
+                  when  aoa.public_id is not null
+                    and aoa.email is not null then aoa.email
+                  when  asa.public_id is not null
+                    and asa.email is not null then asa.email
+                  when  aca.public_id is not null
+                    and aca.email is not null then aca.email
+                  else 'None'
+                  end                           as auth_account_email,
+              coalesce(am.public_id, 'None')    as auth_method_id,
+              case
+                   when apa.public_id is not null then 'password auth method'
+                   when ala.public_id is not null then 'ldap auth method'
+                   when aoa.public_id is not null then 'oidc auth method'
+                   when asa.public_id is not null then 'saml auth method'
+                   when aca.public_id is not null then 'cert auth method'
+                   else 'None'
+                   end                          as auth_method_type,
+              case
+                   when apm.public_id is not null then coalesce(apm.name, 'None')
+                   when alm.public_id is not null then coalesce(alm.name, 'None')
+                   when aom.public_id is not null then coalesce(aom.name, 'None')
+                   when asm.public_id is not null then coalesce(asm.name, 'None')
+                   when acm.public_id is not null then coalesce(acm.name, 'None')
+                   else 'None'
+                   end                          as auth_method_name,
+              case
+                   when apm.public_id is not null then coalesce(apm.description, 'None')
+                   when alm.public_id is not null then coalesce(alm.description, 'None')
+                   when aom.public_id is not null then coalesce(aom.description, 'None')
+                   when asm.public_id is not null then coalesce(asm.description, 'None')
+                   when acm.public_id is not null then coalesce(acm.description, 'None')
+                   else 'None'
+                   end                          as auth_method_description,
+              case
+                  when apa.public_id is not null then 'Not Applicable'
+                  when alm.public_id is not null then 'Not Applicable'
+                  when acm.public_id is not null then 'Not Applicable'
+                  when asm.public_id is not null then coalesce(asm.idp_entity_id, 'None')
+                  when aom.public_id is null then 'None'
+                  else aom.issuer
+                  end                           as auth_method_external_id,
+              org.public_id                     as user_organization_id,
+              coalesce(org.name, 'None')        as user_organization_name,
+              coalesce(org.description, 'None') as user_organization_description
+         from iam_user as u
+    left join auth_account as aa on           u.public_id       = aa.iam_user_id
+    left join auth_method as am on            aa.auth_method_id = am.public_id
+    left join auth_password_account as apa on aa.public_id      = apa.public_id
+    left join auth_password_method as apm on  am.public_id      = apm.public_id
+    left join auth_oidc_account as aoa on     aa.public_id      = aoa.public_id
+    left join auth_oidc_method as aom on      am.public_id      = aom.public_id
+    left join auth_ldap_account as ala on     aa.public_id      = ala.public_id
+    left join auth_ldap_method as alm on      am.public_id      = alm.public_id
+    left join auth_saml_account as asa on     aa.public_id      = asa.public_id
+    left join auth_saml_method as asm on      am.public_id      = asm.public_id
+    left join auth_cert_account as aca on     aa.public_id      = aca.public_id
+    left join auth_cert_method as acm on      am.public_id      = acm.public_id
+         join iam_scope as org on             u.scope_id        = org.public_id
+  ;
+
+commit;
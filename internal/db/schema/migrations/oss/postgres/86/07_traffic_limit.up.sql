@@ -0,0 +1,128 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- target_traffic_policy holds the bandwidth limits and byte quotas enforced
+  -- by workers on the connections to a target. A target without a row in this
+  -- table is not limited. A value of 0 in any column means unlimited.
+  create table target_traffic_policy (
+    target_id wt_public_id primary key
+      references target (public_id)
+      on delete cascade
+      on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    connection_bandwidth_limit bigint not null default 0
+      constraint connection_bandwidth_limit_must_not_be_negative
+        check(connection_bandwidth_limit >= 0),
+    session_bandwidth_limit bigint not null default 0
+      constraint session_bandwidth_limit_must_not_be_negative
+        check(session_bandwidth_limit >= 0),
+    connection_byte_quota bigint not null default 0
+      constraint connection_byte_quota_must_not_be_negative
+        check(connection_byte_quota >= 0),
+    session_byte_quota bigint not null default 0
+      constraint session_byte_quota_must_not_be_negative
+        check(session_byte_quota >= 0)
+  );
+  comment on table target_traffic_policy is
+    'target_traffic_policy holds the bandwidth limits in bytes per second and the byte quotas of the connections to a target.';
+
+  create trigger update_time_column before update on target_traffic_policy
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on target_traffic_policy
+    for each row execute procedure immutable_columns('target_id', 'create_time');
+
+  create trigger default_create_time_column before insert on target_traffic_policy
+    for each row execute procedure default_create_time();
+
+  -- session_traffic_limit holds the traffic policy of the target of a session
+  -- when the session was authorized. Sessions of targets without a traffic
+  -- policy have no row in this table.
+  create table session_traffic_limit (
+    session_id wt_public_id primary key
+      constraint session_fkey
+        references session (public_id)
+        on delete cascade
+        on update cascade,
+    connection_bandwidth_limit bigint not null default 0
+      constraint connection_bandwidth_limit_must_not_be_negative
+        check(connection_bandwidth_limit >= 0),
+    session_bandwidth_limit bigint not null default 0
+      constraint session_bandwidth_limit_must_not_be_negative
+        check(session_bandwidth_limit >= 0),
+    connection_byte_quota bigint not null default 0
+      constraint connection_byte_quota_must_not_be_negative
+        check(connection_byte_quota >= 0),
+    session_byte_quota bigint not null default 0
+      constraint session_byte_quota_must_not_be_negative
+        check(session_byte_quota >= 0)
+  );
+  comment on table session_traffic_limit is
+    'session_traffic_limit holds the bandwidth limits and byte quotas enforced by workers on the connections of a session.';
+
+  create trigger immutable_columns before update on session_traffic_limit
+    for each row execute procedure immutable_columns('session_id', 'connection_bandwidth_limit', 'session_bandwidth_limit', 'connection_byte_quota', 'session_byte_quota');
+
+  -- session_byte_quota_exceeded returns true if the connections of the session
+  -- have transferred at least the session byte quota of the session.
+  create function session_byte_quota_exceeded(session_id wt_public_id) returns boolean
+  as $$
+    select exists (
+      select
+        from session_traffic_limit stl
+       where stl.session_id = $1
+         and stl.session_byte_quota > 0
+         and (
+           select coalesce(sum(sc.bytes_up + sc.bytes_down), 0)
+             from session_connection sc
+            where sc.session_id = $1
+         ) >= stl.session_byte_quota
+    );
+  $$ language sql stable;
+  comment on function session_byte_quota_exceeded is
+    'session_byte_quota_exceeded returns true if the connections of a session have used up its session byte quota.';
+
+  alter table session_termination_reason_enm
+    drop constraint only_predefined_session_termination_reasons_allowed;
+  alter table session_termination_reason_enm
+    add constraint only_predefined_session_termination_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'terminated',
+          'network error',
+          'system error',
+          'connection limit',
+          'canceled',
+          'quota exceeded'
+        )
+      );
+  insert into session_termination_reason_enm (name)
+  values
+    ('quota exceeded');
+
+  alter table session_connection_closed_reason_enm
+    drop constraint only_predefined_session_connection_closed_reasons_allowed;
+  alter table session_connection_closed_reason_enm
+    add constraint only_predefined_session_connection_closed_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'canceled',
+          'network error',
+          'system error',
+          'quota exceeded'
+        )
+      );
+  insert into session_connection_closed_reason_enm (name)
+  values
+    ('quota exceeded');
+
+commit;
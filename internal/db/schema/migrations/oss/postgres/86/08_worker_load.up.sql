@@ -0,0 +1,35 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- server_worker_load holds the load last reported by a worker in its status
+  -- updates. Controllers use it to route sessions to the least loaded workers.
+  -- Workers that have not reported their load have no row in this table.
+  create table server_worker_load (
+    worker_id wt_public_id primary key
+      constraint server_worker_fkey
+        references server_worker (public_id)
+        on delete cascade
+        on update cascade,
+    update_time wt_timestamp,
+    proxied_connection_count bigint not null default 0
+      constraint proxied_connection_count_must_not_be_negative
+        check(proxied_connection_count >= 0),
+    cpu_utilization double precision not null default 0
+      constraint cpu_utilization_must_be_a_percentage
+        check(cpu_utilization >= 0 and cpu_utilization <= 100),
+    memory_bytes bigint not null default 0
+      constraint memory_bytes_must_not_be_negative
+        check(memory_bytes >= 0),
+    status_latency_ms bigint not null default 0
+      constraint status_latency_ms_must_not_be_negative
+        check(status_latency_ms >= 0)
+  );
+  comment on table server_worker_load is
+    'server_worker_load holds the load last reported by a worker in its status updates.';
+
+  create trigger update_time_column before update on server_worker_load
+    for each row execute procedure update_time_column();
+
+commit;
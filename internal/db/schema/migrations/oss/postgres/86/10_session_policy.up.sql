@@ -0,0 +1,40 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- target_session_policy holds the limits on the number of active sessions to
+  -- a target. A target without a row in this table is not limited. A value of 0
+  -- in any column means unlimited.
+  create table target_session_policy (
+    target_id wt_public_id primary key
+      references target (public_id)
+      on delete cascade
+      on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    max_active_sessions integer not null default 0
+      constraint max_active_sessions_must_not_be_negative
+        check(max_active_sessions >= 0),
+    max_active_sessions_per_user integer not null default 0
+      constraint max_active_sessions_per_user_must_not_be_negative
+        check(max_active_sessions_per_user >= 0)
+  );
+  comment on table target_session_policy is
+    'target_session_policy holds the maximum number of active sessions to a target, in total and per user.';
+
+  create trigger update_time_column before update on target_session_policy
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on target_session_policy
+    for each row execute procedure immutable_columns('target_id', 'create_time');
+
+  create trigger default_create_time_column before insert on target_session_policy
+    for each row execute procedure default_create_time();
+
+  -- The active sessions of a user and of a target are counted when a session
+  -- is created with active session limits.
+  create index session_active_user_id_ix on session (user_id) where termination_reason is null;
+  create index session_active_target_id_ix on session (target_id) where termination_reason is null;
+
+commit;
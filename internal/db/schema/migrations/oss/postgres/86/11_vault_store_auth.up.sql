@@ -0,0 +1,157 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table credential_vault_auth_method_enm (
+    name text primary key
+      constraint only_predefined_vault_auth_methods_allowed
+        check (name in ('approle', 'jwt', 'kubernetes'))
+  );
+  comment on table credential_vault_auth_method_enm is
+    'credential_vault_auth_method_enm is an enumeration table for the Vault auth methods a credential_vault_store can use to log in to Vault.';
+
+  insert into credential_vault_auth_method_enm(name)
+    values
+      ('approle'),
+      ('jwt'),
+      ('kubernetes');
+
+  -- credential_vault_store_auth holds the Vault auth method and the
+  -- credentials a credential_vault_store uses to log in to Vault and obtain
+  -- its own token. A credential_vault_store without a row in this table uses
+  -- the token it was created or updated with.
+  create table credential_vault_store_auth (
+    store_id wt_public_id primary key
+      constraint credential_vault_store_fkey
+        references credential_vault_store (public_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    method text not null
+      constraint credential_vault_auth_method_enm_fkey
+        references credential_vault_auth_method_enm (name)
+        on delete restrict
+        on update cascade,
+    mount_path text not null
+      constraint mount_path_must_not_be_empty
+        check(length(trim(mount_path)) > 0),
+    role text not null
+      constraint role_must_not_be_empty
+        check(length(trim(role)) > 0)
+  );
+  comment on table credential_vault_store_auth is
+    'credential_vault_store_auth is a table where each row contains the Vault auth method a credential_vault_store uses to log in to Vault. '
+    'A credential_vault_store can have 0 or 1 auth methods.';
+
+  create trigger update_time_column before update on credential_vault_store_auth
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on credential_vault_store_auth
+    for each row execute procedure immutable_columns('store_id', 'create_time');
+
+  create trigger default_create_time_column before insert on credential_vault_store_auth
+    for each row execute procedure default_create_time();
+
+  -- credential_vault_store_auth_secret holds the encrypted AppRole secret_id
+  -- or JWT of a credential_vault_store_auth. A Kubernetes auth method without
+  -- a row in this table uses the service account token of the controller.
+  create table credential_vault_store_auth_secret (
+    store_id wt_public_id primary key
+      constraint credential_vault_store_auth_fkey
+        references credential_vault_store_auth (store_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    secret bytea not null -- encrypted AppRole secret_id or JWT
+      constraint secret_must_not_be_empty
+        check(length(secret) > 0),
+    secret_hmac bytea not null
+      constraint secret_hmac_must_not_be_empty
+        check(length(secret_hmac) > 0),
+    key_id kms_private_id not null
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade
+  );
+  comment on table credential_vault_store_auth_secret is
+    'credential_vault_store_auth_secret is a table where each row contains the encrypted secret a credential_vault_store_auth uses to log in to Vault. '
+    'A credential_vault_store_auth can have 0 or 1 secrets.';
+
+  create trigger update_time_column before update on credential_vault_store_auth_secret
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on credential_vault_store_auth_secret
+    for each row execute procedure immutable_columns('store_id', 'create_time');
+
+  create trigger default_create_time_column before insert on credential_vault_store_auth_secret
+    for each row execute procedure default_create_time();
+
+  create function credential_vault_store_auth_requires_secret() returns trigger
+  as $$
+  begin
+    perform from credential_vault_store_auth auth
+     where auth.store_id = new.store_id
+       and auth.method != 'kubernetes'
+       and not exists (select from credential_vault_store_auth_secret secret
+                        where secret.store_id = auth.store_id);
+    if found then
+      raise exception 'credential_vault_store_auth % with method % requires a secret', new.store_id, new.method
+        using errcode = '23514'; -- check_violation
+    end if;
+    return null;
+  end;
+  $$ language plpgsql;
+  comment on function credential_vault_store_auth_requires_secret() is
+    'credential_vault_store_auth_requires_secret is a function used on credential_vault_store_auth after insert or update initially deferred '
+    'to ensure the approle and jwt auth methods have a row in credential_vault_store_auth_secret.';
+
+  create constraint trigger approle_and_jwt_require_secret
+    after insert or update on credential_vault_store_auth deferrable initially deferred
+    for each row execute procedure credential_vault_store_auth_requires_secret();
+
+  -- Replaces view from 49/01_vault_credentials.up.sql to add the auth method
+  -- columns.
+  create or replace view credential_vault_store_list_lookup as
+  select store.public_id                   as public_id,
+         store.project_id                  as project_id,
+         store.name                        as name,
+         store.description                 as description,
+         store.create_time                 as create_time,
+         store.update_time                 as update_time,
+         store.delete_time                 as delete_time,
+         store.version                     as version,
+         store.vault_address               as vault_address,
+         store.namespace                   as namespace,
+         store.ca_cert                     as ca_cert,
+         store.tls_server_name             as tls_server_name,
+         store.tls_skip_verify             as tls_skip_verify,
+         store.worker_filter               as worker_filter,
+         token.token_hmac                  as token_hmac,
+         coalesce(token.status, 'expired') as token_status,
+         cert.certificate                  as client_cert,
+         cert.certificate_key_hmac         as client_cert_key_hmac,
+         auth.method                       as auth_method,
+         auth.mount_path                   as auth_mount_path,
+         auth.role                         as auth_role,
+         secret.secret_hmac                as auth_secret_hmac
+    from credential_vault_store store
+    left join credential_vault_token token
+      on store.public_id = token.store_id
+     and token.status = 'current'
+    left join credential_vault_client_certificate cert
+      on store.public_id = cert.store_id
+    left join credential_vault_store_auth auth
+      on store.public_id = auth.store_id
+    left join credential_vault_store_auth_secret secret
+      on store.public_id = secret.store_id
+   where store.delete_time is null;
+  comment on view credential_vault_store_list_lookup is
+    'credential_vault_store_list_lookup is a view where each row contains a credential store. '
+    'If the Vault token has expired this view will return an empty token_hmac and a token_status of ''expired'' '
+    'No encrypted data is returned. This view can be used to retrieve data which will be returned external to boundary.';
+
+commit;
@@ -0,0 +1,52 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  alter table credential_static_store
+    add column rotation_period integer not null default 0
+      constraint rotation_period_must_not_be_negative
+        check(rotation_period >= 0),
+    add column rotation_grace_period integer not null default 0
+      constraint rotation_grace_period_must_not_be_negative
+        check(rotation_grace_period >= 0),
+    add column rotator text null
+      constraint rotator_must_not_be_empty
+        check(length(trim(rotator)) > 0),
+    add constraint rotation_period_requires_rotator
+      check(
+        rotation_period = 0
+        or
+        rotator is not null
+      );
+  comment on column credential_static_store.rotation_period is
+    'rotation_period is the number of seconds between automatic rotations of the credentials in the store. '
+    'A value of 0 disables automatic rotation.';
+  comment on column credential_static_store.rotation_grace_period is
+    'rotation_grace_period is the number of seconds the previous version of a rotated credential remains valid on the target host.';
+  comment on column credential_static_store.rotator is
+    'rotator is the name of the credential rotator, configured on the controller, used to push rotated credentials to target hosts.';
+
+  create table credential_static_rotation (
+    credential_id wt_public_id primary key
+      constraint credential_static_fkey
+        references credential_static (public_id)
+        on delete cascade
+        on update cascade,
+    rotate_time wt_timestamp,
+    grace_period_end timestamp with time zone null,
+    previous_public_key text null
+      constraint previous_public_key_must_not_be_empty
+        check(length(trim(previous_public_key)) > 0)
+  );
+  comment on table credential_static_rotation is
+    'credential_static_rotation is a table where each row contains the rotation state of a static credential. '
+    'A row is inserted the first time a credential is rotated.';
+  comment on column credential_static_rotation.grace_period_end is
+    'grace_period_end is the time the previous version of the credential is retired on the target host. '
+    'It is null when there is no previous version waiting to be retired.';
+  comment on column credential_static_rotation.previous_public_key is
+    'previous_public_key is the authorized key of the previous version of an ssh private key credential. '
+    'It is null for other credential types or when there is no previous version waiting to be retired.';
+
+commit;
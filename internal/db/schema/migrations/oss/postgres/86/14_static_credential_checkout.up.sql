@@ -0,0 +1,82 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  alter table credential_static_store
+    add column exclusive_checkout boolean not null default false,
+    add column rotate_on_checkin boolean not null default false,
+    add constraint rotate_on_checkin_requires_rotator
+      check(
+        rotate_on_checkin = false
+        or
+        rotator is not null
+      );
+  comment on column credential_static_store.exclusive_checkout is
+    'exclusive_checkout indicates a credential in the store can only be used by one active session at a time.';
+  comment on column credential_static_store.rotate_on_checkin is
+    'rotate_on_checkin indicates a checked out credential in the store is rotated when the session using it is terminated.';
+
+  alter table credential_static_rotation
+    add column rotation_requested boolean not null default false;
+  comment on column credential_static_rotation.rotation_requested is
+    'rotation_requested indicates the credential must be rotated regardless of the rotation period of its store. '
+    'It is reset when the credential is rotated.';
+
+  create table credential_static_checkout (
+    credential_id wt_public_id primary key
+      constraint credential_static_fkey
+        references credential_static (public_id)
+        on delete cascade
+        on update cascade,
+    session_id wt_public_id not null
+      constraint session_fkey
+        references session (public_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp
+  );
+  comment on table credential_static_checkout is
+    'credential_static_checkout is a table where each row contains a static credential checked out by an active session. '
+    'A credential can only be checked out by one session at a time.';
+
+  create index credential_static_checkout_session_id_ix
+    on credential_static_checkout (session_id);
+
+  create trigger default_create_time_column before insert on credential_static_checkout
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_static_checkout
+    for each row execute procedure immutable_columns('credential_id', 'session_id', 'create_time');
+
+  -- checkin_static_credentials checks in all static credentials checked out
+  -- by a session when the session enters the terminated state. Credentials
+  -- in stores with rotate_on_checkin set are marked for rotation.
+  create function checkin_static_credentials() returns trigger
+  as $$
+  begin
+    if new.state = 'terminated' then
+      insert into credential_static_rotation
+        (credential_id, rotation_requested)
+      select checkout.credential_id, true
+        from credential_static_checkout as checkout
+        join credential_static as cred
+          on cred.public_id = checkout.credential_id
+        join credential_static_store as store
+          on store.public_id = cred.store_id
+       where checkout.session_id = new.session_id
+         and store.rotate_on_checkin
+      on conflict (credential_id) do update
+        set rotation_requested = true;
+
+      delete from credential_static_checkout
+        where session_id = new.session_id;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger checkin_static_credentials after insert on session_state
+    for each row execute procedure checkin_static_credentials();
+
+commit;
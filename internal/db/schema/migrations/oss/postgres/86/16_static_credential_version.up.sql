@@ -0,0 +1,72 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table credential_static_version (
+    credential_id wt_public_id not null
+      constraint credential_static_fkey
+        references credential_static (public_id)
+        on delete cascade
+        on update cascade,
+    version wt_version,
+    author_id text
+      constraint iam_user_fkey
+        references iam_user (public_id)
+        on delete set null
+        on update cascade,
+    data bytea not null -- encrypted value
+      constraint data_must_not_be_empty
+        check(length(data) > 0),
+    key_id kms_private_id not null
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    create_time wt_timestamp,
+    primary key(credential_id, version)
+  );
+  comment on table credential_static_version is
+    'credential_static_version is a table where each row contains the encrypted attributes of a static credential '
+    'as they were after the credential was created or updated. '
+    'The version column matches the version of the static credential at that time.';
+  comment on column credential_static_version.author_id is
+    'author_id is the user who created the version. '
+    'It is null if the version was created by the system, for example by a credential rotation.';
+
+  create trigger default_create_time_column before insert on credential_static_version
+    for each row execute procedure default_create_time();
+
+  -- data and key_id can be changed by a key rewrap.
+  create trigger immutable_columns before update on credential_static_version
+    for each row execute procedure immutable_columns('credential_id', 'version', 'create_time');
+
+  alter table session_credential_static
+    add column credential_version bigint;
+  comment on column session_credential_static.credential_version is
+    'credential_version is the version of the static credential given to the session. '
+    'It is null if the credential has no version history, for example a credential which has not been updated '
+    'since it was created before version history was recorded.';
+
+  drop trigger immutable_columns on session_credential_static;
+  create trigger immutable_columns before update on session_credential_static
+    for each row execute procedure immutable_columns('session_id', 'credential_static_id', 'credential_purpose', 'credential_version', 'create_time');
+
+  -- set_session_credential_static_version sets the credential_version of a
+  -- new session_credential_static row to the latest version of the static
+  -- credential.
+  create function set_session_credential_static_version() returns trigger
+  as $$
+  begin
+    select max(version)
+      into new.credential_version
+      from credential_static_version
+     where credential_id = new.credential_static_id;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger set_session_credential_static_version before insert on session_credential_static
+    for each row execute procedure set_session_credential_static_version();
+
+commit;
@@ -0,0 +1,294 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table credential_file_store (
+    public_id wt_public_id primary key,
+    project_id wt_scope_id not null
+      constraint iam_scope_project_fkey
+        references iam_scope_project (scope_id)
+        on delete cascade
+        on update cascade,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    path text not null
+      constraint path_must_be_absolute
+        check(path like '/%'),
+    age_identity_path text
+      constraint age_identity_path_must_be_absolute
+        check(age_identity_path like '/%'),
+    constraint credential_store_fkey
+      foreign key (project_id, public_id)
+      references credential_store (project_id, public_id)
+      on delete cascade
+      on update cascade,
+    constraint credential_file_store_project_id_name_uq
+      unique(project_id, name)
+  );
+  comment on table credential_file_store is
+    'credential_file_store is a table where each row is a resource that represents a file credential store. '
+    'The credentials are read from an encrypted bundle file mounted on the controllers. '
+    'It is a credential_store subtype and an aggregate root.';
+
+  create trigger update_version_column after update on credential_file_store
+    for each row execute procedure update_version_column();
+  create trigger update_time_column before update on credential_file_store
+    for each row execute procedure update_time_column();
+  create trigger default_create_time_column before insert on credential_file_store
+    for each row execute procedure default_create_time();
+  create trigger immutable_columns before update on credential_file_store
+    for each row execute procedure immutable_columns('public_id', 'project_id', 'create_time');
+  create trigger insert_credential_store_subtype before insert on credential_file_store
+    for each row execute procedure insert_credential_store_subtype();
+  create trigger delete_credential_store_subtype after delete on credential_file_store
+    for each row execute procedure delete_credential_store_subtype();
+  create trigger update_credential_store_table_update_time before update on credential_file_store
+    for each row execute procedure update_credential_store_table_update_time();
+
+  create table credential_file_library (
+    public_id wt_public_id primary key,
+    store_id wt_public_id not null
+      constraint credential_file_store_fkey
+        references credential_file_store (public_id)
+        on delete cascade
+        on update cascade,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    path text not null
+      constraint path_must_not_be_empty
+        check(length(trim(path)) > 0),
+    credential_type text not null default 'unspecified'
+      constraint credential_type_enm_fkey
+        references credential_type_enm (name)
+        on delete restrict
+        on update cascade,
+    project_id wt_public_id not null,
+    username_attribute text
+      constraint username_attribute_must_not_be_empty
+        check(length(trim(username_attribute)) > 0),
+    password_attribute text
+      constraint password_attribute_must_not_be_empty
+        check(length(trim(password_attribute)) > 0),
+    private_key_attribute text
+      constraint private_key_attribute_must_not_be_empty
+        check(length(trim(private_key_attribute)) > 0),
+    private_key_passphrase_attribute text
+      constraint private_key_passphrase_attribute_must_not_be_empty
+        check(length(trim(private_key_passphrase_attribute)) > 0),
+    certificate_attribute text
+      constraint certificate_attribute_must_not_be_empty
+        check(length(trim(certificate_attribute)) > 0),
+    ca_certificate_attribute text
+      constraint ca_certificate_attribute_must_not_be_empty
+        check(length(trim(ca_certificate_attribute)) > 0),
+    kubeconfig_attribute text
+      constraint kubeconfig_attribute_must_not_be_empty
+        check(length(trim(kubeconfig_attribute)) > 0),
+    constraint credential_file_library_store_id_name_uq
+      unique(store_id, name),
+    constraint credential_file_library_store_id_public_id_uq
+      unique(store_id, public_id),
+    constraint credential_library_fkey
+      foreign key (project_id, store_id, public_id, credential_type)
+      references credential_library (project_id, store_id, public_id, credential_type)
+      on delete cascade
+      on update cascade
+  );
+  comment on table credential_file_library is
+    'credential_file_library is a table where each row is a resource that represents a file credential library. '
+    'A library selects the entry at path in the bundle of its file credential store. '
+    'The *_attribute columns override the default names of the attributes read from the entry. '
+    'It is a credential_library subtype.';
+
+  create trigger insert_credential_library_subtype before insert on credential_file_library
+    for each row execute procedure insert_credential_library_subtype();
+  create trigger default_create_time_column before insert on credential_file_library
+    for each row execute procedure default_create_time();
+  create trigger delete_credential_library_subtype after delete on credential_file_library
+    for each row execute procedure delete_credential_library_subtype();
+  create trigger immutable_columns before update on credential_file_library
+    for each row execute procedure immutable_columns('public_id', 'store_id', 'project_id', 'credential_type', 'create_time');
+  create trigger update_time_column before update on credential_file_library
+    for each row execute procedure update_time_column();
+  create trigger update_version_column after update on credential_file_library
+    for each row execute procedure update_version_column();
+  create trigger update_credential_library_table_update_time before update on credential_file_library
+    for each row execute procedure update_credential_library_table_update_time();
+
+  create table credential_file_store_deleted (
+    public_id wt_public_id primary key,
+    delete_time wt_timestamp not null
+  );
+  comment on table credential_file_store_deleted is
+    'credential_file_store_deleted holds the ID and delete_time of every deleted file credential store. '
+    'It is automatically trimmed of records older than 30 days by a job.';
+
+  create trigger insert_deleted_id after delete on credential_file_store
+    for each row execute function insert_deleted_id('credential_file_store_deleted');
+
+  create index credential_file_store_deleted_delete_time_idx on credential_file_store_deleted (delete_time);
+
+  create table credential_file_library_deleted (
+    public_id wt_public_id primary key,
+    delete_time wt_timestamp not null
+  );
+  comment on table credential_file_library_deleted is
+    'credential_file_library_deleted holds the ID and delete_time of every deleted file credential library. '
+    'It is automatically trimmed of records older than 30 days by a job.';
+
+  create trigger insert_deleted_id after delete on credential_file_library
+    for each row execute function insert_deleted_id('credential_file_library_deleted');
+
+  create index credential_file_library_deleted_delete_time_idx on credential_file_library_deleted (delete_time);
+
+  insert into oplog_ticket (name, version)
+  values
+    ('credential_file_store', 1),
+    ('credential_file_library', 1);
+
+  -- The whx_credential_dimension_source view shows the current values in the
+  -- operational tables of the credential dimension.
+  -- Replaces whx_credential_dimension_source defined in oss/71/07_targets.up.sql
+  drop view whx_credential_dimension_source;
+  create view whx_credential_dimension_source as
+    with vault_generic_library as (
+      select vcl.public_id                                        as public_id,
+             'vault generic credential library'                   as type,
+             coalesce(vcl.name,        'None')                    as name,
+             coalesce(vcl.description, 'None')                    as description,
+             vcl.vault_path                                       as vault_path,
+             vcl.http_method                                      as http_method,
+             case
+               when vcl.http_method = 'GET' then 'Not Applicable'
+               else coalesce(vcl.http_request_body::text, 'None')
+             end                                                  as http_request_body,
+             'Not Applicable'                                     as username,
+             'Not Applicable'                                     as key_type_and_bits
+        from credential_vault_library as vcl
+    ),
+    vault_ssh_cert_library as (
+      select vsccl.public_id                                      as public_id,
+             'vault ssh certificate credential library'           as type,
+             coalesce(vsccl.name,        'None')                  as name,
+             coalesce(vsccl.description, 'None')                  as description,
+             vsccl.vault_path                                     as vault_path,
+             'Not Applicable'                                     as http_method,
+             'Not Applicable'                                     as http_request_body,
+             vsccl.username                                       as username,
+             case
+               when vsccl.key_type = 'ed25519' then vsccl.key_type
+               else vsccl.key_type || '-' || vsccl.key_bits::text
+             end                                                  as key_type_and_bits
+        from credential_vault_ssh_cert_library as vsccl
+    ),
+    file_library as (
+      select fcl.public_id                                        as public_id,
+             'file credential library'                            as type,
+             coalesce(fcl.name,        'None')                    as name,
+             coalesce(fcl.description, 'None')                    as description,
+             'Not Applicable'                                     as vault_path,
+             'Not Applicable'                                     as http_method,
+             'Not Applicable'                                     as http_request_body,
+             'Not Applicable'                                     as username,
+             'Not Applicable'                                     as key_type_and_bits
+        from credential_file_library as fcl
+    ),
+    final as (
+          select s.public_id                                                         as session_id,
+                 scd.credential_purpose                                              as credential_purpose,
+                 cl.public_id                                                        as credential_library_id,
+                 coalesce(vcl.type,              vsccl.type,              fcl.type)              as credential_library_type,
+                 coalesce(vcl.name,              vsccl.name,              fcl.name)              as credential_library_name,
+                 coalesce(vcl.description,       vsccl.description,       fcl.description)       as credential_library_description,
+                 coalesce(vcl.vault_path,        vsccl.vault_path,        fcl.vault_path)        as credential_library_vault_path,
+                 coalesce(vcl.http_method,       vsccl.http_method,       fcl.http_method)       as credential_library_vault_http_method,
+                 coalesce(vcl.http_request_body, vsccl.http_request_body, fcl.http_request_body) as credential_library_vault_http_request_body,
+                 coalesce(vcl.username,          vsccl.username,          fcl.username)          as credential_library_username,
+                 coalesce(vcl.key_type_and_bits, vsccl.key_type_and_bits, fcl.key_type_and_bits) as credential_library_key_type_and_bits,
+                 cs.public_id                                                        as credential_store_id,
+                 case
+                   when vcs is not null then 'vault credential store'
+                   when fcs is not null then 'file credential store'
+                   else 'None'
+                 end                                                                 as credential_store_type,
+                 coalesce(vcs.name,              fcs.name,                'None')    as credential_store_name,
+                 coalesce(vcs.description,       fcs.description,         'None')    as credential_store_description,
+                 case
+                   when fcs is not null then 'Not Applicable'
+                   else coalesce(vcs.namespace, 'None')
+                 end                                                                 as credential_store_vault_namespace,
+                 case
+                   when fcs is not null then 'Not Applicable'
+                   else coalesce(vcs.vault_address, 'None')
+                 end                                                                 as credential_store_vault_address,
+                 t.public_id                                                         as target_id,
+                 case
+                   when tt.type = 'tcp' then 'tcp target'
+                   when tt.type = 'ssh' then 'ssh target'
+                   else 'Unknown'
+                 end                                                                 as target_type,
+                 coalesce(tt.name,               'None')                             as target_name,
+                 coalesce(tt.description,        'None')                             as target_description,
+                 coalesce(tt.default_port,       0)                                  as target_default_port_number,
+                 tt.session_max_seconds                                              as target_session_max_seconds,
+                 tt.session_connection_limit                                         as target_session_connection_limit,
+                 p.public_id                                                         as project_id,
+                 coalesce(p.name,                'None')                             as project_name,
+                 coalesce(p.description,         'None')                             as project_description,
+                 o.public_id                                                         as organization_id,
+                 coalesce(o.name,                'None')                             as organization_name,
+                 coalesce(o.description,         'None')                             as organization_description
+            from session_credential_dynamic as scd
+            join session                as s     on scd.session_id = s.public_id
+            join credential_library     as cl    on scd.library_id = cl.public_id
+            join credential_store       as cs    on cl.store_id    = cs.public_id
+            join target                 as t     on s.target_id    = t.public_id
+            join iam_scope              as p     on p.public_id    = t.project_id and p.type = 'project'
+            join iam_scope              as o     on p.parent_id    = o.public_id  and o.type = 'org'
+       left join vault_generic_library  as vcl   on cl.public_id   = vcl.public_id
+       left join vault_ssh_cert_library as vsccl on cl.public_id   = vsccl.public_id
+       left join file_library           as fcl   on cl.public_id   = fcl.public_id
+       left join credential_vault_store as vcs   on cs.public_id   = vcs.public_id
+       left join credential_file_store  as fcs   on cs.public_id   = fcs.public_id
+       left join target_all_subtypes    as tt    on t.public_id    = tt.public_id
+    )
+    select session_id,
+           credential_purpose,
+           credential_library_id,
+           credential_library_type,
+           credential_library_name,
+           credential_library_description,
+           credential_library_vault_path,
+           credential_library_vault_http_method,
+           credential_library_vault_http_request_body,
+           credential_library_username,
+           credential_library_key_type_and_bits,
+           credential_store_id,
+           credential_store_type,
+           credential_store_name,
+           credential_store_description,
+           credential_store_vault_namespace,
+           credential_store_vault_address,
+           target_id,
+           target_type,
+           target_name,
+           target_description,
+           target_default_port_number,
+           target_session_max_seconds,
+           target_session_connection_limit,
+           project_id,
+           project_name,
+           project_description,
+           organization_id,
+           organization_name,
+           organization_description
+      from final;
+
+commit;
@@ -0,0 +1,273 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  alter table credential_vault_library
+    add column lease_ttl_from_session boolean not null default false;
+  comment on column credential_vault_library.lease_ttl_from_session is
+    'lease_ttl_from_session indicates the library requests a lease ttl equal to the remaining time of the session. '
+    'Only applies to secrets with a renewable lease.';
+
+  alter table credential_vault_library_hst
+    add column lease_ttl_from_session boolean null;
+
+  -- Replaces view from 86/15_tls_client_certificate_kubeconfig_credentials.up.sql
+  drop view credential_vault_library_issue_credentials;
+  create view credential_vault_library_issue_credentials as
+  with
+    password_override (library_id, username_attribute, password_attribute) as (
+      select library_id,
+        nullif(username_attribute, wt_to_sentinel('no override')),
+        nullif(password_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_username_password_mapping_override
+    ),
+    ssh_private_key_override (library_id, username_attribute, private_key_attribute, private_key_passphrase_attribute) as (
+      select library_id,
+        nullif(username_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_passphrase_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_ssh_private_key_mapping_override
+    ),
+    tls_client_certificate_override (library_id, certificate_attribute, private_key_attribute, ca_certificate_attribute) as (
+      select library_id,
+        nullif(certificate_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_attribute, wt_to_sentinel('no override')),
+        nullif(ca_certificate_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_tls_client_cert_mapping_override
+    ),
+    kubeconfig_override (library_id, kubeconfig_attribute) as (
+      select library_id,
+        nullif(kubeconfig_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_kubeconfig_mapping_override
+    )
+  select library.public_id    as public_id,
+    library.store_id          as store_id,
+    library.name              as name,
+    library.description       as description,
+    library.create_time       as create_time,
+    library.update_time       as update_time,
+    library.version           as version,
+    library.vault_path        as vault_path,
+    library.http_method       as http_method,
+    library.http_request_body as http_request_body,
+    library.credential_type   as credential_type,
+    null                      as key_type,
+    null                      as key_bits,
+    null                      as username,
+    null                      as ttl,
+    null                      as key_id,
+    null                      as critical_options,
+    null                      as extensions,
+    store.project_id          as project_id,
+    store.vault_address       as vault_address,
+    store.namespace           as namespace,
+    store.ca_cert             as ca_cert,
+    store.tls_server_name     as tls_server_name,
+    store.tls_skip_verify     as tls_skip_verify,
+    store.worker_filter       as worker_filter,
+    store.ct_token            as ct_token, -- encrypted
+    store.token_hmac          as token_hmac,
+    store.token_status        as token_status,
+    store.token_key_id        as token_key_id,
+    store.client_cert         as client_cert,
+    store.ct_client_key       as ct_client_key, -- encrypted
+    store.client_key_id       as client_key_id,
+    coalesce(upasso.username_attribute,sshpk.username_attribute)
+      as username_attribute,
+    upasso.password_attribute              as password_attribute,
+    coalesce(sshpk.private_key_attribute,tlscc.private_key_attribute)
+      as private_key_attribute,
+    sshpk.private_key_passphrase_attribute as private_key_passphrase_attribute,
+    tlscc.certificate_attribute            as certificate_attribute,
+    tlscc.ca_certificate_attribute         as ca_certificate_attribute,
+    kc.kubeconfig_attribute                as kubeconfig_attribute,
+    'generic'                              as cred_lib_type, -- used to switch on
+    null                                   as additional_valid_principals,
+    library.kv_v2                          as kv_v2,
+    library.kv_v2_version                  as kv_v2_version,
+    library.lease_ttl_from_session         as lease_ttl_from_session
+    from credential_vault_library library
+    join credential_vault_store_client store
+      on library.store_id = store.public_id
+    left join password_override upasso
+      on library.public_id = upasso.library_id
+    left join ssh_private_key_override sshpk
+      on library.public_id = sshpk.library_id
+    left join tls_client_certificate_override tlscc
+      on library.public_id = tlscc.library_id
+    left join kubeconfig_override kc
+      on library.public_id = kc.library_id
+  union
+  select library.public_id      as public_id,
+    library.store_id            as store_id,
+    library.name                as name,
+    library.description         as description,
+    library.create_time         as create_time,
+    library.update_time         as update_time,
+    library.version             as version,
+    library.vault_path          as vault_path,
+    null                        as http_method,
+    null                        as http_request_body,
+    library.credential_type     as credential_type,
+    library.key_type            as key_type,
+    library.key_bits            as key_bits,
+    library.username            as username,
+    library.ttl                 as ttl,
+    library.key_id              as key_id,
+    library.critical_options    as critical_options,
+    library.extensions          as extensions,
+    store.project_id            as project_id,
+    store.vault_address         as vault_address,
+    store.namespace             as namespace,
+    store.ca_cert               as ca_cert,
+    store.tls_server_name       as tls_server_name,
+    store.tls_skip_verify       as tls_skip_verify,
+    store.worker_filter         as worker_filter,
+    store.ct_token              as ct_token, -- encrypted
+    store.token_hmac            as token_hmac,
+    store.token_status          as token_status,
+    store.token_key_id          as token_key_id,
+    store.client_cert           as client_cert,
+    store.ct_client_key         as ct_client_key, -- encrypted
+    store.client_key_id         as client_key_id,
+    null                        as username_attribute,
+    null                        as password_attribute,
+    null                        as private_key_attribute,
+    null                        as private_key_passphrase_attribute,
+    null                        as certificate_attribute,
+    null                        as ca_certificate_attribute,
+    null                        as kubeconfig_attribute,
+    'ssh-signed-cert'           as cred_lib_type, -- used to switch on
+    additional_valid_principals as additional_valid_principals,
+    false                       as kv_v2,
+    0                           as kv_v2_version,
+    false                       as lease_ttl_from_session
+    from credential_vault_ssh_cert_library library
+    join credential_vault_store_client store
+      on library.store_id = store.public_id;
+  comment on view credential_vault_library_issue_credentials is
+    'credential_vault_library_issue_credentials is a view where each row contains a credential library and the credential library''s data needed to connect to Vault. '
+    'This view should only be used when issuing credentials from a Vault credential library. Each row may contain encrypted data. '
+    'This view should not be used to retrieve data which will be returned external to boundary.';
+
+  -- Replaces view from 86/15_tls_client_certificate_kubeconfig_credentials.up.sql
+  drop view credential_vault_library_list_lookup;
+  create view credential_vault_library_list_lookup as
+  with
+    password_override (library_id, username_attribute, password_attribute) as (
+      select library_id,
+        nullif(username_attribute, wt_to_sentinel('no override')),
+        nullif(password_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_username_password_mapping_override
+    ),
+    ssh_private_key_override (library_id, username_attribute, private_key_attribute, private_key_passphrase_attribute) as (
+      select library_id,
+        nullif(username_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_passphrase_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_ssh_private_key_mapping_override
+    ),
+    tls_client_certificate_override (library_id, certificate_attribute, private_key_attribute, ca_certificate_attribute) as (
+      select library_id,
+        nullif(certificate_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_attribute, wt_to_sentinel('no override')),
+        nullif(ca_certificate_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_tls_client_cert_mapping_override
+    ),
+    kubeconfig_override (library_id, kubeconfig_attribute) as (
+      select library_id,
+        nullif(kubeconfig_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_kubeconfig_mapping_override
+    )
+  select library.public_id         as public_id,
+         library.store_id          as store_id,
+         library.name              as name,
+         library.description       as description,
+         library.create_time       as create_time,
+         library.update_time       as update_time,
+         library.version           as version,
+         library.vault_path        as vault_path,
+         library.http_method       as http_method,
+         library.http_request_body as http_request_body,
+         library.credential_type   as credential_type,
+         coalesce(upasso.username_attribute,sshpk.username_attribute)
+                                   as username_attribute,
+         upasso.password_attribute              as password_attribute,
+         coalesce(sshpk.private_key_attribute,tlscc.private_key_attribute)
+                                                as private_key_attribute,
+         sshpk.private_key_passphrase_attribute as private_key_passphrase_attribute,
+         tlscc.certificate_attribute            as certificate_attribute,
+         tlscc.ca_certificate_attribute         as ca_certificate_attribute,
+         kc.kubeconfig_attribute                as kubeconfig_attribute,
+         library.kv_v2                          as kv_v2,
+         library.kv_v2_version                  as kv_v2_version,
+         library.lease_ttl_from_session         as lease_ttl_from_session
+    from credential_vault_library library
+    left join password_override upasso
+      on library.public_id = upasso.library_id
+    left join ssh_private_key_override sshpk
+      on library.public_id = sshpk.library_id
+    left join tls_client_certificate_override tlscc
+      on library.public_id = tlscc.library_id
+    left join kubeconfig_override kc
+      on library.public_id = kc.library_id;
+  comment on view credential_vault_library_list_lookup is
+    'credential_vault_library_list_lookup is a view where each row contains a credential library and any of library''s credential mapping overrides. '
+    'No encrypted data is returned. This view can be used to retrieve data which will be returned external to boundary.';
+
+  -- Replaces view from 56/02_add_data_key_foreign_key_references.up.sql
+  -- Adds lease_ttl_from_session and session_expiration_time so the renewal
+  -- of a lease never extends it past the end of its session.
+  drop view credential_vault_credential_private;
+  create view credential_vault_credential_private as
+     select credential.public_id         as public_id,
+            credential.library_id        as library_id,
+            credential.session_id        as session_id,
+            credential.create_time       as create_time,
+            credential.update_time       as update_time,
+            credential.version           as version,
+            credential.external_id       as external_id,
+            credential.last_renewal_time as last_renewal_time,
+            credential.expiration_time   as expiration_time,
+            credential.is_renewable      as is_renewable,
+            credential.status            as status,
+            credential.last_renewal_time + (credential.expiration_time - credential.last_renewal_time) / 2 as renewal_time,
+            token.token_hmac             as token_hmac,
+            token.token                  as ct_token, -- encrypted
+            token.create_time            as token_create_time,
+            token.update_time            as token_update_time,
+            token.last_renewal_time      as token_last_renewal_time,
+            token.expiration_time        as token_expiration_time,
+            token.key_id                 as token_key_id,
+            token.status                 as token_status,
+            store.project_id             as project_id,
+            store.vault_address          as vault_address,
+            store.namespace              as namespace,
+            store.ca_cert                as ca_cert,
+            store.tls_server_name        as tls_server_name,
+            store.tls_skip_verify        as tls_skip_verify,
+            cert.certificate             as client_cert,
+            cert.certificate_key         as ct_client_key, -- encrypted
+            cert.certificate_key_hmac    as client_cert_key_hmac,
+            cert.key_id                  as client_key_id,
+            coalesce(library.lease_ttl_from_session, false) as lease_ttl_from_session,
+            session.expiration_time      as session_expiration_time
+       from credential_vault_credential credential
+       join credential_vault_token token
+         on credential.token_hmac = token.token_hmac
+       join credential_vault_store store
+         on token.store_id = store.public_id
+  left join credential_vault_client_certificate cert
+         on store.public_id = cert.store_id
+  left join credential_vault_library library
+         on credential.library_id = library.public_id
+  left join session
+         on credential.session_id = session.public_id
+      where credential.expiration_time != 'infinity'::date;
+  comment on view credential_vault_credential_private is
+    'credential_vault_credential_private is a view where each row contains a credential, '
+    'the vault token used to issue the credential, and the credential store data needed to connect to Vault. '
+    'Each row may contain encrypted data. This view should not be used to retrieve data which will be returned external to boundary.';
+
+commit;
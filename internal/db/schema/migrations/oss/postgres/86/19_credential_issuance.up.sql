@@ -0,0 +1,149 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table credential_issuance (
+    public_id wt_url_safe_id default wt_url_safe_id() primary key,
+    session_id wt_public_id not null,
+    user_id text,
+    target_id text,
+    project_id wt_public_id not null
+      constraint iam_scope_project_fkey
+        references iam_scope_project (scope_id)
+        on delete cascade
+        on update cascade,
+    credential_library_id wt_public_id
+      constraint credential_library_fkey
+        references credential_library (public_id)
+        on delete cascade
+        on update cascade,
+    credential_dynamic_id wt_public_id,
+    credential_static_id wt_public_id
+      constraint credential_static_fkey
+        references credential_static (public_id)
+        on delete cascade
+        on update cascade,
+    credential_version bigint,
+    credential_purpose text not null
+      constraint credential_purpose_fkey
+        references credential_purpose_enm (name)
+        on delete restrict
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    constraint credential_library_id_or_credential_static_id_must_be_set
+      check(num_nonnulls(credential_library_id, credential_static_id) = 1),
+    constraint credential_issuance_session_library_purpose_uq
+      unique(session_id, credential_library_id, credential_purpose),
+    constraint credential_issuance_session_static_purpose_uq
+      unique(session_id, credential_static_id, credential_purpose)
+  );
+  comment on table credential_issuance is
+    'credential_issuance is a table where each row records a credential issued to a session. '
+    'Rows are inserted by triggers on the session_credential_dynamic and session_credential_static tables. '
+    'Unlike those tables, a row is not deleted when its session is deleted, '
+    'it is only deleted with the credential library, static credential or project it belongs to.';
+  comment on column credential_issuance.user_id is
+    'user_id is the user of the session when the credential was issued. '
+    'It is not a foreign key so the issuance is kept when the user is deleted.';
+  comment on column credential_issuance.credential_dynamic_id is
+    'credential_dynamic_id is the dynamic credential issued from the credential library. '
+    'It is null for credential libraries which do not store the credentials they issue.';
+  comment on column credential_issuance.credential_version is
+    'credential_version is the version of the static credential issued to the session.';
+  comment on column credential_issuance.update_time is
+    'update_time is the time the row was inserted or the credential_dynamic_id was set. '
+    'It is used to refresh lists of issuances.';
+
+  create index credential_issuance_credential_library_id_create_time_public_id_ix
+    on credential_issuance (credential_library_id, create_time desc, public_id desc);
+  create index credential_issuance_credential_static_id_create_time_public_id_ix
+    on credential_issuance (credential_static_id, create_time desc, public_id desc);
+  create index credential_issuance_credential_library_id_update_time_public_id_ix
+    on credential_issuance (credential_library_id, update_time desc, public_id desc);
+  create index credential_issuance_credential_static_id_update_time_public_id_ix
+    on credential_issuance (credential_static_id, update_time desc, public_id desc);
+
+  -- Record the credentials already issued to existing sessions. This must run
+  -- before the default_create_time_column trigger is added to keep the
+  -- original create times.
+  insert into credential_issuance
+    (session_id, user_id, target_id, project_id, credential_library_id, credential_dynamic_id, credential_purpose, create_time, update_time)
+  select s.public_id, s.user_id, s.target_id, s.project_id, scd.library_id, scd.credential_id, scd.credential_purpose, scd.create_time, scd.create_time
+    from session_credential_dynamic as scd
+    join session as s
+      on s.public_id = scd.session_id;
+
+  insert into credential_issuance
+    (session_id, user_id, target_id, project_id, credential_static_id, credential_version, credential_purpose, create_time, update_time)
+  select s.public_id, s.user_id, s.target_id, s.project_id, scs.credential_static_id, scs.credential_version, scs.credential_purpose, scs.create_time, scs.create_time
+    from session_credential_static as scs
+    join session as s
+      on s.public_id = scs.session_id;
+
+  create trigger default_create_time_column before insert on credential_issuance
+    for each row execute procedure default_create_time();
+
+  -- credential_dynamic_id is set after the row is inserted, when the dynamic
+  -- credential is issued.
+  create trigger immutable_columns before update on credential_issuance
+    for each row execute procedure immutable_columns('public_id', 'session_id', 'user_id', 'target_id', 'project_id',
+      'credential_library_id', 'credential_static_id', 'credential_version', 'credential_purpose', 'create_time');
+
+  -- insert_dynamic_credential_issuance inserts a credential_issuance row for
+  -- a new session_credential_dynamic row.
+  create function insert_dynamic_credential_issuance() returns trigger
+  as $$
+  begin
+    insert into credential_issuance
+      (session_id, user_id, target_id, project_id, credential_library_id, credential_dynamic_id, credential_purpose)
+    select s.public_id, s.user_id, s.target_id, s.project_id, new.library_id, new.credential_id, new.credential_purpose
+      from session as s
+     where s.public_id = new.session_id;
+    return null;
+  end;
+  $$ language plpgsql;
+
+  create trigger insert_credential_issuance after insert on session_credential_dynamic
+    for each row execute procedure insert_dynamic_credential_issuance();
+
+  -- update_dynamic_credential_issuance sets the credential_dynamic_id and the
+  -- update_time of the credential_issuance row for a session_credential_dynamic
+  -- row when the dynamic credential is issued.
+  create function update_dynamic_credential_issuance() returns trigger
+  as $$
+  begin
+    update credential_issuance
+       set credential_dynamic_id = new.credential_id,
+           update_time           = now()
+     where session_id            = new.session_id
+       and credential_library_id = new.library_id
+       and credential_purpose    = new.credential_purpose;
+    return null;
+  end;
+  $$ language plpgsql;
+
+  create trigger update_credential_issuance after update of credential_id on session_credential_dynamic
+    for each row
+    when (old.credential_id is null and new.credential_id is not null)
+    execute procedure update_dynamic_credential_issuance();
+
+  -- insert_static_credential_issuance inserts a credential_issuance row for a
+  -- new session_credential_static row.
+  create function insert_static_credential_issuance() returns trigger
+  as $$
+  begin
+    insert into credential_issuance
+      (session_id, user_id, target_id, project_id, credential_static_id, credential_version, credential_purpose)
+    select s.public_id, s.user_id, s.target_id, s.project_id, new.credential_static_id, new.credential_version, new.credential_purpose
+      from session as s
+     where s.public_id = new.session_id;
+    return null;
+  end;
+  $$ language plpgsql;
+
+  create trigger insert_credential_issuance after insert on session_credential_static
+    for each row execute procedure insert_static_credential_issuance();
+
+commit;
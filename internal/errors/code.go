@@ -69,6 +69,9 @@ const (
 
 	InvalidListToken Code = 136 // InvalidListToken represents an error where the provided list token is invalid
 
+	SessionLimitExceeded Code = 137 // SessionLimitExceeded represents that creating a session would exceed a limit on the number of active sessions
+	CredentialCheckedOut Code = 138 // CredentialCheckedOut represents that a credential is exclusively checked out by another session
+
 	AuthAttemptExpired Code = 198 // AuthAttemptExpired represents an expired authentication attempt
 	AuthMethodInactive Code = 199 // AuthMethodInactive represents an error that means the auth method is not active.
 
@@ -87,6 +90,38 @@ const (
 	// new passwords are equal.
 	PasswordsEqual Code = 203
 
+	// PasswordTooWeak results from attempting to set a password which does
+	// not meet the complexity requirements of the password policy.
+	PasswordTooWeak Code = 204
+
+	// PasswordReused results from attempting to set a password which matches
+	// one of the account's previous passwords.
+	PasswordReused Code = 205
+
+	// PasswordExpired is returned from Authenticate when the account's
+	// password is older than the maximum age allowed by the password policy.
+	PasswordExpired Code = 206
+
+	// AccountLocked is returned from Authenticate when the account is locked
+	// because of too many failed authentication attempts.
+	AccountLocked Code = 207
+
+	// TotpRequired is returned from Authenticate when the account requires a
+	// TOTP code as a second factor and none was provided.
+	TotpRequired Code = 208
+
+	// TotpInvalid results from providing a TOTP code which does not match
+	// the account's TOTP secret.
+	TotpInvalid Code = 209
+
+	// TotpAlreadyEnrolled results from attempting to enroll an account in
+	// TOTP when it already has a verified enrollment.
+	TotpAlreadyEnrolled Code = 210
+
+	// TotpNotEnrolled is returned from Authenticate when the password policy
+	// requires a TOTP code but the account is not enrolled in TOTP.
+	TotpNotEnrolled Code = 211
+
 	Encrypt Code = 300 // Encrypt represents an error occurred during the underlying encryption process
 	Decrypt Code = 301 // Decrypt represents an error occurred during the underlying decryption process
 	Encode  Code = 302 // Encode represents an error occurred during the underlying encoding/marshaling process
@@ -146,6 +181,11 @@ const (
 	VaultInvalidMappingOverride   Code = 3016 // VaultInvalidMappingOverride represents an error returned when a credential mapping is unknown or does not match a credential type
 	VaultInvalidCredentialMapping Code = 3017 // VaultInvalidCredentialMapping represents an error returned when a Vault secret failed to be mapped to a specific credential type
 
+	// Credential file specific errors
+	CredentialFileRead           Code = 3020 // CredentialFileRead represents an error reading or decrypting a credential bundle file
+	CredentialFileEntryNotFound  Code = 3021 // CredentialFileEntryNotFound represents an error returned when a path does not exist in a credential bundle file
+	CredentialFileInvalidMapping Code = 3022 // CredentialFileInvalidMapping represents an error returned when a credential bundle entry failed to be mapped to a specific credential type
+
 	// OIDC authentication provided errors
 	OidcProviderCallbackError Code = 4000 // OidcProviderCallbackError represents an error that is passed by the OIDC provider to the callback endpoint
 
@@ -165,6 +165,46 @@ func TestCode_Both_String_Info(t *testing.T) {
 			c:    PasswordsEqual,
 			want: PasswordsEqual,
 		},
+		{
+			name: "PasswordTooWeak",
+			c:    PasswordTooWeak,
+			want: PasswordTooWeak,
+		},
+		{
+			name: "PasswordReused",
+			c:    PasswordReused,
+			want: PasswordReused,
+		},
+		{
+			name: "PasswordExpired",
+			c:    PasswordExpired,
+			want: PasswordExpired,
+		},
+		{
+			name: "AccountLocked",
+			c:    AccountLocked,
+			want: AccountLocked,
+		},
+		{
+			name: "TotpRequired",
+			c:    TotpRequired,
+			want: TotpRequired,
+		},
+		{
+			name: "TotpInvalid",
+			c:    TotpInvalid,
+			want: TotpInvalid,
+		},
+		{
+			name: "TotpAlreadyEnrolled",
+			c:    TotpAlreadyEnrolled,
+			want: TotpAlreadyEnrolled,
+		},
+		{
+			name: "TotpNotEnrolled",
+			c:    TotpNotEnrolled,
+			want: TotpNotEnrolled,
+		},
 		{
 			name: "Encrypt",
 			c:    Encrypt,
@@ -300,6 +340,21 @@ func TestCode_Both_String_Info(t *testing.T) {
 			c:    VaultInvalidCredentialMapping,
 			want: VaultInvalidCredentialMapping,
 		},
+		{
+			name: "CredentialFileRead",
+			c:    CredentialFileRead,
+			want: CredentialFileRead,
+		},
+		{
+			name: "CredentialFileEntryNotFound",
+			c:    CredentialFileEntryNotFound,
+			want: CredentialFileEntryNotFound,
+		},
+		{
+			name: "CredentialFileInvalidMapping",
+			c:    CredentialFileInvalidMapping,
+			want: CredentialFileInvalidMapping,
+		},
 		{
 			name: "OidcProviderCallbackError",
 			c:    OidcProviderCallbackError,
@@ -420,6 +475,16 @@ func TestCode_Both_String_Info(t *testing.T) {
 			c:    InvalidListToken,
 			want: InvalidListToken,
 		},
+		{
+			name: "SessionLimitExceeded",
+			c:    SessionLimitExceeded,
+			want: SessionLimitExceeded,
+		},
+		{
+			name: "CredentialCheckedOut",
+			c:    CredentialCheckedOut,
+			want: CredentialCheckedOut,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
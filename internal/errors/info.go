@@ -119,6 +119,38 @@ var errorCodeInfo = map[Code]Info{
 		Message: "old and new password are equal",
 		Kind:    Password,
 	},
+	PasswordTooWeak: {
+		Message: "does not meet complexity requirements",
+		Kind:    Password,
+	},
+	PasswordReused: {
+		Message: "password was used previously",
+		Kind:    Password,
+	},
+	PasswordExpired: {
+		Message: "password has expired",
+		Kind:    Password,
+	},
+	AccountLocked: {
+		Message: "account is locked",
+		Kind:    Password,
+	},
+	TotpRequired: {
+		Message: "totp code required",
+		Kind:    Password,
+	},
+	TotpInvalid: {
+		Message: "invalid totp code",
+		Kind:    Password,
+	},
+	TotpAlreadyEnrolled: {
+		Message: "totp already enrolled",
+		Kind:    Password,
+	},
+	TotpNotEnrolled: {
+		Message: "totp not enrolled",
+		Kind:    Password,
+	},
 	Encrypt: {
 		Message: "error occurred during encrypt",
 		Kind:    Encryption,
@@ -255,6 +287,18 @@ var errorCodeInfo = map[Code]Info{
 		Message: "mapping vault secret to a credential type failed",
 		Kind:    Integrity,
 	},
+	CredentialFileRead: {
+		Message: "reading the credential bundle file failed",
+		Kind:    External,
+	},
+	CredentialFileEntryNotFound: {
+		Message: "credential bundle entry not found",
+		Kind:    Integrity,
+	},
+	CredentialFileInvalidMapping: {
+		Message: "mapping credential bundle entry to a credential type failed",
+		Kind:    Integrity,
+	},
 	OidcProviderCallbackError: {
 		Message: "oidc provider callback error",
 		Kind:    External,
@@ -351,4 +395,12 @@ var errorCodeInfo = map[Code]Info{
 		Message: "invalid list token",
 		Kind:    Parameter,
 	},
+	SessionLimitExceeded: {
+		Message: "active session limit exceeded",
+		Kind:    State,
+	},
+	CredentialCheckedOut: {
+		Message: "credential checked out",
+		Kind:    State,
+	},
 }
@@ -29,6 +29,9 @@ const (
 	AccountService_DeleteAccount_FullMethodName  = "/controller.api.services.v1.AccountService/DeleteAccount"
 	AccountService_SetPassword_FullMethodName    = "/controller.api.services.v1.AccountService/SetPassword"
 	AccountService_ChangePassword_FullMethodName = "/controller.api.services.v1.AccountService/ChangePassword"
+	AccountService_EnrollTotp_FullMethodName     = "/controller.api.services.v1.AccountService/EnrollTotp"
+	AccountService_VerifyTotp_FullMethodName     = "/controller.api.services.v1.AccountService/VerifyTotp"
+	AccountService_RemoveTotp_FullMethodName     = "/controller.api.services.v1.AccountService/RemoveTotp"
 )
 
 // AccountServiceClient is the client API for AccountService service.
@@ -71,6 +74,16 @@ type AccountServiceClient interface {
 	// request. This method is intended for end users and requires the existing
 	// password to be provided for authentication purposes.
 	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	// EnrollTotp creates a new TOTP secret and recovery codes for the Account.
+	// The enrollment is pending until it is verified with VerifyTotp. The secret
+	// and recovery codes are only returned by this method.
+	EnrollTotp(ctx context.Context, in *EnrollTotpRequest, opts ...grpc.CallOption) (*EnrollTotpResponse, error)
+	// VerifyTotp completes the pending TOTP enrollment of the Account if the
+	// provided code is valid for the enrollment's secret. Once verified, a TOTP
+	// code is required when the Account authenticates.
+	VerifyTotp(ctx context.Context, in *VerifyTotpRequest, opts ...grpc.CallOption) (*VerifyTotpResponse, error)
+	// RemoveTotp removes the TOTP enrollment and recovery codes of the Account.
+	RemoveTotp(ctx context.Context, in *RemoveTotpRequest, opts ...grpc.CallOption) (*RemoveTotpResponse, error)
 }
 
 type accountServiceClient struct {
@@ -144,6 +157,33 @@ func (c *accountServiceClient) ChangePassword(ctx context.Context, in *ChangePas
 	return out, nil
 }
 
+func (c *accountServiceClient) EnrollTotp(ctx context.Context, in *EnrollTotpRequest, opts ...grpc.CallOption) (*EnrollTotpResponse, error) {
+	out := new(EnrollTotpResponse)
+	err := c.cc.Invoke(ctx, AccountService_EnrollTotp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) VerifyTotp(ctx context.Context, in *VerifyTotpRequest, opts ...grpc.CallOption) (*VerifyTotpResponse, error) {
+	out := new(VerifyTotpResponse)
+	err := c.cc.Invoke(ctx, AccountService_VerifyTotp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) RemoveTotp(ctx context.Context, in *RemoveTotpRequest, opts ...grpc.CallOption) (*RemoveTotpResponse, error) {
+	out := new(RemoveTotpResponse)
+	err := c.cc.Invoke(ctx, AccountService_RemoveTotp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountServiceServer is the server API for AccountService service.
 // All implementations must embed UnimplementedAccountServiceServer
 // for forward compatibility
@@ -184,6 +224,16 @@ type AccountServiceServer interface {
 	// request. This method is intended for end users and requires the existing
 	// password to be provided for authentication purposes.
 	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	// EnrollTotp creates a new TOTP secret and recovery codes for the Account.
+	// The enrollment is pending until it is verified with VerifyTotp. The secret
+	// and recovery codes are only returned by this method.
+	EnrollTotp(context.Context, *EnrollTotpRequest) (*EnrollTotpResponse, error)
+	// VerifyTotp completes the pending TOTP enrollment of the Account if the
+	// provided code is valid for the enrollment's secret. Once verified, a TOTP
+	// code is required when the Account authenticates.
+	VerifyTotp(context.Context, *VerifyTotpRequest) (*VerifyTotpResponse, error)
+	// RemoveTotp removes the TOTP enrollment and recovery codes of the Account.
+	RemoveTotp(context.Context, *RemoveTotpRequest) (*RemoveTotpResponse, error)
 	mustEmbedUnimplementedAccountServiceServer()
 }
 
@@ -212,6 +262,15 @@ func (UnimplementedAccountServiceServer) SetPassword(context.Context, *SetPasswo
 func (UnimplementedAccountServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
 }
+func (UnimplementedAccountServiceServer) EnrollTotp(context.Context, *EnrollTotpRequest) (*EnrollTotpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTotp not implemented")
+}
+func (UnimplementedAccountServiceServer) VerifyTotp(context.Context, *VerifyTotpRequest) (*VerifyTotpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTotp not implemented")
+}
+func (UnimplementedAccountServiceServer) RemoveTotp(context.Context, *RemoveTotpRequest) (*RemoveTotpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTotp not implemented")
+}
 func (UnimplementedAccountServiceServer) mustEmbedUnimplementedAccountServiceServer() {}
 
 // UnsafeAccountServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -351,6 +410,60 @@ func _AccountService_ChangePassword_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_EnrollTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTotpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).EnrollTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_EnrollTotp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).EnrollTotp(ctx, req.(*EnrollTotpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_VerifyTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTotpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).VerifyTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_VerifyTotp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).VerifyTotp(ctx, req.(*VerifyTotpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_RemoveTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTotpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).RemoveTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_RemoveTotp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).RemoveTotp(ctx, req.(*RemoveTotpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AccountService_ServiceDesc is the grpc.ServiceDesc for AccountService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -386,6 +499,18 @@ var AccountService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangePassword",
 			Handler:    _AccountService_ChangePassword_Handler,
 		},
+		{
+			MethodName: "EnrollTotp",
+			Handler:    _AccountService_EnrollTotp_Handler,
+		},
+		{
+			MethodName: "VerifyTotp",
+			Handler:    _AccountService_VerifyTotp_Handler,
+		},
+		{
+			MethodName: "RemoveTotp",
+			Handler:    _AccountService_RemoveTotp_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/account_service.proto",
@@ -22,11 +22,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	CredentialLibraryService_GetCredentialLibrary_FullMethodName    = "/controller.api.services.v1.CredentialLibraryService/GetCredentialLibrary"
-	CredentialLibraryService_ListCredentialLibraries_FullMethodName = "/controller.api.services.v1.CredentialLibraryService/ListCredentialLibraries"
-	CredentialLibraryService_CreateCredentialLibrary_FullMethodName = "/controller.api.services.v1.CredentialLibraryService/CreateCredentialLibrary"
-	CredentialLibraryService_UpdateCredentialLibrary_FullMethodName = "/controller.api.services.v1.CredentialLibraryService/UpdateCredentialLibrary"
-	CredentialLibraryService_DeleteCredentialLibrary_FullMethodName = "/controller.api.services.v1.CredentialLibraryService/DeleteCredentialLibrary"
+	CredentialLibraryService_GetCredentialLibrary_FullMethodName           = "/controller.api.services.v1.CredentialLibraryService/GetCredentialLibrary"
+	CredentialLibraryService_ListCredentialLibraries_FullMethodName        = "/controller.api.services.v1.CredentialLibraryService/ListCredentialLibraries"
+	CredentialLibraryService_CreateCredentialLibrary_FullMethodName        = "/controller.api.services.v1.CredentialLibraryService/CreateCredentialLibrary"
+	CredentialLibraryService_UpdateCredentialLibrary_FullMethodName        = "/controller.api.services.v1.CredentialLibraryService/UpdateCredentialLibrary"
+	CredentialLibraryService_DeleteCredentialLibrary_FullMethodName        = "/controller.api.services.v1.CredentialLibraryService/DeleteCredentialLibrary"
+	CredentialLibraryService_ListCredentialLibraryIssuances_FullMethodName = "/controller.api.services.v1.CredentialLibraryService/ListCredentialLibraryIssuances"
 )
 
 // CredentialLibraryServiceClient is the client API for CredentialLibraryService service.
@@ -59,6 +60,11 @@ type CredentialLibraryServiceClient interface {
 	// DeleteCredentialLibrary removes an Credential Library from Boundary. If the Credential Library id
 	// is malformed or not provided an error is returned.
 	DeleteCredentialLibrary(ctx context.Context, in *DeleteCredentialLibraryRequest, opts ...grpc.CallOption) (*DeleteCredentialLibraryResponse, error)
+	// ListCredentialLibraryIssuances returns the Credentials issued from a
+	// Credential Library to Sessions, most recently issued first. Each issuance
+	// includes the Session, User and Target the Credential was issued to and
+	// when it was issued. Issuances are kept after their Session is deleted.
+	ListCredentialLibraryIssuances(ctx context.Context, in *ListCredentialLibraryIssuancesRequest, opts ...grpc.CallOption) (*ListCredentialLibraryIssuancesResponse, error)
 }
 
 type credentialLibraryServiceClient struct {
@@ -114,6 +120,15 @@ func (c *credentialLibraryServiceClient) DeleteCredentialLibrary(ctx context.Con
 	return out, nil
 }
 
+func (c *credentialLibraryServiceClient) ListCredentialLibraryIssuances(ctx context.Context, in *ListCredentialLibraryIssuancesRequest, opts ...grpc.CallOption) (*ListCredentialLibraryIssuancesResponse, error) {
+	out := new(ListCredentialLibraryIssuancesResponse)
+	err := c.cc.Invoke(ctx, CredentialLibraryService_ListCredentialLibraryIssuances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CredentialLibraryServiceServer is the server API for CredentialLibraryService service.
 // All implementations must embed UnimplementedCredentialLibraryServiceServer
 // for forward compatibility
@@ -144,6 +159,11 @@ type CredentialLibraryServiceServer interface {
 	// DeleteCredentialLibrary removes an Credential Library from Boundary. If the Credential Library id
 	// is malformed or not provided an error is returned.
 	DeleteCredentialLibrary(context.Context, *DeleteCredentialLibraryRequest) (*DeleteCredentialLibraryResponse, error)
+	// ListCredentialLibraryIssuances returns the Credentials issued from a
+	// Credential Library to Sessions, most recently issued first. Each issuance
+	// includes the Session, User and Target the Credential was issued to and
+	// when it was issued. Issuances are kept after their Session is deleted.
+	ListCredentialLibraryIssuances(context.Context, *ListCredentialLibraryIssuancesRequest) (*ListCredentialLibraryIssuancesResponse, error)
 	mustEmbedUnimplementedCredentialLibraryServiceServer()
 }
 
@@ -166,6 +186,9 @@ func (UnimplementedCredentialLibraryServiceServer) UpdateCredentialLibrary(conte
 func (UnimplementedCredentialLibraryServiceServer) DeleteCredentialLibrary(context.Context, *DeleteCredentialLibraryRequest) (*DeleteCredentialLibraryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredentialLibrary not implemented")
 }
+func (UnimplementedCredentialLibraryServiceServer) ListCredentialLibraryIssuances(context.Context, *ListCredentialLibraryIssuancesRequest) (*ListCredentialLibraryIssuancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredentialLibraryIssuances not implemented")
+}
 func (UnimplementedCredentialLibraryServiceServer) mustEmbedUnimplementedCredentialLibraryServiceServer() {
 }
 
@@ -270,6 +293,24 @@ func _CredentialLibraryService_DeleteCredentialLibrary_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CredentialLibraryService_ListCredentialLibraryIssuances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredentialLibraryIssuancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialLibraryServiceServer).ListCredentialLibraryIssuances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialLibraryService_ListCredentialLibraryIssuances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialLibraryServiceServer).ListCredentialLibraryIssuances(ctx, req.(*ListCredentialLibraryIssuancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CredentialLibraryService_ServiceDesc is the grpc.ServiceDesc for CredentialLibraryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -297,6 +338,10 @@ var CredentialLibraryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCredentialLibrary",
 			Handler:    _CredentialLibraryService_DeleteCredentialLibrary_Handler,
 		},
+		{
+			MethodName: "ListCredentialLibraryIssuances",
+			Handler:    _CredentialLibraryService_ListCredentialLibraryIssuances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/credential_library_service.proto",
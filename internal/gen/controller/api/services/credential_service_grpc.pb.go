@@ -22,11 +22,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	CredentialService_GetCredential_FullMethodName    = "/controller.api.services.v1.CredentialService/GetCredential"
-	CredentialService_ListCredentials_FullMethodName  = "/controller.api.services.v1.CredentialService/ListCredentials"
-	CredentialService_CreateCredential_FullMethodName = "/controller.api.services.v1.CredentialService/CreateCredential"
-	CredentialService_UpdateCredential_FullMethodName = "/controller.api.services.v1.CredentialService/UpdateCredential"
-	CredentialService_DeleteCredential_FullMethodName = "/controller.api.services.v1.CredentialService/DeleteCredential"
+	CredentialService_GetCredential_FullMethodName           = "/controller.api.services.v1.CredentialService/GetCredential"
+	CredentialService_ListCredentials_FullMethodName         = "/controller.api.services.v1.CredentialService/ListCredentials"
+	CredentialService_CreateCredential_FullMethodName        = "/controller.api.services.v1.CredentialService/CreateCredential"
+	CredentialService_UpdateCredential_FullMethodName        = "/controller.api.services.v1.CredentialService/UpdateCredential"
+	CredentialService_DeleteCredential_FullMethodName        = "/controller.api.services.v1.CredentialService/DeleteCredential"
+	CredentialService_ListCredentialVersions_FullMethodName  = "/controller.api.services.v1.CredentialService/ListCredentialVersions"
+	CredentialService_RollbackCredential_FullMethodName      = "/controller.api.services.v1.CredentialService/RollbackCredential"
+	CredentialService_ListCredentialIssuances_FullMethodName = "/controller.api.services.v1.CredentialService/ListCredentialIssuances"
 )
 
 // CredentialServiceClient is the client API for CredentialService service.
@@ -59,6 +62,19 @@ type CredentialServiceClient interface {
 	// DeleteCredential removes an Credential from Boundary. If the Credential id
 	// is malformed or not provided an error is returned.
 	DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*DeleteCredentialResponse, error)
+	// ListCredentialVersions returns the versions of a static Credential, newest
+	// first. A new version is recorded each time the Credential is created or
+	// updated. The attributes of a version are never returned.
+	ListCredentialVersions(ctx context.Context, in *ListCredentialVersionsRequest, opts ...grpc.CallOption) (*ListCredentialVersionsResponse, error)
+	// RollbackCredential sets the attributes of a static Credential back to the
+	// attributes recorded in one of its earlier versions. The rollback is
+	// recorded as a new version of the Credential.
+	RollbackCredential(ctx context.Context, in *RollbackCredentialRequest, opts ...grpc.CallOption) (*RollbackCredentialResponse, error)
+	// ListCredentialIssuances returns the issuances of a static Credential to
+	// Sessions, most recently issued first. Each issuance includes the Session,
+	// User and Target the Credential was issued to and when it was issued.
+	// Issuances are kept after their Session is deleted.
+	ListCredentialIssuances(ctx context.Context, in *ListCredentialIssuancesRequest, opts ...grpc.CallOption) (*ListCredentialIssuancesResponse, error)
 }
 
 type credentialServiceClient struct {
@@ -114,6 +130,33 @@ func (c *credentialServiceClient) DeleteCredential(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *credentialServiceClient) ListCredentialVersions(ctx context.Context, in *ListCredentialVersionsRequest, opts ...grpc.CallOption) (*ListCredentialVersionsResponse, error) {
+	out := new(ListCredentialVersionsResponse)
+	err := c.cc.Invoke(ctx, CredentialService_ListCredentialVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialServiceClient) RollbackCredential(ctx context.Context, in *RollbackCredentialRequest, opts ...grpc.CallOption) (*RollbackCredentialResponse, error) {
+	out := new(RollbackCredentialResponse)
+	err := c.cc.Invoke(ctx, CredentialService_RollbackCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialServiceClient) ListCredentialIssuances(ctx context.Context, in *ListCredentialIssuancesRequest, opts ...grpc.CallOption) (*ListCredentialIssuancesResponse, error) {
+	out := new(ListCredentialIssuancesResponse)
+	err := c.cc.Invoke(ctx, CredentialService_ListCredentialIssuances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CredentialServiceServer is the server API for CredentialService service.
 // All implementations must embed UnimplementedCredentialServiceServer
 // for forward compatibility
@@ -144,6 +187,19 @@ type CredentialServiceServer interface {
 	// DeleteCredential removes an Credential from Boundary. If the Credential id
 	// is malformed or not provided an error is returned.
 	DeleteCredential(context.Context, *DeleteCredentialRequest) (*DeleteCredentialResponse, error)
+	// ListCredentialVersions returns the versions of a static Credential, newest
+	// first. A new version is recorded each time the Credential is created or
+	// updated. The attributes of a version are never returned.
+	ListCredentialVersions(context.Context, *ListCredentialVersionsRequest) (*ListCredentialVersionsResponse, error)
+	// RollbackCredential sets the attributes of a static Credential back to the
+	// attributes recorded in one of its earlier versions. The rollback is
+	// recorded as a new version of the Credential.
+	RollbackCredential(context.Context, *RollbackCredentialRequest) (*RollbackCredentialResponse, error)
+	// ListCredentialIssuances returns the issuances of a static Credential to
+	// Sessions, most recently issued first. Each issuance includes the Session,
+	// User and Target the Credential was issued to and when it was issued.
+	// Issuances are kept after their Session is deleted.
+	ListCredentialIssuances(context.Context, *ListCredentialIssuancesRequest) (*ListCredentialIssuancesResponse, error)
 	mustEmbedUnimplementedCredentialServiceServer()
 }
 
@@ -166,6 +222,15 @@ func (UnimplementedCredentialServiceServer) UpdateCredential(context.Context, *U
 func (UnimplementedCredentialServiceServer) DeleteCredential(context.Context, *DeleteCredentialRequest) (*DeleteCredentialResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
 }
+func (UnimplementedCredentialServiceServer) ListCredentialVersions(context.Context, *ListCredentialVersionsRequest) (*ListCredentialVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredentialVersions not implemented")
+}
+func (UnimplementedCredentialServiceServer) RollbackCredential(context.Context, *RollbackCredentialRequest) (*RollbackCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackCredential not implemented")
+}
+func (UnimplementedCredentialServiceServer) ListCredentialIssuances(context.Context, *ListCredentialIssuancesRequest) (*ListCredentialIssuancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredentialIssuances not implemented")
+}
 func (UnimplementedCredentialServiceServer) mustEmbedUnimplementedCredentialServiceServer() {}
 
 // UnsafeCredentialServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -269,6 +334,60 @@ func _CredentialService_DeleteCredential_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CredentialService_ListCredentialVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredentialVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).ListCredentialVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialService_ListCredentialVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).ListCredentialVersions(ctx, req.(*ListCredentialVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialService_RollbackCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).RollbackCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialService_RollbackCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).RollbackCredential(ctx, req.(*RollbackCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialService_ListCredentialIssuances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredentialIssuancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).ListCredentialIssuances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialService_ListCredentialIssuances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).ListCredentialIssuances(ctx, req.(*ListCredentialIssuancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CredentialService_ServiceDesc is the grpc.ServiceDesc for CredentialService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -296,6 +415,18 @@ var CredentialService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCredential",
 			Handler:    _CredentialService_DeleteCredential_Handler,
 		},
+		{
+			MethodName: "ListCredentialVersions",
+			Handler:    _CredentialService_ListCredentialVersions_Handler,
+		},
+		{
+			MethodName: "RollbackCredential",
+			Handler:    _CredentialService_RollbackCredential_Handler,
+		},
+		{
+			MethodName: "ListCredentialIssuances",
+			Handler:    _CredentialService_ListCredentialIssuances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/credential_service.proto",
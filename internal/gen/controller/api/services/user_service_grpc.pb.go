@@ -22,14 +22,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	UserService_GetUser_FullMethodName            = "/controller.api.services.v1.UserService/GetUser"
-	UserService_ListUsers_FullMethodName          = "/controller.api.services.v1.UserService/ListUsers"
-	UserService_CreateUser_FullMethodName         = "/controller.api.services.v1.UserService/CreateUser"
-	UserService_UpdateUser_FullMethodName         = "/controller.api.services.v1.UserService/UpdateUser"
-	UserService_DeleteUser_FullMethodName         = "/controller.api.services.v1.UserService/DeleteUser"
-	UserService_AddUserAccounts_FullMethodName    = "/controller.api.services.v1.UserService/AddUserAccounts"
-	UserService_SetUserAccounts_FullMethodName    = "/controller.api.services.v1.UserService/SetUserAccounts"
-	UserService_RemoveUserAccounts_FullMethodName = "/controller.api.services.v1.UserService/RemoveUserAccounts"
+	UserService_GetUser_FullMethodName              = "/controller.api.services.v1.UserService/GetUser"
+	UserService_ListUsers_FullMethodName            = "/controller.api.services.v1.UserService/ListUsers"
+	UserService_CreateUser_FullMethodName           = "/controller.api.services.v1.UserService/CreateUser"
+	UserService_UpdateUser_FullMethodName           = "/controller.api.services.v1.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName           = "/controller.api.services.v1.UserService/DeleteUser"
+	UserService_AddUserAccounts_FullMethodName      = "/controller.api.services.v1.UserService/AddUserAccounts"
+	UserService_SetUserAccounts_FullMethodName      = "/controller.api.services.v1.UserService/SetUserAccounts"
+	UserService_RemoveUserAccounts_FullMethodName   = "/controller.api.services.v1.UserService/RemoveUserAccounts"
+	UserService_RevokeUserAuthTokens_FullMethodName = "/controller.api.services.v1.UserService/RevokeUserAuthTokens"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -84,6 +85,10 @@ type UserServiceClient interface {
 	// will be removed from. If the provided Account ids is not associated with the
 	// provided User, an error is returned.
 	RemoveUserAccounts(ctx context.Context, in *RemoveUserAccountsRequest, opts ...grpc.CallOption) (*RemoveUserAccountsResponse, error)
+	// RevokeUserAuthTokens deletes every auth token of the specified User,
+	// signing the User out of every client. The User can authenticate again
+	// afterwards.
+	RevokeUserAuthTokens(ctx context.Context, in *RevokeUserAuthTokensRequest, opts ...grpc.CallOption) (*RevokeUserAuthTokensResponse, error)
 }
 
 type userServiceClient struct {
@@ -166,6 +171,15 @@ func (c *userServiceClient) RemoveUserAccounts(ctx context.Context, in *RemoveUs
 	return out, nil
 }
 
+func (c *userServiceClient) RevokeUserAuthTokens(ctx context.Context, in *RevokeUserAuthTokensRequest, opts ...grpc.CallOption) (*RevokeUserAuthTokensResponse, error) {
+	out := new(RevokeUserAuthTokensResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeUserAuthTokens_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -218,6 +232,10 @@ type UserServiceServer interface {
 	// will be removed from. If the provided Account ids is not associated with the
 	// provided User, an error is returned.
 	RemoveUserAccounts(context.Context, *RemoveUserAccountsRequest) (*RemoveUserAccountsResponse, error)
+	// RevokeUserAuthTokens deletes every auth token of the specified User,
+	// signing the User out of every client. The User can authenticate again
+	// afterwards.
+	RevokeUserAuthTokens(context.Context, *RevokeUserAuthTokensRequest) (*RevokeUserAuthTokensResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -249,6 +267,9 @@ func (UnimplementedUserServiceServer) SetUserAccounts(context.Context, *SetUserA
 func (UnimplementedUserServiceServer) RemoveUserAccounts(context.Context, *RemoveUserAccountsRequest) (*RemoveUserAccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserAccounts not implemented")
 }
+func (UnimplementedUserServiceServer) RevokeUserAuthTokens(context.Context, *RevokeUserAuthTokensRequest) (*RevokeUserAuthTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserAuthTokens not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -406,6 +427,24 @@ func _UserService_RemoveUserAccounts_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_RevokeUserAuthTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserAuthTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeUserAuthTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeUserAuthTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeUserAuthTokens(ctx, req.(*RevokeUserAuthTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -445,6 +484,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveUserAccounts",
 			Handler:    _UserService_RemoveUserAccounts_Handler,
 		},
+		{
+			MethodName: "RevokeUserAuthTokens",
+			Handler:    _UserService_RevokeUserAuthTokens_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/user_service.proto",
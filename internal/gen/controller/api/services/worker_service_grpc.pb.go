@@ -33,6 +33,7 @@ const (
 	WorkerService_RemoveWorkerTags_FullMethodName                 = "/controller.api.services.v1.WorkerService/RemoveWorkerTags"
 	WorkerService_ReadCertificateAuthority_FullMethodName         = "/controller.api.services.v1.WorkerService/ReadCertificateAuthority"
 	WorkerService_ReinitializeCertificateAuthority_FullMethodName = "/controller.api.services.v1.WorkerService/ReinitializeCertificateAuthority"
+	WorkerService_DrainWorker_FullMethodName                      = "/controller.api.services.v1.WorkerService/DrainWorker"
 )
 
 // WorkerServiceClient is the client API for WorkerService service.
@@ -89,6 +90,12 @@ type WorkerServiceClient interface {
 	ReadCertificateAuthority(ctx context.Context, in *ReadCertificateAuthorityRequest, opts ...grpc.CallOption) (*ReadCertificateAuthorityResponse, error)
 	// ReinitializeCas removes both current and next root certs and replaces them with a new set
 	ReinitializeCertificateAuthority(ctx context.Context, in *ReinitializeCertificateAuthorityRequest, opts ...grpc.CallOption) (*ReinitializeCertificateAuthorityResponse, error)
+	// DrainWorker puts an existing Worker into drain mode. A draining Worker is
+	// not given any new sessions, and shuts down once its existing connections
+	// have closed or the drain timeout has passed, at which point any remaining
+	// connections are terminated. If missing, malformed, or referencing a
+	// non-existing resource, an error is returned.
+	DrainWorker(ctx context.Context, in *DrainWorkerRequest, opts ...grpc.CallOption) (*DrainWorkerResponse, error)
 }
 
 type workerServiceClient struct {
@@ -198,6 +205,15 @@ func (c *workerServiceClient) ReinitializeCertificateAuthority(ctx context.Conte
 	return out, nil
 }
 
+func (c *workerServiceClient) DrainWorker(ctx context.Context, in *DrainWorkerRequest, opts ...grpc.CallOption) (*DrainWorkerResponse, error) {
+	out := new(DrainWorkerResponse)
+	err := c.cc.Invoke(ctx, WorkerService_DrainWorker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkerServiceServer is the server API for WorkerService service.
 // All implementations must embed UnimplementedWorkerServiceServer
 // for forward compatibility
@@ -252,6 +268,12 @@ type WorkerServiceServer interface {
 	ReadCertificateAuthority(context.Context, *ReadCertificateAuthorityRequest) (*ReadCertificateAuthorityResponse, error)
 	// ReinitializeCas removes both current and next root certs and replaces them with a new set
 	ReinitializeCertificateAuthority(context.Context, *ReinitializeCertificateAuthorityRequest) (*ReinitializeCertificateAuthorityResponse, error)
+	// DrainWorker puts an existing Worker into drain mode. A draining Worker is
+	// not given any new sessions, and shuts down once its existing connections
+	// have closed or the drain timeout has passed, at which point any remaining
+	// connections are terminated. If missing, malformed, or referencing a
+	// non-existing resource, an error is returned.
+	DrainWorker(context.Context, *DrainWorkerRequest) (*DrainWorkerResponse, error)
 	mustEmbedUnimplementedWorkerServiceServer()
 }
 
@@ -292,6 +314,9 @@ func (UnimplementedWorkerServiceServer) ReadCertificateAuthority(context.Context
 func (UnimplementedWorkerServiceServer) ReinitializeCertificateAuthority(context.Context, *ReinitializeCertificateAuthorityRequest) (*ReinitializeCertificateAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReinitializeCertificateAuthority not implemented")
 }
+func (UnimplementedWorkerServiceServer) DrainWorker(context.Context, *DrainWorkerRequest) (*DrainWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainWorker not implemented")
+}
 func (UnimplementedWorkerServiceServer) mustEmbedUnimplementedWorkerServiceServer() {}
 
 // UnsafeWorkerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -503,6 +528,24 @@ func _WorkerService_ReinitializeCertificateAuthority_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkerService_DrainWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainWorkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).DrainWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkerService_DrainWorker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).DrainWorker(ctx, req.(*DrainWorkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkerService_ServiceDesc is the grpc.ServiceDesc for WorkerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -554,6 +597,10 @@ var WorkerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReinitializeCertificateAuthority",
 			Handler:    _WorkerService_ReinitializeCertificateAuthority_Handler,
 		},
+		{
+			MethodName: "DrainWorker",
+			Handler:    _WorkerService_DrainWorker_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/worker_service.proto",
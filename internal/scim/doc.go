@@ -0,0 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package scim stores the attributes of users and groups provisioned through
+// the SCIM 2.0 endpoint of the controller which do not have an equivalent in
+// the iam package, and lists iam users and groups along with them.
+//
+// Every iam user and group in the scope configured for SCIM is exposed to the
+// SCIM client. Users and groups without SCIM attributes have no external id
+// and users are active.
+package scim
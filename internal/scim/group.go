@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// A Group holds the SCIM attributes of an iam group. ExternalId is the
+// identifier of the group in the SCIM client.
+type Group struct {
+	IamGroupId string               `gorm:"primary_key"`
+	CreateTime *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	UpdateTime *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	ExternalId string               `gorm:"default:null"`
+}
+
+// NewGroup creates a new in memory Group for iamGroupId.
+func NewGroup(ctx context.Context, iamGroupId string) (*Group, error) {
+	const op = "scim.NewGroup"
+	if iamGroupId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing iam group id")
+	}
+	return &Group{IamGroupId: iamGroupId}, nil
+}
+
+// TableName returns the table name.
+func (g *Group) TableName() string {
+	return "scim_group"
+}
+
+func (g *Group) clone() *Group {
+	cp := *g
+	return &cp
+}
+
+// A ProvisionedGroup is an iam group along with its SCIM attributes and the
+// ids of its user members.
+type ProvisionedGroup struct {
+	PublicId    string
+	ScopeId     string
+	Name        string
+	Description string
+	Version     uint32
+	CreateTime  *timestamp.Timestamp
+	UpdateTime  *timestamp.Timestamp
+	ExternalId  string
+	MemberIds   []string `gorm:"-"`
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName       string
+	withExternalId string
+	withLimit      int
+	withOffset     int
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithName filters listed users or groups to the one with the provided name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithExternalId filters listed users or groups to the ones with the
+// provided external id.
+func WithExternalId(id string) Option {
+	return func(o *options) {
+		o.withExternalId = id
+	}
+}
+
+// WithLimit sets the maximum number of users or groups listed. A value of 0
+// or less does not limit the number.
+func WithLimit(limit int) Option {
+	return func(o *options) {
+		o.withLimit = limit
+	}
+}
+
+// WithOffset sets the number of users or groups skipped before the listed
+// ones.
+func WithOffset(offset int) Option {
+	return func(o *options) {
+		o.withOffset = offset
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+const (
+	listProvisionedUsersQuery = `
+select u.public_id,
+       u.scope_id,
+       coalesce(u.name, '')          as name,
+       coalesce(u.description, '')   as description,
+       u.version,
+       u.create_time,
+       u.update_time,
+       coalesce(su.external_id, '')  as external_id,
+       coalesce(su.active, true)     as active
+  from iam_user u
+  left join scim_user su
+    on su.iam_user_id = u.public_id
+ where %s
+ order by u.create_time, u.public_id
+%s;
+`
+	countProvisionedUsersQuery = `
+select count(*)
+  from iam_user u
+  left join scim_user su
+    on su.iam_user_id = u.public_id
+ where %s;
+`
+	listProvisionedGroupsQuery = `
+select g.public_id,
+       g.scope_id,
+       coalesce(g.name, '')          as name,
+       coalesce(g.description, '')   as description,
+       g.version,
+       g.create_time,
+       g.update_time,
+       coalesce(sg.external_id, '')  as external_id
+  from iam_group g
+  left join scim_group sg
+    on sg.iam_group_id = g.public_id
+ where %s
+ order by g.create_time, g.public_id
+%s;
+`
+	countProvisionedGroupsQuery = `
+select count(*)
+  from iam_group g
+  left join scim_group sg
+    on sg.iam_group_id = g.public_id
+ where %s;
+`
+)
@@ -0,0 +1,339 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+)
+
+// A Repository stores and retrieves the SCIM attributes of iam users and
+// groups. It is not safe to use a repository concurrently.
+type Repository struct {
+	reader db.Reader
+	writer db.Writer
+}
+
+// NewRepository creates a new Repository. The returned repository should
+// only be used for one transaction and it is not safe for concurrent go
+// routines to access it.
+func NewRepository(ctx context.Context, r db.Reader, w db.Writer) (*Repository, error) {
+	const op = "scim.NewRepository"
+	switch {
+	case r == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil db reader")
+	case w == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil db writer")
+	}
+	return &Repository{
+		reader: r,
+		writer: w,
+	}, nil
+}
+
+// SetUser sets the SCIM attributes of the iam user u.IamUserId to those in
+// u and returns the stored User. u is not changed.
+func (r *Repository) SetUser(ctx context.Context, u *User) (*User, error) {
+	const op = "scim.(Repository).SetUser"
+	if u == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user")
+	}
+	if u.IamUserId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing iam user id")
+	}
+
+	newUser := u.clone()
+	newUser.CreateTime, newUser.UpdateTime = nil, nil
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			onConflict := &db.OnConflict{
+				Target: db.Columns{"iam_user_id"},
+				Action: db.SetColumns([]string{"external_id", "active"}),
+			}
+			if err := w.Create(ctx, newUser, db.WithOnConflict(onConflict)); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(u.IamUserId))
+	}
+	return newUser, nil
+}
+
+// LookupUser returns the SCIM attributes of the iam user iamUserId. If none
+// have been set, an active User without an external id is returned.
+func (r *Repository) LookupUser(ctx context.Context, iamUserId string) (*User, error) {
+	const op = "scim.(Repository).LookupUser"
+	if iamUserId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing iam user id")
+	}
+	u := &User{}
+	if err := r.reader.LookupWhere(ctx, u, "iam_user_id = ?", []any{iamUserId}); err != nil {
+		if !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(iamUserId))
+		}
+		return &User{IamUserId: iamUserId, Active: true}, nil
+	}
+	return u, nil
+}
+
+// LookupProvisionedUser returns the iam user publicId in scopeId along with
+// its SCIM attributes. nil is returned if there is no such user.
+func (r *Repository) LookupProvisionedUser(ctx context.Context, scopeId, publicId string) (*ProvisionedUser, error) {
+	const op = "scim.(Repository).LookupProvisionedUser"
+	switch {
+	case scopeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case publicId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	users, _, err := r.listUsers(ctx, scopeId, publicId, getOpts())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(users) == 0 {
+		return nil, nil
+	}
+	return users[0], nil
+}
+
+// ListUsers lists the iam users in scopeId along with their SCIM
+// attributes, ordered by creation time, and returns the total number of
+// users matching the filter options. WithName, WithExternalId, WithLimit and
+// WithOffset are supported.
+func (r *Repository) ListUsers(ctx context.Context, scopeId string, opt ...Option) ([]*ProvisionedUser, int, error) {
+	const op = "scim.(Repository).ListUsers"
+	if scopeId == "" {
+		return nil, 0, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	users, total, err := r.listUsers(ctx, scopeId, "", getOpts(opt...))
+	if err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+	return users, total, nil
+}
+
+func (r *Repository) listUsers(ctx context.Context, scopeId, publicId string, opts options) ([]*ProvisionedUser, int, error) {
+	const op = "scim.(Repository).listUsers"
+	where := []string{"u.scope_id = @scope_id"}
+	args := []any{sql.Named("scope_id", scopeId)}
+	if publicId != "" {
+		where = append(where, "u.public_id = @public_id")
+		args = append(args, sql.Named("public_id", publicId))
+	}
+	if opts.withName != "" {
+		where = append(where, "u.name = @name")
+		args = append(args, sql.Named("name", opts.withName))
+	}
+	if opts.withExternalId != "" {
+		where = append(where, "su.external_id = @external_id")
+		args = append(args, sql.Named("external_id", opts.withExternalId))
+	}
+	whereClause := strings.Join(where, " and ")
+
+	query := fmt.Sprintf(listProvisionedUsersQuery, whereClause, limitClause(opts))
+	rows, err := r.reader.Query(ctx, query, args)
+	if err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var users []*ProvisionedUser
+	for rows.Next() {
+		var u ProvisionedUser
+		if err := r.reader.ScanRows(ctx, rows, &u); err != nil {
+			return nil, 0, errors.Wrap(ctx, err, op)
+		}
+		users = append(users, &u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+
+	total, err := r.count(ctx, fmt.Sprintf(countProvisionedUsersQuery, whereClause), args)
+	if err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+	return users, total, nil
+}
+
+// SetGroup sets the SCIM attributes of the iam group g.IamGroupId to those
+// in g and returns the stored Group. g is not changed.
+func (r *Repository) SetGroup(ctx context.Context, g *Group) (*Group, error) {
+	const op = "scim.(Repository).SetGroup"
+	if g == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing group")
+	}
+	if g.IamGroupId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing iam group id")
+	}
+
+	newGroup := g.clone()
+	newGroup.CreateTime, newGroup.UpdateTime = nil, nil
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			onConflict := &db.OnConflict{
+				Target: db.Columns{"iam_group_id"},
+				Action: db.SetColumns([]string{"external_id"}),
+			}
+			if err := w.Create(ctx, newGroup, db.WithOnConflict(onConflict)); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(g.IamGroupId))
+	}
+	return newGroup, nil
+}
+
+// LookupGroup returns the SCIM attributes of the iam group iamGroupId. If
+// none have been set, a Group without an external id is returned.
+func (r *Repository) LookupGroup(ctx context.Context, iamGroupId string) (*Group, error) {
+	const op = "scim.(Repository).LookupGroup"
+	if iamGroupId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing iam group id")
+	}
+	g := &Group{}
+	if err := r.reader.LookupWhere(ctx, g, "iam_group_id = ?", []any{iamGroupId}); err != nil {
+		if !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(iamGroupId))
+		}
+		return &Group{IamGroupId: iamGroupId}, nil
+	}
+	return g, nil
+}
+
+// LookupProvisionedGroup returns the iam group publicId in scopeId along
+// with its SCIM attributes and user members. nil is returned if there is no
+// such group.
+func (r *Repository) LookupProvisionedGroup(ctx context.Context, scopeId, publicId string) (*ProvisionedGroup, error) {
+	const op = "scim.(Repository).LookupProvisionedGroup"
+	switch {
+	case scopeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case publicId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	groups, _, err := r.listGroups(ctx, scopeId, publicId, getOpts())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+	return groups[0], nil
+}
+
+// ListGroups lists the iam groups in scopeId along with their SCIM
+// attributes and user members, ordered by creation time, and returns the
+// total number of groups matching the filter options. WithName,
+// WithExternalId, WithLimit and WithOffset are supported.
+func (r *Repository) ListGroups(ctx context.Context, scopeId string, opt ...Option) ([]*ProvisionedGroup, int, error) {
+	const op = "scim.(Repository).ListGroups"
+	if scopeId == "" {
+		return nil, 0, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	groups, total, err := r.listGroups(ctx, scopeId, "", getOpts(opt...))
+	if err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+	return groups, total, nil
+}
+
+func (r *Repository) listGroups(ctx context.Context, scopeId, publicId string, opts options) ([]*ProvisionedGroup, int, error) {
+	const op = "scim.(Repository).listGroups"
+	where := []string{"g.scope_id = @scope_id"}
+	args := []any{sql.Named("scope_id", scopeId)}
+	if publicId != "" {
+		where = append(where, "g.public_id = @public_id")
+		args = append(args, sql.Named("public_id", publicId))
+	}
+	if opts.withName != "" {
+		where = append(where, "g.name = @name")
+		args = append(args, sql.Named("name", opts.withName))
+	}
+	if opts.withExternalId != "" {
+		where = append(where, "sg.external_id = @external_id")
+		args = append(args, sql.Named("external_id", opts.withExternalId))
+	}
+	whereClause := strings.Join(where, " and ")
+
+	query := fmt.Sprintf(listProvisionedGroupsQuery, whereClause, limitClause(opts))
+	rows, err := r.reader.Query(ctx, query, args)
+	if err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var groups []*ProvisionedGroup
+	byId := make(map[string]*ProvisionedGroup)
+	var ids []string
+	for rows.Next() {
+		var g ProvisionedGroup
+		if err := r.reader.ScanRows(ctx, rows, &g); err != nil {
+			return nil, 0, errors.Wrap(ctx, err, op)
+		}
+		groups = append(groups, &g)
+		byId[g.PublicId] = &g
+		ids = append(ids, g.PublicId)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+
+	if len(ids) > 0 {
+		var members []*iam.GroupMemberUser
+		if err := r.reader.SearchWhere(ctx, &members, "group_id in (?)", []any{ids}); err != nil {
+			return nil, 0, errors.Wrap(ctx, err, op)
+		}
+		for _, m := range members {
+			g := byId[m.GetGroupId()]
+			g.MemberIds = append(g.MemberIds, m.GetMemberId())
+		}
+	}
+
+	total, err := r.count(ctx, fmt.Sprintf(countProvisionedGroupsQuery, whereClause), args)
+	if err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+	return groups, total, nil
+}
+
+func (r *Repository) count(ctx context.Context, query string, args []any) (int, error) {
+	const op = "scim.(Repository).count"
+	rows, err := r.reader.Query(ctx, query, args)
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var count int
+	for rows.Next() {
+		if err := rows.Scan(&count); err != nil {
+			return 0, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	return count, nil
+}
+
+func limitClause(opts options) string {
+	var clause string
+	if opts.withLimit > 0 {
+		clause = fmt.Sprintf("limit %d", opts.withLimit)
+	}
+	if opts.withOffset > 0 {
+		clause = fmt.Sprintf("%s offset %d", clause, opts.withOffset)
+	}
+	return clause
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Users(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	repo, err := NewRepository(ctx, rw, rw)
+	require.NoError(t, err)
+
+	u1 := iam.TestUser(t, iamRepo, org.GetPublicId(), iam.WithName("alice"))
+	u2 := iam.TestUser(t, iamRepo, org.GetPublicId(), iam.WithName("bob"))
+
+	t.Run("set-and-lookup", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+
+		_, err := repo.LookupUser(ctx, "")
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+		_, err = repo.SetUser(ctx, &User{})
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+
+		got, err := repo.LookupUser(ctx, u1.GetPublicId())
+		require.NoError(err)
+		assert.Equal(&User{IamUserId: u1.GetPublicId(), Active: true}, got)
+
+		_, err = repo.SetUser(ctx, &User{IamUserId: u1.GetPublicId(), ExternalId: "ext-alice", Active: true})
+		require.NoError(err)
+		_, err = repo.SetUser(ctx, &User{IamUserId: u1.GetPublicId(), ExternalId: "ext-alice", Active: false})
+		require.NoError(err)
+		got, err = repo.LookupUser(ctx, u1.GetPublicId())
+		require.NoError(err)
+		assert.Equal("ext-alice", got.ExternalId)
+		assert.False(got.Active)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+
+		users, total, err := repo.ListUsers(ctx, org.GetPublicId())
+		require.NoError(err)
+		assert.Equal(2, total)
+		require.Len(users, 2)
+		assert.Equal(u1.GetPublicId(), users[0].PublicId)
+		assert.Equal("ext-alice", users[0].ExternalId)
+		assert.False(users[0].Active)
+		assert.Equal(u2.GetPublicId(), users[1].PublicId)
+		assert.Empty(users[1].ExternalId)
+		assert.True(users[1].Active)
+
+		users, total, err = repo.ListUsers(ctx, org.GetPublicId(), WithName("bob"))
+		require.NoError(err)
+		assert.Equal(1, total)
+		require.Len(users, 1)
+		assert.Equal(u2.GetPublicId(), users[0].PublicId)
+
+		users, total, err = repo.ListUsers(ctx, org.GetPublicId(), WithExternalId("ext-alice"))
+		require.NoError(err)
+		assert.Equal(1, total)
+		require.Len(users, 1)
+		assert.Equal(u1.GetPublicId(), users[0].PublicId)
+
+		users, total, err = repo.ListUsers(ctx, org.GetPublicId(), WithLimit(1), WithOffset(1))
+		require.NoError(err)
+		assert.Equal(2, total)
+		require.Len(users, 1)
+		assert.Equal(u2.GetPublicId(), users[0].PublicId)
+
+		got, err := repo.LookupProvisionedUser(ctx, org.GetPublicId(), u2.GetPublicId())
+		require.NoError(err)
+		assert.Equal("bob", got.Name)
+		got, err = repo.LookupProvisionedUser(ctx, org.GetPublicId(), "u_doesnotexist")
+		require.NoError(err)
+		assert.Nil(got)
+	})
+}
+
+func TestRepository_Groups(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	repo, err := NewRepository(ctx, rw, rw)
+	require.NoError(t, err)
+
+	u := iam.TestUser(t, iamRepo, org.GetPublicId())
+	g1 := iam.TestGroup(t, conn, org.GetPublicId(), iam.WithName("engineering"))
+	g2 := iam.TestGroup(t, conn, org.GetPublicId(), iam.WithName("sales"))
+	iam.TestGroupMember(t, conn, g1.GetPublicId(), u.GetPublicId())
+
+	assert, require := assert.New(t), require.New(t)
+
+	_, err = repo.SetGroup(ctx, &Group{})
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	_, err = repo.SetGroup(ctx, &Group{IamGroupId: g2.GetPublicId(), ExternalId: "ext-sales"})
+	require.NoError(err)
+	got, err := repo.LookupGroup(ctx, g2.GetPublicId())
+	require.NoError(err)
+	assert.Equal("ext-sales", got.ExternalId)
+
+	groups, total, err := repo.ListGroups(ctx, org.GetPublicId())
+	require.NoError(err)
+	assert.Equal(2, total)
+	require.Len(groups, 2)
+	assert.Equal([]string{u.GetPublicId()}, groups[0].MemberIds)
+	assert.Empty(groups[1].MemberIds)
+	assert.Equal("ext-sales", groups[1].ExternalId)
+
+	pg, err := repo.LookupProvisionedGroup(ctx, org.GetPublicId(), g1.GetPublicId())
+	require.NoError(err)
+	assert.Equal("engineering", pg.Name)
+	assert.Equal([]string{u.GetPublicId()}, pg.MemberIds)
+}
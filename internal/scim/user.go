@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scim
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// A User holds the SCIM attributes of an iam user. ExternalId is the
+// identifier of the user in the SCIM client and Active is false once the
+// SCIM client has deprovisioned the user.
+type User struct {
+	IamUserId  string               `gorm:"primary_key"`
+	CreateTime *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	UpdateTime *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	ExternalId string               `gorm:"default:null"`
+	Active     bool
+}
+
+// NewUser creates a new in memory active User for iamUserId.
+func NewUser(ctx context.Context, iamUserId string) (*User, error) {
+	const op = "scim.NewUser"
+	if iamUserId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing iam user id")
+	}
+	return &User{IamUserId: iamUserId, Active: true}, nil
+}
+
+// TableName returns the table name.
+func (u *User) TableName() string {
+	return "scim_user"
+}
+
+func (u *User) clone() *User {
+	cp := *u
+	return &cp
+}
+
+// A ProvisionedUser is an iam user along with its SCIM attributes.
+type ProvisionedUser struct {
+	PublicId    string
+	ScopeId     string
+	Name        string
+	Description string
+	Version     uint32
+	CreateTime  *timestamp.Timestamp
+	UpdateTime  *timestamp.Timestamp
+	ExternalId  string
+	Active      bool
+}
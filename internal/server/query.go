@@ -27,6 +27,13 @@ const (
  		where state = @state;
 	`
 
+	clearWorkerDrainDeadlineQuery = `
+		update server_worker
+		   set drain_deadline = null
+		 where public_id = @public_id
+		   and drain_deadline is not null;
+	`
+
 	getWorkerAuthsByWorkerKeyIdQuery = `
 		with key_id_to_worker_id as (
 		 select worker_id from worker_auth_authorized where worker_key_identifier = @worker_key_identifier
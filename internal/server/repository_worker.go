@@ -5,11 +5,14 @@ package server
 
 import (
 	"context"
+	"database/sql"
 	stderrors "errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/server/store"
@@ -386,6 +389,15 @@ func (r *Repository) UpsertWorkerStatus(ctx context.Context, worker *Worker, opt
 				}
 			}
 
+			// Once a worker reports that it is shutting down any drain that
+			// was requested has completed, so clear it to allow the worker to
+			// serve sessions again after it is restarted.
+			if workerClone.GetOperationalState() == ShutdownOperationalState.String() {
+				if _, err := w.Exec(ctx, clearWorkerDrainDeadlineQuery, []any{sql.Named("public_id", workerClone.GetPublicId())}); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to clear worker drain deadline"))
+				}
+			}
+
 			// If we've been told to update tags, we need to clean out old
 			// ones and add new ones. Within the current transaction, simply
 			// delete all tags for the given worker, then add the new ones
@@ -565,6 +577,58 @@ func (r *Repository) UpdateWorker(ctx context.Context, worker *Worker, version u
 	return ret, rowsUpdated, nil
 }
 
+// DrainWorker marks the worker as draining until the provided deadline. The
+// deadline is sent to the worker with its next status response, after which
+// the worker stops accepting new sessions and shuts down once its proxied
+// connections have closed or the deadline has passed. Workers that are
+// draining are not selected for new sessions.
+func (r *Repository) DrainWorker(ctx context.Context, publicId string, version uint32, deadline time.Time) (*Worker, int, error) {
+	const op = "server.(Repository).DrainWorker"
+	switch {
+	case publicId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	case version == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "version is zero")
+	case deadline.IsZero():
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing deadline")
+	}
+
+	var rowsUpdated int
+	var ret *Worker
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			worker := NewWorker(scope.Global.String())
+			worker.PublicId = publicId
+			worker.DrainDeadline = timestamp.New(deadline)
+			var err error
+			rowsUpdated, err = w.Update(ctx, worker, []string{"DrainDeadline"}, nil, db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated > 1 {
+				// return err, which will result in a rollback of the update
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+
+			wAgg := &workerAggregate{PublicId: publicId}
+			if err := reader.LookupById(ctx, wAgg); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if ret, err = wAgg.toWorker(ctx); err != nil {
+				return err
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for %s", publicId)))
+	}
+	return ret, rowsUpdated, nil
+}
+
 // CreateWorker will create a worker in the repository and return the written
 // worker.  Creating a worker is not intentionally oplogged.  A worker's
 // ReportedStatus and Tags are intentionally ignored when creating a worker (not
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package server
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// UpsertWorkerLoad stores l as the last load reported by the worker
+// l.WorkerId, replacing any previously stored load. Worker loads are
+// intentionally not oplogged.
+func (r *Repository) UpsertWorkerLoad(ctx context.Context, l *WorkerLoad) error {
+	const op = "server.(Repository).UpsertWorkerLoad"
+	if l == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing worker load")
+	}
+	if err := l.validate(ctx, op); err != nil {
+		return err
+	}
+
+	newLoad := l.clone()
+	newLoad.UpdateTime = nil
+	onConflict := &db.OnConflict{
+		Target: db.Columns{"worker_id"},
+		Action: db.SetColumns([]string{
+			"proxied_connection_count",
+			"cpu_utilization",
+			"memory_bytes",
+			"status_latency_ms",
+		}),
+	}
+	if err := r.writer.Create(ctx, newLoad, db.WithOnConflict(onConflict)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(l.WorkerId))
+	}
+	return nil
+}
+
+// ListWorkerLoads returns the last load reported by each of the workers in
+// workerIds. Workers which have not reported their load are omitted.
+func (r *Repository) ListWorkerLoads(ctx context.Context, workerIds []string) ([]*WorkerLoad, error) {
+	const op = "server.(Repository).ListWorkerLoads"
+	if len(workerIds) == 0 {
+		return nil, nil
+	}
+	var loads []*WorkerLoad
+	if err := r.reader.SearchWhere(ctx, &loads, "worker_id in (?)", []any{workerIds}, db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return loads, nil
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_WorkerLoad(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	ctx := context.Background()
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := server.NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	t.Run("invalid", func(t *testing.T) {
+		err := repo.UpsertWorkerLoad(ctx, nil)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+
+		err = repo.UpsertWorkerLoad(ctx, &server.WorkerLoad{})
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+
+		w := server.TestKmsWorker(t, conn, wrapper)
+		err = repo.UpsertWorkerLoad(ctx, &server.WorkerLoad{WorkerId: w.GetPublicId(), CpuUtilization: 101})
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	})
+
+	t.Run("upsert-and-list", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		w1 := server.TestKmsWorker(t, conn, wrapper)
+		w2 := server.TestKmsWorker(t, conn, wrapper)
+		w3 := server.TestKmsWorker(t, conn, wrapper)
+
+		loads, err := repo.ListWorkerLoads(ctx, nil)
+		require.NoError(err)
+		assert.Empty(loads)
+
+		l1, err := server.NewWorkerLoad(ctx, w1.GetPublicId())
+		require.NoError(err)
+		l1.ProxiedConnectionCount = 3
+		l1.CpuUtilization = 12.5
+		require.NoError(repo.UpsertWorkerLoad(ctx, l1))
+		require.NoError(repo.UpsertWorkerLoad(ctx, &server.WorkerLoad{WorkerId: w2.GetPublicId(), MemoryBytes: 1024}))
+
+		l1.ProxiedConnectionCount = 5
+		l1.StatusLatencyMs = 20
+		require.NoError(repo.UpsertWorkerLoad(ctx, l1))
+
+		loads, err = repo.ListWorkerLoads(ctx, []string{w1.GetPublicId(), w2.GetPublicId(), w3.GetPublicId()})
+		require.NoError(err)
+		require.Len(loads, 2)
+		byId := make(map[string]*server.WorkerLoad)
+		for _, l := range loads {
+			byId[l.WorkerId] = l
+			assert.NotNil(l.UpdateTime)
+		}
+		assert.Equal(uint64(5), byId[w1.GetPublicId()].ProxiedConnectionCount)
+		assert.Equal(12.5, byId[w1.GetPublicId()].CpuUtilization)
+		assert.Equal(uint64(20), byId[w1.GetPublicId()].StatusLatencyMs)
+		assert.Equal(uint64(1024), byId[w2.GetPublicId()].MemoryBytes)
+	})
+
+	t.Run("deleted-with-worker", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		w := server.TestKmsWorker(t, conn, wrapper)
+		require.NoError(repo.UpsertWorkerLoad(ctx, &server.WorkerLoad{WorkerId: w.GetPublicId(), ProxiedConnectionCount: 1}))
+
+		_, err := repo.DeleteWorker(ctx, w.GetPublicId())
+		require.NoError(err)
+		loads, err := repo.ListWorkerLoads(ctx, []string{w.GetPublicId()})
+		require.NoError(err)
+		assert.Empty(loads)
+	})
+}
@@ -1509,3 +1509,64 @@ func TestRepository_UpdateWorker(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_DrainWorker(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	require.NoError(t, kmsCache.CreateKeys(context.Background(), scope.Global.String(), kms.WithRandomReader(rand.Reader)))
+	repo, err := server.NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	t.Run("invalid", func(t *testing.T) {
+		w := server.TestPkiWorker(t, conn, wrapper)
+		deadline := time.Now().Add(time.Hour)
+
+		_, _, err := repo.DrainWorker(ctx, "", w.GetVersion(), deadline)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+		_, _, err = repo.DrainWorker(ctx, w.GetPublicId(), 0, deadline)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+		_, _, err = repo.DrainWorker(ctx, w.GetPublicId(), w.GetVersion(), time.Time{})
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	})
+
+	t.Run("bad-version", func(t *testing.T) {
+		w := server.TestPkiWorker(t, conn, wrapper)
+		_, n, err := repo.DrainWorker(ctx, w.GetPublicId(), w.GetVersion()+1, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Zero(t, n)
+	})
+
+	t.Run("drain-and-shutdown", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		w := server.TestKmsWorker(t, conn, wrapper)
+		assert.False(w.Draining())
+
+		deadline := time.Now().Add(time.Hour).Truncate(time.Second)
+		drained, n, err := repo.DrainWorker(ctx, w.GetPublicId(), w.GetVersion(), deadline)
+		require.NoError(err)
+		assert.Equal(1, n)
+		assert.True(drained.Draining())
+		assert.Equal(w.GetVersion()+1, drained.GetVersion())
+		assert.True(deadline.Equal(drained.GetDrainDeadline().AsTime()))
+
+		// A worker draining through the api is not selected for sessions but
+		// is still listed
+		workers, err := repo.ListWorkers(ctx, []string{scope.Global.String()}, server.WithWorkerPool([]string{w.GetPublicId()}))
+		require.NoError(err)
+		require.Len(workers, 1)
+		assert.True(workers[0].Draining())
+
+		// Reporting the shutdown state clears the drain deadline
+		status := server.NewWorker(scope.Global.String(),
+			server.WithName(w.GetName()),
+			server.WithAddress(w.GetAddress()),
+			server.WithOperationalState(server.ShutdownOperationalState.String()))
+		got, err := repo.UpsertWorkerStatus(ctx, status)
+		require.NoError(err)
+		assert.Nil(got.Worker.GetDrainDeadline())
+		assert.False(got.Draining())
+	})
+}
@@ -6,6 +6,7 @@ package server
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/fatih/structs"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
@@ -25,10 +26,15 @@ const (
 	KmsWorkerType            WorkerType       = "kms"
 	PkiWorkerType            WorkerType       = "pki"
 	ActiveOperationalState   OperationalState = "active"
+	DrainingOperationalState OperationalState = "draining"
 	ShutdownOperationalState OperationalState = "shutdown"
 	UnknownOperationalState  OperationalState = "unknown"
 )
 
+// DefaultDrainTimeout is how long a draining worker waits for its proxied
+// connections to close before they are terminated and the worker shuts down.
+const DefaultDrainTimeout = 30 * time.Minute
+
 func (t WorkerType) Valid() bool {
 	switch t {
 	case KmsWorkerType, PkiWorkerType:
@@ -51,7 +57,7 @@ type workerAuthWorkerId struct {
 
 func ValidOperationalState(s string) bool {
 	switch s {
-	case ActiveOperationalState.String(), ShutdownOperationalState.String():
+	case ActiveOperationalState.String(), DrainingOperationalState.String(), ShutdownOperationalState.String():
 		return true
 	}
 	return false
@@ -59,7 +65,7 @@ func ValidOperationalState(s string) bool {
 
 func (t OperationalState) String() string {
 	switch t {
-	case ActiveOperationalState, ShutdownOperationalState:
+	case ActiveOperationalState, DrainingOperationalState, ShutdownOperationalState:
 		return string(t)
 	}
 	return string(UnknownOperationalState)
@@ -210,6 +216,15 @@ func (w *Worker) GetLastStatusTime() *timestamp.Timestamp {
 	return w.Worker.GetLastStatusTime()
 }
 
+// Draining reports whether the worker has been asked to drain or has reported
+// that it is draining. Draining workers must not be given new sessions.
+func (w *Worker) Draining() bool {
+	if w == nil || w.Worker == nil {
+		return false
+	}
+	return w.GetOperationalState() == DrainingOperationalState.String() || w.Worker.GetDrainDeadline() != nil
+}
+
 // TableName overrides the table name used by Worker to `server_worker`
 func (Worker) TableName() string {
 	return "server_worker"
@@ -231,6 +246,7 @@ type workerAggregate struct {
 	ApiTags               string
 	ActiveConnectionCount uint32
 	OperationalState      string
+	DrainDeadline         *timestamp.Timestamp
 	// Config Fields
 	LastStatusTime   *timestamp.Timestamp
 	WorkerConfigTags string
@@ -252,6 +268,7 @@ func (a *workerAggregate) toWorker(ctx context.Context) (*Worker, error) {
 			Type:             a.Type,
 			ReleaseVersion:   a.ReleaseVersion,
 			OperationalState: a.OperationalState,
+			DrainDeadline:    a.DrainDeadline,
 		},
 		activeConnectionCount: a.ActiveConnectionCount,
 	}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// WorkerRoutingStrategy defines how a controller orders the workers which can
+// handle a session when authorizing the session. Clients try the workers in
+// the order they are given.
+type WorkerRoutingStrategy string
+
+const (
+	// RandomWorkerRouting orders the workers randomly. This is the default.
+	RandomWorkerRouting WorkerRoutingStrategy = "random"
+	// LeastLoadedWorkerRouting orders the workers by the load they reported in
+	// their status updates, least loaded first.
+	LeastLoadedWorkerRouting WorkerRoutingStrategy = "least_loaded"
+)
+
+// Valid reports whether s is a known strategy. The empty strategy is valid
+// and means RandomWorkerRouting.
+func (s WorkerRoutingStrategy) Valid() bool {
+	switch s {
+	case "", RandomWorkerRouting, LeastLoadedWorkerRouting:
+		return true
+	}
+	return false
+}
+
+func (s WorkerRoutingStrategy) String() string {
+	if s == "" {
+		return string(RandomWorkerRouting)
+	}
+	return string(s)
+}
+
+// The weights of each measurement in the load score of a worker. They add up
+// to 1.
+const (
+	connectionLoadWeight = 0.4
+	cpuLoadWeight        = 0.3
+	latencyLoadWeight    = 0.2
+	memoryLoadWeight     = 0.1
+)
+
+// WorkerLoad is the load a worker reported in its last status update.
+type WorkerLoad struct {
+	WorkerId   string               `gorm:"primary_key"`
+	UpdateTime *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	// ProxiedConnectionCount is the number of connections the worker was
+	// proxying.
+	ProxiedConnectionCount uint64
+	// CpuUtilization is the percentage of the CPUs available to the worker
+	// process used since its previous status update.
+	CpuUtilization float64
+	// MemoryBytes is the memory obtained from the operating system by the
+	// worker process and not released back.
+	MemoryBytes uint64
+	// StatusLatencyMs is the round trip time of the previous status update of
+	// the worker, in milliseconds.
+	StatusLatencyMs uint64
+}
+
+// NewWorkerLoad creates a new in memory WorkerLoad for workerId.
+func NewWorkerLoad(ctx context.Context, workerId string) (*WorkerLoad, error) {
+	const op = "server.NewWorkerLoad"
+	if workerId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing worker id")
+	}
+	return &WorkerLoad{WorkerId: workerId}, nil
+}
+
+// TableName returns the table name.
+func (l *WorkerLoad) TableName() string {
+	return "server_worker_load"
+}
+
+func (l *WorkerLoad) validate(ctx context.Context, op errors.Op) error {
+	switch {
+	case l.WorkerId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing worker id")
+	case l.CpuUtilization < 0 || l.CpuUtilization > 100:
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("cpu utilization %v is not a percentage", l.CpuUtilization))
+	}
+	return nil
+}
+
+func (l *WorkerLoad) clone() *WorkerLoad {
+	cp := *l
+	return &cp
+}
+
+// SortWorkersByLoad sorts workers by their load, least loaded first. The load
+// of a worker is a weighted score of its proxied connections, CPU utilization,
+// status latency and memory use, each relative to the highest value among
+// workers. Workers missing from loads are scored with their
+// ActiveConnectionCount and the average of the other measurements so that
+// they are neither preferred nor avoided. The sort is stable, so workers
+// should be shuffled first to spread sessions across equally loaded workers.
+func SortWorkersByLoad(workers []*Worker, loads []*WorkerLoad) {
+	byId := make(map[string]*WorkerLoad, len(loads))
+	for _, l := range loads {
+		byId[l.WorkerId] = l
+	}
+
+	conns := newLoadMeasurement()
+	cpu := newLoadMeasurement()
+	latency := newLoadMeasurement()
+	memory := newLoadMeasurement()
+	for _, w := range workers {
+		l, ok := byId[w.GetPublicId()]
+		if !ok {
+			conns.add(w.GetPublicId(), float64(w.ActiveConnectionCount()))
+			continue
+		}
+		conns.add(w.GetPublicId(), float64(l.ProxiedConnectionCount))
+		cpu.add(w.GetPublicId(), l.CpuUtilization)
+		latency.add(w.GetPublicId(), float64(l.StatusLatencyMs))
+		memory.add(w.GetPublicId(), float64(l.MemoryBytes))
+	}
+
+	scores := make(map[string]float64, len(workers))
+	for _, w := range workers {
+		id := w.GetPublicId()
+		scores[id] = connectionLoadWeight*conns.relative(id) +
+			cpuLoadWeight*cpu.relative(id) +
+			latencyLoadWeight*latency.relative(id) +
+			memoryLoadWeight*memory.relative(id)
+	}
+	slices.SortStableFunc(workers, func(a, b *Worker) int {
+		sa, sb := scores[a.GetPublicId()], scores[b.GetPublicId()]
+		switch {
+		case sa < sb:
+			return -1
+		case sa > sb:
+			return 1
+		}
+		return 0
+	})
+}
+
+// loadMeasurement holds one measurement of the load of a set of workers.
+type loadMeasurement struct {
+	values map[string]float64
+	max    float64
+	sum    float64
+}
+
+func newLoadMeasurement() *loadMeasurement {
+	return &loadMeasurement{values: make(map[string]float64)}
+}
+
+func (m *loadMeasurement) add(workerId string, v float64) {
+	m.values[workerId] = v
+	m.max = max(m.max, v)
+	m.sum += v
+}
+
+// relative returns the value for workerId as a fraction of the highest value,
+// or the average fraction if there is no value for workerId.
+func (m *loadMeasurement) relative(workerId string) float64 {
+	if m.max == 0 {
+		return 0
+	}
+	v, ok := m.values[workerId]
+	if !ok {
+		v = m.sum / float64(len(m.values))
+	}
+	return v / m.max
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package server
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerRoutingStrategy(t *testing.T) {
+	assert.True(t, WorkerRoutingStrategy("").Valid())
+	assert.True(t, RandomWorkerRouting.Valid())
+	assert.True(t, LeastLoadedWorkerRouting.Valid())
+	assert.False(t, WorkerRoutingStrategy("round_robin").Valid())
+
+	assert.Equal(t, "random", WorkerRoutingStrategy("").String())
+	assert.Equal(t, "least_loaded", LeastLoadedWorkerRouting.String())
+}
+
+func TestSortWorkersByLoad(t *testing.T) {
+	newWorker := func(id string, activeConns uint32) *Worker {
+		w := NewWorker(scope.Global.String())
+		w.PublicId = id
+		w.activeConnectionCount = activeConns
+		return w
+	}
+	ids := func(workers []*Worker) []string {
+		var ret []string
+		for _, w := range workers {
+			ret = append(ret, w.GetPublicId())
+		}
+		return ret
+	}
+
+	tests := []struct {
+		name    string
+		workers []*Worker
+		loads   []*WorkerLoad
+		want    []string
+	}{
+		{
+			name:    "no-loads-uses-active-connections",
+			workers: []*Worker{newWorker("w_1", 10), newWorker("w_2", 0), newWorker("w_3", 5)},
+			want:    []string{"w_2", "w_3", "w_1"},
+		},
+		{
+			name:    "equal-load-keeps-order",
+			workers: []*Worker{newWorker("w_1", 0), newWorker("w_2", 0), newWorker("w_3", 0)},
+			want:    []string{"w_1", "w_2", "w_3"},
+		},
+		{
+			name:    "proxied-connections",
+			workers: []*Worker{newWorker("w_1", 0), newWorker("w_2", 0)},
+			loads: []*WorkerLoad{
+				{WorkerId: "w_1", ProxiedConnectionCount: 20},
+				{WorkerId: "w_2", ProxiedConnectionCount: 2},
+			},
+			want: []string{"w_2", "w_1"},
+		},
+		{
+			name:    "cpu-outweighs-latency",
+			workers: []*Worker{newWorker("w_1", 0), newWorker("w_2", 0)},
+			loads: []*WorkerLoad{
+				{WorkerId: "w_1", CpuUtilization: 90, StatusLatencyMs: 10},
+				{WorkerId: "w_2", CpuUtilization: 10, StatusLatencyMs: 100},
+			},
+			want: []string{"w_2", "w_1"},
+		},
+		{
+			name:    "latency",
+			workers: []*Worker{newWorker("w_1", 0), newWorker("w_2", 0)},
+			loads: []*WorkerLoad{
+				{WorkerId: "w_1", CpuUtilization: 50, StatusLatencyMs: 500},
+				{WorkerId: "w_2", CpuUtilization: 50, StatusLatencyMs: 5},
+			},
+			want: []string{"w_2", "w_1"},
+		},
+		{
+			name:    "unreported-worker-is-average",
+			workers: []*Worker{newWorker("w_1", 0), newWorker("w_2", 0), newWorker("w_3", 0)},
+			loads: []*WorkerLoad{
+				{WorkerId: "w_1", CpuUtilization: 100, MemoryBytes: 1000},
+				{WorkerId: "w_3", CpuUtilization: 0, MemoryBytes: 10},
+			},
+			want: []string{"w_3", "w_2", "w_1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SortWorkersByLoad(tt.workers, tt.loads)
+			assert.Equal(t, tt.want, ids(tt.workers))
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// ActiveSessionLimits are the maximum numbers of active sessions checked when
+// creating a session. A session is active until it is terminated or expires.
+// A zero value means unlimited.
+type ActiveSessionLimits struct {
+	// PerUser limits the active sessions of the session's user to any
+	// target.
+	PerUser uint32
+	// PerTarget limits the active sessions of any user to the session's
+	// target.
+	PerTarget uint32
+	// PerUserTarget limits the active sessions of the session's user to the
+	// session's target.
+	PerUserTarget uint32
+}
+
+// IsUnlimited reports whether l does not limit any sessions.
+func (l ActiveSessionLimits) IsUnlimited() bool {
+	return l.PerUser == 0 && l.PerTarget == 0 && l.PerUserTarget == 0
+}
+
+// checkActiveSessionLimits returns an error with the SessionLimitExceeded code
+// if creating one more session for userId to targetId would exceed l. It must
+// be called in the transaction creating the session: the user and target rows
+// are locked until the end of the transaction so concurrent session creations
+// are counted one after the other.
+func checkActiveSessionLimits(ctx context.Context, w db.Writer, userId, targetId string, l ActiveSessionLimits) error {
+	const op = "session.checkActiveSessionLimits"
+	if l.IsUnlimited() {
+		return nil
+	}
+	args := []any{
+		sql.Named("user_id", userId),
+		sql.Named("target_id", targetId),
+	}
+	if l.PerUser > 0 {
+		if _, err := w.Exec(ctx, lockUserForActiveSessionCount, args); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	if l.PerTarget > 0 || l.PerUserTarget > 0 {
+		if _, err := w.Exec(ctx, lockTargetForActiveSessionCount, args); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+
+	rows, err := w.Query(ctx, countActiveSessions, args)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var counts struct {
+		UserCount       uint32
+		TargetCount     uint32
+		UserTargetCount uint32
+	}
+	for rows.Next() {
+		if err := w.ScanRows(ctx, rows, &counts); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	switch {
+	case l.PerUser > 0 && counts.UserCount >= l.PerUser:
+		return errors.New(ctx, errors.SessionLimitExceeded, op,
+			fmt.Sprintf("user %s has reached the limit of %d active sessions", userId, l.PerUser))
+	case l.PerTarget > 0 && counts.TargetCount >= l.PerTarget:
+		return errors.New(ctx, errors.SessionLimitExceeded, op,
+			fmt.Sprintf("target %s has reached the limit of %d active sessions", targetId, l.PerTarget))
+	case l.PerUserTarget > 0 && counts.UserTargetCount >= l.PerUserTarget:
+		return errors.New(ctx, errors.SessionLimitExceeded, op,
+			fmt.Sprintf("user %s has reached the limit of %d active sessions to target %s", userId, l.PerUserTarget, targetId))
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateSession_ActiveSessionLimits(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	// userA and userB are two users of the same target, and otherTarget is a
+	// second target userA can connect to.
+	userA := TestSessionParams(t, conn, wrapper, iamRepo)
+	other := TestSessionParams(t, conn, wrapper, iamRepo)
+	userB := userA
+	userB.UserId, userB.AuthTokenId = other.UserId, other.AuthTokenId
+	otherTarget := other
+	otherTarget.UserId, otherTarget.AuthTokenId = userA.UserId, userA.AuthTokenId
+
+	create := func(t *testing.T, c ComposedOf, l ActiveSessionLimits) (*Session, error) {
+		t.Helper()
+		s, err := New(ctx, c)
+		require.NoError(t, err)
+		return repo.CreateSession(ctx, wrapper, s, []string{"1.2.3.4"}, WithActiveSessionLimits(l))
+	}
+	wantLimitExceeded := func(t *testing.T, err error) {
+		t.Helper()
+		assert.Truef(t, errors.Match(errors.T(errors.SessionLimitExceeded), err), "want err code: %q got: %q", errors.SessionLimitExceeded, err)
+	}
+
+	var userASessions []*Session
+	t.Run("per-user-target", func(t *testing.T) {
+		l := ActiveSessionLimits{PerUserTarget: 2}
+		for i := 0; i < 2; i++ {
+			s, err := create(t, userA, l)
+			require.NoError(t, err)
+			userASessions = append(userASessions, s)
+		}
+		_, err := create(t, userA, l)
+		wantLimitExceeded(t, err)
+
+		// Other users are not limited by the sessions of userA.
+		_, err = create(t, userB, l)
+		require.NoError(t, err)
+	})
+
+	t.Run("per-target", func(t *testing.T) {
+		_, err := create(t, userB, ActiveSessionLimits{PerTarget: 3})
+		wantLimitExceeded(t, err)
+		_, err = create(t, otherTarget, ActiveSessionLimits{PerTarget: 3})
+		require.NoError(t, err)
+	})
+
+	t.Run("per-user", func(t *testing.T) {
+		_, err := create(t, otherTarget, ActiveSessionLimits{PerUser: 3})
+		wantLimitExceeded(t, err)
+		_, err = create(t, userB, ActiveSessionLimits{PerUser: 3})
+		require.NoError(t, err)
+	})
+
+	t.Run("terminated-sessions-not-counted", func(t *testing.T) {
+		_, err := repo.CancelSession(ctx, userASessions[0].PublicId, userASessions[0].Version)
+		require.NoError(t, err)
+		_, err = repo.TerminateCompletedSessions(ctx)
+		require.NoError(t, err)
+
+		_, err = create(t, userA, ActiveSessionLimits{PerUserTarget: 2})
+		require.NoError(t, err)
+	})
+
+	t.Run("unlimited", func(t *testing.T) {
+		_, err := create(t, userA, ActiveSessionLimits{})
+		require.NoError(t, err)
+	})
+}
@@ -14,12 +14,13 @@ import (
 type ClosedReason string
 
 const (
-	UnknownReason          ClosedReason = "unknown"
-	ConnectionTimedOut     ClosedReason = "timed out"
-	ConnectionClosedByUser ClosedReason = "closed by end-user"
-	ConnectionCanceled     ClosedReason = "canceled"
-	ConnectionNetworkError ClosedReason = "network error"
-	ConnectionSystemError  ClosedReason = "system error"
+	UnknownReason           ClosedReason = "unknown"
+	ConnectionTimedOut      ClosedReason = "timed out"
+	ConnectionClosedByUser  ClosedReason = "closed by end-user"
+	ConnectionCanceled      ClosedReason = "canceled"
+	ConnectionNetworkError  ClosedReason = "network error"
+	ConnectionSystemError   ClosedReason = "system error"
+	ConnectionQuotaExceeded ClosedReason = "quota exceeded"
 )
 
 // String representation of the termination reason
@@ -42,6 +43,8 @@ func convertToClosedReason(ctx context.Context, s string) (ClosedReason, error)
 		return ConnectionNetworkError, nil
 	case ConnectionSystemError.String():
 		return ConnectionSystemError, nil
+	case ConnectionQuotaExceeded.String():
+		return ConnectionQuotaExceeded, nil
 	default:
 		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s is not a valid reason", s))
 	}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// checkoutStaticCredentials checks out the static credentials of sessionId
+// which belong to a credential store with exclusive checkout enabled. It
+// returns an error with the CredentialCheckedOut code if any of them is
+// already checked out by another session. It must be called in the
+// transaction creating the session after the session's static credentials
+// have been written. The credentials are checked in when the session is
+// terminated.
+func checkoutStaticCredentials(ctx context.Context, w db.Writer, sessionId string) error {
+	const op = "session.checkoutStaticCredentials"
+	if sessionId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+	rows, err := w.Query(ctx, checkoutStaticCredentialsQuery, []any{sql.Named("session_id", sessionId)})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var refused []string
+	for rows.Next() {
+		var r struct {
+			CredentialId string
+		}
+		if err := w.ScanRows(ctx, rows, &r); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		refused = append(refused, r.CredentialId)
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if len(refused) > 0 {
+		return errors.New(ctx, errors.CredentialCheckedOut, op,
+			fmt.Sprintf("credentials %s are checked out by another session", strings.Join(refused, ", ")))
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+	"testing"
+
+	cred "github.com/hashicorp/boundary/internal/credential"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateSession_ExclusiveCheckout(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	targetRepo, err := target.NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	params := TestSessionParams(t, conn, wrapper, iamRepo)
+	exclusiveStore := credstatic.TestCredentialStore(t, conn, wrapper, params.ProjectId,
+		credstatic.WithRotator("test"), credstatic.WithExclusiveCheckout(true), credstatic.WithRotateOnCheckin(true))
+	exclusive := credstatic.TestUsernamePasswordCredential(t, conn, wrapper, "u", "p", exclusiveStore.GetPublicId(), params.ProjectId)
+	sharedStore := credstatic.TestCredentialStore(t, conn, wrapper, params.ProjectId)
+	shared := credstatic.TestUsernamePasswordCredential(t, conn, wrapper, "u", "p", sharedStore.GetPublicId(), params.ProjectId)
+
+	tar, err := targetRepo.LookupTarget(ctx, params.TargetId)
+	require.NoError(t, err)
+	_, err = targetRepo.AddTargetCredentialSources(ctx, tar.GetPublicId(), tar.GetVersion(), target.CredentialSources{
+		BrokeredCredentialIds: []string{exclusive.GetPublicId(), shared.GetPublicId()},
+	})
+	require.NoError(t, err)
+
+	create := func(t *testing.T, credIds ...string) (*Session, error) {
+		t.Helper()
+		c := params
+		c.StaticCredentials = nil
+		for _, id := range credIds {
+			c.StaticCredentials = append(c.StaticCredentials, NewStaticCredential(id, cred.BrokeredPurpose))
+		}
+		s, err := New(ctx, c)
+		require.NoError(t, err)
+		return repo.CreateSession(ctx, wrapper, s, []string{"1.2.3.4"})
+	}
+	countRows := func(t *testing.T, query string, args ...any) int {
+		t.Helper()
+		rows, err := rw.Query(ctx, query, args)
+		require.NoError(t, err)
+		defer rows.Close()
+		require.True(t, rows.Next())
+		var count int
+		require.NoError(t, rows.Scan(&count))
+		return count
+	}
+
+	holder, err := create(t, exclusive.GetPublicId(), shared.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, 1, countRows(t, `select count(*) from credential_static_checkout where session_id = ?`, holder.PublicId))
+
+	// A second session is refused the checked out credential
+	_, err = create(t, exclusive.GetPublicId())
+	assert.Truef(t, errors.Match(errors.T(errors.CredentialCheckedOut), err), "want err code: %q got: %q", errors.CredentialCheckedOut, err)
+
+	// Credentials of stores without exclusive checkout are shared
+	_, err = create(t, shared.GetPublicId())
+	require.NoError(t, err)
+
+	// Terminating the holder checks in the credential and requests its rotation
+	_, err = repo.CancelSession(ctx, holder.PublicId, holder.Version)
+	require.NoError(t, err)
+	_, err = repo.TerminateCompletedSessions(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, countRows(t, `select count(*) from credential_static_checkout where credential_id = ?`, exclusive.GetPublicId()))
+	assert.Equal(t, 1, countRows(t, `select count(*) from credential_static_rotation where credential_id = ? and rotation_requested`, exclusive.GetPublicId()))
+	assert.Equal(t, 0, countRows(t, `select count(*) from credential_static_rotation where credential_id = ?`, shared.GetPublicId()))
+
+	_, err = create(t, exclusive.GetPublicId())
+	require.NoError(t, err)
+}
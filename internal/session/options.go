@@ -42,6 +42,7 @@ type options struct {
 	withIgnoreDecryptionFailures bool
 	withRandomReader             io.Reader
 	withStartPageAfterItem       pagination.Item
+	withActiveSessionLimits      ActiveSessionLimits
 }
 
 func getDefaultOptions() options {
@@ -159,3 +160,11 @@ func WithStartPageAfterItem(item pagination.Item) Option {
 		o.withStartPageAfterItem = item
 	}
 }
+
+// WithActiveSessionLimits is used to limit the number of active sessions when
+// creating a session.
+func WithActiveSessionLimits(l ActiveSessionLimits) Option {
+	return func(o *options) {
+		o.withActiveSessionLimits = l
+	}
+}
@@ -159,6 +159,8 @@ from
           where
             us.public_id = cs.session_id
           ) then 'canceled' 
+        -- session byte quota exceeded
+        when session_byte_quota_exceeded(us.public_id) then 'quota exceeded'
         -- default: session connection limit reached.
         else 'connection limit'
       end
@@ -182,6 +184,8 @@ from
               sc.session_id = us.public_id
           ) >= connection_limit
         ) or 
+        -- session byte quota exceeded...
+        session_byte_quota_exceeded(us.public_id) or
         -- canceled sessions
         us.public_id in (
           select 
@@ -215,6 +219,7 @@ from
 	//	* sessions that are expired and all their connections are closed.
 	// 	* sessions that are canceling and all their connections are closed
 	//  * sessions that have exhausted their connection limit and all their connections are closed.
+	//  * sessions that have exceeded their session byte quota and all their connections are closed.
 	termSessionsUpdate = `
 with canceling_session(session_id) as
 (
@@ -240,6 +245,8 @@ update session us
 			where
 				us.public_id = cs.session_id
 			) then 'canceled'
+		-- session byte quota exceeded
+		when session_byte_quota_exceeded(us.public_id) then 'quota exceeded'
 		-- default: session connection limit reached.
 		else 'connection limit'
 	end
@@ -260,6 +267,8 @@ where
 				sc.session_id = us.public_id
 			) >= connection_limit
 		) or
+		-- session byte quota exceeded...
+		session_byte_quota_exceeded(us.public_id) or
 		-- canceled sessions
 		us.public_id in (
 			select
@@ -463,6 +472,68 @@ order by update_time desc, public_id desc;
 `
 )
 
+const (
+	// lockUserForActiveSessionCount and lockTargetForActiveSessionCount lock
+	// the user and the target of a new session so the active sessions of
+	// concurrent session creations are counted one after the other.
+	lockUserForActiveSessionCount = `
+select public_id
+  from iam_user
+ where public_id = @user_id
+   for no key update;
+`
+	lockTargetForActiveSessionCount = `
+select public_id
+  from target
+ where public_id = @target_id
+   for no key update;
+`
+
+	countActiveSessions = `
+select
+  count(*) filter (where user_id = @user_id)                              as user_count,
+  count(*) filter (where target_id = @target_id)                          as target_count,
+  count(*) filter (where user_id = @user_id and target_id = @target_id)   as user_target_count
+from
+  session
+where
+  termination_reason is null and
+  expiration_time > now() and
+  (user_id = @user_id or target_id = @target_id);
+`
+)
+
+const (
+	// checkoutStaticCredentialsQuery checks out the static credentials of a
+	// session which belong to a store with exclusive checkout enabled. It
+	// returns the credentials that could not be checked out because another
+	// session holds them.
+	checkoutStaticCredentialsQuery = `
+with exclusive as (
+  select distinct cred.credential_static_id as credential_id
+    from session_credential_static cred
+    join credential_static cs
+      on cs.public_id = cred.credential_static_id
+    join credential_static_store store
+      on store.public_id = cs.store_id
+   where cred.session_id = @session_id
+     and store.exclusive_checkout
+),
+checked_out as (
+  insert into credential_static_checkout
+    (credential_id, session_id)
+  select credential_id, @session_id
+    from exclusive
+  on conflict (credential_id) do nothing
+  returning credential_id
+)
+  select credential_id
+    from exclusive
+   where credential_id not in (select credential_id from checked_out)
+order by credential_id;
+`
+)
+
 const (
 	sessionCredentialDynamicBatchInsertBase = `
 insert into session_credential_dynamic
@@ -291,6 +291,9 @@ func (r *ConnectionRepository) ConnectConnection(ctx context.Context, c ConnectW
 type closeConnectionResp struct {
 	Connection       *Connection
 	ConnectionStates []*ConnectionState
+	// SessionTerminated is true if closing the connection terminated its
+	// session.
+	SessionTerminated bool
 }
 
 // closeConnections set's a connection's state to "closed" in the repo.  It's
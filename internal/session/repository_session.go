@@ -22,9 +22,12 @@ import (
 
 // CreateSession inserts into the repository and returns the new Session with
 // its State of "Pending".  The following fields must be empty when creating a
-// session: WorkerId, and PublicId.  No options are
-// currently supported.
-func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.Wrapper, newSession *Session, workerAddresses []string, _ ...Option) (*Session, error) {
+// session: WorkerId, and PublicId.  Supported options: WithActiveSessionLimits,
+// which makes the creation fail with a SessionLimitExceeded error if the new
+// session would exceed the limits. Static credentials in a store with exclusive
+// checkout enabled are checked out by the new session; the creation fails with
+// a CredentialCheckedOut error if one of them is held by another session.
+func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.Wrapper, newSession *Session, workerAddresses []string, opt ...Option) (*Session, error) {
 	const op = "session.(Repository).CreateSession"
 	if newSession == nil {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session")
@@ -63,6 +66,8 @@ func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing addresses")
 	}
 
+	opts := getOpts(opt...)
+
 	id, err := newId(ctx)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -85,6 +90,10 @@ func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.
 		db.StdRetryCnt,
 		db.ExpBackoff{},
 		func(read db.Reader, w db.Writer) error {
+			if err := checkActiveSessionLimits(ctx, w, newSession.UserId, newSession.TargetId, opts.withActiveSessionLimits); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+
 			returnedSession = newSession.Clone().(*Session)
 			returnedSession.DynamicCredentials = nil
 			returnedSession.StaticCredentials = nil
@@ -123,6 +132,17 @@ func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.
 				returnedSession.ProtocolWorkerId = swp.WorkerId
 			}
 
+			if l := newSession.TrafficLimit; l != nil {
+				tl, err := NewTrafficLimit(ctx, newSession.PublicId, l.ConnectionBandwidthLimit, l.SessionBandwidthLimit, l.ConnectionByteQuota, l.SessionByteQuota)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if err = w.Create(ctx, tl); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				returnedSession.TrafficLimit = tl
+			}
+
 			for _, cred := range newSession.DynamicCredentials {
 				cred.SessionId = newSession.PublicId
 			}
@@ -144,6 +164,10 @@ func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.
 					return errors.Wrap(ctx, err, op)
 				}
 				returnedSession.StaticCredentials = c
+
+				if err := checkoutStaticCredentials(ctx, w, newSession.PublicId); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
 			}
 
 			// TODO: after upgrading to gorm v2 this batch insert can be replaced, since gorm v2 supports batch inserts
@@ -247,6 +271,14 @@ func (r *Repository) LookupSession(ctx context.Context, sessionId string, opt ..
 			}
 			session.ProtocolWorkerId = sessionWorkerProtocol.WorkerId
 
+			trafficLimit := AllocTrafficLimit()
+			switch err := read.LookupWhere(ctx, trafficLimit, "session_id = ?", []any{sessionId}); {
+			case err == nil:
+				session.TrafficLimit = trafficLimit
+			case !errors.IsNotFoundError(err):
+				return errors.Wrap(ctx, err, op)
+			}
+
 			connections, err := fetchConnections(ctx, read, sessionId, db.WithOrder("create_time desc"))
 			if err != nil {
 				return errors.Wrap(ctx, err, op)
@@ -507,6 +539,7 @@ func (r *Repository) CancelSession(ctx context.Context, sessionId string, sessio
 //   - sessions that have exhausted their connection limit and all their connections are closed.
 //   - sessions that are expired and all their connections are closed.
 //   - sessions that are canceling and all their connections are closed
+//   - sessions that have exceeded their session byte quota and all their connections are closed.
 //
 // This function should called on a periodic basis a Controllers via it's
 // "ticker" pattern.
@@ -537,6 +570,7 @@ func (r *Repository) TerminateCompletedSessions(ctx context.Context) (int, error
 //   - sessions that have exhausted their connection limit and all their connections are closed.
 //   - sessions that are expired and all their connections are closed.
 //   - sessions that are canceling and all their connections are closed
+//   - sessions that have exceeded their session byte quota and all their connections are closed.
 func (r *Repository) terminateSessionIfPossible(ctx context.Context, sessionId string) (int, error) {
 	const op = "session.(Repository).terminateSessionIfPossible"
 	rowsAffected := 0
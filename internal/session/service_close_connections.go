@@ -12,6 +12,7 @@ import (
 // CloseConnections is a domain service function that:
 // * closes requested connections
 // * uses the sessionId of the connection to see if the session meets conditions for termination
+// * reports in the response of each connection if its session was terminated
 func CloseConnections(ctx context.Context, sessionRepoFn *Repository, connectionRepoFn *ConnectionRepository,
 	closeWiths []CloseWith,
 ) ([]closeConnectionResp, error) {
@@ -24,12 +25,18 @@ func CloseConnections(ctx context.Context, sessionRepoFn *Repository, connection
 
 	// Attempt to terminate only once per sessionId
 	sessionIdsProcessed := make(map[string]bool)
+	sessionIdsTerminated := make(map[string]bool)
 	for _, c := range closeInfos {
 		if !sessionIdsProcessed[c.Connection.SessionId] {
-			sessionRepoFn.terminateSessionIfPossible(ctx, c.Connection.SessionId)
+			if n, _ := sessionRepoFn.terminateSessionIfPossible(ctx, c.Connection.SessionId); n > 0 {
+				sessionIdsTerminated[c.Connection.SessionId] = true
+			}
 			sessionIdsProcessed[c.Connection.SessionId] = true
 		}
 	}
+	for i := range closeInfos {
+		closeInfos[i].SessionTerminated = sessionIdsTerminated[closeInfos[i].Connection.SessionId]
+	}
 
 	return closeInfos, nil
 }
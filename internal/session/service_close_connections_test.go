@@ -133,6 +133,7 @@ func TestServiceCloseConnections(t *testing.T) {
 				require.NotNil(r.Connection)
 				require.NotNil(r.ConnectionStates)
 				assert.Equal(StatusClosed, r.ConnectionStates[0].Status)
+				assert.Equal(tt.wantClosedSession, r.SessionTerminated)
 			}
 
 			// Ensure session is in the state we want- terminated if all conns closed, else active
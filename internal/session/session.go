@@ -66,6 +66,9 @@ type ComposedOf struct {
 	StaticCredentials []*StaticCredential
 	// Which worker is performing protocol-related tasks
 	ProtocolWorkerId string
+	// TrafficLimit is the bandwidth limits and byte quotas of the session.
+	// TrafficLimit is optional.
+	TrafficLimit *TrafficLimit
 }
 
 // Session contains information about a user's session with a target
@@ -135,6 +138,9 @@ type Session struct {
 	// ProtocolWorkerId of the session
 	ProtocolWorkerId string `gorm:"-"`
 
+	// TrafficLimit of the session, nil if the session is not limited
+	TrafficLimit *TrafficLimit `gorm:"-"`
+
 	// Connections for the session are for read only and are ignored during write operations
 	Connections []*Connection `gorm:"-"`
 
@@ -205,6 +211,7 @@ func New(ctx context.Context, c ComposedOf, _ ...Option) (*Session, error) {
 		DynamicCredentials:  c.DynamicCredentials,
 		StaticCredentials:   c.StaticCredentials,
 		ProtocolWorkerId:    c.ProtocolWorkerId,
+		TrafficLimit:        c.TrafficLimit,
 	}
 	if err := s.validateNewSession(ctx); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -237,6 +244,9 @@ func (s *Session) Clone() any {
 		KeyId:               s.KeyId,
 		ProtocolWorkerId:    s.ProtocolWorkerId,
 	}
+	if s.TrafficLimit != nil {
+		clone.TrafficLimit = s.TrafficLimit.Clone().(*TrafficLimit)
+	}
 	if len(s.States) > 0 {
 		clone.States = make([]*State, 0, len(s.States))
 		for _, ss := range s.States {
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+const (
+	defaultSessionTrafficLimitTableName = "session_traffic_limit"
+)
+
+// TrafficLimit contains the bandwidth limits, in bytes per second, and the
+// byte quotas enforced by workers on the connections of a session. A zero
+// value for any setting means unlimited. It is copied from the traffic policy
+// of the target when the session is authorized.
+type TrafficLimit struct {
+	// SessionId of the session
+	SessionId string `json:"session_id,omitempty" gorm:"primary_key"`
+	// ConnectionBandwidthLimit is the maximum bytes per second of a single
+	// connection
+	ConnectionBandwidthLimit uint64 `json:"connection_bandwidth_limit,omitempty"`
+	// SessionBandwidthLimit is the maximum bytes per second of all
+	// connections of the session
+	SessionBandwidthLimit uint64 `json:"session_bandwidth_limit,omitempty"`
+	// ConnectionByteQuota is the maximum bytes transferred by a single
+	// connection
+	ConnectionByteQuota uint64 `json:"connection_byte_quota,omitempty"`
+	// SessionByteQuota is the maximum bytes transferred by all connections of
+	// the session
+	SessionByteQuota uint64 `json:"session_byte_quota,omitempty"`
+
+	tableName string `gorm:"-"`
+}
+
+// NewTrafficLimit creates a new in-memory traffic limit for a session
+func NewTrafficLimit(ctx context.Context, sessionId string, connectionBandwidthLimit, sessionBandwidthLimit, connectionByteQuota, sessionByteQuota uint64) (*TrafficLimit, error) {
+	const op = "session.NewTrafficLimit"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+	return &TrafficLimit{
+		SessionId:                sessionId,
+		ConnectionBandwidthLimit: connectionBandwidthLimit,
+		SessionBandwidthLimit:    sessionBandwidthLimit,
+		ConnectionByteQuota:      connectionByteQuota,
+		SessionByteQuota:         sessionByteQuota,
+	}, nil
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (l *TrafficLimit) TableName() string {
+	if l.tableName != "" {
+		return l.tableName
+	}
+	return defaultSessionTrafficLimitTableName
+}
+
+// SetTableName sets the tablename and satisfies the ReplayableMessage
+// interface. If the caller attempts to set the name to "" the name will be
+// reset to the default name.
+func (l *TrafficLimit) SetTableName(n string) {
+	l.tableName = n
+}
+
+// AllocTrafficLimit will allocate a TrafficLimit
+func AllocTrafficLimit() *TrafficLimit {
+	return &TrafficLimit{}
+}
+
+// Clone creates a clone of the TrafficLimit
+func (l *TrafficLimit) Clone() any {
+	clone := &TrafficLimit{
+		SessionId:                l.SessionId,
+		ConnectionBandwidthLimit: l.ConnectionBandwidthLimit,
+		SessionBandwidthLimit:    l.SessionBandwidthLimit,
+		ConnectionByteQuota:      l.ConnectionByteQuota,
+		SessionByteQuota:         l.SessionByteQuota,
+	}
+	return clone
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTrafficLimit(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewTrafficLimit(ctx, "", 1, 2, 3, 4)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+
+	got, err := NewTrafficLimit(ctx, "s_1234567890", 1, 2, 3, 4)
+	require.NoError(t, err)
+	assert.Equal(t, &TrafficLimit{
+		SessionId:                "s_1234567890",
+		ConnectionBandwidthLimit: 1,
+		SessionBandwidthLimit:    2,
+		ConnectionByteQuota:      3,
+		SessionByteQuota:         4,
+	}, got)
+	assert.Equal(t, got, got.Clone())
+}
+
+func TestRepository_TrafficLimit(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+	connRepo, err := NewConnectionRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	setupFn := func(t *testing.T, l *TrafficLimit, bytesUp, bytesDown int64) *Session {
+		t.Helper()
+		composedOf := TestSessionParams(t, conn, wrapper, iamRepo)
+		composedOf.ConnectionLimit = -1
+		composedOf.TrafficLimit = l
+		s := TestSession(t, conn, wrapper, composedOf)
+		s, _, err := repo.ActivateSession(ctx, s.PublicId, s.Version, TestTofu(t))
+		require.NoError(t, err)
+		c := TestConnection(t, conn, s.PublicId, "127.0.0.1", 22, "127.0.0.1", 222, "127.0.0.1")
+		_, err = connRepo.closeConnections(ctx, []CloseWith{{
+			ConnectionId: c.PublicId,
+			BytesUp:      bytesUp,
+			BytesDown:    bytesDown,
+			ClosedReason: ConnectionQuotaExceeded,
+		}})
+		require.NoError(t, err)
+		return s
+	}
+
+	t.Run("lookup", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s := setupFn(t, &TrafficLimit{ConnectionBandwidthLimit: 10, SessionByteQuota: 100}, 1, 1)
+		got, _, err := repo.LookupSession(ctx, s.PublicId)
+		require.NoError(err)
+		require.NotNil(got.TrafficLimit)
+		assert.Equal(uint64(10), got.TrafficLimit.ConnectionBandwidthLimit)
+		assert.Equal(uint64(100), got.TrafficLimit.SessionByteQuota)
+
+		unlimited := setupFn(t, nil, 1, 1)
+		got, _, err = repo.LookupSession(ctx, unlimited.PublicId)
+		require.NoError(err)
+		assert.Nil(got.TrafficLimit)
+	})
+
+	t.Run("terminate", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		exceeded := setupFn(t, &TrafficLimit{SessionByteQuota: 100}, 60, 40)
+		remaining := setupFn(t, &TrafficLimit{SessionByteQuota: 100}, 60, 39)
+		unlimited := setupFn(t, &TrafficLimit{ConnectionByteQuota: 10}, 60, 40)
+
+		_, err := repo.TerminateCompletedSessions(ctx)
+		require.NoError(err)
+
+		got, _, err := repo.LookupSession(ctx, exceeded.PublicId)
+		require.NoError(err)
+		assert.Equal(QuotaExceeded.String(), got.TerminationReason)
+		for _, id := range []string{remaining.PublicId, unlimited.PublicId} {
+			got, _, err := repo.LookupSession(ctx, id)
+			require.NoError(err)
+			assert.Empty(got.TerminationReason)
+		}
+	})
+}
@@ -13,6 +13,9 @@ type StaticCredential struct {
 	SessionId          string `json:"session_id,omitempty" gorm:"primary_key"`
 	CredentialPurpose  string `json:"credential_purpose,omitempty" gorm:"primary_key"`
 	CredentialStaticId string `json:"credential_id,omitempty" gorm:"default:null"`
+	// CredentialVersion is the version of the static credential given to the
+	// session. It is set by the database when the session is created.
+	CredentialVersion uint32 `json:"credential_version,omitempty" gorm:"default:null"`
 
 	tableName string `gorm:"-"`
 }
@@ -31,6 +34,7 @@ func (c *StaticCredential) clone() *StaticCredential {
 		SessionId:          c.SessionId,
 		CredentialPurpose:  c.CredentialPurpose,
 		CredentialStaticId: c.CredentialStaticId,
+		CredentialVersion:  c.CredentialVersion,
 	}
 }
 
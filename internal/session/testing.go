@@ -153,6 +153,14 @@ func TestSession(t testing.TB, conn *db.DB, rootWrapper wrapping.Wrapper, c Comp
 		require.NoError(err)
 	}
 
+	if l := s.TrafficLimit; l != nil {
+		tl, err := NewTrafficLimit(ctx, s.PublicId, l.ConnectionBandwidthLimit, l.SessionBandwidthLimit, l.ConnectionByteQuota, l.SessionByteQuota)
+		require.NoError(err)
+		err = rw.Create(ctx, tl)
+		require.NoError(err)
+		s.TrafficLimit = tl
+	}
+
 	ss, err := fetchStates(ctx, rw, s.PublicId, append(opts.withDbOpts, db.WithOrder("start_time desc"))...)
 	require.NoError(err)
 	s.States = ss
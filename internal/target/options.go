@@ -56,6 +56,8 @@ type options struct {
 	WithNetResolver            intglobals.NetIpResolver
 	WithStartPageAfterItem     pagination.Item
 	withAliases                []*talias.Alias
+	withTrafficPolicy          *TrafficPolicy
+	withSessionPolicy          *SessionPolicy
 }
 
 func getDefaultOptions() options {
@@ -284,3 +286,19 @@ func WithAliases(in []*talias.Alias) Option {
 		o.withAliases = in
 	}
 }
+
+// WithTrafficPolicy provides an option to set the traffic policy of a target
+// in the same transaction as the target is updated.
+func WithTrafficPolicy(p *TrafficPolicy) Option {
+	return func(o *options) {
+		o.withTrafficPolicy = p
+	}
+}
+
+// WithSessionPolicy provides an option to set the session policy of a target
+// in the same transaction as the target is updated.
+func WithSessionPolicy(p *SessionPolicy) Option {
+	return func(o *options) {
+		o.withSessionPolicy = p
+	}
+}
@@ -628,7 +628,12 @@ func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Opt
 // included in fieldMask. Name, Description, and WorkerFilter are the only
 // updatable fields. If no updatable fields are included in the fieldMaskPaths,
 // then an error is returned.
-func (r *Repository) UpdateTarget(ctx context.Context, target Target, version uint32, fieldMaskPaths []string, _ ...Option) (Target, int, error) {
+//
+// WithTrafficPolicy and WithSessionPolicy are the only valid options. The
+// policies, which must be for target, are set in the same transaction and the
+// fieldMaskPaths may be empty. The version of the target is incremented when
+// only the policies are set.
+func (r *Repository) UpdateTarget(ctx context.Context, target Target, version uint32, fieldMaskPaths []string, opt ...Option) (Target, int, error) {
 	const op = "target.(Repository).UpdateTarget"
 	if target == nil {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing target")
@@ -642,6 +647,14 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 	if version == 0 {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
 	}
+	opts := GetOpts(opt...)
+	if opts.withTrafficPolicy != nil && opts.withTrafficPolicy.TargetId != target.GetPublicId() {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "traffic policy is not for target")
+	}
+	if opts.withSessionPolicy != nil && opts.withSessionPolicy.TargetId != target.GetPublicId() {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "session policy is not for target")
+	}
+	setPolicies := opts.withTrafficPolicy != nil || opts.withSessionPolicy != nil
 	vet, ok := subtypeRegistry.vetForUpdateFunc(target.GetType())
 	if !ok {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported target type %s", target.GetType()))
@@ -691,7 +704,7 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 		fieldMaskPaths,
 		[]string{"SessionMaxSeconds", "SessionConnectionLimit", "EnableSessionRecording"},
 	)
-	if len(dbMask) == 0 && len(nullFields) == 0 {
+	if len(dbMask) == 0 && len(nullFields) == 0 && !setPolicies {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
 	}
 
@@ -717,10 +730,10 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 		}
 	}
 
-	// If the Address field is the only present change, then we must still
-	// update the target's version because a target address is a child object
-	// of the target.
-	if (len(filteredDbMask) == 0 && len(filteredNullFields) == 0) && (updateAddress || deleteAddress) {
+	// If the Address field or the policies are the only present change, then
+	// we must still update the target's version because a target address and
+	// the policies are child objects of the target.
+	if (len(filteredDbMask) == 0 && len(filteredNullFields) == 0) && (updateAddress || deleteAddress || setPolicies) {
 		target.SetVersion(version + 1)
 		filteredDbMask = append(filteredDbMask, "Version")
 	}
@@ -750,6 +763,16 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 			if rowsUpdated > 1 {
 				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 target resource would have been updated")
 			}
+			if rowsUpdated == 1 && opts.withTrafficPolicy != nil {
+				if _, err := setTrafficPolicy(ctx, w, opts.withTrafficPolicy); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to set traffic policy"))
+				}
+			}
+			if rowsUpdated == 1 && opts.withSessionPolicy != nil {
+				if _, err := setSessionPolicy(ctx, w, opts.withSessionPolicy); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to set session policy"))
+				}
+			}
 
 			if hostSources, err = fetchHostSources(ctx, read, t.GetPublicId()); err != nil {
 				return errors.Wrap(ctx, err, op)
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package target
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// GetSessionPolicy returns the session policy for targetId. If no policy has
+// been set for targetId, a SessionPolicy with every setting unlimited is
+// returned.
+func (r *Repository) GetSessionPolicy(ctx context.Context, targetId string) (*SessionPolicy, error) {
+	const op = "target.(Repository).GetSessionPolicy"
+	if targetId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+	p := &SessionPolicy{}
+	if err := r.reader.LookupWhere(ctx, p, "target_id = ?", []any{targetId}); err != nil {
+		if !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return &SessionPolicy{TargetId: targetId}, nil
+	}
+	return p, nil
+}
+
+// SetSessionPolicy sets the session policy for p.TargetId to p and returns
+// the stored SessionPolicy. p is not changed. Every setting in p replaces the
+// current setting, so to change a single setting the current SessionPolicy
+// should be retrieved with GetSessionPolicy and modified.
+func (r *Repository) SetSessionPolicy(ctx context.Context, p *SessionPolicy) (*SessionPolicy, error) {
+	const op = "target.(Repository).SetSessionPolicy"
+	if p == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing policy")
+	}
+	if p.TargetId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+
+	var newPolicy *SessionPolicy
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			if newPolicy, err = setSessionPolicy(ctx, w, p); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(p.TargetId))
+	}
+	return newPolicy, nil
+}
+
+// setSessionPolicy upserts p using w, which must be within a transaction, and
+// returns the stored SessionPolicy. p is not changed.
+func setSessionPolicy(ctx context.Context, w db.Writer, p *SessionPolicy) (*SessionPolicy, error) {
+	const op = "target.setSessionPolicy"
+	newPolicy := p.clone()
+	newPolicy.CreateTime, newPolicy.UpdateTime = nil, nil
+	onConflict := &db.OnConflict{
+		Target: db.Columns{"target_id"},
+		Action: db.SetColumns([]string{
+			"max_active_sessions",
+			"max_active_sessions_per_user",
+		}),
+	}
+	if err := w.Create(ctx, newPolicy, db.WithOnConflict(onConflict)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return newPolicy, nil
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package target_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/targettest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_SessionPolicy(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	repo, err := target.NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+
+	t.Run("invalid", func(t *testing.T) {
+		assert := assert.New(t)
+		_, err := repo.GetSessionPolicy(ctx, "")
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+		_, err = repo.SetSessionPolicy(ctx, nil)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+		_, err = repo.SetSessionPolicy(ctx, &target.SessionPolicy{})
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	})
+
+	t.Run("get-and-set", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tg := targettest.TestNewTestTarget(ctx, t, conn, proj.GetPublicId(), "sessions")
+
+		got, err := repo.GetSessionPolicy(ctx, tg.GetPublicId())
+		require.NoError(err)
+		assert.Equal(&target.SessionPolicy{TargetId: tg.GetPublicId()}, got)
+		assert.True(got.IsUnlimited())
+
+		_, err = repo.SetSessionPolicy(ctx, &target.SessionPolicy{TargetId: tg.GetPublicId(), MaxActiveSessions: 10})
+		require.NoError(err)
+		_, err = repo.SetSessionPolicy(ctx, &target.SessionPolicy{TargetId: tg.GetPublicId(), MaxActiveSessionsPerUser: 2})
+		require.NoError(err)
+		got, err = repo.GetSessionPolicy(ctx, tg.GetPublicId())
+		require.NoError(err)
+		assert.Equal(uint32(0), got.MaxActiveSessions)
+		assert.Equal(uint32(2), got.MaxActiveSessionsPerUser)
+		assert.False(got.IsUnlimited())
+	})
+
+	t.Run("deleted-with-target", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tg := targettest.TestNewTestTarget(ctx, t, conn, proj.GetPublicId(), "sessions-deleted")
+		_, err := repo.SetSessionPolicy(ctx, &target.SessionPolicy{TargetId: tg.GetPublicId(), MaxActiveSessions: 1})
+		require.NoError(err)
+
+		_, err = repo.DeleteTarget(ctx, tg.GetPublicId())
+		require.NoError(err)
+		var policies []*target.SessionPolicy
+		require.NoError(rw.SearchWhere(ctx, &policies, "target_id = ?", []any{tg.GetPublicId()}))
+		assert.Empty(policies)
+	})
+}
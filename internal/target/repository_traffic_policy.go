@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package target
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// GetTrafficPolicy returns the traffic policy for targetId. If no policy has
+// been set for targetId, a TrafficPolicy with every setting unlimited is
+// returned.
+func (r *Repository) GetTrafficPolicy(ctx context.Context, targetId string) (*TrafficPolicy, error) {
+	const op = "target.(Repository).GetTrafficPolicy"
+	if targetId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+	p := &TrafficPolicy{}
+	if err := r.reader.LookupWhere(ctx, p, "target_id = ?", []any{targetId}); err != nil {
+		if !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return &TrafficPolicy{TargetId: targetId}, nil
+	}
+	return p, nil
+}
+
+// SetTrafficPolicy sets the traffic policy for p.TargetId to p and returns
+// the stored TrafficPolicy. p is not changed. Every setting in p replaces the
+// current setting, so to change a single setting the current TrafficPolicy
+// should be retrieved with GetTrafficPolicy and modified.
+func (r *Repository) SetTrafficPolicy(ctx context.Context, p *TrafficPolicy) (*TrafficPolicy, error) {
+	const op = "target.(Repository).SetTrafficPolicy"
+	if p == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing policy")
+	}
+	if p.TargetId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+
+	var newPolicy *TrafficPolicy
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			if newPolicy, err = setTrafficPolicy(ctx, w, p); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(p.TargetId))
+	}
+	return newPolicy, nil
+}
+
+// setTrafficPolicy upserts p using w, which must be within a transaction, and
+// returns the stored TrafficPolicy. p is not changed.
+func setTrafficPolicy(ctx context.Context, w db.Writer, p *TrafficPolicy) (*TrafficPolicy, error) {
+	const op = "target.setTrafficPolicy"
+	newPolicy := p.clone()
+	newPolicy.CreateTime, newPolicy.UpdateTime = nil, nil
+	onConflict := &db.OnConflict{
+		Target: db.Columns{"target_id"},
+		Action: db.SetColumns([]string{
+			"connection_bandwidth_limit",
+			"session_bandwidth_limit",
+			"connection_byte_quota",
+			"session_byte_quota",
+		}),
+	}
+	if err := w.Create(ctx, newPolicy, db.WithOnConflict(onConflict)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return newPolicy, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package target_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/targettest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_TrafficPolicy(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	repo, err := target.NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+
+	t.Run("invalid", func(t *testing.T) {
+		assert := assert.New(t)
+		_, err := repo.GetTrafficPolicy(ctx, "")
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+		_, err = repo.SetTrafficPolicy(ctx, nil)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+		_, err = repo.SetTrafficPolicy(ctx, &target.TrafficPolicy{})
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	})
+
+	t.Run("get-and-set", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tg := targettest.TestNewTestTarget(ctx, t, conn, proj.GetPublicId(), "traffic")
+
+		got, err := repo.GetTrafficPolicy(ctx, tg.GetPublicId())
+		require.NoError(err)
+		assert.Equal(&target.TrafficPolicy{TargetId: tg.GetPublicId()}, got)
+		assert.True(got.IsUnlimited())
+
+		_, err = repo.SetTrafficPolicy(ctx, &target.TrafficPolicy{TargetId: tg.GetPublicId(), ConnectionBandwidthLimit: 1024, SessionByteQuota: 1 << 20})
+		require.NoError(err)
+		_, err = repo.SetTrafficPolicy(ctx, &target.TrafficPolicy{TargetId: tg.GetPublicId(), SessionBandwidthLimit: 2048, ConnectionByteQuota: 1 << 32})
+		require.NoError(err)
+		got, err = repo.GetTrafficPolicy(ctx, tg.GetPublicId())
+		require.NoError(err)
+		assert.Equal(uint64(0), got.ConnectionBandwidthLimit)
+		assert.Equal(uint64(2048), got.SessionBandwidthLimit)
+		assert.Equal(uint64(1<<32), got.ConnectionByteQuota)
+		assert.Equal(uint64(0), got.SessionByteQuota)
+		assert.False(got.IsUnlimited())
+	})
+
+	t.Run("update-target", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tg := targettest.TestNewTestTarget(ctx, t, conn, proj.GetPublicId(), "traffic-updated")
+
+		_, _, err := repo.UpdateTarget(ctx, tg, tg.GetVersion(), nil, target.WithTrafficPolicy(&target.TrafficPolicy{TargetId: "ttcp_other"}))
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+
+		// Setting only the policy increments the version of the target.
+		version := tg.GetVersion()
+		updated, rowsUpdated, err := repo.UpdateTarget(ctx, tg, version, nil, target.WithTrafficPolicy(&target.TrafficPolicy{TargetId: tg.GetPublicId(), SessionByteQuota: 10}))
+		require.NoError(err)
+		assert.Equal(1, rowsUpdated)
+		assert.Equal(version+1, updated.GetVersion())
+		got, err := repo.GetTrafficPolicy(ctx, tg.GetPublicId())
+		require.NoError(err)
+		assert.Equal(uint64(10), got.SessionByteQuota)
+
+		// A stale version does not change the policy.
+		_, rowsUpdated, err = repo.UpdateTarget(ctx, tg, version, nil, target.WithTrafficPolicy(&target.TrafficPolicy{TargetId: tg.GetPublicId(), SessionByteQuota: 20}))
+		require.NoError(err)
+		assert.Equal(0, rowsUpdated)
+		got, err = repo.GetTrafficPolicy(ctx, tg.GetPublicId())
+		require.NoError(err)
+		assert.Equal(uint64(10), got.SessionByteQuota)
+	})
+
+	t.Run("deleted-with-target", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tg := targettest.TestNewTestTarget(ctx, t, conn, proj.GetPublicId(), "traffic-deleted")
+		_, err := repo.SetTrafficPolicy(ctx, &target.TrafficPolicy{TargetId: tg.GetPublicId(), SessionByteQuota: 10})
+		require.NoError(err)
+
+		_, err = repo.DeleteTarget(ctx, tg.GetPublicId())
+		require.NoError(err)
+		var policies []*target.TrafficPolicy
+		require.NoError(rw.SearchWhere(ctx, &policies, "target_id = ?", []any{tg.GetPublicId()}))
+		assert.Empty(policies)
+	})
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package target
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// A SessionPolicy contains the limits on the number of active sessions to a
+// target. A session is active until it is terminated or expires. A zero value
+// for any setting means unlimited.
+//
+// MaxActiveSessions is the maximum number of active sessions to the target
+// and MaxActiveSessionsPerUser is the maximum number of active sessions a
+// single user can have to the target.
+//
+// The limits are checked when a session is authorized, so lowering them does
+// not affect the sessions that are already active.
+type SessionPolicy struct {
+	TargetId                 string               `gorm:"primary_key"`
+	CreateTime               *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	UpdateTime               *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	MaxActiveSessions        uint32
+	MaxActiveSessionsPerUser uint32
+}
+
+// NewSessionPolicy creates a new in memory SessionPolicy for targetId with
+// every setting unlimited.
+func NewSessionPolicy(ctx context.Context, targetId string) (*SessionPolicy, error) {
+	const op = "target.NewSessionPolicy"
+	if targetId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+	return &SessionPolicy{TargetId: targetId}, nil
+}
+
+// TableName returns the table name.
+func (p *SessionPolicy) TableName() string {
+	return "target_session_policy"
+}
+
+// IsUnlimited reports whether p does not limit any sessions.
+func (p *SessionPolicy) IsUnlimited() bool {
+	return p.MaxActiveSessions == 0 && p.MaxActiveSessionsPerUser == 0
+}
+
+func (p *SessionPolicy) clone() *SessionPolicy {
+	cp := *p
+	return &cp
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package target
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// A TrafficPolicy contains the bandwidth limits and byte quotas workers
+// enforce on the connections to a target. A zero value for any setting means
+// unlimited.
+//
+// ConnectionBandwidthLimit and SessionBandwidthLimit are the maximum number of
+// bytes per second, in both directions combined, transferred by a single
+// connection and by all connections of a session.
+//
+// ConnectionByteQuota and SessionByteQuota are the total number of bytes, in
+// both directions combined, a single connection and all connections of a
+// session can transfer. Connections are closed when a quota is exceeded.
+//
+// The policy is copied into a session when the session is authorized, so
+// changes only apply to sessions authorized afterwards.
+type TrafficPolicy struct {
+	TargetId                 string               `gorm:"primary_key"`
+	CreateTime               *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	UpdateTime               *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	ConnectionBandwidthLimit uint64
+	SessionBandwidthLimit    uint64
+	ConnectionByteQuota      uint64
+	SessionByteQuota         uint64
+}
+
+// NewTrafficPolicy creates a new in memory TrafficPolicy for targetId with
+// every setting unlimited.
+func NewTrafficPolicy(ctx context.Context, targetId string) (*TrafficPolicy, error) {
+	const op = "target.NewTrafficPolicy"
+	if targetId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+	return &TrafficPolicy{TargetId: targetId}, nil
+}
+
+// TableName returns the table name.
+func (p *TrafficPolicy) TableName() string {
+	return "target_traffic_policy"
+}
+
+// IsUnlimited reports whether p does not limit any traffic.
+func (p *TrafficPolicy) IsUnlimited() bool {
+	return p.ConnectionBandwidthLimit == 0 &&
+		p.SessionBandwidthLimit == 0 &&
+		p.ConnectionByteQuota == 0 &&
+		p.SessionByteQuota == 0
+}
+
+func (p *TrafficPolicy) clone() *TrafficPolicy {
+	cp := *p
+	return &cp
+}
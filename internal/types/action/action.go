@@ -75,6 +75,14 @@ const (
 	SetGrantScopes                     Type = 61
 	RemoveGrantScopes                  Type = 62
 	MonthlyActiveUsers                 Type = 63
+	EnrollTotp                         Type = 64
+	VerifyTotp                         Type = 65
+	RemoveTotp                         Type = 66
+	RevokeAuthTokens                   Type = 67
+	Drain                              Type = 68
+	ListVersions                       Type = 69
+	Rollback                           Type = 70
+	ListIssuances                      Type = 71
 
 	// When adding new actions, be sure to update:
 	//
@@ -146,6 +154,14 @@ var Map = map[string]Type{
 	SetGrantScopes.String():                     SetGrantScopes,
 	RemoveGrantScopes.String():                  RemoveGrantScopes,
 	MonthlyActiveUsers.String():                 MonthlyActiveUsers,
+	EnrollTotp.String():                         EnrollTotp,
+	VerifyTotp.String():                         VerifyTotp,
+	RemoveTotp.String():                         RemoveTotp,
+	RevokeAuthTokens.String():                   RevokeAuthTokens,
+	Drain.String():                              Drain,
+	ListVersions.String():                       ListVersions,
+	Rollback.String():                           Rollback,
+	ListIssuances.String():                      ListIssuances,
 }
 
 var DeprecatedMap = map[string]Type{
@@ -223,6 +239,14 @@ func (a Type) String() string {
 		"set-grant-scopes",
 		"remove-grant-scopes",
 		"monthly-active-users",
+		"enroll-totp",
+		"verify-totp",
+		"remove-totp",
+		"revoke-auth-tokens",
+		"drain",
+		"list-versions",
+		"rollback",
+		"list-issuances",
 	}[a]
 }
 
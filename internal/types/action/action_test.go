@@ -138,6 +138,38 @@ func TestAction(t *testing.T) {
 			action: Download,
 			want:   "download",
 		},
+		{
+			action: EnrollTotp,
+			want:   "enroll-totp",
+		},
+		{
+			action: VerifyTotp,
+			want:   "verify-totp",
+		},
+		{
+			action: RemoveTotp,
+			want:   "remove-totp",
+		},
+		{
+			action: RevokeAuthTokens,
+			want:   "revoke-auth-tokens",
+		},
+		{
+			action: Drain,
+			want:   "drain",
+		},
+		{
+			action: ListVersions,
+			want:   "list-versions",
+		},
+		{
+			action: Rollback,
+			want:   "rollback",
+		},
+		{
+			action: ListIssuances,
+			want:   "list-issuances",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.want, func(t *testing.T) {
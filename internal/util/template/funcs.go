@@ -3,7 +3,10 @@
 
 package template
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+)
 
 // truncateFrom will truncate a string after the first encounter of sep; sep is
 // elided. This is a passthrough to strings.Cut with only the first return
@@ -23,3 +26,33 @@ func coalesce(vals ...string) string {
 	}
 	return ""
 }
+
+// join concatenates the elements of a list with sep between them. It accepts
+// the lists of strings in Account as well as the lists of values decoded from
+// OIDC claims.
+func join(vals any, sep string) (string, error) {
+	switch v := vals.(type) {
+	case []string:
+		return strings.Join(v, sep), nil
+	case []any:
+		strs := make([]string, 0, len(v))
+		for _, val := range v {
+			strs = append(strs, fmt.Sprint(val))
+		}
+		return strings.Join(strs, sep), nil
+	default:
+		return "", fmt.Errorf("cannot join value of type %T", vals)
+	}
+}
+
+// listLen returns the number of elements of a list accepted by join.
+func listLen(vals any) int {
+	switch v := vals.(type) {
+	case []string:
+		return len(v)
+	case []any:
+		return len(v)
+	default:
+		return 0
+	}
+}
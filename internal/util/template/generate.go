@@ -5,8 +5,10 @@ package template
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"text/template"
+	"text/template/parse"
 
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/util"
@@ -34,11 +36,15 @@ func New(ctx context.Context, raw string) (*Parsed, error) {
 		funcMap: map[string]any{
 			"truncateFrom": truncateFrom,
 			"coalesce":     coalesce,
+			"join":         join,
 		},
 	}
 
+	// Claims are maps, error on a missing claim instead of silently
+	// generating "<no value>".
 	tmpl, err := template.New("template").
 		Funcs(ret.funcMap).
+		Option("missingkey=error").
 		Parse(ret.raw)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error parsing template"))
@@ -71,3 +77,66 @@ func (p *Parsed) Generate(ctx context.Context, data any) (string, error) {
 
 	return out, nil
 }
+
+// listSep separates the values joined by join when generating a list.
+// Joined values containing it are rejected so one value cannot expand into
+// several.
+const listSep = "\x00"
+
+// GenerateList generates a list of values from the provided template. A
+// template which consists of a single join action, such as
+// {{ join .Account.MemberOfGroups "," }}, generates one value per element of
+// the joined list. Any other template generates exactly one value, even if
+// it contains the join separator.
+func (p *Parsed) GenerateList(ctx context.Context, data any) ([]string, error) {
+	const op = "util.template.(Parsed).GenerateList"
+	if p.tmpl == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "parsed template not initialized")
+	}
+	if !p.isJoin() {
+		out, err := p.Generate(ctx, data)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return []string{out}, nil
+	}
+
+	tmpl, err := p.tmpl.Clone()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	tmpl.Funcs(template.FuncMap{
+		"join": func(vals any, _ string) (string, error) {
+			out, err := join(vals, listSep)
+			if err != nil {
+				return "", err
+			}
+			if n := listLen(vals); n > 0 && strings.Count(out, listSep) != n-1 {
+				return "", fmt.Errorf("cannot join values containing a NUL character")
+			}
+			return out, nil
+		},
+	})
+	out, err := (&Parsed{raw: p.raw, tmpl: tmpl, funcMap: p.funcMap}).Generate(ctx, data)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if out == "" {
+		return nil, nil
+	}
+	return strings.Split(out, listSep), nil
+}
+
+// isJoin reports whether the template consists of a single join action.
+func (p *Parsed) isJoin() bool {
+	nodes := p.tmpl.Tree.Root.Nodes
+	if len(nodes) != 1 {
+		return false
+	}
+	action, ok := nodes[0].(*parse.ActionNode)
+	if !ok || len(action.Pipe.Decl) > 0 || len(action.Pipe.Cmds) != 1 {
+		return false
+	}
+	ident, ok := action.Pipe.Cmds[0].Args[0].(*parse.IdentifierNode)
+	return ok && ident.Ident == "join"
+}
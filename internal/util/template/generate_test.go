@@ -39,7 +39,7 @@ func TestErrors(t *testing.T) {
 	require.NotNil(parsed)
 	assert.Equal(ts, parsed.raw)
 	assert.NotNil(parsed.tmpl)
-	assert.Len(parsed.funcMap, 2)
+	assert.Len(parsed.funcMap, 3)
 
 	// Test out errors on the parsed value
 
@@ -136,3 +136,167 @@ accountName
 
 	assert.Equal(exp, out)
 }
+
+func TestGenerate_AccountAttributes(t *testing.T) {
+	ctx := context.Background()
+	ldapData := Data{
+		Account: Account{
+			Dn:             util.Pointer("cn=alice,ou=people,dc=example,dc=com"),
+			MemberOfGroups: []string{"admins", "dba"},
+		},
+	}
+	oidcData := Data{
+		Account: Account{
+			TokenClaims: map[string]any{
+				"preferred_username": "alice",
+				"groups":             []any{"admins", "dba"},
+			},
+			UserinfoClaims: map[string]any{
+				"unix-name": "alice",
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		raw     string
+		data    Data
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "ldap-dn",
+			raw:  "{{ .Account.Dn }}",
+			data: ldapData,
+			want: "cn=alice,ou=people,dc=example,dc=com",
+		},
+		{
+			name: "ldap-groups",
+			raw:  `{{ join .Account.MemberOfGroups "," }}`,
+			data: ldapData,
+			want: "admins,dba",
+		},
+		{
+			name:    "ldap-dn-not-ldap",
+			raw:     "{{ .Account.Dn }}",
+			data:    oidcData,
+			wantErr: true,
+		},
+		{
+			name: "oidc-claim",
+			raw:  "{{ .Account.TokenClaims.preferred_username }}",
+			data: oidcData,
+			want: "alice",
+		},
+		{
+			name: "oidc-claim-index",
+			raw:  `{{ index .Account.UserinfoClaims "unix-name" }}`,
+			data: oidcData,
+			want: "alice",
+		},
+		{
+			name: "oidc-claim-list",
+			raw:  `{{ join .Account.TokenClaims.groups "," }}`,
+			data: oidcData,
+			want: "admins,dba",
+		},
+		{
+			name:    "oidc-missing-claim",
+			raw:     "{{ .Account.TokenClaims.email }}",
+			data:    oidcData,
+			wantErr: true,
+		},
+		{
+			name:    "oidc-claims-not-oidc",
+			raw:     "{{ .Account.TokenClaims.preferred_username }}",
+			data:    ldapData,
+			wantErr: true,
+		},
+		{
+			name:    "join-not-a-list",
+			raw:     `{{ join .Account.TokenClaims.preferred_username "," }}`,
+			data:    oidcData,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require, assert := require.New(t), assert.New(t)
+			parsed, err := New(ctx, tt.raw)
+			require.NoError(err)
+			out, err := parsed.Generate(ctx, tt.data)
+			if tt.wantErr {
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, out)
+		})
+	}
+}
+
+func TestGenerateList(t *testing.T) {
+	ctx := context.Background()
+	data := Data{
+		Account: Account{
+			LoginName:      util.Pointer("alice,root"),
+			MemberOfGroups: []string{"admins", "dba"},
+			TokenClaims: map[string]any{
+				"groups": []any{"admins", "dba"},
+				"nul":    []any{"admins\x00root"},
+				"none":   []any{},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		raw     string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "join",
+			raw:  `{{ join .Account.MemberOfGroups "," }}`,
+			want: []string{"admins", "dba"},
+		},
+		{
+			name: "join-claim",
+			raw:  `{{join .Account.TokenClaims.groups " "}}`,
+			want: []string{"admins", "dba"},
+		},
+		{
+			name: "join-empty",
+			raw:  `{{ join .Account.TokenClaims.none "," }}`,
+		},
+		{
+			name: "join-with-text",
+			raw:  `team-{{ join .Account.MemberOfGroups "," }}`,
+			want: []string{"team-admins,dba"},
+		},
+		{
+			name: "value-with-separator",
+			raw:  `{{ .Account.LoginName }}`,
+			want: []string{"alice,root"},
+		},
+		{
+			name:    "join-value-with-nul",
+			raw:     `{{ join .Account.TokenClaims.nul "," }}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require, assert := require.New(t), assert.New(t)
+			parsed, err := New(ctx, tt.raw)
+			require.NoError(err)
+			out, err := parsed.GenerateList(ctx, data)
+			if tt.wantErr {
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, out)
+		})
+	}
+}
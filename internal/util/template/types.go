@@ -34,4 +34,14 @@ type Account struct {
 	LoginName *string
 	Subject   *string
 	Email     *string
+
+	// Dn and MemberOfGroups are only populated for LDAP accounts.
+	Dn             *string
+	MemberOfGroups []string
+
+	// TokenClaims and UserinfoClaims are only populated for OIDC accounts.
+	// They contain the claims of the ID token and the userinfo endpoint from
+	// the last authentication of the account.
+	TokenClaims    map[string]any
+	UserinfoClaims map[string]any
 }
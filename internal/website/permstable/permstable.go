@@ -324,6 +324,30 @@ var account = &Resource{
 						"ids=<pin>;type=<type>;actions=change-password",
 					},
 				},
+				&Action{
+					Name:        "enroll-totp",
+					Description: "Enroll a password-type account in TOTP multi-factor authentication",
+					Examples: []string{
+						"ids=<id>;actions=enroll-totp",
+						"ids=<pin>;type=<type>;actions=enroll-totp",
+					},
+				},
+				&Action{
+					Name:        "verify-totp",
+					Description: "Verify the pending TOTP enrollment of a password-type account",
+					Examples: []string{
+						"ids=<id>;actions=verify-totp",
+						"ids=<pin>;type=<type>;actions=verify-totp",
+					},
+				},
+				&Action{
+					Name:        "remove-totp",
+					Description: "Remove the TOTP enrollment of a password-type account",
+					Examples: []string{
+						"ids=<id>;actions=remove-totp",
+						"ids=<pin>;type=<type>;actions=remove-totp",
+					},
+				},
 			),
 		},
 	},
@@ -908,6 +932,13 @@ var user = &Resource{
 						"ids=<id>;actions=remove-accounts",
 					},
 				},
+				&Action{
+					Name:        "revoke-auth-tokens",
+					Description: "Revoke every auth token of a user",
+					Examples: []string{
+						"ids=<id>;actions=revoke-auth-tokens",
+					},
+				},
 			),
 		},
 	},
@@ -948,7 +979,16 @@ var worker = &Resource{
 				"ID":   "<id>",
 				"Type": "worker",
 			},
-			Actions: rudActions("a worker", false),
+			Actions: append(
+				rudActions("a worker", false),
+				&Action{
+					Name:        "drain",
+					Description: "Drain a worker before shutting it down",
+					Examples: []string{
+						"ids=<id>;actions=drain",
+					},
+				},
+			),
 		},
 	},
 }